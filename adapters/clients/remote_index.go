@@ -25,6 +25,7 @@ import (
 
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/models"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
@@ -377,9 +378,16 @@ func (c *RemoteIndex) SearchShard(ctx context.Context, host, index, shard string
 	targetCombination *dto.TargetCombination,
 	properties []string,
 ) ([]*storobj.Object, []float32, error) {
+	// the aggregation override is read from the context by the vector indexes
+	// of the remote shard as well
+	multivectorAggregation, _ := hnswent.MultivectorAggregationFromContext(ctx)
+	// as is the id of the snapshot the remote shard is read from
+	snapshot, _ := dto.ReadSnapshotFromContext(ctx)
+
 	// new request
 	body, err := clusterapi.IndicesPayloads.SearchParams.
-		Marshal(vector, targetVector, distance, limit, filters, keywordRanking, sort, cursor, groupBy, additional, targetCombination, properties)
+		Marshal(vector, targetVector, distance, limit, filters, keywordRanking, sort, cursor, groupBy, additional, targetCombination, properties,
+			multivectorAggregation, snapshot)
 	if err != nil {
 		return nil, nil, fmt.Errorf("marshal request payload: %w", err)
 	}
@@ -618,6 +626,48 @@ func (c *RemoteIndex) GetShardStatus(ctx context.Context,
 	return status, c.retry(ctx, 9, try)
 }
 
+// GetShardReindexed tells whether the replica of the shard on the host
+// finished the reindexing task
+func (c *RemoteIndex) GetShardReindexed(ctx context.Context,
+	hostName, indexName, shardName, task string,
+) (bool, error) {
+	req, err := setupRequest(ctx, http.MethodGet, hostName,
+		fmt.Sprintf("/indices/%s/shards/%s/reindexed/%s", indexName, shardName, task),
+		"", nil)
+	if err != nil {
+		return false, errors.Wrap(err, "open http request")
+	}
+	var reindexed bool
+	try := func(ctx context.Context) (bool, error) {
+		res, err := c.client.Do(req)
+		if err != nil {
+			return ctx.Err() == nil, fmt.Errorf("connect: %w", err)
+		}
+		defer res.Body.Close()
+
+		if code := res.StatusCode; code != http.StatusOK {
+			body, _ := io.ReadAll(res.Body)
+			return shouldRetry(code), fmt.Errorf("status code: %v body: (%s)", code, body)
+		}
+		resBytes, err := io.ReadAll(res.Body)
+		if err != nil {
+			return false, errors.Wrap(err, "read body")
+		}
+
+		ct, ok := clusterapi.IndicesPayloads.GetShardReindexedResults.CheckContentTypeHeader(res)
+		if !ok {
+			return false, errors.Errorf("unexpected content type: %s", ct)
+		}
+
+		reindexed, err = clusterapi.IndicesPayloads.GetShardReindexedResults.Unmarshal(resBytes)
+		if err != nil {
+			return false, errors.Wrap(err, "unmarshal body")
+		}
+		return false, nil
+	}
+	return reindexed, c.retry(ctx, 9, try)
+}
+
 func (c *RemoteIndex) UpdateShardStatus(ctx context.Context, hostName, indexName, shardName,
 	targetStatus string, schemaVersion uint64,
 ) error {
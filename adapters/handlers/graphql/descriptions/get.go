@@ -48,3 +48,30 @@ const ConsistencyLevel = "Determines how many replicas must acknowledge a reques
 	"before it is considered successful. Can be 'ONE', 'QUORUM', or 'ALL'"
 
 const Tenant = "The value by which a tenant is identified, specified in the class schema"
+
+const Snapshot = "Reads from the point-in-time snapshot with the given client chosen id, " +
+	"so that subsequent requests of the same user with the same id, e.g. paginated with 'after', see the same objects. " +
+	"The snapshots don't include the vector indexes, they can't be combined with vector and hybrid searches. A snapshot is released after 5 minutes without reads " +
+	"and expires after 30 minutes, a user can hold 4 snapshots per shard"
+
+// Highlights
+const (
+	HighlightsProperties   = "The text properties of which the fragments containing query terms are returned, defaults to the searched properties"
+	HighlightsFragmentSize = "The number of characters of a fragment, defaults to 100"
+)
+
+// Facets
+const (
+	FacetsProperties = "The properties of which the value counts are returned in the facets of the response extensions, keyed by the class or its alias. All results of a vector, bm25 or hybrid search are counted, independent of the limit and up to the maximum number of results of a query, otherwise all objects matching the where filter"
+	FacetName        = "The name of the property"
+	FacetLimit       = "The number of most frequent values to return, defaults to 10"
+	FacetRanges      = "Count the values of an int or number property per range instead, a range includes from and excludes to"
+)
+
+// Query profile
+const (
+	QueryProfile               = "How the vector index of every searched shard and target vector was searched with the where filter"
+	QueryProfileFilterStrategy = "The strategy of the filtered search, one of flat, acorn or sweeping"
+	QueryProfileReason         = "Why the filter strategy was chosen"
+	QueryProfileSelectivity    = "The share of the vectors of the index which match the filter"
+)
@@ -43,6 +43,21 @@ const (
 	AggregatePropertySum                  = "The sum of all values for this property"
 )
 
+const (
+	AggregatePropertyHistogram        = "The number of objects per fixed-interval bucket of the values of this property"
+	AggregatePropertyDateHistogram    = "The number of objects per calendar-interval bucket of the values of this property"
+	AggregateHistogramInterval        = "The width of the buckets"
+	AggregateDateHistogramInterval    = "The calendar unit of the buckets, one of hour, day, week or month"
+	AggregateDateHistogramTimezone    = "The IANA time zone in which the buckets start, defaults to UTC"
+	AggregateHistogramMetrics         = "Int or number properties to aggregate per bucket"
+	AggregateHistogramBucket          = "A bucket of a histogram"
+	AggregateHistogramBucketKey       = "The lower bound of a numerical bucket or the start of a date bucket"
+	AggregateHistogramBucketCount     = "The number of objects in the bucket"
+	AggregateHistogramBucketMetrics   = "The aggregated values of the requested metric properties of the objects in the bucket"
+	AggregateHistogramMetricsProperty = "The name of the aggregated property"
+	AggregateHistogramMetricsCount    = "The number of values of the property in the bucket"
+)
+
 // Network
 const (
 	NetworkMeta            = "Get meta information about Objects from a Weaviate in a network"
@@ -136,6 +136,10 @@ func classField(class *models.Class, description string,
 				Type:        graphql.Int,
 			},
 			"hybrid": hybridArgument(fieldsObject, class, modulesProvider),
+			"snapshot": &graphql.ArgumentConfig{
+				Description: descriptions.Snapshot,
+				Type:        graphql.String,
+			},
 		},
 		Resolve: makeResolveClass(authorizer, modulesProvider, class),
 	}
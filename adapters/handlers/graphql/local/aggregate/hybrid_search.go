@@ -72,6 +72,8 @@ func hybridOperands(classObject *graphql.Object,
 			Type:        graphql.NewList(graphql.String),
 		},
 		"bm25SearchOperator": common_filters.GenerateBM25SearchOperatorFields(prefixName),
+		"bm25Fuzziness":      common_filters.GenerateBM25FuzzinessFields(prefixName),
+		"sparseVector":       common_filters.GenerateHybridSparseVectorFields(prefixName),
 		"searches": &graphql.InputObjectFieldConfig{
 			Description: "Subsearch list",
 			Type: graphql.NewList(graphql.NewInputObject(
@@ -13,6 +13,7 @@ package aggregate
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/tailor-inc/graphql"
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
@@ -77,6 +78,22 @@ func numericPropertyFields(class *models.Class, property *models.Property, prefi
 				return prop.SchemaType, nil
 			},
 		},
+		"histogram": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sHistogram", prefix, class.Class, property.Name),
+			Description: descriptions.AggregatePropertyHistogram,
+			Type:        graphql.NewList(histogramBucketFields(class, property, prefix, graphql.Float)),
+			Resolve:     resolveHistogram,
+			Args: graphql.FieldConfigArgument{
+				"interval": &graphql.ArgumentConfig{
+					Description: descriptions.AggregateHistogramInterval,
+					Type:        graphql.NewNonNull(graphql.Float),
+				},
+				"metrics": &graphql.ArgumentConfig{
+					Description: descriptions.AggregateHistogramMetrics,
+					Type:        graphql.NewList(graphql.String),
+				},
+			},
+		},
 	}
 
 	return graphql.NewObject(graphql.ObjectConfig{
@@ -120,6 +137,26 @@ func datePropertyFields(class *models.Class,
 			Type:        graphql.String,
 			Resolve:     makeResolveDateFieldAggregator("median"),
 		},
+		"dateHistogram": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sDateHistogram", prefix, class.Class, property.Name),
+			Description: descriptions.AggregatePropertyDateHistogram,
+			Type:        graphql.NewList(histogramBucketFields(class, property, prefix, graphql.String)),
+			Resolve:     resolveHistogram,
+			Args: graphql.FieldConfigArgument{
+				"interval": &graphql.ArgumentConfig{
+					Description: descriptions.AggregateDateHistogramInterval,
+					Type:        graphql.NewNonNull(graphql.String),
+				},
+				"timezone": &graphql.ArgumentConfig{
+					Description: descriptions.AggregateDateHistogramTimezone,
+					Type:        graphql.String,
+				},
+				"metrics": &graphql.ArgumentConfig{
+					Description: descriptions.AggregateHistogramMetrics,
+					Type:        graphql.NewList(graphql.String),
+				},
+			},
+		},
 	}
 
 	return graphql.NewObject(graphql.ObjectConfig{
@@ -129,6 +166,139 @@ func datePropertyFields(class *models.Class,
 	})
 }
 
+func histogramBucketFields(class *models.Class,
+	property *models.Property, prefix string, keyType graphql.Output,
+) *graphql.Object {
+	metricsFields := graphql.Fields{
+		"property": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sHistogramMetricsProperty", prefix, class.Class, property.Name),
+			Description: descriptions.AggregateHistogramMetricsProperty,
+			Type:        graphql.String,
+			Resolve:     histogramMetricResolver(func(m histogramMetric) interface{} { return m.Property }),
+		},
+		"count": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sHistogramMetricsCount", prefix, class.Class, property.Name),
+			Description: descriptions.AggregateHistogramMetricsCount,
+			Type:        graphql.Int,
+			Resolve:     histogramMetricResolver(func(m histogramMetric) interface{} { return m.Count }),
+		},
+		"sum": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sHistogramMetricsSum", prefix, class.Class, property.Name),
+			Description: descriptions.AggregateSum,
+			Type:        graphql.Float,
+			Resolve:     histogramMetricResolver(func(m histogramMetric) interface{} { return m.Sum }),
+		},
+		"mean": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sHistogramMetricsMean", prefix, class.Class, property.Name),
+			Description: descriptions.AggregateMean,
+			Type:        graphql.Float,
+			Resolve:     histogramMetricResolver(func(m histogramMetric) interface{} { return m.Mean() }),
+		},
+		"minimum": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sHistogramMetricsMinimum", prefix, class.Class, property.Name),
+			Description: descriptions.AggregateMin,
+			Type:        graphql.Float,
+			Resolve:     histogramMetricResolver(func(m histogramMetric) interface{} { return m.Minimum }),
+		},
+		"maximum": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sHistogramMetricsMaximum", prefix, class.Class, property.Name),
+			Description: descriptions.AggregateMax,
+			Type:        graphql.Float,
+			Resolve:     histogramMetricResolver(func(m histogramMetric) interface{} { return m.Maximum }),
+		},
+	}
+
+	metrics := graphql.NewObject(graphql.ObjectConfig{
+		Name:        fmt.Sprintf("%s%s%sHistogramMetricsObj", prefix, class.Class, property.Name),
+		Fields:      metricsFields,
+		Description: descriptions.AggregateHistogramBucketMetrics,
+	})
+
+	bucketFields := graphql.Fields{
+		"key": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sHistogramKey", prefix, class.Class, property.Name),
+			Description: descriptions.AggregateHistogramBucketKey,
+			Type:        keyType,
+			Resolve:     histogramBucketResolver(func(b aggregation.HistogramBucket) interface{} { return b.Key }),
+		},
+		"count": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sHistogramCount", prefix, class.Class, property.Name),
+			Description: descriptions.AggregateHistogramBucketCount,
+			Type:        graphql.Int,
+			Resolve:     histogramBucketResolver(func(b aggregation.HistogramBucket) interface{} { return b.Count }),
+		},
+		"metrics": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sHistogramMetrics", prefix, class.Class, property.Name),
+			Description: descriptions.AggregateHistogramBucketMetrics,
+			Type:        graphql.NewList(metrics),
+			Resolve: histogramBucketResolver(func(b aggregation.HistogramBucket) interface{} {
+				list := make([]interface{}, 0, len(b.Metrics))
+				for name, m := range b.Metrics {
+					list = append(list, histogramMetric{Property: name, HistogramMetrics: m})
+				}
+				sort.Slice(list, func(i, j int) bool {
+					return list[i].(histogramMetric).Property < list[j].(histogramMetric).Property
+				})
+				return list
+			}),
+		},
+	}
+
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name:        fmt.Sprintf("%s%s%sHistogramObj", prefix, class.Class, property.Name),
+		Fields:      bucketFields,
+		Description: descriptions.AggregateHistogramBucket,
+	})
+}
+
+func resolveHistogram(p graphql.ResolveParams) (interface{}, error) {
+	property, ok := p.Source.(aggregation.Property)
+	if !ok {
+		return nil, fmt.Errorf("histogram: expected aggregation.Property, got %T", p.Source)
+	}
+
+	list := make([]interface{}, len(property.Histogram))
+	for i, bucket := range property.Histogram {
+		list[i] = bucket
+	}
+	return list, nil
+}
+
+type histogramBucketExtractorFunc func(aggregation.HistogramBucket) interface{}
+
+func histogramBucketResolver(extractor histogramBucketExtractorFunc) func(p graphql.ResolveParams) (interface{}, error) {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		bucket, ok := p.Source.(aggregation.HistogramBucket)
+		if !ok {
+			return nil, fmt.Errorf("histogram bucket: %s: expected aggregation.HistogramBucket, but got %T",
+				p.Info.FieldName, p.Source)
+		}
+
+		return extractor(bucket), nil
+	}
+}
+
+// histogramMetric is a HistogramMetrics entry of a bucket together with the
+// name of its prop, as graphql can only return lists
+type histogramMetric struct {
+	Property string
+	aggregation.HistogramMetrics
+}
+
+type histogramMetricExtractorFunc func(histogramMetric) interface{}
+
+func histogramMetricResolver(extractor histogramMetricExtractorFunc) func(p graphql.ResolveParams) (interface{}, error) {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		metric, ok := p.Source.(histogramMetric)
+		if !ok {
+			return nil, fmt.Errorf("histogram metrics: %s: expected histogramMetric, but got %T",
+				p.Info.FieldName, p.Source)
+		}
+
+		return extractor(metric), nil
+	}
+}
+
 func referencePropertyFields(class *models.Class,
 	property *models.Property, prefix string,
 ) *graphql.Object {
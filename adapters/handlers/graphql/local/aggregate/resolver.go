@@ -23,6 +23,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/common_filters"
 	restCtx "github.com/weaviate/weaviate/adapters/handlers/rest/context"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/dto"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
@@ -159,6 +160,14 @@ func resolveAggregate(p graphql.ResolveParams, authorizer authorization.Authoriz
 		hybridParams = p
 	}
 
+	var snapshot string
+	if id, ok := p.Args["snapshot"]; ok {
+		snapshot = id.(string)
+		if err := dto.ValidateReadSnapshotID(snapshot); err != nil {
+			return nil, err
+		}
+	}
+
 	params := &aggregation.Params{
 		Filters:          filters,
 		ClassName:        className,
@@ -172,6 +181,7 @@ func resolveAggregate(p graphql.ResolveParams, authorizer authorization.Authoriz
 		ModuleParams:     moduleParams,
 		Hybrid:           hybridParams,
 		Tenant:           tenant,
+		Snapshot:         snapshot,
 	}
 
 	// we might support objectLimit without nearMedia filters later, e.g. with sort
@@ -256,6 +266,13 @@ func extractAggregators(selections *ast.SelectionSet) ([]aggregation.Aggregator,
 			}
 		}
 
+		if property.Type == aggregation.HistogramType || property.Type == aggregation.DateHistogramType {
+			property, err = extractHistogramFromArgs(property.Type, field.Arguments)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		analyses = append(analyses, property)
 	}
 
@@ -322,6 +339,45 @@ func extractLimitFromArgs(args []*ast.Argument) *int {
 	return nil
 }
 
+func extractHistogramFromArgs(aggType string, args []*ast.Argument) (aggregation.Aggregator, error) {
+	var interval, timeZone string
+	var metrics []schema.PropertyName
+	for _, arg := range args {
+		switch arg.Name.Value {
+		case "interval":
+			interval, _ = arg.Value.GetValue().(string)
+		case "timezone":
+			timeZone, _ = arg.Value.GetValue().(string)
+		case "metrics":
+			values, ok := arg.Value.GetValue().([]ast.Value)
+			if !ok {
+				values = []ast.Value{arg.Value}
+			}
+			for _, value := range values {
+				if name, ok := value.GetValue().(string); ok {
+					metrics = append(metrics, schema.PropertyName(name))
+				}
+			}
+		}
+	}
+
+	var agg aggregation.Aggregator
+	if aggType == aggregation.HistogramType {
+		asFloat, err := strconv.ParseFloat(interval, 64)
+		if err != nil {
+			return agg, fmt.Errorf("histogram: interval must be a number, got %q", interval)
+		}
+		agg = aggregation.NewHistogramAggregator(asFloat, metrics)
+	} else {
+		agg = aggregation.NewDateHistogramAggregator(interval, timeZone, metrics)
+	}
+
+	if err := agg.ValidateHistogram(); err != nil {
+		return agg, err
+	}
+	return agg, nil
+}
+
 func validateObjectLimitUsage(params *aggregation.Params) bool {
 	return params.NearObject != nil ||
 		params.NearVector != nil ||
@@ -389,6 +389,91 @@ func Test_Resolve(t *testing.T) {
 				},
 			}},
 		},
+		testCase{
+			name: "with histogram and date histogram",
+			query: `{ Aggregate { Car {
+				horsepower { histogram(interval: 50, metrics: ["weight"]) { key count metrics { property count mean maximum } } }
+				startOfProduction { dateHistogram(interval: "month", timezone: "Europe/Berlin") { key count } }
+				} } } `,
+			expectedProps: []aggregation.ParamProperty{
+				{
+					Name: "horsepower",
+					Aggregators: []aggregation.Aggregator{
+						aggregation.NewHistogramAggregator(50, []schema.PropertyName{"weight"}),
+					},
+				},
+				{
+					Name: "startOfProduction",
+					Aggregators: []aggregation.Aggregator{
+						aggregation.NewDateHistogramAggregator(aggregation.CalendarIntervalMonth, "Europe/Berlin", nil),
+					},
+				},
+			},
+			resolverReturn: []aggregation.Group{
+				{
+					Count: 3,
+					Properties: map[string]aggregation.Property{
+						"horsepower": {
+							Type: aggregation.PropertyTypeNumerical,
+							Histogram: []aggregation.HistogramBucket{
+								{
+									Key:   100.0,
+									Count: 2,
+									Metrics: map[string]aggregation.HistogramMetrics{
+										"weight": {Count: 2, Sum: 3000, Minimum: 1200, Maximum: 1800},
+									},
+								},
+								{Key: 250.0, Count: 1},
+							},
+						},
+						"startOfProduction": {
+							Type: aggregation.PropertyTypeDate,
+							Histogram: []aggregation.HistogramBucket{
+								{Key: "2020-01-01T00:00:00+01:00", Count: 3},
+							},
+						},
+					},
+				},
+			},
+
+			expectedGroupBy: nil,
+			expectedResults: []result{{
+				pathToField: []string{"Aggregate", "Car"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"horsepower": map[string]interface{}{
+							"histogram": []interface{}{
+								map[string]interface{}{
+									"key":   100.0,
+									"count": 2,
+									"metrics": []interface{}{
+										map[string]interface{}{
+											"property": "weight",
+											"count":    2,
+											"mean":     1500.0,
+											"maximum":  1800.0,
+										},
+									},
+								},
+								map[string]interface{}{
+									"key":     250.0,
+									"count":   1,
+									"metrics": []interface{}{},
+								},
+							},
+						},
+						"startOfProduction": map[string]interface{}{
+							"dateHistogram": []interface{}{
+								map[string]interface{}{
+									"key":   "2020-01-01T00:00:00+01:00",
+									"count": 3,
+								},
+							},
+						},
+					},
+				},
+			}},
+		},
 		testCase{
 			name:  "single prop: mean (with type)",
 			query: `{ Aggregate { Car(groupBy:["madeBy", "Manufacturer", "name"]) { horsepower { mean type } } } }`,
@@ -983,6 +1068,34 @@ func Test_Resolve(t *testing.T) {
 	tests.AssertExtraction(t, "Car")
 }
 
+func Test_Resolve_InvalidHistogram(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		query string
+		error string
+	}{
+		{
+			name:  "non-positive interval",
+			query: `{ Aggregate { Car { horsepower { histogram(interval: 0) { key count } } } } }`,
+			error: "could not extract properties for class 'Car': histogram: interval must be a positive number, got 0",
+		},
+		{
+			name:  "unknown calendar interval",
+			query: `{ Aggregate { Car { startOfProduction { dateHistogram(interval: "year") { key count } } } } }`,
+			error: `could not extract properties for class 'Car': dateHistogram: interval must be one of "hour", "day", "week" or "month", got "year"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver := newMockResolver(config.Config{})
+			resolver.AssertFailToResolve(t, tt.query, tt.error)
+		})
+	}
+}
+
 func (tests testCases) AssertExtraction(t *testing.T, className string) {
 	for _, testCase := range tests {
 		t.Run(testCase.name, func(t *testing.T) {
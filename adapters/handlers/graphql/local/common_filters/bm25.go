@@ -57,6 +57,45 @@ func GenerateBM25SearchOperatorFields(prefixName string) *graphql.InputObjectFie
 	}
 }
 
+func GenerateBM25FuzzinessFields(prefixName string) *graphql.InputObjectFieldConfig {
+	return &graphql.InputObjectFieldConfig{
+		Description: "Match query terms to indexed terms within a maximum edit distance",
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name: prefixName + "Fuzziness",
+				Fields: graphql.InputObjectConfigFieldMap{
+					"maxEdits": &graphql.InputObjectFieldConfig{
+						Description: fmt.Sprintf("The maximum Levenshtein distance between a query term and an indexed term (at most %d)", searchparams.MaxFuzzyEdits),
+						Type:        graphql.NewNonNull(graphql.Int),
+					},
+					"prefixLength": &graphql.InputObjectFieldConfig{
+						Description: "The number of leading characters which have to match exactly",
+						Type:        graphql.Int,
+					},
+					"maxExpansions": &graphql.InputObjectFieldConfig{
+						Description: fmt.Sprintf("The maximum number of indexed terms a query term is expanded to (default %d)", searchparams.DefaultFuzzyMaxExpansions),
+						Type:        graphql.Int,
+					},
+				},
+			},
+		),
+	}
+}
+
+func extractFuzziness(source map[string]interface{}) *searchparams.Fuzziness {
+	fuzziness := &searchparams.Fuzziness{}
+	if maxEdits, ok := source["maxEdits"].(int); ok {
+		fuzziness.MaxEdits = maxEdits
+	}
+	if prefixLength, ok := source["prefixLength"].(int); ok {
+		fuzziness.PrefixLength = prefixLength
+	}
+	if maxExpansions, ok := source["maxExpansions"].(int); ok {
+		fuzziness.MaxExpansions = maxExpansions
+	}
+	return fuzziness
+}
+
 // ExtractBM25
 func ExtractBM25(source map[string]interface{}, explainScore bool) searchparams.KeywordRanking {
 	var args searchparams.KeywordRanking
@@ -87,5 +126,9 @@ func ExtractBM25(source map[string]interface{}, explainScore bool) searchparams.
 		}
 	}
 
+	if fuzziness, ok := source["fuzziness"].(map[string]interface{}); ok {
+		args.Fuzziness = extractFuzziness(fuzziness)
+	}
+
 	return args
 }
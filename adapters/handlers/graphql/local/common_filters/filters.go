@@ -41,6 +41,8 @@ func BuildNew(path string) graphql.InputObjectConfigFieldMap {
 					"ContainsAny":      &graphql.EnumValueConfig{},
 					"ContainsAll":      &graphql.EnumValueConfig{},
 					"ContainsNone":     &graphql.EnumValueConfig{},
+					"Regex":            &graphql.EnumValueConfig{},
+					"StartsWith":       &graphql.EnumValueConfig{},
 				},
 				Description: descriptions.WhereOperatorEnum,
 			}),
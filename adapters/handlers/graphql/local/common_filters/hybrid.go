@@ -14,6 +14,8 @@ package common_filters
 import (
 	"fmt"
 
+	"github.com/tailor-inc/graphql"
+
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/models"
 
@@ -172,6 +174,17 @@ func ExtractHybridSearch(source map[string]interface{}, explainScore bool) (*sea
 		}
 	}
 
+	if fuzziness, ok := source["bm25Fuzziness"].(map[string]interface{}); ok {
+		args.Fuzziness = extractFuzziness(fuzziness)
+	}
+
+	if sparseVector, ok := source["sparseVector"].(map[string]interface{}); ok {
+		args.SparseVector, err = extractHybridSparseVector(sparseVector)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
 	args.Type = "hybrid"
 
 	if args.NearTextParams != nil && args.NearVectorParams != nil {
@@ -186,3 +199,53 @@ func ExtractHybridSearch(source map[string]interface{}, explainScore bool) (*sea
 
 	return &args, combination, nil
 }
+
+// GenerateHybridSparseVectorFields defines the sparse vector which replaces
+// the BM25 query as the keyword side of a hybrid search
+func GenerateHybridSparseVectorFields(prefixName string) *graphql.InputObjectFieldConfig {
+	return &graphql.InputObjectFieldConfig{
+		Description: "Use a dot product search on a sparse vector instead of BM25 as the keyword search",
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name: prefixName + "SparseVector",
+				Fields: graphql.InputObjectConfigFieldMap{
+					"targetVector": &graphql.InputObjectFieldConfig{
+						Description: "The named vector with a sparse index to search",
+						Type:        graphql.NewNonNull(graphql.String),
+					},
+					"indices": &graphql.InputObjectFieldConfig{
+						Description: "The dimension indices of the non-zero entries of the query vector",
+						Type:        graphql.NewNonNull(graphql.NewList(graphql.Int)),
+					},
+					"values": &graphql.InputObjectFieldConfig{
+						Description: "The weights of the non-zero entries, in the same order as the indices",
+						Type:        graphql.NewNonNull(graphql.NewList(graphql.Float)),
+					},
+				},
+			},
+		),
+	}
+}
+
+func extractHybridSparseVector(source map[string]interface{}) (*searchparams.SparseVectorSearch, error) {
+	out := &searchparams.SparseVectorSearch{Vector: &models.SparseVector{}}
+	if targetVector, ok := source["targetVector"].(string); ok {
+		out.TargetVector = targetVector
+	}
+	if indices, ok := source["indices"].([]interface{}); ok {
+		out.Vector.Indices = make([]uint32, len(indices))
+		for i, index := range indices {
+			if index.(int) < 0 {
+				return nil, fmt.Errorf("sparseVector: indices must not be negative, got %d", index)
+			}
+			out.Vector.Indices[i] = uint32(index.(int))
+		}
+	}
+	if values, ok := source["values"].([]interface{}); ok {
+		out.Vector.Values = make([]float32, len(values))
+		for i, value := range values {
+			out.Vector.Values[i] = float32(value.(float64))
+		}
+	}
+	return out, nil
+}
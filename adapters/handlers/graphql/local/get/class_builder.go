@@ -187,6 +187,8 @@ func (b *classBuilder) additionalFields(classProperties graphql.Fields, class *m
 	additionalProperties["lastUpdateTimeUnix"] = b.additionalLastUpdateTimeUnix()
 	additionalProperties["score"] = b.additionalScoreField()
 	additionalProperties["explainScore"] = b.additionalExplainScoreField()
+	additionalProperties["highlights"] = b.additionalHighlightsField(class)
+	additionalProperties["queryProfile"] = b.additionalQueryProfileField(class)
 	additionalProperties["group"] = b.additionalGroupField(classProperties, class)
 	if replicationEnabled(class) {
 		additionalProperties["isConsistent"] = b.isConsistentField()
@@ -284,6 +286,55 @@ func (b *classBuilder) additionalExplainScoreField() *graphql.Field {
 	}
 }
 
+func (b *classBuilder) additionalHighlightsField(class *models.Class) *graphql.Field {
+	return &graphql.Field{
+		Args: graphql.FieldConfigArgument{
+			"properties": &graphql.ArgumentConfig{
+				Description: descriptions.HighlightsProperties,
+				Type:        graphql.NewList(graphql.String),
+			},
+			"fragmentSize": &graphql.ArgumentConfig{
+				Description: descriptions.HighlightsFragmentSize,
+				Type:        graphql.Int,
+			},
+		},
+		Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+			Name: fmt.Sprintf("%sAdditionalHighlights", class.Class),
+			Fields: graphql.Fields{
+				"property":  &graphql.Field{Type: graphql.String},
+				"fragments": &graphql.Field{Type: graphql.NewList(graphql.String)},
+			},
+		})),
+	}
+}
+
+func (b *classBuilder) additionalQueryProfileField(class *models.Class) *graphql.Field {
+	return &graphql.Field{
+		Description: descriptions.QueryProfile,
+		Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+			Name: fmt.Sprintf("%sAdditionalQueryProfile", class.Class),
+			Fields: graphql.Fields{
+				"shard":        &graphql.Field{Type: graphql.String},
+				"targetVector": &graphql.Field{Type: graphql.String},
+				"filterStrategy": &graphql.Field{
+					Description: descriptions.QueryProfileFilterStrategy,
+					Type:        graphql.String,
+				},
+				"reason": &graphql.Field{
+					Description: descriptions.QueryProfileReason,
+					Type:        graphql.String,
+				},
+				"selectivity": &graphql.Field{
+					Description: descriptions.QueryProfileSelectivity,
+					Type:        graphql.Float,
+				},
+				"allowListSize": &graphql.Field{Type: graphql.Int},
+				"indexSize":     &graphql.Field{Type: graphql.Int},
+			},
+		})),
+	}
+}
+
 func (b *classBuilder) additionalLastUpdateTimeUnix() *graphql.Field {
 	return &graphql.Field{
 		Type: graphql.String,
@@ -15,6 +15,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
@@ -228,6 +229,10 @@ func buildGetClassField(classObject *graphql.Object,
 				Description: "Cut off number of results after the Nth extrema. Off by default, negative numbers mean off.",
 				Type:        graphql.Int,
 			},
+			"snapshot": &graphql.ArgumentConfig{
+				Description: descriptions.Snapshot,
+				Type:        graphql.String,
+			},
 
 			"sort":       sortArgument(class.Class),
 			"nearVector": nearVectorArgument(class.Class),
@@ -235,6 +240,7 @@ func buildGetClassField(classObject *graphql.Object,
 			"where":      whereArgument(class.Class),
 			"group":      groupArgument(class.Class),
 			"groupBy":    groupByArgument(class.Class),
+			"facets":     facetsArgument(class.Class),
 		},
 		Resolve: newResolver(authorizer, modulesProvider).makeResolveGetClass(class.Class),
 	}
@@ -463,6 +469,14 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		}
 	}
 
+	var snapshot string
+	if id, ok := p.Args["snapshot"]; ok {
+		snapshot = id.(string)
+		if err := dto.ValidateReadSnapshotID(snapshot); err != nil {
+			return nil, err
+		}
+	}
+
 	group := extractGroup(p.Args)
 
 	var groupByParams *searchparams.GroupBy
@@ -490,12 +504,37 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		GroupBy:                 groupByParams,
 		Tenant:                  tenant,
 		TargetVectorCombination: targetVectorCombination,
+		Snapshot:                snapshot,
 	}
 
 	// need to perform vector search by distance
 	// under certain conditions
 	setLimitBasedOnVectorSearchParams(&params)
 
+	if _, ok := p.Args["facets"]; ok {
+		params.AdditionalProperties.Facets = extractFacets(p.Args)
+		collector, ok := source[FacetsRootKey].(*Facets)
+		if !ok {
+			return nil, fmt.Errorf("facets are not supported by this endpoint")
+		}
+		// the facets are returned by the alias of the class, if any
+		key := p.Info.FieldName
+		if p.Info.Path != nil {
+			if pathKey, ok := p.Info.Path.Key.(string); ok {
+				key = pathKey
+			}
+		}
+
+		return func() (interface{}, error) {
+			result, facets, err := resolver.GetClassWithFacets(p.Context, principal, params)
+			if err != nil {
+				return result, enterrors.NewErrGraphQLUser(err, "Get", params.ClassName)
+			}
+			collector.add(key, facets)
+			return result, nil
+		}, nil
+	}
+
 	return func() (interface{}, error) {
 		result, err := resolver.GetClass(p.Context, principal, params)
 		if err != nil {
@@ -590,7 +629,8 @@ func (ac *additionalCheck) isAdditional(parentName, name string) bool {
 			name == "distance" || name == "id" || name == "vector" || name == "vectors" ||
 			name == "creationTimeUnix" || name == "lastUpdateTimeUnix" ||
 			name == "score" || name == "explainScore" || name == "isConsistent" ||
-			name == "group" {
+			name == "group" || name == "highlights" ||
+			name == "queryProfile" {
 			return true
 		}
 		if ac.isModuleAdditional(name) {
@@ -697,6 +737,14 @@ func extractProperties(className string, selections *ast.SelectionSet,
 							additionalProps.ExplainScore = true
 							continue
 						}
+						if additionalProperty == "highlights" {
+							additionalProps.Highlights = extractHighlights(s.Arguments)
+							continue
+						}
+						if additionalProperty == "queryProfile" {
+							additionalProps.QueryProfile = true
+							continue
+						}
 						if additionalProperty == "lastUpdateTimeUnix" {
 							additionalProps.LastUpdateTimeUnix = true
 							continue
@@ -890,3 +938,29 @@ func hackyWorkaroundToExtractClassName(def ast.Definition, name string) (string,
 
 	return string(matches[1]), nil
 }
+
+func extractHighlights(args []*ast.Argument) *additional.Highlights {
+	out := &additional.Highlights{}
+	for _, arg := range args {
+		switch arg.Name.Value {
+		case "properties":
+			switch value := arg.Value.GetValue().(type) {
+			case string:
+				out.Properties = append(out.Properties, value)
+			case []ast.Value:
+				for _, elem := range value {
+					if prop, ok := elem.GetValue().(string); ok {
+						out.Properties = append(out.Properties, prop)
+					}
+				}
+			}
+		case "fragmentSize":
+			if value, ok := arg.Value.GetValue().(string); ok {
+				out.FragmentSize, _ = strconv.Atoi(value)
+			}
+		default:
+			// ignore what we don't recognize
+		}
+	}
+	return out
+}
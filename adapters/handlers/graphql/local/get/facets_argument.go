@@ -0,0 +1,132 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package get
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/entities/additional"
+)
+
+// FacetsRootKey is the key of the Facets of a request in the graphql root
+// object
+const FacetsRootKey = "Facets"
+
+func facetsArgument(className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("GetObjects%s", className)
+	return &graphql.ArgumentConfig{
+		Description: descriptions.FacetsProperties,
+		Type: graphql.NewList(graphql.NewInputObject(graphql.InputObjectConfig{
+			Name: fmt.Sprintf("%sFacetsInpObj", prefix),
+			Fields: graphql.InputObjectConfigFieldMap{
+				"name": &graphql.InputObjectFieldConfig{
+					Description: descriptions.FacetName,
+					Type:        graphql.NewNonNull(graphql.String),
+				},
+				"limit": &graphql.InputObjectFieldConfig{
+					Description: descriptions.FacetLimit,
+					Type:        graphql.Int,
+				},
+				"ranges": &graphql.InputObjectFieldConfig{
+					Description: descriptions.FacetRanges,
+					Type: graphql.NewList(graphql.NewInputObject(graphql.InputObjectConfig{
+						Name: fmt.Sprintf("%sFacetsRangeInpObj", prefix),
+						Fields: graphql.InputObjectConfigFieldMap{
+							"from": &graphql.InputObjectFieldConfig{Type: graphql.Float},
+							"to":   &graphql.InputObjectFieldConfig{Type: graphql.Float},
+						},
+					})),
+				},
+			},
+		})),
+	}
+}
+
+func extractFacets(args map[string]interface{}) []additional.Facet {
+	values, ok := args["facets"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	out := make([]additional.Facet, 0, len(values))
+	for _, value := range values {
+		fields, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		var facet additional.Facet
+		facet.Property, _ = fields["name"].(string)
+		facet.Limit, _ = fields["limit"].(int)
+		if ranges, ok := fields["ranges"].([]interface{}); ok {
+			facet.Ranges = extractFacetRanges(ranges)
+		}
+		out = append(out, facet)
+	}
+	return out
+}
+
+func extractFacetRanges(values []interface{}) []additional.FacetRange {
+	bound := func(value interface{}) *float64 {
+		if asFloat, ok := value.(float64); ok {
+			return &asFloat
+		}
+		return nil
+	}
+
+	out := make([]additional.FacetRange, 0, len(values))
+	for _, value := range values {
+		fields, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		out = append(out, additional.FacetRange{From: bound(fields["from"]), To: bound(fields["to"])})
+	}
+	return out
+}
+
+// Facets collects the facets of the classes of a Get query. The results of a
+// class are a list of objects, so the facets are returned once per response
+// next to the data, keyed by the response key of the class, i.e. its alias or
+// name.
+type Facets struct {
+	sync.Mutex
+	byKey map[string][]*additional.FacetResult
+}
+
+func NewFacets() *Facets {
+	return &Facets{byKey: map[string][]*additional.FacetResult{}}
+}
+
+func (f *Facets) add(key string, facets []*additional.FacetResult) {
+	f.Lock()
+	defer f.Unlock()
+	f.byKey[key] = facets
+}
+
+// Extensions returns the graphql extensions with the collected facets, or nil
+// if no facets were requested
+func (f *Facets) Extensions() map[string]interface{} {
+	f.Lock()
+	defer f.Unlock()
+	if len(f.byKey) == 0 {
+		return nil
+	}
+
+	facets := make(map[string]interface{}, len(f.byKey))
+	for key, value := range f.byKey {
+		facets[key] = value
+	}
+	return map[string]interface{}{"facets": facets}
+}
@@ -455,6 +455,36 @@ func TestExtractAdditionalFields(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:  "with _additional highlights",
+			query: `{ Get { SomeAction(bm25:{query:"fox"}) { _additional { highlights(properties:["intField", "name"], fragmentSize:50) { property fragments } } } } }`,
+			expectedParams: dto.GetParams{
+				ClassName:      "SomeAction",
+				KeywordRanking: &searchparams.KeywordRanking{Type: "bm25", Query: "fox"},
+				AdditionalProperties: additional.Properties{
+					Highlights: &additional.Highlights{Properties: []string{"intField", "name"}, FragmentSize: 50},
+				},
+			},
+			resolverReturn: []interface{}{
+				map[string]interface{}{
+					"_additional": map[string]interface{}{
+						"highlights": []*additional.Highlight{
+							{Property: "name", Fragments: []string{"the <em>fox</em>"}},
+						},
+					},
+				},
+			},
+			expectedResult: map[string]interface{}{
+				"_additional": map[string]interface{}{
+					"highlights": []interface{}{
+						map[string]interface{}{
+							"property":  "name",
+							"fragments": []interface{}{"the <em>fox</em>"},
+						},
+					},
+				},
+			},
+		},
 		{
 			name:  "with _additional lastUpdateTimeUnix",
 			query: "{ Get { SomeAction { _additional { lastUpdateTimeUnix } } } }",
@@ -1929,6 +1959,62 @@ func TestGetWithAlias(t *testing.T) {
 	resolver.AssertResolve(t, "{ Get { SomeActionAlias { intField } } }")
 }
 
+func TestGetWithFacets(t *testing.T) {
+	t.Parallel()
+
+	expectedParams := dto.GetParams{
+		ClassName:  "SomeAction",
+		Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		AdditionalProperties: additional.Properties{
+			Facets: []additional.Facet{
+				{Property: "name", Limit: 3},
+				{Property: "intField", Ranges: []additional.FacetRange{{To: ptFloat64(10)}, {From: ptFloat64(10)}}},
+			},
+		},
+	}
+	facets := []*additional.FacetResult{
+		{Property: "name", Values: []additional.FacetValue{{Value: "fox", Count: 2}}},
+		{Property: "intField", Ranges: []additional.FacetRangeCount{{To: ptFloat64(10), Count: 1}}},
+	}
+
+	t.Run("are returned without results", func(t *testing.T) {
+		resolver := newMockResolver()
+		resolver.On("GetClassWithFacets", expectedParams).
+			Return(test_helper.EmptyList(), facets, nil).Once()
+
+		result := resolver.Resolve(`{ Get { SomeAction(facets:[{name:"name", limit:3}, {name:"intField", ranges:[{to:10}, {from:10}]}]) { intField } } }`)
+		require.Empty(t, result.Errors)
+		resolver.AssertExpectations(t)
+
+		assert.Empty(t, result.Data.(map[string]interface{})["Get"].(map[string]interface{})["SomeAction"])
+		assert.Equal(t, map[string]interface{}{"SomeAction": facets},
+			resolver.RootObject[FacetsRootKey].(*Facets).Extensions()["facets"])
+	})
+
+	t.Run("are keyed by the alias of the class", func(t *testing.T) {
+		resolver := newMockResolver()
+		resolver.On("GetClassWithFacets", expectedParams).
+			Return([]interface{}{map[string]interface{}{"intField": 1}}, facets, nil).Once()
+
+		result := resolver.AssertResolve(t, `{ Get { counts: SomeAction(facets:[{name:"name", limit:3}, {name:"intField", ranges:[{to:10}, {from:10}]}]) { intField } } }`)
+
+		assert.Equal(t, map[string]interface{}{"intField": 1}, result.Get("Get", "counts").Result.([]interface{})[0])
+		assert.Equal(t, map[string]interface{}{"counts": facets},
+			resolver.RootObject[FacetsRootKey].(*Facets).Extensions()["facets"])
+	})
+
+	t.Run("are not returned if not requested", func(t *testing.T) {
+		resolver := newMockResolver()
+		resolver.On("GetClass", dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		}).Return(test_helper.EmptyList(), nil).Once()
+
+		resolver.AssertResolve(t, "{ Get { SomeAction { intField } } }")
+		assert.Nil(t, resolver.RootObject[FacetsRootKey].(*Facets).Extensions())
+	})
+}
+
 func TestNearObject(t *testing.T) {
 	t.Parallel()
 
@@ -3521,3 +3607,7 @@ func timeMust(t strfmt.DateTime, err error) strfmt.DateTime {
 
 	return t
 }
+
+func ptFloat64(in float64) *float64 {
+	return &in
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
 	test_helper "github.com/weaviate/weaviate/adapters/handlers/graphql/test/helper"
+	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
@@ -657,7 +658,7 @@ func newMockResolverWithVectorizer(vectorizer string) *mockResolver {
 	mockLog := &mockRequestsLog{}
 	mocker.RootFieldName = "Get"
 	mocker.RootField = field
-	mocker.RootObject = map[string]interface{}{"Resolver": Resolver(mocker), "RequestsLog": RequestsLog(mockLog)}
+	mocker.RootObject = map[string]interface{}{"Resolver": Resolver(mocker), "RequestsLog": RequestsLog(mockLog), FacetsRootKey: NewFacets()}
 	return mocker
 }
 
@@ -671,7 +672,7 @@ func newMockResolverWithNoModules() *mockResolver {
 	mockLog := &mockRequestsLog{}
 	mocker.RootFieldName = "Get"
 	mocker.RootField = field
-	mocker.RootObject = map[string]interface{}{"Resolver": Resolver(mocker), "RequestsLog": RequestsLog(mockLog)}
+	mocker.RootObject = map[string]interface{}{"Resolver": Resolver(mocker), "RequestsLog": RequestsLog(mockLog), FacetsRootKey: NewFacets()}
 	return mocker
 }
 
@@ -690,6 +691,13 @@ func (m *mockResolver) GetClass(ctx context.Context, principal *models.Principal
 	return args.Get(0).([]interface{}), args.Error(1)
 }
 
+func (m *mockResolver) GetClassWithFacets(ctx context.Context, principal *models.Principal,
+	params dto.GetParams,
+) ([]interface{}, []*additional.FacetResult, error) {
+	args := m.Called(params)
+	return args.Get(0).([]interface{}), args.Get(1).([]*additional.FacetResult), args.Error(2)
+}
+
 type targetsAndVectors struct {
 	targets []string
 	vectors []models.Vector
@@ -14,6 +14,7 @@ package get
 import (
 	"context"
 
+	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/models"
 )
@@ -21,6 +22,7 @@ import (
 // Resolver is a local abstraction of the required UC resolvers
 type Resolver interface {
 	GetClass(ctx context.Context, principal *models.Principal, info dto.GetParams) ([]interface{}, error)
+	GetClassWithFacets(ctx context.Context, principal *models.Principal, info dto.GetParams) ([]interface{}, []*additional.FacetResult, error)
 }
 
 // RequestsLog is a local abstraction on the RequestsLog that needs to be
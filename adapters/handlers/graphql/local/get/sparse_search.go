@@ -41,5 +41,6 @@ func bm25Fields(prefix string) graphql.InputObjectConfigFieldMap {
 			Type:        graphql.NewList(graphql.String),
 		},
 		"searchOperator": common_filters.GenerateBM25SearchOperatorFields(prefix),
+		"fuzziness":      common_filters.GenerateBM25FuzzinessFields(prefix),
 	}
 }
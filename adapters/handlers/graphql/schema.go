@@ -70,17 +70,23 @@ func Build(schema *schema.SchemaWithAliases, traverser Traverser,
 
 // Resolve at query time
 func (g *graphQL) Resolve(context context.Context, query string, operationName string, variables map[string]interface{}) *graphql.Result {
-	return graphql.Do(graphql.Params{
+	facets := get.NewFacets()
+	result := graphql.Do(graphql.Params{
 		Schema: g.schema,
 		RootObject: map[string]interface{}{
-			"Resolver": g.traverser,
-			"Config":   g.config,
+			"Resolver":        g.traverser,
+			"Config":          g.config,
+			get.FacetsRootKey: facets,
 		},
 		RequestString:  query,
 		OperationName:  operationName,
 		VariableValues: variables,
 		Context:        context,
 	})
+	if extensions := facets.Extensions(); extensions != nil {
+		result.Extensions = extensions
+	}
+	return result
 }
 
 func buildGraphqlSchema(dbSchema *schema.SchemaWithAliases, logger logrus.FieldLogger,
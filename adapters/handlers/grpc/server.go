@@ -123,8 +123,10 @@ func CreateGRPCServer(state *state.State, options ...grpc.ServerOption) (*grpc.S
 		state.ServerConfig.Config.Authentication.AnonymousAccess.Enabled,
 		state.SchemaManager,
 		state.BatchManager,
+		state.ObjectsManager,
 		&state.ServerConfig.Config,
 		state.Authorizer,
+		state.DB,
 		state.Logger,
 	)
 	pbv0.RegisterWeaviateServer(s, weaviateV0)
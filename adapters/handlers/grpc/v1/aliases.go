@@ -0,0 +1,147 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+)
+
+func (s *Service) AliasesGet(ctx context.Context, req *pb.AliasesGetRequest) (*pb.AliasesGetReply, error) {
+	before := time.Now()
+
+	ctx, principal, err := s.principalContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Alias == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing alias")
+	}
+
+	alias, err := s.schemaManager.GetAlias(ctx, principal, req.Alias)
+	if err != nil {
+		return nil, schemaError("get alias", err)
+	}
+
+	return &pb.AliasesGetReply{
+		Took:  float32(time.Since(before).Seconds()),
+		Alias: aliasToGRPC(alias),
+	}, nil
+}
+
+func (s *Service) AliasesList(ctx context.Context, req *pb.AliasesListRequest) (*pb.AliasesListReply, error) {
+	before := time.Now()
+
+	ctx, principal, err := s.principalContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	aliases, err := s.schemaManager.GetAliases(ctx, principal, "", req.GetCollection())
+	if err != nil {
+		return nil, fmt.Errorf("get aliases: %w", err)
+	}
+
+	retAliases := make([]*pb.Alias, len(aliases))
+	for i, alias := range aliases {
+		retAliases[i] = aliasToGRPC(alias)
+	}
+
+	return &pb.AliasesListReply{
+		Took:    float32(time.Since(before).Seconds()),
+		Aliases: retAliases,
+	}, nil
+}
+
+func (s *Service) AliasesCreate(ctx context.Context, req *pb.AliasesCreateRequest) (*pb.AliasesCreateReply, error) {
+	before := time.Now()
+
+	ctx, principal, err := s.principalContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Alias == nil || req.Alias.Alias == "" || req.Alias.Collection == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing alias or collection")
+	}
+
+	alias, _, err := s.schemaManager.AddAlias(ctx, principal, &models.Alias{
+		Alias: req.Alias.Alias,
+		Class: req.Alias.Collection,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create alias: %w", err)
+	}
+
+	return &pb.AliasesCreateReply{
+		Took:  float32(time.Since(before).Seconds()),
+		Alias: aliasToGRPC(alias),
+	}, nil
+}
+
+func (s *Service) AliasesUpdate(ctx context.Context, req *pb.AliasesUpdateRequest) (*pb.AliasesUpdateReply, error) {
+	before := time.Now()
+
+	ctx, principal, err := s.principalContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Alias == "" || req.Collection == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing alias or collection")
+	}
+
+	alias, err := s.schemaManager.UpdateAlias(ctx, principal, req.Alias, req.Collection)
+	if err != nil {
+		return nil, schemaError("update alias", err)
+	}
+
+	return &pb.AliasesUpdateReply{
+		Took:  float32(time.Since(before).Seconds()),
+		Alias: aliasToGRPC(alias),
+	}, nil
+}
+
+func (s *Service) AliasesDelete(ctx context.Context, req *pb.AliasesDeleteRequest) (*pb.AliasesDeleteReply, error) {
+	before := time.Now()
+
+	ctx, principal, err := s.principalContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Alias == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing alias")
+	}
+
+	if err := s.schemaManager.DeleteAlias(ctx, principal, req.Alias); err != nil {
+		return nil, schemaError("delete alias", err)
+	}
+
+	return &pb.AliasesDeleteReply{
+		Took: float32(time.Since(before).Seconds()),
+	}, nil
+}
+
+func aliasToGRPC(alias *models.Alias) *pb.Alias {
+	if alias == nil {
+		return nil
+	}
+	return &pb.Alias{
+		Alias:      alias.Alias,
+		Collection: alias.Class,
+	}
+}
@@ -16,6 +16,10 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
 	"github.com/weaviate/weaviate/usecases/byteops"
 
 	"github.com/weaviate/weaviate/entities/models"
@@ -97,6 +101,7 @@ func BatchObjectsFromProto(req *pb.BatchObjectsRequest, authorizedGetClass func(
 		if len(obj.Vectors) > 0 {
 			parsedVectors := make(map[string][]float32)
 			parsedMultiVectors := make(map[string][][]float32)
+			parsedSparseVectors := make(map[string]*models.SparseVector)
 			for _, vec := range obj.Vectors {
 				switch vec.Type {
 				case *pb.Vectors_VECTOR_TYPE_UNSPECIFIED.Enum(), *pb.Vectors_VECTOR_TYPE_SINGLE_FP32.Enum():
@@ -108,17 +113,37 @@ func BatchObjectsFromProto(req *pb.BatchObjectsRequest, authorizedGetClass func(
 						continue
 					}
 					parsedMultiVectors[vec.Name] = out
+				case *pb.Vectors_VECTOR_TYPE_SINGLE_FP16.Enum(), *pb.Vectors_VECTOR_TYPE_SINGLE_INT8.Enum(), *pb.Vectors_VECTOR_TYPE_SINGLE_UINT8.Enum():
+					dataType, _ := SingleVectorDataType(vec.Type)
+					if err := validateEncodedVector(class, vec.Name, dataType, vec.VectorBytes); err != nil {
+						objectErrors[i] = err
+						continue
+					}
+					parsedVectors[vec.Name] = vectorIndexCommon.DecodeVector(dataType, vec.VectorBytes, nil)
+				case *pb.Vectors_VECTOR_TYPE_SPARSE_FP32.Enum():
+					out, err := sparse.Decode(vec.VectorBytes)
+					if err != nil {
+						objectErrors[i] = err
+						continue
+					}
+					parsedSparseVectors[vec.Name] = out
 				default:
 					// do nothing
 				}
 			}
-			vectors = make(models.Vectors, len(parsedVectors)+len(parsedMultiVectors))
+			if objectErrors[i] != nil {
+				continue
+			}
+			vectors = make(models.Vectors, len(parsedVectors)+len(parsedMultiVectors)+len(parsedSparseVectors))
 			for targetVector, vector := range parsedVectors {
 				vectors[targetVector] = vector
 			}
 			for targetVector, multiVector := range parsedMultiVectors {
 				vectors[targetVector] = multiVector
 			}
+			for targetVector, sparseVector := range parsedSparseVectors {
+				vectors[targetVector] = sparseVector
+			}
 		}
 
 		objOriginalIndex[insertCounter] = i
@@ -250,3 +275,44 @@ func BatchReferencesFromProto(req *pb.BatchReferencesRequest) []*models.BatchRef
 	}
 	return refs
 }
+
+// SingleVectorDataType returns the vector index data type in which a single
+// vector of the given type is encoded
+func SingleVectorDataType(vectorType pb.Vectors_VectorType) (string, bool) {
+	switch vectorType {
+	case pb.Vectors_VECTOR_TYPE_UNSPECIFIED, pb.Vectors_VECTOR_TYPE_SINGLE_FP32:
+		return vectorIndexCommon.DataTypeFloat32, true
+	case pb.Vectors_VECTOR_TYPE_SINGLE_FP16:
+		return vectorIndexCommon.DataTypeFloat16, true
+	case pb.Vectors_VECTOR_TYPE_SINGLE_INT8:
+		return vectorIndexCommon.DataTypeInt8, true
+	case pb.Vectors_VECTOR_TYPE_SINGLE_UINT8:
+		return vectorIndexCommon.DataTypeUint8, true
+	default:
+		return "", false
+	}
+}
+
+// validateEncodedVector checks that a vector which is not sent as float32 is
+// sent in the data type its target vector is stored in. Its values are then
+// stored exactly as sent, as decoding and encoding them again is lossless.
+func validateEncodedVector(class *models.Class, name, dataType string, vectorBytes []byte) error {
+	if len(vectorBytes)%vectorIndexCommon.BytesPerDimension(dataType) != 0 {
+		return fmt.Errorf("vector %q: %d bytes are not a %s vector", name, len(vectorBytes), dataType)
+	}
+	if class == nil {
+		return fmt.Errorf("vector %q: %s vectors require an existing collection", name, dataType)
+	}
+
+	stored := vectorIndexCommon.DefaultDataType
+	if vectorConfig, ok := class.VectorConfig[name]; ok {
+		if vc, ok := vectorConfig.VectorIndexConfig.(schemaConfig.VectorIndexConfig); ok {
+			stored = vectorindex.DataType(vc)
+		}
+	}
+	if stored != dataType {
+		return fmt.Errorf("vector %q is sent as %s, but collection %s stores it as %s",
+			name, dataType, class.Class, stored)
+	}
+	return nil
+}
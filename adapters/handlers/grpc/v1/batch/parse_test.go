@@ -21,6 +21,8 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/grpc/v1/batch"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -64,6 +66,7 @@ func TestGRPCBatchRequest(t *testing.T) {
 	refClass1 := "OtherClass"
 	refClass2 := "AnotherClass"
 	multiVecClass := "MultiVec"
+	dataTypesClass := "DataTypes"
 	scheme := schema.Schema{
 		Objects: &models.Schema{
 			Classes: []*models.Class{
@@ -106,6 +109,14 @@ func TestGRPCBatchRequest(t *testing.T) {
 						},
 					},
 				},
+				{
+					Class: dataTypesClass,
+					VectorConfig: map[string]models.VectorConfig{
+						"fp16":  {VectorIndexType: "flat", VectorIndexConfig: flatent.UserConfig{DataType: "float16"}},
+						"int8":  {VectorIndexType: "flat", VectorIndexConfig: flatent.UserConfig{DataType: "int8"}},
+						"uint8": {VectorIndexType: "flat", VectorIndexConfig: flatent.UserConfig{DataType: "uint8"}},
+					},
+				},
 			},
 		},
 	}
@@ -465,6 +476,75 @@ func TestGRPCBatchRequest(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: "named vectors in compact data types",
+			req: []*pb.BatchObject{{Collection: dataTypesClass, Uuid: UUID4, Vectors: []*pb.Vectors{
+				{
+					Name:        "fp16",
+					VectorBytes: []byte{0x00, 0x38, 0x00, 0xc0},
+					Type:        pb.Vectors_VECTOR_TYPE_SINGLE_FP16,
+				},
+				{
+					Name:        "int8",
+					VectorBytes: []byte{0xfd, 0x04},
+					Type:        pb.Vectors_VECTOR_TYPE_SINGLE_INT8,
+				},
+				{
+					Name:        "uint8",
+					VectorBytes: []byte{200, 1},
+					Type:        pb.Vectors_VECTOR_TYPE_SINGLE_UINT8,
+				},
+			}}},
+			out: []*models.Object{{
+				Class: dataTypesClass, ID: UUID4, Properties: nilMap,
+				Vectors: map[string]models.Vector{
+					"fp16":  []float32{0.5, -2},
+					"int8":  []float32{-3, 4},
+					"uint8": []float32{200, 1},
+				},
+			}},
+		},
+		{
+			name: "named vectors in another data type than stored",
+			req: []*pb.BatchObject{
+				{Collection: dataTypesClass, Uuid: UUID4, Vectors: []*pb.Vectors{{
+					Name:        "int8",
+					VectorBytes: []byte{0x00, 0x38},
+					Type:        pb.Vectors_VECTOR_TYPE_SINGLE_FP16,
+				}}},
+				{Collection: collection, Uuid: UUID4, Vectors: []*pb.Vectors{{
+					Name:        "custom",
+					VectorBytes: []byte{0xfd, 0x04},
+					Type:        pb.Vectors_VECTOR_TYPE_SINGLE_INT8,
+				}}},
+				{Collection: dataTypesClass, Uuid: UUID4, Vectors: []*pb.Vectors{{
+					Name:        "fp16",
+					VectorBytes: []byte{0x00, 0x38, 0x00},
+					Type:        pb.Vectors_VECTOR_TYPE_SINGLE_FP16,
+				}}},
+			},
+			out:      []*models.Object{},
+			outError: []int{0, 1, 2},
+		},
+		{
+			name: "sparse named vector",
+			req: []*pb.BatchObject{{Collection: collection, Uuid: UUID4, Vectors: []*pb.Vectors{
+				{
+					Name: "sparse",
+					VectorBytes: sparse.Encode(&models.SparseVector{
+						Indices: []uint32{3, 70000},
+						Values:  []float32{0.5, 1.25},
+					}),
+					Type: pb.Vectors_VECTOR_TYPE_SPARSE_FP32,
+				},
+			}}},
+			out: []*models.Object{{
+				Class: collection, ID: UUID4, Properties: nilMap,
+				Vectors: map[string]models.Vector{
+					"sparse": &models.SparseVector{Indices: []uint32{3, 70000}, Values: []float32{0.5, 1.25}},
+				},
+			}},
+		},
 	}
 	getClass := func(class, shard string) (*models.Class, error) {
 		return scheme.GetClass(class), nil
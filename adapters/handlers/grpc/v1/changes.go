@@ -0,0 +1,156 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	restCtx "github.com/weaviate/weaviate/adapters/handlers/rest/context"
+	"github.com/weaviate/weaviate/adapters/repos/db/changestream"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+type changeStreamer interface {
+	StreamChanges(ctx context.Context, className, tenant string, checkpoints map[string]uint64,
+		fn func(shard string, event changestream.Event) error) error
+}
+
+// ChangesStream streams all writes to a collection (or a single tenant of it)
+// that are applied to the shards held by the node serving the request. Every
+// message carries the shard and a per-shard sequence, which clients pass back
+// as checkpoints to resume a stream without missing or repeating changes.
+//
+// The stream only ends when the client hangs up, the node shuts down or the
+// requested checkpoint is no longer retained, in which case the client needs to
+// re-synchronize the collection before following the changes again.
+func (s *Service) ChangesStream(req *pb.ChangesStreamRequest, stream pb.Weaviate_ChangesStreamServer) error {
+	ctx := stream.Context()
+
+	principal, err := s.authenticator.PrincipalFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	if req.Collection == "" {
+		return status.Error(codes.InvalidArgument, "missing collection")
+	}
+	if class := s.schemaManager.ResolveAlias(req.Collection); class != "" {
+		req.Collection = class
+	}
+
+	tenant := req.GetTenant()
+	if err := s.authorizer.Authorize(ctx, principal, authorization.READ, authorization.ShardsData(req.Collection, tenant)...); err != nil {
+		return err
+	}
+
+	class := s.schemaManager.ReadOnlyClass(req.Collection)
+	if class == nil {
+		return status.Errorf(codes.NotFound, "could not find class %s in schema", req.Collection)
+	}
+
+	replier := newChangesReplier(class, tenant, req.IncludeVectors)
+
+	var sendLock sync.Mutex
+	err = s.changeStreamer.StreamChanges(ctx, req.Collection, tenant, req.Checkpoints,
+		func(shard string, event changestream.Event) error {
+			reply, err := replier.reply(shard, event)
+			if err != nil {
+				return fmt.Errorf("prepare change %d of shard %s: %w", event.Sequence, shard, err)
+			}
+
+			sendLock.Lock()
+			defer sendLock.Unlock()
+			return stream.Send(reply)
+		})
+	if err != nil {
+		if errors.Is(err, changestream.ErrCheckpointExpired) || errors.Is(err, changestream.ErrCheckpointInFuture) {
+			return status.Error(codes.OutOfRange, err.Error())
+		}
+		return fmt.Errorf("stream changes: %w", err)
+	}
+
+	return nil
+}
+
+type changesReplier struct {
+	class          *models.Class
+	tenant         string
+	includeVectors bool
+	mapper         *Mapper
+}
+
+func newChangesReplier(class *models.Class, tenant string, includeVectors bool) *changesReplier {
+	return &changesReplier{
+		class:          class,
+		tenant:         tenant,
+		includeVectors: includeVectors,
+		mapper:         NewMapping(),
+	}
+}
+
+func (r *changesReplier) reply(shard string, event changestream.Event) (*pb.ChangesStreamReply, error) {
+	reply := &pb.ChangesStreamReply{
+		Collection:     r.class.Class,
+		Shard:          shard,
+		Sequence:       event.Sequence,
+		Operation:      changeOperationToProto(event.Operation),
+		Uuid:           event.ID.String(),
+		UpdateTimeUnix: event.UpdateTime,
+	}
+	if r.tenant != "" {
+		reply.Tenant = &r.tenant
+	}
+
+	if event.Object == nil {
+		return reply, nil
+	}
+
+	properties, err := objectPropertiesToProto(r.mapper, r.class, event.Object.Properties(),
+		func(propName string, beacons []string) {
+			reply.References = append(reply.References, &pb.ChangesStreamReply_References{
+				PropName: propName,
+				Beacons:  beacons,
+			})
+		})
+	if err != nil {
+		return nil, err
+	}
+	reply.Properties = properties
+
+	if r.includeVectors {
+		reply.Vectors = objectVectorsToProto(event.Object.Vector, event.Object.GetVectors(), vectorDataTypes(r.class))
+	}
+
+	return reply, nil
+}
+
+func changeOperationToProto(op changestream.Operation) pb.ChangesStreamReply_Operation {
+	switch op {
+	case changestream.OperationInsert:
+		return pb.ChangesStreamReply_OPERATION_INSERT
+	case changestream.OperationUpdate:
+		return pb.ChangesStreamReply_OPERATION_UPDATE
+	case changestream.OperationDelete:
+		return pb.ChangesStreamReply_OPERATION_DELETE
+	default:
+		return pb.ChangesStreamReply_OPERATION_UNSPECIFIED
+	}
+}
@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/changestream"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/byteops"
+)
+
+func TestChangesReplier(t *testing.T) {
+	class := &models.Class{
+		Class: "Article",
+		Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString()},
+			{Name: "wordCount", DataType: schema.DataTypeInt.PropString()},
+			{Name: "author", DataType: []string{"Author"}},
+			{
+				Name:     "meta",
+				DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "source", DataType: schema.DataTypeText.PropString()},
+				},
+			},
+		},
+	}
+	id := strfmt.UUID("8d5a3aa2-3c8d-4589-9ae1-3f638f506003")
+
+	obj := storobj.FromObject(&models.Object{
+		ID:    id,
+		Class: "Article",
+		Properties: map[string]interface{}{
+			"title":     "hello",
+			"wordCount": float64(3),
+			"author": models.MultipleRef{
+				{Beacon: "weaviate://localhost/Author/c6a8b4f1-1e0e-4b36-a9a4-7e2f9f0ab3d1"},
+			},
+			"meta": map[string]interface{}{"source": "web"},
+		},
+	}, nil, map[string][]float32{"title": {1, 2}}, nil)
+
+	t.Run("update with vectors", func(t *testing.T) {
+		replier := newChangesReplier(class, "", true)
+		reply, err := replier.reply("shard1", changestream.Event{
+			Sequence:   7,
+			Operation:  changestream.OperationUpdate,
+			ID:         id,
+			UpdateTime: 1000,
+			Object:     obj,
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, "Article", reply.Collection)
+		assert.Equal(t, "shard1", reply.Shard)
+		assert.Nil(t, reply.Tenant)
+		assert.Equal(t, uint64(7), reply.Sequence)
+		assert.Equal(t, pb.ChangesStreamReply_OPERATION_UPDATE, reply.Operation)
+		assert.Equal(t, id.String(), reply.Uuid)
+		assert.Equal(t, int64(1000), reply.UpdateTimeUnix)
+
+		assert.Equal(t, "hello", reply.Properties.Fields["title"].GetTextValue())
+		assert.Equal(t, int64(3), reply.Properties.Fields["wordCount"].GetIntValue())
+		assert.Equal(t, "web", reply.Properties.Fields["meta"].GetObjectValue().Fields["source"].GetTextValue())
+		assert.NotContains(t, reply.Properties.Fields, "author")
+
+		require.Len(t, reply.References, 1)
+		assert.Equal(t, "author", reply.References[0].PropName)
+		assert.Equal(t, []string{"weaviate://localhost/Author/c6a8b4f1-1e0e-4b36-a9a4-7e2f9f0ab3d1"}, reply.References[0].Beacons)
+
+		require.Len(t, reply.Vectors, 1)
+		assert.Equal(t, "title", reply.Vectors[0].Name)
+		assert.Equal(t, byteops.Fp32SliceToBytes([]float32{1, 2}), reply.Vectors[0].VectorBytes)
+	})
+
+	t.Run("deletion of a tenant object", func(t *testing.T) {
+		replier := newChangesReplier(class, "tenant1", true)
+		reply, err := replier.reply("tenant1", changestream.Event{
+			Sequence:  8,
+			Operation: changestream.OperationDelete,
+			ID:        id,
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, pb.ChangesStreamReply_OPERATION_DELETE, reply.Operation)
+		assert.Equal(t, "tenant1", reply.GetTenant())
+		assert.Nil(t, reply.Properties)
+		assert.Empty(t, reply.Vectors)
+	})
+}
@@ -0,0 +1,171 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+
+	"github.com/weaviate/weaviate/adapters/handlers/grpc/v1/auth"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func newTestService(schemaManager schemaHandler) *Service {
+	logger, _ := test.NewNullLogger()
+	return &Service{
+		schemaManager: schemaManager,
+		authenticator: auth.NewHandler(true, nil),
+		logger:        logger,
+	}
+}
+
+func newTestObjectsService(schemaManager schemaHandler, objectsManager objectsHandler) *Service {
+	s := newTestService(schemaManager)
+	s.objectsManager = objectsManager
+	return s
+}
+
+// fakeSchemaHandler returns err from all changes of the schema. Methods which
+// are not implemented panic.
+type fakeSchemaHandler struct {
+	schemaHandler
+	classes map[string]*models.Class
+	err     error
+
+	deletedClasses []string
+	addedProps     []*models.Property
+	addedTenants   []*models.Tenant
+	deletedTenants []string
+}
+
+func (f *fakeSchemaHandler) ReadOnlyClass(name string) *models.Class {
+	return f.classes[name]
+}
+
+func (f *fakeSchemaHandler) ResolveAlias(alias string) string {
+	return ""
+}
+
+func (f *fakeSchemaHandler) GetConsistentClass(ctx context.Context, principal *models.Principal,
+	name string, consistency bool,
+) (*models.Class, uint64, error) {
+	return f.classes[name], 0, f.err
+}
+
+func (f *fakeSchemaHandler) AddClass(ctx context.Context, principal *models.Principal,
+	cls *models.Class,
+) (*models.Class, uint64, error) {
+	if f.err != nil {
+		return nil, 0, f.err
+	}
+	return cls, 1, nil
+}
+
+// UpdateClass stores the updated class with the vectorizer defaulted, so
+// that it differs from the request like a class stored by the schema
+func (f *fakeSchemaHandler) UpdateClass(ctx context.Context, principal *models.Principal,
+	className string, updated *models.Class,
+) error {
+	if f.err != nil {
+		return f.err
+	}
+	stored := *updated
+	if stored.Vectorizer == "" {
+		stored.Vectorizer = "none"
+	}
+	f.classes[className] = &stored
+	return nil
+}
+
+func (f *fakeSchemaHandler) DeleteClass(ctx context.Context, principal *models.Principal, class string) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.deletedClasses = append(f.deletedClasses, class)
+	return nil
+}
+
+func (f *fakeSchemaHandler) AddClassProperty(ctx context.Context, principal *models.Principal,
+	class *models.Class, className string, merge bool, newProps ...*models.Property,
+) (*models.Class, uint64, error) {
+	if f.err != nil {
+		return nil, 0, f.err
+	}
+	f.addedProps = append(f.addedProps, newProps...)
+	return class, 1, nil
+}
+
+func (f *fakeSchemaHandler) DeleteClassProperty(ctx context.Context, principal *models.Principal,
+	className string, propName string,
+) error {
+	return f.err
+}
+
+func (f *fakeSchemaHandler) AddTenants(ctx context.Context, principal *models.Principal,
+	class string, tenants []*models.Tenant,
+) (uint64, error) {
+	if f.err != nil {
+		return 0, f.err
+	}
+	f.addedTenants = append(f.addedTenants, tenants...)
+	return 1, nil
+}
+
+func (f *fakeSchemaHandler) DeleteTenants(ctx context.Context, principal *models.Principal,
+	class string, tenants []string,
+) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.deletedTenants = append(f.deletedTenants, tenants...)
+	return nil
+}
+
+// fakeObjectsHandler serves the objects by id and returns err from all
+// calls if set. Methods which are not implemented panic.
+type fakeObjectsHandler struct {
+	objectsHandler
+	objects map[strfmt.UUID]*models.Object
+	err     *objects.Error
+
+	tenant string
+}
+
+func (f *fakeObjectsHandler) GetObject(ctx context.Context, principal *models.Principal,
+	class string, id strfmt.UUID, additional additional.Properties,
+	replProps *additional.ReplicationProperties, tenant string,
+) (*models.Object, error) {
+	f.tenant = tenant
+	if f.err != nil {
+		return nil, f.err
+	}
+	obj, ok := f.objects[id]
+	if !ok {
+		return nil, objects.NewErrNotFound("no object with id '%s'", id)
+	}
+	return obj, nil
+}
+
+func (f *fakeObjectsHandler) HeadObject(ctx context.Context, principal *models.Principal,
+	className string, id strfmt.UUID, repl *additional.ReplicationProperties, tenant string,
+) (bool, *objects.Error) {
+	f.tenant = tenant
+	if f.err != nil {
+		return false, f.err
+	}
+	_, ok := f.objects[id]
+	return ok, nil
+}
@@ -78,6 +78,10 @@ func ExtractFilters(filterIn *pb.Filters, authorizedGetClass classGetterWithAuth
 			returnFilter.Operator = filters.ContainsAll
 		case pb.Filters_OPERATOR_CONTAINS_NONE:
 			returnFilter.Operator = filters.ContainsNone
+		case pb.Filters_OPERATOR_REGEX:
+			returnFilter.Operator = filters.OperatorRegex
+		case pb.Filters_OPERATOR_STARTS_WITH:
+			returnFilter.Operator = filters.OperatorStartsWith
 		default:
 			return filters.Clause{}, fmt.Errorf("unknown filter operator %v", filterIn.Operator)
 		}
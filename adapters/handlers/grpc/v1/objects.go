@@ -0,0 +1,420 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/weaviate/weaviate/adapters/handlers/grpc/v1/batch"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modelsext"
+	"github.com/weaviate/weaviate/entities/schema"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/byteops"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func (s *Service) ObjectsGet(ctx context.Context, req *pb.ObjectsGetRequest) (*pb.ObjectsGetReply, error) {
+	before := time.Now()
+
+	ctx, principal, err := s.principalContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := objectID(req.Collection, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := s.objectsManager.GetObject(ctx, principal, req.Collection, id,
+		additional.Properties{Vector: req.IncludeVectors},
+		extractReplicationProperties(req.ConsistencyLevel), req.GetTenant())
+	if err != nil {
+		return nil, objectError("get object", err)
+	}
+
+	result, err := s.objectToGRPC(obj, req.IncludeVectors, req.VectorNames)
+	if err != nil {
+		return nil, fmt.Errorf("prepare reply: %w", err)
+	}
+
+	return &pb.ObjectsGetReply{
+		Took:   float32(time.Since(before).Seconds()),
+		Object: result,
+	}, nil
+}
+
+func (s *Service) ObjectsPut(ctx context.Context, req *pb.ObjectsPutRequest) (*pb.ObjectsPutReply, error) {
+	before := time.Now()
+
+	ctx, principal, err := s.principalContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := s.objectFromGRPC(req.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.objectsManager.UpdateObject(ctx, principal, obj.Class, obj.ID, obj,
+		extractReplicationProperties(req.ConsistencyLevel))
+	if err != nil {
+		return nil, objectError("replace object", err)
+	}
+
+	result, err := s.objectToGRPC(updated, true, nil)
+	if err != nil {
+		return nil, fmt.Errorf("prepare reply: %w", err)
+	}
+
+	return &pb.ObjectsPutReply{
+		Took:   float32(time.Since(before).Seconds()),
+		Object: result,
+	}, nil
+}
+
+func (s *Service) ObjectsPatch(ctx context.Context, req *pb.ObjectsPatchRequest) (*pb.ObjectsPatchReply, error) {
+	before := time.Now()
+
+	ctx, principal, err := s.principalContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := s.objectFromGRPC(req.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.objectsManager.MergeObject(ctx, principal, obj,
+		extractReplicationProperties(req.ConsistencyLevel)); err != nil {
+		return nil, objectError("patch object", err)
+	}
+
+	return &pb.ObjectsPatchReply{
+		Took: float32(time.Since(before).Seconds()),
+	}, nil
+}
+
+func (s *Service) ObjectsDelete(ctx context.Context, req *pb.ObjectsDeleteRequest) (*pb.ObjectsDeleteReply, error) {
+	before := time.Now()
+
+	ctx, principal, err := s.principalContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := objectID(req.Collection, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.objectsManager.DeleteObject(ctx, principal, req.Collection, id,
+		extractReplicationProperties(req.ConsistencyLevel), req.GetTenant()); err != nil {
+		return nil, objectError("delete object", err)
+	}
+
+	return &pb.ObjectsDeleteReply{
+		Took: float32(time.Since(before).Seconds()),
+	}, nil
+}
+
+func (s *Service) ObjectsExists(ctx context.Context, req *pb.ObjectsExistsRequest) (*pb.ObjectsExistsReply, error) {
+	before := time.Now()
+
+	ctx, principal, err := s.principalContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := objectID(req.Collection, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, objErr := s.objectsManager.HeadObject(ctx, principal, req.Collection, id,
+		extractReplicationProperties(req.ConsistencyLevel), req.GetTenant())
+	if objErr != nil {
+		return nil, objectError("check object", objErr)
+	}
+
+	return &pb.ObjectsExistsReply{
+		Took:   float32(time.Since(before).Seconds()),
+		Exists: exists,
+	}, nil
+}
+
+func objectID(collection, id string) (strfmt.UUID, error) {
+	if collection == "" {
+		return "", status.Error(codes.InvalidArgument, "missing collection")
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "invalid uuid %q: %v", id, err)
+	}
+	return strfmt.UUID(id), nil
+}
+
+// objectFromGRPC parses an object of a write request the same way as the
+// objects of a batch.
+func (s *Service) objectFromGRPC(in *pb.BatchObject) (*models.Object, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing object")
+	}
+	if in.Collection == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing collection")
+	}
+
+	// authorization is left to the objects manager. The class is only needed
+	// to resolve references and may be missing if auto schema is enabled.
+	getClass := func(collection, _ string) (*models.Class, error) {
+		if class := s.schemaManager.ResolveAlias(collection); class != "" {
+			collection = class
+		}
+		return s.schemaManager.ReadOnlyClass(collection), nil
+	}
+
+	objs, _, errs := batch.BatchObjectsFromProto(&pb.BatchObjectsRequest{
+		Objects: []*pb.BatchObject{in},
+	}, getClass)
+	if err := errs[0]; err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid object: %v", err)
+	}
+	return objs[0], nil
+}
+
+func (s *Service) objectToGRPC(obj *models.Object, includeVectors bool, vectorNames []string) (*pb.ObjectsResult, error) {
+	class := s.schemaManager.ReadOnlyClass(obj.Class)
+	if class == nil {
+		return nil, fmt.Errorf("could not find class %s in schema", obj.Class)
+	}
+
+	result := &pb.ObjectsResult{
+		Collection:         obj.Class,
+		Uuid:               obj.ID.String(),
+		CreationTimeUnix:   obj.CreationTimeUnix,
+		LastUpdateTimeUnix: obj.LastUpdateTimeUnix,
+	}
+	if obj.Tenant != "" {
+		result.Tenant = &obj.Tenant
+	}
+
+	properties, err := objectPropertiesToProto(NewMapping(), class, obj.Properties,
+		func(propName string, beacons []string) {
+			result.References = append(result.References, &pb.ObjectsResult_References{
+				PropName: propName,
+				Beacons:  beacons,
+			})
+		})
+	if err != nil {
+		return nil, err
+	}
+	result.Properties = properties
+
+	if includeVectors {
+		result.Vectors = objectVectorsToProto(obj.Vector, obj.Vectors, vectorDataTypes(class))
+		if len(vectorNames) > 0 {
+			result.Vectors = slices.DeleteFunc(result.Vectors, func(vec *pb.Vectors) bool {
+				return !slices.Contains(vectorNames, vec.Name)
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// objectError maps the errors of the objects manager to the status codes
+// matching the REST API. Authorization errors are passed on to the
+// interceptors.
+func objectError(action string, err error) error {
+	var objErr *objects.Error
+	if errors.As(err, &objErr) {
+		switch {
+		case objErr.NotFound():
+			return status.Error(codes.NotFound, err.Error())
+		case objErr.BadRequest(), objErr.UnprocessableEntity():
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	switch {
+	case errors.As(err, &objects.ErrNotFound{}):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.As(err, &objects.ErrInvalidUserInput{}), errors.As(err, &objects.ErrMultiTenancy{}):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return fmt.Errorf("%s: %w", action, err)
+	}
+}
+
+// objectPropertiesToProto converts the properties of an object to their typed
+// values. Reference properties are not part of the returned properties, their
+// beacons are passed to addReferences instead.
+func objectPropertiesToProto(mapper *Mapper, class *models.Class, raw models.PropertySchema,
+	addReferences func(propName string, beacons []string),
+) (*pb.Properties, error) {
+	values, ok := raw.(map[string]interface{})
+	if !ok || len(values) == 0 {
+		return nil, nil
+	}
+
+	properties := &pb.Properties{Fields: make(map[string]*pb.Value, len(values))}
+	for _, prop := range class.Properties {
+		value, ok := values[prop.Name]
+		if !ok {
+			continue
+		}
+
+		dt, err := schema.GetPropertyDataType(class, prop.Name)
+		if err != nil {
+			return nil, fmt.Errorf("get data type of property %s: %w", prop.Name, err)
+		}
+
+		switch *dt {
+		case schema.DataTypeCRef:
+			refs, ok := value.(models.MultipleRef)
+			if !ok {
+				continue
+			}
+			beacons := make([]string, 0, len(refs))
+			for _, ref := range refs {
+				beacons = append(beacons, ref.Beacon.String())
+			}
+			addReferences(prop.Name, beacons)
+		case schema.DataTypeObject, schema.DataTypeObjectArray:
+			nestedProps, err := getAllNonRefNonBlobNestedProperties(&Property{Property: prop})
+			if err != nil {
+				return nil, fmt.Errorf("get nested properties of %s: %w", prop.Name, err)
+			}
+			properties.Fields[prop.Name], err = mapper.NewNestedValue(value, *dt, &Property{Property: prop},
+				search.SelectProperty{Name: prop.Name, IsObject: true, Props: nestedProps})
+			if err != nil {
+				return nil, fmt.Errorf("create object value for %s: %w", prop.Name, err)
+			}
+		default:
+			properties.Fields[prop.Name], err = mapper.NewPrimitiveValue(value, *dt)
+			if err != nil {
+				return nil, fmt.Errorf("create primitive value for %s: %w", prop.Name, err)
+			}
+		}
+	}
+
+	return properties, nil
+}
+
+// objectVectorsToProto returns the legacy vector under the default vector name
+// followed by all named vectors, sorted by name. Named vectors are encoded in
+// their data type, see singleVectorToProto.
+func objectVectorsToProto(legacy []float32, named models.Vectors, dataTypes map[string]string) []*pb.Vectors {
+	vectors := make([]*pb.Vectors, 0, len(named)+1)
+	if len(legacy) != 0 {
+		vectors = append(vectors, &pb.Vectors{
+			Name:        modelsext.DefaultNamedVectorName,
+			VectorBytes: byteops.Fp32SliceToBytes(legacy),
+			Type:        pb.Vectors_VECTOR_TYPE_SINGLE_FP32,
+		})
+	}
+
+	names := make([]string, 0, len(named))
+	for name := range named {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		switch vec := named[name].(type) {
+		case []float32:
+			if len(vec) != 0 {
+				vectors = append(vectors, singleVectorToProto(name, vec, dataTypes[name]))
+			}
+		case [][]float32:
+			if len(vec) != 0 {
+				vectors = append(vectors, &pb.Vectors{
+					Name:        name,
+					VectorBytes: byteops.Fp32SliceOfSlicesToBytes(vec),
+					Type:        pb.Vectors_VECTOR_TYPE_MULTI_FP32,
+				})
+			}
+		case *models.SparseVector:
+			if vec != nil && len(vec.Indices) != 0 {
+				vectors = append(vectors, &pb.Vectors{
+					Name:        name,
+					VectorBytes: sparse.Encode(vec),
+					Type:        pb.Vectors_VECTOR_TYPE_SPARSE_FP32,
+				})
+			}
+		default:
+			// do nothing
+		}
+	}
+
+	return vectors
+}
+
+// singleVectorToProto encodes the vector in the data type it is stored in, so
+// that clients receive it without a conversion to float32. Vectors which can
+// not be represented in the data type are returned as float32.
+func singleVectorToProto(name string, vector []float32, dataType string) *pb.Vectors {
+	var vectorType pb.Vectors_VectorType
+	switch dataType {
+	case vectorIndexCommon.DataTypeFloat16:
+		vectorType = pb.Vectors_VECTOR_TYPE_SINGLE_FP16
+	case vectorIndexCommon.DataTypeInt8:
+		vectorType = pb.Vectors_VECTOR_TYPE_SINGLE_INT8
+	case vectorIndexCommon.DataTypeUint8:
+		vectorType = pb.Vectors_VECTOR_TYPE_SINGLE_UINT8
+	}
+	if vectorType != pb.Vectors_VECTOR_TYPE_UNSPECIFIED {
+		if encoded, err := vectorIndexCommon.EncodeVector(dataType, vector); err == nil {
+			return &pb.Vectors{Name: name, VectorBytes: encoded, Type: vectorType}
+		}
+	}
+	return &pb.Vectors{
+		Name:        name,
+		VectorBytes: byteops.Fp32SliceToBytes(vector),
+		Type:        pb.Vectors_VECTOR_TYPE_SINGLE_FP32,
+	}
+}
+
+// vectorDataTypes returns the data types of the named vectors of a class which
+// are not stored as float32
+func vectorDataTypes(class *models.Class) map[string]string {
+	if class == nil {
+		return nil
+	}
+	var dataTypes map[string]string
+	for name, vectorConfig := range class.VectorConfig {
+		vc, ok := vectorConfig.VectorIndexConfig.(schemaConfig.VectorIndexConfig)
+		if !ok {
+			continue
+		}
+		if dataType := vectorindex.DataType(vc); dataType != vectorIndexCommon.DataTypeFloat32 {
+			if dataTypes == nil {
+				dataTypes = map[string]string{}
+			}
+			dataTypes[name] = dataType
+		}
+	}
+	return dataTypes
+}
@@ -0,0 +1,294 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	authzerrors "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestObjectID(t *testing.T) {
+	id, err := objectID("Article", "8d5a3aa2-3c8d-4589-9ae1-3f638f506003")
+	require.Nil(t, err)
+	assert.Equal(t, "8d5a3aa2-3c8d-4589-9ae1-3f638f506003", id.String())
+
+	_, err = objectID("", "8d5a3aa2-3c8d-4589-9ae1-3f638f506003")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = objectID("Article", "not-a-uuid")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestObjectError(t *testing.T) {
+	forbidden := authzerrors.NewForbidden(&models.Principal{Username: "john"}, "read", "objects")
+
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{
+			name: "not found",
+			err:  objects.NewErrNotFound("no object with id %s", "123"),
+			code: codes.NotFound,
+		},
+		{
+			name: "not found from merge",
+			err:  &objects.Error{Msg: "not found", Code: objects.StatusNotFound},
+			code: codes.NotFound,
+		},
+		{
+			name: "invalid input",
+			err:  objects.NewErrInvalidUserInput("invalid object"),
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "multi tenancy",
+			err:  objects.NewErrMultiTenancy(errors.New("missing tenant")),
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "bad request from merge",
+			err:  &objects.Error{Msg: "bad request", Code: objects.StatusBadRequest, Err: errors.New("invalid")},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "internal",
+			err:  errors.New("something went wrong"),
+			code: codes.Unknown,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.code, status.Code(objectError("get object", tt.err)))
+		})
+	}
+
+	t.Run("forbidden is passed on", func(t *testing.T) {
+		err := objectError("get object", &objects.Error{Msg: "forbidden", Code: objects.StatusForbidden, Err: forbidden})
+		assert.True(t, errors.As(err, &authzerrors.Forbidden{}))
+	})
+}
+
+func TestObjectPropertiesToProto(t *testing.T) {
+	class := &models.Class{
+		Class: "Article",
+		Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString()},
+			{Name: "author", DataType: []string{"Author"}},
+		},
+	}
+
+	var refs []string
+	properties, err := objectPropertiesToProto(NewMapping(), class, map[string]interface{}{
+		"title": "hello",
+		"author": models.MultipleRef{
+			{Beacon: "weaviate://localhost/Author/c6a8b4f1-1e0e-4b36-a9a4-7e2f9f0ab3d1"},
+		},
+		"unknown": "ignored",
+	}, func(propName string, beacons []string) {
+		assert.Equal(t, "author", propName)
+		refs = append(refs, beacons...)
+	})
+	require.Nil(t, err)
+
+	require.Len(t, properties.Fields, 1)
+	assert.Equal(t, "hello", properties.Fields["title"].GetTextValue())
+	assert.Equal(t, []string{"weaviate://localhost/Author/c6a8b4f1-1e0e-4b36-a9a4-7e2f9f0ab3d1"}, refs)
+
+	properties, err = objectPropertiesToProto(NewMapping(), class, nil, nil)
+	require.Nil(t, err)
+	assert.Nil(t, properties)
+}
+
+func TestObjectVectorsToProto(t *testing.T) {
+	vectors := objectVectorsToProto([]float32{1, 2}, models.Vectors{
+		"b": []float32{3},
+		"a": [][]float32{{4}, {5}},
+		"c": []float32{},
+	}, nil)
+
+	require.Len(t, vectors, 3)
+	assert.Equal(t, "default", vectors[0].Name)
+	assert.Equal(t, "a", vectors[1].Name)
+	assert.Equal(t, pb.Vectors_VECTOR_TYPE_MULTI_FP32, vectors[1].Type)
+	assert.Equal(t, "b", vectors[2].Name)
+	assert.Equal(t, pb.Vectors_VECTOR_TYPE_SINGLE_FP32, vectors[2].Type)
+}
+
+func TestObjectVectorsToProtoDataTypes(t *testing.T) {
+	vectors := objectVectorsToProto(nil, models.Vectors{
+		"fp16":        []float32{0.5, -2},
+		"int8":        []float32{-3, 4},
+		"uint8":       []float32{200, 1},
+		"notEncoded":  []float32{0.5, 1},
+		"unspecified": []float32{0.5, 1},
+	}, map[string]string{
+		"fp16":       "float16",
+		"int8":       "int8",
+		"uint8":      "uint8",
+		"notEncoded": "uint8",
+	})
+
+	require.Len(t, vectors, 5)
+	byName := map[string]*pb.Vectors{}
+	for _, vec := range vectors {
+		byName[vec.Name] = vec
+	}
+	assert.Equal(t, pb.Vectors_VECTOR_TYPE_SINGLE_FP16, byName["fp16"].Type)
+	assert.Equal(t, []byte{0x00, 0x38, 0x00, 0xc0}, byName["fp16"].VectorBytes)
+	assert.Equal(t, pb.Vectors_VECTOR_TYPE_SINGLE_INT8, byName["int8"].Type)
+	assert.Equal(t, []byte{0xfd, 0x04}, byName["int8"].VectorBytes)
+	assert.Equal(t, pb.Vectors_VECTOR_TYPE_SINGLE_UINT8, byName["uint8"].Type)
+	assert.Equal(t, []byte{200, 1}, byName["uint8"].VectorBytes)
+	assert.Equal(t, pb.Vectors_VECTOR_TYPE_SINGLE_FP32, byName["notEncoded"].Type)
+	assert.Equal(t, pb.Vectors_VECTOR_TYPE_SINGLE_FP32, byName["unspecified"].Type)
+
+	for name, vec := range byName {
+		parsed, err := extractVector(vec)
+		require.Nil(t, err)
+		assert.Equal(t, map[string][]float32{
+			"fp16":        {0.5, -2},
+			"int8":        {-3, 4},
+			"uint8":       {200, 1},
+			"notEncoded":  {0.5, 1},
+			"unspecified": {0.5, 1},
+		}[name], parsed)
+	}
+}
+
+func TestObjectsGetAndExistsRPCs(t *testing.T) {
+	ctx := context.Background()
+	id := strfmt.UUID("8d5a3aa2-3c8d-4589-9ae1-3f638f506003")
+	missing := "c6a8b4f1-1e0e-4b36-a9a4-7e2f9f0ab3d1"
+	article := &models.Class{
+		Class:      "Article",
+		Properties: []*models.Property{{Name: "title", DataType: schema.DataTypeText.PropString()}},
+	}
+	forbidden := &objects.Error{
+		Msg:  "forbidden",
+		Code: objects.StatusForbidden,
+		Err:  authzerrors.NewForbidden(&models.Principal{Username: "john"}, "read", "objects"),
+	}
+
+	newService := func() (*Service, *fakeObjectsHandler) {
+		fake := &fakeObjectsHandler{objects: map[strfmt.UUID]*models.Object{
+			id: {
+				Class:      "Article",
+				ID:         id,
+				Properties: map[string]interface{}{"title": "hello"},
+				Vector:     []float32{1, 2},
+			},
+		}}
+		return newTestObjectsService(&fakeSchemaHandler{classes: map[string]*models.Class{"Article": article}}, fake), fake
+	}
+
+	t.Run("get", func(t *testing.T) {
+		s, fake := newService()
+		tenant := "tenant1"
+
+		reply, err := s.ObjectsGet(ctx, &pb.ObjectsGetRequest{
+			Collection:     "Article",
+			Uuid:           id.String(),
+			Tenant:         &tenant,
+			IncludeVectors: true,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, "tenant1", fake.tenant)
+		assert.Equal(t, "Article", reply.Object.Collection)
+		assert.Equal(t, id.String(), reply.Object.Uuid)
+		assert.Equal(t, "hello", reply.Object.Properties.Fields["title"].GetTextValue())
+		require.Len(t, reply.Object.Vectors, 1)
+
+		reply, err = s.ObjectsGet(ctx, &pb.ObjectsGetRequest{Collection: "Article", Uuid: id.String()})
+		require.Nil(t, err)
+		assert.Empty(t, reply.Object.Vectors)
+	})
+
+	t.Run("get not found", func(t *testing.T) {
+		s, _ := newService()
+
+		_, err := s.ObjectsGet(ctx, &pb.ObjectsGetRequest{Collection: "Article", Uuid: missing})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("get invalid request", func(t *testing.T) {
+		s, _ := newService()
+
+		_, err := s.ObjectsGet(ctx, &pb.ObjectsGetRequest{Collection: "Article", Uuid: "not-a-uuid"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = s.ObjectsGet(ctx, &pb.ObjectsGetRequest{Uuid: id.String()})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("get forbidden", func(t *testing.T) {
+		s, fake := newService()
+		fake.err = forbidden
+
+		// mapped to PermissionDenied by the interceptors
+		_, err := s.ObjectsGet(ctx, &pb.ObjectsGetRequest{Collection: "Article", Uuid: id.String()})
+		require.NotNil(t, err)
+		assert.True(t, errors.As(err, &authzerrors.Forbidden{}))
+	})
+
+	t.Run("exists", func(t *testing.T) {
+		s, fake := newService()
+		tenant := "tenant1"
+
+		reply, err := s.ObjectsExists(ctx, &pb.ObjectsExistsRequest{Collection: "Article", Uuid: id.String(), Tenant: &tenant})
+		require.Nil(t, err)
+		assert.True(t, reply.Exists)
+		assert.Equal(t, "tenant1", fake.tenant)
+
+		reply, err = s.ObjectsExists(ctx, &pb.ObjectsExistsRequest{Collection: "Article", Uuid: missing})
+		require.Nil(t, err)
+		assert.False(t, reply.Exists)
+	})
+
+	t.Run("exists in unknown collection", func(t *testing.T) {
+		s, fake := newService()
+		fake.err = &objects.Error{Msg: "class not found", Code: objects.StatusNotFound}
+
+		_, err := s.ObjectsExists(ctx, &pb.ObjectsExistsRequest{Collection: "Unknown", Uuid: id.String()})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("exists invalid request", func(t *testing.T) {
+		s, _ := newService()
+
+		_, err := s.ObjectsExists(ctx, &pb.ObjectsExistsRequest{Collection: "Article", Uuid: "not-a-uuid"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("exists forbidden", func(t *testing.T) {
+		s, fake := newService()
+		fake.err = forbidden
+
+		_, err := s.ObjectsExists(ctx, &pb.ObjectsExistsRequest{Collection: "Article", Uuid: id.String()})
+		require.NotNil(t, err)
+		assert.True(t, errors.As(err, &authzerrors.Forbidden{}))
+	})
+}
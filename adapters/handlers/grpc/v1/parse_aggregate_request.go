@@ -46,6 +46,13 @@ func (p *AggregateParser) Aggregate(req *pb.AggregateRequest) (*aggregation.Para
 	params.ClassName = schema.ClassName(class.Class)
 	params.Tenant = req.Tenant
 
+	if req.Snapshot != nil {
+		if err := dto.ValidateReadSnapshotID(*req.Snapshot); err != nil {
+			return nil, fmt.Errorf("snapshot: %w", err)
+		}
+		params.Snapshot = *req.Snapshot
+	}
+
 	if req.ObjectLimit != nil {
 		objectLimit := int(*req.ObjectLimit)
 		params.ObjectLimit = &objectLimit
@@ -299,6 +306,11 @@ func (p *AggregateParser) Aggregate(req *pb.AggregateRequest) (*aggregation.Para
 				}
 				params.Hybrid.SearchOperator = hs.Bm25SearchOperator.Operator.String()
 			}
+			params.Hybrid.Fuzziness = extractFuzziness(hs.Bm25Fuzziness)
+			params.Hybrid.SparseVector, err = extractSparseVectorSearch(hs.SparseVector)
+			if err != nil {
+				return nil, err
+			}
 
 			if nearVec != nil {
 				params.Hybrid.NearVectorParams, _, err = parseNearVec(nearVec, targetVectors, class, nil)
@@ -366,6 +378,9 @@ func parseAggregations(in *pb.AggregateRequest_Aggregation) []aggregation.Aggreg
 		if a.Int.Sum {
 			aggregators = append(aggregators, aggregation.SumAggregator)
 		}
+		if h := a.Int.Histogram; h != nil {
+			aggregators = append(aggregators, aggregation.NewHistogramAggregator(h.Interval, parseHistogramMetrics(h.Metrics)))
+		}
 		return aggregators
 	case *pb.AggregateRequest_Aggregation_Number_:
 		var aggregators []aggregation.Aggregator
@@ -393,6 +408,9 @@ func parseAggregations(in *pb.AggregateRequest_Aggregation) []aggregation.Aggreg
 		if a.Number.Sum {
 			aggregators = append(aggregators, aggregation.SumAggregator)
 		}
+		if h := a.Number.Histogram; h != nil {
+			aggregators = append(aggregators, aggregation.NewHistogramAggregator(h.Interval, parseHistogramMetrics(h.Metrics)))
+		}
 		return aggregators
 	case *pb.AggregateRequest_Aggregation_Text_:
 		var aggregators []aggregation.Aggregator
@@ -452,6 +470,9 @@ func parseAggregations(in *pb.AggregateRequest_Aggregation) []aggregation.Aggreg
 		if a.Date.Minimum {
 			aggregators = append(aggregators, aggregation.MinimumAggregator)
 		}
+		if h := a.Date.DateHistogram; h != nil {
+			aggregators = append(aggregators, aggregation.NewDateHistogramAggregator(h.Interval, h.GetTimeZone(), parseHistogramMetrics(h.Metrics)))
+		}
 		return aggregators
 	case *pb.AggregateRequest_Aggregation_Reference_:
 		var aggregators []aggregation.Aggregator
@@ -541,3 +562,14 @@ func extractTargetVectorsForAggregate(req *pb.AggregateRequest, class *models.Cl
 
 	return targetVectors, combination, vectorSearch, nil
 }
+
+func parseHistogramMetrics(in []string) []schema.PropertyName {
+	if len(in) == 0 {
+		return nil
+	}
+	metrics := make([]schema.PropertyName, len(in))
+	for i := range in {
+		metrics[i] = schema.PropertyName(in[i])
+	}
+	return metrics
+}
@@ -130,6 +130,53 @@ func TestGRPCAggregateRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "histogram aggregations",
+			req: &pb.AggregateRequest{
+				Collection: mixedVectorsClass,
+				Aggregations: []*pb.AggregateRequest_Aggregation{
+					{
+						Property: "first",
+						Aggregation: &pb.AggregateRequest_Aggregation_Int{
+							Int: &pb.AggregateRequest_Aggregation_Integer{
+								Count:     true,
+								Histogram: &pb.AggregateRequest_Aggregation_Histogram{Interval: 10, Metrics: []string{"second"}},
+							},
+						},
+					},
+					{
+						Property: "second",
+						Aggregation: &pb.AggregateRequest_Aggregation_Date_{
+							Date: &pb.AggregateRequest_Aggregation_Date{
+								DateHistogram: &pb.AggregateRequest_Aggregation_DateHistogram{
+									Interval: aggregation.CalendarIntervalDay,
+									TimeZone: ptr("Europe/Berlin"),
+								},
+							},
+						},
+					},
+				},
+			},
+			out: &aggregation.Params{
+				ClassName: schema.ClassName(mixedVectorsClass),
+				Properties: []aggregation.ParamProperty{
+					{
+						Name: "first",
+						Aggregators: []aggregation.Aggregator{
+							aggregation.CountAggregator,
+							aggregation.NewHistogramAggregator(10, []schema.PropertyName{"second"}),
+						},
+					},
+					{
+						Name: "second",
+						Aggregators: []aggregation.Aggregator{
+							aggregation.NewDateHistogramAggregator(aggregation.CalendarIntervalDay, "Europe/Berlin", nil),
+						},
+					},
+				},
+			},
+			error: false,
+		},
 		{
 			name: "multiple target vectors",
 			req: &pb.AggregateRequest{
@@ -15,8 +15,12 @@ import (
 	"fmt"
 	"slices"
 
+	"github.com/weaviate/weaviate/adapters/handlers/grpc/v1/batch"
 	"github.com/weaviate/weaviate/entities/modelsext"
 	"github.com/weaviate/weaviate/entities/schema/configvalidation"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
 	"github.com/weaviate/weaviate/usecases/config"
 
 	"github.com/go-openapi/strfmt"
@@ -86,6 +90,20 @@ func (p *Parser) Search(req *pb.SearchRequest, config *config.Config) (dto.GetPa
 
 	out.Tenant = req.Tenant
 
+	if req.MultivectorAggregation != nil {
+		if err := hnswent.ValidateMultivectorAggregation(*req.MultivectorAggregation); err != nil {
+			return dto.GetParams{}, errors.Wrap(err, "multivector aggregation")
+		}
+		out.MultivectorAggregation = *req.MultivectorAggregation
+	}
+
+	if req.Snapshot != nil {
+		if err := dto.ValidateReadSnapshotID(*req.Snapshot); err != nil {
+			return dto.GetParams{}, errors.Wrap(err, "snapshot")
+		}
+		out.Snapshot = *req.Snapshot
+	}
+
 	targetVectors, targetCombination, vectorSearch, err := extractTargetVectors(req, class)
 	if err != nil {
 		return dto.GetParams{}, errors.Wrap(err, "extract target vectors")
@@ -117,6 +135,7 @@ func (p *Parser) Search(req *pb.SearchRequest, config *config.Config) (dto.GetPa
 			}
 			out.KeywordRanking.SearchOperator = bm25.SearchOperator.Operator.String()
 		}
+		out.KeywordRanking.Fuzziness = extractFuzziness(bm25.Fuzziness)
 	}
 
 	if nv := req.NearVector; nv != nil {
@@ -308,6 +327,11 @@ func (p *Parser) Search(req *pb.SearchRequest, config *config.Config) (dto.GetPa
 			}
 			out.HybridSearch.SearchOperator = hs.Bm25SearchOperator.Operator.String()
 		}
+		out.HybridSearch.Fuzziness = extractFuzziness(hs.Bm25Fuzziness)
+		out.HybridSearch.SparseVector, err = extractSparseVectorSearch(hs.SparseVector)
+		if err != nil {
+			return dto.GetParams{}, err
+		}
 
 		if nearVec != nil {
 			out.HybridSearch.NearVectorParams, out.TargetVectorCombination, err = parseNearVec(nearVec, targetVectors, class, out.TargetVectorCombination)
@@ -395,6 +419,10 @@ func (p *Parser) Search(req *pb.SearchRequest, config *config.Config) (dto.GetPa
 		out.GroupBy = groupBy
 	}
 
+	if len(req.Facets) > 0 {
+		out.AdditionalProperties.Facets = extractFacets(req.Facets)
+	}
+
 	if out.HybridSearch != nil && out.HybridSearch.NearTextParams != nil && out.HybridSearch.NearVectorParams != nil {
 		return dto.GetParams{}, errors.New("cannot combine nearText and nearVector in hybrid search")
 	}
@@ -410,6 +438,43 @@ func (p *Parser) Search(req *pb.SearchRequest, config *config.Config) (dto.GetPa
 	return out, nil
 }
 
+// SearchBatch parses the params shared by the queries of a batch search and
+// the vector of each query
+func (p *Parser) SearchBatch(req *pb.SearchBatchRequest, config *config.Config) (dto.GetParams, [][]float32, error) {
+	if len(req.Vectors) == 0 {
+		return dto.GetParams{}, nil, errors.New("at least one vector is required")
+	}
+
+	out, err := p.Search(&pb.SearchRequest{
+		Collection:       req.Collection,
+		Tenant:           req.Tenant,
+		ConsistencyLevel: req.ConsistencyLevel,
+		Properties:       req.Properties,
+		Metadata:         req.Metadata,
+		Limit:            req.Limit,
+		Offset:           req.Offset,
+		Filters:          req.Filters,
+		Uses_127Api:      true,
+	}, config)
+	if err != nil {
+		return dto.GetParams{}, nil, err
+	}
+
+	vectors := make([][]float32, len(req.Vectors))
+	for i := range req.Vectors {
+		vector, err := extractVector(req.Vectors[i])
+		if err != nil {
+			return dto.GetParams{}, nil, fmt.Errorf("vectors[%d]: %w", i, err)
+		}
+		single, ok := vector.([]float32)
+		if !ok || len(single) == 0 {
+			return dto.GetParams{}, nil, fmt.Errorf("vectors[%d]: a single vector is required", i)
+		}
+		vectors[i] = single
+	}
+	return out, vectors, nil
+}
+
 func extractGroupBy(groupIn *pb.GroupBy, out *dto.GetParams, class *models.Class) (*searchparams.GroupBy, error) {
 	if len(groupIn.Path) != 1 {
 		return nil, fmt.Errorf("groupby path can only have one entry, received %v", groupIn.Path)
@@ -451,6 +516,20 @@ func extractGroupBy(groupIn *pb.GroupBy, out *dto.GetParams, class *models.Class
 	return groupOut, nil
 }
 
+func extractFacets(facetsIn []*pb.Facet) []additional.Facet {
+	facets := make([]additional.Facet, len(facetsIn))
+	for i, facetIn := range facetsIn {
+		facets[i] = additional.Facet{
+			Property: schema.LowercaseFirstLetter(facetIn.Property),
+			Limit:    int(facetIn.Limit),
+		}
+		for _, r := range facetIn.Ranges {
+			facets[i].Ranges = append(facets[i].Ranges, additional.FacetRange{From: r.From, To: r.To})
+		}
+	}
+	return facets
+}
+
 func extractTargetVectors(req *pb.SearchRequest, class *models.Class) ([]string, *dto.TargetCombination, bool, error) {
 	var targetVectors []string
 	var targets *pb.Targets
@@ -608,6 +687,33 @@ func extractSorting(sortIn []*pb.SortBy) []filters.Sort {
 	return sortOut
 }
 
+func extractFuzziness(in *pb.Fuzziness) *searchparams.Fuzziness {
+	if in == nil {
+		return nil
+	}
+	return &searchparams.Fuzziness{
+		MaxEdits:      int(in.MaxEdits),
+		PrefixLength:  int(in.PrefixLength),
+		MaxExpansions: int(in.GetMaxExpansions()),
+	}
+}
+
+// extractSparseVectorSearch returns the sparse query vector of a hybrid
+// search, which is searched in the sparse index of its named vector
+func extractSparseVectorSearch(in *pb.Vectors) (*searchparams.SparseVectorSearch, error) {
+	if in == nil {
+		return nil, nil
+	}
+	if in.Type != pb.Vectors_VECTOR_TYPE_SPARSE_FP32 {
+		return nil, fmt.Errorf("sparse vector: expected type %v, got %v", pb.Vectors_VECTOR_TYPE_SPARSE_FP32, in.Type)
+	}
+	vector, err := sparse.Decode(in.VectorBytes)
+	if err != nil {
+		return nil, fmt.Errorf("sparse vector: %w", err)
+	}
+	return &searchparams.SparseVectorSearch{TargetVector: in.Name, Vector: vector}, nil
+}
+
 func extractRerank(req *pb.SearchRequest) *rank.Params {
 	rerank := rank.Params{
 		Property: &req.Rerank.Property,
@@ -824,6 +930,13 @@ func extractAdditionalPropsFromMetadata(class *models.Class, prop *pb.MetadataRe
 		Vectors:            prop.Vectors,
 	}
 
+	if prop.Highlights != nil {
+		props.Highlights = &additional.Highlights{
+			Properties:   schema.LowercaseFirstLetterOfStrings(prop.Highlights.Properties),
+			FragmentSize: int(prop.Highlights.FragmentSize),
+		}
+	}
+
 	// certainty is not compatible with
 	// - multi-vector search
 	// - non-vector search
@@ -856,7 +969,8 @@ func isIdOnlyRequest(metadata *pb.MetadataRequest) bool {
 		!metadata.Certainty &&
 		!metadata.Score &&
 		!metadata.ExplainScore &&
-		!metadata.IsConsistent)
+		!metadata.IsConsistent &&
+		metadata.Highlights == nil)
 }
 
 func getAllNonRefNonBlobProperties(authorizedGetClass classGetterWithAuthzFunc, className string) ([]search.SelectProperty, error) {
@@ -1328,6 +1442,9 @@ func extractVector(vector *pb.Vectors) (models.Vector, error) {
 				return nil, fmt.Errorf("extract vector: %w", err)
 			}
 			return out, nil
+		case *pb.Vectors_VECTOR_TYPE_SINGLE_FP16.Enum(), *pb.Vectors_VECTOR_TYPE_SINGLE_INT8.Enum(), *pb.Vectors_VECTOR_TYPE_SINGLE_UINT8.Enum():
+			dataType, _ := batch.SingleVectorDataType(vector.Type)
+			return vectorIndexCommon.DecodeVector(dataType, vector.VectorBytes, nil), nil
 		default:
 			return nil, fmt.Errorf("cannot extract vector: unknown vector type: %T", vector.Type)
 		}
@@ -600,6 +600,38 @@ func TestGRPCSearchRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "Facets",
+			req: &pb.SearchRequest{Collection: classname, Facets: []*pb.Facet{
+				{Property: "Name", Limit: 3},
+				{Property: "number", Ranges: []*pb.FacetRange{{To: &one}, {From: &one}}},
+			}},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination, Properties: defaultTestClassProps,
+				AdditionalProperties: additional.Properties{
+					Facets: []additional.Facet{
+						{Property: "name", Limit: 3},
+						{Property: "number", Ranges: []additional.FacetRange{{To: &one}, {From: &one}}},
+					},
+				},
+			},
+			error: false,
+		},
+		{
+			name: "multivector aggregation override",
+			req:  &pb.SearchRequest{Collection: classname, MultivectorAggregation: ptr("maxSimNormalized")},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination, Properties: defaultTestClassProps,
+				MultivectorAggregation: "maxSimNormalized",
+			},
+			error: false,
+		},
+		{
+			name:  "invalid multivector aggregation override",
+			req:   &pb.SearchRequest{Collection: classname, MultivectorAggregation: ptr("minSim")},
+			out:   dto.GetParams{},
+			error: true,
+		},
 		{
 			name: "Properties return all nonref values",
 			req:  &pb.SearchRequest{Collection: classname},
@@ -963,6 +995,23 @@ func TestGRPCSearchRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "bm25 fuzziness",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				Bm25Search: &pb.BM25{Query: "query", Properties: []string{"name"}, Fuzziness: &pb.Fuzziness{MaxEdits: 1, PrefixLength: 2}},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				KeywordRanking: &searchparams.KeywordRanking{
+					Query: "query", Properties: []string{"name"}, Type: "bm25",
+					Fuzziness: &searchparams.Fuzziness{MaxEdits: 1, PrefixLength: 2},
+				},
+				Properties:           defaultTestClassProps,
+				AdditionalProperties: additional.Properties{Vector: true, NoProps: false},
+			},
+			error: false,
+		},
 		{
 			name: "bm25 groupby",
 			req: &pb.SearchRequest{
@@ -2514,3 +2563,45 @@ func sortNamedVecs(vecs []string) {
 func ptr[T any](t T) *T {
 	return &t
 }
+
+func TestGRPCSearchBatchRequest(t *testing.T) {
+	parser := NewParser(true, getClass, getAlias)
+	cfg := &config.Config{QueryDefaults: config.QueryDefaults{Limit: 10}}
+
+	t.Run("shared params and one vector per query", func(t *testing.T) {
+		out, vectors, err := parser.SearchBatch(&pb.SearchBatchRequest{
+			Collection: classname,
+			Limit:      5,
+			Metadata:   &pb.MetadataRequest{Uuid: true, Distance: true},
+			Properties: &pb.PropertiesRequest{NonRefProperties: []string{"name"}},
+			Vectors: []*pb.Vectors{
+				{VectorBytes: byteops.Fp32SliceToBytes([]float32{1, 2, 3})},
+				{VectorBytes: byteops.Fp32SliceToBytes([]float32{4, 5, 6}), Type: pb.Vectors_VECTOR_TYPE_SINGLE_FP32},
+			},
+		}, cfg)
+		require.Nil(t, err)
+		require.Equal(t, classname, out.ClassName)
+		require.Equal(t, &filters.Pagination{Limit: 5}, out.Pagination)
+		require.True(t, out.AdditionalProperties.ID)
+		require.True(t, out.AdditionalProperties.Distance)
+		require.Equal(t, search.SelectProperties{{Name: "name", IsPrimitive: true}}, out.Properties)
+		require.Nil(t, out.NearVector)
+		require.Equal(t, [][]float32{{1, 2, 3}, {4, 5, 6}}, vectors)
+	})
+
+	t.Run("requires vectors", func(t *testing.T) {
+		_, _, err := parser.SearchBatch(&pb.SearchBatchRequest{Collection: classname}, cfg)
+		require.NotNil(t, err)
+	})
+
+	t.Run("rejects multi vectors", func(t *testing.T) {
+		_, _, err := parser.SearchBatch(&pb.SearchBatchRequest{
+			Collection: classname,
+			Vectors: []*pb.Vectors{{
+				VectorBytes: byteops.Fp32SliceOfSlicesToBytes([][]float32{{1, 2}, {3, 4}}),
+				Type:        pb.Vectors_VECTOR_TYPE_MULTI_FP32,
+			}},
+		}, cfg)
+		require.NotNil(t, err)
+	})
+}
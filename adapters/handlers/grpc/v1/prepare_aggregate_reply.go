@@ -13,6 +13,7 @@ package v1
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -165,7 +166,7 @@ func (r *AggregateReplier) parseAggregationResult(propertyName string, property
 		}
 		switch dataType {
 		case "int", "int[]":
-			integerAggregation, err := parseIntegerAggregation(property.SchemaType, property.NumericalAggregations)
+			integerAggregation, err := parseIntegerAggregation(property.SchemaType, property.NumericalAggregations, property.Histogram)
 			if err != nil {
 				return nil, fmt.Errorf("parse integer aggregation: %w", err)
 			}
@@ -174,7 +175,7 @@ func (r *AggregateReplier) parseAggregationResult(propertyName string, property
 				Aggregation: &pb.AggregateReply_Aggregations_Aggregation_Int{Int: integerAggregation},
 			}, nil
 		default:
-			numericalAggregation, err := parseNumericalAggregation(property.SchemaType, property.NumericalAggregations)
+			numericalAggregation, err := parseNumericalAggregation(property.SchemaType, property.NumericalAggregations, property.Histogram)
 			if err != nil {
 				return nil, fmt.Errorf("parse numerical aggregation: %w", err)
 			}
@@ -196,7 +197,7 @@ func (r *AggregateReplier) parseAggregationResult(propertyName string, property
 			Aggregation: &pb.AggregateReply_Aggregations_Aggregation_Boolean_{Boolean: booleanAggregation},
 		}, nil
 	case aggregation.PropertyTypeDate:
-		dateAggregation, err := parseDateAggregation(property.SchemaType, property.DateAggregations)
+		dateAggregation, err := parseDateAggregation(property.SchemaType, property.DateAggregations, property.Histogram)
 		if err != nil {
 			return nil, fmt.Errorf("parse date aggregation: %w", err)
 		}
@@ -215,11 +216,12 @@ func (r *AggregateReplier) parseAggregationResult(propertyName string, property
 	}
 }
 
-func parseNumericalAggregation(schemaType string, in map[string]interface{}) (*pb.AggregateReply_Aggregations_Aggregation_Number, error) {
+func parseNumericalAggregation(schemaType string, in map[string]interface{}, histogram []aggregation.HistogramBucket) (*pb.AggregateReply_Aggregations_Aggregation_Number, error) {
 	var number *pb.AggregateReply_Aggregations_Aggregation_Number
-	if len(in) > 0 {
+	if len(in) > 0 || len(histogram) > 0 {
 		number = &pb.AggregateReply_Aggregations_Aggregation_Number{}
 		number.Type = &schemaType
+		number.Histogram = parseHistogramBuckets(histogram)
 		for name, value := range in {
 			switch val := value.(type) {
 			case float64:
@@ -249,11 +251,12 @@ func parseNumericalAggregation(schemaType string, in map[string]interface{}) (*p
 	return number, nil
 }
 
-func parseIntegerAggregation(schemaType string, in map[string]interface{}) (*pb.AggregateReply_Aggregations_Aggregation_Integer, error) {
+func parseIntegerAggregation(schemaType string, in map[string]interface{}, histogram []aggregation.HistogramBucket) (*pb.AggregateReply_Aggregations_Aggregation_Integer, error) {
 	var number *pb.AggregateReply_Aggregations_Aggregation_Integer
-	if len(in) > 0 {
+	if len(in) > 0 || len(histogram) > 0 {
 		number = &pb.AggregateReply_Aggregations_Aggregation_Integer{}
 		number.Type = &schemaType
+		number.Histogram = parseHistogramBuckets(histogram)
 		for name, value := range in {
 			switch val := value.(type) {
 			case float64:
@@ -314,11 +317,12 @@ func parseBooleanAggregation(schemaType string, in aggregation.Boolean) *pb.Aggr
 	}
 }
 
-func parseDateAggregation(schemaType string, in map[string]interface{}) (*pb.AggregateReply_Aggregations_Aggregation_Date, error) {
+func parseDateAggregation(schemaType string, in map[string]interface{}, histogram []aggregation.HistogramBucket) (*pb.AggregateReply_Aggregations_Aggregation_Date, error) {
 	var date *pb.AggregateReply_Aggregations_Aggregation_Date
-	if len(in) > 0 {
+	if len(in) > 0 || len(histogram) > 0 {
 		date = &pb.AggregateReply_Aggregations_Aggregation_Date{}
 		date.Type = &schemaType
+		date.Histogram = parseDateHistogramBuckets(histogram)
 		for name, value := range in {
 			switch val := value.(type) {
 			case int64:
@@ -353,6 +357,57 @@ func parseReferenceAggregation(schemaType string, in aggregation.Reference) *pb.
 	}
 }
 
+func parseHistogramBuckets(in []aggregation.HistogramBucket) []*pb.AggregateReply_Aggregations_Aggregation_HistogramBucket {
+	if len(in) == 0 {
+		return nil
+	}
+	buckets := make([]*pb.AggregateReply_Aggregations_Aggregation_HistogramBucket, len(in))
+	for i := range in {
+		key, _ := in[i].Key.(float64)
+		buckets[i] = &pb.AggregateReply_Aggregations_Aggregation_HistogramBucket{
+			Key:     key,
+			Count:   int64(in[i].Count),
+			Metrics: parseHistogramBucketMetrics(in[i].Metrics),
+		}
+	}
+	return buckets
+}
+
+func parseDateHistogramBuckets(in []aggregation.HistogramBucket) []*pb.AggregateReply_Aggregations_Aggregation_DateHistogramBucket {
+	if len(in) == 0 {
+		return nil
+	}
+	buckets := make([]*pb.AggregateReply_Aggregations_Aggregation_DateHistogramBucket, len(in))
+	for i := range in {
+		key, _ := in[i].Key.(string)
+		buckets[i] = &pb.AggregateReply_Aggregations_Aggregation_DateHistogramBucket{
+			Key:     key,
+			Count:   int64(in[i].Count),
+			Metrics: parseHistogramBucketMetrics(in[i].Metrics),
+		}
+	}
+	return buckets
+}
+
+func parseHistogramBucketMetrics(in map[string]aggregation.HistogramMetrics) []*pb.AggregateReply_Aggregations_Aggregation_HistogramMetrics {
+	if len(in) == 0 {
+		return nil
+	}
+	metrics := make([]*pb.AggregateReply_Aggregations_Aggregation_HistogramMetrics, 0, len(in))
+	for property, m := range in {
+		metrics = append(metrics, &pb.AggregateReply_Aggregations_Aggregation_HistogramMetrics{
+			Property: property,
+			Count:    int64(m.Count),
+			Sum:      m.Sum,
+			Mean:     m.Mean(),
+			Minimum:  m.Minimum,
+			Maximum:  m.Maximum,
+		})
+	}
+	sort.Slice(metrics, func(i, j int) bool { return metrics[i].Property < metrics[j].Property })
+	return metrics
+}
+
 func ptInt64[T int | float64](in T) *int64 {
 	out := int64(in)
 	return &out
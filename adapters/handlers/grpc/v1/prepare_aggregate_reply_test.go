@@ -48,6 +48,65 @@ func TestGRPCAggregateReply(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "date histogram",
+			res: &aggregation.Result{
+				Groups: []aggregation.Group{
+					{
+						Count: 3,
+						Properties: map[string]aggregation.Property{
+							"published": {
+								Type:       aggregation.PropertyTypeDate,
+								SchemaType: "date",
+								Histogram: []aggregation.HistogramBucket{
+									{
+										Key:   "2024-01-01T00:00:00+01:00",
+										Count: 2,
+										Metrics: map[string]aggregation.HistogramMetrics{
+											"views": {Count: 2, Sum: 30, Minimum: 10, Maximum: 20},
+										},
+									},
+									{Key: "2024-01-02T00:00:00+01:00", Count: 1},
+								},
+							},
+						},
+					},
+				},
+			},
+			outRes: &pb.AggregateReply{
+				Result: &pb.AggregateReply_GroupedResults{
+					GroupedResults: &pb.AggregateReply_Grouped{
+						Groups: []*pb.AggregateReply_Group{
+							{
+								ObjectsCount: ptInt64(3),
+								Aggregations: &pb.AggregateReply_Aggregations{
+									Aggregations: []*pb.AggregateReply_Aggregations_Aggregation{
+										{
+											Property: "published",
+											Aggregation: &pb.AggregateReply_Aggregations_Aggregation_Date_{
+												Date: &pb.AggregateReply_Aggregations_Aggregation_Date{
+													Type: ptr("date"),
+													Histogram: []*pb.AggregateReply_Aggregations_Aggregation_DateHistogramBucket{
+														{
+															Key:   "2024-01-01T00:00:00+01:00",
+															Count: 2,
+															Metrics: []*pb.AggregateReply_Aggregations_Aggregation_HistogramMetrics{
+																{Property: "views", Count: 2, Sum: 30, Mean: 15, Minimum: 10, Maximum: 20},
+															},
+														},
+														{Key: "2024-01-02T00:00:00+01:00", Count: 1},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -31,6 +31,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
 )
 
@@ -70,7 +71,7 @@ func NewReplier(
 	}
 }
 
-func (r *Replier) Search(res []interface{}, start time.Time, searchParams dto.GetParams, scheme schema.Schema) (*pb.SearchReply, error) {
+func (r *Replier) Search(res []interface{}, facets []*additional.FacetResult, start time.Time, searchParams dto.GetParams, scheme schema.Schema) (*pb.SearchReply, error) {
 	tookSeconds := float64(time.Since(start)) / float64(time.Second)
 	out := &pb.SearchReply{
 		Took:                    float32(tookSeconds),
@@ -98,13 +99,32 @@ func (r *Replier) Search(res []interface{}, start time.Time, searchParams dto.Ge
 		out.GenerativeGroupedResults = generativeGroupedResults
 		out.Results = objects
 	}
+
+	if len(facets) > 0 {
+		out.Facets = extractFacetResults(facets)
+	}
 	return out, nil
 }
 
+func extractFacetResults(facets []*additional.FacetResult) []*pb.FacetResult {
+	out := make([]*pb.FacetResult, len(facets))
+	for i, facet := range facets {
+		out[i] = &pb.FacetResult{Property: facet.Property}
+		for _, value := range facet.Values {
+			out[i].Values = append(out[i].Values, &pb.FacetResult_Value{Value: value.Value, Count: int64(value.Count)})
+		}
+		for _, r := range facet.Ranges {
+			out[i].Ranges = append(out[i].Ranges, &pb.FacetResult_Range{From: r.From, To: r.To, Count: int64(r.Count)})
+		}
+	}
+	return out
+}
+
 func (r *Replier) extractObjectsToResults(res []interface{}, searchParams dto.GetParams, scheme schema.Schema, fromGroup bool) ([]*pb.SearchResult, string, *pb.GenerativeResult, error) {
 	results := make([]*pb.SearchResult, len(res))
 	generativeGroupResultsReturnDeprecated := ""
 	var generativeGroupResults *pb.GenerativeResult
+	dataTypes := vectorDataTypes(scheme.GetClass(searchParams.ClassName))
 	for i, raw := range res {
 		asMap, ok := raw.(map[string]interface{})
 		if !ok {
@@ -120,7 +140,7 @@ func (r *Replier) extractObjectsToResults(res []interface{}, searchParams dto.Ge
 			return nil, "", nil, err
 		}
 
-		additionalProps, err := r.extractAdditionalProps(asMap, searchParams.AdditionalProperties, firstObject, fromGroup)
+		additionalProps, err := r.extractAdditionalProps(asMap, searchParams.AdditionalProperties, dataTypes, firstObject, fromGroup)
 		if err != nil {
 			return nil, "", nil, err
 		}
@@ -164,7 +184,7 @@ func idToByte(idRaw interface{}) ([]byte, string, error) {
 	return hexInteger.Bytes(), idStrfmtStr, nil
 }
 
-func (r *Replier) extractAdditionalProps(asMap map[string]any, additionalPropsParams additional.Properties, firstObject, fromGroup bool) (*additionalProps, error) {
+func (r *Replier) extractAdditionalProps(asMap map[string]any, additionalPropsParams additional.Properties, dataTypes map[string]string, firstObject, fromGroup bool) (*additionalProps, error) {
 	generativeSearchRaw, generativeSearchEnabled := additionalPropsParams.ModuleParams["generate"]
 	_, rerankEnabled := additionalPropsParams.ModuleParams["rerank"]
 
@@ -265,11 +285,7 @@ func (r *Replier) extractAdditionalProps(asMap map[string]any, additionalPropsPa
 					switch vec := vector.(type) {
 					case []float32:
 						if len(vec) != 0 {
-							addProps.Metadata.Vectors = append(addProps.Metadata.Vectors, &pb.Vectors{
-								VectorBytes: byteops.Fp32SliceToBytes(vec),
-								Name:        name,
-								Type:        pb.Vectors_VECTOR_TYPE_SINGLE_FP32,
-							})
+							addProps.Metadata.Vectors = append(addProps.Metadata.Vectors, singleVectorToProto(name, vec, dataTypes[name]))
 						}
 					case [][]float32:
 						if len(vec) != 0 {
@@ -279,6 +295,14 @@ func (r *Replier) extractAdditionalProps(asMap map[string]any, additionalPropsPa
 								Type:        pb.Vectors_VECTOR_TYPE_MULTI_FP32,
 							})
 						}
+					case *models.SparseVector:
+						if vec != nil && len(vec.Indices) != 0 {
+							addProps.Metadata.Vectors = append(addProps.Metadata.Vectors, &pb.Vectors{
+								Name:        name,
+								VectorBytes: sparse.Encode(vec),
+								Type:        pb.Vectors_VECTOR_TYPE_SPARSE_FP32,
+							})
+						}
 					default:
 						// do nothing
 					}
@@ -347,6 +371,16 @@ func (r *Replier) extractAdditionalProps(asMap map[string]any, additionalPropsPa
 		}
 	}
 
+	if additionalPropsParams.Highlights != nil {
+		highlights, ok := additionalPropertiesMap["highlights"].([]*additional.Highlight)
+		if ok {
+			addProps.Metadata.Highlights = make([]*pb.Highlight, len(highlights))
+			for i, highlight := range highlights {
+				addProps.Metadata.Highlights[i] = &pb.Highlight{Property: highlight.Property, Fragments: highlight.Fragments}
+			}
+		}
+	}
+
 	if additionalPropsParams.Score {
 		addProps.Metadata.ScorePresent = false
 		score, ok := additionalPropertiesMap["score"]
@@ -540,7 +574,8 @@ func (r *Replier) extractPropertiesAnswer(scheme schema.Schema, results map[stri
 			if err != nil {
 				continue
 			}
-			additionalProps, err := r.extractAdditionalProps(refLocal.Fields, prop.Refs[0].AdditionalProperties, false, false)
+			additionalProps, err := r.extractAdditionalProps(refLocal.Fields, prop.Refs[0].AdditionalProperties,
+				vectorDataTypes(scheme.GetClass(refLocal.Class)), false, false)
 			if err != nil {
 				return nil, err
 			}
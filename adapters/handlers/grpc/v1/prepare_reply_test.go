@@ -191,6 +191,8 @@ func TestGRPCReply(t *testing.T) {
 		outSearch     []*pb.SearchResult
 		outGenerative string
 		outGroup      []*pb.GroupByResult
+		facets        []*additional.FacetResult
+		outFacets     []*pb.FacetResult
 		hasError      bool
 	}{
 		{
@@ -209,6 +211,40 @@ func TestGRPCReply(t *testing.T) {
 				{Metadata: &pb.MetadataResult{Vector: []float32{2}, VectorBytes: byteVector([]float32{2})}, Properties: &pb.PropertiesResult{}},
 			},
 		},
+		{
+			name: "highlights",
+			res: []interface{}{
+				map[string]interface{}{
+					"_additional": map[string]interface{}{"highlights": []*additional.Highlight{
+						{Property: "word", Fragments: []string{"a <em>fox</em>", "the <em>fox</em>"}},
+					}},
+				},
+			},
+			searchParams: dto.GetParams{AdditionalProperties: additional.Properties{Highlights: &additional.Highlights{Properties: []string{"word"}}}},
+			outSearch: []*pb.SearchResult{
+				{Metadata: &pb.MetadataResult{Highlights: []*pb.Highlight{
+					{Property: "word", Fragments: []string{"a <em>fox</em>", "the <em>fox</em>"}},
+				}}, Properties: &pb.PropertiesResult{}},
+			},
+		},
+		{
+			name: "facets",
+			res: []interface{}{
+				map[string]interface{}{},
+			},
+			facets: []*additional.FacetResult{
+				{Property: "word", Values: []additional.FacetValue{{Value: "fox", Count: 3}}},
+				{Property: "age", Ranges: []additional.FacetRangeCount{{From: &someFloat64, Count: 2}}},
+			},
+			searchParams: dto.GetParams{AdditionalProperties: additional.Properties{Facets: []additional.Facet{{Property: "word"}, {Property: "age"}}}},
+			outSearch: []*pb.SearchResult{
+				{Metadata: &pb.MetadataResult{}, Properties: &pb.PropertiesResult{}},
+			},
+			outFacets: []*pb.FacetResult{
+				{Property: "word", Values: []*pb.FacetResult_Value{{Value: "fox", Count: 3}}},
+				{Property: "age", Ranges: []*pb.FacetResult_Range{{From: &someFloat64, Count: 2}}},
+			},
+		},
 		{
 			name: "named vector only",
 			res: []interface{}{
@@ -1329,7 +1365,7 @@ func TestGRPCReply(t *testing.T) {
 	for _, tt := range tests {
 		replier := NewReplier(false, fakeGenerativeParams{}, nil)
 		t.Run(tt.name, func(t *testing.T) {
-			out, err := replier.Search(tt.res, time.Now(), tt.searchParams, scheme)
+			out, err := replier.Search(tt.res, tt.facets, time.Now(), tt.searchParams, scheme)
 			if tt.hasError {
 				require.NotNil(t, err)
 			} else {
@@ -1346,6 +1382,10 @@ func TestGRPCReply(t *testing.T) {
 					require.Equal(t, tt.outSearch[i].Metadata.String(), out.Results[i].Metadata.String())
 				}
 				require.Equal(t, tt.outGenerative, *out.GenerativeGroupedResult)
+				require.Equal(t, len(tt.outFacets), len(out.Facets))
+				for i := range tt.outFacets {
+					require.Equal(t, tt.outFacets[i].String(), out.Facets[i].String())
+				}
 			}
 		})
 	}
@@ -0,0 +1,291 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	restCtx "github.com/weaviate/weaviate/adapters/handlers/rest/context"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	schemaManager "github.com/weaviate/weaviate/usecases/schema"
+)
+
+func (s *Service) CollectionsGet(ctx context.Context, req *pb.CollectionsGetRequest) (*pb.CollectionsGetReply, error) {
+	before := time.Now()
+
+	ctx, principal, err := s.principalContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Collection == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing collection")
+	}
+
+	class, _, err := s.schemaManager.GetConsistentClass(ctx, principal, req.Collection, req.Consistent)
+	if err != nil {
+		return nil, schemaError("get collection", err)
+	}
+	if class == nil {
+		return nil, status.Errorf(codes.NotFound, "could not find class %s in schema", req.Collection)
+	}
+
+	collection, err := modelToStruct(class)
+	if err != nil {
+		return nil, fmt.Errorf("prepare reply: %w", err)
+	}
+
+	return &pb.CollectionsGetReply{
+		Took:       float32(time.Since(before).Seconds()),
+		Collection: collection,
+	}, nil
+}
+
+func (s *Service) CollectionsList(ctx context.Context, req *pb.CollectionsListRequest) (*pb.CollectionsListReply, error) {
+	before := time.Now()
+
+	ctx, principal, err := s.principalContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sch, err := s.schemaManager.GetConsistentSchema(ctx, principal, req.Consistent)
+	if err != nil {
+		return nil, schemaError("get schema", err)
+	}
+
+	var classes []*models.Class
+	if sch.Objects != nil {
+		classes = sch.Objects.Classes
+	}
+	collections := make([]*structpb.Struct, len(classes))
+	for i, class := range classes {
+		if collections[i], err = modelToStruct(class); err != nil {
+			return nil, fmt.Errorf("prepare reply: %w", err)
+		}
+	}
+
+	return &pb.CollectionsListReply{
+		Took:        float32(time.Since(before).Seconds()),
+		Collections: collections,
+	}, nil
+}
+
+func (s *Service) CollectionsCreate(ctx context.Context, req *pb.CollectionsCreateRequest) (*pb.CollectionsCreateReply, error) {
+	before := time.Now()
+
+	ctx, principal, err := s.principalContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	class := &models.Class{}
+	if err := structToModel(req.Collection, class); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid collection: %v", err)
+	}
+
+	created, _, err := s.schemaManager.AddClass(ctx, principal, class)
+	if err != nil {
+		return nil, schemaError("create collection", err)
+	}
+	if created == nil {
+		created = class
+	}
+
+	collection, err := modelToStruct(created)
+	if err != nil {
+		return nil, fmt.Errorf("prepare reply: %w", err)
+	}
+
+	return &pb.CollectionsCreateReply{
+		Took:       float32(time.Since(before).Seconds()),
+		Collection: collection,
+	}, nil
+}
+
+func (s *Service) CollectionsUpdate(ctx context.Context, req *pb.CollectionsUpdateRequest) (*pb.CollectionsUpdateReply, error) {
+	before := time.Now()
+
+	ctx, principal, err := s.principalContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	class := &models.Class{}
+	if err := structToModel(req.Collection, class); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid collection: %v", err)
+	}
+	if class.Class == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing collection name")
+	}
+
+	if err := s.schemaManager.UpdateClass(ctx, principal, class.Class, class); err != nil {
+		return nil, schemaError("update collection", err)
+	}
+
+	// the stored class has defaults applied and may differ from the request
+	updated, _, err := s.schemaManager.GetConsistentClass(ctx, principal, class.Class, true)
+	if err != nil {
+		return nil, schemaError("read updated collection", err)
+	}
+	if updated == nil {
+		return nil, status.Errorf(codes.NotFound, "could not find class %s in schema", class.Class)
+	}
+
+	collection, err := modelToStruct(updated)
+	if err != nil {
+		return nil, fmt.Errorf("prepare reply: %w", err)
+	}
+
+	return &pb.CollectionsUpdateReply{
+		Took:       float32(time.Since(before).Seconds()),
+		Collection: collection,
+	}, nil
+}
+
+func (s *Service) CollectionsDelete(ctx context.Context, req *pb.CollectionsDeleteRequest) (*pb.CollectionsDeleteReply, error) {
+	before := time.Now()
+
+	ctx, principal, err := s.principalContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Collection == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing collection")
+	}
+
+	if err := s.schemaManager.DeleteClass(ctx, principal, req.Collection); err != nil {
+		return nil, schemaError("delete collection", err)
+	}
+
+	return &pb.CollectionsDeleteReply{
+		Took: float32(time.Since(before).Seconds()),
+	}, nil
+}
+
+func (s *Service) PropertiesAdd(ctx context.Context, req *pb.PropertiesAddRequest) (*pb.PropertiesAddReply, error) {
+	before := time.Now()
+
+	ctx, principal, err := s.principalContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Collection == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing collection")
+	}
+
+	prop := &models.Property{}
+	if err := structToModel(req.Property, prop); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid property: %v", err)
+	}
+
+	_, _, err = s.schemaManager.AddClassProperty(ctx, principal,
+		s.schemaManager.ReadOnlyClass(req.Collection), req.Collection, false, prop)
+	if err != nil {
+		return nil, schemaError("add property", err)
+	}
+
+	property, err := modelToStruct(prop)
+	if err != nil {
+		return nil, fmt.Errorf("prepare reply: %w", err)
+	}
+
+	return &pb.PropertiesAddReply{
+		Took:     float32(time.Since(before).Seconds()),
+		Property: property,
+	}, nil
+}
+
+func (s *Service) PropertiesDelete(ctx context.Context, req *pb.PropertiesDeleteRequest) (*pb.PropertiesDeleteReply, error) {
+	before := time.Now()
+
+	ctx, principal, err := s.principalContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Collection == "" || req.Property == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing collection or property")
+	}
+
+	if err := s.schemaManager.DeleteClassProperty(ctx, principal, req.Collection, req.Property); err != nil {
+		return nil, schemaError("delete property", err)
+	}
+
+	return &pb.PropertiesDeleteReply{
+		Took: float32(time.Since(before).Seconds()),
+	}, nil
+}
+
+// principalContext extracts the principal of the request and adds it to the
+// context, as expected by the schema handler.
+func (s *Service) principalContext(ctx context.Context) (context.Context, *models.Principal, error) {
+	principal, err := s.authenticator.PrincipalFromContext(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("extract auth: %w", err)
+	}
+	return restCtx.AddPrincipalToContext(ctx, principal), principal, nil
+}
+
+// schemaError reports errors of the schema handler about missing
+// collections, properties or aliases as NotFound, other errors are passed on
+// to the interceptors, e.g. to map authorization errors.
+func schemaError(action string, err error) error {
+	if errors.Is(err, schemaManager.ErrNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return fmt.Errorf("%s: %w", action, err)
+}
+
+type validatable interface {
+	Validate(formats strfmt.Registry) error
+}
+
+// structToModel decodes a definition in the JSON format of the REST API into
+// one of the generated models and validates it the same way the REST API does.
+func structToModel(in *structpb.Struct, out validatable) error {
+	if in == nil {
+		return fmt.Errorf("missing definition")
+	}
+
+	raw, err := in.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(raw, out); err != nil {
+		return err
+	}
+	return out.Validate(strfmt.Default)
+}
+
+// modelToStruct encodes one of the generated models in the JSON format of the
+// REST API.
+func modelToStruct(in any) (*structpb.Struct, error) {
+	raw, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &structpb.Struct{}
+	if err := out.UnmarshalJSON(raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
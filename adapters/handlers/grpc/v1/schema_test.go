@@ -0,0 +1,213 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	authzerrors "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	schemaManager "github.com/weaviate/weaviate/usecases/schema"
+)
+
+func TestSchemaStructConversion(t *testing.T) {
+	t.Run("collection round trip", func(t *testing.T) {
+		vTrue := true
+		class := &models.Class{
+			Class:       "Article",
+			Description: "news articles",
+			Properties: []*models.Property{
+				{
+					Name:            "title",
+					DataType:        schema.DataTypeText.PropString(),
+					Tokenization:    models.PropertyTokenizationWord,
+					IndexFilterable: &vTrue,
+				},
+			},
+			ReplicationConfig: &models.ReplicationConfig{Factor: 3},
+			VectorIndexConfig: map[string]interface{}{"ef": float64(64)},
+		}
+
+		in, err := modelToStruct(class)
+		require.Nil(t, err)
+		assert.Equal(t, "Article", in.Fields["class"].GetStringValue())
+
+		out := &models.Class{}
+		require.Nil(t, structToModel(in, out))
+		assert.Equal(t, class, out)
+	})
+
+	t.Run("property", func(t *testing.T) {
+		in, err := structpb.NewStruct(map[string]interface{}{
+			"name":     "wordCount",
+			"dataType": []interface{}{"int"},
+		})
+		require.Nil(t, err)
+
+		prop := &models.Property{}
+		require.Nil(t, structToModel(in, prop))
+		assert.Equal(t, "wordCount", prop.Name)
+		assert.Equal(t, schema.DataTypeInt.PropString(), prop.DataType)
+	})
+
+	t.Run("invalid definition", func(t *testing.T) {
+		in, err := structpb.NewStruct(map[string]interface{}{
+			"class":              "Article",
+			"multiTenancyConfig": "enabled",
+		})
+		require.Nil(t, err)
+		require.NotNil(t, structToModel(in, &models.Class{}))
+	})
+
+	t.Run("missing definition", func(t *testing.T) {
+		require.NotNil(t, structToModel(nil, &models.Class{}))
+	})
+}
+
+func TestCollectionsRPCs(t *testing.T) {
+	ctx := context.Background()
+	article := &models.Class{
+		Class:      "Article",
+		Properties: []*models.Property{{Name: "title", DataType: schema.DataTypeText.PropString()}},
+	}
+	notFound := fmt.Errorf("class %q: %w", "Unknown", schemaManager.ErrNotFound)
+	forbidden := authzerrors.NewForbidden(&models.Principal{Username: "john"}, "delete", "collections")
+
+	t.Run("get", func(t *testing.T) {
+		s := newTestService(&fakeSchemaHandler{classes: map[string]*models.Class{"Article": article}})
+
+		reply, err := s.CollectionsGet(ctx, &pb.CollectionsGetRequest{Collection: "Article"})
+		require.Nil(t, err)
+		assert.Equal(t, "Article", reply.Collection.Fields["class"].GetStringValue())
+
+		_, err = s.CollectionsGet(ctx, &pb.CollectionsGetRequest{Collection: "Unknown"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		_, err = s.CollectionsGet(ctx, &pb.CollectionsGetRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		s = newTestService(&fakeSchemaHandler{err: forbidden})
+		_, err = s.CollectionsGet(ctx, &pb.CollectionsGetRequest{Collection: "Article"})
+		assert.True(t, errors.As(err, &authzerrors.Forbidden{}))
+	})
+
+	t.Run("create", func(t *testing.T) {
+		s := newTestService(&fakeSchemaHandler{})
+
+		in, err := modelToStruct(article)
+		require.Nil(t, err)
+		reply, err := s.CollectionsCreate(ctx, &pb.CollectionsCreateRequest{Collection: in})
+		require.Nil(t, err)
+		assert.Equal(t, "Article", reply.Collection.Fields["class"].GetStringValue())
+
+		_, err = s.CollectionsCreate(ctx, &pb.CollectionsCreateRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		s = newTestService(&fakeSchemaHandler{err: notFound})
+		_, err = s.CollectionsCreate(ctx, &pb.CollectionsCreateRequest{Collection: in})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		s = newTestService(&fakeSchemaHandler{err: forbidden})
+		_, err = s.CollectionsCreate(ctx, &pb.CollectionsCreateRequest{Collection: in})
+		assert.True(t, errors.As(err, &authzerrors.Forbidden{}))
+	})
+
+	t.Run("update", func(t *testing.T) {
+		fake := &fakeSchemaHandler{classes: map[string]*models.Class{"Article": article}}
+		s := newTestService(fake)
+
+		in, err := modelToStruct(&models.Class{Class: "Article", Description: "news"})
+		require.Nil(t, err)
+		reply, err := s.CollectionsUpdate(ctx, &pb.CollectionsUpdateRequest{Collection: in})
+		require.Nil(t, err)
+		assert.Equal(t, "news", reply.Collection.Fields["description"].GetStringValue())
+		// the reply is the stored class, not the request
+		assert.Equal(t, "none", reply.Collection.Fields["vectorizer"].GetStringValue())
+
+		_, err = s.CollectionsUpdate(ctx, &pb.CollectionsUpdateRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		fake.err = notFound
+		_, err = s.CollectionsUpdate(ctx, &pb.CollectionsUpdateRequest{Collection: in})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		fake := &fakeSchemaHandler{}
+		s := newTestService(fake)
+
+		_, err := s.CollectionsDelete(ctx, &pb.CollectionsDeleteRequest{Collection: "Article"})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"Article"}, fake.deletedClasses)
+
+		_, err = s.CollectionsDelete(ctx, &pb.CollectionsDeleteRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		fake.err = notFound
+		_, err = s.CollectionsDelete(ctx, &pb.CollectionsDeleteRequest{Collection: "Unknown"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		// mapped to PermissionDenied by the interceptors
+		fake.err = forbidden
+		_, err = s.CollectionsDelete(ctx, &pb.CollectionsDeleteRequest{Collection: "Article"})
+		assert.True(t, errors.As(err, &authzerrors.Forbidden{}))
+	})
+
+	t.Run("add property", func(t *testing.T) {
+		fake := &fakeSchemaHandler{classes: map[string]*models.Class{"Article": article}}
+		s := newTestService(fake)
+
+		in, err := structpb.NewStruct(map[string]interface{}{
+			"name":     "wordCount",
+			"dataType": []interface{}{"int"},
+		})
+		require.Nil(t, err)
+		reply, err := s.PropertiesAdd(ctx, &pb.PropertiesAddRequest{Collection: "Article", Property: in})
+		require.Nil(t, err)
+		assert.Equal(t, "wordCount", reply.Property.Fields["name"].GetStringValue())
+		require.Len(t, fake.addedProps, 1)
+		assert.Equal(t, "wordCount", fake.addedProps[0].Name)
+
+		_, err = s.PropertiesAdd(ctx, &pb.PropertiesAddRequest{Collection: "Article"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		fake.err = notFound
+		_, err = s.PropertiesAdd(ctx, &pb.PropertiesAddRequest{Collection: "Unknown", Property: in})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("delete property", func(t *testing.T) {
+		fake := &fakeSchemaHandler{}
+		s := newTestService(fake)
+
+		_, err := s.PropertiesDelete(ctx, &pb.PropertiesDeleteRequest{Collection: "Article", Property: "title"})
+		require.Nil(t, err)
+
+		_, err = s.PropertiesDelete(ctx, &pb.PropertiesDeleteRequest{Collection: "Article"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		fake.err = notFound
+		_, err = s.PropertiesDelete(ctx, &pb.PropertiesDeleteRequest{Collection: "Unknown", Property: "title"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
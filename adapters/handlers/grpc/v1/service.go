@@ -17,6 +17,7 @@ import (
 	"runtime"
 	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
@@ -47,10 +48,12 @@ type Service struct {
 	traverser            *traverser.Traverser
 	authComposer         composer.TokenFunc
 	allowAnonymousAccess bool
-	schemaManager        *schemaManager.Manager
+	schemaManager        schemaHandler
 	batchManager         *objects.BatchManager
+	objectsManager       objectsHandler
 	config               *config.Config
 	authorizer           authorization.Authorizer
+	changeStreamer       changeStreamer
 	logger               logrus.FieldLogger
 
 	authenticator      *auth.Handler
@@ -58,10 +61,48 @@ type Service struct {
 	batchStreamHandler *batch.StreamHandler
 }
 
+// schemaHandler is the part of the schema manager used by the service
+type schemaHandler interface {
+	ReadOnlyClass(name string) *models.Class
+	ResolveAlias(alias string) string
+	GetSchemaSkipAuth() schema.Schema
+	GetConsistentSchema(ctx context.Context, principal *models.Principal, consistency bool) (schema.Schema, error)
+	GetConsistentClass(ctx context.Context, principal *models.Principal, name string, consistency bool) (*models.Class, uint64, error)
+	AddClass(ctx context.Context, principal *models.Principal, cls *models.Class) (*models.Class, uint64, error)
+	UpdateClass(ctx context.Context, principal *models.Principal, className string, updated *models.Class) error
+	DeleteClass(ctx context.Context, principal *models.Principal, class string) error
+	AddClassProperty(ctx context.Context, principal *models.Principal, class *models.Class, className string, merge bool, newProps ...*models.Property) (*models.Class, uint64, error)
+	DeleteClassProperty(ctx context.Context, principal *models.Principal, className string, propName string) error
+	GetConsistentTenants(ctx context.Context, principal *models.Principal, class string, consistency bool, tenants []string) ([]*models.Tenant, error)
+	AddTenants(ctx context.Context, principal *models.Principal, class string, tenants []*models.Tenant) (uint64, error)
+	UpdateTenants(ctx context.Context, principal *models.Principal, class string, tenants []*models.Tenant) ([]*models.Tenant, error)
+	DeleteTenants(ctx context.Context, principal *models.Principal, class string, tenants []string) error
+	GetAliases(ctx context.Context, principal *models.Principal, alias, className string) ([]*models.Alias, error)
+	GetAlias(ctx context.Context, principal *models.Principal, alias string) (*models.Alias, error)
+	AddAlias(ctx context.Context, principal *models.Principal, alias *models.Alias) (*models.Alias, uint64, error)
+	UpdateAlias(ctx context.Context, principal *models.Principal, aliasName, targetClassName string) (*models.Alias, error)
+	DeleteAlias(ctx context.Context, principal *models.Principal, aliasName string) error
+}
+
+// objectsHandler is the part of the objects manager used by the single
+// object RPCs
+type objectsHandler interface {
+	GetObject(ctx context.Context, principal *models.Principal, class string, id strfmt.UUID,
+		additional additional.Properties, replProps *additional.ReplicationProperties, tenant string) (*models.Object, error)
+	HeadObject(ctx context.Context, principal *models.Principal, className string, id strfmt.UUID,
+		repl *additional.ReplicationProperties, tenant string) (bool, *objects.Error)
+	UpdateObject(ctx context.Context, principal *models.Principal, class string, id strfmt.UUID,
+		updates *models.Object, repl *additional.ReplicationProperties) (*models.Object, error)
+	MergeObject(ctx context.Context, principal *models.Principal, updates *models.Object,
+		repl *additional.ReplicationProperties) *objects.Error
+	DeleteObject(ctx context.Context, principal *models.Principal, className string, id strfmt.UUID,
+		repl *additional.ReplicationProperties, tenant string) error
+}
+
 func NewService(traverser *traverser.Traverser, authComposer composer.TokenFunc,
 	allowAnonymousAccess bool, schemaManager *schemaManager.Manager,
-	batchManager *objects.BatchManager, config *config.Config, authorization authorization.Authorizer,
-	logger logrus.FieldLogger,
+	batchManager *objects.BatchManager, objectsManager *objects.Manager, config *config.Config,
+	authorization authorization.Authorizer, changeStreamer changeStreamer, logger logrus.FieldLogger,
 ) (*Service, batch.Drain) {
 	authenticator := auth.NewHandler(allowAnonymousAccess, authComposer)
 	batchHandler := batch.NewHandler(authorization, batchManager, logger, authenticator, schemaManager)
@@ -72,9 +113,11 @@ func NewService(traverser *traverser.Traverser, authComposer composer.TokenFunc,
 		allowAnonymousAccess: allowAnonymousAccess,
 		schemaManager:        schemaManager,
 		batchManager:         batchManager,
+		objectsManager:       objectsManager,
 		config:               config,
 		logger:               logger,
 		authorizer:           authorization,
+		changeStreamer:       changeStreamer,
 		authenticator:        authenticator,
 		batchHandler:         batchHandler,
 		batchStreamHandler:   batchStreamHandler,
@@ -314,13 +357,80 @@ func (s *Service) search(ctx context.Context, req *pb.SearchRequest) (*pb.Search
 		return nil, err
 	}
 
-	res, err := s.traverser.GetClass(restCtx.AddPrincipalToContext(ctx, principal), principal, searchParams)
+	res, facets, err := s.traverser.GetClassWithFacets(restCtx.AddPrincipalToContext(ctx, principal), principal, searchParams)
 	if err != nil {
 		return nil, err
 	}
 
 	scheme := s.schemaManager.GetSchemaSkipAuth()
-	return replier.Search(res, before, searchParams, scheme)
+	return replier.Search(res, facets, before, searchParams, scheme)
+}
+
+// SearchBatch runs a vector search for each of the vectors of the request,
+// sharing its filters, limit and returned properties. The shards search the
+// vectors together, so that the filters are only evaluated once per shard.
+func (s *Service) SearchBatch(ctx context.Context, req *pb.SearchBatchRequest) (*pb.SearchBatchReply, error) {
+	var result *pb.SearchBatchReply
+	var errInner error
+
+	if class := s.schemaManager.ResolveAlias(req.Collection); class != "" {
+		req.Collection = class
+	}
+
+	if err := enterrors.GoWrapperWithBlock(func() {
+		result, errInner = s.searchBatch(ctx, req)
+	}, s.logger); err != nil {
+		return nil, err
+	}
+
+	return result, errInner
+}
+
+func (s *Service) searchBatch(ctx context.Context, req *pb.SearchBatchRequest) (*pb.SearchBatchReply, error) {
+	before := time.Now()
+
+	principal, err := s.authenticator.PrincipalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	parser := NewParser(
+		true,
+		s.classGetterWithAuthzFunc(ctx, principal, req.Tenant),
+		s.aliasGetter(),
+	)
+	replier := NewReplier(
+		true,
+		parser.generative,
+		s.logger,
+	)
+
+	searchParams, vectors, err := parser.SearchBatch(req, s.config)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateClassAndProperty(searchParams); err != nil {
+		return nil, err
+	}
+
+	res, err := s.traverser.GetClassBatch(ctx, principal, searchParams, req.TargetVector, vectors)
+	if err != nil {
+		return nil, err
+	}
+
+	scheme := s.schemaManager.GetSchemaSkipAuth()
+	out := &pb.SearchBatchReply{Results: make([]*pb.SearchBatchResult, len(res))}
+	for i := range res {
+		reply, err := replier.Search(res[i], nil, before, searchParams, scheme)
+		if err != nil {
+			return nil, fmt.Errorf("vectors[%d]: %w", i, err)
+		}
+		out.Results[i] = &pb.SearchBatchResult{Results: reply.Results}
+	}
+	out.Took = float32(float64(time.Since(before)) / float64(time.Second))
+	return out, nil
 }
 
 func (s *Service) validateClassAndProperty(searchParams dto.GetParams) error {
@@ -14,8 +14,14 @@ package v1
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
 )
 
@@ -58,6 +64,151 @@ func (s *Service) tenantsGet(ctx context.Context, principal *models.Principal, r
 	return retTenants, nil
 }
 
+func (s *Service) TenantsCreate(ctx context.Context, req *pb.TenantsCreateRequest) (*pb.TenantsCreateReply, error) {
+	before := time.Now()
+
+	ctx, principal, err := s.principalContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if class := s.schemaManager.ResolveAlias(req.Collection); class != "" {
+		req.Collection = class
+	}
+
+	tenants, err := tenantsFromGRPC(req.Collection, req.Tenants)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.schemaManager.AddTenants(ctx, principal, req.Collection, tenants); err != nil {
+		return nil, schemaError("create tenants", err)
+	}
+	for _, tenant := range tenants {
+		tenant.ActivityStatus = schema.ActivityStatus(tenant.ActivityStatus)
+	}
+
+	retTenants, err := tenantsToGRPC(tenants)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.TenantsCreateReply{
+		Took:    float32(time.Since(before).Seconds()),
+		Tenants: retTenants,
+	}, nil
+}
+
+func (s *Service) TenantsUpdate(ctx context.Context, req *pb.TenantsUpdateRequest) (*pb.TenantsUpdateReply, error) {
+	before := time.Now()
+
+	ctx, principal, err := s.principalContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if class := s.schemaManager.ResolveAlias(req.Collection); class != "" {
+		req.Collection = class
+	}
+
+	tenants, err := tenantsFromGRPC(req.Collection, req.Tenants)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.schemaManager.UpdateTenants(ctx, principal, req.Collection, tenants)
+	if err != nil {
+		return nil, schemaError("update tenants", err)
+	}
+
+	retTenants, err := tenantsToGRPC(updated)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.TenantsUpdateReply{
+		Took:    float32(time.Since(before).Seconds()),
+		Tenants: retTenants,
+	}, nil
+}
+
+func (s *Service) TenantsDelete(ctx context.Context, req *pb.TenantsDeleteRequest) (*pb.TenantsDeleteReply, error) {
+	before := time.Now()
+
+	ctx, principal, err := s.principalContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if class := s.schemaManager.ResolveAlias(req.Collection); class != "" {
+		req.Collection = class
+	}
+	if req.Collection == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing collection")
+	}
+	if len(req.Tenants) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "must specify at least one tenant name")
+	}
+
+	if err := s.schemaManager.DeleteTenants(ctx, principal, req.Collection, req.Tenants); err != nil {
+		return nil, schemaError("delete tenants", err)
+	}
+
+	return &pb.TenantsDeleteReply{
+		Took: float32(time.Since(before).Seconds()),
+	}, nil
+}
+
+func tenantsFromGRPC(collection string, tenants []*pb.Tenant) ([]*models.Tenant, error) {
+	if collection == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing collection")
+	}
+	if len(tenants) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "must specify at least one tenant")
+	}
+
+	retTenants := make([]*models.Tenant, len(tenants))
+	for i, tenant := range tenants {
+		retTenant, err := tenantFromGRPC(tenant)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		retTenants[i] = retTenant
+	}
+	return retTenants, nil
+}
+
+// tenantFromGRPC converts a tenant of a request. An unspecified activity
+// status is left empty, so the schema handler applies its default.
+func tenantFromGRPC(tenant *pb.Tenant) (*models.Tenant, error) {
+	if tenant.GetName() == "" {
+		return nil, fmt.Errorf("missing tenant name")
+	}
+
+	var activityStatus string
+	if tenant.ActivityStatus != pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_UNSPECIFIED {
+		name, ok := pb.TenantActivityStatus_name[int32(tenant.ActivityStatus)]
+		if !ok {
+			return nil, fmt.Errorf("unknown tenant activity status %d", tenant.ActivityStatus)
+		}
+		activityStatus = strings.TrimPrefix(name, "TENANT_ACTIVITY_STATUS_")
+	}
+
+	return &models.Tenant{
+		Name:           tenant.Name,
+		ActivityStatus: activityStatus,
+	}, nil
+}
+
+func tenantsToGRPC(tenants []*models.Tenant) ([]*pb.Tenant, error) {
+	retTenants := make([]*pb.Tenant, len(tenants))
+	for i, tenant := range tenants {
+		tenantGRPC, err := tenantToGRPC(tenant)
+		if err != nil {
+			return nil, err
+		}
+		retTenants[i] = tenantGRPC
+	}
+	return retTenants, nil
+}
+
 func tenantToGRPC(tenant *models.Tenant) (*pb.Tenant, error) {
 	status, ok := pb.TenantActivityStatus_value[fmt.Sprintf("TENANT_ACTIVITY_STATUS_%s", tenant.ActivityStatus)]
 	if !ok {
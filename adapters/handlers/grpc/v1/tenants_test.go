@@ -12,12 +12,18 @@
 package v1
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/weaviate/weaviate/cluster/types"
 	"github.com/weaviate/weaviate/entities/models"
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	schemaManager "github.com/weaviate/weaviate/usecases/schema"
 )
 
 func TestGRPCTenants(t *testing.T) {
@@ -58,3 +64,80 @@ func TestGRPCTenants(t *testing.T) {
 		})
 	}
 }
+
+func TestGRPCTenantFromRequest(t *testing.T) {
+	t.Run("explicit activity status", func(t *testing.T) {
+		tenant, err := tenantFromGRPC(&pb.Tenant{
+			Name:           "TestTenant",
+			ActivityStatus: pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_COLD,
+		})
+		require.Nil(t, err)
+		require.Equal(t, "TestTenant", tenant.Name)
+		require.Equal(t, models.TenantActivityStatusCOLD, tenant.ActivityStatus)
+	})
+
+	t.Run("unspecified activity status", func(t *testing.T) {
+		tenant, err := tenantFromGRPC(&pb.Tenant{Name: "TestTenant"})
+		require.Nil(t, err)
+		require.Equal(t, "", tenant.ActivityStatus)
+	})
+
+	t.Run("missing name", func(t *testing.T) {
+		_, err := tenantFromGRPC(&pb.Tenant{})
+		require.NotNil(t, err)
+	})
+
+	t.Run("missing tenants", func(t *testing.T) {
+		_, err := tenantsFromGRPC("TestClass", nil)
+		require.NotNil(t, err)
+	})
+}
+
+func TestTenantsRPCs(t *testing.T) {
+	ctx := context.Background()
+	notFound := fmt.Errorf("class %q: %w", "Unknown", schemaManager.ErrNotFound)
+
+	t.Run("create", func(t *testing.T) {
+		fake := &fakeSchemaHandler{}
+		s := newTestService(fake)
+
+		reply, err := s.TenantsCreate(ctx, &pb.TenantsCreateRequest{
+			Collection: "Article",
+			Tenants: []*pb.Tenant{
+				{Name: "hot"},
+				{Name: "cold", ActivityStatus: pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_COLD},
+			},
+		})
+		require.Nil(t, err)
+		require.Len(t, reply.Tenants, 2)
+		require.Equal(t, pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_HOT, reply.Tenants[0].ActivityStatus)
+		require.Equal(t, pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_COLD, reply.Tenants[1].ActivityStatus)
+		require.Len(t, fake.addedTenants, 2)
+
+		_, err = s.TenantsCreate(ctx, &pb.TenantsCreateRequest{Collection: "Article"})
+		require.NotNil(t, err)
+
+		fake.err = notFound
+		_, err = s.TenantsCreate(ctx, &pb.TenantsCreateRequest{
+			Collection: "Unknown",
+			Tenants:    []*pb.Tenant{{Name: "hot"}},
+		})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		fake := &fakeSchemaHandler{}
+		s := newTestService(fake)
+
+		_, err := s.TenantsDelete(ctx, &pb.TenantsDeleteRequest{Collection: "Article", Tenants: []string{"hot"}})
+		require.Nil(t, err)
+		require.Equal(t, []string{"hot"}, fake.deletedTenants)
+
+		_, err = s.TenantsDelete(ctx, &pb.TenantsDeleteRequest{Collection: "Article"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		fake.err = notFound
+		_, err = s.TenantsDelete(ctx, &pb.TenantsDeleteRequest{Collection: "Unknown", Tenants: []string{"hot"}})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/weaviate/weaviate/cluster/router/types"
 	"github.com/weaviate/weaviate/entities/models"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
@@ -65,6 +66,7 @@ type indices struct {
 	regexpReferences          *regexp.Regexp
 	regexpShardsQueueSize     *regexp.Regexp
 	regexpShardsStatus        *regexp.Regexp
+	regexpShardReindexed      *regexp.Regexp
 	regexpShardFiles          *regexp.Regexp
 	regexpShardFileMetadata   *regexp.Regexp
 	regexpShard               *regexp.Regexp
@@ -109,6 +111,8 @@ const (
 		`\/shards\/(` + sh + `)\/queuesize`
 	urlPatternShardsStatus = `\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/status`
+	urlPatternShardReindexed = `\/indices\/(` + cl + `)` +
+		`\/shards\/(` + sh + `)\/reindexed\/([A-Za-z_]+)`
 	urlPatternShardFiles = `\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/files/(.*)`
 	urlPatternShardFileMetadata = `\/indices\/(` + cl + `)` +
@@ -159,6 +163,7 @@ type shards interface {
 		uuids []strfmt.UUID, deletionTime time.Time, dryRun bool, schemaVersion uint64) objects.BatchSimpleObjects
 	GetShardQueueSize(ctx context.Context, indexName, shardName string) (int64, error)
 	GetShardStatus(ctx context.Context, indexName, shardName string) (string, error)
+	GetShardReindexed(ctx context.Context, indexName, shardName, task string) (bool, error)
 	UpdateShardStatus(ctx context.Context, indexName, shardName,
 		targetStatus string, schemaVersion uint64) error
 
@@ -216,6 +221,7 @@ func NewIndices(shards shards, db db, auth auth, maintenanceModeEnabled func() b
 		regexpReferences:                 regexp.MustCompile(urlPatternReferences),
 		regexpShardsQueueSize:            regexp.MustCompile(urlPatternShardsQueueSize),
 		regexpShardsStatus:               regexp.MustCompile(urlPatternShardsStatus),
+		regexpShardReindexed:             regexp.MustCompile(urlPatternShardReindexed),
 		regexpShardFiles:                 regexp.MustCompile(urlPatternShardFiles),
 		regexpShardFileMetadata:          regexp.MustCompile(urlPatternShardFileMetadata),
 		regexpShard:                      regexp.MustCompile(urlPatternShard),
@@ -355,6 +361,13 @@ func (i *indices) indicesHandler() http.HandlerFunc {
 			}
 			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
 			return
+		case i.regexpShardReindexed.MatchString(path):
+			if r.Method == http.MethodGet {
+				i.getGetShardReindexed().ServeHTTP(w, r)
+				return
+			}
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
 
 		case i.regexpShardFiles.MatchString(path):
 			if r.Method == http.MethodPost {
@@ -797,7 +810,7 @@ func (i *indices) postSearchObjects() http.Handler {
 			return
 		}
 
-		vector, targetVector, certainty, limit, filters, keywordRanking, sort, cursor, groupBy, additional, targetCombination, props, err := IndicesPayloads.SearchParams.
+		vector, targetVector, certainty, limit, filters, keywordRanking, sort, cursor, groupBy, additional, targetCombination, props, multivectorAggregation, snapshot, err := IndicesPayloads.SearchParams.
 			Unmarshal(reqPayload)
 		if err != nil {
 			http.Error(w, "unmarshal search params from json: "+err.Error(),
@@ -810,7 +823,15 @@ func (i *indices) postSearchObjects() http.Handler {
 			"action": "Search",
 		}).Debug("searching ...")
 
-		results, dists, err := i.shards.Search(r.Context(), index, shard,
+		ctx := r.Context()
+		if multivectorAggregation != "" {
+			ctx = hnswent.ContextWithMultivectorAggregation(ctx, multivectorAggregation)
+		}
+		if snapshot != "" {
+			ctx = dto.ContextWithReadSnapshot(ctx, snapshot)
+		}
+
+		results, dists, err := i.shards.Search(ctx, index, shard,
 			vector, targetVector, certainty, limit, filters, keywordRanking, sort, cursor, groupBy, additional, targetCombination, props)
 		if err != nil && errors.As(err, &enterrors.ErrUnprocessable{}) {
 			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
@@ -1317,6 +1338,45 @@ func (i *indices) getGetShardStatus() http.Handler {
 	})
 }
 
+func (i *indices) getGetShardReindexed() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpShardReindexed.FindStringSubmatch(r.URL.Path)
+		if len(args) != 4 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard, task := args[1], args[2], args[3]
+
+		defer r.Body.Close()
+
+		i.logger.WithFields(logrus.Fields{
+			"shard":  shard,
+			"task":   task,
+			"action": "GetShardReindexed",
+		}).Debug("getting shard reindex state ...")
+
+		reindexed, err := i.shards.GetShardReindexed(r.Context(), index, shard, task)
+		if err != nil && errors.As(err, &enterrors.ErrUnprocessable{}) {
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		reindexedBytes, err := IndicesPayloads.GetShardReindexedResults.Marshal(reindexed)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.GetShardReindexedResults.SetContentTypeHeader(w)
+		w.Write(reindexedBytes)
+	})
+}
+
 func (i *indices) postUpdateShardStatus() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := i.regexpShardsStatus.FindStringSubmatch(r.URL.Path)
@@ -59,6 +59,7 @@ type indicesPayloads struct {
 	GetShardQueueSizeResults   getShardQueueSizeResultsPayload
 	GetShardStatusParams       getShardStatusParamsPayload
 	GetShardStatusResults      getShardStatusResultsPayload
+	GetShardReindexedResults   getShardReindexedResultsPayload
 	UpdateShardStatusParams    updateShardStatusParamsPayload
 	UpdateShardsStatusResults  updateShardsStatusResultsPayload
 	ShardFiles                 shardFilesPayload
@@ -471,6 +472,11 @@ type searchParametersPayload struct {
 	TargetVectors     []string                     `json:"TargetVectors"`
 	TargetCombination *dto.TargetCombination       `json:"targetCombination"`
 	Properties        []string                     `json:"properties"`
+	// MultivectorAggregation overrides the configured aggregation of multi
+	// vector distances for this search
+	MultivectorAggregation string `json:"multivectorAggregation,omitempty"`
+	// Snapshot is the id of the point-in-time snapshot the shard is read from
+	Snapshot string `json:"snapshot,omitempty"`
 }
 
 func (p *searchParametersPayload) UnmarshalJSON(data []byte) error {
@@ -526,6 +532,7 @@ func (p searchParamsPayload) Marshal(vectors []models.Vector, targetVectors []st
 	filter *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking,
 	sort []filters.Sort, cursor *filters.Cursor, groupBy *searchparams.GroupBy,
 	addP additional.Properties, targetCombination *dto.TargetCombination, properties []string,
+	multivectorAggregation, snapshot string,
 ) ([]byte, error) {
 	var vector []float32
 	var targetVector string
@@ -538,13 +545,13 @@ func (p searchParamsPayload) Marshal(vectors []models.Vector, targetVectors []st
 		}
 	}
 
-	par := searchParametersPayload{vector, targetVector, distance, limit, filter, keywordRanking, sort, cursor, groupBy, addP, vectors, targetVectors, targetCombination, properties}
+	par := searchParametersPayload{vector, targetVector, distance, limit, filter, keywordRanking, sort, cursor, groupBy, addP, vectors, targetVectors, targetCombination, properties, multivectorAggregation, snapshot}
 	return json.Marshal(par)
 }
 
 func (p searchParamsPayload) Unmarshal(in []byte) ([]models.Vector, []string, float32, int,
 	*filters.LocalFilter, *searchparams.KeywordRanking, []filters.Sort,
-	*filters.Cursor, *searchparams.GroupBy, additional.Properties, *dto.TargetCombination, []string, error,
+	*filters.Cursor, *searchparams.GroupBy, additional.Properties, *dto.TargetCombination, []string, string, string, error,
 ) {
 	var par searchParametersPayload
 	err := json.Unmarshal(in, &par)
@@ -555,7 +562,8 @@ func (p searchParamsPayload) Unmarshal(in []byte) ([]models.Vector, []string, fl
 	}
 
 	return par.SearchVectors, par.TargetVectors, par.Distance, par.Limit,
-		par.Filters, par.KeywordRanking, par.Sort, par.Cursor, par.GroupBy, par.Additional, par.TargetCombination, par.Properties, err
+		par.Filters, par.KeywordRanking, par.Sort, par.Cursor, par.GroupBy, par.Additional, par.TargetCombination, par.Properties,
+		par.MultivectorAggregation, par.Snapshot, err
 }
 
 func (p searchParamsPayload) MIME() string {
@@ -924,6 +932,31 @@ func (p getShardStatusResultsPayload) CheckContentTypeHeader(r *http.Response) (
 	return ct, ct == p.MIME()
 }
 
+type getShardReindexedResultsPayload struct{}
+
+func (p getShardReindexedResultsPayload) Unmarshal(in []byte) (bool, error) {
+	var out bool
+	err := json.Unmarshal(in, &out)
+	return out, err
+}
+
+func (p getShardReindexedResultsPayload) Marshal(in bool) ([]byte, error) {
+	return json.Marshal(in)
+}
+
+func (p getShardReindexedResultsPayload) MIME() string {
+	return "application/vnd.weaviate.getshardreindexedresults+json"
+}
+
+func (p getShardReindexedResultsPayload) SetContentTypeHeader(w http.ResponseWriter) {
+	w.Header().Set("content-type", p.MIME())
+}
+
+func (p getShardReindexedResultsPayload) CheckContentTypeHeader(r *http.Response) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
 type updateShardStatusParamsPayload struct{}
 
 func (p updateShardStatusParamsPayload) Marshal(targetStatus string) ([]byte, error) {
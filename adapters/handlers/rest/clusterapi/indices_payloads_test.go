@@ -167,10 +167,10 @@ func TestBackwardCompatibilitySearch(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run("test", func(t *testing.T) {
-			b126, err := payload.Marshal(tt.SearchVectors, tt.Targets, 0.7, 10, nil, nil, nil, nil, nil, additional.Properties{}, nil, nil)
+			b126, err := payload.Marshal(tt.SearchVectors, tt.Targets, 0.7, 10, nil, nil, nil, nil, nil, additional.Properties{}, nil, nil, "", "")
 			require.Nil(t, err)
 
-			vecs, targets, _, _, _, _, _, _, _, _, _, _, err := payload.Unmarshal(b126)
+			vecs, targets, _, _, _, _, _, _, _, _, _, _, _, _, err := payload.Unmarshal(b126)
 			require.Nil(t, err)
 			assert.Equal(t, tt.SearchVectors, vecs)
 			assert.Equal(t, tt.Targets, targets)
@@ -184,7 +184,7 @@ func TestBackwardCompatibilitySearch(t *testing.T) {
 				assert.Equal(t, tt.SearchVectors[0], vecsOld)
 				assert.Equal(t, tt.Targets[0], targetsOld)
 
-				vecs, targets, _, _, _, _, _, _, _, _, _, _, err := payload.Unmarshal(b125)
+				vecs, targets, _, _, _, _, _, _, _, _, _, _, _, _, err := payload.Unmarshal(b125)
 				require.Nil(t, err)
 				assert.Equal(t, tt.SearchVectors, vecs)
 				assert.Equal(t, tt.Targets, targets)
@@ -277,3 +277,34 @@ func Test_searchParametersPayload_Unmarshal(t *testing.T) {
 		})
 	}
 }
+
+func TestSearchParamsPayloadMultivectorAggregation(t *testing.T) {
+	payload := searchParamsPayload{}
+	vectors := []models.Vector{[][]float32{{1, 2}, {3, 4}}}
+
+	b, err := payload.Marshal(vectors, []string{"colpali"}, 0, 10, nil, nil, nil, nil, nil, additional.Properties{}, nil, nil, "topKMean", "")
+	require.Nil(t, err)
+	_, _, _, _, _, _, _, _, _, _, _, _, aggregation, _, err := payload.Unmarshal(b)
+	require.Nil(t, err)
+	assert.Equal(t, "topKMean", aggregation)
+
+	// searches without an override keep the previous payload
+	b, err = payload.Marshal(vectors, []string{"colpali"}, 0, 10, nil, nil, nil, nil, nil, additional.Properties{}, nil, nil, "", "")
+	require.Nil(t, err)
+	assert.NotContains(t, string(b), "multivectorAggregation")
+}
+
+func TestSearchParamsPayloadSnapshot(t *testing.T) {
+	payload := searchParamsPayload{}
+	vectors := []models.Vector{[]float32{1, 2}}
+
+	b, err := payload.Marshal(vectors, []string{""}, 0, 10, nil, nil, nil, nil, nil, additional.Properties{}, nil, nil, "", "export")
+	require.Nil(t, err)
+	_, _, _, _, _, _, _, _, _, _, _, _, _, snapshot, err := payload.Unmarshal(b)
+	require.Nil(t, err)
+	assert.Equal(t, "export", snapshot)
+
+	b, err = payload.Marshal(vectors, []string{""}, 0, 10, nil, nil, nil, nil, nil, additional.Properties{}, nil, nil, "", "")
+	require.Nil(t, err)
+	assert.NotContains(t, string(b), "snapshot")
+}
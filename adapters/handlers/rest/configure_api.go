@@ -56,6 +56,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/classifications"
 	"github.com/weaviate/weaviate/adapters/repos/db"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/roaringset"
 	modulestorage "github.com/weaviate/weaviate/adapters/repos/modules"
 	schemarepo "github.com/weaviate/weaviate/adapters/repos/schema"
@@ -144,8 +145,10 @@ import (
 	"github.com/weaviate/weaviate/usecases/build"
 	"github.com/weaviate/weaviate/usecases/classification"
 	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/coldstorage"
 	"github.com/weaviate/weaviate/usecases/config"
 	configRuntime "github.com/weaviate/weaviate/usecases/config/runtime"
+	"github.com/weaviate/weaviate/usecases/encryption"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
@@ -379,6 +382,19 @@ func MakeAppState(ctx, serverShutdownCtx context.Context, options *swag.CommandL
 	remoteIndexClient := clients.NewRemoteIndex(appState.ClusterHttpClient)
 	remoteNodesClient := clients.NewRemoteNode(appState.ClusterHttpClient)
 	replicationClient := clients.NewReplicationClient(appState.ClusterHttpClient)
+	encryptor, err := newEncryptor(appState.ServerConfig.Config.EncryptionAtRest)
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("could not initialize encryption at rest")
+	}
+	segmentTiering, err := newSegmentTiering(appState.ServerConfig.Config.SegmentTiering,
+		appState.Cluster.LocalName())
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("could not initialize segment tiering")
+	}
 	repo, err := db.New(appState.Logger, appState.Cluster.LocalName(), db.Config{
 		ServerVersion:                       config.ServerVersion,
 		GitHash:                             build.Revision,
@@ -441,8 +457,12 @@ func MakeAppState(ctx, serverShutdownCtx context.Context, options *swag.CommandL
 		InvertedSorterDisabled:                       appState.ServerConfig.Config.InvertedSorterDisabled,
 		MaintenanceModeEnabled:                       appState.Cluster.MaintenanceModeEnabledForLocalhost,
 		AsyncIndexingEnabled:                         appState.ServerConfig.Config.AsyncIndexingEnabled,
+		ChangeStreamRetention:                        appState.ServerConfig.Config.ChangeStreamRetention,
+		RecallMonitoring:                             appState.ServerConfig.Config.RecallMonitoring,
 		HFreshEnabled:                                appState.ServerConfig.Config.HFreshEnabled,
 		OperationalMode:                              appState.ServerConfig.Config.OperationalMode,
+		Encryption:                                   encryptor,
+		SegmentTiering:                               segmentTiering,
 	}, remoteIndexClient, appState.Cluster, remoteNodesClient, replicationClient, appState.Metrics, appState.MemWatch, nil, nil, nil) // TODO client
 	if err != nil {
 		appState.Logger.
@@ -647,6 +667,10 @@ func MakeAppState(ctx, serverShutdownCtx context.Context, options *swag.CommandL
 	}
 
 	appState.SchemaManager = schemaManager
+	if appState.ServerConfig.Config.DistributedTasks.Enabled {
+		schemaManager.SetVectorReindexTasks(appState.ClusterService.Raft)
+	}
+	schemaManager.SetVectorIndexMaintainer(migrator)
 	repo.SetNodeSelector(appState.ClusterService.NodeSelector())
 	repo.SetSchemaReader(appState.ClusterService.SchemaReader())
 	repo.SetReplicationFSM(appState.ClusterService.ReplicationFsm())
@@ -776,11 +800,14 @@ func MakeAppState(ctx, serverShutdownCtx context.Context, options *swag.CommandL
 		appState.DistributedTaskScheduler = distributedtask.NewScheduler(distributedtask.SchedulerParams{
 			CompletionRecorder: appState.ClusterService.Raft,
 			TasksLister:        appState.ClusterService.Raft,
-			Providers:          map[string]distributedtask.Provider{},
-			Logger:             appState.Logger,
-			MetricsRegisterer:  metricsRegisterer,
-			LocalNode:          appState.Cluster.LocalName(),
-			TickInterval:       appState.ServerConfig.Config.DistributedTasks.SchedulerTickInterval,
+			TaskCleaner:        appState.ClusterService.Raft,
+			Providers: map[string]distributedtask.Provider{
+				vectorIndex.ReindexTaskNamespace: db.NewVectorReindexTaskProvider(repo, appState.Logger),
+			},
+			Logger:            appState.Logger,
+			MetricsRegisterer: metricsRegisterer,
+			LocalNode:         appState.Cluster.LocalName(),
+			TickInterval:      appState.ServerConfig.Config.DistributedTasks.SchedulerTickInterval,
 
 			// Using a single global value for now to keep it simple. If there is a need
 			// this can be changed to provide a value per provider.
@@ -831,6 +858,15 @@ func configureReindexer(appState *state.State, reindexCtx context.Context) db.Sh
 		))
 	}
 
+	if cfg.ReindexSearchablePositionsAtStartup {
+		tasks = append(tasks, db.NewShardInvertedReindexTaskSearchablePositions(
+			logger,
+			time.Second*time.Duration(cfg.ReindexSearchablePositionsConfig.ProcessingDurationSeconds),
+			time.Second*time.Duration(cfg.ReindexSearchablePositionsConfig.PauseDurationSeconds),
+			cfg.ReindexSearchablePositionsConfig.Collections, appState.SchemaManager,
+		))
+	}
+
 	if len(tasks) == 0 {
 		return db.NewShardReindexerV3Noop()
 	}
@@ -1061,10 +1097,10 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 
 	setupSchemaHandlers(api, appState.SchemaManager, appState.Metrics, appState.Logger)
 	setupAliasesHandlers(api, appState.SchemaManager, appState.Metrics, appState.Logger)
-	objectsManager := objects.NewManager(appState.SchemaManager, appState.ServerConfig, appState.Logger,
+	appState.ObjectsManager = objects.NewManager(appState.SchemaManager, appState.ServerConfig, appState.Logger,
 		appState.Authorizer, appState.DB, appState.Modules,
 		objects.NewMetrics(appState.Metrics), appState.MemWatch, appState.AutoSchemaManager)
-	setupObjectHandlers(api, objectsManager, appState.ServerConfig.Config, appState.Logger,
+	setupObjectHandlers(api, appState.ObjectsManager, appState.ServerConfig.Config, appState.Logger,
 		appState.Modules, appState.Metrics)
 	setupObjectBatchHandlers(api, appState.BatchManager, appState.Metrics, appState.Logger)
 	setupGraphQLHandlers(api, appState, appState.SchemaManager, appState.ServerConfig.Config.DisableGraphQL,
@@ -2247,3 +2283,71 @@ func postInitRuntimeOverrides(appState *state.State, cm *configRuntime.ConfigMan
 		}, appState.Logger)
 	}
 }
+
+// newSegmentTiering returns the tiering of the segments per collection, it is
+// nil if the segment tiering is disabled. The objects of the node are kept
+// apart by its name.
+func newSegmentTiering(cfg config.SegmentTieringConfig, nodeName string,
+) (func(collection string) *lsmkv.SegmentTiering, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	var backend coldstorage.Backend
+	switch cfg.Backend {
+	case "filesystem":
+		fs, err := coldstorage.NewFilesystem(cfg.FilesystemPath)
+		if err != nil {
+			return nil, err
+		}
+		backend = fs
+	case "s3":
+		s3, err := coldstorage.NewS3(coldstorage.S3Config{
+			Endpoint: cfg.S3Endpoint,
+			Bucket:   cfg.S3Bucket,
+			Path:     cfg.S3Path,
+			UseSSL:   cfg.S3UseSSL,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := s3.VerifyBucket(context.Background()); err != nil {
+			return nil, err
+		}
+		backend = s3
+	default:
+		return nil, fmt.Errorf("unsupported backend %q", cfg.Backend)
+	}
+
+	tiering := &lsmkv.SegmentTiering{
+		Backend:        backend,
+		KeyPrefix:      nodeName,
+		MinAge:         cfg.MinAge,
+		MinSegmentSize: cfg.MinSegmentSize,
+		CacheTTL:       cfg.CacheTTL,
+		FetchTimeout:   cfg.FetchTimeout,
+	}
+	return func(collection string) *lsmkv.SegmentTiering {
+		if !cfg.IsTiered(collection) {
+			return nil
+		}
+		return tiering
+	}, nil
+}
+
+// newEncryptor returns nil if the encryption at rest is disabled
+func newEncryptor(cfg config.EncryptionAtRestConfig) (*encryption.Encryptor, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	switch cfg.KeyProvider {
+	case "local":
+		provider, err := encryption.NewLocalKeyProvider(cfg.LocalKeyfile)
+		if err != nil {
+			return nil, err
+		}
+		return encryption.New(provider), nil
+	default:
+		return nil, fmt.Errorf("unsupported key provider %q", cfg.KeyProvider)
+	}
+}
@@ -4948,6 +4948,61 @@ func init() {
         ]
       }
     },
+    "/schema/{className}/properties/{propertyName}": {
+      "delete": {
+        "summary": "Delete a property from a collection",
+        "description": "Removes a property from the definition of a collection. WARNING: This action permanently deletes the values of the property of all data objects stored within the collection.",
+        "operationId": "schema.objects.properties.delete",
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ],
+        "tags": [
+          "schema"
+        ],
+        "parameters": [
+          {
+            "name": "className",
+            "description": "The name of the collection (class) containing the property.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "propertyName",
+            "description": "The name of the property to delete.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Property deleted successfully."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The collection or property does not exist, or the property cannot be deleted.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error occurred while deleting the property. Check the ErrorResponse for details.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
     "/schema/{className}/shards": {
       "get": {
         "description": "Retrieves the status of all shards associated with the specified collection (` + "`" + `className` + "`" + `). For multi-tenant collections, use the ` + "`" + `tenant` + "`" + ` query parameter to retrieve status for a specific tenant's shards.",
@@ -5078,6 +5133,218 @@ func init() {
         ]
       }
     },
+    "/schema/{className}/shards/{shardName}/vectors/{vectorName}/maintenance": {
+      "get": {
+        "summary": "Get the maintenance status of a vector index",
+        "description": "Returns whether the maintenance cycles of the vector index of a named vector of a shard on this node are paused, together with its recent maintenance operations and their progress, the most recent first.",
+        "operationId": "schema.objects.vectors.maintenance.get",
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ],
+        "tags": [
+          "schema"
+        ],
+        "parameters": [
+          {
+            "name": "className",
+            "description": "The name of the collection (class) containing the shard.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "shardName",
+            "description": "The name of the shard on this node.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "vectorName",
+            "description": "The name of the named vector, or ` + "`" + `default` + "`" + ` for a collection without named vectors.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Maintenance status of the vector index.",
+            "schema": {
+              "$ref": "#/definitions/VectorIndexMaintenanceStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The collection, shard or named vector does not exist on this node.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error occurred while retrieving the maintenance status. Check the ErrorResponse for details.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "post": {
+        "summary": "Start a vector index maintenance operation",
+        "description": "Starts a maintenance operation on the vector index of a named vector of a shard on this node. ` + "`" + `tombstoneCleanup` + "`" + `, ` + "`" + `condense` + "`" + `, ` + "`" + `snapshot` + "`" + ` and ` + "`" + `rebuild` + "`" + ` run in the background, their progress is reported by the maintenance status. ` + "`" + `pause` + "`" + ` and ` + "`" + `resume` + "`" + ` stop and restart the maintenance cycles of the vector index right away.",
+        "operationId": "schema.objects.vectors.maintenance.start",
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ],
+        "tags": [
+          "schema"
+        ],
+        "parameters": [
+          {
+            "name": "className",
+            "description": "The name of the collection (class) containing the shard.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "shardName",
+            "description": "The name of the shard on this node.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "vectorName",
+            "description": "The name of the named vector, or ` + "`" + `default` + "`" + ` for a collection without named vectors.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "description": "The maintenance operation to start.",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/VectorIndexMaintenanceRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Maintenance operation started successfully.",
+            "schema": {
+              "$ref": "#/definitions/VectorIndexMaintenanceOperation"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The collection, shard or named vector does not exist on this node.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The operation is unknown, not supported by the vector index or another operation is still running.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error occurred while starting the maintenance operation. Check the ErrorResponse for details.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/schema/{className}/shards/{shardName}/vectors/{vectorName}/maintenance/{operationId}": {
+      "delete": {
+        "summary": "Cancel a vector index maintenance operation",
+        "description": "Cancels a running maintenance operation on the vector index of a named vector of a shard on this node. The request returns right away, the operation reports the status ` + "`" + `CANCELLED` + "`" + ` once it stopped. A tombstone cleanup stops after the node it is processing and keeps the tombstones removed so far. Condensing stops before the next commit log file. A snapshot which is being written is not interrupted. A cancelled rebuild keeps the current vector index and drops the partly built one. Cancelling a finished operation has no effect. Operations are only tracked while the shard is loaded, a rebuild interrupted by a shutdown continues once the shard is loaded again.",
+        "operationId": "schema.objects.vectors.maintenance.cancel",
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ],
+        "tags": [
+          "schema"
+        ],
+        "parameters": [
+          {
+            "name": "className",
+            "description": "The name of the collection (class) containing the shard.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "shardName",
+            "description": "The name of the shard on this node.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "vectorName",
+            "description": "The name of the named vector, or ` + "`" + `default` + "`" + ` for a collection without named vectors.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "operationId",
+            "description": "The ID of the maintenance operation.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Maintenance operation cancelled successfully."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The collection, shard, named vector or operation does not exist on this node.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error occurred while cancelling the maintenance operation. Check the ErrorResponse for details.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
     "/schema/{className}/tenants": {
       "get": {
         "description": "Retrieves a list of all tenants currently associated with the specified collection.",
@@ -5439,6 +5706,70 @@ func init() {
         }
       }
     },
+    "/schema/{className}/vectors/{vectorName}/reindex": {
+      "post": {
+        "summary": "Rebuild the vector index of a named vector",
+        "description": "Moves a named vector to a vector index of another type, distance or quantizer. Every shard builds the new index in the background from the vectors already stored, encoding them with the quantizer of the new index, and swaps it in once complete, searches are served by the previous index until then. Compression is enabled in place by updating the collection, a reindex is only needed to switch to another quantizer, e.g. from ` + "`" + `pq` + "`" + ` to ` + "`" + `rq` + "`" + `; compression can not be disabled and the quantizer of a flat index can not be switched. The progress is reported per shard in the verbose nodes status and, if distributed tasks are enabled, per node in the ` + "`" + `vector-reindex` + "`" + ` task.",
+        "operationId": "schema.objects.vectors.reindex",
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ],
+        "tags": [
+          "schema"
+        ],
+        "parameters": [
+          {
+            "name": "className",
+            "description": "The name of the collection (class) containing the named vector.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "vectorName",
+            "description": "The name of the named vector to reindex.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "description": "The vector index type and configuration to rebuild the named vector into.",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/VectorConfig"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The rebuild of the vector index was started."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The collection or named vector does not exist, or the vector index configuration is invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error occurred while starting the rebuild. Check the ErrorResponse for details.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
     "/tasks": {
       "get": {
         "tags": [
@@ -6677,9 +7008,22 @@ func init() {
         }
       }
     },
+    "ChangeStreamConfig": {
+      "description": "Configuration of the change stream of a collection",
+      "properties": {
+        "enabled": {
+          "description": "Whether or not all object writes of this collection are recorded in a per-shard change stream, which can be followed through the gRPC ` + "`" + `ChangesStream` + "`" + ` RPC. Can only be set when the collection is created (default: ` + "`" + `false` + "`" + `).",
+          "type": "boolean",
+          "x-omitempty": false
+        }
+      }
+    },
     "Class": {
       "type": "object",
       "properties": {
+        "changeStreamConfig": {
+          "$ref": "#/definitions/ChangeStreamConfig"
+        },
         "class": {
           "description": "Name of the collection (formerly 'class') (required). Multiple words should be concatenated in CamelCase, e.g. ` + "`" + `ArticleAuthor` + "`" + `.",
           "type": "string"
@@ -6698,6 +7042,9 @@ func init() {
         "multiTenancyConfig": {
           "$ref": "#/definitions/MultiTenancyConfig"
         },
+        "objectStorageConfig": {
+          "$ref": "#/definitions/ObjectStorageConfig"
+        },
         "objectTtlConfig": {
           "$ref": "#/definitions/ObjectTtlConfig"
         },
@@ -7010,6 +7357,15 @@ func init() {
           "description": "The ID of the task.",
           "type": "string"
         },
+        "nodesProgress": {
+          "description": "The fraction of the task completed by each node, for tasks which report their progress.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "number",
+            "format": "float"
+          },
+          "x-omitempty": true
+        },
         "payload": {
           "description": "The payload of the task.",
           "type": "object"
@@ -7039,6 +7395,46 @@ func init() {
         }
       }
     },
+    "EncryptionStatus": {
+      "description": "The encryption at rest of the segments, write-ahead-logs and vector index commit logs of a shard.",
+      "properties": {
+        "currentKeyId": {
+          "description": "The id of the key new files are encrypted with.",
+          "type": "string"
+        },
+        "enabled": {
+          "description": "Whether files are encrypted at rest.",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "encryptedFiles": {
+          "description": "The number of encrypted files.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "keyIds": {
+          "description": "The ids of the keys the files of the shard are encrypted with.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": false
+        },
+        "plaintextFiles": {
+          "description": "The number of files which were written before the encryption was enabled.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "staleFiles": {
+          "description": "The number of files encrypted with another key than the current one, they are rewritten with the current key in the background.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
     "ErrorResponse": {
       "description": "An error response returned by Weaviate endpoints.",
       "type": "object",
@@ -7144,6 +7540,13 @@ func init() {
             "$ref": "#/definitions/GraphQLError"
           },
           "x-omitempty": true
+        },
+        "extensions": {
+          "description": "GraphQL extensions object, contains the facets of the queried classes.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/JsonObject"
+          }
         }
       }
     },
@@ -7177,6 +7580,10 @@ func init() {
           "description": "Index each object with the null state (default: ` + "`" + `false` + "`" + `).",
           "type": "boolean"
         },
+        "indexPositions": {
+          "description": "Index the positions of the terms of searchable properties, which is required for phrase and proximity queries (default: ` + "`" + `false` + "`" + `).",
+          "type": "boolean"
+        },
         "indexPropertyLength": {
           "description": "Index length of properties (default: ` + "`" + `false` + "`" + `).",
           "type": "boolean"
@@ -7348,6 +7755,11 @@ func init() {
           "type": "boolean",
           "x-omitempty": false
         },
+        "encryptionStatus": {
+          "description": "The encryption at rest of the files of the shard, only set if the encryption is enabled.",
+          "type": "object",
+          "$ref": "#/definitions/EncryptionStatus"
+        },
         "loaded": {
           "description": "The load status of the shard.",
           "type": "boolean",
@@ -7392,6 +7804,13 @@ func init() {
           "type": "number",
           "format": "int64",
           "x-omitempty": false
+        },
+        "vectorReindexStatus": {
+          "description": "The status of the named vector indexes being rebuilt into another index type or distance.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VectorReindexStatus"
+          }
         }
       }
     },
@@ -7524,6 +7943,15 @@ func init() {
         }
       }
     },
+    "ObjectStorageConfig": {
+      "description": "Configuration of how the objects of a collection are stored",
+      "properties": {
+        "compression": {
+          "description": "Compression of the stored objects: ` + "`" + `none` + "`" + `, ` + "`" + `zstd` + "`" + ` or ` + "`" + `snappy` + "`" + `. ` + "`" + `zstd` + "`" + ` has the best ratio, ` + "`" + `snappy` + "`" + ` is faster to read and write. Changing it applies to the data written afterwards, existing data is converted when it is compacted (default: ` + "`" + `none` + "`" + `).",
+          "type": "string"
+        }
+      }
+    },
     "ObjectTtlConfig": {
       "description": "Configuration of objects' time-to-live",
       "properties": {
@@ -8756,6 +9184,28 @@ func init() {
         }
       }
     },
+    "SparseVector": {
+      "description": "A sparse vector given as parallel lists of dimension indices and their weights, as produced by learned sparse embedding models.",
+      "type": "object",
+      "properties": {
+        "indices": {
+          "description": "The dimension indices of the non-zero entries.",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "uint32"
+          }
+        },
+        "values": {
+          "description": "The weights of the non-zero entries, in the same order as the indices.",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float"
+          }
+        }
+      }
+    },
     "Statistics": {
       "description": "The definition of node statistics.",
       "properties": {
@@ -8952,20 +9402,111 @@ func init() {
       "description": "A vector representation of the object. If provided at object creation, this wil take precedence over any vectorizer setting.",
       "type": "object"
     },
-    "VectorConfig": {
-      "type": "object",
+    "VectorConfig": {
+      "type": "object",
+      "properties": {
+        "vectorIndexConfig": {
+          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
+          "type": "object"
+        },
+        "vectorIndexType": {
+          "description": "Name of the vector index to use, eg. (HNSW)",
+          "type": "string"
+        },
+        "vectorizer": {
+          "description": "Configuration of a specific vectorizer used by this vector",
+          "type": "object"
+        }
+      }
+    },
+    "VectorIndexMaintenanceOperation": {
+      "description": "A maintenance operation on the vector index of a named vector of a shard.",
+      "properties": {
+        "error": {
+          "description": "The error which stopped the operation, if it failed.",
+          "type": "string"
+        },
+        "finishTimeUnix": {
+          "description": "The time the operation finished at, in milliseconds since epoch. Not set while it is running.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "description": "The id of the operation, used to cancel it.",
+          "type": "string"
+        },
+        "operation": {
+          "description": "The operation, one of tombstoneCleanup, condense, snapshot, rebuild, pause or resume.",
+          "type": "string"
+        },
+        "progress": {
+          "description": "The share of the operation which is done, between 0 and 1.",
+          "type": "number",
+          "format": "float64",
+          "x-omitempty": false
+        },
+        "startTimeUnix": {
+          "description": "The time the operation started at, in milliseconds since epoch.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "status": {
+          "description": "The status of the operation: RUNNING, FINISHED, FAILED or CANCELLED.",
+          "type": "string"
+        }
+      }
+    },
+    "VectorIndexMaintenanceRequest": {
+      "description": "A maintenance operation to run on the vector index of a named vector of a shard.",
+      "properties": {
+        "operation": {
+          "description": "The operation to run: tombstoneCleanup removes deleted objects from the graph, condense condenses and combines the commit logs, snapshot creates a snapshot of the commit logs, rebuild builds the index again from the stored vectors, pause and resume stop and restart the background maintenance cycles.",
+          "type": "string"
+        }
+      }
+    },
+    "VectorIndexMaintenanceStatus": {
+      "description": "The maintenance of the vector index of a named vector of a shard.",
+      "properties": {
+        "maintenancePaused": {
+          "description": "Whether the background maintenance cycles of the vector index are paused. They stay paused when the shard is loaded again, until resumed.",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "operations": {
+          "description": "The running and most recently finished maintenance operations.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VectorIndexMaintenanceOperation"
+          },
+          "x-omitempty": false
+        }
+      }
+    },
+    "VectorReindexStatus": {
+      "description": "The status of a named vector index being rebuilt into another index type or distance.",
       "properties": {
-        "vectorIndexConfig": {
-          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
-          "type": "object"
+        "error": {
+          "description": "The error which stopped the rebuild, if it failed.",
+          "type": "string"
         },
-        "vectorIndexType": {
-          "description": "Name of the vector index to use, eg. (HNSW)",
+        "progress": {
+          "description": "The share of the objects of the shard whose vectors were added to the rebuilt index, between 0 and 1.",
+          "type": "number",
+          "format": "float64",
+          "x-omitempty": false
+        },
+        "status": {
+          "description": "The status of the rebuild, INDEXING while vectors are added and FAILED if it stopped with an error.",
           "type": "string"
         },
-        "vectorizer": {
-          "description": "Configuration of a specific vectorizer used by this vector",
-          "type": "object"
+        "targetVector": {
+          "description": "The name of the named vector whose index is rebuilt.",
+          "type": "string"
+        },
+        "vectorIndexType": {
+          "description": "The index type the named vector is rebuilt into.",
+          "type": "string"
         }
       }
     },
@@ -9009,7 +9550,9 @@ func init() {
             "ContainsAny",
             "ContainsAll",
             "ContainsNone",
-            "Not"
+            "Not",
+            "Regex",
+            "StartsWith"
           ],
           "example": "GreaterThanEqual"
         },
@@ -14306,6 +14849,61 @@ func init() {
         ]
       }
     },
+    "/schema/{className}/properties/{propertyName}": {
+      "delete": {
+        "summary": "Delete a property from a collection",
+        "description": "Removes a property from the definition of a collection. WARNING: This action permanently deletes the values of the property of all data objects stored within the collection.",
+        "operationId": "schema.objects.properties.delete",
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ],
+        "tags": [
+          "schema"
+        ],
+        "parameters": [
+          {
+            "name": "className",
+            "description": "The name of the collection (class) containing the property.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "propertyName",
+            "description": "The name of the property to delete.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Property deleted successfully."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The collection or property does not exist, or the property cannot be deleted.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error occurred while deleting the property. Check the ErrorResponse for details.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
     "/schema/{className}/shards": {
       "get": {
         "description": "Retrieves the status of all shards associated with the specified collection (` + "`" + `className` + "`" + `). For multi-tenant collections, use the ` + "`" + `tenant` + "`" + ` query parameter to retrieve status for a specific tenant's shards.",
@@ -14331,9 +14929,222 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Shard statuses retrieved successfully.",
+            "description": "Shard statuses retrieved successfully.",
+            "schema": {
+              "$ref": "#/definitions/ShardStatusList"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Collection not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error occurred while retrieving shard statuses. Check the ErrorResponse for details.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
+      }
+    },
+    "/schema/{className}/shards/{shardName}": {
+      "put": {
+        "description": "Updates the status of a specific shard within a collection (e.g., sets it to ` + "`" + `READY` + "`" + ` or ` + "`" + `READONLY` + "`" + `). This is typically used after resolving an underlying issue (like disk space) that caused a shard to become non-operational. There is also a convenience function in each client to set the status of all shards of a collection.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Update a shard status",
+        "operationId": "schema.objects.shards.update",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the collection (class) containing the shard.",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the shard to update.",
+            "name": "shardName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The shard status object containing the desired new status.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ShardStatus"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Shard status updated successfully.",
+            "schema": {
+              "$ref": "#/definitions/ShardStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Collection or shard not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid update attempt",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error occurred while updating the shard status. Check the ErrorResponse for details.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/{className}/shards/{shardName}/vectors/{vectorName}/maintenance": {
+      "get": {
+        "summary": "Get the maintenance status of a vector index",
+        "description": "Returns whether the maintenance cycles of the vector index of a named vector of a shard on this node are paused, together with its recent maintenance operations and their progress, the most recent first.",
+        "operationId": "schema.objects.vectors.maintenance.get",
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ],
+        "tags": [
+          "schema"
+        ],
+        "parameters": [
+          {
+            "name": "className",
+            "description": "The name of the collection (class) containing the shard.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "shardName",
+            "description": "The name of the shard on this node.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "vectorName",
+            "description": "The name of the named vector, or ` + "`" + `default` + "`" + ` for a collection without named vectors.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Maintenance status of the vector index.",
+            "schema": {
+              "$ref": "#/definitions/VectorIndexMaintenanceStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The collection, shard or named vector does not exist on this node.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error occurred while retrieving the maintenance status. Check the ErrorResponse for details.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "post": {
+        "summary": "Start a vector index maintenance operation",
+        "description": "Starts a maintenance operation on the vector index of a named vector of a shard on this node. ` + "`" + `tombstoneCleanup` + "`" + `, ` + "`" + `condense` + "`" + `, ` + "`" + `snapshot` + "`" + ` and ` + "`" + `rebuild` + "`" + ` run in the background, their progress is reported by the maintenance status. ` + "`" + `pause` + "`" + ` and ` + "`" + `resume` + "`" + ` stop and restart the maintenance cycles of the vector index right away.",
+        "operationId": "schema.objects.vectors.maintenance.start",
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ],
+        "tags": [
+          "schema"
+        ],
+        "parameters": [
+          {
+            "name": "className",
+            "description": "The name of the collection (class) containing the shard.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "shardName",
+            "description": "The name of the shard on this node.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "vectorName",
+            "description": "The name of the named vector, or ` + "`" + `default` + "`" + ` for a collection without named vectors.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "description": "The maintenance operation to start.",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/VectorIndexMaintenanceRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Maintenance operation started successfully.",
             "schema": {
-              "$ref": "#/definitions/ShardStatusList"
+              "$ref": "#/definitions/VectorIndexMaintenanceOperation"
             }
           },
           "401": {
@@ -14346,62 +15157,70 @@ func init() {
             }
           },
           "404": {
-            "description": "Collection not found.",
+            "description": "The collection, shard or named vector does not exist on this node.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The operation is unknown, not supported by the vector index or another operation is still running.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An error occurred while retrieving shard statuses. Check the ErrorResponse for details.",
+            "description": "An error occurred while starting the maintenance operation. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-serviceIds": [
-          "weaviate.local.get.meta"
-        ]
+        }
       }
     },
-    "/schema/{className}/shards/{shardName}": {
-      "put": {
-        "description": "Updates the status of a specific shard within a collection (e.g., sets it to ` + "`" + `READY` + "`" + ` or ` + "`" + `READONLY` + "`" + `). This is typically used after resolving an underlying issue (like disk space) that caused a shard to become non-operational. There is also a convenience function in each client to set the status of all shards of a collection.",
+    "/schema/{className}/shards/{shardName}/vectors/{vectorName}/maintenance/{operationId}": {
+      "delete": {
+        "summary": "Cancel a vector index maintenance operation",
+        "description": "Cancels a running maintenance operation on the vector index of a named vector of a shard on this node. The request returns right away, the operation reports the status ` + "`" + `CANCELLED` + "`" + ` once it stopped. A tombstone cleanup stops after the node it is processing and keeps the tombstones removed so far. Condensing stops before the next commit log file. A snapshot which is being written is not interrupted. A cancelled rebuild keeps the current vector index and drops the partly built one. Cancelling a finished operation has no effect. Operations are only tracked while the shard is loaded, a rebuild interrupted by a shutdown continues once the shard is loaded again.",
+        "operationId": "schema.objects.vectors.maintenance.cancel",
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ],
         "tags": [
           "schema"
         ],
-        "summary": "Update a shard status",
-        "operationId": "schema.objects.shards.update",
         "parameters": [
           {
-            "type": "string",
-            "description": "The name of the collection (class) containing the shard.",
             "name": "className",
+            "description": "The name of the collection (class) containing the shard.",
             "in": "path",
-            "required": true
+            "required": true,
+            "type": "string"
           },
           {
-            "type": "string",
-            "description": "The name of the shard to update.",
             "name": "shardName",
+            "description": "The name of the shard on this node.",
             "in": "path",
-            "required": true
+            "required": true,
+            "type": "string"
           },
           {
-            "description": "The shard status object containing the desired new status.",
-            "name": "body",
-            "in": "body",
+            "name": "vectorName",
+            "description": "The name of the named vector, or ` + "`" + `default` + "`" + ` for a collection without named vectors.",
+            "in": "path",
             "required": true,
-            "schema": {
-              "$ref": "#/definitions/ShardStatus"
-            }
+            "type": "string"
+          },
+          {
+            "name": "operationId",
+            "description": "The ID of the maintenance operation.",
+            "in": "path",
+            "required": true,
+            "type": "string"
           }
         ],
         "responses": {
           "200": {
-            "description": "Shard status updated successfully.",
-            "schema": {
-              "$ref": "#/definitions/ShardStatus"
-            }
+            "description": "Maintenance operation cancelled successfully."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -14413,27 +15232,18 @@ func init() {
             }
           },
           "404": {
-            "description": "Collection or shard not found.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid update attempt",
+            "description": "The collection, shard, named vector or operation does not exist on this node.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An error occurred while updating the shard status. Check the ErrorResponse for details.",
+            "description": "An error occurred while cancelling the maintenance operation. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
-        ]
+        }
       }
     },
     "/schema/{className}/tenants": {
@@ -14797,6 +15607,70 @@ func init() {
         }
       }
     },
+    "/schema/{className}/vectors/{vectorName}/reindex": {
+      "post": {
+        "summary": "Rebuild the vector index of a named vector",
+        "description": "Moves a named vector to a vector index of another type, distance or quantizer. Every shard builds the new index in the background from the vectors already stored, encoding them with the quantizer of the new index, and swaps it in once complete, searches are served by the previous index until then. Compression is enabled in place by updating the collection, a reindex is only needed to switch to another quantizer, e.g. from ` + "`" + `pq` + "`" + ` to ` + "`" + `rq` + "`" + `; compression can not be disabled and the quantizer of a flat index can not be switched. The progress is reported per shard in the verbose nodes status and, if distributed tasks are enabled, per node in the ` + "`" + `vector-reindex` + "`" + ` task.",
+        "operationId": "schema.objects.vectors.reindex",
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ],
+        "tags": [
+          "schema"
+        ],
+        "parameters": [
+          {
+            "name": "className",
+            "description": "The name of the collection (class) containing the named vector.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "vectorName",
+            "description": "The name of the named vector to reindex.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "description": "The vector index type and configuration to rebuild the named vector into.",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/VectorConfig"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The rebuild of the vector index was started."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The collection or named vector does not exist, or the vector index configuration is invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error occurred while starting the rebuild. Check the ErrorResponse for details.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
     "/tasks": {
       "get": {
         "tags": [
@@ -16170,9 +17044,22 @@ func init() {
         }
       }
     },
+    "ChangeStreamConfig": {
+      "description": "Configuration of the change stream of a collection",
+      "properties": {
+        "enabled": {
+          "description": "Whether or not all object writes of this collection are recorded in a per-shard change stream, which can be followed through the gRPC ` + "`" + `ChangesStream` + "`" + ` RPC. Can only be set when the collection is created (default: ` + "`" + `false` + "`" + `).",
+          "type": "boolean",
+          "x-omitempty": false
+        }
+      }
+    },
     "Class": {
       "type": "object",
       "properties": {
+        "changeStreamConfig": {
+          "$ref": "#/definitions/ChangeStreamConfig"
+        },
         "class": {
           "description": "Name of the collection (formerly 'class') (required). Multiple words should be concatenated in CamelCase, e.g. ` + "`" + `ArticleAuthor` + "`" + `.",
           "type": "string"
@@ -16191,6 +17078,9 @@ func init() {
         "multiTenancyConfig": {
           "$ref": "#/definitions/MultiTenancyConfig"
         },
+        "objectStorageConfig": {
+          "$ref": "#/definitions/ObjectStorageConfig"
+        },
         "objectTtlConfig": {
           "$ref": "#/definitions/ObjectTtlConfig"
         },
@@ -16523,6 +17413,15 @@ func init() {
           "description": "The ID of the task.",
           "type": "string"
         },
+        "nodesProgress": {
+          "description": "The fraction of the task completed by each node, for tasks which report their progress.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "number",
+            "format": "float"
+          },
+          "x-omitempty": true
+        },
         "payload": {
           "description": "The payload of the task.",
           "type": "object"
@@ -16552,6 +17451,46 @@ func init() {
         }
       }
     },
+    "EncryptionStatus": {
+      "description": "The encryption at rest of the segments, write-ahead-logs and vector index commit logs of a shard.",
+      "properties": {
+        "currentKeyId": {
+          "description": "The id of the key new files are encrypted with.",
+          "type": "string"
+        },
+        "enabled": {
+          "description": "Whether files are encrypted at rest.",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "encryptedFiles": {
+          "description": "The number of encrypted files.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "keyIds": {
+          "description": "The ids of the keys the files of the shard are encrypted with.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": false
+        },
+        "plaintextFiles": {
+          "description": "The number of files which were written before the encryption was enabled.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "staleFiles": {
+          "description": "The number of files encrypted with another key than the current one, they are rewritten with the current key in the background.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
     "ErrorResponse": {
       "description": "An error response returned by Weaviate endpoints.",
       "type": "object",
@@ -16693,6 +17632,13 @@ func init() {
             "$ref": "#/definitions/GraphQLError"
           },
           "x-omitempty": true
+        },
+        "extensions": {
+          "description": "GraphQL extensions object, contains the facets of the queried classes.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/JsonObject"
+          }
         }
       }
     },
@@ -16726,6 +17672,10 @@ func init() {
           "description": "Index each object with the null state (default: ` + "`" + `false` + "`" + `).",
           "type": "boolean"
         },
+        "indexPositions": {
+          "description": "Index the positions of the terms of searchable properties, which is required for phrase and proximity queries (default: ` + "`" + `false` + "`" + `).",
+          "type": "boolean"
+        },
         "indexPropertyLength": {
           "description": "Index length of properties (default: ` + "`" + `false` + "`" + `).",
           "type": "boolean"
@@ -16897,6 +17847,11 @@ func init() {
           "type": "boolean",
           "x-omitempty": false
         },
+        "encryptionStatus": {
+          "description": "The encryption at rest of the files of the shard, only set if the encryption is enabled.",
+          "type": "object",
+          "$ref": "#/definitions/EncryptionStatus"
+        },
         "loaded": {
           "description": "The load status of the shard.",
           "type": "boolean",
@@ -16941,6 +17896,13 @@ func init() {
           "type": "number",
           "format": "int64",
           "x-omitempty": false
+        },
+        "vectorReindexStatus": {
+          "description": "The status of the named vector indexes being rebuilt into another index type or distance.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VectorReindexStatus"
+          }
         }
       }
     },
@@ -17073,6 +18035,15 @@ func init() {
         }
       }
     },
+    "ObjectStorageConfig": {
+      "description": "Configuration of how the objects of a collection are stored",
+      "properties": {
+        "compression": {
+          "description": "Compression of the stored objects: ` + "`" + `none` + "`" + `, ` + "`" + `zstd` + "`" + ` or ` + "`" + `snappy` + "`" + `. ` + "`" + `zstd` + "`" + ` has the best ratio, ` + "`" + `snappy` + "`" + ` is faster to read and write. Changing it applies to the data written afterwards, existing data is converted when it is compacted (default: ` + "`" + `none` + "`" + `).",
+          "type": "string"
+        }
+      }
+    },
     "ObjectTtlConfig": {
       "description": "Configuration of objects' time-to-live",
       "properties": {
@@ -18481,6 +19452,28 @@ func init() {
         }
       }
     },
+    "SparseVector": {
+      "description": "A sparse vector given as parallel lists of dimension indices and their weights, as produced by learned sparse embedding models.",
+      "type": "object",
+      "properties": {
+        "indices": {
+          "description": "The dimension indices of the non-zero entries.",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "uint32"
+          }
+        },
+        "values": {
+          "description": "The weights of the non-zero entries, in the same order as the indices.",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float"
+          }
+        }
+      }
+    },
     "Statistics": {
       "description": "The definition of node statistics.",
       "properties": {
@@ -18697,6 +19690,97 @@ func init() {
         }
       }
     },
+    "VectorIndexMaintenanceOperation": {
+      "description": "A maintenance operation on the vector index of a named vector of a shard.",
+      "properties": {
+        "error": {
+          "description": "The error which stopped the operation, if it failed.",
+          "type": "string"
+        },
+        "finishTimeUnix": {
+          "description": "The time the operation finished at, in milliseconds since epoch. Not set while it is running.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "description": "The id of the operation, used to cancel it.",
+          "type": "string"
+        },
+        "operation": {
+          "description": "The operation, one of tombstoneCleanup, condense, snapshot, rebuild, pause or resume.",
+          "type": "string"
+        },
+        "progress": {
+          "description": "The share of the operation which is done, between 0 and 1.",
+          "type": "number",
+          "format": "float64",
+          "x-omitempty": false
+        },
+        "startTimeUnix": {
+          "description": "The time the operation started at, in milliseconds since epoch.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "status": {
+          "description": "The status of the operation: RUNNING, FINISHED, FAILED or CANCELLED.",
+          "type": "string"
+        }
+      }
+    },
+    "VectorIndexMaintenanceRequest": {
+      "description": "A maintenance operation to run on the vector index of a named vector of a shard.",
+      "properties": {
+        "operation": {
+          "description": "The operation to run: tombstoneCleanup removes deleted objects from the graph, condense condenses and combines the commit logs, snapshot creates a snapshot of the commit logs, rebuild builds the index again from the stored vectors, pause and resume stop and restart the background maintenance cycles.",
+          "type": "string"
+        }
+      }
+    },
+    "VectorIndexMaintenanceStatus": {
+      "description": "The maintenance of the vector index of a named vector of a shard.",
+      "properties": {
+        "maintenancePaused": {
+          "description": "Whether the background maintenance cycles of the vector index are paused. They stay paused when the shard is loaded again, until resumed.",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "operations": {
+          "description": "The running and most recently finished maintenance operations.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VectorIndexMaintenanceOperation"
+          },
+          "x-omitempty": false
+        }
+      }
+    },
+    "VectorReindexStatus": {
+      "description": "The status of a named vector index being rebuilt into another index type or distance.",
+      "properties": {
+        "error": {
+          "description": "The error which stopped the rebuild, if it failed.",
+          "type": "string"
+        },
+        "progress": {
+          "description": "The share of the objects of the shard whose vectors were added to the rebuilt index, between 0 and 1.",
+          "type": "number",
+          "format": "float64",
+          "x-omitempty": false
+        },
+        "status": {
+          "description": "The status of the rebuild, INDEXING while vectors are added and FAILED if it stopped with an error.",
+          "type": "string"
+        },
+        "targetVector": {
+          "description": "The name of the named vector whose index is rebuilt.",
+          "type": "string"
+        },
+        "vectorIndexType": {
+          "description": "The index type the named vector is rebuilt into.",
+          "type": "string"
+        }
+      }
+    },
     "VectorWeights": {
       "description": "Allow custom overrides of vector weights as math expressions. E.g. ` + "`" + `pancake` + "`" + `: ` + "`" + `7` + "`" + ` will set the weight for the word pancake to 7 in the vectorization, whereas ` + "`" + `w * 3` + "`" + ` would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
       "type": "object"
@@ -18737,7 +19821,9 @@ func init() {
             "ContainsAny",
             "ContainsAll",
             "ContainsNone",
-            "Not"
+            "Not",
+            "Regex",
+            "StartsWith"
           ],
           "example": "GreaterThanEqual"
         },
@@ -149,6 +149,10 @@ func parseOperator(in string) (filters.Operator, error) {
 		return filters.ContainsNone, nil
 	case models.WhereFilterOperatorNot:
 		return filters.OperatorNot, nil
+	case models.WhereFilterOperatorRegex:
+		return filters.OperatorRegex, nil
+	case models.WhereFilterOperatorStartsWith:
+		return filters.OperatorStartsWith, nil
 	default:
 		return -1, fmt.Errorf("unrecognized operator: %s", in)
 	}
@@ -331,6 +331,16 @@ func Test_ExtractFlatFilters(t *testing.T) {
 				input:          inputIntFilterWithOp("LessThanEqual"),
 				expectedFilter: intFilterWithOp(filters.OperatorLessThanEqual),
 			},
+			{
+				name:           "regex",
+				input:          inputIntFilterWithOp("Regex"),
+				expectedFilter: intFilterWithOp(filters.OperatorRegex),
+			},
+			{
+				name:           "starts with",
+				input:          inputIntFilterWithOp("StartsWith"),
+				expectedFilter: intFilterWithOp(filters.OperatorStartsWith),
+			},
 		}
 
 		for _, test := range tests {
@@ -12,6 +12,7 @@
 package rest
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -693,6 +694,67 @@ func setupDebugHandlers(appState *state.State) {
 		w.WriteHeader(http.StatusAccepted)
 	}))
 
+	// Measures the recall@k of a vector index against an exact search, e.g.
+	// curl "localhost:6060/debug/index/recall/vector?collection=Articles&vector=title&k=10&samples=20"
+	// The shard is optional, all loaded shards are measured if it is omitted.
+	http.HandleFunc("/debug/index/recall/vector", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		colName := r.URL.Query().Get("collection")
+		shardName := r.URL.Query().Get("shard")
+		targetVector := r.URL.Query().Get("vector")
+
+		if colName == "" {
+			http.Error(w, "collection is required", http.StatusBadRequest)
+			return
+		}
+
+		recallConfig := appState.ServerConfig.Config.RecallMonitoring
+		k := cmp.Or(recallConfig.K, ucfg.DefaultRecallMonitoringK)
+		samples := cmp.Or(recallConfig.SampleSize, ucfg.DefaultRecallMonitoringSampleSize)
+		for param, target := range map[string]*int{"k": &k, "samples": &samples} {
+			if v := r.URL.Query().Get(param); v != "" {
+				parsed, err := strconv.Atoi(v)
+				if err != nil || parsed <= 0 {
+					http.Error(w, param+" must be a positive integer", http.StatusBadRequest)
+					return
+				}
+				*target = parsed
+			}
+		}
+
+		idx := appState.DB.GetIndex(schema.ClassName(colName))
+		if idx == nil {
+			logger.WithField("collection", colName).Error("collection not found")
+			http.Error(w, "collection not found", http.StatusNotFound)
+			return
+		}
+
+		measurement, err := idx.DebugMeasureRecall(r.Context(), shardName, targetVector, k, samples)
+		if err != nil {
+			logger.
+				WithField("shard", shardName).
+				WithField("targetVector", targetVector).
+				WithError(err).
+				Error("failed to measure vector index recall")
+			if errTxt := err.Error(); strings.Contains(errTxt, "not found") {
+				http.Error(w, err.Error(), http.StatusNotFound)
+				return
+			}
+
+			http.Error(w, "failed to measure vector index recall: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		jsonBytes, err := json.Marshal(measurement)
+		if err != nil {
+			logger.WithError(err).Error("marshal failed on recall measurement")
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write(jsonBytes)
+	}))
+
 	http.HandleFunc("/debug/stats/collection/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		path := strings.TrimSpace(strings.TrimPrefix(r.URL.Path, "/debug/stats/collection/"))
 		parts := strings.Split(path, "/")
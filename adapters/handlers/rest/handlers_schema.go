@@ -145,6 +145,123 @@ func (s *schemaHandlers) addClassProperty(params schema.SchemaObjectsPropertiesA
 	return schema.NewSchemaObjectsPropertiesAddOK().WithPayload(params.Body)
 }
 
+func (s *schemaHandlers) deleteClassProperty(params schema.SchemaObjectsPropertiesDeleteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	ctx := restCtx.AddPrincipalToContext(params.HTTPRequest.Context(), principal)
+	err := s.manager.DeleteClassProperty(ctx, principal, params.ClassName, params.PropertyName)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch {
+		case errors.As(err, &authzerrors.Forbidden{}):
+			return schema.NewSchemaObjectsPropertiesDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsPropertiesDeleteUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsPropertiesDeleteOK()
+}
+
+func (s *schemaHandlers) reindexClassVector(params schema.SchemaObjectsVectorsReindexParams,
+	principal *models.Principal,
+) middleware.Responder {
+	ctx := restCtx.AddPrincipalToContext(params.HTTPRequest.Context(), principal)
+	err := s.manager.ReindexVectorIndex(ctx, principal, params.ClassName, params.VectorName, *params.Body)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch {
+		case errors.As(err, &authzerrors.Forbidden{}):
+			return schema.NewSchemaObjectsVectorsReindexForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsVectorsReindexUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsVectorsReindexOK()
+}
+
+func (s *schemaHandlers) startVectorIndexMaintenance(params schema.SchemaObjectsVectorsMaintenanceStartParams,
+	principal *models.Principal,
+) middleware.Responder {
+	ctx := restCtx.AddPrincipalToContext(params.HTTPRequest.Context(), principal)
+	op, err := s.manager.StartVectorIndexMaintenance(ctx, principal, params.ClassName, params.ShardName,
+		params.VectorName, params.Body.Operation)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch {
+		case errors.As(err, &authzerrors.Forbidden{}):
+			return schema.NewSchemaObjectsVectorsMaintenanceStartForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewSchemaObjectsVectorsMaintenanceStartNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsVectorsMaintenanceStartUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsVectorsMaintenanceStartOK().WithPayload(op)
+}
+
+func (s *schemaHandlers) getVectorIndexMaintenance(params schema.SchemaObjectsVectorsMaintenanceGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	ctx := restCtx.AddPrincipalToContext(params.HTTPRequest.Context(), principal)
+	status, err := s.manager.GetVectorIndexMaintenance(ctx, principal, params.ClassName, params.ShardName,
+		params.VectorName)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch {
+		case errors.As(err, &authzerrors.Forbidden{}):
+			return schema.NewSchemaObjectsVectorsMaintenanceGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewSchemaObjectsVectorsMaintenanceGetNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsVectorsMaintenanceGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsVectorsMaintenanceGetOK().WithPayload(status)
+}
+
+func (s *schemaHandlers) cancelVectorIndexMaintenance(params schema.SchemaObjectsVectorsMaintenanceCancelParams,
+	principal *models.Principal,
+) middleware.Responder {
+	ctx := restCtx.AddPrincipalToContext(params.HTTPRequest.Context(), principal)
+	err := s.manager.CancelVectorIndexMaintenance(ctx, principal, params.ClassName, params.ShardName,
+		params.VectorName, params.OperationID)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch {
+		case errors.As(err, &authzerrors.Forbidden{}):
+			return schema.NewSchemaObjectsVectorsMaintenanceCancelForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewSchemaObjectsVectorsMaintenanceCancelNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsVectorsMaintenanceCancelInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsVectorsMaintenanceCancelOK()
+}
+
 func (s *schemaHandlers) getSchema(params schema.SchemaDumpParams, principal *models.Principal) middleware.Responder {
 	dbSchema, err := s.manager.GetConsistentSchema(params.HTTPRequest.Context(), principal, *params.Consistency)
 	if err != nil {
@@ -367,9 +484,13 @@ func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager,
 		SchemaObjectsDeleteHandlerFunc(h.deleteClass)
 	api.SchemaSchemaObjectsPropertiesAddHandler = schema.
 		SchemaObjectsPropertiesAddHandlerFunc(h.addClassProperty)
+	api.SchemaSchemaObjectsPropertiesDeleteHandler = schema.
+		SchemaObjectsPropertiesDeleteHandlerFunc(h.deleteClassProperty)
 
 	api.SchemaSchemaObjectsUpdateHandler = schema.
 		SchemaObjectsUpdateHandlerFunc(h.updateClass)
+	api.SchemaSchemaObjectsVectorsReindexHandler = schema.
+		SchemaObjectsVectorsReindexHandlerFunc(h.reindexClassVector)
 
 	api.SchemaSchemaObjectsGetHandler = schema.
 		SchemaObjectsGetHandlerFunc(h.getClass)
@@ -380,6 +501,12 @@ func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager,
 		SchemaObjectsShardsGetHandlerFunc(h.getShardsStatus)
 	api.SchemaSchemaObjectsShardsUpdateHandler = schema.
 		SchemaObjectsShardsUpdateHandlerFunc(h.updateShardStatus)
+	api.SchemaSchemaObjectsVectorsMaintenanceStartHandler = schema.
+		SchemaObjectsVectorsMaintenanceStartHandlerFunc(h.startVectorIndexMaintenance)
+	api.SchemaSchemaObjectsVectorsMaintenanceGetHandler = schema.
+		SchemaObjectsVectorsMaintenanceGetHandlerFunc(h.getVectorIndexMaintenance)
+	api.SchemaSchemaObjectsVectorsMaintenanceCancelHandler = schema.
+		SchemaObjectsVectorsMaintenanceCancelHandlerFunc(h.cancelVectorIndexMaintenance)
 
 	api.SchemaTenantsCreateHandler = schema.TenantsCreateHandlerFunc(h.createTenants)
 	api.SchemaTenantsUpdateHandler = schema.TenantsUpdateHandlerFunc(h.updateTenants)
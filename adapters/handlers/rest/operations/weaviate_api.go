@@ -323,6 +323,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		SchemaSchemaObjectsPropertiesAddHandler: schema.SchemaObjectsPropertiesAddHandlerFunc(func(params schema.SchemaObjectsPropertiesAddParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsPropertiesAdd has not yet been implemented")
 		}),
+		SchemaSchemaObjectsPropertiesDeleteHandler: schema.SchemaObjectsPropertiesDeleteHandlerFunc(func(params schema.SchemaObjectsPropertiesDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsPropertiesDelete has not yet been implemented")
+		}),
 		SchemaSchemaObjectsShardsGetHandler: schema.SchemaObjectsShardsGetHandlerFunc(func(params schema.SchemaObjectsShardsGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsShardsGet has not yet been implemented")
 		}),
@@ -332,6 +335,18 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		SchemaSchemaObjectsUpdateHandler: schema.SchemaObjectsUpdateHandlerFunc(func(params schema.SchemaObjectsUpdateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsUpdate has not yet been implemented")
 		}),
+		SchemaSchemaObjectsVectorsMaintenanceCancelHandler: schema.SchemaObjectsVectorsMaintenanceCancelHandlerFunc(func(params schema.SchemaObjectsVectorsMaintenanceCancelParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsVectorsMaintenanceCancel has not yet been implemented")
+		}),
+		SchemaSchemaObjectsVectorsMaintenanceGetHandler: schema.SchemaObjectsVectorsMaintenanceGetHandlerFunc(func(params schema.SchemaObjectsVectorsMaintenanceGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsVectorsMaintenanceGet has not yet been implemented")
+		}),
+		SchemaSchemaObjectsVectorsMaintenanceStartHandler: schema.SchemaObjectsVectorsMaintenanceStartHandlerFunc(func(params schema.SchemaObjectsVectorsMaintenanceStartParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsVectorsMaintenanceStart has not yet been implemented")
+		}),
+		SchemaSchemaObjectsVectorsReindexHandler: schema.SchemaObjectsVectorsReindexHandlerFunc(func(params schema.SchemaObjectsVectorsReindexParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsVectorsReindex has not yet been implemented")
+		}),
 		SchemaTenantExistsHandler: schema.TenantExistsHandlerFunc(func(params schema.TenantExistsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.TenantExists has not yet been implemented")
 		}),
@@ -579,12 +594,22 @@ type WeaviateAPI struct {
 	SchemaSchemaObjectsGetHandler schema.SchemaObjectsGetHandler
 	// SchemaSchemaObjectsPropertiesAddHandler sets the operation handler for the schema objects properties add operation
 	SchemaSchemaObjectsPropertiesAddHandler schema.SchemaObjectsPropertiesAddHandler
+	// SchemaSchemaObjectsPropertiesDeleteHandler sets the operation handler for the schema objects properties delete operation
+	SchemaSchemaObjectsPropertiesDeleteHandler schema.SchemaObjectsPropertiesDeleteHandler
 	// SchemaSchemaObjectsShardsGetHandler sets the operation handler for the schema objects shards get operation
 	SchemaSchemaObjectsShardsGetHandler schema.SchemaObjectsShardsGetHandler
 	// SchemaSchemaObjectsShardsUpdateHandler sets the operation handler for the schema objects shards update operation
 	SchemaSchemaObjectsShardsUpdateHandler schema.SchemaObjectsShardsUpdateHandler
 	// SchemaSchemaObjectsUpdateHandler sets the operation handler for the schema objects update operation
 	SchemaSchemaObjectsUpdateHandler schema.SchemaObjectsUpdateHandler
+	// SchemaSchemaObjectsVectorsMaintenanceCancelHandler sets the operation handler for the schema objects vectors maintenance cancel operation
+	SchemaSchemaObjectsVectorsMaintenanceCancelHandler schema.SchemaObjectsVectorsMaintenanceCancelHandler
+	// SchemaSchemaObjectsVectorsMaintenanceGetHandler sets the operation handler for the schema objects vectors maintenance get operation
+	SchemaSchemaObjectsVectorsMaintenanceGetHandler schema.SchemaObjectsVectorsMaintenanceGetHandler
+	// SchemaSchemaObjectsVectorsMaintenanceStartHandler sets the operation handler for the schema objects vectors maintenance start operation
+	SchemaSchemaObjectsVectorsMaintenanceStartHandler schema.SchemaObjectsVectorsMaintenanceStartHandler
+	// SchemaSchemaObjectsVectorsReindexHandler sets the operation handler for the schema objects vectors reindex operation
+	SchemaSchemaObjectsVectorsReindexHandler schema.SchemaObjectsVectorsReindexHandler
 	// SchemaTenantExistsHandler sets the operation handler for the tenant exists operation
 	SchemaTenantExistsHandler schema.TenantExistsHandler
 	// SchemaTenantsCreateHandler sets the operation handler for the tenants create operation
@@ -939,6 +964,9 @@ func (o *WeaviateAPI) Validate() error {
 	if o.SchemaSchemaObjectsPropertiesAddHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsPropertiesAddHandler")
 	}
+	if o.SchemaSchemaObjectsPropertiesDeleteHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsPropertiesDeleteHandler")
+	}
 	if o.SchemaSchemaObjectsShardsGetHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsShardsGetHandler")
 	}
@@ -948,6 +976,18 @@ func (o *WeaviateAPI) Validate() error {
 	if o.SchemaSchemaObjectsUpdateHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsUpdateHandler")
 	}
+	if o.SchemaSchemaObjectsVectorsMaintenanceCancelHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsVectorsMaintenanceCancelHandler")
+	}
+	if o.SchemaSchemaObjectsVectorsMaintenanceGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsVectorsMaintenanceGetHandler")
+	}
+	if o.SchemaSchemaObjectsVectorsMaintenanceStartHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsVectorsMaintenanceStartHandler")
+	}
+	if o.SchemaSchemaObjectsVectorsReindexHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsVectorsReindexHandler")
+	}
 	if o.SchemaTenantExistsHandler == nil {
 		unregistered = append(unregistered, "schema.TenantExistsHandler")
 	}
@@ -1411,6 +1451,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/schema/{className}/properties"] = schema.NewSchemaObjectsPropertiesAdd(o.context, o.SchemaSchemaObjectsPropertiesAddHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/schema/{className}/properties/{propertyName}"] = schema.NewSchemaObjectsPropertiesDelete(o.context, o.SchemaSchemaObjectsPropertiesDeleteHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1423,6 +1467,22 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/schema/{className}"] = schema.NewSchemaObjectsUpdate(o.context, o.SchemaSchemaObjectsUpdateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/schema/{className}/shards/{shardName}/vectors/{vectorName}/maintenance/{operationId}"] = schema.NewSchemaObjectsVectorsMaintenanceCancel(o.context, o.SchemaSchemaObjectsVectorsMaintenanceCancelHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/shards/{shardName}/vectors/{vectorName}/maintenance"] = schema.NewSchemaObjectsVectorsMaintenanceGet(o.context, o.SchemaSchemaObjectsVectorsMaintenanceGetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/shards/{shardName}/vectors/{vectorName}/maintenance"] = schema.NewSchemaObjectsVectorsMaintenanceStart(o.context, o.SchemaSchemaObjectsVectorsMaintenanceStartHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/vectors/{vectorName}/reindex"] = schema.NewSchemaObjectsVectorsReindex(o.context, o.SchemaSchemaObjectsVectorsReindexHandler)
 	if o.handlers["HEAD"] == nil {
 		o.handlers["HEAD"] = make(map[string]http.Handler)
 	}
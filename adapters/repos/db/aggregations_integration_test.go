@@ -16,6 +16,7 @@ package db
 import (
 	"context"
 	"fmt"
+	"sort"
 	"testing"
 	"time"
 
@@ -94,6 +95,9 @@ func Test_Aggregations(t *testing.T) {
 	t.Run("date aggregations with filters",
 		testDateAggregationsWithFilters(repo))
 
+	t.Run("histogram aggregations",
+		testHistogramAggregations(repo))
+
 	t.Run("clean up",
 		cleanupCompanyTestSchemaAndData(repo, migrator))
 }
@@ -154,6 +158,9 @@ func Test_Aggregations_MultiShard(t *testing.T) {
 	t.Run("date aggregations with filters",
 		testDateAggregationsWithFilters(repo))
 
+	t.Run("histogram aggregations",
+		testHistogramAggregations(repo))
+
 	t.Run("clean up",
 		cleanupCompanyTestSchemaAndData(repo, migrator))
 }
@@ -2291,6 +2298,108 @@ func testDateAggregationsWithoutGrouping(repo *DB, exact bool) func(t *testing.T
 	}
 }
 
+func testHistogramAggregations(repo *DB) func(t *testing.T) {
+	expectedHistogram := func(sector string) []aggregation.HistogramBucket {
+		byKey := map[float64]*aggregation.HistogramBucket{}
+		for _, company := range companies {
+			if sector != "" && company["sector"] != sector {
+				continue
+			}
+			key := float64(company["price"].(int64)/200) * 200
+			if byKey[key] == nil {
+				byKey[key] = &aggregation.HistogramBucket{Key: key, Metrics: map[string]aggregation.HistogramMetrics{}}
+			}
+			metrics := byKey[key].Metrics["dividendYield"]
+			for i := 0; i < importFactor; i++ {
+				byKey[key].Count++
+				metrics.Add(company["dividendYield"].(float64))
+			}
+			byKey[key].Metrics["dividendYield"] = metrics
+		}
+
+		out := []aggregation.HistogramBucket{}
+		for _, bucket := range byKey {
+			out = append(out, *bucket)
+		}
+		sort.Slice(out, func(i, j int) bool { return out[i].Key.(float64) < out[j].Key.(float64) })
+		return out
+	}
+
+	assertHistogram := func(t *testing.T, expected, actual []aggregation.HistogramBucket) {
+		require.Len(t, actual, len(expected))
+		for i := range expected {
+			assert.Equal(t, expected[i].Key, actual[i].Key)
+			assert.Equal(t, expected[i].Count, actual[i].Count)
+			expectedMetrics, actualMetrics := expected[i].Metrics["dividendYield"], actual[i].Metrics["dividendYield"]
+			assert.Equal(t, expectedMetrics.Count, actualMetrics.Count)
+			assert.InDelta(t, expectedMetrics.Sum, actualMetrics.Sum, 0.0001)
+			assert.Equal(t, expectedMetrics.Minimum, actualMetrics.Minimum)
+			assert.Equal(t, expectedMetrics.Maximum, actualMetrics.Maximum)
+		}
+	}
+
+	priceHistogram := []aggregation.ParamProperty{
+		{
+			Name:        schema.PropertyName("price"),
+			Aggregators: []aggregation.Aggregator{aggregation.NewHistogramAggregator(200, []schema.PropertyName{"dividendYield"})},
+		},
+	}
+
+	return func(t *testing.T) {
+		t.Run("histogram without filters", func(t *testing.T) {
+			res, err := repo.Aggregate(context.Background(), aggregation.Params{
+				ClassName:  schema.ClassName(companyClass.Class),
+				Properties: priceHistogram,
+			}, nil)
+			require.Nil(t, err)
+			require.Len(t, res.Groups, 1)
+			assertHistogram(t, expectedHistogram(""), res.Groups[0].Properties["price"].Histogram)
+		})
+
+		t.Run("histogram with filters", func(t *testing.T) {
+			res, err := repo.Aggregate(context.Background(), aggregation.Params{
+				ClassName:  schema.ClassName(companyClass.Class),
+				Filters:    sectorEqualsFoodFilter(),
+				Properties: priceHistogram,
+			}, nil)
+			require.Nil(t, err)
+			require.Len(t, res.Groups, 1)
+			assertHistogram(t, expectedHistogram("Food"), res.Groups[0].Properties["price"].Histogram)
+		})
+
+		t.Run("date histogram", func(t *testing.T) {
+			res, err := repo.Aggregate(context.Background(), aggregation.Params{
+				ClassName: schema.ClassName(customerClass.Class),
+				Properties: []aggregation.ParamProperty{
+					{
+						Name:        schema.PropertyName("timeArrived"),
+						Aggregators: []aggregation.Aggregator{aggregation.NewDateHistogramAggregator(aggregation.CalendarIntervalDay, "America/New_York", nil)},
+					},
+				},
+			}, nil)
+			require.Nil(t, err)
+			require.Len(t, res.Groups, 1)
+			assert.Equal(t, []aggregation.HistogramBucket{
+				{Key: "2022-06-16T00:00:00-04:00", Count: len(customers)},
+			}, res.Groups[0].Properties["timeArrived"].Histogram)
+		})
+
+		t.Run("histogram metric of wrong type", func(t *testing.T) {
+			_, err := repo.Aggregate(context.Background(), aggregation.Params{
+				ClassName: schema.ClassName(companyClass.Class),
+				Properties: []aggregation.ParamProperty{
+					{
+						Name:        schema.PropertyName("price"),
+						Aggregators: []aggregation.Aggregator{aggregation.NewHistogramAggregator(200, []schema.PropertyName{"sector"})},
+					},
+				},
+			}, nil)
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), "histogram metric sector must be an int or number property, got text")
+		})
+	}
+}
+
 func ptInt(in int) *int {
 	return &in
 }
@@ -31,6 +31,12 @@ func addDateAggregations(prop *aggregation.Property,
 	}
 	agg.buildPairsFromCounts()
 
+	for _, aProp := range aggs {
+		if aProp.Type == aggregation.TopOccurrencesType {
+			prop.TopOccurrences = agg.TopOccurrences(extractLimitFromTopOccs(aggs))
+		}
+	}
+
 	// if there are no elements to aggregate over because a filter does not match anything, calculating median etc. makes
 	// no sense. Non-existent entries evaluate to nil with an interface{} map
 	if agg.count == 0 {
@@ -213,3 +219,22 @@ func (a *dateAggregator) buildPairsFromCounts() {
 		return a.pairs[x].value.epochNano < a.pairs[y].value.epochNano
 	})
 }
+
+// TopOccurrences returns the most frequent dates, ties are ordered by date.
+// It requires a call of buildPairsFromCounts()
+func (a *dateAggregator) TopOccurrences(limit int) []aggregation.Occurrence {
+	pairs := make([]timestampCountPair, len(a.pairs))
+	copy(pairs, a.pairs)
+	sort.SliceStable(pairs, func(x, y int) bool {
+		return pairs[x].count > pairs[y].count
+	})
+
+	if len(pairs) > limit {
+		pairs = pairs[:limit]
+	}
+	out := make([]aggregation.Occurrence, len(pairs))
+	for i, pair := range pairs {
+		out[i] = aggregation.Occurrence{Value: pair.value.rfc3339, Occurs: int(pair.count)}
+	}
+	return out
+}
@@ -166,8 +166,15 @@ func (fa *filteredAggregator) properties(ctx context.Context,
 		return nil
 	}
 	propertyNames := make([]string, 0, len(propAggs))
-	for k := range propAggs {
+	for k, prop := range propAggs {
 		propertyNames = append(propertyNames, k)
+		if prop.histogramAgg != nil {
+			for _, metric := range prop.histogramAgg.histogram.Metrics {
+				if _, ok := propAggs[metric.String()]; !ok {
+					propertyNames = append(propertyNames, metric.String())
+				}
+			}
+		}
 	}
 
 	err = docid.ScanObjectsLSM(fa.store, ids, scan, propertyNames, fa.logger)
@@ -198,6 +205,12 @@ func (fa *filteredAggregator) AnalyzeObject(ctx context.Context,
 		if err := fa.addPropValue(prop, value); err != nil {
 			return fmt.Errorf("failed to add prop value: %w", err)
 		}
+
+		if prop.histogramAgg != nil {
+			if err := prop.histogramAgg.AddObject(value, (*properties).(map[string]interface{})); err != nil {
+				return fmt.Errorf("failed to add object to histogram: %w", err)
+			}
+		}
 	}
 
 	return nil
@@ -391,6 +404,7 @@ type propAgg struct {
 	numericalAgg *numericalAggregator
 	dateAgg      *dateAggregator
 	refAgg       *refAggregator
+	histogramAgg *histogramAggregator
 }
 
 // propAggs groups propAgg helpers by prop name
@@ -421,6 +435,9 @@ func (pa propAggs) results() (map[string]aggregation.Property, error) {
 		aggProp := aggregation.Property{
 			Type: prop.aggType,
 		}
+		if prop.histogramAgg != nil {
+			aggProp.Histogram = prop.histogramAgg.Res()
+		}
 
 		switch prop.aggType {
 		case aggregation.PropertyTypeBoolean:
@@ -466,6 +483,15 @@ func (fa *filteredAggregator) prepareAggregatorsForProps() (propAggs, error) {
 		pa.aggType = at
 		pa.dataType = dt
 		pa.initAggregator()
+
+		if histogram, ok := extractHistogram(prop.Aggregators); ok {
+			if err := fa.validateHistogram(histogram, at); err != nil {
+				return nil, errors.Wrapf(err, "property %s", prop.Name)
+			}
+			if pa.histogramAgg, err = newHistogramAggregator(histogram); err != nil {
+				return nil, errors.Wrapf(err, "property %s", prop.Name)
+			}
+		}
 		out[prop.Name.String()] = pa
 	}
 
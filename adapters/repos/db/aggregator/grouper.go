@@ -286,6 +286,9 @@ func ScanAllLSM(ctx context.Context, store *lsmkv.Store, scan docid.ObjectScanFn
 
 	c := b.Cursor()
 	defer c.Close()
+	if err := c.Err(); err != nil {
+		return err
+	}
 
 	for k, v := c.First(); k != nil; k, v = c.Next() {
 		select {
@@ -0,0 +1,234 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregator
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// maxHistogramBuckets protects against intervals which are too small for the
+// range of the values
+const maxHistogramBuckets = 10000
+
+// histogramAggregator counts the objects per bucket of a numerical or date
+// prop and aggregates the metric props of the objects in each bucket. Only
+// buckets containing at least one object are returned.
+//
+// Every shard builds its own buckets. A bucket keeps count, sum, minimum and
+// maximum of each metric, and derives the mean from them, so that the
+// ShardCombiner can merge the buckets of all shards exactly. Metrics are
+// limited to int and number props.
+type histogramAggregator struct {
+	aggType   string
+	histogram aggregation.Histogram
+	location  *time.Location
+	buckets   map[interface{}]*aggregation.HistogramBucket
+	sync.Mutex
+}
+
+func newHistogramAggregator(agg aggregation.Aggregator) (*histogramAggregator, error) {
+	if err := agg.ValidateHistogram(); err != nil {
+		return nil, err
+	}
+
+	location, err := time.LoadLocation(agg.Histogram.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	return &histogramAggregator{
+		aggType:   agg.Type,
+		histogram: *agg.Histogram,
+		location:  location,
+		buckets:   map[interface{}]*aggregation.HistogramBucket{},
+	}, nil
+}
+
+// extractHistogram returns the Histogram or DateHistogram Agg of the given
+// aggregators, if any
+func extractHistogram(aggs []aggregation.Aggregator) (aggregation.Aggregator, bool) {
+	for _, agg := range aggs {
+		if agg.Type == aggregation.HistogramType || agg.Type == aggregation.DateHistogramType {
+			return agg, true
+		}
+	}
+	return aggregation.Aggregator{}, false
+}
+
+// validateHistogram checks that the histogram matches the type of the prop and
+// that all metrics are numerical props
+func (a *Aggregator) validateHistogram(agg aggregation.Aggregator, aggType aggregation.PropertyType) error {
+	switch {
+	case agg.Type == aggregation.HistogramType && aggType != aggregation.PropertyTypeNumerical:
+		return fmt.Errorf("histogram requires an int or number property, got %s", aggType)
+	case agg.Type == aggregation.DateHistogramType && aggType != aggregation.PropertyTypeDate:
+		return fmt.Errorf("dateHistogram requires a date property, got %s", aggType)
+	}
+
+	for _, metric := range agg.Histogram.Metrics {
+		metricType, _, err := a.aggTypeOfProperty(metric)
+		if err != nil {
+			return fmt.Errorf("%s metric: %w", agg.Type, err)
+		}
+		if metricType != aggregation.PropertyTypeNumerical {
+			return fmt.Errorf("%s metric %s must be an int or number property, got %s", agg.Type, metric, metricType)
+		}
+	}
+	return nil
+}
+
+// AddObject adds an object with the value of the histogram prop, which is
+// either a single value or an array, and the values of all of its props. An
+// object with an array value is counted once in every bucket one of its
+// elements falls into.
+func (a *histogramAggregator) AddObject(value interface{}, props map[string]interface{}) error {
+	values, ok := value.([]interface{})
+	if !ok {
+		values = []interface{}{value}
+	}
+
+	keys := make(map[interface{}]struct{}, len(values))
+	for _, v := range values {
+		key, err := a.key(v)
+		if err != nil {
+			return err
+		}
+		keys[key] = struct{}{}
+	}
+
+	a.Lock()
+	defer a.Unlock()
+
+	for key := range keys {
+		bucket, ok := a.buckets[key]
+		if !ok {
+			if len(a.buckets) >= maxHistogramBuckets {
+				return fmt.Errorf("%s exceeds %d buckets, use a larger interval", a.aggType, maxHistogramBuckets)
+			}
+			bucket = &aggregation.HistogramBucket{Key: key}
+			a.buckets[key] = bucket
+		}
+		bucket.Count++
+
+		for _, metric := range a.histogram.Metrics {
+			if err := addHistogramMetric(bucket, metric, props[metric.String()]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func addHistogramMetric(bucket *aggregation.HistogramBucket, metric schema.PropertyName, value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	values, ok := value.([]interface{})
+	if !ok {
+		values = []interface{}{value}
+	}
+
+	if bucket.Metrics == nil {
+		bucket.Metrics = map[string]aggregation.HistogramMetrics{}
+	}
+	metrics := bucket.Metrics[metric.String()]
+	for _, v := range values {
+		asFloat, ok := v.(float64)
+		if !ok {
+			return fmt.Errorf("metric %s: expected property type float64, received %T", metric, v)
+		}
+		metrics.Add(asFloat)
+	}
+	bucket.Metrics[metric.String()] = metrics
+	return nil
+}
+
+// key returns the bucket of a value. Histograms key a value by the start of
+// its fixed interval, date histograms by the start of its calendar interval in
+// the requested time zone.
+func (a *histogramAggregator) key(value interface{}) (interface{}, error) {
+	if a.aggType == aggregation.HistogramType {
+		asFloat, ok := value.(float64)
+		if !ok {
+			return nil, fmt.Errorf("histogram: expected property type float64, received %T", value)
+		}
+		return math.Floor(asFloat/a.histogram.Interval) * a.histogram.Interval, nil
+	}
+
+	asString, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("dateHistogram: expected property type date, received %T", value)
+	}
+	t, err := time.Parse(time.RFC3339Nano, asString)
+	if err != nil {
+		return nil, fmt.Errorf("dateHistogram: failed to parse timestamp: %w", err)
+	}
+	return calendarBucketStart(t.In(a.location), a.histogram.CalendarInterval).Format(time.RFC3339), nil
+}
+
+// calendarBucketStart truncates the time to the start of its hour, day, week
+// (starting on Monday) or month in the location of the time
+func calendarBucketStart(t time.Time, calendarInterval string) time.Time {
+	year, month, day := t.Date()
+	switch calendarInterval {
+	case aggregation.CalendarIntervalHour:
+		return time.Date(year, month, day, t.Hour(), 0, 0, 0, t.Location())
+	case aggregation.CalendarIntervalDay:
+		return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+	case aggregation.CalendarIntervalWeek:
+		daysSinceMonday := (int(t.Weekday()) + 6) % 7
+		return time.Date(year, month, day-daysSinceMonday, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(year, month, 1, 0, 0, 0, 0, t.Location())
+	}
+}
+
+// Res returns the buckets ordered by key
+func (a *histogramAggregator) Res() []aggregation.HistogramBucket {
+	out := make([]aggregation.HistogramBucket, 0, len(a.buckets))
+	for _, bucket := range a.buckets {
+		out = append(out, *bucket)
+	}
+	sortHistogram(out)
+	return out
+}
+
+func sortHistogram(buckets []aggregation.HistogramBucket) {
+	sort.Slice(buckets, func(i, j int) bool {
+		return histogramKeyLess(buckets[i].Key, buckets[j].Key)
+	})
+}
+
+func histogramKeyLess(a, b interface{}) bool {
+	switch aTyped := a.(type) {
+	case float64:
+		bTyped, _ := b.(float64)
+		return aTyped < bTyped
+	case string:
+		bTyped, _ := b.(string)
+		aTime, errA := time.Parse(time.RFC3339, aTyped)
+		bTime, errB := time.Parse(time.RFC3339, bTyped)
+		if errA != nil || errB != nil {
+			return aTyped < bTyped
+		}
+		return aTime.Before(bTime)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,145 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestHistogramAggregator_Numerical(t *testing.T) {
+	agg, err := newHistogramAggregator(aggregation.NewHistogramAggregator(10, []schema.PropertyName{"rating"}))
+	require.Nil(t, err)
+
+	objects := []map[string]interface{}{
+		{"price": 1.0, "rating": 4.0},
+		{"price": 9.9, "rating": 2.0},
+		{"price": 10.0},
+		{"price": -0.5, "rating": 1.0},
+		{"price": []interface{}{25.0, 28.0}, "rating": []interface{}{3.0, 5.0}},
+	}
+	for _, obj := range objects {
+		require.Nil(t, agg.AddObject(obj["price"], obj))
+	}
+
+	assert.Equal(t, []aggregation.HistogramBucket{
+		{Key: -10.0, Count: 1, Metrics: map[string]aggregation.HistogramMetrics{"rating": {Count: 1, Sum: 1, Minimum: 1, Maximum: 1}}},
+		{Key: 0.0, Count: 2, Metrics: map[string]aggregation.HistogramMetrics{"rating": {Count: 2, Sum: 6, Minimum: 2, Maximum: 4}}},
+		{Key: 10.0, Count: 1},
+		// an object is counted once per bucket, even with several values in it
+		{Key: 20.0, Count: 1, Metrics: map[string]aggregation.HistogramMetrics{"rating": {Count: 2, Sum: 8, Minimum: 3, Maximum: 5}}},
+	}, agg.Res())
+}
+
+func TestHistogramAggregator_Date(t *testing.T) {
+	dates := []string{
+		"2024-03-03T23:30:00Z", // Sunday, already Monday in Berlin
+		"2024-03-04T08:15:00Z",
+		"2024-03-31T22:30:00Z", // April in Berlin
+		"2024-03-31T21:30:00.123Z",
+	}
+
+	tests := []struct {
+		interval string
+		timeZone string
+		expected []aggregation.HistogramBucket
+	}{
+		{
+			interval: aggregation.CalendarIntervalHour,
+			expected: []aggregation.HistogramBucket{
+				{Key: "2024-03-03T23:00:00Z", Count: 1},
+				{Key: "2024-03-04T08:00:00Z", Count: 1},
+				{Key: "2024-03-31T21:00:00Z", Count: 1},
+				{Key: "2024-03-31T22:00:00Z", Count: 1},
+			},
+		},
+		{
+			interval: aggregation.CalendarIntervalDay,
+			timeZone: "Europe/Berlin",
+			expected: []aggregation.HistogramBucket{
+				{Key: "2024-03-04T00:00:00+01:00", Count: 2},
+				{Key: "2024-03-31T00:00:00+01:00", Count: 1},
+				{Key: "2024-04-01T00:00:00+02:00", Count: 1},
+			},
+		},
+		{
+			interval: aggregation.CalendarIntervalWeek,
+			expected: []aggregation.HistogramBucket{
+				{Key: "2024-02-26T00:00:00Z", Count: 1},
+				{Key: "2024-03-04T00:00:00Z", Count: 1},
+				{Key: "2024-03-25T00:00:00Z", Count: 2},
+			},
+		},
+		{
+			interval: aggregation.CalendarIntervalMonth,
+			timeZone: "Europe/Berlin",
+			expected: []aggregation.HistogramBucket{
+				{Key: "2024-03-01T00:00:00+01:00", Count: 3},
+				{Key: "2024-04-01T00:00:00+02:00", Count: 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.interval, func(t *testing.T) {
+			agg, err := newHistogramAggregator(aggregation.NewDateHistogramAggregator(tt.interval, tt.timeZone, nil))
+			require.Nil(t, err)
+			for _, date := range dates {
+				require.Nil(t, agg.AddObject(date, nil))
+			}
+			assert.Equal(t, tt.expected, agg.Res())
+		})
+	}
+}
+
+func TestHistogramAggregator_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		agg         aggregation.Aggregator
+		expectedErr string
+	}{
+		{
+			name:        "zero interval",
+			agg:         aggregation.NewHistogramAggregator(0, nil),
+			expectedErr: "histogram: interval must be a positive number, got 0",
+		},
+		{
+			name:        "unknown calendar interval",
+			agg:         aggregation.NewDateHistogramAggregator("year", "", nil),
+			expectedErr: "dateHistogram: interval must be one of \"hour\", \"day\", \"week\" or \"month\", got \"year\"",
+		},
+		{
+			name:        "unknown time zone",
+			agg:         aggregation.NewDateHistogramAggregator("day", "Mars/Olympus", nil),
+			expectedErr: "dateHistogram: invalid time zone \"Mars/Olympus\": unknown time zone Mars/Olympus",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newHistogramAggregator(tt.agg)
+			assert.EqualError(t, err, tt.expectedErr)
+		})
+	}
+
+	t.Run("too many buckets", func(t *testing.T) {
+		agg, err := newHistogramAggregator(aggregation.NewHistogramAggregator(1, nil))
+		require.Nil(t, err)
+		for i := 0; i < maxHistogramBuckets; i++ {
+			require.Nil(t, agg.AddObject(float64(i), nil))
+		}
+		assert.EqualError(t, agg.AddObject(float64(maxHistogramBuckets), nil),
+			"histogram exceeds 10000 buckets, use a larger interval")
+	})
+}
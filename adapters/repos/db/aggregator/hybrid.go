@@ -24,11 +24,19 @@ import (
 )
 
 func (a *Aggregator) buildHybridKeywordRanking() (*searchparams.KeywordRanking, error) {
+	if a.params.Hybrid.SparseVector != nil {
+		return &searchparams.KeywordRanking{
+			Type:         "sparse",
+			SparseVector: a.params.Hybrid.SparseVector,
+		}, nil
+	}
+
 	kw := &searchparams.KeywordRanking{
 		Type:                 "bm25",
 		Query:                a.params.Hybrid.Query,
 		MinimumOrTokensMatch: a.params.Hybrid.MinimumOrTokensMatch,
 		SearchOperator:       a.params.Hybrid.SearchOperator,
+		Fuzziness:            a.params.Hybrid.Fuzziness,
 	}
 
 	cl := a.getSchema.ReadOnlyClass(a.params.ClassName.String())
@@ -52,12 +60,21 @@ func (a *Aggregator) bm25Objects(ctx context.Context, kw *searchparams.KeywordRa
 	}
 	cfg := inverted.ConfigFromModel(class.InvertedIndexConfig)
 
-	kw.ChooseSearchableProperties(class)
-
-	objs, dists, err := inverted.NewBM25Searcher(cfg.BM25, a.store, a.getSchema.ReadOnlyClass,
+	searcher := inverted.NewBM25Searcher(cfg.BM25, a.store, a.getSchema.ReadOnlyClass,
 		propertyspecific.Indices{}, a.classSearcher, a.stopwords,
 		a.GetPropertyLengthTracker(), a.logger, a.shardVersion,
-	).BM25F(ctx, nil, a.params.ClassName, *a.params.ObjectLimit, *kw, additional.Properties{})
+	)
+	if kw.SparseVector != nil {
+		objs, scores, err := searcher.SparseVectorSearch(ctx, nil, *a.params.ObjectLimit, *kw.SparseVector, additional.Properties{})
+		if err != nil {
+			return nil, nil, fmt.Errorf("sparse vector objects: %w", err)
+		}
+		return objs, scores, nil
+	}
+
+	kw.ChooseSearchableProperties(class)
+
+	objs, dists, err := searcher.BM25F(ctx, nil, a.params.ClassName, *a.params.ObjectLimit, *kw, additional.Properties{})
 	if err != nil {
 		return nil, nil, fmt.Errorf("bm25 objects: %w", err)
 	}
@@ -29,6 +29,7 @@ type Cursor interface {
 	Next() (k, v []byte, vv [][]byte, bi *sroar.Bitmap)
 	Seek(b []byte) (k, v []byte, vv [][]byte, bi *sroar.Bitmap)
 	Close()
+	Err() error
 }
 
 type ReplaceCursor struct {
@@ -54,6 +55,10 @@ func (c ReplaceCursor) Close() {
 	c.cursor.Close()
 }
 
+func (c ReplaceCursor) Err() error {
+	return c.cursor.Err()
+}
+
 type SetCursor struct {
 	cursor *lsmkv.CursorSet
 }
@@ -77,6 +82,10 @@ func (c SetCursor) Close() {
 	c.cursor.Close()
 }
 
+func (c SetCursor) Err() error {
+	return c.cursor.Err()
+}
+
 type RoaringCursor struct {
 	cursor lsmkv.CursorRoaringSet
 }
@@ -100,6 +109,10 @@ func (c RoaringCursor) Close() {
 	c.cursor.Close()
 }
 
+func (c RoaringCursor) Err() error {
+	return c.cursor.Err()
+}
+
 func iteratorConcurrently(ctx context.Context, b *lsmkv.Bucket, newCursor func() Cursor, aggregateFunc func(k []byte, v []byte, vv [][]byte, b *sroar.Bitmap) error, logger logrus.FieldLogger) error {
 	// we're looking at the whole object, so this is neither a Set, nor a Map, but
 	// a Replace strategy
@@ -110,6 +123,9 @@ func iteratorConcurrently(ctx context.Context, b *lsmkv.Bucket, newCursor func()
 	if len(seeds) == 0 {
 		c := newCursor()
 		defer c.Close()
+		if err := c.Err(); err != nil {
+			return err
+		}
 		for k, v, vv, bi := c.First(); k != nil; k, v, vv, bi = c.Next() {
 			err := aggregateFunc(k, v, vv, bi)
 			if err != nil {
@@ -129,6 +145,9 @@ func iteratorConcurrently(ctx context.Context, b *lsmkv.Bucket, newCursor func()
 	eg.Go(func() error {
 		c := newCursor()
 		defer c.Close()
+		if err := c.Err(); err != nil {
+			return err
+		}
 
 		count := 0
 		for k, v, vv, bi := c.First(); k != nil && bytes.Compare(k, seeds[0]) < 0; k, v, vv, bi = c.Next() {
@@ -152,6 +171,9 @@ func iteratorConcurrently(ctx context.Context, b *lsmkv.Bucket, newCursor func()
 		eg.Go(func() error {
 			c := newCursor()
 			defer c.Close()
+			if err := c.Err(); err != nil {
+				return err
+			}
 
 			count := 0
 			for k, v, vv, bi := c.Seek(start); k != nil && bytes.Compare(k, end) < 0; k, v, vv, bi = c.Next() {
@@ -172,6 +194,9 @@ func iteratorConcurrently(ctx context.Context, b *lsmkv.Bucket, newCursor func()
 	eg.Go(func() error {
 		c := newCursor()
 		defer c.Close()
+		if err := c.Err(); err != nil {
+			return err
+		}
 
 		count := 0
 		for k, v, vv, bi := c.Seek(seeds[len(seeds)-1]); k != nil; k, v, vv, bi = c.Next() {
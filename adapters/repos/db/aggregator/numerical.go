@@ -29,6 +29,17 @@ func addNumericalAggregations(prop *aggregation.Property,
 	}
 	agg.buildPairsFromCounts()
 
+	for _, aProp := range aggs {
+		switch aProp.Type {
+		case aggregation.TopOccurrencesType:
+			prop.TopOccurrences = agg.TopOccurrences(extractLimitFromTopOccs(aggs))
+		case aggregation.RangesType:
+			if aProp.Ranges != nil {
+				prop.RangeCounts = agg.RangeCounts(aProp.Ranges.Buckets)
+			}
+		}
+	}
+
 	// if there are no elements to aggregate over because a filter does not match anything, calculating mean etc. makes
 	// no sense. Non-existent entries evaluate to nil with an interface{} map
 	if agg.count == 0 {
@@ -229,3 +240,36 @@ func (a *numericalAggregator) Median() float64 {
 	}
 	panic("Couldn't determine median. This should never happen. Did you add values and call buildRows before?")
 }
+
+// TopOccurrences returns the most frequent values, ties are ordered by value.
+// It requires a call of buildPairsFromCounts()
+func (a *numericalAggregator) TopOccurrences(limit int) []aggregation.Occurrence {
+	pairs := make([]floatCountPair, len(a.pairs))
+	copy(pairs, a.pairs)
+	sort.SliceStable(pairs, func(x, y int) bool {
+		return pairs[x].count > pairs[y].count
+	})
+
+	if len(pairs) > limit {
+		pairs = pairs[:limit]
+	}
+	out := make([]aggregation.Occurrence, len(pairs))
+	for i, pair := range pairs {
+		out[i] = aggregation.Occurrence{Value: pair.value, Occurs: int(pair.count)}
+	}
+	return out
+}
+
+// RangeCounts returns the number of values per range
+func (a *numericalAggregator) RangeCounts(ranges []aggregation.Range) []aggregation.RangeCount {
+	out := make([]aggregation.RangeCount, len(ranges))
+	for i, r := range ranges {
+		out[i].Range = r
+		for value, count := range a.valueCounter {
+			if r.Contains(value) {
+				out[i].Count += int(count)
+			}
+		}
+	}
+	return out
+}
@@ -15,6 +15,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/aggregation"
 )
 
 func TestNumericalAggregator_MedianCalculation(t *testing.T) {
@@ -202,3 +203,40 @@ func TestNumericalAggregator_MinMaxCalculation(t *testing.T) {
 		})
 	}
 }
+
+func TestNumericalAggregator_TopOccurrences(t *testing.T) {
+	agg := newNumericalAggregator()
+	for _, num := range []float64{3, 1, 2, 2, 3, 5, 3} {
+		agg.AddFloat64(num)
+	}
+	agg.buildPairsFromCounts()
+
+	assert.Equal(t, []aggregation.Occurrence{
+		{Value: float64(3), Occurs: 3},
+		{Value: float64(2), Occurs: 2},
+		{Value: float64(1), Occurs: 1},
+	}, agg.TopOccurrences(3))
+}
+
+func TestNumericalAggregator_RangeCounts(t *testing.T) {
+	ptFloat := func(f float64) *float64 { return &f }
+
+	agg := newNumericalAggregator()
+	for _, num := range []float64{1, 5, 9, 10, 10, 20} {
+		agg.AddFloat64(num)
+	}
+	agg.buildPairsFromCounts()
+
+	ranges := []aggregation.Range{
+		{To: ptFloat(5)},
+		{From: ptFloat(5), To: ptFloat(10)},
+		{From: ptFloat(10)},
+		{From: ptFloat(100)},
+	}
+	assert.Equal(t, []aggregation.RangeCount{
+		{Range: ranges[0], Count: 1},
+		{Range: ranges[1], Count: 2},
+		{Range: ranges[2], Count: 3},
+		{Range: ranges[3], Count: 0},
+	}, agg.RangeCounts(ranges))
+}
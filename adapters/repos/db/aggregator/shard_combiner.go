@@ -125,6 +125,9 @@ func (sc *ShardCombiner) mergeIntoCombinedGroupAtPos(combinedGroups []aggregatio
 		default:
 			panic("unknown prop type: " + prop.Type)
 		}
+		sc.mergeTopOccurrences(&combinedProp, prop.TopOccurrences)
+		sc.mergeRangeCounts(&combinedProp, prop.RangeCounts)
+		sc.mergeHistogram(&combinedProp, prop.Histogram)
 		combinedGroups[pos].Properties[propName] = combinedProp
 
 	}
@@ -280,10 +283,16 @@ func (sc *ShardCombiner) finalizeBoolean(combined *aggregation.Boolean) {
 func (sc *ShardCombiner) mergeTextProp(first, second *aggregation.Text) {
 	first.Count += second.Count
 
+	positions := make(map[string]int, len(first.Items))
+	for i, elem := range first.Items {
+		positions[elem.Value] = i
+	}
+
 	for _, textOcc := range second.Items {
-		pos := getPosOfTextOcc(first.Items, textOcc.Value)
-		if pos < 0 {
+		pos, ok := positions[textOcc.Value]
+		if !ok {
 			first.Items = append(first.Items, textOcc)
+			positions[textOcc.Value] = len(first.Items) - 1
 		} else {
 			first.Items[pos].Occurs += textOcc.Occurs
 		}
@@ -300,14 +309,66 @@ func (sc *ShardCombiner) finalizeText(combined *aggregation.Text) {
 	})
 }
 
-func getPosOfTextOcc(haystack []aggregation.TextOccurrence, needle string) int {
-	for i, elem := range haystack {
-		if elem.Value == needle {
-			return i
+func (sc *ShardCombiner) mergeTopOccurrences(combined *aggregation.Property, source []aggregation.Occurrence) {
+	positions := make(map[interface{}]int, len(combined.TopOccurrences))
+	for i, elem := range combined.TopOccurrences {
+		positions[elem.Value] = i
+	}
+
+	for _, occ := range source {
+		pos, ok := positions[occ.Value]
+		if !ok {
+			combined.TopOccurrences = append(combined.TopOccurrences, occ)
+			positions[occ.Value] = len(combined.TopOccurrences) - 1
+		} else {
+			combined.TopOccurrences[pos].Occurs += occ.Occurs
 		}
 	}
+}
 
-	return -1
+func (sc *ShardCombiner) mergeRangeCounts(combined *aggregation.Property, source []aggregation.RangeCount) {
+	if combined.RangeCounts == nil {
+		combined.RangeCounts = append([]aggregation.RangeCount(nil), source...)
+		return
+	}
+	for i := range source {
+		if i < len(combined.RangeCounts) {
+			combined.RangeCounts[i].Count += source[i].Count
+		}
+	}
+}
+
+func (sc *ShardCombiner) mergeHistogram(combined *aggregation.Property, source []aggregation.HistogramBucket) {
+	positions := make(map[interface{}]int, len(combined.Histogram))
+	for i, bucket := range combined.Histogram {
+		positions[bucket.Key] = i
+	}
+
+	for _, bucket := range source {
+		pos, ok := positions[bucket.Key]
+		if !ok {
+			combined.Histogram = append(combined.Histogram, aggregation.HistogramBucket{Key: bucket.Key})
+			pos = len(combined.Histogram) - 1
+			positions[bucket.Key] = pos
+		}
+
+		combinedBucket := &combined.Histogram[pos]
+		combinedBucket.Count += bucket.Count
+		for metric, metrics := range bucket.Metrics {
+			if combinedBucket.Metrics == nil {
+				combinedBucket.Metrics = map[string]aggregation.HistogramMetrics{}
+			}
+			combinedMetrics := combinedBucket.Metrics[metric]
+			combinedMetrics.Merge(metrics)
+			combinedBucket.Metrics[metric] = combinedMetrics
+		}
+	}
+}
+
+func (sc *ShardCombiner) finalizeTopOccurrences(combined []aggregation.Occurrence) {
+	sort.SliceStable(combined, func(a, b int) bool {
+		return combined[a].Occurs > combined[b].Occurs
+	})
 }
 
 func (sc *ShardCombiner) finalizeGroup(group *aggregation.Group) {
@@ -326,6 +387,8 @@ func (sc *ShardCombiner) finalizeGroup(group *aggregation.Group) {
 		default:
 			panic("Unknown prop type: " + prop.Type)
 		}
+		sc.finalizeTopOccurrences(prop.TopOccurrences)
+		sortHistogram(prop.Histogram)
 		group.Properties[propName] = prop
 	}
 }
@@ -132,6 +132,63 @@ func TestShardCombinerMergeNumerical(t *testing.T) {
 	}
 }
 
+func TestShardCombinerMergeFacets(t *testing.T) {
+	from := 10.0
+	shard := func(occurrences []aggregation.Occurrence, count int) *aggregation.Result {
+		return &aggregation.Result{Groups: []aggregation.Group{{
+			Count: count,
+			Properties: map[string]aggregation.Property{
+				"price": {
+					Type:                  aggregation.PropertyTypeNumerical,
+					NumericalAggregations: map[string]interface{}{},
+					TopOccurrences:        occurrences,
+					RangeCounts:           []aggregation.RangeCount{{Range: aggregation.Range{From: &from}, Count: count}},
+				},
+			},
+		}}}
+	}
+
+	res := NewShardCombiner().Do([]*aggregation.Result{
+		shard([]aggregation.Occurrence{{Value: 10.0, Occurs: 2}, {Value: 20.0, Occurs: 1}}, 3),
+		shard([]aggregation.Occurrence{{Value: 20.0, Occurs: 4}}, 4),
+	})
+
+	prop := res.Groups[0].Properties["price"]
+	assert.Equal(t, []aggregation.Occurrence{{Value: 20.0, Occurs: 5}, {Value: 10.0, Occurs: 2}}, prop.TopOccurrences)
+	assert.Equal(t, []aggregation.RangeCount{{Range: aggregation.Range{From: &from}, Count: 7}}, prop.RangeCounts)
+}
+
+func TestShardCombinerMergeHistogram(t *testing.T) {
+	shard := func(buckets ...aggregation.HistogramBucket) *aggregation.Result {
+		return &aggregation.Result{Groups: []aggregation.Group{{
+			Properties: map[string]aggregation.Property{
+				"price": {
+					Type:                  aggregation.PropertyTypeNumerical,
+					NumericalAggregations: map[string]interface{}{},
+					Histogram:             buckets,
+				},
+			},
+		}}}
+	}
+
+	res := NewShardCombiner().Do([]*aggregation.Result{
+		shard(
+			aggregation.HistogramBucket{Key: 10.0, Count: 2, Metrics: map[string]aggregation.HistogramMetrics{"rating": {Count: 2, Sum: 5, Minimum: 2, Maximum: 3}}},
+			aggregation.HistogramBucket{Key: 30.0, Count: 1},
+		),
+		shard(
+			aggregation.HistogramBucket{Key: 0.0, Count: 1},
+			aggregation.HistogramBucket{Key: 10.0, Count: 1, Metrics: map[string]aggregation.HistogramMetrics{"rating": {Count: 1, Sum: 1, Minimum: 1, Maximum: 1}}},
+		),
+	})
+
+	assert.Equal(t, []aggregation.HistogramBucket{
+		{Key: 0.0, Count: 1},
+		{Key: 10.0, Count: 3, Metrics: map[string]aggregation.HistogramMetrics{"rating": {Count: 3, Sum: 6, Minimum: 1, Maximum: 3}}},
+		{Key: 30.0, Count: 1},
+	}, res.Groups[0].Properties["price"].Histogram)
+}
+
 func TestShardCombinerMergeNil(t *testing.T) {
 	tests := []struct {
 		name         string
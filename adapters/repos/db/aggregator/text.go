@@ -42,10 +42,6 @@ type textAggregator struct {
 	count uint64
 
 	itemCounter map[string]int
-
-	// always keep sorted, so we can cut off the last elem, when it grows larger
-	// than max
-	topPairs []aggregation.TextOccurrence
 }
 
 func (a *Aggregator) parseAndAddTextRow(agg *textAggregator,
@@ -79,75 +75,30 @@ func (a *textAggregator) AddText(value string) error {
 	return nil
 }
 
-func (a *textAggregator) insertOrdered(elem aggregation.TextOccurrence) {
-	if len(a.topPairs) == 0 {
-		a.topPairs = []aggregation.TextOccurrence{elem}
-		return
-	}
-
-	added := false
-	for i, pair := range a.topPairs {
-		if pair.Occurs > elem.Occurs {
-			continue
-		}
-		// if number of occurrences is the same,
-		// skip if string is after one in topPairs
-		if pair.Occurs == elem.Occurs && pair.Value < elem.Value {
-			continue
-		}
-
-		// we have found the first one that's smaller so me must insert before i
-		a.topPairs = append(
-			a.topPairs[:i], append(
-				[]aggregation.TextOccurrence{elem},
-				a.topPairs[i:]...,
-			)...,
-		)
-
-		added = true
-		break
-	}
-
-	if len(a.topPairs) > a.max {
-		a.topPairs = a.topPairs[:len(a.topPairs)-1]
-	}
-
-	if !added && len(a.topPairs) < a.max {
-		a.topPairs = append(a.topPairs, elem)
-	}
-}
-
+// Res returns the most frequent values, ties are ordered by value. All
+// values are sorted at once rather than inserted one by one into the top
+// values, so large limits stay cheap.
 func (a *textAggregator) Res() aggregation.Text {
 	out := aggregation.Text{}
 	if a.count == 0 {
 		return out
 	}
 
+	items := make([]aggregation.TextOccurrence, 0, len(a.itemCounter))
 	for value, count := range a.itemCounter {
-		a.insertOrdered(aggregation.TextOccurrence{
-			Value:  value,
-			Occurs: count,
-		})
+		items = append(items, aggregation.TextOccurrence{Value: value, Occurs: count})
 	}
-
-	out.Items = a.topPairs
-	sort.SliceStable(out.Items, func(a, b int) bool {
-		countA := out.Items[a].Occurs
-		countB := out.Items[b].Occurs
-
-		if countA != countB {
-			return countA > countB
-		}
-
-		valueA := out.Items[a].Value
-		valueB := out.Items[b].Value
-		if len(valueA) == 0 || len(valueB) == 0 {
-			return false // order doesn't matter in this case, just prevent a panic
+	sort.Slice(items, func(x, y int) bool {
+		if items[x].Occurs != items[y].Occurs {
+			return items[x].Occurs > items[y].Occurs
 		}
-
-		return valueA[0] < valueB[0]
+		return items[x].Value < items[y].Value
 	})
+	if len(items) > a.max {
+		items = items[:a.max]
+	}
 
+	out.Items = items
 	out.Count = int(a.count)
 	return out
 }
@@ -16,9 +16,11 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/sroar"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
 )
 
 // unfilteredAggregator allows for relatively efficient whole-dataset
@@ -100,6 +102,13 @@ func (ua unfilteredAggregator) properties(
 			continue
 		}
 
+		if histogram, ok := extractHistogram(prop.Aggregators); ok {
+			analyzed.Histogram, err = ua.histogram(ctx, prop.Name, histogram, analyzed.Type)
+			if err != nil {
+				return nil, errors.Wrapf(err, "property %s", prop.Name)
+			}
+		}
+
 		out[prop.Name.String()] = *analyzed
 	}
 
@@ -147,3 +156,46 @@ func (ua unfilteredAggregator) property(ctx context.Context,
 		return nil, fmt.Errorf("aggreation type %s not supported yet", aggType)
 	}
 }
+
+// histogram needs the values of the metric props of each object, so it reads
+// the whole objects rather than the inverted index of the prop
+func (ua unfilteredAggregator) histogram(ctx context.Context, propName schema.PropertyName,
+	histogram aggregation.Aggregator, aggType aggregation.PropertyType,
+) ([]aggregation.HistogramBucket, error) {
+	if err := ua.validateHistogram(histogram, aggType); err != nil {
+		return nil, err
+	}
+	agg, err := newHistogramAggregator(histogram)
+	if err != nil {
+		return nil, err
+	}
+
+	b := ua.store.Bucket(helpers.ObjectsBucketLSM)
+	if b == nil {
+		return nil, errors.Errorf("could not find bucket for prop %s", propName)
+	}
+
+	propertyPaths := [][]string{{propName.String()}}
+	for _, metric := range histogram.Histogram.Metrics {
+		propertyPaths = append(propertyPaths, []string{metric.String()})
+	}
+
+	extract := func(k []byte, v []byte, vv [][]byte, b *sroar.Bitmap) error {
+		props := map[string]interface{}{}
+		if err := storobj.UnmarshalPropertiesFromObject(v, props, propertyPaths); err != nil {
+			return errors.Wrap(err, "unmarshal data object")
+		}
+		value, ok := props[propName.String()]
+		if !ok || value == nil {
+			return nil
+		}
+		return agg.AddObject(value, props)
+	}
+
+	err = iteratorConcurrently(ctx, b, func() Cursor { return ReplaceCursor{b.Cursor()} }, extract, ua.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return agg.Res(), nil
+}
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/sharding"
@@ -25,6 +26,8 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/weaviate/weaviate/usecases/cluster"
 
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -34,6 +37,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 )
@@ -389,6 +393,237 @@ func TestBM25FSinglePropBlock(t *testing.T) {
 	}
 }
 
+func TestBM25FFuzzyBlock(t *testing.T) {
+	config.DefaultUsingBlockMaxWAND = true
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	shardState := singleShardState()
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: shardState,
+	}
+	mockSchemaReader := schemaUC.NewMockSchemaReader(t)
+	mockSchemaReader.EXPECT().Shards(mock.Anything).Return(shardState.AllPhysicalShards(), nil).Maybe()
+	mockSchemaReader.EXPECT().Read(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(className string, retryIfClassNotFound bool, readFunc func(*models.Class, *sharding.State) error) error {
+		class := &models.Class{Class: className}
+		return readFunc(class, shardState)
+	}).Maybe()
+	mockSchemaReader.EXPECT().ReadOnlySchema().Return(models.Schema{Classes: nil}).Maybe()
+	mockSchemaReader.EXPECT().ShardReplicas(mock.Anything, mock.Anything).Return([]string{"node1"}, nil).Maybe()
+	mockReplicationFSMReader := replicationTypes.NewMockReplicationFSMReader(t)
+	mockReplicationFSMReader.EXPECT().FilterOneShardReplicasRead(mock.Anything, mock.Anything, mock.Anything).Return([]string{"node1"}).Maybe()
+	mockReplicationFSMReader.EXPECT().FilterOneShardReplicasWrite(mock.Anything, mock.Anything, mock.Anything).Return([]string{"node1"}, nil).Maybe()
+	mockNodeSelector := cluster.NewMockNodeSelector(t)
+	mockNodeSelector.EXPECT().LocalName().Return("node1").Maybe()
+	mockNodeSelector.EXPECT().NodeHostname(mock.Anything).Return("node1", true).Maybe()
+	repo, err := New(logger, "node1", Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &FakeRemoteClient{}, &FakeNodeResolver{}, &FakeRemoteNodeClient{}, nil, nil, memwatch.NewDummyMonitor(),
+		mockNodeSelector, mockSchemaReader, mockReplicationFSMReader)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	props, _ := SetupClass(t, repo, schemaGetter, logger, 0.5, 1, "none")
+
+	idx := repo.GetIndex("MyClass")
+	require.NotNil(t, idx)
+
+	docIDs := func(t *testing.T, kwr *searchparams.KeywordRanking) []uint64 {
+		res, _, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil, "", 0, props)
+		require.Nil(t, err)
+		ids := make([]uint64, len(res))
+		for i, r := range res {
+			ids[i] = r.DocID
+		}
+		return ids
+	}
+
+	for _, location := range []string{"memory", "disk"} {
+		t.Run("bm25f fuzzy "+location, func(t *testing.T) {
+			exact := docIDs(t, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"description"}, Query: "journey"})
+			require.NotEmpty(t, exact)
+
+			misspelled := docIDs(t, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"description"}, Query: "journy"})
+			require.Empty(t, misspelled)
+
+			fuzzy := docIDs(t, &searchparams.KeywordRanking{
+				Type: "bm25", Properties: []string{"description"}, Query: "journy",
+				Fuzziness: &searchparams.Fuzziness{MaxEdits: 1, PrefixLength: 2},
+			})
+			assert.ElementsMatch(t, exact, fuzzy)
+
+			tooFar := docIDs(t, &searchparams.KeywordRanking{
+				Type: "bm25", Properties: []string{"description"}, Query: "jurny",
+				Fuzziness: &searchparams.Fuzziness{MaxEdits: 1},
+			})
+			require.Empty(t, tooFar)
+
+			_, _, err := idx.objectSearch(context.TODO(), 1000, nil, &searchparams.KeywordRanking{
+				Type: "bm25", Properties: []string{"description"}, Query: "journy",
+				SearchOperator: "OPERATOR_AND", Fuzziness: &searchparams.Fuzziness{MaxEdits: 1},
+			}, nil, nil, additional.Properties{}, nil, "", 0, props)
+			require.NotNil(t, err)
+		})
+
+		for _, index := range repo.indices {
+			index.ForEachShard(func(name string, shard ShardLike) error {
+				err := shard.Store().FlushMemtables(context.Background())
+				require.Nil(t, err)
+				return nil
+			})
+		}
+	}
+
+	t.Run("deleted terms do not take expansion slots", func(t *testing.T) {
+		exact := docIDs(t, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"description"}, Query: "journey"})
+		require.NotEmpty(t, exact)
+
+		// "journe" sorts before "journey" and has the same distance to the
+		// query, but only matches a deleted object
+		id := strfmt.UUID("00000000-0000-0000-0000-000000000100")
+		obj := &models.Object{Class: "MyClass", ID: id, Properties: map[string]interface{}{"description": "journe"}}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}, nil, nil, nil, 0))
+		require.Nil(t, repo.DeleteObject(context.Background(), "MyClass", id, time.Now(), nil, "", 0))
+
+		fuzzy := docIDs(t, &searchparams.KeywordRanking{
+			Type: "bm25", Properties: []string{"description"}, Query: "journy",
+			Fuzziness: &searchparams.Fuzziness{MaxEdits: 1, PrefixLength: 2, MaxExpansions: 1},
+		})
+		assert.ElementsMatch(t, exact, fuzzy)
+	})
+}
+
+func TestBM25FPhraseBlock(t *testing.T) {
+	config.DefaultUsingBlockMaxWAND = true
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	shardState := singleShardState()
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: shardState,
+	}
+	mockSchemaReader := schemaUC.NewMockSchemaReader(t)
+	mockSchemaReader.EXPECT().Shards(mock.Anything).Return(shardState.AllPhysicalShards(), nil).Maybe()
+	mockSchemaReader.EXPECT().Read(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(className string, retryIfClassNotFound bool, readFunc func(*models.Class, *sharding.State) error) error {
+		class := &models.Class{Class: className}
+		return readFunc(class, shardState)
+	}).Maybe()
+	mockSchemaReader.EXPECT().ReadOnlySchema().Return(models.Schema{Classes: nil}).Maybe()
+	mockSchemaReader.EXPECT().ShardReplicas(mock.Anything, mock.Anything).Return([]string{"node1"}, nil).Maybe()
+	mockReplicationFSMReader := replicationTypes.NewMockReplicationFSMReader(t)
+	mockReplicationFSMReader.EXPECT().FilterOneShardReplicasRead(mock.Anything, mock.Anything, mock.Anything).Return([]string{"node1"}).Maybe()
+	mockReplicationFSMReader.EXPECT().FilterOneShardReplicasWrite(mock.Anything, mock.Anything, mock.Anything).Return([]string{"node1"}, nil).Maybe()
+	mockNodeSelector := cluster.NewMockNodeSelector(t)
+	mockNodeSelector.EXPECT().LocalName().Return("node1").Maybe()
+	mockNodeSelector.EXPECT().NodeHostname(mock.Anything).Return("node1", true).Maybe()
+	repo, err := New(logger, "node1", Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &FakeRemoteClient{}, &FakeNodeResolver{}, &FakeRemoteNodeClient{}, nil, nil, memwatch.NewDummyMonitor(),
+		mockNodeSelector, mockSchemaReader, mockReplicationFSMReader)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	vFalse := false
+	vTrue := true
+	invertedConfig := BM25FinvertedConfig(1.2, 0.75, "none")
+	invertedConfig.IndexPositions = true
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig,
+		Class:               "PhraseClass",
+		Properties: []*models.Property{
+			{
+				Name:            "description",
+				DataType:        schema.DataTypeText.PropString(),
+				Tokenization:    models.PropertyTokenizationWord,
+				IndexFilterable: &vFalse,
+				IndexSearchable: &vTrue,
+			},
+			{
+				Name:            "tags",
+				DataType:        schema.DataTypeTextArray.PropString(),
+				Tokenization:    models.PropertyTokenizationWord,
+				IndexFilterable: &vFalse,
+				IndexSearchable: &vTrue,
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}
+	require.Nil(t, NewMigrator(repo, logger, "node1").AddClass(context.Background(), class))
+
+	testData := []map[string]interface{}{
+		{"description": "We moved to New York last year"},
+		{"description": "York is old, the new town is not"},
+		{"description": "New and shiny York"},
+		{"description": "nothing to see here", "tags": []string{"new", "york"}},
+		{"description": "the city of new york", "tags": []string{"new york"}},
+	}
+	ids := make([]strfmt.UUID, len(testData))
+	for i, data := range testData {
+		ids[i] = strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+		obj := &models.Object{Class: "PhraseClass", ID: ids[i], Properties: data}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}, nil, nil, nil, 0))
+	}
+
+	idx := repo.GetIndex("PhraseClass")
+	require.NotNil(t, idx)
+
+	search := func(t *testing.T, query string, properties ...string) []strfmt.UUID {
+		res, _, err := idx.objectSearch(context.TODO(), 1000, nil, &searchparams.KeywordRanking{
+			Type: "bm25", Properties: properties, Query: query,
+		}, nil, nil, additional.Properties{}, nil, "", 0, []string{"description", "tags"})
+		require.Nil(t, err)
+		found := make([]strfmt.UUID, len(res))
+		for i, r := range res {
+			found[i] = r.ID()
+		}
+		return found
+	}
+
+	for _, location := range []string{"memory", "disk"} {
+		t.Run("bm25f phrase "+location, func(t *testing.T) {
+			assert.Len(t, search(t, "new york", "description", "tags"), 5)
+			assert.ElementsMatch(t, []strfmt.UUID{ids[0], ids[4]}, search(t, `"new york"`, "description", "tags"))
+			assert.ElementsMatch(t, []strfmt.UUID{ids[0], ids[4]}, search(t, `"new york"`, "description"))
+			assert.ElementsMatch(t, []strfmt.UUID{ids[4]}, search(t, `"new york"`, "tags"))
+			assert.ElementsMatch(t, []strfmt.UUID{ids[0], ids[2], ids[4]}, search(t, `"new york"~2`, "description"))
+			assert.ElementsMatch(t, []strfmt.UUID{ids[0], ids[4]}, search(t, `"new york" city`, "description"))
+			assert.ElementsMatch(t, []strfmt.UUID{ids[4]}, search(t, `"new york" "the city"`, "description"))
+			assert.Empty(t, search(t, `"york new"`, "description"))
+		})
+
+		for _, index := range repo.indices {
+			index.ForEachShard(func(name string, shard ShardLike) error {
+				err := shard.Store().FlushMemtables(context.Background())
+				require.Nil(t, err)
+				return nil
+			})
+		}
+	}
+
+	t.Run("update and delete", func(t *testing.T) {
+		obj := &models.Object{Class: "PhraseClass", ID: ids[2], Properties: map[string]interface{}{"description": "shiny New York"}}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}, nil, nil, nil, 0))
+		obj = &models.Object{Class: "PhraseClass", ID: ids[0], Properties: map[string]interface{}{"description": "York we moved to, new it was"}}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}, nil, nil, nil, 0))
+		require.Nil(t, repo.DeleteObject(context.Background(), "PhraseClass", ids[4], time.Now(), nil, "", 0))
+
+		assert.ElementsMatch(t, []strfmt.UUID{ids[2]}, search(t, `"new york"`, "description", "tags"))
+	})
+}
+
 func TestBM25FWithFiltersBlock(t *testing.T) {
 	config.DefaultUsingBlockMaxWAND = true
 	dirName := t.TempDir()
@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/changestream"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// changeStreamReadBatchSize is the maximum number of events read from a
+// shard's change stream at once
+const changeStreamReadBatchSize = 100
+
+// StreamChanges follows the change streams of the shards of a collection that
+// are held by this node and calls fn for every event, in order per shard. If
+// tenant is set, only the shard of that tenant is followed. checkpoints holds
+// the last sequence already consumed per shard, missing shards are read from
+// the beginning of their retained stream.
+//
+// fn may be called concurrently for different shards. StreamChanges blocks
+// until the context is cancelled, fn returns an error or one of the followed
+// shards is shut down.
+func (db *DB) StreamChanges(ctx context.Context, className, tenant string,
+	checkpoints map[string]uint64, fn func(shard string, event changestream.Event) error,
+) error {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return fmt.Errorf("stream changes from non-existing index for %s", className)
+	}
+	if !idx.Config.ChangeStreamEnabled {
+		return fmt.Errorf("change stream is not enabled for collection %s", className)
+	}
+
+	logs, err := idx.localChangeStreams(ctx, tenant)
+	if err != nil {
+		return fmt.Errorf("stream changes from index %q: %w", idx.ID(), err)
+	}
+
+	eg, ctx := enterrors.NewErrorGroupWithContextWrapper(db.logger, ctx)
+	for shard, log := range logs {
+		eg.Go(func() error {
+			return followChangeStream(ctx, log, checkpoints[shard], func(event changestream.Event) error {
+				return fn(shard, event)
+			})
+		}, shard)
+	}
+
+	return eg.Wait()
+}
+
+// localChangeStreams returns the change streams of the local shards, or of
+// the tenant's shard if tenant is set.
+func (i *Index) localChangeStreams(ctx context.Context, tenant string) (map[string]*changestream.Log, error) {
+	if i.partitioningEnabled && tenant == "" {
+		return nil, fmt.Errorf("class %s has multi-tenancy enabled, but request was without tenant", i.Config.ClassName)
+	}
+	if !i.partitioningEnabled && tenant != "" {
+		return nil, fmt.Errorf("class %s has multi-tenancy disabled, but request was with tenant", i.Config.ClassName)
+	}
+
+	var names []string
+	if tenant != "" {
+		names = []string{tenant}
+	} else {
+		i.ForEachShard(func(name string, _ ShardLike) error {
+			names = append(names, name)
+			return nil
+		})
+		sort.Strings(names)
+	}
+
+	logs := make(map[string]*changestream.Log, len(names))
+	for _, name := range names {
+		shard, release, err := i.GetShard(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if shard == nil {
+			return nil, fmt.Errorf("shard %q is not present on this node", name)
+		}
+
+		log := shard.ChangeStream()
+		release()
+		if log == nil {
+			return nil, fmt.Errorf("shard %q has no change stream", name)
+		}
+		logs[name] = log
+	}
+
+	return logs, nil
+}
+
+func followChangeStream(ctx context.Context, log *changestream.Log, after uint64,
+	fn func(event changestream.Event) error,
+) error {
+	for {
+		events, err := log.Read(after, changeStreamReadBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			if err := fn(event); err != nil {
+				return err
+			}
+			after = event.Sequence
+		}
+
+		if len(events) > 0 {
+			continue
+		}
+
+		if err := log.Wait(ctx, after); err != nil {
+			if errors.Is(err, context.Canceled) {
+				return nil
+			}
+			return err
+		}
+	}
+}
@@ -0,0 +1,337 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package changestream implements a per-shard, ordered and resumable log of
+// object writes (inserts, updates and deletes). It is used for change data
+// capture, i.e. to mirror the contents of a collection into external systems.
+package changestream
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+type Operation uint8
+
+const (
+	OperationInsert Operation = iota + 1
+	OperationUpdate
+	OperationDelete
+)
+
+func (o Operation) String() string {
+	switch o {
+	case OperationInsert:
+		return "insert"
+	case OperationUpdate:
+		return "update"
+	case OperationDelete:
+		return "delete"
+	default:
+		return fmt.Sprintf("unknown(%d)", o)
+	}
+}
+
+var (
+	// ErrClosed is returned when the log is read from or waited on after the
+	// owning shard has been shut down.
+	ErrClosed = errors.New("change stream closed")
+	// ErrCheckpointExpired is returned when a reader tries to resume from a
+	// sequence that has already been removed by the retention policy. The
+	// reader has missed events and needs to re-synchronize from scratch.
+	ErrCheckpointExpired = errors.New("checkpoint is older than the retained change stream")
+	// ErrCheckpointInFuture is returned when a reader tries to resume from a
+	// sequence that has not been written yet.
+	ErrCheckpointInFuture = errors.New("checkpoint is ahead of the change stream")
+)
+
+// lastSequenceKey stores the sequence of the most recent event. It is 3
+// bytes long and can therefore never collide with an (8 byte) event key.
+// Since it starts with 0x73 it also sorts after every event key that could
+// realistically be written.
+var lastSequenceKey = []byte("seq")
+
+const (
+	eventKeyLength = 8
+	eventVersion   = uint8(1)
+	// version(1) + operation(1) + update time(8) + uuid(16)
+	eventHeaderLength = 1 + 1 + 8 + 16
+)
+
+// IsEnabled reports whether a collection records its writes in a change
+// stream. Change capture is opt-in per collection and fixed at creation.
+func IsEnabled(config *models.ChangeStreamConfig) bool {
+	return config != nil && config.Enabled
+}
+
+// Event is a single write to a shard. Object is nil for deletions.
+type Event struct {
+	Sequence   uint64
+	Operation  Operation
+	ID         strfmt.UUID
+	UpdateTime int64
+	Object     *storobj.Object
+}
+
+// Log is the change stream of a single shard. Events are stored in their own
+// lsmkv bucket keyed by a monotonically increasing sequence number, so they
+// share the durability guarantees (WAL, flushes, backups) of the rest of the
+// shard.
+//
+// Appends to the same shard are serialized, so that sequences are assigned
+// in the order the events become visible to readers. Shards, and therefore
+// collections, do not share a log and do not contend with each other.
+type Log struct {
+	sync.RWMutex
+	bucket    *lsmkv.Bucket
+	retention uint64
+	last      uint64
+	// notify is closed and replaced whenever a new event is appended, which
+	// wakes up all readers blocked in Wait.
+	notify chan struct{}
+	closed bool
+}
+
+// New opens the change stream stored in bucket. retention is the maximum
+// number of events kept, older events are removed as new ones are appended.
+// Events left over from a larger retention are removed when the log is
+// opened. A retention of 0 keeps all events.
+func New(bucket *lsmkv.Bucket, retention uint64) (*Log, error) {
+	l := &Log{
+		bucket:    bucket,
+		retention: retention,
+		notify:    make(chan struct{}),
+	}
+
+	v, err := bucket.Get(lastSequenceKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "read last sequence")
+	}
+	if len(v) == eventKeyLength {
+		l.last = binary.BigEndian.Uint64(v)
+	}
+
+	if err := l.expire(); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// expire removes all events older than the oldest retained one. Append only
+// removes the event which falls out of the retention, older events are left
+// behind if the retention was lowered.
+func (l *Log) expire() error {
+	oldest := sequenceKey(l.oldestRetained())
+
+	var expired [][]byte
+	c := l.bucket.Cursor()
+	for k, _ := c.First(); k != nil && bytes.Compare(k, oldest) < 0; k, _ = c.Next() {
+		if len(k) == eventKeyLength {
+			expired = append(expired, bytes.Clone(k))
+		}
+	}
+	c.Close()
+
+	for _, k := range expired {
+		if err := l.bucket.Delete(k); err != nil {
+			return errors.Wrapf(err, "expire change event %d", binary.BigEndian.Uint64(k))
+		}
+	}
+	return nil
+}
+
+// Append records a write. id is the binary representation of the object's
+// uuid and objBinary the marshalled object as stored in the objects bucket,
+// it can be nil for deletions.
+func (l *Log) Append(op Operation, id []byte, updateTime int64, objBinary []byte) error {
+	l.Lock()
+	defer l.Unlock()
+
+	if l.closed {
+		return ErrClosed
+	}
+
+	seq := l.last + 1
+	value := make([]byte, eventHeaderLength+len(objBinary))
+	value[0] = eventVersion
+	value[1] = byte(op)
+	binary.LittleEndian.PutUint64(value[2:10], uint64(updateTime))
+	copy(value[10:26], id)
+	copy(value[eventHeaderLength:], objBinary)
+
+	if err := l.bucket.Put(sequenceKey(seq), value); err != nil {
+		return errors.Wrapf(err, "store change event %d", seq)
+	}
+	if err := l.bucket.Put(lastSequenceKey, sequenceKey(seq)); err != nil {
+		return errors.Wrapf(err, "store last sequence %d", seq)
+	}
+
+	if l.retention > 0 && seq > l.retention {
+		if err := l.bucket.Delete(sequenceKey(seq - l.retention)); err != nil {
+			return errors.Wrapf(err, "expire change event %d", seq-l.retention)
+		}
+	}
+
+	l.last = seq
+	close(l.notify)
+	l.notify = make(chan struct{})
+
+	return nil
+}
+
+// LastSequence returns the sequence of the most recent event, 0 if no event
+// has been written yet.
+func (l *Log) LastSequence() uint64 {
+	l.RLock()
+	defer l.RUnlock()
+	return l.last
+}
+
+// Read returns up to limit events with a sequence greater than after, in
+// order. An empty result means the reader is up-to-date. Sequences start at 1,
+// so an after of 0 reads from the oldest retained event.
+func (l *Log) Read(after uint64, limit int) ([]Event, error) {
+	l.RLock()
+	defer l.RUnlock()
+
+	if l.closed {
+		return nil, ErrClosed
+	}
+	if after == 0 {
+		after = l.oldestRetained() - 1
+	}
+	if after > l.last {
+		return nil, fmt.Errorf("%w: sequence %d, last %d", ErrCheckpointInFuture, after, l.last)
+	}
+	if after == l.last {
+		return nil, nil
+	}
+	if oldest := l.oldestRetained(); after+1 < oldest {
+		return nil, fmt.Errorf("%w: sequence %d, oldest retained %d", ErrCheckpointExpired, after, oldest)
+	}
+
+	c := l.bucket.Cursor()
+	defer c.Close()
+
+	events := make([]Event, 0, min(limit, int(l.last-after)))
+	for k, v := c.Seek(sequenceKey(after + 1)); k != nil && len(events) < limit; k, v = c.Next() {
+		if len(k) != eventKeyLength {
+			continue
+		}
+		ev, err := parseEvent(binary.BigEndian.Uint64(k), v)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, ev)
+	}
+
+	return events, nil
+}
+
+// Wait blocks until an event with a sequence greater than after has been
+// appended, the context is cancelled or the log is closed.
+func (l *Log) Wait(ctx context.Context, after uint64) error {
+	l.RLock()
+	if l.closed {
+		l.RUnlock()
+		return ErrClosed
+	}
+	if l.last > after {
+		l.RUnlock()
+		return nil
+	}
+	notify := l.notify
+	l.RUnlock()
+
+	select {
+	case <-notify:
+		l.RLock()
+		defer l.RUnlock()
+		if l.closed {
+			return ErrClosed
+		}
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close wakes up all waiting readers and rejects further reads and writes.
+// It does not close the underlying bucket, which is owned by the shard's
+// store.
+func (l *Log) Close() {
+	l.Lock()
+	defer l.Unlock()
+
+	if l.closed {
+		return
+	}
+	l.closed = true
+	close(l.notify)
+}
+
+func (l *Log) oldestRetained() uint64 {
+	if l.retention == 0 || l.last <= l.retention {
+		return 1
+	}
+	return l.last - l.retention + 1
+}
+
+func sequenceKey(seq uint64) []byte {
+	key := make([]byte, eventKeyLength)
+	binary.BigEndian.PutUint64(key, seq)
+	return key
+}
+
+func parseEvent(seq uint64, value []byte) (Event, error) {
+	if len(value) < eventHeaderLength {
+		return Event{}, fmt.Errorf("change event %d: unexpected length %d", seq, len(value))
+	}
+	if value[0] != eventVersion {
+		return Event{}, fmt.Errorf("change event %d: unsupported version %d", seq, value[0])
+	}
+
+	id, err := uuid.FromBytes(value[10:26])
+	if err != nil {
+		return Event{}, errors.Wrapf(err, "change event %d: parse uuid", seq)
+	}
+
+	ev := Event{
+		Sequence:   seq,
+		Operation:  Operation(value[1]),
+		UpdateTime: int64(binary.LittleEndian.Uint64(value[2:10])),
+		ID:         strfmt.UUID(id.String()),
+	}
+
+	if len(value) > eventHeaderLength {
+		// the object binary needs to be copied, the cursor reuses its buffers
+		objBinary := make([]byte, len(value)-eventHeaderLength)
+		copy(objBinary, value[eventHeaderLength:])
+		ev.Object, err = storobj.FromBinary(objBinary)
+		if err != nil {
+			return Event{}, errors.Wrapf(err, "change event %d: unmarshal object", seq)
+		}
+	}
+
+	return ev, nil
+}
@@ -0,0 +1,232 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package changestream
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	logrustest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+func newTestBucket(t *testing.T, dir string) *lsmkv.Bucket {
+	logger, _ := logrustest.NewNullLogger()
+	bucket, err := lsmkv.NewBucketCreator().NewBucket(context.Background(), dir, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		lsmkv.WithStrategy(lsmkv.StrategyReplace))
+	require.Nil(t, err)
+	return bucket
+}
+
+func appendObject(t *testing.T, l *Log, op Operation, id strfmt.UUID, props map[string]interface{}) {
+	obj := storobj.FromObject(&models.Object{
+		ID:                 id,
+		Class:              "Article",
+		LastUpdateTimeUnix: 1000,
+		Properties:         props,
+	}, []float32{1, 2, 3}, nil, nil)
+	objBinary, err := obj.MarshalBinary()
+	require.Nil(t, err)
+
+	idBytes, err := uuid.MustParse(id.String()).MarshalBinary()
+	require.Nil(t, err)
+	require.Nil(t, l.Append(op, idBytes, obj.LastUpdateTimeUnix(), objBinary))
+}
+
+func TestLog_AppendAndRead(t *testing.T) {
+	l, err := New(newTestBucket(t, t.TempDir()), 0)
+	require.Nil(t, err)
+
+	id1 := strfmt.UUID("8d5a3aa2-3c8d-4589-9ae1-3f638f506003")
+	id2 := strfmt.UUID("c6a8b4f1-1e0e-4b36-a9a4-7e2f9f0ab3d1")
+
+	appendObject(t, l, OperationInsert, id1, map[string]interface{}{"title": "first"})
+	appendObject(t, l, OperationInsert, id2, map[string]interface{}{"title": "second"})
+	appendObject(t, l, OperationUpdate, id1, map[string]interface{}{"title": "first, updated"})
+
+	idBytes, err := uuid.MustParse(id2.String()).MarshalBinary()
+	require.Nil(t, err)
+	require.Nil(t, l.Append(OperationDelete, idBytes, 2000, nil))
+
+	assert.Equal(t, uint64(4), l.LastSequence())
+
+	events, err := l.Read(0, 100)
+	require.Nil(t, err)
+	require.Len(t, events, 4)
+
+	assert.Equal(t, uint64(1), events[0].Sequence)
+	assert.Equal(t, OperationInsert, events[0].Operation)
+	assert.Equal(t, id1, events[0].ID)
+	assert.Equal(t, "first", events[0].Object.Properties().(map[string]interface{})["title"])
+	assert.Equal(t, []float32{1, 2, 3}, events[0].Object.Vector)
+
+	assert.Equal(t, OperationUpdate, events[2].Operation)
+	assert.Equal(t, "first, updated", events[2].Object.Properties().(map[string]interface{})["title"])
+
+	assert.Equal(t, uint64(4), events[3].Sequence)
+	assert.Equal(t, OperationDelete, events[3].Operation)
+	assert.Equal(t, id2, events[3].ID)
+	assert.Equal(t, int64(2000), events[3].UpdateTime)
+	assert.Nil(t, events[3].Object)
+
+	t.Run("resume from checkpoint", func(t *testing.T) {
+		events, err := l.Read(2, 1)
+		require.Nil(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, uint64(3), events[0].Sequence)
+	})
+
+	t.Run("up-to-date reader", func(t *testing.T) {
+		events, err := l.Read(4, 100)
+		require.Nil(t, err)
+		assert.Empty(t, events)
+	})
+
+	t.Run("checkpoint in the future", func(t *testing.T) {
+		_, err := l.Read(5, 100)
+		assert.ErrorIs(t, err, ErrCheckpointInFuture)
+	})
+}
+
+func TestLog_Retention(t *testing.T) {
+	l, err := New(newTestBucket(t, t.TempDir()), 2)
+	require.Nil(t, err)
+
+	for i := 0; i < 5; i++ {
+		appendObject(t, l, OperationInsert, strfmt.UUID(uuid.NewString()), nil)
+	}
+
+	_, err = l.Read(1, 100)
+	assert.ErrorIs(t, err, ErrCheckpointExpired)
+
+	// readers without a checkpoint start at the oldest retained event
+	events, err := l.Read(0, 100)
+	require.Nil(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, uint64(4), events[0].Sequence)
+
+	events, err = l.Read(3, 100)
+	require.Nil(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, uint64(4), events[0].Sequence)
+	assert.Equal(t, uint64(5), events[1].Sequence)
+}
+
+func TestLog_RestoresSequenceOnRestart(t *testing.T) {
+	dir := t.TempDir()
+	bucket := newTestBucket(t, dir)
+	l, err := New(bucket, 0)
+	require.Nil(t, err)
+
+	for i := 0; i < 3; i++ {
+		appendObject(t, l, OperationInsert, strfmt.UUID(uuid.NewString()), nil)
+	}
+	l.Close()
+	require.Nil(t, bucket.Shutdown(context.Background()))
+
+	l, err = New(newTestBucket(t, dir), 0)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(3), l.LastSequence())
+
+	appendObject(t, l, OperationInsert, strfmt.UUID(uuid.NewString()), nil)
+	events, err := l.Read(2, 100)
+	require.Nil(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, uint64(3), events[0].Sequence)
+	assert.Equal(t, uint64(4), events[1].Sequence)
+}
+
+func TestLog_LowerRetentionOnRestart(t *testing.T) {
+	dir := t.TempDir()
+	bucket := newTestBucket(t, dir)
+	l, err := New(bucket, 0)
+	require.Nil(t, err)
+
+	for i := 0; i < 5; i++ {
+		appendObject(t, l, OperationInsert, strfmt.UUID(uuid.NewString()), nil)
+	}
+	l.Close()
+	require.Nil(t, bucket.Shutdown(context.Background()))
+
+	bucket = newTestBucket(t, dir)
+	l, err = New(bucket, 2)
+	require.Nil(t, err)
+
+	// the events before the retention are removed, not just skipped
+	for seq := uint64(1); seq <= 3; seq++ {
+		v, err := bucket.Get(sequenceKey(seq))
+		require.Nil(t, err)
+		assert.Nil(t, v, "event %d", seq)
+	}
+
+	events, err := l.Read(0, 100)
+	require.Nil(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, uint64(4), events[0].Sequence)
+	assert.Equal(t, uint64(5), events[1].Sequence)
+}
+
+func TestLog_Wait(t *testing.T) {
+	l, err := New(newTestBucket(t, t.TempDir()), 0)
+	require.Nil(t, err)
+
+	t.Run("returns once an event is appended", func(t *testing.T) {
+		done := make(chan error)
+		go func() {
+			done <- l.Wait(context.Background(), 0)
+		}()
+
+		appendObject(t, l, OperationInsert, strfmt.UUID(uuid.NewString()), nil)
+
+		select {
+		case err := <-done:
+			assert.Nil(t, err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("wait did not return after append")
+		}
+	})
+
+	t.Run("returns on context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, l.Wait(ctx, 1), context.DeadlineExceeded)
+	})
+
+	t.Run("returns when closed", func(t *testing.T) {
+		done := make(chan error)
+		go func() {
+			done <- l.Wait(context.Background(), 1)
+		}()
+
+		l.Close()
+
+		select {
+		case err := <-done:
+			assert.ErrorIs(t, err, ErrClosed)
+		case <-time.After(5 * time.Second):
+			t.Fatal("wait did not return after close")
+		}
+
+		_, err := l.Read(0, 100)
+		assert.ErrorIs(t, err, ErrClosed)
+	})
+}
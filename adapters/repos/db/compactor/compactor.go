@@ -117,6 +117,14 @@ func WriteHeader(mw *MemoryWriter, w io.WriteSeeker, bufw Writer, f *segmentinde
 		IndexStart:       startOfIndex,
 	}
 
+	return WriteSegmentHeader(mw, w, bufw, f, h)
+}
+
+// WriteSegmentHeader does the same as WriteHeader, but for a header which was
+// already assembled, e.g. because it holds a compression
+func WriteSegmentHeader(mw *MemoryWriter, w io.WriteSeeker, bufw Writer, f *segmentindex.SegmentFile,
+	h *segmentindex.Header,
+) error {
 	if mw == nil {
 		if _, err := w.Seek(0, io.SeekStart); err != nil {
 			return fmt.Errorf("seek to beginning to write header: %w", err)
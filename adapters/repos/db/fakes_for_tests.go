@@ -392,6 +392,12 @@ func (f *FakeRemoteClient) GetShardStatus(ctx context.Context,
 	return "", nil
 }
 
+func (f *FakeRemoteClient) GetShardReindexed(ctx context.Context,
+	hostName, indexName, shardName, task string,
+) (bool, error) {
+	return false, nil
+}
+
 func (f *FakeRemoteClient) UpdateShardStatus(ctx context.Context, hostName, indexName, shardName,
 	targetStatus string, schemaVersion uint64,
 ) error {
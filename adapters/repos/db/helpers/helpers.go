@@ -27,6 +27,8 @@ var (
 	VectorsBucketLSM           = "vectors"
 	DimensionsBucketLSM        = "dimensions"
 	VectorsCompressedBucketLSM = "vectors_compressed"
+	ChangeStreamBucketLSM      = "change_stream"
+	SparseVectorsBucketLSM     = "vectors_sparse"
 )
 
 const ObjectsBucketLSMDocIDSecondaryIndex int = 0
@@ -38,6 +40,12 @@ func GetCompressedBucketName(targetVector string) string {
 	return VectorsCompressedBucketLSM
 }
 
+// BucketSparseVectorLSM creates the name of the inverted bucket holding the
+// postings of a sparse named vector
+func BucketSparseVectorLSM(targetVector string) string {
+	return fmt.Sprintf("%s_%s", SparseVectorsBucketLSM, targetVector)
+}
+
 // MetaCountProp helps create an internally used propName for meta props that
 // don't explicitly exist in the user schema, but are required for proper
 // indexing, such as the count of arrays.
@@ -79,6 +87,12 @@ func BucketSearchableFromPropNameLSM(propName string) string {
 	return BucketFromPropNameLSM(propName + "_searchable")
 }
 
+// BucketSearchablePositionsFromPropNameLSM creates the name of the bucket
+// holding the positions of the terms of a searchable property
+func BucketSearchablePositionsFromPropNameLSM(propName string) string {
+	return BucketFromPropNameLSM(propName + "_searchable_positions")
+}
+
 func BucketRangeableFromPropNameLSM(propName string) string {
 	return BucketFromPropNameLSM(propName + "_rangeable")
 }
@@ -0,0 +1,49 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package helpers
+
+import (
+	"context"
+
+	"github.com/weaviate/weaviate/entities/additional"
+)
+
+type vectorSearchProfileKey struct{}
+
+// InitVectorSearchProfile returns a context in which a vector index records
+// the filter strategy of its search, see RecordFilterStrategy.
+func InitVectorSearchProfile(ctx context.Context) (context.Context, *additional.VectorSearchProfile) {
+	profile := &additional.VectorSearchProfile{}
+	return context.WithValue(ctx, vectorSearchProfileKey{}, profile), profile
+}
+
+// RecordFilterStrategy records the strategy of a filtered vector search in
+// the profile of the context, if any, and in the slow query details.
+func RecordFilterStrategy(ctx context.Context, strategy, reason string,
+	allowListSize, indexSize int,
+) {
+	AnnotateSlowQueryLog(ctx, "filter_strategy", strategy)
+	AnnotateSlowQueryLog(ctx, "filter_strategy_reason", reason)
+
+	profile, ok := ctx.Value(vectorSearchProfileKey{}).(*additional.VectorSearchProfile)
+	if !ok {
+		return
+	}
+	profile.FilterStrategy = strategy
+	profile.Reason = reason
+	profile.AllowListSize = allowListSize
+	profile.IndexSize = indexSize
+	profile.Selectivity = 1
+	if indexSize > 0 {
+		profile.Selectivity = min(1, float64(allowListSize)/float64(indexSize))
+	}
+}
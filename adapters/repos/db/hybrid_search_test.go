@@ -1016,6 +1016,10 @@ func (f *fakeObjectSearcher) VectorSearch(context.Context, dto.GetParams, []stri
 	return nil, nil
 }
 
+func (f *fakeObjectSearcher) VectorSearchBatch(context.Context, dto.GetParams, string, [][]float32) ([][]search.Result, error) {
+	return nil, nil
+}
+
 func (f *fakeObjectSearcher) CrossClassVectorSearch(context.Context, models.Vector, string, int, int, *filters.LocalFilter) ([]search.Result, error) {
 	return nil, nil
 }
@@ -67,6 +67,7 @@ import (
 	authzerrors "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/config"
 	configRuntime "github.com/weaviate/weaviate/usecases/config/runtime"
+	"github.com/weaviate/weaviate/usecases/encryption"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
@@ -277,6 +278,11 @@ type Index struct {
 	closingCtx    context.Context
 	closingCancel context.CancelFunc
 
+	// droppingProperties holds the deleted properties whose buckets and
+	// values are being removed, see dropProperty
+	droppingPropertiesLock sync.Mutex
+	droppingProperties     map[string]chan struct{}
+
 	// always true if lazy shard loading is off, in the case of lazy shard
 	// loading will be set to true once the last shard was loaded.
 	allShardsReady atomic.Bool
@@ -284,6 +290,10 @@ type Index struct {
 
 	replicationConfigLock sync.RWMutex
 
+	// guards Config.ObjectsCompression, which changes with the
+	// objectStorageConfig of the class
+	objectsCompressionLock sync.RWMutex
+
 	shardLoadLimiter ShardLoadLimiter
 
 	closed bool
@@ -386,6 +396,7 @@ func NewIndex(
 		shardResolver:           shardResolver,
 		bitmapBufPool:           bitmapBufPool,
 		HFreshEnabled:           cfg.HFreshEnabled,
+		droppingProperties:      map[string]chan struct{}{},
 	}
 
 	getDeletionStrategy := func() string {
@@ -414,6 +425,10 @@ func NewIndex(
 		return nil, err
 	}
 
+	if err := index.resumeDroppedProperties(class); err != nil {
+		return nil, fmt.Errorf("resume dropping properties of index %q: %w", index.ID(), err)
+	}
+
 	index.cycleCallbacks.compactionCycle.Start()
 	index.cycleCallbacks.compactionAuxCycle.Start()
 	index.cycleCallbacks.flushCycle.Start()
@@ -682,6 +697,137 @@ func (i *Index) addProperty(ctx context.Context, props ...*models.Property) erro
 	return nil
 }
 
+// dropProperty records that a property was deleted from the schema and
+// removes its buckets and values in the background, as this takes a while for
+// large shards. It is called while the schema change is applied.
+func (i *Index) dropProperty(propName string) error {
+	if err := recordDroppedProperty(i.path(), propName); err != nil {
+		return errors.Wrapf(err, "record dropped property '%s' of idx '%s'", propName, i.ID())
+	}
+	i.dropPropertyInBackground(propName)
+	return nil
+}
+
+func (i *Index) dropPropertyInBackground(propName string) {
+	i.droppingPropertiesLock.Lock()
+	defer i.droppingPropertiesLock.Unlock()
+
+	if _, ok := i.droppingProperties[propName]; ok {
+		return
+	}
+	done := make(chan struct{})
+	i.droppingProperties[propName] = done
+
+	enterrors.GoWrapper(func() {
+		defer func() {
+			i.droppingPropertiesLock.Lock()
+			delete(i.droppingProperties, propName)
+			i.droppingPropertiesLock.Unlock()
+			close(done)
+		}()
+
+		logger := i.logger.WithField("action", "drop_property").WithField("property", propName)
+		started := time.Now()
+		if err := i.removeDroppedProperty(i.closingCtx, propName); err != nil {
+			// the property stays recorded and is removed after the next restart
+			logger.WithError(err).Error("removing buckets and values of deleted property failed")
+			return
+		}
+		if err := unrecordDroppedProperty(i.path(), propName); err != nil {
+			logger.WithError(err).Error("removing record of deleted property failed")
+			return
+		}
+		logger.WithField("took", time.Since(started)).Info("removed buckets and values of deleted property")
+	}, i.logger)
+}
+
+// removeDroppedProperty removes the buckets and values of a deleted property
+// from all shards. Shards which are not loaded, including the ones of
+// inactive tenants that are only present on disk, strip the values once
+// loaded.
+func (i *Index) removeDroppedProperty(ctx context.Context, propName string) error {
+	eg := enterrors.NewErrorGroupWrapper(i.logger)
+	eg.SetLimit(_NUMCPU)
+
+	i.ForEachShard(func(name string, shard ShardLike) error {
+		eg.Go(func() error {
+			if err := shard.dropProperty(ctx, propName); err != nil {
+				return errors.Wrapf(err, "shard %s", name)
+			}
+			return nil
+		})
+		return nil
+	})
+
+	entries, err := os.ReadDir(i.path())
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "read dir of idx '%s'", i.ID())
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.IsDir() || i.shards.Load(name) != nil {
+			continue
+		}
+		eg.Go(func() error {
+			// prevent the tenant from being activated meanwhile
+			i.shardCreateLocks.Lock(name)
+			defer i.shardCreateLocks.Unlock(name)
+
+			if shard := i.shards.Load(name); shard != nil {
+				return shard.dropProperty(ctx, propName)
+			}
+			return removeDroppedPropertyOfUnloadedShard(i.path(), name, propName)
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return errors.Wrapf(err, "drop property '%s' from idx '%s'", propName, i.ID())
+	}
+	return nil
+}
+
+// awaitDroppedProperties waits until the buckets and values of previously
+// deleted properties with the same names are removed, so that they are not
+// mixed up with the ones of the properties being added
+func (i *Index) awaitDroppedProperties(ctx context.Context, props ...*models.Property) error {
+	for _, prop := range props {
+		i.droppingPropertiesLock.Lock()
+		done := i.droppingProperties[prop.Name]
+		i.droppingPropertiesLock.Unlock()
+		if done == nil {
+			continue
+		}
+
+		select {
+		case <-done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// resumeDroppedProperties continues to remove the properties which were
+// deleted before the last shutdown. Properties which were added again
+// meanwhile were removed before they were added, see awaitDroppedProperties.
+func (i *Index) resumeDroppedProperties(class *models.Class) error {
+	props, err := loadDroppedProperties(i.path())
+	if err != nil {
+		return err
+	}
+
+	for _, propName := range props {
+		if _, err := schema.GetPropertyByName(class, propName); err == nil {
+			if err := unrecordDroppedProperty(i.path(), propName); err != nil {
+				return err
+			}
+			continue
+		}
+		i.dropPropertyInBackground(propName)
+	}
+	return nil
+}
+
 func (i *Index) updateVectorIndexConfig(ctx context.Context,
 	updated schemaConfig.VectorIndexConfig,
 ) error {
@@ -730,6 +876,65 @@ func (i *Index) updateVectorIndexConfigs(ctx context.Context,
 	return nil
 }
 
+// reindexVectorIndex moves the named vector to a vector index of another
+// type or distance. Every shard builds the new index in the background and
+// swaps it in once complete.
+func (i *Index) reindexVectorIndex(ctx context.Context, targetVector string,
+	updated schemaConfig.VectorIndexConfig,
+) error {
+	previous := i.GetVectorIndexConfig(targetVector)
+	if previous == nil {
+		return fmt.Errorf("vector index of target vector %q not found in idx %q", targetVector, i.ID())
+	}
+
+	reindex := func() error {
+		eg := enterrors.NewErrorGroupWrapper(i.logger)
+		eg.SetLimit(_NUMCPU)
+
+		i.ForEachShard(func(name string, shard ShardLike) error {
+			eg.Go(func() error {
+				if err := shard.reindexVectorIndex(ctx, targetVector, previous, updated); err != nil {
+					return fmt.Errorf("shard %q: %w", name, err)
+				}
+				return nil
+			})
+			return nil
+		})
+
+		entries, err := os.ReadDir(i.path())
+		if err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "read dir of idx '%s'", i.ID())
+		}
+		for _, entry := range entries {
+			name := entry.Name()
+			if !entry.IsDir() || i.shards.Load(name) != nil {
+				continue
+			}
+			eg.Go(func() error {
+				return recordVectorIndexGeneration(i.Config.Encryption, shardPath(i.path(), name), targetVector, previous)
+			})
+		}
+		return eg.Wait()
+	}
+
+	// The first round records the vector index that shards which are not
+	// loaded keep on disk before the config changes, so they rebuild it once
+	// loaded. Shards loaded in between still saw the previous config, the
+	// second round reindexes those as well.
+	if err := reindex(); err != nil {
+		return fmt.Errorf("reindex vector %q of idx %q: %w", targetVector, i.ID(), err)
+	}
+
+	i.vectorIndexUserConfigLock.Lock()
+	i.vectorIndexUserConfigs[targetVector] = updated
+	i.vectorIndexUserConfigLock.Unlock()
+
+	if err := reindex(); err != nil {
+		return fmt.Errorf("reindex vector %q of idx %q: %w", targetVector, i.ID(), err)
+	}
+	return nil
+}
+
 func (i *Index) GetInvertedIndexConfig() schema.InvertedIndexConfig {
 	i.invertedIndexConfigLock.Lock()
 	defer i.invertedIndexConfigLock.Unlock()
@@ -783,6 +988,42 @@ func (i *Index) updateReplicationConfig(ctx context.Context, cfg *models.Replica
 	return nil
 }
 
+// objectsCompression returns the compression of the objects bucket set in the
+// objectStorageConfig of a class, empty if none was set
+func objectsCompression(cfg *models.ObjectStorageConfig) string {
+	if cfg == nil {
+		return ""
+	}
+	return cfg.Compression
+}
+
+// updateObjectStorageConfig changes the compression of the objects bucket of
+// the loaded shards, shards loaded later read it from the index config
+func (i *Index) updateObjectStorageConfig(cfg *models.ObjectStorageConfig) error {
+	i.objectsCompressionLock.Lock()
+	defer i.objectsCompressionLock.Unlock()
+
+	i.Config.ObjectsCompression = objectsCompression(cfg)
+
+	return i.ForEachLoadedShard(func(name string, shard ShardLike) error {
+		bucket := shard.Store().Bucket(helpers.ObjectsBucketLSM)
+		if bucket == nil {
+			return nil
+		}
+		if err := bucket.SetCompression(i.Config.ObjectsCompression); err != nil {
+			return fmt.Errorf("updating objects compression on shard %q: %w", name, err)
+		}
+		return nil
+	})
+}
+
+func (i *Index) objectsCompression() string {
+	i.objectsCompressionLock.RLock()
+	defer i.objectsCompressionLock.RUnlock()
+
+	return i.Config.ObjectsCompression
+}
+
 func (i *Index) ReplicationFactor() int64 {
 	i.replicationConfigLock.RLock()
 	defer i.replicationConfigLock.RUnlock()
@@ -849,6 +1090,31 @@ type IndexConfig struct {
 	InvertedSorterDisabled *configRuntime.DynamicValue[bool]
 	MaintenanceModeEnabled func() bool
 
+	// ChangeStreamEnabled records all object writes in a per-shard change
+	// stream, it is set by the collection's changeStreamConfig.
+	// ChangeStreamRetention is the maximum number of events kept per shard
+	// (0 = unlimited)
+	ChangeStreamEnabled   bool
+	ChangeStreamRetention int
+
+	// RecallMonitoringSampleSize is the number of recent query vectors every
+	// shard keeps per target vector for the recall monitoring, none are kept
+	// if it is 0
+	RecallMonitoringSampleSize int
+
+	// ObjectsCompression is the compression of the values of the objects
+	// bucket, it is set by the collection's objectStorageConfig. The objects
+	// are stored uncompressed if it is empty.
+	ObjectsCompression string
+
+	// Encryption of the lsm stores and vector commit logs at rest, nil if
+	// disabled
+	Encryption *encryption.Encryptor
+
+	// SegmentTiering moves cold segments of the lsm stores to object storage,
+	// nil if disabled
+	SegmentTiering *lsmkv.SegmentTiering
+
 	HFreshEnabled bool
 }
 
@@ -2872,6 +3138,65 @@ func (i *Index) IncomingGetShardStatus(ctx context.Context, shardName string) (s
 	return shard.GetStatus().String(), nil
 }
 
+// IncomingGetShardReindexed tells whether the local replica of the shard
+// finished the reindexing task. Shards need not be loaded to be checked.
+func (i *Index) IncomingGetShardReindexed(ctx context.Context, shardName, task string) (bool, error) {
+	switch task {
+	case searchablePositionsReindexTask:
+		return newFileSearchablePositionsReindexTracker(shardPathLSM(i.path(), shardName)).IsReindexed(), nil
+	default:
+		return false, enterrors.NewErrUnprocessable(fmt.Errorf("unknown reindex task %q", task))
+	}
+}
+
+// allReplicasReindexed tells whether all replicas of the active shards of the
+// index finished the reindexing task. Replicas which cannot be reached count
+// as not reindexed.
+func (i *Index) allReplicasReindexed(ctx context.Context, task string) (bool, error) {
+	replicas := map[string][]string{}
+	className := i.Config.ClassName.String()
+	err := i.schemaReader.Read(className, true, func(_ *models.Class, state *sharding.State) error {
+		if state == nil {
+			return fmt.Errorf("unable to retrieve sharding state for class %s", className)
+		}
+		for shardName, physical := range state.Physical {
+			// inactive tenants are reindexed once activated
+			if physical.ActivityStatus() != models.TenantActivityStatusHOT {
+				continue
+			}
+			replicas[shardName] = slices.Clone(physical.BelongsToNodes)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	localNode := i.getSchema.NodeName()
+	for shardName, nodes := range replicas {
+		for _, node := range nodes {
+			var reindexed bool
+			if node == localNode {
+				reindexed, err = i.IncomingGetShardReindexed(ctx, shardName, task)
+			} else {
+				reindexed, err = i.remote.GetShardReindexed(ctx, node, shardName, task)
+			}
+			if err != nil {
+				i.logger.WithFields(logrus.Fields{
+					"shard": shardName,
+					"node":  node,
+					"task":  task,
+				}).WithError(err).Debug("checking whether replica is reindexed")
+				return false, nil
+			}
+			if !reindexed {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
 func (i *Index) updateShardStatus(ctx context.Context, tenantName, shardName, targetStatus string, schemaVersion uint64) error {
 	shard, release, err := i.getShardForDirectLocalOperation(ctx, tenantName, shardName, localShardOperationWrite)
 	if err != nil {
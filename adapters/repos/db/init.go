@@ -20,6 +20,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/weaviate/weaviate/adapters/repos/db/changestream"
 	"github.com/weaviate/weaviate/adapters/repos/db/indexcheckpoint"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	resolver "github.com/weaviate/weaviate/adapters/repos/db/sharding"
@@ -122,6 +123,12 @@ func (db *DB) init(ctx context.Context) error {
 				ForceFullReplicasSearch:                      db.config.ForceFullReplicasSearch,
 				TransferInactivityTimeout:                    db.config.TransferInactivityTimeout,
 				LSMEnableSegmentsChecksumValidation:          db.config.LSMEnableSegmentsChecksumValidation,
+				ChangeStreamEnabled:                          changestream.IsEnabled(class.ChangeStreamConfig),
+				ChangeStreamRetention:                        db.config.ChangeStreamRetention,
+				RecallMonitoringSampleSize:                   db.config.RecallMonitoring.QuerySamples(class.Class),
+				ObjectsCompression:                           objectsCompression(class.ObjectStorageConfig),
+				Encryption:                                   db.config.Encryption,
+				SegmentTiering:                               db.segmentTiering(class.Class),
 				ReplicationFactor:                            class.ReplicationConfig.Factor,
 				AsyncReplicationEnabled:                      class.ReplicationConfig.AsyncEnabled,
 				DeletionStrategy:                             class.ReplicationConfig.DeletionStrategy,
@@ -172,6 +179,11 @@ func (db *DB) init(ctx context.Context) error {
 	if db.promMetrics != nil {
 		db.metricsObserver = newNodeWideMetricsObserver(db)
 		db.metricsObserver.Start()
+
+		if db.config.RecallMonitoring.Enabled {
+			db.recallMonitor = newRecallMonitor(db)
+			db.recallMonitor.Start()
+		}
 	}
 
 	return nil
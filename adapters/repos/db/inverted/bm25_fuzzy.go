@@ -0,0 +1,223 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/common_filters"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+type fuzzyTerm struct {
+	term     string
+	distance int
+}
+
+func validateFuzziness(params searchparams.KeywordRanking) error {
+	if params.Fuzziness == nil {
+		return nil
+	}
+	if err := params.Fuzziness.Validate(); err != nil {
+		return err
+	}
+	// the expansions of a query term are scored as terms of their own, so they
+	// cannot be counted towards the terms which have to match
+	if params.SearchOperator == common_filters.SearchOperatorAnd || params.MinimumOrTokensMatch > 1 {
+		return fmt.Errorf("fuzziness cannot be combined with the And search operator or minimumOrTokensMatch")
+	}
+	return nil
+}
+
+// expandFuzzyTerms adds the indexed terms of the given properties within the
+// edit distance of the fuzziness to the query terms. Expanded terms inherit
+// the duplicate boost of the query term they originate from.
+func (b *BM25Searcher) expandFuzzyTerms(ctx context.Context, propNames []string,
+	queryTerms []string, duplicateBoosts []int, fuzziness *searchparams.Fuzziness,
+) ([]string, []int, error) {
+	if fuzziness == nil || fuzziness.MaxEdits == 0 || len(queryTerms) == 0 {
+		return queryTerms, duplicateBoosts, nil
+	}
+
+	positions := make(map[string]int, len(queryTerms))
+	expandedTerms := make([]string, 0, len(queryTerms))
+	expandedBoosts := make([]int, 0, len(queryTerms))
+	add := func(term string, boost int) {
+		if pos, ok := positions[term]; ok {
+			expandedBoosts[pos] = max(expandedBoosts[pos], boost)
+			return
+		}
+		positions[term] = len(expandedTerms)
+		expandedTerms = append(expandedTerms, term)
+		expandedBoosts = append(expandedBoosts, boost)
+	}
+
+	for i, queryTerm := range queryTerms {
+		add(queryTerm, duplicateBoosts[i])
+
+		candidates := map[string]int{}
+		for _, propName := range propNames {
+			if err := b.fuzzyTermCandidates(ctx, propName, queryTerm, fuzziness, candidates); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		closest, err := closestFuzzyTerms(candidates, fuzziness.Expansions(), func(term string) (bool, error) {
+			return b.hasLivePostings(ctx, propNames, term)
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, candidate := range closest {
+			add(candidate.term, duplicateBoosts[i])
+		}
+	}
+
+	return expandedTerms, expandedBoosts, nil
+}
+
+// fuzzyTermCandidates collects the terms of the searchable bucket of a
+// property which are within the edit distance of the query term. Only the
+// keys are read, without the postings. If a prefix length is set, only the
+// keys sharing that prefix are read.
+func (b *BM25Searcher) fuzzyTermCandidates(ctx context.Context, propName, queryTerm string,
+	fuzziness *searchparams.Fuzziness, candidates map[string]int,
+) error {
+	bucket := b.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
+	if bucket == nil {
+		return fmt.Errorf("could not find bucket for property %v", propName)
+	}
+
+	query := []rune(queryTerm)
+	prefix := []byte(string(query[:min(fuzziness.PrefixLength, len(query))]))
+
+	return bucket.MapKeysWithPrefix(ctx, prefix, func(k []byte) bool {
+		if !utf8.Valid(k) {
+			return true
+		}
+
+		term := string(k)
+		if term == queryTerm {
+			return true
+		}
+		if distance, ok := levenshteinWithin(query, []rune(term), fuzziness.MaxEdits); ok {
+			if known, exists := candidates[term]; !exists || distance < known {
+				candidates[term] = distance
+			}
+		}
+		return true
+	})
+}
+
+// hasLivePostings returns whether the term still matches an object in any
+// of the properties. The keys of terms whose postings were all deleted stay
+// in the segments until they are compacted.
+func (b *BM25Searcher) hasLivePostings(ctx context.Context, propNames []string, term string) (bool, error) {
+	var opts []lsmkv.MapListOption
+	if b.shardVersion < 2 {
+		opts = append(opts, lsmkv.MapListLegacySortingRequired())
+	}
+	for _, propName := range propNames {
+		bucket := b.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
+		if bucket == nil {
+			return false, fmt.Errorf("could not find bucket for property %v", propName)
+		}
+		postings, err := bucket.MapList(ctx, []byte(term), opts...)
+		if err != nil {
+			return false, err
+		}
+		if len(postings) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// closestFuzzyTerms returns up to limit candidates, ordered by their edit
+// distance first and lexicographically second. Candidates are only checked
+// with live in that order until the limit is reached.
+func closestFuzzyTerms(candidates map[string]int, limit int,
+	live func(term string) (bool, error),
+) ([]fuzzyTerm, error) {
+	terms := make([]fuzzyTerm, 0, len(candidates))
+	for term, distance := range candidates {
+		terms = append(terms, fuzzyTerm{term: term, distance: distance})
+	}
+	sort.Slice(terms, func(i, j int) bool {
+		if terms[i].distance != terms[j].distance {
+			return terms[i].distance < terms[j].distance
+		}
+		return terms[i].term < terms[j].term
+	})
+
+	closest := make([]fuzzyTerm, 0, min(limit, len(terms)))
+	for _, term := range terms {
+		if len(closest) == limit {
+			break
+		}
+		ok, err := live(term.term)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			closest = append(closest, term)
+		}
+	}
+	return closest, nil
+}
+
+// levenshteinWithin returns the Levenshtein distance between a and b if it
+// does not exceed maxDistance. The computation stops as soon as the distance
+// is known to be larger.
+func levenshteinWithin(a, b []rune, maxDistance int) (int, bool) {
+	if abs(len(a)-len(b)) > maxDistance {
+		return 0, false
+	}
+
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			rowMin = min(rowMin, curr[j])
+		}
+		if rowMin > maxDistance {
+			return 0, false
+		}
+		prev, curr = curr, prev
+	}
+
+	distance := prev[len(b)]
+	return distance, distance <= maxDistance
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/common_filters"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func TestLevenshteinWithin(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		max      int
+		distance int
+		within   bool
+	}{
+		{a: "journey", b: "journey", max: 0, distance: 0, within: true},
+		{a: "journy", b: "journey", max: 1, distance: 1, within: true},
+		{a: "jounrey", b: "journey", max: 2, distance: 2, within: true},
+		{a: "jounrey", b: "journey", max: 1, within: false},
+		{a: "car", b: "cars", max: 1, distance: 1, within: true},
+		{a: "car", b: "carpet", max: 2, within: false},
+		{a: "", b: "ab", max: 2, distance: 2, within: true},
+		{a: "müller", b: "muller", max: 1, distance: 1, within: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%s/%d", tt.a, tt.b, tt.max), func(t *testing.T) {
+			distance, within := levenshteinWithin([]rune(tt.a), []rune(tt.b), tt.max)
+			assert.Equal(t, tt.within, within)
+			if tt.within {
+				assert.Equal(t, tt.distance, distance)
+			}
+		})
+	}
+}
+
+func TestClosestFuzzyTerms(t *testing.T) {
+	candidates := map[string]int{
+		"cart": 1,
+		"cars": 1,
+		"care": 1,
+		"card": 1,
+		"cab":  2,
+		"bar":  2,
+	}
+
+	checked := []string{}
+	live := func(term string) (bool, error) {
+		checked = append(checked, term)
+		return term != "care", nil
+	}
+
+	closest, err := closestFuzzyTerms(candidates, 3, live)
+	require.NoError(t, err)
+	assert.Equal(t, []fuzzyTerm{
+		{term: "card", distance: 1},
+		{term: "cars", distance: 1},
+		{term: "cart", distance: 1},
+	}, closest)
+	// the liveness is only checked until the limit is reached
+	assert.Equal(t, []string{"card", "care", "cars", "cart"}, checked)
+
+	all, err := closestFuzzyTerms(candidates, 10, live)
+	require.NoError(t, err)
+	assert.Len(t, all, 5)
+
+	_, err = closestFuzzyTerms(candidates, 3, func(string) (bool, error) {
+		return false, errors.New("read postings")
+	})
+	assert.Error(t, err)
+}
+
+func TestValidateFuzziness(t *testing.T) {
+	fuzziness := &searchparams.Fuzziness{MaxEdits: 1}
+
+	require.Nil(t, validateFuzziness(searchparams.KeywordRanking{}))
+	require.Nil(t, validateFuzziness(searchparams.KeywordRanking{Fuzziness: fuzziness}))
+	require.Nil(t, validateFuzziness(searchparams.KeywordRanking{
+		Fuzziness: fuzziness, SearchOperator: common_filters.SearchOperatorOr, MinimumOrTokensMatch: 1,
+	}))
+
+	require.NotNil(t, validateFuzziness(searchparams.KeywordRanking{
+		Fuzziness: &searchparams.Fuzziness{MaxEdits: 3},
+	}))
+	require.NotNil(t, validateFuzziness(searchparams.KeywordRanking{
+		Fuzziness: &searchparams.Fuzziness{MaxEdits: 1, PrefixLength: -1},
+	}))
+	require.NotNil(t, validateFuzziness(searchparams.KeywordRanking{
+		Fuzziness: fuzziness, SearchOperator: common_filters.SearchOperatorAnd,
+	}))
+	require.NotNil(t, validateFuzziness(searchparams.KeywordRanking{
+		Fuzziness: fuzziness, MinimumOrTokensMatch: 2,
+	}))
+}
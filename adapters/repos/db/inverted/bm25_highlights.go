@@ -0,0 +1,63 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// addHighlightTerms adds the query terms per tokenization to the additional
+// properties of the results, so that they can be highlighted the way they
+// were scored. The terms already include the fuzzy expansions and are
+// shared by all results.
+func addHighlightTerms(objs []*storobj.Object, queryTermsByTokenization map[string][]string) {
+	terms := make(map[string][]string, len(queryTermsByTokenization))
+	for tokenization, queryTerms := range queryTermsByTokenization {
+		if len(queryTerms) > 0 {
+			terms[tokenization] = queryTerms
+		}
+	}
+
+	for _, obj := range objs {
+		highlightTermsOf(obj).Terms = terms
+	}
+}
+
+// addHighlightPhrases adds the phrases of the query to the highlight terms of
+// the results
+func addHighlightPhrases(objs []*storobj.Object, phrases []phrase) {
+	if len(phrases) == 0 {
+		return
+	}
+
+	highlightPhrases := make([]additional.HighlightPhrase, len(phrases))
+	for i, phrase := range phrases {
+		highlightPhrases[i] = additional.HighlightPhrase{Text: phrase.text, Slop: phrase.slop}
+	}
+	for _, obj := range objs {
+		highlightTermsOf(obj).Phrases = highlightPhrases
+	}
+}
+
+func highlightTermsOf(obj *storobj.Object) *additional.HighlightTerms {
+	if obj.Object.Additional == nil {
+		obj.Object.Additional = models.AdditionalProperties{}
+	}
+	terms, ok := obj.Object.Additional["highlightTerms"].(*additional.HighlightTerms)
+	if !ok {
+		terms = &additional.HighlightTerms{}
+		obj.Object.Additional["highlightTerms"] = terms
+	}
+	return terms
+}
@@ -0,0 +1,203 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/tokenizer"
+)
+
+// MaxPhraseSlop is the largest number of terms allowed between the terms of a
+// proximity query
+const MaxPhraseSlop = 64
+
+type phrase struct {
+	text string
+	slop int
+}
+
+// parsePhraseQuery extracts the quoted phrases of a BM25 query. A phrase may
+// be followed by ~N to allow up to N other terms between its terms, e.g.
+// "new york"~2. The returned query contains the terms of the phrases without
+// quotes, so they are scored like any other query term. An unterminated quote
+// is kept as part of the query.
+func parsePhraseQuery(query string) (string, []phrase, error) {
+	var phrases []phrase
+	var plain strings.Builder
+
+	rest := query
+	for {
+		start := strings.IndexByte(rest, '"')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(rest[start+1:], '"')
+		if end < 0 {
+			break
+		}
+		end += start + 1
+
+		text := rest[start+1 : end]
+		plain.WriteString(rest[:start])
+		plain.WriteString(" ")
+		plain.WriteString(text)
+		plain.WriteString(" ")
+		rest = rest[end+1:]
+
+		slop := 0
+		if strings.HasPrefix(rest, "~") {
+			digits := 1
+			for digits < len(rest) && rest[digits] >= '0' && rest[digits] <= '9' {
+				digits++
+			}
+			if digits > 1 {
+				var err error
+				if slop, err = strconv.Atoi(rest[1:digits]); err != nil || slop > MaxPhraseSlop {
+					return "", nil, fmt.Errorf("phrase slop must be between 0 and %d, got %q", MaxPhraseSlop, rest[1:digits])
+				}
+				rest = rest[digits:]
+			}
+		}
+
+		if strings.TrimSpace(text) != "" {
+			phrases = append(phrases, phrase{text: text, slop: slop})
+		}
+	}
+	plain.WriteString(rest)
+
+	return plain.String(), phrases, nil
+}
+
+// applyPhrases restricts the documents of a query with phrases to the ones
+// matching every phrase in at least one of the searched properties. Phrases
+// are only recognized in collections indexing term positions, other
+// collections keep treating quotes as part of the query. The phrases are
+// returned for highlighting.
+func (b *BM25Searcher) applyPhrases(ctx context.Context, class *models.Class,
+	params searchparams.KeywordRanking, filterDocIds helpers.AllowList,
+) (searchparams.KeywordRanking, helpers.AllowList, []phrase, error) {
+	if class.InvertedIndexConfig == nil || !class.InvertedIndexConfig.IndexPositions {
+		return params, filterDocIds, nil, nil
+	}
+
+	query, phrases, err := parsePhraseQuery(params.Query)
+	if err != nil {
+		return params, nil, nil, err
+	}
+	if len(phrases) == 0 {
+		return params, filterDocIds, nil, nil
+	}
+
+	var allowed *sroar.Bitmap
+	for _, phrase := range phrases {
+		docIDs := sroar.NewBitmap()
+		for _, propertyWithBoost := range params.Properties {
+			propName := strings.Split(propertyWithBoost, "^")[0]
+			prop, err := schema.GetPropertyByName(class, propName)
+			if err != nil {
+				return params, nil, nil, err
+			}
+
+			terms := tokenizer.TokenizeForClass(prop.Tokenization, phrase.text, class.Class)
+			if len(terms) == 0 {
+				continue
+			}
+
+			bucket := b.store.Bucket(helpers.BucketSearchablePositionsFromPropNameLSM(propName))
+			if bucket == nil {
+				return params, nil, nil, fmt.Errorf("positions of property %q are not indexed", propName)
+			}
+
+			matches, err := phraseDocIDs(ctx, bucket, terms, phrase.slop)
+			if err != nil {
+				return params, nil, nil, fmt.Errorf("phrase %q of property %q: %w", phrase.text, propName, err)
+			}
+			docIDs.Or(matches)
+		}
+
+		if allowed == nil {
+			allowed = docIDs
+		} else {
+			allowed.And(docIDs)
+		}
+	}
+
+	if filterDocIds != nil {
+		filtered := sroar.NewBitmap()
+		for _, docID := range allowed.ToArray() {
+			if filterDocIds.Contains(docID) {
+				filtered.Set(docID)
+			}
+		}
+		allowed = filtered
+	}
+
+	params.Query = query
+	return params, helpers.NewAllowListFromBitmap(allowed), phrases, nil
+}
+
+// phraseDocIDs returns the ids of the documents containing the terms in the
+// given order with at most slop other terms in between
+func phraseDocIDs(ctx context.Context, bucket *lsmkv.Bucket, terms []string, slop int) (*sroar.Bitmap, error) {
+	docIDs := sroar.NewBitmap()
+
+	postings := make(map[string]map[uint64][]byte, len(terms))
+	for _, term := range terms {
+		if _, ok := postings[term]; ok {
+			continue
+		}
+		pairs, err := bucket.MapList(ctx, []byte(term))
+		if err != nil {
+			return nil, err
+		}
+		if len(pairs) == 0 {
+			return docIDs, nil
+		}
+		byDocID := make(map[uint64][]byte, len(pairs))
+		for _, pair := range pairs {
+			byDocID[binary.BigEndian.Uint64(pair.Key)] = pair.Value
+		}
+		postings[term] = byDocID
+	}
+
+	positions := make([][]uint32, len(terms))
+	for docID := range postings[terms[0]] {
+		found := true
+		for i, term := range terms {
+			encoded, ok := postings[term][docID]
+			if !ok {
+				found = false
+				break
+			}
+			decoded, err := DecodePositions(encoded)
+			if err != nil {
+				return nil, fmt.Errorf("doc id %d: %w", docID, err)
+			}
+			positions[i] = decoded
+		}
+		if found && matchesPhrase(positions, slop) {
+			docIDs.Set(docID)
+		}
+	}
+	return docIDs, nil
+}
@@ -0,0 +1,48 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePhraseQuery(t *testing.T) {
+	tests := []struct {
+		query           string
+		expectedQuery   string
+		expectedPhrases []phrase
+	}{
+		{query: "new york", expectedQuery: "new york"},
+		{query: `"new york"`, expectedQuery: " new york ", expectedPhrases: []phrase{{text: "new york"}}},
+		{query: `"new york"~3 city`, expectedQuery: " new york  city", expectedPhrases: []phrase{{text: "new york", slop: 3}}},
+		{query: `a "b c" d "e f"~1`, expectedQuery: "a  b c  d  e f ", expectedPhrases: []phrase{{text: "b c"}, {text: "e f", slop: 1}}},
+		{query: `"new york"~ city`, expectedQuery: " new york ~ city", expectedPhrases: []phrase{{text: "new york"}}},
+		{query: `"new york`, expectedQuery: `"new york`},
+		{query: `"" york`, expectedQuery: "   york"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			query, phrases, err := parsePhraseQuery(tt.query)
+			require.Nil(t, err)
+			assert.Equal(t, tt.expectedQuery, query)
+			assert.Equal(t, tt.expectedPhrases, phrases)
+		})
+	}
+
+	t.Run("slop too large", func(t *testing.T) {
+		_, _, err := parsePhraseQuery(`"new york"~65`)
+		assert.ErrorContains(t, err, "phrase slop")
+	})
+}
@@ -100,9 +100,16 @@ func (b *BM25Searcher) BM25F(ctx context.Context, filterDocIds helpers.AllowList
 		return nil, nil, fmt.Errorf("could not find class %s in schema", className)
 	}
 
+	keywordRanking, filterDocIds, phrases, err := b.applyPhrases(ctx, class, keywordRanking, filterDocIds)
+	if err != nil {
+		return nil, nil, fmt.Errorf("phrases: %w", err)
+	}
+	if filterDocIds != nil && filterDocIds.IsEmpty() {
+		return nil, nil, nil
+	}
+
 	var objs []*storobj.Object
 	var scores []float32
-	var err error
 
 	// TODO: amourao - move this to the global config
 	useWand := os.Getenv("USE_BLOCKMAX_WAND") == "false"
@@ -128,6 +135,9 @@ func (b *BM25Searcher) BM25F(ctx context.Context, filterDocIds helpers.AllowList
 		return nil, nil, errors.Wrap(err, method)
 	}
 
+	if additional.Highlights != nil {
+		addHighlightPhrases(objs, phrases)
+	}
 	return objs, scores, nil
 }
 
@@ -136,6 +146,10 @@ func (b *BM25Searcher) GetPropertyLengthTracker() *JsonShardMetaData {
 }
 
 func (b *BM25Searcher) generateQueryTermsAndStats(ctx context.Context, class *models.Class, params searchparams.KeywordRanking) (bool, float64, map[string][]string, map[string][]string, map[string][]int, map[string]float32, float64, error) {
+	if err := validateFuzziness(params); err != nil {
+		return false, 0, nil, nil, nil, nil, 0, err
+	}
+
 	count, err := b.store.Bucket(helpers.ObjectsBucketLSM).Count(ctx)
 	if err != nil {
 		return false, 0, nil, nil, nil, nil, 0, fmt.Errorf("count objects: %w", err)
@@ -224,6 +238,21 @@ func (b *BM25Searcher) generateQueryTermsAndStats(ctx context.Context, class *mo
 		}
 	}
 
+	if params.Fuzziness != nil {
+		for tokenization, propNames := range propNamesByTokenization {
+			if len(propNames) == 0 {
+				continue
+			}
+			queryTerms, dupBoosts, err := b.expandFuzzyTerms(ctx, propNames, queryTermsByTokenization[tokenization],
+				duplicateBoostsByTokenization[tokenization], params.Fuzziness)
+			if err != nil {
+				return false, 0, nil, nil, nil, nil, 0, fmt.Errorf("expand fuzzy terms: %w", err)
+			}
+			queryTermsByTokenization[tokenization] = queryTerms
+			duplicateBoostsByTokenization[tokenization] = dupBoosts
+		}
+	}
+
 	averagePropLength = averagePropLength / float64(averagePropLengthCount)
 
 	// If this value is zero or NaN, the prop length tracker is fully corrupted.
@@ -356,6 +385,9 @@ func (b *BM25Searcher) wand(
 
 	start = time.Now()
 	objects, scores, err := b.getTopKObjects(topKHeap, params.AdditionalExplanations, allQueryTerms, additional)
+	if err == nil && additional.Highlights != nil {
+		addHighlightTerms(objects, queryTermsByTokenization)
+	}
 
 	fetchTime := time.Since(start)
 	helpers.AnnotateSlowQueryLog(ctx, "kwd_5_objects_time", fetchTime)
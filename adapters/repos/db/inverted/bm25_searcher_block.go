@@ -257,6 +257,9 @@ func (b *BM25Searcher) wandBlock(
 	helpers.AnnotateSlowQueryLog(ctx, "kwd_4_bmw_time", blockSearchTime)
 
 	objects, scores := b.combineResults(allIds, allScores, allExplanation, termCounts, additional, limit)
+	if additional.Highlights != nil {
+		addHighlightTerms(objects, queryTermsByTokenization)
+	}
 
 	combineTime := time.Since(start)
 	helpers.AnnotateSlowQueryLog(ctx, "kwd_5_objects_time", combineTime)
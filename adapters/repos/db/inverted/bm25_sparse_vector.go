@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/additional"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
+)
+
+// SparseVectorSearch returns the objects with the highest dot product between
+// the query and their vector of a sparse index. The postings of the sparse
+// index are scored with the same block-max WAND as BM25 terms, with the
+// dimensions of the query as terms and the query weights as term weights.
+func (b *BM25Searcher) SparseVectorSearch(ctx context.Context, filterDocIds helpers.AllowList,
+	limit int, params searchparams.SparseVectorSearch, additional additional.Properties,
+) ([]*storobj.Object, []float32, error) {
+	if params.Vector == nil {
+		return nil, nil, fmt.Errorf("sparse vector search: no query vector given")
+	}
+	if err := sparse.Validate(params.Vector); err != nil {
+		return nil, nil, fmt.Errorf("sparse vector search: %w", err)
+	}
+	bucket := b.store.Bucket(helpers.BucketSparseVectorLSM(params.TargetVector))
+	if bucket == nil {
+		return nil, nil, fmt.Errorf("sparse vector search: target vector %q does not have a sparse index",
+			params.TargetVector)
+	}
+
+	if filterDocIds != nil && filterDocIds.IsEmpty() {
+		return []*storobj.Object{}, []float32{}, nil
+	}
+
+	// the stored weights are scaled to integer frequencies, so the query
+	// weights are scaled down by the same factor
+	keys := make([][]byte, 0, len(params.Vector.Indices))
+	weights := make([]float64, 0, len(params.Vector.Indices))
+	for i, dim := range params.Vector.Indices {
+		if params.Vector.Values[i] == 0 {
+			continue
+		}
+		key := make([]byte, 4)
+		binary.BigEndian.PutUint32(key, dim)
+		keys = append(keys, key)
+		weights = append(weights, float64(params.Vector.Values[i])/sparse.WeightScale)
+	}
+	if len(keys) == 0 {
+		return []*storobj.Object{}, []float32{}, nil
+	}
+
+	start := time.Now()
+	groups, release, err := bucket.CreateDotProductTerms(ctx, filterDocIds, keys, weights)
+	if err != nil {
+		return nil, nil, fmt.Errorf("sparse vector search: %w", err)
+	}
+	defer release()
+	helpers.AnnotateSlowQueryLog(ctx, "sparse_1_term_time", time.Since(start))
+
+	if limit == 0 {
+		for _, group := range groups {
+			for _, term := range group {
+				limit += term.Count()
+			}
+		}
+	}
+
+	start = time.Now()
+	eg := enterrors.NewErrorGroupWrapper(b.logger)
+	eg.SetLimit(_NUMCPU)
+
+	allIds := make([][]uint64, len(groups))
+	allScores := make([][]float32, len(groups))
+	for i := range groups {
+		if len(groups[i]) == 0 {
+			continue
+		}
+
+		i := i
+		eg.Go(func() error {
+			topKHeap, err := lsmkv.DoBlockMaxWand(ctx, limit, groups[i], 0, false, len(keys), 1, b.logger)
+			if err != nil {
+				return err
+			}
+			ids, scores, _, err := b.getTopKIds(topKHeap)
+			if err != nil {
+				return err
+			}
+			allIds[i] = ids
+			allScores[i] = scores
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, nil, fmt.Errorf("sparse vector search: %w", err)
+	}
+	helpers.AnnotateSlowQueryLog(ctx, "sparse_2_bmw_time", time.Since(start))
+
+	// an object only has postings in the segment or memtable it was written
+	// to, so the results of the groups are disjoint
+	ids, scores, _ := b.sortResultsByScore(slices.Concat(allIds...), slices.Concat(allScores...), nil)
+	limit = min(limit, len(ids))
+
+	objs, scores, err := b.getObjectsAndScores(ids, scores, nil, nil, additional, limit)
+	if err != nil {
+		return nil, nil, fmt.Errorf("sparse vector search: %w", err)
+	}
+	return objs, scores, nil
+}
@@ -53,6 +53,7 @@ func ConfigFromModel(iicm *models.InvertedIndexConfig) schema.InvertedIndexConfi
 	conf.IndexTimestamps = iicm.IndexTimestamps
 	conf.IndexNullState = iicm.IndexNullState
 	conf.IndexPropertyLength = iicm.IndexPropertyLength
+	conf.IndexPositions = iicm.IndexPositions
 
 	if iicm.Bm25 == nil {
 		conf.BM25.K1 = float64(config.DefaultBM25k1)
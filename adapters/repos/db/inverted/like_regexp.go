@@ -13,9 +13,13 @@ package inverted
 
 import (
 	"bytes"
+	"fmt"
 	"regexp"
+	"regexp/syntax"
 
 	"github.com/pkg/errors"
+
+	"github.com/weaviate/weaviate/entities/filters"
 )
 
 type likeRegexp struct {
@@ -24,6 +28,33 @@ type likeRegexp struct {
 	regexp      *regexp.Regexp
 }
 
+// parseRowMatcher returns the matcher for the row keys of the Like, Regex and
+// StartsWith operators. If the matcher is optimizable, all matching keys share
+// the fixed prefix min, so they can be read with a seek instead of a full scan.
+func parseRowMatcher(operator filters.Operator, in []byte) (*likeRegexp, error) {
+	switch operator {
+	case filters.OperatorLike:
+		return parseLikeRegexp(in)
+	case filters.OperatorRegex:
+		return parseRegexp(in)
+	case filters.OperatorStartsWith:
+		// every key within the prefix range matches, there is no need for a
+		// regexp
+		return &likeRegexp{
+			min:         in,
+			optimizable: len(in) > 0,
+		}, nil
+	default:
+		return nil, fmt.Errorf("operator %v cannot match row keys", operator)
+	}
+}
+
+// match reports whether a key that lies within the prefix range of an
+// optimizable matcher, or any key of a non-optimizable one, matches.
+func (l *likeRegexp) match(k []byte) bool {
+	return l.regexp == nil || l.regexp.Match(k)
+}
+
 func parseLikeRegexp(in []byte) (*likeRegexp, error) {
 	r, err := regexp.Compile(transformLikeStringToRegexp(in))
 	if err != nil {
@@ -38,6 +69,45 @@ func parseLikeRegexp(in []byte) (*likeRegexp, error) {
 	}, nil
 }
 
+// parseRegexp compiles a regular expression in RE2 syntax. Like in RE2, the
+// expression matches anywhere in a key unless it is anchored.
+func parseRegexp(in []byte) (*likeRegexp, error) {
+	r, err := regexp.Compile(string(in))
+	if err != nil {
+		return nil, errors.Wrap(err, "compile regex")
+	}
+
+	min := regexpLiteralPrefix(string(in))
+	return &likeRegexp{
+		regexp:      r,
+		min:         min,
+		optimizable: len(min) > 0,
+	}, nil
+}
+
+// regexpLiteralPrefix returns the case-sensitive literal that all matches of
+// an expression anchored at the beginning of the text start with.
+func regexpLiteralPrefix(expr string) []byte {
+	re, err := syntax.Parse(expr, syntax.Perl)
+	if err != nil {
+		return nil
+	}
+	re = re.Simplify()
+
+	if re.Op != syntax.OpConcat || len(re.Sub) == 0 || re.Sub[0].Op != syntax.OpBeginText {
+		return nil
+	}
+
+	var prefix []rune
+	for _, sub := range re.Sub[1:] {
+		if sub.Op != syntax.OpLiteral || sub.Flags&syntax.FoldCase != 0 {
+			break
+		}
+		prefix = append(prefix, sub.Rune...)
+	}
+	return []byte(string(prefix))
+}
+
 func transformLikeStringToRegexp(in []byte) string {
 	in = []byte(regexp.QuoteMeta(string(in)))
 	in = bytes.ReplaceAll(in, []byte("\\?"), []byte("."))
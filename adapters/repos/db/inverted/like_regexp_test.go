@@ -17,6 +17,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/filters"
 )
 
 func TestLikeRegexp(t *testing.T) {
@@ -162,3 +164,84 @@ func TestLikeRegexp_ForOptimizability(t *testing.T) {
 
 	run(t, tests)
 }
+
+func TestRowMatcher(t *testing.T) {
+	type test struct {
+		operator            filters.Operator
+		input               []byte
+		shouldBeOptimizable bool
+		expectedMin         []byte
+		matches             []string
+		doesNotMatch        []string
+	}
+
+	tests := []test{
+		{
+			operator:            filters.OperatorRegex,
+			input:               []byte("car"),
+			shouldBeOptimizable: false,
+			matches:             []string{"car", "supercar", "cars"},
+			doesNotMatch:        []string{"ca", "Car"},
+		},
+		{
+			operator:            filters.OperatorRegex,
+			input:               []byte("^car(e|t)+$"),
+			shouldBeOptimizable: true,
+			expectedMin:         []byte("car"),
+			matches:             []string{"care", "cartt"},
+			doesNotMatch:        []string{"car", "cars", "supercare"},
+		},
+		{
+			operator:            filters.OperatorRegex,
+			input:               []byte("^ca?r"),
+			shouldBeOptimizable: true,
+			expectedMin:         []byte("c"),
+			matches:             []string{"car", "cr"},
+			doesNotMatch:        []string{"scar"},
+		},
+		{
+			operator:            filters.OperatorRegex,
+			input:               []byte("(?i)^car"),
+			shouldBeOptimizable: false,
+			matches:             []string{"car", "Cart"},
+			doesNotMatch:        []string{"scar"},
+		},
+		{
+			operator:            filters.OperatorRegex,
+			input:               []byte("^car|^bus"),
+			shouldBeOptimizable: false,
+			matches:             []string{"cars", "bus"},
+			doesNotMatch:        []string{"taxi"},
+		},
+		{
+			operator:            filters.OperatorStartsWith,
+			input:               []byte("c*r"),
+			shouldBeOptimizable: true,
+			expectedMin:         []byte("c*r"),
+			// keys outside of the prefix range are never read
+			matches: []string{"c*r", "c*rs"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s %q", test.operator.Name(), string(test.input)), func(t *testing.T) {
+			res, err := parseRowMatcher(test.operator, test.input)
+			require.Nil(t, err)
+			assert.Equal(t, test.shouldBeOptimizable, res.optimizable)
+			if test.shouldBeOptimizable {
+				assert.Equal(t, test.expectedMin, res.min)
+			}
+			for _, subject := range test.matches {
+				assert.True(t, res.match([]byte(subject)), subject)
+			}
+			for _, subject := range test.doesNotMatch {
+				assert.False(t, res.match([]byte(subject)), subject)
+			}
+		})
+	}
+
+	t.Run("invalid regex", func(t *testing.T) {
+		_, err := parseRowMatcher(filters.OperatorRegex, []byte("car("))
+		require.NotNil(t, err)
+	})
+}
@@ -0,0 +1,181 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/tokenizer"
+)
+
+// positionsArrayGap is added between the positions of the elements of a text
+// array, so that phrases can not match across elements. It needs to be larger
+// than MaxPhraseSlop.
+const positionsArrayGap = 128
+
+type TermPositions struct {
+	Data      []byte
+	Positions []uint32
+}
+
+type PropertyPositions struct {
+	Name  string
+	Items []TermPositions
+}
+
+// HasPositionsIndex indicates whether the positions of the terms of a property
+// can be indexed, which is the case for searchable text properties.
+func HasPositionsIndex(prop *models.Property) bool {
+	switch dt, _ := schema.AsPrimitive(prop.DataType); dt {
+	case schema.DataTypeText, schema.DataTypeTextArray:
+		return HasSearchableIndex(prop)
+	default:
+		return false
+	}
+}
+
+// PropertyPositions tokenizes the value of a text or text array property the
+// same way as Text and TextArray, but keeps the positions of the terms.
+func (a *Analyzer) PropertyPositions(prop *models.Property, value any) ([]TermPositions, error) {
+	switch dt, _ := schema.AsPrimitive(prop.DataType); dt {
+	case schema.DataTypeText:
+		asString, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected property %s to be of type string, but got %T", prop.Name, value)
+		}
+		return a.TextArrayPositions(prop.Tokenization, []string{asString}), nil
+	case schema.DataTypeTextArray:
+		values, err := typedSliceToUntyped(value)
+		if err != nil {
+			return nil, fmt.Errorf("property %s: %w", prop.Name, err)
+		}
+		in, err := stringsFromValues(prop, values)
+		if err != nil {
+			return nil, err
+		}
+		return a.TextArrayPositions(prop.Tokenization, in), nil
+	default:
+		return nil, nil
+	}
+}
+
+// TextArrayPositions tokenizes given input according to selected tokenization
+// and returns the positions of each term in order of first occurrence
+func (a *Analyzer) TextArrayPositions(tokenization string, inArr []string) []TermPositions {
+	var out []TermPositions
+	indexes := map[string]int{}
+
+	offset := uint32(0)
+	for _, in := range inArr {
+		terms := tokenizer.TokenizeForClass(tokenization, in, a.className)
+		for i, term := range terms {
+			position := offset + uint32(i)
+			if idx, ok := indexes[term]; ok {
+				out[idx].Positions = append(out[idx].Positions, position)
+				continue
+			}
+			indexes[term] = len(out)
+			out = append(out, TermPositions{Data: []byte(term), Positions: []uint32{position}})
+		}
+		offset += uint32(len(terms)) + positionsArrayGap
+	}
+	return out
+}
+
+// EncodePositions encodes ascending positions as varint deltas
+func EncodePositions(positions []uint32) []byte {
+	buf := make([]byte, 0, len(positions)*2)
+	prev := uint32(0)
+	for _, position := range positions {
+		buf = binary.AppendUvarint(buf, uint64(position-prev))
+		prev = position
+	}
+	return buf
+}
+
+func DecodePositions(data []byte) ([]uint32, error) {
+	positions := make([]uint32, 0, len(data))
+	prev := uint32(0)
+	for len(data) > 0 {
+		delta, n := binary.Uvarint(data)
+		if n <= 0 {
+			return nil, fmt.Errorf("invalid positions encoding")
+		}
+		prev += uint32(delta)
+		positions = append(positions, prev)
+		data = data[n:]
+	}
+	return positions, nil
+}
+
+// matchesPhrase reports whether the terms occur in the given order with at most
+// slop other terms in between. positions holds the ascending positions of each
+// term of the phrase.
+func matchesPhrase(positions [][]uint32, slop int) bool {
+	if len(positions) == 0 {
+		return false
+	}
+
+	for _, start := range positions[0] {
+		end, ok := phraseEnd(positions, start)
+		if !ok {
+			// later starts can not find a following position either
+			return false
+		}
+		if int(end-start)-(len(positions)-1) <= slop {
+			return true
+		}
+	}
+	return false
+}
+
+// PhraseSpans returns the first and last position of every occurrence of the
+// terms in the given order with at most slop other terms in between, the way
+// matchesPhrase matches them. positions holds the ascending positions of each
+// term of the phrase.
+func PhraseSpans(positions [][]uint32, slop int) [][2]uint32 {
+	if len(positions) == 0 {
+		return nil
+	}
+
+	var spans [][2]uint32
+	for _, start := range positions[0] {
+		end, ok := phraseEnd(positions, start)
+		if !ok {
+			break
+		}
+		if int(end-start)-(len(positions)-1) <= slop {
+			spans = append(spans, [2]uint32{start, end})
+		}
+	}
+	return spans
+}
+
+// phraseEnd returns the last position of the shortest span of the phrase
+// starting at start, or false if its terms do not follow start in order
+func phraseEnd(positions [][]uint32, start uint32) (uint32, bool) {
+	// picking the earliest following position of each term results in the
+	// shortest span for the given start
+	end := start
+	for _, termPositions := range positions[1:] {
+		i := sort.Search(len(termPositions), func(i int) bool { return termPositions[i] > end })
+		if i == len(termPositions) {
+			return 0, false
+		}
+		end = termPositions[i]
+	}
+	return end, true
+}
@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestTextArrayPositions(t *testing.T) {
+	a := NewAnalyzer(nil, "")
+
+	t.Run("single text", func(t *testing.T) {
+		res := a.TextArrayPositions(models.PropertyTokenizationWord, []string{"New York is new"})
+		assert.Equal(t, []TermPositions{
+			{Data: []byte("new"), Positions: []uint32{0, 3}},
+			{Data: []byte("york"), Positions: []uint32{1}},
+			{Data: []byte("is"), Positions: []uint32{2}},
+		}, res)
+	})
+
+	t.Run("text array elements are separated by a gap", func(t *testing.T) {
+		res := a.TextArrayPositions(models.PropertyTokenizationWord, []string{"new", "york new"})
+		assert.Equal(t, []TermPositions{
+			{Data: []byte("new"), Positions: []uint32{0, 1 + positionsArrayGap + 1}},
+			{Data: []byte("york"), Positions: []uint32{1 + positionsArrayGap}},
+		}, res)
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		assert.Empty(t, a.TextArrayPositions(models.PropertyTokenizationWord, []string{""}))
+	})
+}
+
+func TestEncodeDecodePositions(t *testing.T) {
+	for _, positions := range [][]uint32{
+		{},
+		{0},
+		{0, 1, 2},
+		{5, 300, 70000, 1 << 31},
+	} {
+		decoded, err := DecodePositions(EncodePositions(positions))
+		require.Nil(t, err)
+		assert.Equal(t, positions, decoded)
+	}
+
+	_, err := DecodePositions([]byte{0x80})
+	assert.Error(t, err)
+}
+
+func TestMatchesPhrase(t *testing.T) {
+	tests := []struct {
+		name      string
+		positions [][]uint32
+		slop      int
+		expected  bool
+	}{
+		{name: "no terms", positions: nil, expected: false},
+		{name: "single term", positions: [][]uint32{{4}}, expected: true},
+		{name: "adjacent", positions: [][]uint32{{0}, {1}}, expected: true},
+		{name: "wrong order", positions: [][]uint32{{1}, {0}}, expected: false},
+		{name: "gap without slop", positions: [][]uint32{{0}, {2}}, expected: false},
+		{name: "gap within slop", positions: [][]uint32{{0}, {2}}, slop: 1, expected: true},
+		{name: "gap exceeds slop", positions: [][]uint32{{0}, {4}}, slop: 2, expected: false},
+		{name: "later start matches", positions: [][]uint32{{0, 7}, {8}}, expected: true},
+		{name: "repeated term", positions: [][]uint32{{0, 1}, {0, 1}}, expected: true},
+		{name: "three terms", positions: [][]uint32{{0, 10}, {3, 11}, {12}}, expected: true},
+		{name: "three terms with slop", positions: [][]uint32{{0}, {3}, {4}}, slop: 2, expected: true},
+		{name: "three terms out of slop", positions: [][]uint32{{0}, {3}, {5}}, slop: 2, expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, matchesPhrase(tt.positions, tt.slop))
+		})
+	}
+}
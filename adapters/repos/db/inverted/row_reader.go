@@ -65,7 +65,7 @@ func (rr *RowReader) Read(ctx context.Context, readFn ReadFn) error {
 		return rr.lessThan(ctx, readFn, false)
 	case filters.OperatorLessThanEqual:
 		return rr.lessThan(ctx, readFn, true)
-	case filters.OperatorLike:
+	case filters.OperatorLike, filters.OperatorRegex, filters.OperatorStartsWith:
 		return rr.like(ctx, readFn)
 	case filters.OperatorIsNull: // we need to fetch a row with a given value (there is only nil and !nil) and can reuse equal to get the correct row
 		return rr.equal(ctx, readFn)
@@ -106,6 +106,9 @@ func (rr *RowReader) greaterThan(ctx context.Context, readFn ReadFn,
 ) error {
 	c := rr.newCursor()
 	defer c.Close()
+	if err := c.Err(); err != nil {
+		return err
+	}
 
 	for k, v := c.Seek(rr.value); k != nil; k, v = c.Next() {
 		if err := ctx.Err(); err != nil {
@@ -137,6 +140,9 @@ func (rr *RowReader) lessThan(ctx context.Context, readFn ReadFn,
 ) error {
 	c := rr.newCursor()
 	defer c.Close()
+	if err := c.Err(); err != nil {
+		return err
+	}
 
 	for k, v := c.First(); k != nil && bytes.Compare(k, rr.value) != 1; k, v = c.Next() {
 		if err := ctx.Err(); err != nil {
@@ -161,13 +167,16 @@ func (rr *RowReader) lessThan(ctx context.Context, readFn ReadFn,
 }
 
 func (rr *RowReader) like(ctx context.Context, readFn ReadFn) error {
-	like, err := parseLikeRegexp(rr.value)
+	like, err := parseRowMatcher(rr.operator, rr.value)
 	if err != nil {
-		return fmt.Errorf("parse like value: %w", err)
+		return fmt.Errorf("parse %s value: %w", rr.operator.Name(), err)
 	}
 
 	c := rr.newCursor()
 	defer c.Close()
+	if err := c.Err(); err != nil {
+		return err
+	}
 
 	var (
 		initialK []byte
@@ -198,7 +207,7 @@ func (rr *RowReader) like(ctx context.Context, readFn ReadFn) error {
 			}
 		}
 
-		if !like.regexp.Match(k) {
+		if !like.match(k) {
 			continue
 		}
 
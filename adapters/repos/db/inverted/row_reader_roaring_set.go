@@ -86,7 +86,7 @@ func (rr *RowReaderRoaringSet) Read(ctx context.Context, readFn ReadFn) error {
 		return rr.lessThan(ctx, readFn, false)
 	case filters.OperatorLessThanEqual:
 		return rr.lessThan(ctx, readFn, true)
-	case filters.OperatorLike:
+	case filters.OperatorLike, filters.OperatorRegex, filters.OperatorStartsWith:
 		return rr.like(ctx, readFn)
 	default:
 		return fmt.Errorf("operator %v not supported", rr.operator)
@@ -129,6 +129,9 @@ func (rr *RowReaderRoaringSet) greaterThan(ctx context.Context,
 ) error {
 	c := rr.newCursor()
 	defer c.Close()
+	if err := c.Err(); err != nil {
+		return err
+	}
 
 	for k, v := c.Seek(rr.value); k != nil; k, v = c.Next() {
 		if err := ctx.Err(); err != nil {
@@ -157,6 +160,9 @@ func (rr *RowReaderRoaringSet) lessThan(ctx context.Context,
 ) error {
 	c := rr.newCursor()
 	defer c.Close()
+	if err := c.Err(); err != nil {
+		return err
+	}
 
 	for k, v := c.First(); k != nil && bytes.Compare(k, rr.value) < 1; k, v = c.Next() {
 		if err := ctx.Err(); err != nil {
@@ -180,13 +186,16 @@ func (rr *RowReaderRoaringSet) lessThan(ctx context.Context,
 func (rr *RowReaderRoaringSet) like(ctx context.Context,
 	readFn ReadFn,
 ) error {
-	like, err := parseLikeRegexp(rr.value)
+	like, err := parseRowMatcher(rr.operator, rr.value)
 	if err != nil {
-		return fmt.Errorf("parse like value: %w", err)
+		return fmt.Errorf("parse %s value: %w", rr.operator.Name(), err)
 	}
 
 	c := rr.newCursor()
 	defer c.Close()
+	if err := c.Err(); err != nil {
+		return err
+	}
 
 	var (
 		initialK   []byte
@@ -218,7 +227,7 @@ func (rr *RowReaderRoaringSet) like(ctx context.Context,
 			}
 		}
 
-		if !like.regexp.Match(k) {
+		if !like.match(k) {
 			continue
 		}
 
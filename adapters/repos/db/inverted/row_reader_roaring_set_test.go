@@ -179,6 +179,37 @@ func TestRowReaderRoaringSet(t *testing.T) {
 				{"hhh", []uint64{11111111, 2222222, 33333333}},
 			},
 		},
+		{
+			name:     "regex 'd|f' value",
+			value:    "d|f",
+			operator: filters.OperatorRegex,
+			expected: []kvData{
+				{"ddd", []uint64{1111, 2222, 3333}},
+				{"fff", []uint64{111111, 222222, 333333}},
+			},
+		},
+		{
+			name:     "regex '^g+$' value",
+			value:    "^g+$",
+			operator: filters.OperatorRegex,
+			expected: []kvData{
+				{"ggg", []uint64{1111111, 2222222, 3333333}},
+			},
+		},
+		{
+			name:     "starts with 'ee' value",
+			value:    "ee",
+			operator: filters.OperatorStartsWith,
+			expected: []kvData{
+				{"eee", []uint64{11111, 22222, 33333}},
+			},
+		},
+		{
+			name:     "starts with non-matching value",
+			value:    "ab",
+			operator: filters.OperatorStartsWith,
+			expected: []kvData{},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -283,6 +314,10 @@ func (c *dummyCursorRoaringSet) Close() {
 	c.closed = true
 }
 
+func (c *dummyCursorRoaringSet) Err() error {
+	return nil
+}
+
 func createRowReaderRoaringSet(value []byte, operator filters.Operator, data []kvData) *RowReaderRoaringSet {
 	return &RowReaderRoaringSet{
 		value:     value,
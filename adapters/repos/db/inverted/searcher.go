@@ -636,11 +636,15 @@ func (s *Searcher) extractTokenizableProp(prop *models.Property, propType schema
 
 	switch propType {
 	case schema.DataTypeText:
-		// if the operator is like, we cannot apply the regular text-splitting
-		// logic as it would remove all wildcard symbols
-		if operator == filters.OperatorLike {
+		switch operator {
+		case filters.OperatorLike:
+			// if the operator is like, we cannot apply the regular text-splitting
+			// logic as it would remove all wildcard symbols
 			terms = tokenizer.TokenizeWithWildcardsForClass(prop.Tokenization, valueString, class.Class)
-		} else {
+		case filters.OperatorRegex:
+			// a regular expression is matched against every indexed term as is
+			terms = []string{valueString}
+		default:
 			terms = tokenizer.TokenizeForClass(prop.Tokenization, valueString, class.Class)
 		}
 	default:
@@ -657,7 +661,9 @@ func (s *Searcher) extractTokenizableProp(prop *models.Property, propType schema
 
 	propValuePairs := make([]*propValuePair, 0, len(terms))
 	for _, term := range terms {
-		if s.stopwords.IsStopword(term) && prop.Tokenization == models.PropertyTokenizationWord {
+		// a prefix or pattern which is a stopword can still match other terms
+		if s.stopwords.IsStopword(term) && prop.Tokenization == models.PropertyTokenizationWord &&
+			operator != filters.OperatorStartsWith && operator != filters.OperatorRegex {
 			continue
 		}
 		propValuePairs = append(propValuePairs, &propValuePair{
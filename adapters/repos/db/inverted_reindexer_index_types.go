@@ -21,6 +21,7 @@ const (
 	IndexTypePropNull
 	IndexTypePropSearchableValue
 	IndexTypePropMetaCount
+	IndexTypePropSearchablePositions
 )
 
 func isSupportedPropertyIndexType(indexType PropertyIndexType) bool {
@@ -0,0 +1,414 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	schema "github.com/weaviate/weaviate/usecases/schema"
+)
+
+// searchablePositionsReindexTask names the task when asking other replicas
+// whether they are done, see Index.IncomingGetShardReindexed
+const searchablePositionsReindexTask = "searchable_positions"
+
+// NewShardInvertedReindexTaskSearchablePositions creates the task indexing the
+// term positions of the searchable properties of existing collections. Once
+// all shards of a collection on this node are reindexed, indexPositions is
+// enabled in the collection's inverted index config, which makes phrase
+// queries available. If no collections are given, all collections are
+// reindexed.
+func NewShardInvertedReindexTaskSearchablePositions(logger logrus.FieldLogger,
+	processingDuration, pauseDuration time.Duration, collections []string,
+	schemaManager *schema.Manager,
+) *ShardReindexTask_SearchablePositions {
+	name := "SearchablePositions"
+
+	var selectedCollections map[string]struct{}
+	if len(collections) > 0 {
+		selectedCollections = make(map[string]struct{}, len(collections))
+		for _, collection := range collections {
+			selectedCollections[collection] = struct{}{}
+		}
+	}
+
+	config := searchablePositionsConfig{
+		processingDuration:            processingDuration,
+		pauseDuration:                 pauseDuration,
+		checkProcessingEveryNoObjects: 1000,
+		selectedCollections:           selectedCollections,
+	}
+
+	logger = logger.WithField("task", name)
+	logger.WithField("config", fmt.Sprintf("%+v", config)).Debug("task created")
+
+	return &ShardReindexTask_SearchablePositions{
+		name:          name,
+		logger:        logger,
+		schemaManager: schemaManager,
+		config:        config,
+	}
+}
+
+type ShardReindexTask_SearchablePositions struct {
+	name          string
+	logger        logrus.FieldLogger
+	schemaManager *schema.Manager
+	config        searchablePositionsConfig
+}
+
+type searchablePositionsConfig struct {
+	processingDuration            time.Duration
+	pauseDuration                 time.Duration
+	checkProcessingEveryNoObjects int
+	selectedCollections           map[string]struct{}
+}
+
+func (t *ShardReindexTask_SearchablePositions) Name() string {
+	return t.name
+}
+
+func (t *ShardReindexTask_SearchablePositions) OnBeforeLsmInit(ctx context.Context, shard *Shard) error {
+	return nil
+}
+
+// OnAfterLsmInit creates the positions buckets before the shard accepts
+// writes, so that objects written during the reindexing are indexed by the
+// regular write path.
+func (t *ShardReindexTask_SearchablePositions) OnAfterLsmInit(ctx context.Context, shard *Shard) (err error) {
+	collectionName := shard.Index().Config.ClassName.String()
+	logger := t.logger.WithFields(map[string]any{
+		"collection": collectionName,
+		"shard":      shard.Name(),
+		"method":     "OnAfterLsmInit",
+	})
+	defer func() {
+		if err != nil {
+			logger.WithError(err).Error("finished with error")
+		}
+	}()
+
+	if !t.isCollectionSelected(collectionName) {
+		logger.Debug("different collection selected. nothing to do")
+		return nil
+	}
+
+	rt := newFileSearchablePositionsReindexTracker(shard.pathLSM())
+	if rt.IsReindexed() {
+		// the write path indexes positions until they are enabled on all
+		// replicas
+		shard.indexingPositions.Store(true)
+		logger.Debug("reindexed. nothing to do")
+		return nil
+	}
+
+	class := shard.index.getSchema.ReadOnlyClass(collectionName)
+	if class == nil {
+		return fmt.Errorf("could not find class %s in schema", collectionName)
+	}
+	// tenants which were inactive while positions were enabled lack the buckets
+	if !rt.IsStarted() && shard.index.invertedIndexConfig.IndexPositions &&
+		!missingPositionsBuckets(shard.pathLSM(), class) {
+		logger.Debug("positions already indexed. nothing to do")
+		return nil
+	}
+
+	for _, prop := range class.Properties {
+		if !inverted.HasPositionsIndex(prop) {
+			continue
+		}
+		if err = shard.store.CreateOrLoadBucket(ctx,
+			helpers.BucketSearchablePositionsFromPropNameLSM(prop.Name),
+			shard.makeDefaultBucketOptions(lsmkv.StrategyMapCollection)...,
+		); err != nil {
+			return fmt.Errorf("creating positions bucket of prop %q: %w", prop.Name, err)
+		}
+	}
+
+	if !rt.IsStarted() {
+		if err = rt.init(); err != nil {
+			return fmt.Errorf("creating reindex tracker: %w", err)
+		}
+		if err = rt.markStarted(time.Now()); err != nil {
+			return fmt.Errorf("marking reindex started: %w", err)
+		}
+	}
+	shard.indexingPositions.Store(true)
+	return nil
+}
+
+func missingPositionsBuckets(lsmPath string, class *models.Class) bool {
+	for _, prop := range class.Properties {
+		if inverted.HasPositionsIndex(prop) &&
+			!bucketDirExists(lsmPath, helpers.BucketSearchablePositionsFromPropNameLSM(prop.Name)) {
+			return true
+		}
+	}
+	return false
+}
+
+// OnAfterLsmInitAsync adds the positions of the objects written before the
+// reindexing started. Objects written afterwards have already been indexed by
+// the write path.
+func (t *ShardReindexTask_SearchablePositions) OnAfterLsmInitAsync(ctx context.Context, shard ShardLike,
+) (rerunAt time.Time, reloadShard bool, err error) {
+	collectionName := shard.Index().Config.ClassName.String()
+	logger := t.logger.WithFields(map[string]any{
+		"collection": collectionName,
+		"shard":      shard.Name(),
+		"method":     "OnAfterLsmInitAsync",
+	})
+	logger.Info("starting")
+	defer func(started time.Time) {
+		logger = logger.WithField("took", time.Since(started))
+		if err != nil {
+			logger.WithError(err).Error("finished with error")
+		} else {
+			logger.Info("finished")
+		}
+	}(time.Now())
+
+	zerotime := time.Time{}
+
+	if !t.isCollectionSelected(collectionName) {
+		logger.Debug("different collection selected. nothing to do")
+		return zerotime, false, nil
+	}
+
+	rt := newFileSearchablePositionsReindexTracker(shard.pathLSM())
+	if rt.IsReindexed() {
+		return t.enablePositions(ctx, logger, shard.Index())
+	}
+	if !rt.IsStarted() {
+		logger.Debug("not started. nothing to do")
+		return zerotime, false, nil
+	}
+
+	reindexStarted, err := rt.getStarted()
+	if err != nil {
+		return zerotime, false, fmt.Errorf("getting reindex started: %w", err)
+	}
+	lastKey, err := rt.GetProgress()
+	if err != nil {
+		return zerotime, false, fmt.Errorf("getting reindex progress: %w", err)
+	}
+
+	class := shard.Index().getSchema.ReadOnlyClass(collectionName)
+	if class == nil {
+		return zerotime, false, fmt.Errorf("could not find class %s in schema", collectionName)
+	}
+
+	objectsBucket := shard.Store().Bucket(helpers.ObjectsBucketLSM)
+	if lastKey == nil {
+		// objects still in the memtable would be skipped by the on disk cursor
+		if err = objectsBucket.FlushMemtable(); err != nil {
+			return zerotime, false, fmt.Errorf("flushing objects bucket: %w", err)
+		}
+	}
+
+	cursor := objectsBucket.CursorOnDisk()
+	defer cursor.Close()
+
+	var k, v []byte
+	if lastKey == nil {
+		k, v = cursor.First()
+	} else {
+		k, v = cursor.Seek(lastKey)
+		if bytes.Equal(k, lastKey) {
+			k, v = cursor.Next()
+		}
+	}
+
+	processingStarted := time.Now()
+	processedCount := 0
+	for ; k != nil; k, v = cursor.Next() {
+		if err = ctx.Err(); err != nil {
+			return zerotime, false, fmt.Errorf("context check: %w / %w", err, context.Cause(ctx))
+		}
+
+		obj, err := storobj.FromBinary(v)
+		if err != nil {
+			return zerotime, false, fmt.Errorf("unmarshalling object %x: %w", k, err)
+		}
+		// objects updated since are indexed by the write path. Stale positions
+		// of objects replaced in the meantime belong to deleted doc ids only.
+		if obj.LastUpdateTimeUnix() < reindexStarted.UnixMilli() {
+			props, err := analyzeObjectPositions(shard.Store(), class, obj)
+			if err != nil {
+				return zerotime, false, fmt.Errorf("analyzing object %x: %w", k, err)
+			}
+			if err = addToPositionsIndex(shard.Store(), props, obj.DocID); err != nil {
+				return zerotime, false, fmt.Errorf("indexing object %x: %w", k, err)
+			}
+		}
+
+		processedCount++
+		if processedCount%t.config.checkProcessingEveryNoObjects == 0 &&
+			time.Since(processingStarted) > t.config.processingDuration {
+			if err = rt.markProgress(k); err != nil {
+				return zerotime, false, fmt.Errorf("marking reindex progress: %w", err)
+			}
+			logger.WithField("processed", processedCount).Debug("paused")
+			return time.Now().Add(t.config.pauseDuration), false, nil
+		}
+	}
+
+	if err = rt.markReindexed(); err != nil {
+		return zerotime, false, fmt.Errorf("marking reindexed: %w", err)
+	}
+	logger.WithField("processed", processedCount).Debug("reindexed")
+
+	return t.enablePositions(ctx, logger, shard.Index())
+}
+
+// enablePositions turns on phrase queries of the collection once all replicas
+// of all active shards of the collection are reindexed. Until then the check
+// is repeated after the pause duration, so that the replica finishing last
+// does not depend on the others to enable them.
+func (t *ShardReindexTask_SearchablePositions) enablePositions(ctx context.Context,
+	logger logrus.FieldLogger, index *Index,
+) (rerunAt time.Time, reloadShard bool, err error) {
+	if index.GetInvertedIndexConfig().IndexPositions {
+		return time.Time{}, false, nil
+	}
+
+	allReindexed, err := index.allReplicasReindexed(ctx, searchablePositionsReindexTask)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("checking replicas: %w", err)
+	}
+	if !allReindexed {
+		logger.Debug("other replicas not reindexed yet")
+		return time.Now().Add(t.config.pauseDuration), false, nil
+	}
+
+	if err := updateToPositionsInvertedIndexConfig(ctx, t.schemaManager, index.Config.ClassName.String()); err != nil {
+		return time.Time{}, false, fmt.Errorf("updating inverted index config: %w", err)
+	}
+	return time.Time{}, false, nil
+}
+
+func (t *ShardReindexTask_SearchablePositions) isCollectionSelected(collectionName string) bool {
+	if t.config.selectedCollections == nil {
+		return true
+	}
+	_, ok := t.config.selectedCollections[collectionName]
+	return ok
+}
+
+func updateToPositionsInvertedIndexConfig(ctx context.Context, sc *schema.Manager, className string) error {
+	class := sc.ReadOnlyClass(className)
+	if class == nil {
+		return fmt.Errorf("class %q not found", className)
+	}
+	// nothing to update
+	if class.InvertedIndexConfig.IndexPositions {
+		return nil
+	}
+	class.ModuleConfig = structToMap(class.ModuleConfig)
+	class.VectorIndexConfig = structToMap(class.VectorIndexConfig)
+	class.ShardingConfig = structToMap(class.ShardingConfig)
+	for i := range class.VectorConfig {
+		tempConfig := class.VectorConfig[i]
+		tempConfig.VectorIndexConfig = structToMap(tempConfig.VectorIndexConfig)
+		tempConfig.Vectorizer = structToMap(tempConfig.Vectorizer)
+		class.VectorConfig[i] = tempConfig
+	}
+	class.InvertedIndexConfig.IndexPositions = true
+	return schema.UpdateClassInternal(&sc.Handler, ctx, className, class)
+}
+
+// -----------------------------------------------------------------------------
+
+func newFileSearchablePositionsReindexTracker(lsmPath string) *fileSearchablePositionsReindexTracker {
+	return &fileSearchablePositionsReindexTracker{
+		filenameStarted:   "started.mig",
+		filenameProgress:  "progress.mig",
+		filenameReindexed: "reindexed.mig",
+		migrationPath:     filepath.Join(lsmPath, ".migrations", searchablePositionsReindexTask),
+	}
+}
+
+type fileSearchablePositionsReindexTracker struct {
+	filenameStarted   string
+	filenameProgress  string
+	filenameReindexed string
+	migrationPath     string
+}
+
+func (t *fileSearchablePositionsReindexTracker) init() error {
+	return os.MkdirAll(t.migrationPath, 0o777)
+}
+
+func (t *fileSearchablePositionsReindexTracker) IsStarted() bool {
+	return t.fileExists(t.filenameStarted)
+}
+
+func (t *fileSearchablePositionsReindexTracker) markStarted(started time.Time) error {
+	return os.WriteFile(t.filepath(t.filenameStarted), []byte(started.UTC().Format(time.RFC3339Nano)), 0o666)
+}
+
+func (t *fileSearchablePositionsReindexTracker) getStarted() (time.Time, error) {
+	content, err := os.ReadFile(t.filepath(t.filenameStarted))
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Parse(time.RFC3339Nano, strings.TrimSpace(string(content)))
+}
+
+func (t *fileSearchablePositionsReindexTracker) markProgress(lastProcessedKey []byte) error {
+	return os.WriteFile(t.filepath(t.filenameProgress), []byte(hex.EncodeToString(lastProcessedKey)), 0o666)
+}
+
+// GetProgress returns the key of the last processed object, nil if the
+// processing has not started yet
+func (t *fileSearchablePositionsReindexTracker) GetProgress() ([]byte, error) {
+	content, err := os.ReadFile(t.filepath(t.filenameProgress))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return hex.DecodeString(strings.TrimSpace(string(content)))
+}
+
+func (t *fileSearchablePositionsReindexTracker) IsReindexed() bool {
+	return t.fileExists(t.filenameReindexed)
+}
+
+func (t *fileSearchablePositionsReindexTracker) markReindexed() error {
+	return os.WriteFile(t.filepath(t.filenameReindexed), []byte(time.Now().UTC().Format(time.RFC3339Nano)), 0o666)
+}
+
+func (t *fileSearchablePositionsReindexTracker) filepath(filename string) string {
+	return filepath.Join(t.migrationPath, filename)
+}
+
+func (t *fileSearchablePositionsReindexTracker) fileExists(filename string) bool {
+	_, err := os.Stat(t.filepath(filename))
+	return err == nil
+}
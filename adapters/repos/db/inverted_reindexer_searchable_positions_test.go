@@ -0,0 +1,133 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+type fakeReindexedRemoteClient struct {
+	sharding.RemoteIndexClient
+	reindexed map[string]bool
+	err       error
+}
+
+func (f *fakeReindexedRemoteClient) GetShardReindexed(ctx context.Context,
+	hostName, indexName, shardName, task string,
+) (bool, error) {
+	return f.reindexed[hostName+"/"+shardName], f.err
+}
+
+type fakeHostResolver struct{}
+
+func (fakeHostResolver) NodeHostname(nodeName string) (string, bool) {
+	return nodeName, true
+}
+
+func TestAllReplicasReindexed(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	state := &sharding.State{
+		Physical: map[string]sharding.Physical{
+			"shard1": {Name: "shard1", BelongsToNodes: []string{"node1", "node2"}, Status: models.TenantActivityStatusHOT},
+			"shard2": {Name: "shard2", BelongsToNodes: []string{"node2"}, Status: models.TenantActivityStatusHOT},
+			// inactive tenants are reindexed once activated
+			"shard3": {Name: "shard3", BelongsToNodes: []string{"node1"}, Status: models.TenantActivityStatusCOLD},
+		},
+	}
+
+	newIndex := func(t *testing.T, client sharding.RemoteIndexClient) *Index {
+		schemaGetter := schemaUC.NewMockSchemaGetter(t)
+		schemaGetter.On("NodeName").Return("node1").Maybe()
+		schemaReader := schemaUC.NewMockSchemaReader(t)
+		schemaReader.EXPECT().Read(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+			func(className string, retryIfClassNotFound bool, readFunc func(*models.Class, *sharding.State) error) error {
+				return readFunc(nil, state)
+			}).Maybe()
+
+		return &Index{
+			Config:       IndexConfig{RootPath: t.TempDir(), ClassName: schema.ClassName("TestClass")},
+			getSchema:    schemaGetter,
+			schemaReader: schemaReader,
+			remote:       sharding.NewRemoteIndex("TestClass", schemaGetter, fakeHostResolver{}, client),
+			logger:       logger,
+		}
+	}
+
+	markReindexed := func(t *testing.T, index *Index, shardName string) {
+		rt := newFileSearchablePositionsReindexTracker(shardPathLSM(index.path(), shardName))
+		require.NoError(t, rt.init())
+		require.NoError(t, rt.markReindexed())
+	}
+
+	t.Run("local replica not reindexed", func(t *testing.T) {
+		index := newIndex(t, &fakeReindexedRemoteClient{reindexed: map[string]bool{
+			"node2/shard1": true,
+			"node2/shard2": true,
+		}})
+
+		reindexed, err := index.allReplicasReindexed(ctx, searchablePositionsReindexTask)
+		require.NoError(t, err)
+		require.False(t, reindexed)
+	})
+
+	t.Run("remote replica not reindexed", func(t *testing.T) {
+		index := newIndex(t, &fakeReindexedRemoteClient{reindexed: map[string]bool{
+			"node2/shard1": true,
+		}})
+		markReindexed(t, index, "shard1")
+
+		reindexed, err := index.allReplicasReindexed(ctx, searchablePositionsReindexTask)
+		require.NoError(t, err)
+		require.False(t, reindexed)
+	})
+
+	t.Run("remote replica not reachable", func(t *testing.T) {
+		index := newIndex(t, &fakeReindexedRemoteClient{err: errors.New("connection refused")})
+		markReindexed(t, index, "shard1")
+
+		reindexed, err := index.allReplicasReindexed(ctx, searchablePositionsReindexTask)
+		require.NoError(t, err)
+		require.False(t, reindexed)
+	})
+
+	t.Run("all replicas reindexed", func(t *testing.T) {
+		index := newIndex(t, &fakeReindexedRemoteClient{reindexed: map[string]bool{
+			"node2/shard1": true,
+			"node2/shard2": true,
+		}})
+		markReindexed(t, index, "shard1")
+
+		reindexed, err := index.allReplicasReindexed(ctx, searchablePositionsReindexTask)
+		require.NoError(t, err)
+		require.True(t, reindexed)
+	})
+
+	t.Run("unknown task", func(t *testing.T) {
+		index := newIndex(t, &fakeReindexedRemoteClient{})
+
+		_, err := index.IncomingGetShardReindexed(ctx, "shard1", "unknown")
+		require.Error(t, err)
+	})
+}
@@ -34,6 +34,10 @@ func GetPropNameAndIndexTypeFromBucketName(bucketName string) (string, PropertyI
 			IndexTypePropLength,
 			helpers.BucketFromPropNameLengthLSM,
 		},
+		{
+			IndexTypePropSearchablePositions,
+			helpers.BucketSearchablePositionsFromPropNameLSM,
+		},
 		{
 			IndexTypePropSearchableValue,
 			helpers.BucketSearchableFromPropNameLSM,
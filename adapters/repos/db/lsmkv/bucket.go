@@ -48,6 +48,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/encryption"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 )
 
@@ -84,6 +85,12 @@ type Bucket struct {
 	flushLock        sync.RWMutex
 	haltedFlushTimer *interval.BackoffTimer
 
+	// serializes flushes, there can only be a single flushing memtable at a
+	// time. Held by [Store.Snapshot] while it freezes the active memtable.
+	// A flushing memtable while flushMux is not held was frozen by a store
+	// snapshot and is flushed with the next FlushAndSwitch.
+	flushMux sync.Mutex
+
 	minWalThreshold   uint64
 	walThreshold      uint64
 	flushDirtyAfter   time.Duration
@@ -172,6 +179,21 @@ type Bucket struct {
 	// ensuring segment files have integrity before reading them.
 	enableChecksumValidation bool
 
+	// optional compression of the values of new segments, segments written
+	// with another compression stay readable and are converted when they are
+	// compacted (currently supported only in buckets of REPLACE strategy). It
+	// can be changed while the bucket is in use, see SetCompression.
+	compression *compressionSetting
+
+	// optional encryption of the segments and write-ahead-logs, plaintext
+	// files and files encrypted with a previous key stay readable and are
+	// rewritten with the current key in the background
+	encryption *encryption.Encryptor
+
+	// optional tiering of segments which were not rewritten for a while to
+	// object storage, nil if all segments are kept locally
+	segmentTiering *SegmentTiering
+
 	// keep segments in memory for more performant search
 	// (currently used by roaringsetrange inverted indexes)
 	keepSegmentsInMemory bool
@@ -227,6 +249,7 @@ func (*Bucket) NewBucket(ctx context.Context, dir, rootDir string, logger logrus
 		haltedFlushTimer:             interval.NewBackoffTimer(),
 		writeSegmentInfoIntoFileName: false,
 		minWalThreshold:              config.DefaultPersistenceMaxReuseWalSize,
+		compression:                  &compressionSetting{},
 	}
 
 	for _, opt := range opts {
@@ -239,6 +262,16 @@ func (*Bucket) NewBucket(ctx context.Context, dir, rootDir string, logger logrus
 		return nil, errors.New("strategy needs to be explicitly set for all buckets")
 	}
 
+	if err := b.checkCompression(b.compression.get()); err != nil {
+		return nil, err
+	}
+	if b.strategy == StrategyReplace {
+		// segments compressed with the dictionary can only be read with it
+		if err := b.compression.loadZstdDictionary(b.dir, b.encryption); err != nil {
+			return nil, err
+		}
+	}
+
 	if b.memtableResizer != nil {
 		b.memtableThreshold = uint64(b.memtableResizer.Initial())
 	}
@@ -286,6 +319,9 @@ func (*Bucket) NewBucket(ctx context.Context, dir, rootDir string, logger logrus
 			maxSegmentSize:               b.maxSegmentSize,
 			cleanupInterval:              b.segmentsCleanupInterval,
 			enableChecksumValidation:     b.enableChecksumValidation,
+			compression:                  b.compression,
+			encryption:                   b.encryption,
+			segmentTiering:               b.segmentTiering,
 			keepSegmentsInMemory:         b.keepSegmentsInMemory,
 			MinMMapSize:                  b.minMMapSize,
 			bm25config:                   b.bm25Config,
@@ -502,6 +538,28 @@ func (b *Bucket) SetMemtableThreshold(size uint64) {
 	b.memtableThreshold = size
 }
 
+// SetCompression changes the compression of the segments written from now
+// on: of the next flush, compaction and cleanup. Existing segments keep their
+// compression until they are compacted.
+func (b *Bucket) SetCompression(compression string) error {
+	c, err := SegmentCompressionFromString(compression)
+	if err != nil {
+		return err
+	}
+	if err := b.checkCompression(c); err != nil {
+		return err
+	}
+	b.compression.set(c)
+	return nil
+}
+
+func (b *Bucket) checkCompression(c segmentindex.Compression) error {
+	if c != segmentindex.CompressionNone && b.strategy != StrategyReplace {
+		return errors.Errorf("compression only supported on %q buckets", StrategyReplace)
+	}
+	return nil
+}
+
 type BucketConsistentView struct {
 	Active   memtable
 	Flushing memtable
@@ -1254,7 +1312,7 @@ func (b *Bucket) DeleteWith(key []byte, deletionTime time.Time, opts ...Secondar
 func (b *Bucket) createNewActiveMemtable() (memtable, error) {
 	path := filepath.Join(b.dir, fmt.Sprintf("segment-%d", time.Now().UnixNano()))
 
-	cl, err := newLazyCommitLogger(path, b.strategy)
+	cl, err := newLazyCommitLogger(path, b.strategy, b.encryption)
 	if err != nil {
 		return nil, errors.Wrap(err, "init commit logger")
 	}
@@ -1264,6 +1322,8 @@ func (b *Bucket) createNewActiveMemtable() (memtable, error) {
 	if err != nil {
 		return nil, err
 	}
+	mt.compression = b.compression
+	mt.encryption = b.encryption
 
 	return mt, nil
 }
@@ -1394,7 +1454,27 @@ func (b *Bucket) Shutdown(ctx context.Context) (err error) {
 		return fmt.Errorf("long-running flush in progress: %w", ctx.Err())
 	}
 
+	// wait for flushes in progress, a memtable frozen by a store snapshot is
+	// flushed before the active memtable
+	b.flushMux.Lock()
+	defer b.flushMux.Unlock()
+
+	if b.flushing != nil {
+		b.waitForZeroWriters(b.flushing)
+	}
+
 	b.flushLock.Lock()
+	if b.flushing != nil {
+		if b.flushing.getStrategy() == StrategyInverted {
+			avgPropLength, propLengthCount := b.disk.GetAveragePropertyLength()
+			b.flushing.setAveragePropertyLength(avgPropLength, propLengthCount)
+		}
+		if _, err := b.flushing.flush(); err != nil {
+			b.flushLock.Unlock()
+			return err
+		}
+		b.flushing = nil
+	}
 	if b.active.getStrategy() == StrategyInverted {
 		avgPropLength, propLengthCount := b.disk.GetAveragePropertyLength()
 		b.active.setAveragePropertyLength(avgPropLength, propLengthCount)
@@ -1411,26 +1491,7 @@ func (b *Bucket) Shutdown(ctx context.Context) (err error) {
 		}
 	}
 	b.flushLock.Unlock()
-
-	if b.flushing == nil {
-		// active has flushing, no one else was currently flushing, it's safe to
-		// exit
-		return nil
-	}
-
-	// it seems we still need to wait for someone to finish flushing
-	t := time.NewTicker(50 * time.Millisecond)
-	defer t.Stop()
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-t.C:
-			if b.flushing == nil {
-				return nil
-			}
-		}
-	}
+	return nil
 }
 
 func (b *Bucket) shouldReuseWAL() bool {
@@ -1444,7 +1505,10 @@ func (b *Bucket) flushAndSwitchIfThresholdsMet(shouldAbort cyclemanager.ShouldAb
 	memtableTooLarge := b.active.Size() >= b.memtableThreshold
 	walTooLarge := uint64(commitLogSize) >= b.walThreshold
 	dirtyTooLong := b.active.DirtyDuration() >= b.flushDirtyAfter
-	shouldSwitch := memtableTooLarge || walTooLarge || dirtyTooLong
+	// a memtable frozen by a store snapshot is flushed once it has been dirty
+	// for as long as an active memtable would be
+	frozenDirtyTooLong := b.flushing != nil && b.flushing.DirtyDuration() >= b.flushDirtyAfter
+	shouldSwitch := memtableTooLarge || walTooLarge || dirtyTooLong || frozenDirtyTooLong
 
 	// If true, the parent shard has indicated that it has
 	// entered an immutable state. During this time, the
@@ -1462,7 +1526,7 @@ func (b *Bucket) flushAndSwitchIfThresholdsMet(shouldAbort cyclemanager.ShouldAb
 		return false
 	}
 
-	if b.shouldReuseWAL() {
+	if !frozenDirtyTooLong && b.shouldReuseWAL() {
 		defer b.flushLock.RUnlock()
 		return b.getAndUpdateWritesSinceLastSync()
 	}
@@ -1560,6 +1624,9 @@ func (b *Bucket) isReadOnly() bool {
 // calling, but there are some situations where this might be intended, such as
 // in test scenarios or when a force flush is desired.
 func (b *Bucket) FlushAndSwitch() error {
+	b.flushMux.Lock()
+	defer b.flushMux.Unlock()
+
 	before := time.Now()
 	var err error
 
@@ -1569,6 +1636,10 @@ func (b *Bucket) FlushAndSwitch() error {
 		WithField("path", bucketPath).
 		Trace("start flush and switch")
 
+	if err := b.flushFrozenMemtable(); err != nil {
+		return fmt.Errorf("flush and switch: %w", err)
+	}
+
 	switched, err := b.atomicallySwitchMemtable(b.createNewActiveMemtable)
 	if err != nil {
 		b.logger.WithField("action", "lsm_memtable_flush_start").
@@ -1583,6 +1654,16 @@ func (b *Bucket) FlushAndSwitch() error {
 		return nil
 	}
 
+	return b.flushSwitchedMemtable(before)
+}
+
+// flushSwitchedMemtable flushes the memtable which was switched from active
+// to flushing by FlushAndSwitch or a store snapshot. The caller has to hold
+// flushMux.
+func (b *Bucket) flushSwitchedMemtable(before time.Time) error {
+	var err error
+	bucketPath := b.GetDir()
+
 	// Before we can start the actual flush, we need to make sure that all
 	// ongoing writers have finished their write, otherwise we could lose the
 	// write.
@@ -1905,6 +1986,14 @@ func (b *Bucket) createDiskTermFromCV(ctx context.Context, view BucketConsistent
 		}
 	}()
 
+	// the segments which are only read by the block max terms below need to
+	// be loaded first, so that a segment in cold storage which cannot be
+	// fetched fails the query
+	if err := loadSegments(view.Disk); err != nil {
+		view.Release()
+		return nil, nil, func() {}, err
+	}
+
 	averagePropLength, err := b.GetAveragePropertyLength()
 	if err != nil {
 		view.Release()
@@ -2137,6 +2226,15 @@ func DetermineUnloadedBucketStrategy(bucketPath string) (string, error) {
 }
 
 func DetermineUnloadedBucketStrategyAmong(bucketPath string, prioritizedStrategies []string) (string, error) {
+	return DetermineUnloadedEncryptedBucketStrategyAmong(bucketPath, prioritizedStrategies, nil)
+}
+
+// DetermineUnloadedEncryptedBucketStrategyAmong is like
+// DetermineUnloadedBucketStrategyAmong, but is also able to read segments and
+// write-ahead-logs which are encrypted at rest
+func DetermineUnloadedEncryptedBucketStrategyAmong(bucketPath string, prioritizedStrategies []string,
+	enc *encryption.Encryptor,
+) (string, error) {
 	if len(prioritizedStrategies) == 0 {
 		return "", fmt.Errorf("no prioritizedStrategies given")
 	}
@@ -2162,7 +2260,7 @@ func DetermineUnloadedBucketStrategyAmong(bucketPath string, prioritizedStrategi
 	for _, entry := range entries {
 		switch filepath.Ext(entry.Name()) {
 		case ".db":
-			info, err := entry.Info()
+			info, err := encryption.Stat(filepath.Join(bucketPath, entry.Name()))
 			if err != nil {
 				ec.Add(fmt.Errorf("%q:%w", entry.Name(), err))
 				continue
@@ -2172,7 +2270,7 @@ func DetermineUnloadedBucketStrategyAmong(bucketPath string, prioritizedStrategi
 			}
 
 			strategy, err := func() (string, error) {
-				file, err := os.Open(filepath.Join(bucketPath, entry.Name()))
+				file, err := enc.Open(filepath.Join(bucketPath, entry.Name()))
 				if err != nil {
 					return "", err
 				}
@@ -2200,8 +2298,22 @@ func DetermineUnloadedBucketStrategyAmong(bucketPath string, prioritizedStrategi
 			}
 			return strategy, nil
 
+		case RemoteMarkerSuffix:
+			// the segment is in cold storage, the marker knows its strategy
+			marker, err := readRemoteMarker(filepath.Join(bucketPath, entry.Name()))
+			if err != nil {
+				ec.Add(fmt.Errorf("%q:%w", entry.Name(), err))
+				continue
+			}
+			strategy := marker.Strategy.String()
+			if !slices.Contains(prioritizedStrategies, strategy) {
+				ecMismatchStrategy.Add(fmt.Errorf("%q:strategy %q does not match %v", entry.Name(), strategy, prioritizedStrategies))
+				continue
+			}
+			return strategy, nil
+
 		case ".wal":
-			info, err := entry.Info()
+			info, err := encryption.Stat(filepath.Join(bucketPath, entry.Name()))
 			if err != nil {
 				ec.Add(fmt.Errorf("%q:%w", entry.Name(), err))
 				continue
@@ -2223,7 +2335,7 @@ func DetermineUnloadedBucketStrategyAmong(bucketPath string, prioritizedStrategi
 	// strategy not yet determined. proceed with wal files
 	for _, entry := range walEntries {
 		strategy, err := func() (string, error) {
-			file, err := os.Open(filepath.Join(bucketPath, entry.Name()))
+			file, err := enc.Open(filepath.Join(bucketPath, entry.Name()))
 			if err != nil {
 				return "", err
 			}
@@ -45,6 +45,12 @@ func (b *Bucket) FlushMemtable() error {
 func (b *Bucket) ListFiles(ctx context.Context, basePath string) ([]string, error) {
 	bucketRoot := b.disk.dir
 
+	// backups contain the segments themselves instead of the references to
+	// cold storage, as they are restored without it
+	if err := b.disk.fetchRemoteSegments(); err != nil {
+		return nil, errors.Wrap(err, "fetch segments from cold storage")
+	}
+
 	files, err := b.listFiles(bucketRoot, basePath)
 	if err != nil {
 		return nil, err
@@ -86,6 +92,12 @@ func (b *Bucket) listFiles(bucketRoot, basePath string) ([]string, error) {
 		if ext == ".wal" || ext == ".tmp" {
 			continue
 		}
+		// ignore the references to segments in cold storage, the segments
+		// were fetched before
+		if strings.HasSuffix(entry.Name(), RemoteMarkerSuffix) ||
+			strings.HasSuffix(entry.Name(), RemoteMarkerSuffix+DeleteMarkerSuffix) {
+			continue
+		}
 
 		files = append(files, path.Join(basePath, entry.Name()))
 	}
@@ -0,0 +1,180 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"runtime/debug"
+
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	entcfg "github.com/weaviate/weaviate/entities/config"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// CreateDotProductTerms is the counterpart of [Bucket.CreateDiskTerm] for
+// inverted buckets whose term frequencies hold weights rather than counts,
+// such as the postings of a sparse vector index. Each key is scored as its
+// stored frequency multiplied by the weight at the same position, so running
+// [DoBlockMaxWand] on the returned terms yields the top dot products.
+//
+// As with CreateDiskTerm, there is one group of terms per segment and per
+// memtable, and the groups are disjoint. The returned function must be called
+// once the terms are no longer used.
+func (b *Bucket) CreateDotProductTerms(ctx context.Context, filterDocIds helpers.AllowList,
+	keys [][]byte, weights []float64,
+) ([][]*SegmentBlockMax, func(), error) {
+	if b.strategy != StrategyInverted {
+		return nil, func() {}, fmt.Errorf("dot product terms require strategy %q, got %q",
+			StrategyInverted, b.strategy)
+	}
+	if len(keys) != len(weights) {
+		return nil, func() {}, fmt.Errorf("got %d keys but %d weights", len(keys), len(weights))
+	}
+
+	view := b.getConsistentView()
+	return b.createDotProductTermsFromCV(ctx, view, filterDocIds, keys, weights)
+}
+
+func (b *Bucket) createDotProductTermsFromCV(ctx context.Context, view BucketConsistentView,
+	filterDocIds helpers.AllowList, keys [][]byte, weights []float64,
+) ([][]*SegmentBlockMax, func(), error) {
+	defer func() {
+		if !entcfg.Enabled(os.Getenv("DISABLE_RECOVERY_ON_PANIC")) {
+			if r := recover(); r != nil {
+				b.logger.Errorf("Recovered from panic in CreateDotProductTerms: %v", r)
+				debug.PrintStack()
+				view.Release()
+			}
+		}
+	}()
+
+	if err := loadSegments(view.Disk); err != nil {
+		view.Release()
+		return nil, func() {}, err
+	}
+
+	output := make([][]*SegmentBlockMax, len(view.Disk)+2)
+	// flushing memtable
+	output[len(view.Disk)] = make([]*SegmentBlockMax, 0, len(keys))
+	// active memtable
+	output[len(view.Disk)+1] = make([]*SegmentBlockMax, 0, len(keys))
+
+	memTombstones := sroar.NewBitmap()
+	var activeTombstones *sroar.Bitmap
+	if view.Active != nil {
+		tombstones, err := view.Active.ReadOnlyTombstones()
+		if err != nil {
+			view.Release()
+			return nil, func() {}, fmt.Errorf("active tombstones: %w", err)
+		}
+		activeTombstones = tombstones
+		memTombstones.Or(tombstones)
+	}
+	if view.Flushing != nil {
+		tombstones, err := view.Flushing.ReadOnlyTombstones()
+		if err != nil {
+			view.Release()
+			return nil, func() {}, fmt.Errorf("flushing tombstones: %w", err)
+		}
+		memTombstones.Or(tombstones)
+	}
+
+	for i, key := range keys {
+		if view.Active != nil {
+			term, err := newDotProductTermFromMemtable(view.Active, key, i, weights[i], nil, filterDocIds)
+			if err != nil {
+				view.Release()
+				return nil, func() {}, err
+			}
+			if term != nil {
+				output[len(view.Disk)+1] = append(output[len(view.Disk)+1], term)
+			}
+		}
+		if view.Flushing != nil {
+			term, err := newDotProductTermFromMemtable(view.Flushing, key, i, weights[i], activeTombstones, filterDocIds)
+			if err != nil {
+				view.Release()
+				return nil, func() {}, err
+			}
+			if term != nil {
+				output[len(view.Disk)] = append(output[len(view.Disk)], term)
+			}
+		}
+	}
+
+	if ctx.Err() != nil {
+		view.Release()
+		return nil, func() {}, fmt.Errorf("after memtable terms: %w", ctx.Err())
+	}
+
+	for j := len(view.Disk) - 1; j >= 0; j-- {
+		segment := view.Disk[j]
+		output[j] = make([]*SegmentBlockMax, 0, len(keys))
+
+		allTombstones := memTombstones.Clone()
+		if j != len(view.Disk)-1 {
+			segTombstones, err := view.Disk[j+1].ReadOnlyTombstones()
+			if err != nil {
+				view.Release()
+				return nil, func() {}, fmt.Errorf("read tombstones: %w", err)
+			}
+			allTombstones.Or(segTombstones)
+		}
+
+		for i, key := range keys {
+			if !segment.hasKey(key) {
+				continue
+			}
+			term := segment.newSegmentBlockMaxDotProduct(key, i, weights[i], allTombstones, filterDocIds)
+			if term != nil {
+				output[j] = append(output[j], term)
+			}
+		}
+	}
+	return output, view.Release, nil
+}
+
+// newDotProductTermFromMemtable returns nil if the memtable has no postings
+// for the key that pass the filter.
+func newDotProductTermFromMemtable(mt memtable, key []byte, queryTermIndex int, weight float64,
+	tombstones *sroar.Bitmap, filterDocIds helpers.AllowList,
+) (*SegmentBlockMax, error) {
+	term := NewSegmentBlockMaxDecoded(key, queryTermIndex, 1, filterDocIds, 0, schema.BM25Config{})
+	term.dotProduct = true
+	term.queryWeight = weight
+
+	if _, err := fillTerm(mt, key, term, filterDocIds); err != nil {
+		return nil, fmt.Errorf("memtable term %q: %w", key, err)
+	}
+	if term.Count() == 0 {
+		return nil, nil
+	}
+
+	maxTf := uint64(0)
+	for _, tf := range term.blockDataDecoded.Tfs {
+		if tf > maxTf {
+			maxTf = tf
+		}
+	}
+	term.blockEntries[0].MaxImpactTf = uint32(maxTf)
+	term.idf = float64(maxTf) * weight
+	term.currentBlockImpact = float32(term.idf)
+
+	if !term.Exhausted() {
+		term.tombstones = tombstones
+		term.advanceOnTombstoneOrFilter()
+	}
+	return term, nil
+}
@@ -0,0 +1,152 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"encoding/binary"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestBucketCreateDotProductTerms(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucketCreator().NewBucket(ctx, t.TempDir(), "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategyInverted))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, b.Shutdown(context.Background()))
+	})
+
+	const (
+		docCount = 3000
+		dims     = 40
+		limit    = 10
+	)
+	r := rand.New(rand.NewSource(7))
+
+	dimKey := func(dim int) []byte {
+		key := make([]byte, 4)
+		binary.BigEndian.PutUint32(key, uint32(dim))
+		return key
+	}
+
+	// docs[docID][dim] = tf. Dimensions with a high id are rare, so some
+	// terms are small enough to be stored fully decoded.
+	docs := make(map[uint64]map[int]uint64, docCount)
+	for docID := uint64(0); docID < docCount; docID++ {
+		docs[docID] = map[int]uint64{}
+		for dim := 0; dim < dims; dim++ {
+			if r.Intn(dims) >= dims-dim/2 || r.Intn(4) != 0 {
+				continue
+			}
+			tf := uint64(r.Intn(1000) + 1)
+			docs[docID][dim] = tf
+			require.NoError(t, b.MapSet(dimKey(dim), NewMapPairFromDocIdAndTf(docID, float32(tf), 1, false)))
+		}
+		// spread the docs over several segments and the active memtable
+		if docID%1000 == 999 && docID != docCount-1 {
+			require.NoError(t, b.FlushAndSwitch())
+		}
+	}
+
+	// delete every 7th doc
+	for docID := uint64(0); docID < docCount; docID += 7 {
+		for dim := range docs[docID] {
+			docIDBytes := make([]byte, 8)
+			binary.BigEndian.PutUint64(docIDBytes, docID)
+			require.NoError(t, b.MapDeleteKey(dimKey(dim), docIDBytes))
+		}
+		delete(docs, docID)
+	}
+
+	search := func(t *testing.T, query map[int]float64, allow helpers.AllowList) []float64 {
+		keys := make([][]byte, 0, len(query))
+		weights := make([]float64, 0, len(query))
+		for dim, w := range query {
+			keys = append(keys, dimKey(dim))
+			weights = append(weights, w)
+		}
+
+		groups, release, err := b.CreateDotProductTerms(ctx, allow, keys, weights)
+		require.NoError(t, err)
+		defer release()
+
+		scores := map[uint64]float64{}
+		for _, group := range groups {
+			if len(group) == 0 {
+				continue
+			}
+			heap, err := DoBlockMaxWand(ctx, limit, group, 0, false, len(keys), 1, logger)
+			require.NoError(t, err)
+			for heap.Len() > 0 {
+				item := heap.Pop()
+				scores[item.ID] += float64(item.Dist)
+			}
+		}
+		return topScores(scores, limit)
+	}
+
+	bruteForce := func(query map[int]float64, allow helpers.AllowList) []float64 {
+		scores := map[uint64]float64{}
+		for docID, vec := range docs {
+			if allow != nil && !allow.Contains(docID) {
+				continue
+			}
+			for dim, w := range query {
+				if tf, ok := vec[dim]; ok {
+					scores[docID] += float64(tf) * w
+				}
+			}
+		}
+		return topScores(scores, limit)
+	}
+
+	for i := 0; i < 20; i++ {
+		query := map[int]float64{}
+		for j := 0; j < 5; j++ {
+			query[r.Intn(dims)] = r.Float64()
+		}
+
+		require.InDeltaSlice(t, bruteForce(query, nil), search(t, query, nil), 1e-3)
+
+		allow := helpers.NewAllowList()
+		for docID := uint64(0); docID < docCount; docID += 3 {
+			allow.Insert(docID)
+		}
+		require.InDeltaSlice(t, bruteForce(query, allow), search(t, query, allow), 1e-3)
+	}
+}
+
+func topScores(scores map[uint64]float64, limit int) []float64 {
+	out := make([]float64, 0, len(scores))
+	for _, score := range scores {
+		if score > 0 {
+			out = append(out, score)
+		}
+	}
+	sort.Sort(sort.Reverse(sort.Float64Slice(out)))
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
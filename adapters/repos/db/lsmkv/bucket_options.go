@@ -18,6 +18,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/roaringset"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/encryption"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 )
 
@@ -219,6 +220,38 @@ func WithSegmentsChecksumValidationEnabled(enable bool) BucketOption {
 	}
 }
 
+// WithCompression compresses the values of new segments with the given
+// algorithm, see compression.go. Existing segments are converted when they
+// are compacted.
+func WithCompression(compression string) BucketOption {
+	return func(b *Bucket) error {
+		c, err := SegmentCompressionFromString(compression)
+		if err != nil {
+			return err
+		}
+		b.compression.set(c)
+		return nil
+	}
+}
+
+// WithEncryption encrypts new segments and write-ahead-logs. Existing files
+// are rewritten with the current key during the compaction cycles.
+func WithEncryption(enc *encryption.Encryptor) BucketOption {
+	return func(b *Bucket) error {
+		b.encryption = enc
+		return nil
+	}
+}
+
+// WithSegmentTiering moves segments to object storage once they were not
+// rewritten for the configured time, nil keeps all segments locally
+func WithSegmentTiering(tiering *SegmentTiering) BucketOption {
+	return func(b *Bucket) error {
+		b.segmentTiering = tiering
+		return nil
+	}
+}
+
 /*
 Background for this option:
 
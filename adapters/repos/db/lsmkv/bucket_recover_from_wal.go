@@ -96,7 +96,7 @@ func (b *Bucket) mayRecoverFromCommitLogs(ctx context.Context, sg *SegmentGroup,
 
 			path := filepath.Join(b.dir, strings.TrimSuffix(fname, ".wal"))
 
-			cl, err := newCommitLogger(path, b.strategy, files[fname])
+			cl, err := newCommitLogger(path, b.strategy, files[fname], b.encryption)
 			if err != nil {
 				return errors.Wrap(err, "init commit logger")
 			}
@@ -112,6 +112,8 @@ func (b *Bucket) mayRecoverFromCommitLogs(ctx context.Context, sg *SegmentGroup,
 			if err != nil {
 				return err
 			}
+			mt.compression = b.compression
+			mt.encryption = b.encryption
 
 			_, err = cl.file.Seek(0, io.SeekStart)
 			if err != nil {
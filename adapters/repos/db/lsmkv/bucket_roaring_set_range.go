@@ -60,6 +60,10 @@ func (b *Bucket) ReaderRoaringSetRange() ReaderRoaringSetRange {
 
 func (b *Bucket) readerRoaringSetRangeFromSegments() ReaderRoaringSetRange {
 	view := b.getConsistentView()
+	if err := loadSegments(view.Disk); err != nil {
+		view.Release()
+		return failedReaderRoaringSetRange{err: err}
+	}
 
 	readers := make([]roaringsetrange.InnerReader, len(view.Disk))
 	for i, segment := range view.Disk {
@@ -73,6 +77,19 @@ func (b *Bucket) readerRoaringSetRangeFromSegments() ReaderRoaringSetRange {
 	return roaringsetrange.NewCombinedReader(readers, view.Release, concurrency.SROAR_MERGE, b.logger)
 }
 
+// failedReaderRoaringSetRange returns the error the reader could not be
+// created with, e.g. as a segment in cold storage could not be fetched
+type failedReaderRoaringSetRange struct {
+	err error
+}
+
+func (r failedReaderRoaringSetRange) Read(ctx context.Context, value uint64, operator filters.Operator,
+) (*sroar.Bitmap, func(), error) {
+	return nil, noopRelease, r.err
+}
+
+func (r failedReaderRoaringSetRange) Close() {}
+
 func (b *Bucket) readerRoaringSetRangeFromSegmentInMemo() ReaderRoaringSetRange {
 	var active, flushing memtable
 	var readers []roaringsetrange.InnerReader
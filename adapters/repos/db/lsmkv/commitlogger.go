@@ -25,6 +25,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/weaviate/weaviate/usecases/byteops"
+	"github.com/weaviate/weaviate/usecases/encryption"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 
 	"github.com/weaviate/weaviate/adapters/repos/db/roaringset"
@@ -52,6 +53,7 @@ var (
 type lazyCommitLogger struct {
 	path         string
 	strategy     string
+	encryption   *encryption.Encryptor
 	commitLogger *commitLogger
 	mux          sync.Mutex
 }
@@ -65,7 +67,7 @@ func (cl *lazyCommitLogger) mayInitCommitLogger() error {
 	}
 
 	// file does not exist yet
-	commitLogger, err := newCommitLogger(cl.path, cl.strategy, 0)
+	commitLogger, err := newCommitLogger(cl.path, cl.strategy, 0, cl.encryption)
 	if err != nil {
 		return err
 	}
@@ -174,7 +176,7 @@ func (cl *lazyCommitLogger) sync() error {
 }
 
 type commitLogger struct {
-	file   *os.File
+	file   *encryption.File
 	writer *bufio.Writer
 	n      atomic.Int64
 	path   string
@@ -238,23 +240,33 @@ func (ct CommitType) Is(checkedCommitType CommitType) bool {
 	return ct == checkedCommitType
 }
 
-func newLazyCommitLogger(path, strategy string) (*lazyCommitLogger, error) {
+func newLazyCommitLogger(path, strategy string, enc *encryption.Encryptor) (*lazyCommitLogger, error) {
 	return &lazyCommitLogger{
-		path:     path,
-		strategy: strategy,
+		path:       path,
+		strategy:   strategy,
+		encryption: enc,
 	}, nil
 }
 
-func newCommitLogger(path, strategy string, fileSize int64) (*commitLogger, error) {
+func newCommitLogger(path, strategy string, fileSize int64, enc *encryption.Encryptor) (*commitLogger, error) {
 	out := &commitLogger{path: walPath(path)}
 
-	out.n.Swap(fileSize)
-
-	f, err := os.OpenFile(out.path, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0o666)
+	f, err := enc.OpenFile(out.path, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0o666)
 	if err != nil {
 		return nil, err
 	}
 
+	if fileSize > 0 && f.Encrypted() {
+		// the size on disk includes the encryption header
+		info, err := f.Stat()
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		fileSize = info.Size()
+	}
+	out.n.Swap(fileSize)
+
 	observeWrite := monitoring.GetMetrics().FileIOWrites.With(prometheus.Labels{
 		"strategy":  strategy,
 		"operation": "appendWAL",
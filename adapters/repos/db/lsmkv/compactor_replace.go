@@ -50,12 +50,18 @@ type compactorReplace struct {
 	maxNewFileSize int64
 
 	enableChecksumValidation bool
+	// values are decompressed by the cursors and compressed again with the
+	// compression of the bucket, segments with different compressions can
+	// therefore be compacted with each other
+	compression segmentindex.Compression
+	zstdDict    *zstdDictionary
 }
 
 func newCompactorReplace(w io.WriteSeeker,
 	c1, c2 innerCursorReplaceAllKeys, level, secondaryIndexCount uint16,
 	scratchSpacePath string, cleanupTombstones bool,
-	enableChecksumValidation bool, maxNewFileSize int64, allocChecker memwatch.AllocChecker,
+	enableChecksumValidation bool, compression segmentindex.Compression,
+	zstdDict *zstdDictionary, maxNewFileSize int64, allocChecker memwatch.AllocChecker,
 ) *compactorReplace {
 	observeWrite := monitoring.GetMetrics().FileIOWrites.With(prometheus.Labels{
 		"operation": "compaction",
@@ -78,6 +84,8 @@ func newCompactorReplace(w io.WriteSeeker,
 		secondaryIndexCount:      secondaryIndexCount,
 		scratchSpacePath:         scratchSpacePath,
 		enableChecksumValidation: enableChecksumValidation,
+		compression:              compression,
+		zstdDict:                 zstdDict,
 		allocChecker:             allocChecker,
 		maxNewFileSize:           maxNewFileSize,
 	}
@@ -114,9 +122,15 @@ func (c *compactorReplace) do() error {
 		dataEnd = uint64(kis[len(kis)-1].ValueEnd)
 	}
 
-	version := segmentindex.ChooseHeaderVersion(c.enableChecksumValidation)
-	if err := compactor.WriteHeader(c.mw, c.w, c.bufw, segmentFile, c.currentLevel, version,
-		c.secondaryIndexCount, dataEnd, segmentindex.StrategyReplace); err != nil {
+	header := &segmentindex.Header{
+		Level:            c.currentLevel,
+		Version:          segmentindex.ChooseHeaderVersion(c.enableChecksumValidation),
+		SecondaryIndices: c.secondaryIndexCount,
+		Strategy:         segmentindex.StrategyReplace,
+		IndexStart:       dataEnd,
+		Compression:      c.compression,
+	}
+	if err := compactor.WriteSegmentHeader(c.mw, c.w, c.bufw, segmentFile, header); err != nil {
 		return fmt.Errorf("write header: %w", err)
 	}
 
@@ -204,6 +218,14 @@ func (c *compactorReplace) writeKeys(f *segmentindex.SegmentFile) ([]segmentinde
 func (c *compactorReplace) writeIndividualNode(f *segmentindex.SegmentFile,
 	offset int, key, value []byte, secondaryKeys [][]byte, tombstone bool,
 ) (segmentindex.Key, error) {
+	if !tombstone {
+		compressed, err := compressValue(c.compression, c.zstdDict, value)
+		if err != nil {
+			return segmentindex.Key{}, fmt.Errorf("compress value: %w", err)
+		}
+		value = compressed
+	}
+
 	segNode := segmentReplaceNode{
 		offset:              offset,
 		tombstone:           tombstone,
@@ -0,0 +1,318 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+
+	"github.com/klauspost/compress/dict"
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/usecases/encryption"
+)
+
+const (
+	// CompressionNone stores the values of the segments as they are
+	CompressionNone = "none"
+	// CompressionZstd has the best ratio, it is meant for buckets holding
+	// large values, e.g. objects with long texts
+	CompressionZstd = "zstd"
+	// CompressionSnappy compresses less than zstd, but is faster to read and
+	// write
+	CompressionSnappy = "snappy"
+)
+
+func SegmentCompressionFromString(in string) (segmentindex.Compression, error) {
+	switch in {
+	case CompressionNone, "":
+		return segmentindex.CompressionNone, nil
+	case CompressionZstd:
+		return segmentindex.CompressionZstd, nil
+	case CompressionSnappy:
+		return segmentindex.CompressionSnappy, nil
+	default:
+		return 0, fmt.Errorf("unsupported compression %q, must be one of %q, %q or %q",
+			in, CompressionNone, CompressionZstd, CompressionSnappy)
+	}
+}
+
+// compressionSetting is the compression of the segments a bucket writes. It
+// is shared by the bucket and its segment group, so that a change applies to
+// flushes, compactions and cleanups alike. A nil setting means no compression.
+//
+// It also holds the zstd dictionary of the bucket, see trainZstdDictionary.
+type compressionSetting struct {
+	compression atomic.Uint32
+
+	dir        string
+	encryption *encryption.Encryptor
+	trainLock  sync.Mutex
+	zstdDict   atomic.Pointer[zstdDictionary]
+}
+
+func (s *compressionSetting) get() segmentindex.Compression {
+	if s == nil {
+		return segmentindex.CompressionNone
+	}
+	return segmentindex.Compression(s.compression.Load())
+}
+
+func (s *compressionSetting) set(c segmentindex.Compression) {
+	s.compression.Store(uint32(c))
+}
+
+// zstdDictionary returns the trained zstd dictionary of the bucket, nil if
+// none was trained yet
+func (s *compressionSetting) zstdDictionary() *zstdDictionary {
+	if s == nil {
+		return nil
+	}
+	return s.zstdDict.Load()
+}
+
+const (
+	// zstdDictionaryFile holds the trained zstd dictionary of a bucket
+	zstdDictionaryFile = "zstd.dict"
+	zstdDictionarySize = 16 * 1024
+	// a dictionary is only trained from at least minSampleSize bytes of
+	// values, and from at most maxSampleSize bytes
+	zstdDictionaryMinSampleSize = 8 * zstdDictionarySize
+	zstdDictionaryMaxSampleSize = 4 * 1024 * 1024
+)
+
+// zstdDictionary is trained from the values of a bucket. Small values, e.g.
+// objects of a few hundred bytes, have little to compress on their own, but
+// share most of their structure with each other: property names, json
+// syntax and repeated texts. Compressing every value with the dictionary
+// makes use of that, while point reads still decompress single values.
+//
+// The id of the dictionary is part of every zstd frame compressed with it, so
+// the segment format does not change: frames without a dictionary, written
+// before it was trained, are decompressed the same way.
+type zstdDictionary struct {
+	id      uint32
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+}
+
+func newZstdDictionary(raw []byte) (*zstdDictionary, error) {
+	header, err := zstd.InspectDictionary(raw)
+	if err != nil {
+		return nil, fmt.Errorf("inspect zstd dictionary: %w", err)
+	}
+	encoder, err := zstd.NewWriter(nil, zstd.WithEncoderDict(raw))
+	if err != nil {
+		return nil, fmt.Errorf("zstd encoder: %w", err)
+	}
+	decoder, err := zstd.NewReader(nil, zstd.WithDecoderDicts(raw))
+	if err != nil {
+		encoder.Close()
+		return nil, fmt.Errorf("zstd decoder: %w", err)
+	}
+	return &zstdDictionary{id: header.ID(), encoder: encoder, decoder: decoder}, nil
+}
+
+// loadZstdDictionary loads the dictionary of the bucket in dir, if one was
+// trained. The dictionary file is written with the encryption of the bucket.
+func (s *compressionSetting) loadZstdDictionary(dir string, enc *encryption.Encryptor) error {
+	s.dir, s.encryption = dir, enc
+
+	f, err := enc.Open(filepath.Join(dir, zstdDictionaryFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("open zstd dictionary: %w", err)
+	}
+	defer f.Close()
+
+	raw, err := io.ReadAll(f)
+	if err != nil {
+		return fmt.Errorf("read zstd dictionary: %w", err)
+	}
+	d, err := newZstdDictionary(raw)
+	if err != nil {
+		return err
+	}
+	s.zstdDict.Store(d)
+	return nil
+}
+
+// trainZstdDictionary trains the dictionary of the bucket from the given
+// values, unless there already is one or the values are too few. It is
+// called for the values of every flushed segment with zstd compression, so
+// the dictionary is trained from the first flush holding enough of them.
+// A bucket keeps its dictionary for good, segments compressed with it need
+// it to be read. The dictionary is persisted before it is used, only an
+// error persisting it is returned.
+func (s *compressionSetting) trainZstdDictionary(values [][]byte) (*zstdDictionary, error) {
+	if d := s.zstdDictionary(); d != nil || s == nil || s.dir == "" {
+		return d, nil
+	}
+
+	s.trainLock.Lock()
+	defer s.trainLock.Unlock()
+	if d := s.zstdDict.Load(); d != nil {
+		return d, nil
+	}
+
+	var samples [][]byte
+	size := 0
+	for _, value := range values {
+		if len(value) == 0 {
+			continue
+		}
+		samples = append(samples, value)
+		size += len(value)
+		if size >= zstdDictionaryMaxSampleSize {
+			break
+		}
+	}
+	if size < zstdDictionaryMinSampleSize {
+		return nil, nil
+	}
+
+	raw, err := dict.BuildZstdDict(samples, dict.Options{
+		MaxDictSize: zstdDictionarySize,
+		HashBytes:   6,
+	})
+	if err != nil {
+		// the values have nothing in common to train from, they are
+		// compressed without a dictionary and a later flush tries again
+		return nil, nil
+	}
+	d, err := newZstdDictionary(raw)
+	if err != nil {
+		return nil, nil
+	}
+	if err := s.writeZstdDictionary(raw); err != nil {
+		return nil, err
+	}
+	s.zstdDict.Store(d)
+	return d, nil
+}
+
+func (s *compressionSetting) writeZstdDictionary(raw []byte) error {
+	path := filepath.Join(s.dir, zstdDictionaryFile)
+	f, err := s.encryption.Create(path + ".tmp")
+	if err != nil {
+		return fmt.Errorf("create zstd dictionary: %w", err)
+	}
+	if _, err := f.Write(raw); err != nil {
+		f.Close()
+		return fmt.Errorf("write zstd dictionary: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return fmt.Errorf("sync zstd dictionary: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("close zstd dictionary: %w", err)
+	}
+	if err := os.Rename(path+".tmp", path); err != nil {
+		return fmt.Errorf("rename zstd dictionary: %w", err)
+	}
+	return diskio.Fsync(s.dir)
+}
+
+var (
+	zstdInit    sync.Once
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
+	zstdErr     error
+)
+
+// the encoder and decoder are safe for concurrent use with EncodeAll and
+// DecodeAll, they are shared by all buckets
+func initZstd() error {
+	zstdInit.Do(func() {
+		zstdEncoder, zstdErr = zstd.NewWriter(nil)
+		if zstdErr != nil {
+			return
+		}
+		zstdDecoder, zstdErr = zstd.NewReader(nil)
+	})
+	return zstdErr
+}
+
+// compressValue compresses a single value of a segment. Values are compressed
+// one by one rather than in blocks of several nodes, so that the offsets of
+// the index keep pointing at single nodes and a point read only decompresses
+// the value it returns. zstd uses the dictionary of the bucket if it has one,
+// which recovers most of the ratio blocks would have. Empty values are kept
+// as they are.
+func compressValue(compression segmentindex.Compression, zstdDict *zstdDictionary, value []byte) ([]byte, error) {
+	if len(value) == 0 {
+		return value, nil
+	}
+
+	switch compression {
+	case segmentindex.CompressionNone:
+		return value, nil
+	case segmentindex.CompressionZstd:
+		if zstdDict != nil {
+			return zstdDict.encoder.EncodeAll(value, make([]byte, 0, len(value)/2)), nil
+		}
+		if err := initZstd(); err != nil {
+			return nil, fmt.Errorf("init zstd: %w", err)
+		}
+		return zstdEncoder.EncodeAll(value, make([]byte, 0, len(value)/2)), nil
+	case segmentindex.CompressionSnappy:
+		return snappy.Encode(nil, value), nil
+	default:
+		return nil, fmt.Errorf("unsupported compression %s", compression)
+	}
+}
+
+// decompressValue reverses compressValue. The output is written to buf if it
+// is large enough, so that cursors can reuse their buffer. Values compressed
+// with a zstd dictionary can only be decompressed with it.
+func decompressValue(compression segmentindex.Compression, zstdDict *zstdDictionary, buf, value []byte) ([]byte, error) {
+	if len(value) == 0 {
+		return value, nil
+	}
+
+	switch compression {
+	case segmentindex.CompressionNone:
+		return value, nil
+	case segmentindex.CompressionZstd:
+		if err := initZstd(); err != nil {
+			return nil, fmt.Errorf("init zstd: %w", err)
+		}
+		decoder := zstdDecoder
+		if zstdDict != nil {
+			decoder = zstdDict.decoder
+		}
+		out, err := decoder.DecodeAll(value, buf[:0])
+		if err != nil {
+			return nil, fmt.Errorf("decompress zstd value: %w", err)
+		}
+		return out, nil
+	case segmentindex.CompressionSnappy:
+		out, err := snappy.Decode(buf[:cap(buf)], value)
+		if err != nil {
+			return nil, fmt.Errorf("decompress snappy value: %w", err)
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("unsupported compression %s", compression)
+	}
+}
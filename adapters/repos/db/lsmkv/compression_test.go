@@ -0,0 +1,356 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestCompressValue(t *testing.T) {
+	value := []byte(strings.Repeat("a long text property which repeats itself ", 100))
+
+	for _, compression := range []segmentindex.Compression{
+		segmentindex.CompressionNone,
+		segmentindex.CompressionZstd,
+		segmentindex.CompressionSnappy,
+	} {
+		t.Run(compression.String(), func(t *testing.T) {
+			compressed, err := compressValue(compression, nil, value)
+			require.NoError(t, err)
+			if compression != segmentindex.CompressionNone {
+				assert.Less(t, len(compressed), len(value)/4)
+			}
+
+			decompressed, err := decompressValue(compression, nil, nil, compressed)
+			require.NoError(t, err)
+			assert.Equal(t, value, decompressed)
+
+			buf := make([]byte, 0, 2*len(value))
+			decompressed, err = decompressValue(compression, nil, buf, compressed)
+			require.NoError(t, err)
+			assert.Equal(t, value, decompressed)
+
+			empty, err := compressValue(compression, nil, nil)
+			require.NoError(t, err)
+			assert.Empty(t, empty)
+			empty, err = decompressValue(compression, nil, nil, empty)
+			require.NoError(t, err)
+			assert.Empty(t, empty)
+		})
+	}
+}
+
+func TestBucketReplaceCompression(t *testing.T) {
+	for _, compression := range []string{CompressionZstd, CompressionSnappy} {
+		for _, pread := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s pread=%t", compression, pread), func(t *testing.T) {
+				testBucketReplaceCompression(t, compression, pread)
+			})
+		}
+	}
+
+	t.Run("only supported for replace buckets", func(t *testing.T) {
+		logger, _ := test.NewNullLogger()
+		_, err := NewBucketCreator().NewBucket(context.Background(), t.TempDir(), "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(StrategyMapCollection), WithCompression(CompressionZstd))
+		require.Error(t, err)
+	})
+
+	t.Run("unknown compression", func(t *testing.T) {
+		logger, _ := test.NewNullLogger()
+		_, err := NewBucketCreator().NewBucket(context.Background(), t.TempDir(), "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(StrategyReplace), WithCompression("lzma"))
+		require.Error(t, err)
+	})
+
+	t.Run("changed on an open bucket", func(t *testing.T) {
+		ctx := context.Background()
+		logger, _ := test.NewNullLogger()
+		b, err := NewBucketCreator().NewBucket(ctx, t.TempDir(), "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(StrategyReplace))
+		require.NoError(t, err)
+		defer b.Shutdown(ctx)
+
+		require.NoError(t, b.Put([]byte("a"), []byte(strings.Repeat("a", 1000))))
+		require.NoError(t, b.FlushAndSwitch())
+		require.NoError(t, b.SetCompression(CompressionZstd))
+		require.NoError(t, b.Put([]byte("b"), []byte(strings.Repeat("b", 1000))))
+		require.NoError(t, b.FlushAndSwitch())
+
+		require.Len(t, b.disk.segments, 2)
+		assert.Equal(t, segmentindex.CompressionNone, b.disk.segments[0].(*segment).compression)
+		assert.Equal(t, segmentindex.CompressionZstd, b.disk.segments[1].(*segment).compression)
+
+		compacted, err := b.disk.compactOnce()
+		require.NoError(t, err)
+		require.True(t, compacted)
+		require.Len(t, b.disk.segments, 1)
+		assert.Equal(t, segmentindex.CompressionZstd, b.disk.segments[0].(*segment).compression)
+
+		for _, key := range []string{"a", "b"} {
+			v, err := b.Get([]byte(key))
+			require.NoError(t, err)
+			assert.Equal(t, []byte(strings.Repeat(key, 1000)), v)
+		}
+
+		assert.Error(t, b.SetCompression("lzma"))
+	})
+
+	t.Run("changed on a bucket of another strategy", func(t *testing.T) {
+		ctx := context.Background()
+		logger, _ := test.NewNullLogger()
+		b, err := NewBucketCreator().NewBucket(ctx, t.TempDir(), "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(StrategyMapCollection))
+		require.NoError(t, err)
+		defer b.Shutdown(ctx)
+
+		assert.Error(t, b.SetCompression(CompressionZstd))
+		assert.NoError(t, b.SetCompression(CompressionNone))
+	})
+}
+
+func TestBucketReplaceZstdDictionary(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	// small objects which share most of their structure, too small to
+	// compress well on their own
+	value := func(i int, version string) []byte {
+		return []byte(fmt.Sprintf(`{"class":"Article","id":"%08d-0000-0000-0000-000000000000",`+
+			`"properties":{"title":"title of article %d","version":"%s","published":true,`+
+			`"wordCount":%d,"category":"news"},"vectorWeights":null}`, i, i, version, i*7))
+	}
+	key := func(i int) []byte { return []byte(fmt.Sprintf("key-%05d", i)) }
+
+	open := func(dir string) *Bucket {
+		b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(StrategyReplace), WithCompression(CompressionZstd))
+		require.NoError(t, err)
+		b.SetMemtableThreshold(1e9)
+		return b
+	}
+
+	t.Run("not trained from too few values", func(t *testing.T) {
+		dir := t.TempDir()
+		b := open(dir)
+		defer b.Shutdown(ctx)
+
+		for i := 0; i < 10; i++ {
+			require.NoError(t, b.Put(key(i), value(i, "v1")))
+		}
+		require.NoError(t, b.FlushAndSwitch())
+
+		assert.Nil(t, b.compression.zstdDictionary())
+		assert.NoFileExists(t, filepath.Join(dir, zstdDictionaryFile))
+	})
+
+	dir := t.TempDir()
+	b := open(dir)
+	for i := 0; i < 3000; i++ {
+		require.NoError(t, b.Put(key(i), value(i, "v1")))
+	}
+	require.NoError(t, b.FlushAndSwitch())
+
+	t.Run("trained on flush", func(t *testing.T) {
+		d := b.compression.zstdDictionary()
+		require.NotNil(t, d)
+		assert.FileExists(t, filepath.Join(dir, zstdDictionaryFile))
+		require.Len(t, b.disk.segments, 1)
+		assert.Same(t, d, b.disk.segments[0].(*segment).zstdDict)
+
+		withDict, withoutDict := 0, 0
+		for i := 0; i < 3000; i++ {
+			compressed, err := compressValue(segmentindex.CompressionZstd, d, value(i, "v1"))
+			require.NoError(t, err)
+			withDict += len(compressed)
+			compressed, err = compressValue(segmentindex.CompressionZstd, nil, value(i, "v1"))
+			require.NoError(t, err)
+			withoutDict += len(compressed)
+		}
+		assert.Less(t, withDict, withoutDict/2)
+	})
+
+	for i := 0; i < 3000; i += 2 {
+		require.NoError(t, b.Put(key(i), value(i, "v2")))
+	}
+	require.NoError(t, b.FlushAndSwitch())
+	require.NoError(t, b.Shutdown(ctx))
+
+	t.Run("read after reopening and compacting", func(t *testing.T) {
+		b := open(dir)
+		defer b.Shutdown(ctx)
+		require.NotNil(t, b.compression.zstdDictionary())
+
+		verify := func(t *testing.T) {
+			for i := 0; i < 3000; i++ {
+				expected := value(i, "v1")
+				if i%2 == 0 {
+					expected = value(i, "v2")
+				}
+				v, err := b.Get(key(i))
+				require.NoError(t, err)
+				require.Equal(t, expected, v, "key %d", i)
+			}
+		}
+
+		require.Len(t, b.disk.segments, 2)
+		verify(t)
+
+		compacted, err := b.disk.compactOnce()
+		require.NoError(t, err)
+		require.True(t, compacted)
+		require.Len(t, b.disk.segments, 1)
+		verify(t)
+	})
+
+	t.Run("required to read the segments", func(t *testing.T) {
+		require.NoError(t, os.Remove(filepath.Join(dir, zstdDictionaryFile)))
+		b := open(dir)
+		defer b.Shutdown(ctx)
+
+		_, err := b.Get(key(1))
+		assert.Error(t, err)
+	})
+}
+
+// testBucketReplaceCompression writes an uncompressed segment, then a
+// compressed one after the compression was enabled, and compacts the two
+func testBucketReplaceCompression(t *testing.T, compression string, pread bool) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	key := func(i int) []byte { return []byte(fmt.Sprintf("key-%03d", i)) }
+	secondaryKey := func(i int) []byte { return []byte(fmt.Sprintf("secondary-%03d", i)) }
+	value := func(i int, version string) []byte {
+		return []byte(strings.Repeat(fmt.Sprintf("value %d %s ", i, version), 50))
+	}
+	expected := map[int][]byte{}
+
+	open := func(opts ...BucketOption) *Bucket {
+		opts = append([]BucketOption{
+			WithStrategy(StrategyReplace),
+			WithSecondaryIndices(1),
+			WithPread(pread),
+			WithSegmentsChecksumValidationEnabled(true),
+		}, opts...)
+		b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+		require.NoError(t, err)
+		b.SetMemtableThreshold(1e9)
+		return b
+	}
+
+	verify := func(t *testing.T, b *Bucket) {
+		for i := 0; i < 150; i++ {
+			v, err := b.Get(key(i))
+			require.NoError(t, err)
+			assert.Equal(t, expected[i], v, "key %d", i)
+
+			v, err = b.GetBySecondary(ctx, 0, secondaryKey(i))
+			require.NoError(t, err)
+			assert.Equal(t, expected[i], v, "secondary key %d", i)
+		}
+
+		c := b.Cursor()
+		defer c.Close()
+		count := 0
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			var i int
+			_, err := fmt.Sscanf(string(k), "key-%03d", &i)
+			require.NoError(t, err)
+			assert.Equal(t, expected[i], v, "cursor key %d", i)
+			count++
+		}
+		assert.Equal(t, len(expected), count)
+
+		sc := b.CursorWithSecondaryIndex(0)
+		defer sc.Close()
+		count = 0
+		for k, v := sc.First(); k != nil; k, v = sc.Next() {
+			var i int
+			_, err := fmt.Sscanf(string(k), "secondary-%03d", &i)
+			require.NoError(t, err)
+			assert.Equal(t, expected[i], v, "secondary cursor key %d", i)
+			count++
+		}
+		assert.Equal(t, len(expected), count)
+	}
+
+	b := open()
+	for i := 0; i < 100; i++ {
+		require.NoError(t, b.Put(key(i), value(i, "original"), WithSecondaryKey(0, secondaryKey(i))))
+		expected[i] = value(i, "original")
+	}
+	require.NoError(t, b.FlushAndSwitch())
+	require.NoError(t, b.Shutdown(ctx))
+
+	b = open(WithCompression(compression))
+	defer b.Shutdown(ctx)
+	for i := 50; i < 150; i++ {
+		require.NoError(t, b.Put(key(i), value(i, "updated"), WithSecondaryKey(0, secondaryKey(i))))
+		expected[i] = value(i, "updated")
+	}
+	for i := 0; i < 10; i++ {
+		require.NoError(t, b.Delete(key(i), WithSecondaryKey(0, secondaryKey(i))))
+		delete(expected, i)
+	}
+	require.NoError(t, b.FlushAndSwitch())
+
+	expectedCompression, err := SegmentCompressionFromString(compression)
+	require.NoError(t, err)
+
+	t.Run("mixed segments", func(t *testing.T) {
+		require.Len(t, b.disk.segments, 2)
+		assert.Equal(t, segmentindex.CompressionNone, b.disk.segments[0].(*segment).compression)
+		assert.Equal(t, expectedCompression, b.disk.segments[1].(*segment).compression)
+		verify(t, b)
+	})
+
+	t.Run("compacted segment", func(t *testing.T) {
+		var compacted bool
+		for compacted, err = b.disk.compactOnce(); err == nil && compacted; compacted, err = b.disk.compactOnce() {
+		}
+		require.NoError(t, err)
+
+		require.Len(t, b.disk.segments, 1)
+		assert.Equal(t, expectedCompression, b.disk.segments[0].(*segment).compression)
+		verify(t, b)
+
+		payload := 0
+		for _, v := range expected {
+			payload += len(v)
+		}
+		assert.Less(t, b.disk.segments[0].Size(), int64(payload/4))
+
+		v, err := b.Get([]byte("missing"))
+		require.NoError(t, err)
+		assert.Nil(t, v)
+	})
+}
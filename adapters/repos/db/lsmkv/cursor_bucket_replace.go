@@ -24,6 +24,8 @@ type CursorReplace struct {
 	state        []cursorStateReplace
 	unlock       func()
 	serveCache   cursorStateReplace
+	// set if the cursor could not be created, see Err
+	err error
 
 	reusableIDList []int
 }
@@ -69,7 +71,11 @@ func (b *Bucket) Cursor() *CursorReplace {
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
-	innerCursors, unlockSegmentGroup := b.disk.newCursors()
+	innerCursors, unlockSegmentGroup, err := b.disk.newCursors()
+	if err != nil {
+		b.metrics.DecBucketOpenCursorsByStrategy(b.strategy)
+		return failedCursorReplace(err)
+	}
 
 	// we hold a flush-lock during initialzation, but we release it before
 	// returning to the caller. However, `*memtable.newCursor` creates a deep
@@ -137,7 +143,10 @@ func (b *Bucket) CursorInMem() *CursorReplace {
 func (b *Bucket) CursorOnDisk() *CursorReplace {
 	MustBeExpectedStrategy(b.strategy, StrategyReplace)
 
-	innerCursors, unlockSegmentGroup := b.disk.newCursors()
+	innerCursors, unlockSegmentGroup, err := b.disk.newCursors()
+	if err != nil {
+		return failedCursorReplace(err)
+	}
 
 	return &CursorReplace{
 		innerCursors: innerCursors,
@@ -161,7 +170,11 @@ func (b *Bucket) CursorWithSecondaryIndex(pos int) *CursorReplace {
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
-	innerCursors, unlockSegmentGroup := b.disk.newCursorsWithSecondaryIndex(pos)
+	innerCursors, unlockSegmentGroup, err := b.disk.newCursorsWithSecondaryIndex(pos)
+	if err != nil {
+		b.metrics.DecBucketOpenCursorsByStrategy(b.strategy)
+		return failedCursorReplace(err)
+	}
 
 	// we have a flush-RLock, so we have the guarantee that the flushing state
 	// will not change for the lifetime of the cursor, thus there can only be two
@@ -188,6 +201,17 @@ func (c *CursorReplace) Close() {
 	c.unlock()
 }
 
+// Err returns the error if the cursor could not be created, e.g. as a segment
+// in cold storage could not be fetched. The cursor does not return any keys
+// then.
+func (c *CursorReplace) Err() error {
+	return c.err
+}
+
+func failedCursorReplace(err error) *CursorReplace {
+	return &CursorReplace{err: err, unlock: func() {}}
+}
+
 func (c *CursorReplace) seekAll(target []byte) {
 	state := make([]cursorStateReplace, len(c.innerCursors))
 	for i, cur := range c.innerCursors {
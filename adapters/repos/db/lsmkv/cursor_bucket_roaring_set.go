@@ -23,11 +23,15 @@ type CursorRoaringSet interface {
 	Next() ([]byte, *sroar.Bitmap)
 	Seek([]byte) ([]byte, *sroar.Bitmap)
 	Close()
+	// Err returns the error if the cursor could not be created, see
+	// [CursorReplace.Err]
+	Err() error
 }
 
 type cursorRoaringSet struct {
 	combinedCursor *roaringset.CombinedCursor
 	unlock         func()
+	err            error
 }
 
 func (c *cursorRoaringSet) First() ([]byte, *sroar.Bitmap) {
@@ -46,6 +50,10 @@ func (c *cursorRoaringSet) Close() {
 	c.unlock()
 }
 
+func (c *cursorRoaringSet) Err() error {
+	return c.err
+}
+
 // CursorRoaringSet behaves like [Cursor], but for the RoaringSet strategy. It
 // needs to be closed using .Close() to free references to the underlying
 // segments.
@@ -70,7 +78,15 @@ func (b *Bucket) cursorRoaringSet(keyOnly bool) CursorRoaringSet {
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
-	innerCursors, unlockSegmentGroup := b.disk.newRoaringSetCursors()
+	innerCursors, unlockSegmentGroup, err := b.disk.newRoaringSetCursors()
+	if err != nil {
+		b.metrics.DecBucketOpenCursorsByStrategy(b.strategy)
+		return &cursorRoaringSet{
+			combinedCursor: roaringset.NewCombinedCursor(nil, keyOnly),
+			unlock:         func() {},
+			err:            err,
+		}
+	}
 
 	// we hold a flush-lock during initialzation, but we release it before
 	// returning to the caller. However, `*memtable.newCursor` creates a deep
@@ -24,6 +24,8 @@ type CursorSet struct {
 	state        []cursorStateCollection
 	unlock       func()
 	keyOnly      bool
+	// set if the cursor could not be created, see Err
+	err error
 }
 
 type innerCursorCollection interface {
@@ -46,7 +48,10 @@ func (b *Bucket) SetCursor() *CursorSet {
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
-	innerCursors, unlockSegmentGroup := b.disk.newCollectionCursors()
+	innerCursors, unlockSegmentGroup, err := b.disk.newCollectionCursors()
+	if err != nil {
+		return &CursorSet{err: err, unlock: func() {}}
+	}
 
 	// we hold a flush-lock during initialzation, but we release it before
 	// returning to the caller. However, `*memtable.newCollectionCursor` creates
@@ -98,6 +103,12 @@ func (c *CursorSet) Close() {
 	c.unlock()
 }
 
+// Err returns the error if the cursor could not be created, see
+// [CursorReplace.Err]
+func (c *CursorSet) Err() error {
+	return c.err
+}
+
 func (c *CursorSet) seekAll(target []byte) {
 	state := make([]cursorStateCollection, len(c.innerCursors))
 	for i, cur := range c.innerCursors {
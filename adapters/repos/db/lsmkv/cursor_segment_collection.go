@@ -26,8 +26,12 @@ func (s *segment) newCollectionCursor() innerCursorCollection {
 	}
 }
 
-func (sg *SegmentGroup) newCollectionCursors() ([]innerCursorCollection, func()) {
+func (sg *SegmentGroup) newCollectionCursors() ([]innerCursorCollection, func(), error) {
 	segments, release := sg.getConsistentViewOfSegments()
+	if err := loadSegments(segments); err != nil {
+		release()
+		return nil, nil, err
+	}
 
 	out := make([]innerCursorCollection, len(segments))
 
@@ -35,7 +39,7 @@ func (sg *SegmentGroup) newCollectionCursors() ([]innerCursorCollection, func())
 		out[i] = segment.newCollectionCursor()
 	}
 
-	return out, release
+	return out, release, nil
 }
 
 func (s *segmentCursorCollection) seek(key []byte) ([]byte, []value, error) {
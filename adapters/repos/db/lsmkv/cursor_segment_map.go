@@ -30,8 +30,12 @@ func (s *segment) newMapCursor() innerCursorMap {
 	}
 }
 
-func (sg *SegmentGroup) newMapCursors() ([]innerCursorMap, func()) {
+func (sg *SegmentGroup) newMapCursors() ([]innerCursorMap, func(), error) {
 	segments, release := sg.getConsistentViewOfSegments()
+	if err := loadSegments(segments); err != nil {
+		release()
+		return nil, nil, err
+	}
 
 	out := make([]innerCursorMap, len(segments))
 
@@ -43,7 +47,7 @@ func (sg *SegmentGroup) newMapCursors() ([]innerCursorMap, func()) {
 		}
 	}
 
-	return out, release
+	return out, release, nil
 }
 
 func (s *segmentCursorMap) decode(parsed segmentCollectionNode) ([]MapPair, error) {
@@ -12,6 +12,7 @@
 package lsmkv
 
 import (
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 	"github.com/weaviate/weaviate/entities/lsmkv"
 	"github.com/weaviate/weaviate/usecases/byteops"
 )
@@ -25,6 +26,9 @@ type segmentCursorReplace struct {
 	currOffset    uint64
 	reusableNode  *segmentReplaceNode
 	reusableBORW  byteops.ReadWriter
+	// holds the decompressed value of the current node, the raw value stays in
+	// reusableNode so that neither buffer overwrites the other
+	decompressed []byte
 }
 
 func (s *segment) newCursor() innerCursorReplaceAllKeys {
@@ -86,19 +90,27 @@ func (s *segment) newCursorWithSecondaryIndex(pos int) *segmentCursorReplace {
 	}
 }
 
-func (sg *SegmentGroup) newCursors() ([]innerCursorReplace, func()) {
+func (sg *SegmentGroup) newCursors() ([]innerCursorReplace, func(), error) {
 	segments, release := sg.getConsistentViewOfSegments()
+	if err := loadSegments(segments); err != nil {
+		release()
+		return nil, nil, err
+	}
 
 	out := make([]innerCursorReplace, len(segments))
 	for i, segment := range segments {
 		out[i] = segment.newCursor()
 	}
 
-	return out, release
+	return out, release, nil
 }
 
-func (sg *SegmentGroup) newCursorsWithSecondaryIndex(pos int) ([]innerCursorReplace, func()) {
+func (sg *SegmentGroup) newCursorsWithSecondaryIndex(pos int) ([]innerCursorReplace, func(), error) {
 	segments, release := sg.getConsistentViewOfSegments()
+	if err := loadSegments(segments); err != nil {
+		release()
+		return nil, nil, err
+	}
 
 	out := make([]innerCursorReplace, 0, len(segments))
 	for _, segment := range segments {
@@ -107,7 +119,7 @@ func (sg *SegmentGroup) newCursorsWithSecondaryIndex(pos int) ([]innerCursorRepl
 		}
 	}
 
-	return out, release
+	return out, release, nil
 }
 
 func (s *segmentCursorReplace) seek(key []byte) ([]byte, []byte, error) {
@@ -124,7 +136,7 @@ func (s *segmentCursorReplace) seek(key []byte) ([]byte, []byte, error) {
 		return s.keyFn(s.reusableNode), nil, err
 	}
 
-	return s.keyFn(s.reusableNode), s.reusableNode.value, nil
+	return s.current()
 }
 
 func (s *segmentCursorReplace) next() ([]byte, []byte, error) {
@@ -145,7 +157,7 @@ func (s *segmentCursorReplace) next() ([]byte, []byte, error) {
 		return s.keyFn(s.reusableNode), nil, err
 	}
 
-	return s.keyFn(s.reusableNode), s.reusableNode.value, nil
+	return s.current()
 }
 
 func (s *segmentCursorReplace) first() ([]byte, []byte, error) {
@@ -162,7 +174,20 @@ func (s *segmentCursorReplace) first() ([]byte, []byte, error) {
 		return s.keyFn(s.reusableNode), nil, err
 	}
 
-	return s.keyFn(s.reusableNode), s.reusableNode.value, nil
+	return s.current()
+}
+
+func (s *segmentCursorReplace) current() ([]byte, []byte, error) {
+	if s.segment.compression == segmentindex.CompressionNone {
+		return s.keyFn(s.reusableNode), s.reusableNode.value, nil
+	}
+
+	value, err := decompressValue(s.segment.compression, s.segment.zstdDict, s.decompressed, s.reusableNode.value)
+	if err != nil {
+		return s.keyFn(s.reusableNode), nil, err
+	}
+	s.decompressed = value
+	return s.keyFn(s.reusableNode), value, nil
 }
 
 func (s *segmentCursorReplace) nextWithAllKeys() (n segmentReplaceNode, err error) {
@@ -210,6 +235,13 @@ func (s *segmentCursorReplace) parseReplaceNode(offset nodeOffset) (segmentRepla
 	if out.tombstone {
 		return out, lsmkv.Deleted
 	}
+	if err != nil {
+		return out, err
+	}
+
+	// the nodes are handed to compactions, which may write them with another
+	// compression, so they always hold the decompressed value
+	out.value, err = decompressValue(s.segment.compression, s.segment.zstdDict, nil, out.value)
 	return out, err
 }
 
@@ -12,17 +12,30 @@
 package lsmkv
 
 import (
+	"io"
+
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 	"github.com/weaviate/weaviate/adapters/repos/db/roaringset"
 )
 
 func (s *segment) newRoaringSetCursor() roaringset.SegmentCursor {
+	if s.encrypted {
+		size := s.dataEndPos - s.dataStartPos
+		return roaringset.NewSegmentCursorPread(
+			io.NewSectionReader(s.contentFile, int64(s.dataStartPos), int64(size)),
+			size, &roaringSetSeeker{s.index})
+	}
+
 	return roaringset.NewSegmentCursor(s.contents[s.dataStartPos:s.dataEndPos],
 		&roaringSetSeeker{s.index})
 }
 
-func (sg *SegmentGroup) newRoaringSetCursors() ([]roaringset.InnerCursor, func()) {
+func (sg *SegmentGroup) newRoaringSetCursors() ([]roaringset.InnerCursor, func(), error) {
 	segments, release := sg.getConsistentViewOfSegments()
+	if err := loadSegments(segments); err != nil {
+		release()
+		return nil, nil, err
+	}
 
 	out := make([]roaringset.InnerCursor, len(segments))
 
@@ -30,7 +43,7 @@ func (sg *SegmentGroup) newRoaringSetCursors() ([]roaringset.InnerCursor, func()
 		out[i] = segment.newRoaringSetCursor()
 	}
 
-	return out, release
+	return out, release, nil
 }
 
 // diskIndex returns node's Start and End offsets
@@ -0,0 +1,329 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/roaringset"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/usecases/encryption"
+)
+
+func newTestEncryptor(t *testing.T, currentKeyID string, keys map[string][]byte) *encryption.Encryptor {
+	encoded := map[string]string{}
+	for id, key := range keys {
+		encoded[id] = base64.StdEncoding.EncodeToString(key)
+	}
+	raw, err := json.Marshal(map[string]any{"currentKeyId": currentKeyID, "keys": encoded})
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "keyfile.json")
+	require.NoError(t, os.WriteFile(path, raw, 0o600))
+
+	provider, err := encryption.NewLocalKeyProvider(path)
+	require.NoError(t, err)
+	return encryption.New(provider)
+}
+
+func TestBucketEncryption(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	k1, k2 := make([]byte, 32), make([]byte, 32)
+	_, err := rand.Read(k1)
+	require.NoError(t, err)
+	_, err = rand.Read(k2)
+	require.NoError(t, err)
+	before := newTestEncryptor(t, "k1", map[string][]byte{"k1": k1})
+	after := newTestEncryptor(t, "k2", map[string][]byte{"k1": k1, "k2": k2})
+
+	key := func(i int) []byte { return []byte(fmt.Sprintf("key-%03d", i)) }
+	value := func(i int) []byte { return []byte(strings.Repeat(fmt.Sprintf("secret value %d ", i), 10)) }
+
+	open := func(dir string, enc *encryption.Encryptor) (*Bucket, error) {
+		b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(StrategyReplace),
+			WithSegmentsChecksumValidationEnabled(true),
+			WithEncryption(enc))
+		if err != nil {
+			return nil, err
+		}
+		b.SetMemtableThreshold(1e9)
+		return b, nil
+	}
+
+	verify := func(t *testing.T, b *Bucket, count int) {
+		for i := 0; i < count; i++ {
+			v, err := b.Get(key(i))
+			require.NoError(t, err)
+			assert.Equal(t, value(i), v, "key %d", i)
+		}
+
+		c := b.Cursor()
+		defer c.Close()
+		n := 0
+		for k, _ := c.First(); k != nil; k, _ = c.Next() {
+			n++
+		}
+		assert.Equal(t, count, n)
+	}
+
+	filesWithExt := func(t *testing.T, dir, ext string) []string {
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		var names []string
+		for _, entry := range entries {
+			if filepath.Ext(entry.Name()) == ext {
+				names = append(names, filepath.Join(dir, entry.Name()))
+			}
+		}
+		return names
+	}
+
+	keyIDs := func(t *testing.T, dir, ext string) []string {
+		var ids []string
+		for _, name := range filesWithExt(t, dir, ext) {
+			keyID, _, err := encryption.FileKeyID(name)
+			require.NoError(t, err)
+			ids = append(ids, keyID)
+		}
+		return ids
+	}
+
+	dir := t.TempDir()
+	crashedDir := t.TempDir()
+
+	t.Run("plaintext segment written before encryption was enabled", func(t *testing.T) {
+		b, err := open(dir, nil)
+		require.NoError(t, err)
+		for i := 0; i < 50; i++ {
+			require.NoError(t, b.Put(key(i), value(i)))
+		}
+		require.NoError(t, b.Shutdown(ctx))
+		assert.Equal(t, []string{""}, keyIDs(t, dir, ".db"))
+	})
+
+	t.Run("encrypted segment and wal", func(t *testing.T) {
+		b, err := open(dir, before)
+		require.NoError(t, err)
+		for i := 50; i < 100; i++ {
+			require.NoError(t, b.Put(key(i), value(i)))
+		}
+		require.NoError(t, b.FlushAndSwitch())
+		assert.Equal(t, []string{"", "k1"}, keyIDs(t, dir, ".db"))
+		// the bloom filters are derived from the keys, the one of the
+		// plaintext segment is built when it is loaded with the encryption
+		assert.Equal(t, []string{"k1", "k1"}, keyIDs(t, dir, ".bloom"))
+
+		for i := 100; i < 120; i++ {
+			require.NoError(t, b.Put(key(i), value(i)))
+		}
+		require.NoError(t, b.WriteWAL())
+		assert.Equal(t, []string{"k1"}, keyIDs(t, dir, ".wal"))
+		verify(t, b, 120)
+
+		for _, name := range append(filesWithExt(t, dir, ".db"), filesWithExt(t, dir, ".wal")...) {
+			raw, err := os.ReadFile(name)
+			require.NoError(t, err)
+			if encryption.HasHeader(raw) {
+				assert.False(t, bytes.Contains(raw, []byte("secret value")), name)
+			}
+			// simulate a crash by copying the files before the shutdown
+			require.NoError(t, os.WriteFile(filepath.Join(crashedDir, filepath.Base(name)), raw, 0o666))
+		}
+		require.NoError(t, b.Shutdown(ctx))
+	})
+
+	t.Run("keys are required to read encrypted files", func(t *testing.T) {
+		_, err := open(crashedDir, nil)
+		require.ErrorContains(t, err, "encryption is disabled")
+	})
+
+	t.Run("strategy of unloaded bucket", func(t *testing.T) {
+		strategy, err := DetermineUnloadedEncryptedBucketStrategyAmong(crashedDir, prioritizedAllStrategies, after)
+		require.NoError(t, err)
+		assert.Equal(t, StrategyReplace, strategy)
+	})
+
+	t.Run("recover from encrypted wal", func(t *testing.T) {
+		b, err := open(crashedDir, before)
+		require.NoError(t, err)
+		verify(t, b, 120)
+		require.NoError(t, b.Shutdown(ctx))
+
+		// small write-ahead-logs are reused after a shutdown
+		assert.Equal(t, []string{"", "k1"}, keyIDs(t, crashedDir, ".db"))
+		assert.Equal(t, []string{"k1"}, keyIDs(t, crashedDir, ".wal"))
+	})
+
+	t.Run("rewrite with rotated key", func(t *testing.T) {
+		b, err := open(crashedDir, after)
+		require.NoError(t, err)
+		defer b.Shutdown(ctx)
+		verify(t, b, 120)
+
+		for i := 0; i < 2; i++ {
+			rekeyed, err := b.disk.rekeyOnce()
+			require.NoError(t, err)
+			assert.True(t, rekeyed)
+			verify(t, b, 120)
+		}
+		rekeyed, err := b.disk.rekeyOnce()
+		require.NoError(t, err)
+		assert.False(t, rekeyed)
+		assert.Equal(t, []string{"k2", "k2"}, keyIDs(t, crashedDir, ".db"))
+		assert.Equal(t, []string{"k2", "k2"}, keyIDs(t, crashedDir, ".bloom"))
+
+		// the reused write-ahead-log is rewritten when it is flushed
+		require.NoError(t, b.FlushAndSwitch())
+		assert.Equal(t, []string{"k2", "k2", "k2"}, keyIDs(t, crashedDir, ".db"))
+		verify(t, b, 120)
+
+		var compacted bool
+		for compacted, err = b.disk.compactOnce(); err == nil && compacted; compacted, err = b.disk.compactOnce() {
+		}
+		require.NoError(t, err)
+		ids := keyIDs(t, crashedDir, ".db")
+		assert.Less(t, len(ids), 3)
+		for _, id := range ids {
+			assert.Equal(t, "k2", id)
+		}
+		verify(t, b, 120)
+	})
+}
+
+func TestBucketEncryptionReadsDataRegionWithPread(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	k1 := make([]byte, 32)
+	_, err := rand.Read(k1)
+	require.NoError(t, err)
+	enc := newTestEncryptor(t, "k1", map[string][]byte{"k1": k1})
+
+	open := func(t *testing.T, dir string, strategy string) *Bucket {
+		b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(strategy),
+			WithCalcCountNetAdditions(strategy == StrategyReplace),
+			WithBitmapBufPool(roaringset.NewBitmapBufPoolNoop()),
+			WithSegmentsChecksumValidationEnabled(true),
+			WithEncryption(enc))
+		require.NoError(t, err)
+		b.SetMemtableThreshold(1e9)
+		return b
+	}
+
+	assertPread := func(t *testing.T, b *Bucket) {
+		segments, release := b.disk.getConsistentViewOfSegments()
+		defer release()
+		require.NotEmpty(t, segments)
+		for _, s := range segments {
+			seg, ok := s.(*segment)
+			if !ok {
+				lazy := s.(*lazySegment)
+				require.NoError(t, lazy.load())
+				seg = lazy.segment
+			}
+			assert.True(t, seg.encrypted, seg.path)
+			assert.False(t, seg.readFromMemory, seg.path)
+		}
+	}
+
+	// flushes three segments, compacts them and reopens the bucket
+	run := func(t *testing.T, strategy string, write func(b *Bucket, i int), verify func(t *testing.T, b *Bucket)) {
+		dir := t.TempDir()
+		b := open(t, dir, strategy)
+		for i := 0; i < 3; i++ {
+			write(b, i)
+			require.NoError(t, b.FlushAndSwitch())
+		}
+		assertPread(t, b)
+		verify(t, b)
+
+		var compacted bool
+		for compacted, err = b.disk.compactOnce(); err == nil && compacted; compacted, err = b.disk.compactOnce() {
+		}
+		require.NoError(t, err)
+		verify(t, b)
+		require.NoError(t, b.Shutdown(ctx))
+
+		b = open(t, dir, strategy)
+		defer b.Shutdown(ctx)
+		assertPread(t, b)
+		verify(t, b)
+	}
+
+	t.Run("replace with net additions", func(t *testing.T) {
+		run(t, StrategyReplace, func(b *Bucket, i int) {
+			for j := 0; j < 20; j++ {
+				require.NoError(t, b.Put([]byte(fmt.Sprintf("key-%d-%d", i, j)), []byte("value")))
+			}
+			if i > 0 {
+				require.NoError(t, b.Delete([]byte(fmt.Sprintf("key-%d-0", i-1))))
+			}
+		}, func(t *testing.T, b *Bucket) {
+			count, err := b.Count(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, 58, count)
+		})
+	})
+
+	t.Run("roaring set", func(t *testing.T) {
+		run(t, StrategyRoaringSet, func(b *Bucket, i int) {
+			for j := 0; j < 20; j++ {
+				require.NoError(t, b.RoaringSetAddList([]byte(fmt.Sprintf("key-%02d", j)), []uint64{uint64(i), uint64(10 + j)}))
+			}
+		}, func(t *testing.T, b *Bucket) {
+			bm, release, err := b.RoaringSetGet([]byte("key-07"))
+			require.NoError(t, err)
+			assert.ElementsMatch(t, []uint64{0, 1, 2, 17}, bm.ToArray())
+			release()
+
+			c := b.CursorRoaringSet()
+			defer c.Close()
+			n := 0
+			for k, bm := c.First(); k != nil; k, bm = c.Next() {
+				assert.Equal(t, 4, bm.GetCardinality(), string(k))
+				n++
+			}
+			assert.Equal(t, 20, n)
+		})
+	})
+
+	t.Run("inverted", func(t *testing.T) {
+		run(t, StrategyInverted, func(b *Bucket, i int) {
+			for docID := uint64(0); docID < 10; docID++ {
+				require.NoError(t, b.MapSet([]byte("term"), NewMapPairFromDocIdAndTf(uint64(i)*10+docID, 1, 3, false)))
+			}
+		}, func(t *testing.T, b *Bucket) {
+			pairs, err := b.MapList(ctx, []byte("term"))
+			require.NoError(t, err)
+			assert.Len(t, pairs, 30)
+		})
+	})
+}
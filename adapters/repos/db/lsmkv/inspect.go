@@ -0,0 +1,436 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/bits-and-blooms/bloom/v3"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/adapters/repos/db/roaringset"
+	"github.com/weaviate/weaviate/entities/lsmkv"
+	"github.com/weaviate/weaviate/usecases/encryption"
+)
+
+// The functions in this file open segments and write-ahead-logs of buckets
+// which are not loaded, e.g. for offline inspection by weaviate-tools. They
+// never write to the bucket directory, derived files such as bloom filters
+// are neither built nor repaired. Only QuarantineSegment moves files.
+//
+// Segments are opened the same way a bucket opens them on startup, with the
+// checksum validation enabled. Write-ahead-logs are replayed into a memtable
+// which is never flushed, so a log has to fit into memory. The files are not
+// locked, a bucket must not be loaded from them at the same time.
+
+// ErrNoKeyProvider is returned for encrypted files if no encryptor with a key
+// provider is given, the files can't be read without their keys
+var ErrNoKeyProvider = errors.New("file is encrypted, but no key provider is configured")
+
+// SegmentInfo describes a disk segment as read from its header
+type SegmentInfo struct {
+	Path             string
+	Strategy         string
+	Version          uint16
+	Level            uint16
+	SecondaryIndices uint16
+	Compression      string
+	Size             int64
+	Encrypted        bool
+	// segments of version 1 and later contain a checksum, which is validated
+	// when they are opened
+	ChecksumValidated bool
+}
+
+// DumpEntry is a single key of a segment or write-ahead-log with its values.
+// Which fields are set depends on the strategy.
+type DumpEntry struct {
+	Key []byte
+
+	// replace
+	Value         []byte
+	SecondaryKeys [][]byte
+	Tombstone     bool
+
+	// set, map and inverted, the values of sets have no keys
+	Pairs []MapPair
+
+	// roaringset and roaringsetrange, the keys of roaringsetrange are the bit
+	// positions of the layers
+	Additions []uint64
+	Deletions []uint64
+}
+
+// InspectedSegment is a disk segment opened read-only with
+// [OpenSegmentForInspection]
+type InspectedSegment struct {
+	seg  *segment
+	info SegmentInfo
+}
+
+// OpenSegmentForInspection opens a single disk segment. The checksum of
+// segments of version 1 and later is validated, a mismatch is returned as
+// error. Encrypted segments require enc, ErrNoKeyProvider is returned
+// otherwise.
+func OpenSegmentForInspection(path string, enc *encryption.Encryptor, logger logrus.FieldLogger,
+) (*InspectedSegment, error) {
+	_, encrypted, err := encryption.FileKeyID(path)
+	if err != nil {
+		return nil, fmt.Errorf("read encryption header: %w", err)
+	}
+	if encrypted && !enc.Enabled() {
+		return nil, fmt.Errorf("segment %q: %w", path, ErrNoKeyProvider)
+	}
+
+	compression := &compressionSetting{}
+	if err := compression.loadZstdDictionary(filepath.Dir(path), enc); err != nil {
+		return nil, err
+	}
+
+	seg, err := newSegment(path, logger, nil, nil, segmentConfig{
+		enableChecksumValidation: true,
+		encryption:               enc,
+		zstdDict:                 compression.zstdDictionary(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &InspectedSegment{
+		seg: seg,
+		info: SegmentInfo{
+			Path:              path,
+			Strategy:          seg.strategy.String(),
+			Version:           seg.version,
+			Level:             seg.level,
+			SecondaryIndices:  seg.secondaryIndexCount,
+			Compression:       seg.compression.String(),
+			Size:              seg.size,
+			Encrypted:         encrypted,
+			ChecksumValidated: seg.version >= segmentindex.SegmentV1,
+		},
+	}, nil
+}
+
+func (s *InspectedSegment) Info() SegmentInfo {
+	return s.info
+}
+
+func (s *InspectedSegment) Close() error {
+	return s.seg.close()
+}
+
+// VerifyBloomFilters checks that the bloom filters persisted next to the
+// segment, either in its .metadata file or in .bloom files, are intact and
+// contain all keys of the segment. found is false if the segment has no
+// persisted bloom filters, they are then built when the segment is loaded.
+func (s *InspectedSegment) VerifyBloomFilters() (found bool, err error) {
+	seg := s.seg
+
+	primary, secondary, found, err := seg.readBloomFilters()
+	if err != nil || !found {
+		return found, err
+	}
+
+	if err := verifyBloomFilter(primary, seg.index); err != nil {
+		return true, fmt.Errorf("primary bloom filter: %w", err)
+	}
+	for i, bf := range secondary {
+		if err := verifyBloomFilter(bf, seg.secondaryIndices[i]); err != nil {
+			return true, fmt.Errorf("bloom filter of secondary index %d: %w", i, err)
+		}
+	}
+	return true, nil
+}
+
+func verifyBloomFilter(bf *bloom.BloomFilter, index diskIndex) error {
+	keys, err := index.AllKeys()
+	if err != nil {
+		return fmt.Errorf("read keys: %w", err)
+	}
+
+	missing := 0
+	for _, key := range keys {
+		if !bf.Test(key) {
+			missing++
+		}
+	}
+	if missing > 0 {
+		return fmt.Errorf("%d of %d keys are missing", missing, len(keys))
+	}
+	return nil
+}
+
+// Dump calls fn for every key of the segment in order. Tombstones are
+// included.
+func (s *InspectedSegment) Dump(fn func(DumpEntry) error) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("read segment %q: %v", s.info.Path, p)
+		}
+	}()
+
+	switch s.seg.strategy {
+	case segmentindex.StrategyReplace:
+		return s.dumpReplace(fn)
+	case segmentindex.StrategySetCollection:
+		return dumpCollection(s.seg.newCollectionCursor(), fn)
+	case segmentindex.StrategyMapCollection:
+		return dumpMap(s.seg.newMapCursor(), fn)
+	case segmentindex.StrategyInverted:
+		c := s.seg.newInvertedCursorReusable()
+		if c == nil {
+			return fmt.Errorf("read property lengths of segment %q", s.info.Path)
+		}
+		return dumpMap(c, fn)
+	case segmentindex.StrategyRoaringSet:
+		return dumpRoaringSet(s.seg.newRoaringSetCursor(), fn)
+	case segmentindex.StrategyRoaringSetRange:
+		c := s.seg.newRoaringSetRangeCursor()
+		for bit, layer, ok := c.First(); ok; bit, layer, ok = c.Next() {
+			if err := fn(roaringSetDumpEntry([]byte{bit}, layer)); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported strategy %s", s.seg.strategy)
+	}
+}
+
+func (s *InspectedSegment) dumpReplace(fn func(DumpEntry) error) error {
+	c := s.seg.newCursor()
+	for n, err := c.firstWithAllKeys(); !errors.Is(err, lsmkv.NotFound); n, err = c.nextWithAllKeys() {
+		if err != nil && !errors.Is(err, lsmkv.Deleted) {
+			return err
+		}
+		if err := fn(DumpEntry{
+			Key:           n.primaryKey,
+			Value:         n.value,
+			SecondaryKeys: n.secondaryKeys,
+			Tombstone:     n.tombstone,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func dumpCollection(c innerCursorCollection, fn func(DumpEntry) error) error {
+	for key, values, err := c.first(); !errors.Is(err, lsmkv.NotFound); key, values, err = c.next() {
+		if err != nil && !errors.Is(err, lsmkv.Deleted) {
+			return err
+		}
+		pairs := make([]MapPair, len(values))
+		for i, v := range values {
+			pairs[i] = MapPair{Value: v.value, Tombstone: v.tombstone}
+		}
+		if err := fn(DumpEntry{Key: key, Pairs: pairs}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func dumpMap(c innerCursorMap, fn func(DumpEntry) error) error {
+	for key, pairs, err := c.first(); !errors.Is(err, lsmkv.NotFound); key, pairs, err = c.next() {
+		if err != nil && !errors.Is(err, lsmkv.Deleted) {
+			return err
+		}
+		if err := fn(DumpEntry{Key: key, Pairs: pairs}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func dumpRoaringSet(c roaringset.InnerCursor, fn func(DumpEntry) error) error {
+	for key, layer, err := c.First(); key != nil || err != nil; key, layer, err = c.Next() {
+		if err != nil {
+			return err
+		}
+		if err := fn(roaringSetDumpEntry(key, layer)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func roaringSetDumpEntry(key []byte, layer roaringset.BitmapLayer) DumpEntry {
+	entry := DumpEntry{Key: key}
+	if layer.Additions != nil {
+		entry.Additions = layer.Additions.ToArray()
+	}
+	if layer.Deletions != nil {
+		entry.Deletions = layer.Deletions.ToArray()
+	}
+	return entry
+}
+
+// ReplayedWAL is a write-ahead-log replayed into a memtable with [ReplayWAL]
+type ReplayedWAL struct {
+	Path     string
+	Strategy string
+	// size of the log in bytes, excluding the encryption header
+	Size int64
+	// Corruption is the reason the log could not be replayed completely, e.g.
+	// an entry with an invalid checksum or an incomplete last entry. All
+	// entries before are replayed.
+	Corruption error
+
+	memtable *Memtable
+}
+
+// ReplayWAL replays a write-ahead-log into a memtable the same way a bucket
+// recovers from it on startup. The strategy is guessed from the entries of
+// the log if it is empty. The secondary indices of replace buckets are not
+// contained in the log, their number has to be taken from the segments of the
+// bucket.
+func ReplayWAL(path, strategy string, secondaryIndices uint16, enc *encryption.Encryptor,
+	logger logrus.FieldLogger,
+) (*ReplayedWAL, error) {
+	_, encrypted, err := encryption.FileKeyID(path)
+	if err != nil {
+		return nil, fmt.Errorf("read encryption header: %w", err)
+	}
+	if encrypted && !enc.Enabled() {
+		return nil, fmt.Errorf("write-ahead-log %q: %w", path, ErrNoKeyProvider)
+	}
+
+	f, err := enc.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	if strategy == "" {
+		if strategy, err = guessCommitLogStrategy(f); err != nil {
+			return nil, fmt.Errorf("guess strategy of write-ahead-log %q: %w", path, err)
+		}
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+	}
+
+	mt, err := newMemtable(path, strategy, secondaryIndices, &noopMemtableCommitLogger{},
+		nil, logger, false, nil, false, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReplayedWAL{
+		Path:       path,
+		Strategy:   strategy,
+		Size:       info.Size(),
+		Corruption: newCommitLoggerParser(strategy, bufio.NewReaderSize(f, 32*1024), mt).Do(),
+		memtable:   mt,
+	}, nil
+}
+
+// Dump calls fn for every key replayed from the log in order. Tombstones are
+// included.
+func (w *ReplayedWAL) Dump(fn func(DumpEntry) error) error {
+	m := w.memtable
+	switch w.Strategy {
+	case StrategyReplace:
+		m.RLock()
+		nodes := m.key.flattenInOrder()
+		m.RUnlock()
+		for _, n := range nodes {
+			if err := fn(DumpEntry{
+				Key:           n.key,
+				Value:         n.value,
+				SecondaryKeys: n.secondaryKeys,
+				Tombstone:     n.tombstone,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	case StrategySetCollection:
+		return dumpCollection(m.newCollectionCursor(), fn)
+	case StrategyMapCollection, StrategyInverted:
+		return dumpMap(m.newMapCursor(), fn)
+	case StrategyRoaringSet:
+		return dumpRoaringSet(m.newRoaringSetCursor(), fn)
+	case StrategyRoaringSetRange:
+		for _, n := range m.extractRoaringSetRange().Nodes() {
+			if err := fn(roaringSetDumpEntry([]byte{n.Key}, roaringset.BitmapLayer{
+				Additions: n.Additions,
+				Deletions: n.Deletions,
+			})); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported strategy %s", w.Strategy)
+	}
+}
+
+// QuarantineSegment moves a segment together with its bloom filters, count
+// net additions and metadata files into dir, so that the bucket is loaded
+// without it. It returns the new paths of the moved files. The bucket must
+// not be loaded.
+//
+// The keys and tombstones of the segment are no longer part of the bucket,
+// older values of its keys in other segments become visible again. Moving
+// the files back restores the bucket. Existing files in dir are never
+// overwritten.
+func QuarantineSegment(path, dir string) ([]string, error) {
+	if filepath.Ext(path) != ".db" {
+		return nil, fmt.Errorf("%q is not a segment", path)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create quarantine dir: %w", err)
+	}
+
+	seg := &segment{path: path}
+	paths := []string{path, seg.bloomFilterPath(), seg.countNetPath(), seg.metadataPath()}
+	secondary, err := filepath.Glob(seg.buildPath("%s.secondary.*.bloom"))
+	if err != nil {
+		return nil, err
+	}
+	paths = append(paths, secondary...)
+
+	var moved []string
+	for _, p := range paths {
+		if ok, err := fileExists(p); err != nil {
+			return moved, err
+		} else if !ok {
+			continue
+		}
+
+		target := filepath.Join(dir, filepath.Base(p))
+		if ok, err := fileExists(target); err != nil {
+			return moved, err
+		} else if ok {
+			return moved, fmt.Errorf("%q already exists", target)
+		}
+		if err := os.Rename(p, target); err != nil {
+			return moved, fmt.Errorf("move %q: %w", p, err)
+		}
+		moved = append(moved, target)
+	}
+	return moved, nil
+}
@@ -0,0 +1,219 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/roaringset"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestInspectSegment(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategyReplace), WithSecondaryIndices(1), WithUseBloomFilter(true),
+		WithSegmentsChecksumValidationEnabled(true))
+	require.NoError(t, err)
+
+	require.NoError(t, b.Put([]byte("a"), []byte("value a"), WithSecondaryKey(0, []byte("1"))))
+	require.NoError(t, b.Put([]byte("b"), []byte("value b"), WithSecondaryKey(0, []byte("2"))))
+	require.NoError(t, b.Delete([]byte("b"), WithSecondaryKey(0, []byte("2"))))
+	require.NoError(t, b.FlushAndSwitch())
+	require.NoError(t, b.Shutdown(ctx))
+
+	segments, err := filepath.Glob(filepath.Join(dir, "*.db"))
+	require.NoError(t, err)
+	require.Len(t, segments, 1)
+	path := segments[0]
+
+	t.Run("info", func(t *testing.T) {
+		seg, err := OpenSegmentForInspection(path, nil, logger)
+		require.NoError(t, err)
+		defer seg.Close()
+
+		info := seg.Info()
+		assert.Equal(t, StrategyReplace, info.Strategy)
+		assert.Equal(t, uint16(1), info.SecondaryIndices)
+		assert.True(t, info.ChecksumValidated)
+		assert.False(t, info.Encrypted)
+	})
+
+	t.Run("dump", func(t *testing.T) {
+		seg, err := OpenSegmentForInspection(path, nil, logger)
+		require.NoError(t, err)
+		defer seg.Close()
+
+		var entries []DumpEntry
+		require.NoError(t, seg.Dump(func(e DumpEntry) error {
+			entries = append(entries, e)
+			return nil
+		}))
+		require.Len(t, entries, 2)
+		assert.Equal(t, []byte("a"), entries[0].Key)
+		assert.Equal(t, []byte("value a"), entries[0].Value)
+		assert.Equal(t, [][]byte{[]byte("1")}, entries[0].SecondaryKeys)
+		assert.False(t, entries[0].Tombstone)
+		assert.Equal(t, []byte("b"), entries[1].Key)
+		assert.True(t, entries[1].Tombstone)
+	})
+
+	t.Run("verify bloom filters", func(t *testing.T) {
+		seg, err := OpenSegmentForInspection(path, nil, logger)
+		require.NoError(t, err)
+		defer seg.Close()
+
+		found, err := seg.VerifyBloomFilters()
+		require.NoError(t, err)
+		assert.True(t, found)
+
+		corruptFile(t, seg.seg.bloomFilterPath())
+		found, err = seg.VerifyBloomFilters()
+		assert.ErrorIs(t, err, ErrInvalidChecksum)
+		assert.True(t, found)
+	})
+
+	t.Run("corrupt segment", func(t *testing.T) {
+		corruptFile(t, path)
+		_, err := OpenSegmentForInspection(path, nil, logger)
+		assert.Error(t, err)
+	})
+
+	t.Run("quarantine", func(t *testing.T) {
+		quarantine := filepath.Join(t.TempDir(), "quarantine")
+		moved, err := QuarantineSegment(path, quarantine)
+		require.NoError(t, err)
+		// segment, primary and secondary bloom filter
+		assert.Len(t, moved, 3)
+
+		remaining, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Empty(t, remaining)
+	})
+}
+
+func TestReplayWAL(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategyRoaringSet), WithBitmapBufPool(roaringset.NewBitmapBufPoolNoop()))
+	require.NoError(t, err)
+	defer b.Shutdown(ctx)
+
+	require.NoError(t, b.RoaringSetAddList([]byte("a"), []uint64{1, 2}))
+	require.NoError(t, b.RoaringSetAddList([]byte("b"), []uint64{3}))
+	require.NoError(t, b.RoaringSetRemoveOne([]byte("a"), 2))
+	require.NoError(t, b.WriteWAL())
+
+	// copy the log, the bucket keeps writing to it
+	data, err := os.ReadFile(b.active.commitlogWalPath())
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "segment-1.wal")
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+
+	dump := func(t *testing.T, wal *ReplayedWAL) map[string]DumpEntry {
+		entries := map[string]DumpEntry{}
+		require.NoError(t, wal.Dump(func(e DumpEntry) error {
+			entries[string(e.Key)] = e
+			return nil
+		}))
+		return entries
+	}
+
+	t.Run("complete", func(t *testing.T) {
+		wal, err := ReplayWAL(path, "", 0, nil, logger)
+		require.NoError(t, err)
+		require.NoError(t, wal.Corruption)
+		assert.Equal(t, StrategyRoaringSet, wal.Strategy)
+		assert.Equal(t, int64(len(data)), wal.Size)
+
+		entries := dump(t, wal)
+		require.Len(t, entries, 2)
+		assert.Equal(t, []uint64{1}, entries["a"].Additions)
+		assert.Equal(t, []uint64{2}, entries["a"].Deletions)
+		assert.Equal(t, []uint64{3}, entries["b"].Additions)
+	})
+
+	t.Run("incomplete last entry", func(t *testing.T) {
+		require.NoError(t, os.Truncate(path, int64(len(data)-2)))
+
+		wal, err := ReplayWAL(path, StrategyRoaringSet, 0, nil, logger)
+		require.NoError(t, err)
+		assert.Error(t, wal.Corruption)
+
+		entries := dump(t, wal)
+		require.Len(t, entries, 2)
+		assert.Equal(t, []uint64{1, 2}, entries["a"].Additions)
+		assert.Empty(t, entries["a"].Deletions)
+	})
+}
+
+func corruptFile(t *testing.T, path string) {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	data[len(data)/2] ^= 0xff
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+}
+
+func TestInspectEncryptedSegment(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	logger, _ := test.NewNullLogger()
+	enc := newTestEncryptor(t, "key-1", map[string][]byte{"key-1": make([]byte, 32)})
+
+	b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategyReplace), WithEncryption(enc))
+	require.NoError(t, err)
+	require.NoError(t, b.Put([]byte("a"), []byte("value a")))
+	require.NoError(t, b.FlushAndSwitch())
+	require.NoError(t, b.Shutdown(ctx))
+
+	segments, err := filepath.Glob(filepath.Join(dir, "*.db"))
+	require.NoError(t, err)
+	require.Len(t, segments, 1)
+
+	t.Run("without key provider", func(t *testing.T) {
+		_, err := OpenSegmentForInspection(segments[0], nil, logger)
+		assert.ErrorIs(t, err, ErrNoKeyProvider)
+	})
+
+	t.Run("with key provider", func(t *testing.T) {
+		seg, err := OpenSegmentForInspection(segments[0], enc, logger)
+		require.NoError(t, err)
+		defer seg.Close()
+		assert.True(t, seg.Info().Encrypted)
+
+		var keys []string
+		require.NoError(t, seg.Dump(func(e DumpEntry) error {
+			keys = append(keys, string(e.Key))
+			return nil
+		}))
+		assert.Equal(t, []string{"a"}, keys)
+	})
+}
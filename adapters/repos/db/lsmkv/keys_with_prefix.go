@@ -0,0 +1,91 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/lsmkv"
+)
+
+// MapKeysWithPrefix calls fn for the keys of a map or inverted bucket which
+// start with prefix, until it returns false. Only the indexes of the disk
+// segments are read, not the values. This makes it much cheaper than a
+// [CursorMap], but:
+//
+//  1. Keys are visited once per memtable and segment which contains them, in
+//     no specific order across them.
+//  2. Keys whose values were all deleted are visited as well, use
+//     [Bucket.MapList] to tell them apart.
+//  3. The key is only valid during the call of fn.
+func (b *Bucket) MapKeysWithPrefix(ctx context.Context, prefix []byte, fn func(key []byte) bool) error {
+	if b.strategy != StrategyMapCollection && b.strategy != StrategyInverted {
+		return fmt.Errorf("cannot read map keys of bucket with strategy %s", b.strategy)
+	}
+
+	view := b.getConsistentView()
+	defer view.Release()
+
+	if err := loadSegments(view.Disk); err != nil {
+		return err
+	}
+
+	for _, seg := range view.Disk {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		next, err := seg.keysWithPrefix(prefix, fn)
+		if err != nil {
+			return err
+		}
+		if !next {
+			return nil
+		}
+	}
+
+	for _, m := range []memtable{view.Flushing, view.Active} {
+		if m == nil {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		c := m.newMapCursor()
+		k, _, err := c.seek(prefix)
+		for ; err == nil && bytes.HasPrefix(k, prefix); k, _, err = c.next() {
+			if !fn(k) {
+				return nil
+			}
+		}
+		if err != nil && !errors.Is(err, lsmkv.NotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+// keysWithPrefix returns false if fn stopped the iteration
+func (s *segment) keysWithPrefix(prefix []byte, fn func(key []byte) bool) (bool, error) {
+	node, err := s.index.Seek(prefix)
+	for ; err == nil && bytes.HasPrefix(node.Key, prefix); node, err = s.index.Next(node.Key) {
+		if !fn(node.Key) {
+			return false, nil
+		}
+	}
+	if err != nil && !errors.Is(err, lsmkv.NotFound) {
+		return false, err
+	}
+	return true, nil
+}
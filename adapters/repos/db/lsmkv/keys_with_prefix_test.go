@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestMapKeysWithPrefix(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucketCreator().NewBucket(ctx, t.TempDir(), "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategyMapCollection))
+	require.NoError(t, err)
+	defer b.Shutdown(ctx)
+
+	put := func(key string) {
+		require.NoError(t, b.MapSet([]byte(key), MapPair{Key: []byte("k"), Value: []byte("v")}))
+	}
+	put("car")
+	put("cart")
+	put("dog")
+	require.NoError(t, b.FlushAndSwitch())
+	put("cat")
+	put("cart")
+
+	keys := func(prefix string, limit int) []string {
+		var out []string
+		err := b.MapKeysWithPrefix(ctx, []byte(prefix), func(k []byte) bool {
+			out = append(out, string(k))
+			return len(out) < limit
+		})
+		require.NoError(t, err)
+		sort.Strings(out)
+		return out
+	}
+
+	// keys are visited once per segment and memtable
+	assert.Equal(t, []string{"car", "cart", "cart", "cat", "dog"}, keys("", 10))
+	assert.Equal(t, []string{"car", "cart", "cart", "cat"}, keys("ca", 10))
+	assert.Equal(t, []string{"car", "cart"}, keys("car", 2))
+	assert.Empty(t, keys("x", 10))
+
+	replace, err := NewBucketCreator().NewBucket(ctx, t.TempDir(), "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategyReplace))
+	require.NoError(t, err)
+	defer replace.Shutdown(ctx)
+	assert.Error(t, replace.MapKeysWithPrefix(ctx, nil, func([]byte) bool { return true }))
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 	"github.com/weaviate/weaviate/adapters/repos/db/roaringset"
 	"github.com/weaviate/weaviate/adapters/repos/db/roaringsetrange"
+	"github.com/weaviate/weaviate/entities/lsmkv"
 	"github.com/weaviate/weaviate/entities/schema"
 )
 
@@ -49,6 +50,9 @@ type lazySegment struct {
 	segment *segment
 	mux     sync.Mutex
 	loaded  atomic.Bool
+
+	// set if the segment was moved to cold storage, see RemoteMarkerSuffix
+	remote *remoteSegment
 }
 
 func newLazySegment(path string, logger logrus.FieldLogger, metrics *Metrics,
@@ -84,6 +88,9 @@ func newLazySegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 }
 
 func (s *lazySegment) load() error {
+	if s.remote != nil {
+		s.remote.touch()
+	}
 	if s.loaded.Load() {
 		return nil // fast path in case already loaded
 	}
@@ -96,7 +103,20 @@ func (s *lazySegment) load() error {
 	}
 
 	if s.segment == nil {
-		segment, err := newSegment(s.path, s.logger, s.metrics, s.existsLower, s.cfg)
+		cfg := s.cfg
+		if s.remote != nil {
+			if err := s.remote.fetch(s.path); err != nil {
+				return err
+			}
+			fileList, err := derivedFileList(s.path)
+			if err != nil {
+				return fmt.Errorf("list derived files: %w", err)
+			}
+			cfg.fileList = fileList
+			cfg.precomputedCountNetAdditions = &s.remote.marker.CountNetAdditions
+		}
+
+		segment, err := newSegment(s.path, s.logger, s.metrics, s.existsLower, cfg)
 		if err != nil {
 			return err
 		}
@@ -110,12 +130,31 @@ func (s *lazySegment) load() error {
 	return nil
 }
 
+// mustLoad is called by the methods which cannot return an error. Reads,
+// compactions and cleanups load the segments they use with loadSegments
+// first, so that they return the error instead.
 func (s *lazySegment) mustLoad() {
 	if err := s.load(); err != nil {
 		panic(fmt.Errorf("error loading segment %q: %w", s.path, err))
 	}
 }
 
+// loadSegments loads the lazy segments which are not loaded yet, e.g. as they
+// were moved to cold storage. The consistent view the segments are from keeps
+// them from being evicted until it is released.
+func loadSegments(segments []Segment) error {
+	for _, seg := range segments {
+		lazy, ok := seg.(*lazySegment)
+		if !ok {
+			continue
+		}
+		if err := lazy.load(); err != nil {
+			return fmt.Errorf("load segment %q: %w", lazy.path, err)
+		}
+	}
+	return nil
+}
+
 func (s *lazySegment) getPath() string {
 	return s.path
 }
@@ -126,6 +165,10 @@ func (s *lazySegment) setPath(path string) {
 }
 
 func (s *lazySegment) getStrategy() segmentindex.Strategy {
+	if s.remote != nil {
+		return s.remote.marker.Strategy
+	}
+
 	ptr := s.strategy.Load()
 	if ptr != nil {
 		return *ptr
@@ -146,11 +189,18 @@ func (s *lazySegment) getStrategy() segmentindex.Strategy {
 }
 
 func (s *lazySegment) getSecondaryIndexCount() uint16 {
+	if s.remote != nil {
+		return s.remote.marker.SecondaryIndexCount
+	}
 	s.mustLoad()
 	return s.segment.getSecondaryIndexCount()
 }
 
 func (s *lazySegment) getLevel() uint16 {
+	if s.remote != nil {
+		return s.remote.marker.Level
+	}
+
 	ptr := s.level.Load()
 	if ptr != nil {
 		return *ptr
@@ -203,22 +253,42 @@ func (s *lazySegment) close() error {
 }
 
 func (s *lazySegment) dropMarked() error {
-	s.mustLoad()
-	return s.segment.dropMarked()
+	if err := s.load(); err != nil {
+		return fmt.Errorf("lazySegment::dropMarked: %w", err)
+	}
+	if err := s.segment.dropMarked(); err != nil {
+		return err
+	}
+	if s.remote != nil {
+		return s.remote.drop(s.path)
+	}
+	return nil
 }
 
 func (s *lazySegment) get(key []byte) ([]byte, error) {
-	s.mustLoad()
+	if s.notInRemoteSegment(key) {
+		return nil, lsmkv.NotFound
+	}
+	if err := s.load(); err != nil {
+		return nil, fmt.Errorf("lazySegment::get: %w", err)
+	}
 	return s.segment.get(key)
 }
 
 func (s *lazySegment) getBySecondary(pos int, key []byte, buffer []byte) ([]byte, []byte, []byte, error) {
-	s.mustLoad()
+	if err := s.load(); err != nil {
+		return nil, nil, nil, fmt.Errorf("lazySegment::getBySecondary: %w", err)
+	}
 	return s.segment.getBySecondary(pos, key, buffer)
 }
 
 func (s *lazySegment) getCollection(key []byte) ([]value, error) {
-	s.mustLoad()
+	if s.notInRemoteSegment(key) {
+		return nil, lsmkv.NotFound
+	}
+	if err := s.load(); err != nil {
+		return nil, fmt.Errorf("lazySegment::getCollection: %w", err)
+	}
 	return s.segment.getCollection(key)
 }
 
@@ -235,12 +305,24 @@ func (s *lazySegment) isLoaded() bool {
 }
 
 func (s *lazySegment) markForDeletion() error {
-	s.mustLoad()
-	return s.segment.markForDeletion()
+	if err := s.load(); err != nil {
+		return fmt.Errorf("lazySegment::markForDeletion: %w", err)
+	}
+	if err := s.segment.markForDeletion(); err != nil {
+		return err
+	}
+	if s.remote != nil {
+		if err := markDeleted(remoteMarkerPath(s.path)); err != nil {
+			return fmt.Errorf("mark remote segment marker deleted: %w", err)
+		}
+	}
+	return nil
 }
 
 func (s *lazySegment) MergeTombstones(other *sroar.Bitmap) (*sroar.Bitmap, error) {
-	s.mustLoad()
+	if err := s.load(); err != nil {
+		return nil, fmt.Errorf("lazySegment::MergeTombstones: %w", err)
+	}
 	return s.segment.MergeTombstones(other)
 }
 
@@ -270,7 +352,9 @@ func (s *lazySegment) newMapCursor() innerCursorMap {
 }
 
 func (s *lazySegment) newNodeReader(offset nodeOffset, operation string) (*nodeReader, error) {
-	s.mustLoad()
+	if err := s.load(); err != nil {
+		return nil, fmt.Errorf("lazySegment::newNodeReader: %w", err)
+	}
 	return s.segment.newNodeReader(offset, operation)
 }
 
@@ -294,25 +378,46 @@ func (s *lazySegment) quantileKeys(q int) [][]byte {
 	return s.segment.quantileKeys(q)
 }
 
+func (s *lazySegment) keysWithPrefix(prefix []byte, fn func(key []byte) bool) (bool, error) {
+	if err := s.load(); err != nil {
+		return false, fmt.Errorf("lazySegment::keysWithPrefix: %w", err)
+	}
+	return s.segment.keysWithPrefix(prefix, fn)
+}
+
 func (s *lazySegment) ReadOnlyTombstones() (*sroar.Bitmap, error) {
-	s.mustLoad()
+	if err := s.load(); err != nil {
+		return nil, fmt.Errorf("lazySegment::ReadOnlyTombstones: %w", err)
+	}
 	return s.segment.ReadOnlyTombstones()
 }
 
 func (s *lazySegment) replaceStratParseData(in []byte) ([]byte, []byte, error) {
-	s.mustLoad()
+	if err := s.load(); err != nil {
+		return nil, nil, fmt.Errorf("lazySegment::replaceStratParseData: %w", err)
+	}
 	return s.segment.replaceStratParseData(in)
 }
 
 func (s *lazySegment) roaringSetGet(key []byte, bitmapBufPool roaringset.BitmapBufPool,
 ) (roaringset.BitmapLayer, func(), error) {
-	s.mustLoad()
+	if s.notInRemoteSegment(key) {
+		return roaringset.BitmapLayer{}, noopRelease, lsmkv.NotFound
+	}
+	if err := s.load(); err != nil {
+		return roaringset.BitmapLayer{}, noopRelease, fmt.Errorf("lazySegment::roaringSetGet: %w", err)
+	}
 	return s.segment.roaringSetGet(key, bitmapBufPool)
 }
 
 func (s *lazySegment) roaringSetMergeWith(key []byte, input roaringset.BitmapLayer, bitmapBufPool roaringset.BitmapBufPool,
 ) error {
-	s.mustLoad()
+	if s.notInRemoteSegment(key) {
+		return nil
+	}
+	if err := s.load(); err != nil {
+		return fmt.Errorf("lazySegment::roaringSetMergeWith: %w", err)
+	}
 	return s.segment.roaringSetMergeWith(key, input, bitmapBufPool)
 }
 
@@ -327,22 +432,48 @@ func (s *lazySegment) numberFromPath(re *regexp.Regexp) (int, bool) {
 	return 0, false
 }
 
+// Segments in cold storage count their refs themselves, so that they are
+// not fetched for consistent views which do not read them
 func (s *lazySegment) incRef() {
+	if s.remote != nil {
+		s.remote.refCount++
+		return
+	}
 	s.mustLoad()
 	s.segment.incRef()
 }
 
 func (s *lazySegment) decRef() {
+	if s.remote != nil {
+		if s.remote.refCount <= 0 {
+			panic("refCount already zero")
+		}
+		s.remote.refCount--
+		return
+	}
 	s.mustLoad()
 	s.segment.decRef()
 }
 
+// getRefs of segments in cold storage includes the refs of the loaded
+// segment, which are held by consistent views created before the segment
+// was moved
 func (s *lazySegment) getRefs() int {
+	if s.remote != nil {
+		refs := s.remote.refCount
+		if s.loaded.Load() {
+			refs += s.segment.getRefs()
+		}
+		return refs
+	}
 	s.mustLoad()
 	return s.segment.getRefs()
 }
 
 func (s *lazySegment) hasKey(key []byte) bool {
+	if s.notInRemoteSegment(key) {
+		return false
+	}
 	s.mustLoad()
 	return s.segment.hasKey(key)
 }
@@ -367,17 +498,30 @@ func (s *lazySegment) newSegmentBlockMax(key []byte, queryTermIndex int, idf flo
 	return s.segment.newSegmentBlockMax(key, queryTermIndex, idf, propertyBoost, tombstones, filterDocIds, averagePropLength, config)
 }
 
+func (s *lazySegment) newSegmentBlockMaxDotProduct(key []byte, queryTermIndex int, weight float64,
+	tombstones *sroar.Bitmap, filterDocIds helpers.AllowList,
+) *SegmentBlockMax {
+	s.mustLoad()
+	return s.segment.newSegmentBlockMaxDotProduct(key, queryTermIndex, weight, tombstones, filterDocIds)
+}
+
 func (s *lazySegment) getDocCount(key []byte) uint64 {
 	s.mustLoad()
 	return s.segment.getDocCount(key)
 }
 
 func (s *lazySegment) getCountNetAdditions() int {
+	if s.remote != nil {
+		return s.remote.marker.CountNetAdditions
+	}
 	s.mustLoad()
 	return s.segment.getCountNetAdditions()
 }
 
 func (s *lazySegment) existsKey(key []byte) (bool, error) {
+	if s.notInRemoteSegment(key) {
+		return false, nil
+	}
 	if err := s.load(); err != nil {
 		return false, fmt.Errorf("lazySegment::existsKey: %w", err)
 	}
@@ -22,6 +22,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/weaviate/weaviate/usecases/encryption"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 
 	"github.com/pkg/errors"
@@ -130,6 +131,12 @@ type Memtable struct {
 	tombstones *sroar.Bitmap
 
 	enableChecksumValidation bool
+	// compression of the values of the flushed segment, replace strategy
+	// only. It is shared with the bucket and read when the memtable is
+	// flushed, so that a change applies to the memtable in use.
+	compression *compressionSetting
+	// encryption of the flushed segment
+	encryption *encryption.Encryptor
 
 	bm25config                   *models.BM25Config
 	averagePropLength            float64
@@ -96,7 +96,7 @@ func (m *Memtable) flush() (segmentPath string, rerr error) {
 		tmpSegmentPath = m.path + ".db.tmp"
 	}
 
-	f, err := os.OpenFile(tmpSegmentPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o666)
+	f, err := m.encryption.OpenFile(tmpSegmentPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o666)
 	if err != nil {
 		return "", err
 	}
@@ -202,8 +202,41 @@ func (m *Memtable) flush() (segmentPath string, rerr error) {
 
 func (m *Memtable) flushDataReplace(f *segmentindex.SegmentFile) ([]segmentindex.Key, error) {
 	flat := m.key.flattenInOrder()
+	compression := m.compression.get()
+
+	// the header is written first, so the values need to be compressed
+	// upfront to know where the index starts
+	values := make([][]byte, len(flat))
+	for i, node := range flat {
+		if !node.tombstone {
+			values[i] = node.value
+		}
+	}
+
+	var zstdDict *zstdDictionary
+	if compression == segmentindex.CompressionZstd {
+		var err error
+		if zstdDict, err = m.compression.trainZstdDictionary(values); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, node := range flat {
+		values[i] = node.value
+		if node.tombstone {
+			continue
+		}
+		compressed, err := compressValue(compression, zstdDict, node.value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "compress node %d", i)
+		}
+		values[i] = compressed
+	}
 
 	totalDataLength := totalKeyAndValueSize(flat)
+	for i, node := range flat {
+		totalDataLength += len(values[i]) - len(node.value)
+	}
 	perObjectAdditions := len(flat) * (1 + 8 + 4 + int(m.secondaryIndices)*4) // 1 byte for the tombstone, 8 bytes value length encoding, 4 bytes key length encoding, + 4 bytes key encoding for every secondary index
 	headerSize := segmentindex.HeaderSize
 	header := &segmentindex.Header{
@@ -212,6 +245,7 @@ func (m *Memtable) flushDataReplace(f *segmentindex.SegmentFile) ([]segmentindex
 		Version:          segmentindex.ChooseHeaderVersion(m.enableChecksumValidation),
 		SecondaryIndices: m.secondaryIndices,
 		Strategy:         SegmentStrategyFromString(m.strategy),
+		Compression:      compression,
 	}
 
 	n, err := f.WriteHeader(header)
@@ -226,7 +260,7 @@ func (m *Memtable) flushDataReplace(f *segmentindex.SegmentFile) ([]segmentindex
 		segNode := &segmentReplaceNode{
 			offset:              totalWritten,
 			tombstone:           node.tombstone,
-			value:               node.value,
+			value:               values[i],
 			primaryKey:          node.key,
 			secondaryKeys:       node.secondaryKeys,
 			secondaryIndexCount: m.secondaryIndices,
@@ -33,7 +33,7 @@ func TestMemtableRoaringSetRange(t *testing.T) {
 	}
 
 	t.Run("concurrent writes and search", func(t *testing.T) {
-		cl, err := newCommitLogger(memPath(), StrategyRoaringSetRange, 0)
+		cl, err := newCommitLogger(memPath(), StrategyRoaringSetRange, 0, nil)
 		require.NoError(t, err)
 		m, err := newMemtable(memPath(), StrategyRoaringSetRange, 0, cl, nil, logger, false, nil, false, nil, nil)
 		require.Nil(t, err)
@@ -28,7 +28,7 @@ func TestMemtableRoaringSet(t *testing.T) {
 	}
 
 	t.Run("inserting individual entries", func(t *testing.T) {
-		cl, err := newCommitLogger(memPath(), StrategyRoaringSet, 0)
+		cl, err := newCommitLogger(memPath(), StrategyRoaringSet, 0, nil)
 		require.NoError(t, err)
 
 		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, cl, nil, logger, false, nil, false, nil, nil)
@@ -60,7 +60,7 @@ func TestMemtableRoaringSet(t *testing.T) {
 	})
 
 	t.Run("inserting lists", func(t *testing.T) {
-		cl, err := newCommitLogger(memPath(), StrategyRoaringSet, 0)
+		cl, err := newCommitLogger(memPath(), StrategyRoaringSet, 0, nil)
 		require.NoError(t, err)
 
 		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, cl, nil, logger, false, nil, false, nil, nil)
@@ -90,7 +90,7 @@ func TestMemtableRoaringSet(t *testing.T) {
 	})
 
 	t.Run("inserting bitmaps", func(t *testing.T) {
-		cl, err := newCommitLogger(memPath(), StrategyRoaringSet, 0)
+		cl, err := newCommitLogger(memPath(), StrategyRoaringSet, 0, nil)
 		require.NoError(t, err)
 
 		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, cl, nil, logger, false, nil, false, nil, nil)
@@ -122,7 +122,7 @@ func TestMemtableRoaringSet(t *testing.T) {
 	})
 
 	t.Run("removing individual entries", func(t *testing.T) {
-		cl, err := newCommitLogger(memPath(), StrategyRoaringSet, 0)
+		cl, err := newCommitLogger(memPath(), StrategyRoaringSet, 0, nil)
 		require.NoError(t, err)
 
 		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, cl, nil, logger, false, nil, false, nil, nil)
@@ -148,7 +148,7 @@ func TestMemtableRoaringSet(t *testing.T) {
 	})
 
 	t.Run("removing lists", func(t *testing.T) {
-		cl, err := newCommitLogger(memPath(), StrategyRoaringSet, 0)
+		cl, err := newCommitLogger(memPath(), StrategyRoaringSet, 0, nil)
 		require.NoError(t, err)
 
 		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, cl, nil, logger, false, nil, false, nil, nil)
@@ -178,7 +178,7 @@ func TestMemtableRoaringSet(t *testing.T) {
 	})
 
 	t.Run("removing bitmaps", func(t *testing.T) {
-		cl, err := newCommitLogger(memPath(), StrategyRoaringSet, 0)
+		cl, err := newCommitLogger(memPath(), StrategyRoaringSet, 0, nil)
 		require.NoError(t, err)
 
 		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, cl, nil, logger, false, nil, false, nil, nil)
@@ -208,7 +208,7 @@ func TestMemtableRoaringSet(t *testing.T) {
 	})
 
 	t.Run("adding/removing slices", func(t *testing.T) {
-		cl, err := newCommitLogger(memPath(), StrategyRoaringSet, 0)
+		cl, err := newCommitLogger(memPath(), StrategyRoaringSet, 0, nil)
 		require.NoError(t, err)
 
 		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, cl, nil, logger, false, nil, false, nil, nil)
@@ -27,7 +27,7 @@ func Test_MemtableSecondaryKeyBug(t *testing.T) {
 	dir := t.TempDir()
 
 	logger, _ := test.NewNullLogger()
-	cl, err := newCommitLogger(dir, StrategyReplace, 0)
+	cl, err := newCommitLogger(dir, StrategyReplace, 0, nil)
 	require.NoError(t, err)
 
 	m, err := newMemtable(path.Join(dir, "will-never-flush"), StrategyReplace, 1, cl, nil, logger, false, nil, false, nil, nil)
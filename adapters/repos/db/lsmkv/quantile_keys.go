@@ -56,6 +56,11 @@ func (sg *SegmentGroup) quantileKeys(q int) [][]byte {
 	}
 
 	for _, s := range segments {
+		// the keys are an approximation anyway, segments in cold storage are
+		// not fetched just for them
+		if isRemoteSegment(s) && !s.(*lazySegment).loaded.Load() {
+			continue
+		}
 		keys = append(keys, s.quantileKeys(q)...)
 	}
 
@@ -36,6 +36,7 @@ import (
 	"github.com/weaviate/weaviate/entities/lsmkv"
 	"github.com/weaviate/weaviate/entities/schema"
 	entsentry "github.com/weaviate/weaviate/entities/sentry"
+	"github.com/weaviate/weaviate/usecases/encryption"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 	"github.com/weaviate/weaviate/usecases/mmap"
 	"github.com/weaviate/weaviate/usecases/monitoring"
@@ -75,6 +76,7 @@ type Segment interface {
 	newRoaringSetRangeCursor() roaringsetrange.SegmentCursor
 	newRoaringSetRangeReader() roaringsetrange.InnerReader
 	quantileKeys(q int) [][]byte
+	keysWithPrefix(prefix []byte, fn func(key []byte) bool) (bool, error)
 	ReadOnlyTombstones() (*sroar.Bitmap, error)
 	replaceStratParseData(in []byte) ([]byte, []byte, error)
 	roaringSetGet(key []byte, bitmapBufPool roaringset.BitmapBufPool) (roaringset.BitmapLayer, func(), error)
@@ -86,6 +88,7 @@ type Segment interface {
 	getPropertyLengths() (map[uint64]uint32, error)
 	newInvertedCursorReusable() *segmentCursorInvertedReusable
 	newSegmentBlockMax(key []byte, queryTermIndex int, idf float64, propertyBoost float32, tombstones *sroar.Bitmap, filterDocIds helpers.AllowList, averagePropLength float64, config schema.BM25Config) *SegmentBlockMax
+	newSegmentBlockMaxDotProduct(key []byte, queryTermIndex int, weight float64, tombstones *sroar.Bitmap, filterDocIds helpers.AllowList) *SegmentBlockMax
 
 	// replace specific
 	getCountNetAdditions() int
@@ -103,8 +106,10 @@ type segment struct {
 	dataStartPos        uint64
 	dataEndPos          uint64
 	contents            []byte
-	contentFile         *os.File
+	contentFile         segmentContentFile
 	strategy            segmentindex.Strategy
+	compression         segmentindex.Compression
+	zstdDict            *zstdDictionary // of the bucket, see compressValue
 	index               diskIndex
 	secondaryIndices    []diskIndex
 	logger              logrus.FieldLogger
@@ -112,6 +117,12 @@ type segment struct {
 	size                int64
 	readFromMemory      bool
 	unMapContents       bool
+	// the data region of encrypted segments is not part of the contents, it
+	// is only read through the contentFile
+	encrypted bool
+	// encryption of the files derived from the segment, the bloom filters,
+	// net additions and metadata
+	encryption *encryption.Encryptor
 
 	useBloomFilter        bool // see bucket for more datails
 	bloomFilter           *bloom.BloomFilter
@@ -128,6 +139,14 @@ type segment struct {
 	refCount         int
 }
 
+// segmentContentFile is the file a segment reads its nodes from with pread, an
+// *os.File or the *encryption.File of an encrypted segment
+type segmentContentFile interface {
+	io.Reader
+	io.ReaderAt
+	io.Closer
+}
+
 type diskIndex interface {
 	// Get return lsmkv.NotFound in case no node can be found
 	Get(key []byte) (segmentindex.Node, error)
@@ -154,11 +173,13 @@ type segmentConfig struct {
 	calcCountNetAdditions        bool
 	overwriteDerived             bool
 	enableChecksumValidation     bool
+	encryption                   *encryption.Encryptor
 	MinMMapSize                  int64
 	allocChecker                 memwatch.AllocChecker
 	fileList                     map[string]int64
 	precomputedCountNetAdditions *int
 	writeMetadata                bool
+	zstdDict                     *zstdDictionary
 }
 
 // newSegment creates a new segment structure, representing an LSM disk segment.
@@ -188,9 +209,10 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 	// The lifetime of the `file` exceeds this constructor as we store the open file for later use in `contentFile`.
 	// invariant: We close **only** if any error happened after successfully opening the file. To avoid leaking open file descriptor.
 	// NOTE: This `defer` works even with `err` being shadowed in the whole function because defer checks for named `rerr` return value.
+	var contentFile segmentContentFile = file
 	defer func() {
 		if rerr != nil {
-			file.Close()
+			contentFile.Close()
 		}
 	}()
 
@@ -210,12 +232,17 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 		size = fileInfo.Size()
 	}
 
+	encrypted, err := isEncryptedSegment(file)
+	if err != nil {
+		return nil, fmt.Errorf("read encryption header: %w", err)
+	}
+
 	// mmap has some overhead, we can read small files directly to memory
 	var contents []byte
 	var unMapContents bool
 	var allocCheckerErr error
 
-	if size <= cfg.MinMMapSize { // check if it is a candidate for full reading
+	if !encrypted && size <= cfg.MinMMapSize { // check if it is a candidate for full reading
 		if cfg.allocChecker == nil {
 			logger.WithFields(logrus.Fields{
 				"path":        path,
@@ -232,7 +259,20 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 
 	useBloomFilter := cfg.useBloomFilter
 	readFromMemory := cfg.mmapContents
-	if size > cfg.MinMMapSize || cfg.allocChecker == nil || allocCheckerErr != nil { // mmap the file if it's too large or if we have memory pressure
+	if encrypted {
+		// the data region of encrypted segments is decrypted on every read,
+		// see openEncryptedSegment
+		encryptedFile, plaintext, err := openEncryptedSegment(path, cfg.encryption)
+		if err != nil {
+			return nil, fmt.Errorf("open encrypted segment: %w", err)
+		}
+		file.Close()
+		contentFile = encryptedFile
+		contents = plaintext
+		size = int64(len(plaintext))
+		unMapContents = true
+		readFromMemory = false
+	} else if size > cfg.MinMMapSize || cfg.allocChecker == nil || allocCheckerErr != nil { // mmap the file if it's too large or if we have memory pressure
 		contents2, err := mmap.MapRegion(file, int(size), mmap.RDONLY, 0, 0)
 		if err != nil {
 			return nil, fmt.Errorf("mmap file: %w", err)
@@ -250,6 +290,7 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 		readFromMemory = true
 		useBloomFilter = false
 	}
+
 	header, err := segmentindex.ParseHeader(contents[:segmentindex.HeaderSize])
 	if err != nil {
 		return nil, fmt.Errorf("parse header: %w", err)
@@ -260,12 +301,12 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 	}
 
 	if header.Version >= segmentindex.SegmentV1 && cfg.enableChecksumValidation {
-		file.Seek(0, io.SeekStart)
 		headerSize := int64(segmentindex.HeaderSize)
 		if header.Strategy == segmentindex.StrategyInverted {
 			headerSize += int64(segmentindex.HeaderInvertedSize)
 		}
-		segmentFile := segmentindex.NewSegmentFile(segmentindex.WithReader(file))
+		reader := io.NewSectionReader(contentFile, 0, size)
+		segmentFile := segmentindex.NewSegmentFile(segmentindex.WithReader(reader))
 		if err := segmentFile.ValidateChecksum(size, headerSize); err != nil {
 			return nil, fmt.Errorf("validate segment %q: %w", path, err)
 		}
@@ -321,6 +362,9 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 		segmentStartPos:       header.IndexStart,
 		segmentEndPos:         uint64(size),
 		strategy:              header.Strategy,
+		compression:           header.Compression,
+		zstdDict:              cfg.zstdDict,
+		encryption:            cfg.encryption,
 		dataStartPos:          dataStartPos,
 		dataEndPos:            dataEndPos,
 		index:                 primaryDiskIndex,
@@ -335,14 +379,15 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 			tombstones: sroar.NewBitmap(),
 		},
 		unMapContents:    unMapContents,
+		encrypted:        encrypted,
 		observeMetaWrite: func(n int64) { observeWrite.Observe(float64(n)) },
 	}
 
 	// Using pread strategy requires file to remain open for segment lifetime
 	if seg.readFromMemory {
-		defer file.Close()
+		defer contentFile.Close()
 	} else {
-		seg.contentFile = file
+		seg.contentFile = contentFile
 	}
 
 	if seg.secondaryIndexCount > 0 {
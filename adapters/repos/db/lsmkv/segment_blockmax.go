@@ -156,6 +156,13 @@ type SegmentBlockMax struct {
 	tombstones         *sroar.Bitmap
 	filterDocIds       *sroar.Bitmap
 
+	// dotProduct scores a posting as its stored frequency multiplied by
+	// queryWeight instead of using BM25. It is used to search sparse vectors,
+	// whose weights are stored as term frequencies. In this mode idf holds the
+	// upper bound of the term's score.
+	dotProduct  bool
+	queryWeight float64
+
 	// at position 0 we have the doc ids decoder, at position 1 is the tfs decoder
 	decoders []varenc.VarEncEncoder[uint64]
 
@@ -191,6 +198,28 @@ func (s *segment) newSegmentBlockMax(key []byte, queryTermIndex int, idf float64
 }
 
 func NewSegmentBlockMax(s *segment, key []byte, queryTermIndex int, idf float64, propertyBoost float32, tombstones *sroar.Bitmap, filterDocIds helpers.AllowList, averagePropLength float64, config schema.BM25Config) *SegmentBlockMax {
+	output := &SegmentBlockMax{
+		idf:               idf,
+		queryTermIndex:    queryTermIndex,
+		averagePropLength: averagePropLength,
+
+		b:             config.B,
+		k1:            config.K1,
+		propertyBoost: float64(propertyBoost),
+	}
+	return initSegmentBlockMax(s, output, key, tombstones, filterDocIds)
+}
+
+func (s *segment) newSegmentBlockMaxDotProduct(key []byte, queryTermIndex int, weight float64, tombstones *sroar.Bitmap, filterDocIds helpers.AllowList) *SegmentBlockMax {
+	output := &SegmentBlockMax{
+		queryTermIndex: queryTermIndex,
+		dotProduct:     true,
+		queryWeight:    weight,
+	}
+	return initSegmentBlockMax(s, output, key, tombstones, filterDocIds)
+}
+
+func initSegmentBlockMax(s *segment, output *SegmentBlockMax, key []byte, tombstones *sroar.Bitmap, filterDocIds helpers.AllowList) *SegmentBlockMax {
 	node, err := s.index.Get(key)
 	if err != nil {
 		return nil
@@ -218,21 +247,12 @@ func NewSegmentBlockMax(s *segment, key []byte, queryTermIndex int, idf float64,
 		sectionReader = io.NewSectionReader(s.contentFile, int64(node.Start), int64(node.End))
 	}
 
-	output := &SegmentBlockMax{
-		segment:           s,
-		node:              node,
-		idf:               idf,
-		queryTermIndex:    queryTermIndex,
-		averagePropLength: averagePropLength,
-
-		b:             config.B,
-		k1:            config.K1,
-		decoders:      decoders,
-		propertyBoost: float64(propertyBoost),
-		filterDocIds:  filterSroar,
-		tombstones:    tombstones,
-		sectionReader: sectionReader,
-	}
+	output.segment = s
+	output.node = node
+	output.decoders = decoders
+	output.filterDocIds = filterSroar
+	output.tombstones = tombstones
+	output.sectionReader = sectionReader
 
 	err = output.reset()
 	if err != nil {
@@ -359,6 +379,10 @@ func (s *SegmentBlockMax) reset() error {
 		return err
 	}
 
+	if s.dotProduct {
+		s.initDotProductBounds()
+	}
+
 	if s.blockDataDecoded == nil {
 		s.blockDataBuffer = make([]byte, blockMaxBufferSize)
 		s.blockDataDecoded = &terms.BlockDataDecoded{
@@ -404,6 +428,10 @@ func (s *SegmentBlockMax) decodeBlock() error {
 		s.decoded = true
 		s.Metrics.BlockCountDecodedDocIds++
 		s.Metrics.DocCountDecodedDocIds += uint64(s.blockDataSize)
+		if s.dotProduct {
+			s.currentBlockImpact = s.computeCurrentBlockImpact()
+			s.currentBlockMaxId = s.blockEntries[s.blockEntryIdx].MaxId
+		}
 		return nil
 	}
 	if s.segment != nil {
@@ -530,7 +558,6 @@ func (s *SegmentBlockMax) Score(averagePropLength float64, additionalExplanation
 
 	freq := float64(s.blockDataDecoded.Tfs[s.blockDataIdx])
 	propLength := s.propLengths[s.idPointer]
-	tf := freq / (freq + s.k1*((1-s.b)+s.b*(float64(propLength)/s.averagePropLength)))
 	s.Metrics.DocCountScored++
 	if s.blockEntryIdx != s.Metrics.LastAddedBlock {
 		s.Metrics.BlockCountDecodedFreqs++
@@ -545,6 +572,10 @@ func (s *SegmentBlockMax) Score(averagePropLength float64, additionalExplanation
 			PropLength: float32(propLength),
 		}
 	}
+	if s.dotProduct {
+		return s.idPointer, freq * s.queryWeight, doc
+	}
+	tf := freq / (freq + s.k1*((1-s.b)+s.b*(float64(propLength)/s.averagePropLength)))
 	score := tf * s.idf * s.propertyBoost
 	return s.idPointer, score, doc
 }
@@ -581,6 +612,9 @@ func (s *SegmentBlockMax) computeCurrentBlockImpact() float32 {
 		return float32(s.idf)
 	}
 	freq := float64(s.blockEntries[s.blockEntryIdx].MaxImpactTf)
+	if s.dotProduct {
+		return float32(freq * s.queryWeight)
+	}
 	propLength := float64(s.blockEntries[s.blockEntryIdx].MaxImpactPropLength)
 	return float32(s.idf * (freq / (freq + s.k1*(1-s.b+s.b*(propLength/s.averagePropLength)))) * s.propertyBoost)
 }
@@ -605,3 +639,27 @@ func (s *SegmentBlockMax) SetIdf(idf float64) {
 	s.idf = idf
 	s.currentBlockImpact = s.computeCurrentBlockImpact()
 }
+
+// initDotProductBounds sets the per-term score upper bound used for pivoting
+// from the maximum frequencies of the blocks. Terms small enough to be
+// stored fully decoded only record the first frequency in their block entry,
+// so the maximum is computed from the decoded data instead.
+func (s *SegmentBlockMax) initDotProductBounds() {
+	if s.blockDataDecoded != nil && len(s.blockEntries) == 1 {
+		maxTf := uint64(0)
+		for _, tf := range s.blockDataDecoded.Tfs[:s.docCount] {
+			if tf > maxTf {
+				maxTf = tf
+			}
+		}
+		s.blockEntries[0].MaxImpactTf = uint32(maxTf)
+	}
+
+	maxTf := uint32(0)
+	for _, entry := range s.blockEntries {
+		if entry.MaxImpactTf > maxTf {
+			maxTf = entry.MaxImpactTf
+		}
+	}
+	s.idf = float64(maxTf) * s.queryWeight
+}
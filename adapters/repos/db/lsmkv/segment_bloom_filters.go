@@ -27,6 +27,7 @@ import (
 	"github.com/bits-and-blooms/bloom/v3"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/usecases/encryption"
 )
 
 func (s *segment) buildPath(template string) string {
@@ -141,11 +142,11 @@ func (s *segment) storeBloomFilterOnDisk(path string) error {
 		return fmt.Errorf("write bloom filter: %w", err)
 	}
 
-	return writeWithChecksum(rw, path, s.observeMetaWrite)
+	return writeWithChecksum(s.encryption, rw, path, s.observeMetaWrite)
 }
 
 func (s *segment) loadBloomFilterFromDisk() error {
-	data, err := loadWithChecksum(s.bloomFilterPath(), -1, s.metrics.ReadObserver("loadBloomfilter"))
+	data, err := loadWithChecksum(s.encryption, s.bloomFilterPath(), -1, s.metrics.ReadObserver("loadBloomfilter"))
 	if err != nil {
 		return err
 	}
@@ -233,11 +234,11 @@ func (s *segment) storeBloomFilterSecondaryOnDisk(path string, pos int) error {
 		return fmt.Errorf("write bloom filter: %w", err)
 	}
 
-	return writeWithChecksum(rw, path, s.observeMetaWrite)
+	return writeWithChecksum(s.encryption, rw, path, s.observeMetaWrite)
 }
 
 func (s *segment) loadBloomFilterSecondaryFromDisk(pos int) error {
-	data, err := loadWithChecksum(s.bloomFilterSecondaryPath(pos), -1, s.metrics.ReadObserver("loadSecondaryBloomFilter"))
+	data, err := loadWithChecksum(s.encryption, s.bloomFilterSecondaryPath(pos), -1, s.metrics.ReadObserver("loadSecondaryBloomFilter"))
 	if err != nil {
 		return err
 	}
@@ -263,12 +264,12 @@ func fileExistsInList(nameList map[string]int64, filePath string) (bool, error)
 // writeWithChecksum expects the data in the buffer to start at position byteops.Uint32Len so the
 // checksum can be added into the same buffer at its start and everything can be written to the file
 // in one go
-func writeWithChecksum(bufWriter byteops.ReadWriter, path string, observeFileWriter diskio.MeteredWriterCallback) error {
+func writeWithChecksum(enc *encryption.Encryptor, bufWriter byteops.ReadWriter, path string, observeFileWriter diskio.MeteredWriterCallback) error {
 	// checksum needs to be at the start of the file
 	chksm := crc32.ChecksumIEEE(bufWriter.Buffer[byteops.Uint32Len:])
 	bufWriter.MoveBufferToAbsolutePosition(0)
 	bufWriter.WriteUint32(chksm)
-	f, err := os.Create(path)
+	f, err := enc.Create(path)
 	if err != nil {
 		return fmt.Errorf("open file for writing: %w", err)
 	}
@@ -292,8 +293,8 @@ func writeWithChecksum(bufWriter byteops.ReadWriter, path string, observeFileWri
 
 // use negative length check to indicate that no length check should be
 // performed
-func loadWithChecksum(path string, lengthCheck int, observeFileReader BytesReadObserver) ([]byte, error) {
-	f, err := os.Open(path)
+func loadWithChecksum(enc *encryption.Encryptor, path string, lengthCheck int, observeFileReader BytesReadObserver) ([]byte, error) {
+	f, err := enc.Open(path)
 	if err != nil {
 		return nil, err
 	}
@@ -328,3 +329,80 @@ func getBloomFilterSize(bf *bloom.BloomFilter) int {
 	bsSize := bs.BinaryStorageSize()
 	return bsSize + 2*byteops.Uint64Len
 }
+
+// readBloomFilters reads the bloom filters from the derived files of the
+// segment without building them, found is false if there are none. It only
+// depends on the path and the number of secondary indexes, so that it can be
+// used without loading the segment.
+func (s *segment) readBloomFilters() (*bloom.BloomFilter, []*bloom.BloomFilter, bool, error) {
+	if ok, err := fileExists(s.metadataPath()); err != nil {
+		return nil, nil, false, err
+	} else if ok {
+		data, err := loadWithChecksum(s.encryption, s.metadataPath(), -1, nil)
+		if err != nil {
+			return nil, nil, true, fmt.Errorf("load metadata: %w", err)
+		}
+
+		rw := byteops.NewReadWriter(data)
+		if version := rw.ReadUint8(); version != MetadataVersion {
+			return nil, nil, true, fmt.Errorf("invalid metadata version: %d", version)
+		}
+		primaryData := rw.ReadBytesFromBufferWithUint32LengthIndicator()
+		rw.ReadBytesFromBufferWithUint32LengthIndicator() // count net additions
+		if len(primaryData) == 0 {
+			// metadata of buckets without bloom filters
+			return nil, nil, false, nil
+		}
+		secondaryData := make([][]byte, s.secondaryIndexCount)
+		for i := range secondaryData {
+			secondaryData[i] = rw.ReadBytesFromBufferWithUint32LengthIndicator()
+		}
+
+		primary, err := readBloomFilter(primaryData)
+		if err != nil {
+			return nil, nil, true, fmt.Errorf("read primary bloom filter: %w", err)
+		}
+		secondary := make([]*bloom.BloomFilter, len(secondaryData))
+		for i, data := range secondaryData {
+			if secondary[i], err = readBloomFilter(data); err != nil {
+				return nil, nil, true, fmt.Errorf("read bloom filter of secondary index %d: %w", i, err)
+			}
+		}
+		return primary, secondary, true, nil
+	}
+
+	if ok, err := fileExists(s.bloomFilterPath()); err != nil || !ok {
+		return nil, nil, false, err
+	}
+
+	data, err := loadWithChecksum(s.encryption, s.bloomFilterPath(), -1, nil)
+	if err != nil {
+		return nil, nil, true, fmt.Errorf("load primary bloom filter: %w", err)
+	}
+	primary, err := readBloomFilter(data)
+	if err != nil {
+		return nil, nil, true, fmt.Errorf("read primary bloom filter: %w", err)
+	}
+	secondary := make([]*bloom.BloomFilter, s.secondaryIndexCount)
+	for i := range secondary {
+		data, err := loadWithChecksum(s.encryption, s.bloomFilterSecondaryPath(i), -1, nil)
+		if err != nil {
+			return nil, nil, true, fmt.Errorf("load bloom filter of secondary index %d: %w", i, err)
+		}
+		if secondary[i], err = readBloomFilter(data); err != nil {
+			return nil, nil, true, fmt.Errorf("read bloom filter of secondary index %d: %w", i, err)
+		}
+	}
+	return primary, secondary, true, nil
+}
+
+func readBloomFilter(data []byte) (*bloom.BloomFilter, error) {
+	bf := new(bloom.BloomFilter)
+	if _, err := bf.ReadFrom(bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+	return bf, nil
+}
+
+// verifyBloomFilter checks that the bloom filter contains all keys of the
+// index, a bloom filter must never report an existing key as missing
@@ -324,7 +324,7 @@ func TestRepairTooShortBloomSecondaryOnInit(t *testing.T) {
 func TestLoadWithChecksumErrorCases(t *testing.T) {
 	t.Run("file does not exist", func(t *testing.T) {
 		dirName := t.TempDir()
-		_, err := loadWithChecksum(path.Join(dirName, "my-file"), -1, nil)
+		_, err := loadWithChecksum(nil, path.Join(dirName, "my-file"), -1, nil)
 		assert.NotNil(t, err)
 	})
 
@@ -339,7 +339,7 @@ func TestLoadWithChecksumErrorCases(t *testing.T) {
 
 		require.Nil(t, f.Close())
 
-		_, err = loadWithChecksum(path.Join(dirName, "my-file"), 17, nil)
+		_, err = loadWithChecksum(nil, path.Join(dirName, "my-file"), 17, nil)
 		assert.NotNil(t, err)
 	})
 }
@@ -364,7 +364,7 @@ func BenchmarkLoading(b *testing.B) {
 			require.NoError(b, f.Close())
 			b.ResetTimer()
 			for i := 0; i < b.N; i++ {
-				loadedData, err := loadWithChecksum(fName, len(data), nil)
+				loadedData, err := loadWithChecksum(nil, fName, len(data), nil)
 				require.NoError(b, err)
 				require.Equal(b, loadedData, data[4:])
 			}
@@ -35,11 +35,14 @@ type segmentCleanerReplace struct {
 	secondaryIndexCount      uint16
 	scratchSpacePath         string
 	enableChecksumValidation bool
+	compression              segmentindex.Compression
+	zstdDict                 *zstdDictionary
 }
 
 func newSegmentCleanerReplace(w io.WriteSeeker, cursor innerCursorReplaceAllKeys,
 	keyExistsFn keyExistsOnUpperSegmentsFunc, level, secondaryIndexCount uint16,
 	scratchSpacePath string, enableChecksumValidation bool,
+	compression segmentindex.Compression, zstdDict *zstdDictionary,
 ) *segmentCleanerReplace {
 	return &segmentCleanerReplace{
 		w:                        w,
@@ -51,6 +54,8 @@ func newSegmentCleanerReplace(w io.WriteSeeker, cursor innerCursorReplaceAllKeys
 		secondaryIndexCount:      secondaryIndexCount,
 		scratchSpacePath:         scratchSpacePath,
 		enableChecksumValidation: enableChecksumValidation,
+		compression:              compression,
+		zstdDict:                 zstdDict,
 	}
 }
 
@@ -133,6 +138,13 @@ func (p *segmentCleanerReplace) writeKeys(f *segmentindex.SegmentFile,
 		}
 		nodeCopy := node
 		nodeCopy.offset = offset
+		if !nodeCopy.tombstone {
+			// the cursor returns decompressed values
+			nodeCopy.value, err = compressValue(p.compression, p.zstdDict, nodeCopy.value)
+			if err != nil {
+				break
+			}
+		}
 		indexKey, err = nodeCopy.KeyIndexAndWriteTo(f.BodyWriter())
 		if err != nil {
 			break
@@ -179,6 +191,7 @@ func (p *segmentCleanerReplace) writeHeader(f *segmentindex.SegmentFile,
 		SecondaryIndices: p.secondaryIndexCount,
 		Strategy:         segmentindex.StrategyReplace,
 		IndexStart:       startOfIndex,
+		Compression:      p.compression,
 	}
 	// We have to write directly to compactor writer,
 	// since it has seeked back to start. The following
@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/usecases/encryption"
+	"github.com/weaviate/weaviate/usecases/mmap"
+)
+
+// isEncryptedSegment checks for the encryption header in front of the
+// segment. Segments written before the encryption was enabled have none and
+// are read as they are.
+func isEncryptedSegment(file *os.File) (bool, error) {
+	head := make([]byte, encryption.HeaderSize)
+	n, err := file.ReadAt(head, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, err
+	}
+	return encryption.HasHeader(head[:n]), nil
+}
+
+// openEncryptedSegment opens an encrypted segment without decrypting it as a
+// whole. The nodes of the data region are decrypted on every read through
+// the returned file, the same way plaintext segments which are not held in
+// memory are read with pread.
+//
+// Only the segment header and everything behind the data region, i.e. the
+// indexes as well as the tombstones and property lengths of the inverted
+// strategy, are decrypted up front. They are written into an anonymous
+// mapping of the size of the plaintext, so that they are parsed from the
+// contents at the same offsets as the ones of plaintext segments. The pages
+// of the data region are never written and don't take up any memory.
+func openEncryptedSegment(path string, enc *encryption.Encryptor,
+) (_ *encryption.File, _ []byte, rerr error) {
+	file, err := enc.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		if rerr != nil {
+			file.Close()
+		}
+	}()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, nil, fmt.Errorf("stat file: %w", err)
+	}
+	size := uint64(info.Size())
+	if size < segmentindex.HeaderSize {
+		return nil, nil, fmt.Errorf("segment of %d bytes is smaller than its header", size)
+	}
+
+	contents, err := mmap.MapRegion(nil, int(size), mmap.COPY, mmap.ANON, 0)
+	if err != nil {
+		return nil, nil, fmt.Errorf("mmap plaintext: %w", err)
+	}
+	defer func() {
+		if rerr != nil {
+			contents.Unmap()
+		}
+	}()
+
+	decrypt := func(start, end uint64) error {
+		if start > end || end > size {
+			return fmt.Errorf("invalid region [%d, %d) of segment of %d bytes", start, end, size)
+		}
+		_, err := file.ReadAt(contents[start:end], int64(start))
+		return err
+	}
+
+	headerEnd := uint64(segmentindex.HeaderSize + segmentindex.HeaderInvertedSize)
+	if headerEnd > size {
+		headerEnd = size
+	}
+	if err := decrypt(0, headerEnd); err != nil {
+		return nil, nil, fmt.Errorf("decrypt header: %w", err)
+	}
+	header, err := segmentindex.ParseHeader(contents[:segmentindex.HeaderSize])
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse header: %w", err)
+	}
+
+	dataStartPos, dataEndPos := uint64(segmentindex.HeaderSize), header.IndexStart
+	if header.Strategy == segmentindex.StrategyInverted {
+		if headerEnd < segmentindex.HeaderSize+segmentindex.HeaderInvertedSize {
+			return nil, nil, fmt.Errorf("segment of %d bytes is smaller than its inverted header", size)
+		}
+		invertedHeader, err := segmentindex.LoadHeaderInverted(contents[segmentindex.HeaderSize:headerEnd])
+		if err != nil {
+			return nil, nil, fmt.Errorf("load inverted header: %w", err)
+		}
+		dataStartPos, dataEndPos = invertedHeader.KeysOffset, invertedHeader.TombstoneOffset
+	}
+
+	if dataStartPos > headerEnd {
+		if err := decrypt(headerEnd, dataStartPos); err != nil {
+			return nil, nil, fmt.Errorf("decrypt header: %w", err)
+		}
+	}
+	if err := decrypt(dataEndPos, size); err != nil {
+		return nil, nil, fmt.Errorf("decrypt indexes: %w", err)
+	}
+
+	return file, contents, nil
+}
+
+// extractKeysAndTombstones calls cb for every key of the segment, e.g. to
+// count the net additions
+func (s *segment) extractKeysAndTombstones(cb keyAndTombstoneCallbackFn) error {
+	if s.encrypted {
+		r := io.NewSectionReader(s.contentFile, int64(s.dataStartPos), int64(s.dataEndPos-s.dataStartPos))
+		return extractKeysAndTombstonesFromReader(r, s.secondaryIndexCount, cb)
+	}
+
+	extr := newBufferedKeyAndTombstoneExtractor(s.contents, s.dataStartPos,
+		s.dataEndPos, 10e6, s.secondaryIndexCount, cb)
+	extr.do()
+	return nil
+}
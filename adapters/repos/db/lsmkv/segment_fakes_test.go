@@ -299,6 +299,10 @@ func (f *fakeSegment) quantileKeys(q int) [][]byte {
 	panic("not implemented")
 }
 
+func (f *fakeSegment) keysWithPrefix(prefix []byte, fn func(key []byte) bool) (bool, error) {
+	panic("not implemented")
+}
+
 func (f *fakeSegment) ReadOnlyTombstones() (*sroar.Bitmap, error) {
 	if f.strategy != segmentindex.StrategyInverted {
 		return nil, fmt.Errorf("tombstones only supported for inverted strategy")
@@ -422,6 +426,27 @@ func (s *fakeSegment) newSegmentBlockMax(key []byte, queryTermIndex int, idf flo
 	return bmwd
 }
 
+func (s *fakeSegment) newSegmentBlockMaxDotProduct(key []byte, queryTermIndex int, weight float64, tombstones *sroar.Bitmap, filterDocIds helpers.AllowList) *SegmentBlockMax {
+	keys := map[string][]MapPair{}
+
+	for k, v := range s.collectionStore {
+		mv, err := newMapDecoder().Do(v, false)
+		if err != nil {
+			panic(err)
+		}
+		keys[k] = mv
+	}
+
+	term, err := newDotProductTermFromMemtable(newTestMemtableInverted(keys), key, queryTermIndex, weight, tombstones, filterDocIds)
+	if err != nil {
+		panic(err)
+	}
+
+	s.getCounter++
+
+	return term
+}
+
 type fakeSegmentCursorReplace struct {
 	keys   [][]byte
 	values [][]byte
@@ -21,6 +21,7 @@ import (
 	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -34,6 +35,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/usecases/encryption"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 )
 
@@ -77,6 +79,9 @@ type SegmentGroup struct {
 	calcCountNetAdditions    bool // see bucket for more details
 	compactLeftOverSegments  bool // see bucket for more details
 	enableChecksumValidation bool
+	compression              *compressionSetting   // see bucket for more details
+	encryption               *encryption.Encryptor // see bucket for more details
+	segmentTiering           *SegmentTiering       // see bucket for more details
 	MinMMapSize              int64
 	keepLevelCompaction      bool // see bucket for more details
 
@@ -88,6 +93,23 @@ type SegmentGroup struct {
 	lastCleanupCall    time.Time
 	lastCompactionCall time.Time
 
+	// key ids of the segments by path, only accessed by rekeyOnce
+	segmentKeyIDs map[string]string
+
+	// number of store snapshots pinning the segments. Compactions and
+	// cleanups are skipped while it is not zero, as replaced segments could
+	// not be deleted before the snapshots are released
+	snapshotPins atomic.Int32
+	// set on the segment groups of store snapshots, their segments are
+	// referenced by the segment group of the bucket until the snapshot is
+	// released
+	isSnapshot bool
+
+	// fetches of segments in cold storage are cancelled on shutdown, see
+	// remoteSegment.fetch
+	fetchCtx      context.Context
+	cancelFetches context.CancelFunc
+
 	roaringSetRangeSegmentInMemory *roaringsetrange.SegmentInMemory
 	bitmapBufPool                  roaringset.BitmapBufPool
 	bm25config                     *schema.BM25Config
@@ -111,6 +133,9 @@ type sgConfig struct {
 	maxSegmentSize               int64
 	cleanupInterval              time.Duration
 	enableChecksumValidation     bool
+	compression                  *compressionSetting
+	encryption                   *encryption.Encryptor
+	segmentTiering               *SegmentTiering
 	keepSegmentsInMemory         bool
 	MinMMapSize                  int64
 	bm25config                   *models.BM25Config
@@ -140,6 +165,9 @@ func newSegmentGroup(ctx context.Context, logger logrus.FieldLogger, metrics *Me
 		maxSegmentSize:               cfg.maxSegmentSize,
 		cleanupInterval:              cfg.cleanupInterval,
 		enableChecksumValidation:     cfg.enableChecksumValidation,
+		compression:                  cfg.compression,
+		encryption:                   cfg.encryption,
+		segmentTiering:               cfg.segmentTiering,
 		allocChecker:                 b.allocChecker,
 		lastCompactionCall:           now,
 		lastCleanupCall:              now,
@@ -150,6 +178,7 @@ func newSegmentGroup(ctx context.Context, logger logrus.FieldLogger, metrics *Me
 		keepLevelCompaction:          cfg.keepLevelCompaction,
 		shouldSkipKey:                cfg.shouldSkipKey,
 	}
+	sg.fetchCtx, sg.cancelFetches = context.WithCancel(context.Background())
 
 	segmentIndex := 0
 
@@ -220,8 +249,10 @@ func newSegmentGroup(ctx context.Context, logger logrus.FieldLogger, metrics *Me
 					calcCountNetAdditions:    sg.calcCountNetAdditions,
 					overwriteDerived:         false,
 					enableChecksumValidation: sg.enableChecksumValidation,
+					encryption:               sg.encryption,
 					MinMMapSize:              sg.MinMMapSize,
 					allocChecker:             sg.allocChecker,
+					zstdDict:                 sg.compression.zstdDictionary(),
 					fileList:                 make(map[string]int64), // empty to not check if bloom/cna files already exist
 					writeMetadata:            sg.writeMetadata,
 				})
@@ -295,6 +326,11 @@ func newSegmentGroup(ctx context.Context, logger logrus.FieldLogger, metrics *Me
 
 	for _, entry := range fileList {
 		if filepath.Ext(entry) == DeleteMarkerSuffix {
+			if strings.HasSuffix(entry, RemoteMarkerSuffix+DeleteMarkerSuffix) {
+				// the object in cold storage was not deleted either
+				sg.deleteRemoteObject(filepath.Join(sg.dir, entry))
+			}
+
 			// marked for deletion, but never actually deleted. Delete now.
 			if err := os.Remove(filepath.Join(sg.dir, entry)); err != nil {
 				// don't abort if the delete fails, we can still continue (albeit
@@ -308,6 +344,26 @@ func newSegmentGroup(ctx context.Context, logger logrus.FieldLogger, metrics *Me
 
 		}
 
+		if filepath.Ext(entry) == RemoteMarkerSuffix {
+			segmentFileName := strings.TrimSuffix(entry, RemoteMarkerSuffix)
+			if _, ok := files[segmentFileName]; ok {
+				// a local copy exists, the segment is initialized with it
+				continue
+			}
+
+			segment, err := sg.initRemoteSegment(filepath.Join(sg.dir, segmentFileName),
+				sg.makeExistsOn(sg.segments[:segmentIndex]))
+			if err != nil {
+				return nil, fmt.Errorf("init remote segment %s: %w", filepath.Join(sg.dir, segmentFileName), err)
+			}
+			sg.segments[segmentIndex] = segment
+			segmentIndex++
+
+			sg.metrics.IncSegmentTotalByStrategy(sg.strategy)
+			sg.metrics.ObserveSegmentSize(sg.strategy, segment.Size())
+			continue
+		}
+
 		if filepath.Ext(entry) != ".db" {
 			// skip, this could be commit log, etc.
 			continue
@@ -342,13 +398,22 @@ func newSegmentGroup(ctx context.Context, logger logrus.FieldLogger, metrics *Me
 			calcCountNetAdditions:    sg.calcCountNetAdditions,
 			overwriteDerived:         false,
 			enableChecksumValidation: sg.enableChecksumValidation,
+			encryption:               sg.encryption,
 			MinMMapSize:              sg.MinMMapSize,
 			allocChecker:             sg.allocChecker,
+			zstdDict:                 sg.compression.zstdDictionary(),
 			fileList:                 files,
 			writeMetadata:            sg.writeMetadata,
 		}
 		var err error
-		if b.lazySegmentLoading {
+		if _, ok := files[entry+RemoteMarkerSuffix]; ok {
+			// moved to cold storage, but the local copy was not evicted yet
+			segment, err = sg.initRemoteSegment(filepath.Join(sg.dir, entry),
+				sg.makeExistsOn(sg.segments[:segmentIndex]))
+			if err != nil {
+				return nil, fmt.Errorf("init remote segment %s: %w", filepath.Join(sg.dir, entry), err)
+			}
+		} else if b.lazySegmentLoading {
 			segment, err = newLazySegment(filepath.Join(sg.dir, entry), logger,
 				metrics, sg.makeExistsOn(sg.segments[:segmentIndex]), segConf,
 			)
@@ -515,8 +580,10 @@ func (sg *SegmentGroup) add(path string) error {
 			calcCountNetAdditions:    sg.calcCountNetAdditions,
 			overwriteDerived:         true,
 			enableChecksumValidation: sg.enableChecksumValidation,
+			encryption:               sg.encryption,
 			MinMMapSize:              sg.MinMMapSize,
 			allocChecker:             sg.allocChecker,
+			zstdDict:                 sg.compression.zstdDictionary(),
 			writeMetadata:            sg.writeMetadata,
 		})
 	if err != nil {
@@ -535,6 +602,11 @@ func (sg *SegmentGroup) getConsistentViewOfSegments() (segments []Segment, relea
 	segments = make([]Segment, len(sg.segments))
 	copy(segments, sg.segments)
 
+	if sg.isSnapshot {
+		sg.maintenanceLock.RUnlock()
+		return segments, func() {}
+	}
+
 	sg.segmentRefCounterLock.Lock()
 	for _, seg := range segments {
 		seg.incRef()
@@ -764,6 +836,11 @@ func (sg *SegmentGroup) shutdown(ctx context.Context) error {
 		i++
 	}
 	sg.segmentRefCounterLock.Unlock()
+	// reads which are waiting for a segment in cold storage fail instead of
+	// holding their refs
+	if sg.cancelFetches != nil {
+		sg.cancelFetches()
+	}
 	sg.waitForReferenceCountToReachZero(segmentsWithRefs...)
 
 	// Lock acquirement placed after compaction cycle stop request, due to occasional deadlock,
@@ -834,6 +911,13 @@ func segmentExistsWithID(segmentID string, files map[string]int64) (bool, string
 func (sg *SegmentGroup) compactOrCleanup(shouldAbort cyclemanager.ShouldAbortCallback) bool {
 	sg.monitorSegments()
 
+	if sg.snapshotPins.Load() > 0 {
+		sg.logger.WithField("action", "lsm_compaction").
+			WithField("path", sg.dir).
+			Trace("segments pinned by snapshot, skipping compaction and cleanup")
+		return false
+	}
+
 	compact := func() bool {
 		sg.lastCompactionCall = time.Now()
 		compacted, err := sg.compactOnce()
@@ -870,10 +954,37 @@ func (sg *SegmentGroup) compactOrCleanup(shouldAbort cyclemanager.ShouldAbortCal
 	// (ignore if compaction was not called within that time either)
 	forceCleanupInterval := time.Hour * 12
 
+	// segments which are stale after a key rotation are only rewritten if
+	// there is nothing else to do, most of them are rewritten by compactions
+	// and cleanups anyway
+	rekey := func() bool {
+		rekeyed, err := sg.rekeyOnce()
+		if err != nil {
+			sg.logger.WithField("action", "lsm_rekey").
+				WithField("path", sg.dir).
+				WithError(err).
+				Errorf("rewriting segment with current encryption key failed")
+		}
+		return rekeyed
+	}
+
+	// segments are only moved to cold storage once they are neither compacted
+	// nor cleaned up anymore
+	tier := func() bool {
+		tiered, err := sg.tierOnce()
+		if err != nil {
+			sg.logger.WithField("action", "lsm_segment_tiering").
+				WithField("path", sg.dir).
+				WithError(err).
+				Errorf("moving segments to cold storage failed")
+		}
+		return tiered
+	}
+
 	if time.Since(sg.lastCleanupCall) > forceCleanupInterval && sg.lastCleanupCall.Before(sg.lastCompactionCall) {
-		return cleanup() || compact()
+		return cleanup() || compact() || rekey() || tier()
 	}
-	return compact() || cleanup()
+	return compact() || cleanup() || rekey() || tier()
 }
 
 func (sg *SegmentGroup) Len() int {
@@ -14,7 +14,6 @@ package lsmkv
 import (
 	"encoding/binary"
 	"fmt"
-	"os"
 	"path/filepath"
 	"strconv"
 	"time"
@@ -467,6 +466,9 @@ func (c *segmentCleanerCommon) cleanupOnce(shouldAbort cyclemanager.ShouldAbortC
 		}
 
 		oldSegment := segments[candidateIdx]
+		if err := loadSegments([]Segment{oldSegment}); err != nil {
+			return false, err
+		}
 		segmentId := segmentID(oldSegment.getPath())
 		var filename string
 		if c.sg.writeSegmentInfoIntoFileName {
@@ -500,7 +502,7 @@ func (c *segmentCleanerCommon) cleanupOnce(shouldAbort cyclemanager.ShouldAbortC
 			}
 		}()
 
-		file, err := os.Create(tmpSegmentPath)
+		file, err := c.sg.encryption.Create(tmpSegmentPath)
 		if err != nil {
 			return false, err
 		}
@@ -509,7 +511,8 @@ func (c *segmentCleanerCommon) cleanupOnce(shouldAbort cyclemanager.ShouldAbortC
 		case StrategyReplace:
 			c := newSegmentCleanerReplace(file, oldSegment.newCursor(),
 				c.sg.makeKeyExistsOnUpperSegments(segments, startIdx, lastIdx), oldSegment.getLevel(),
-				oldSegment.getSecondaryIndexCount(), scratchSpacePath, c.sg.enableChecksumValidation)
+				oldSegment.getSecondaryIndexCount(), scratchSpacePath, c.sg.enableChecksumValidation,
+				c.sg.compression.get(), c.sg.compression.zstdDictionary())
 			if err = c.do(shouldAbort); err != nil {
 				return false, err
 			}
@@ -13,7 +13,6 @@ package lsmkv
 
 import (
 	"fmt"
-	"os"
 	"path"
 	"path/filepath"
 	"strings"
@@ -328,6 +327,9 @@ func (sg *SegmentGroup) compactOnce() (compacted bool, err error) {
 		left = sg.segments[pair[0]]
 		right = sg.segments[pair[1]]
 	}()
+	if err := loadSegments([]Segment{left, right}); err != nil {
+		return false, err
+	}
 
 	strategy := left.getStrategy()
 	leftPath := left.getPath()
@@ -341,7 +343,7 @@ func (sg *SegmentGroup) compactOnce() (compacted bool, err error) {
 	}
 	path := filepath.Join(sg.dir, filename)
 
-	f, err := os.Create(path)
+	f, err := sg.encryption.Create(path)
 	if err != nil {
 		return false, err
 	}
@@ -358,7 +360,8 @@ func (sg *SegmentGroup) compactOnce() (compacted bool, err error) {
 	case segmentindex.StrategyReplace:
 		c := newCompactorReplace(f, left.newCursor(), right.newCursor(),
 			level, secondaryIndices, scratchSpacePath, cleanupTombstones,
-			sg.enableChecksumValidation, maxNewFileSize, sg.allocChecker)
+			sg.enableChecksumValidation, sg.compression.get(), sg.compression.zstdDictionary(),
+			maxNewFileSize, sg.allocChecker)
 
 		if err := c.do(); err != nil {
 			return false, err
@@ -497,8 +500,10 @@ func (sg *SegmentGroup) preinitializeNewSegment(newPathTmp string, oldPos ...int
 			calcCountNetAdditions:        sg.calcCountNetAdditions,
 			overwriteDerived:             true,
 			enableChecksumValidation:     sg.enableChecksumValidation,
+			encryption:                   sg.encryption,
 			MinMMapSize:                  sg.MinMMapSize,
 			allocChecker:                 sg.allocChecker,
+			zstdDict:                     sg.compression.zstdDictionary(),
 			precomputedCountNetAdditions: &updatedCountNetAdditions,
 			fileList:                     make(map[string]int64), // empty to not check if bloom/cna files already exist
 			writeMetadata:                sg.writeMetadata,
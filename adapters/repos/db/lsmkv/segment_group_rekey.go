@@ -0,0 +1,102 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/usecases/encryption"
+)
+
+// rekeyOnce rewrites a single segment which is plaintext or encrypted with a
+// previous key, so that after a key rotation all segments are eventually
+// encrypted with the current key. It runs in the compaction cycle, whenever
+// neither a compaction nor a cleanup was necessary.
+func (sg *SegmentGroup) rekeyOnce() (bool, error) {
+	if !sg.encryption.Enabled() || sg.isReadyOnly() {
+		return false, nil
+	}
+
+	currentKeyID := sg.encryption.CurrentKeyID()
+	candidateIdx := emptyIdx
+	var candidate Segment
+
+	err := func() error {
+		segments, release := sg.getConsistentViewOfSegments()
+		defer release()
+
+		// the key of a segment never changes while it exists, so the headers
+		// only need to be read once per segment
+		keyIDs := make(map[string]string, len(segments))
+		for i, seg := range segments {
+			if isRemoteSegment(seg) {
+				// would need to be fetched from cold storage, it keeps its
+				// key until it is compacted
+				continue
+			}
+
+			path := seg.getPath()
+			keyID, ok := sg.segmentKeyIDs[path]
+			if !ok {
+				var err error
+				keyID, _, err = encryption.FileKeyID(path)
+				if err != nil {
+					return fmt.Errorf("read encryption header of %q: %w", path, err)
+				}
+			}
+			keyIDs[path] = keyID
+
+			if keyID != currentKeyID && candidateIdx == emptyIdx {
+				candidateIdx = i
+				candidate = seg
+			}
+		}
+		sg.segmentKeyIDs = keyIDs
+		return nil
+	}()
+	if err != nil || candidateIdx == emptyIdx {
+		return false, err
+	}
+
+	var filename string
+	if sg.writeSegmentInfoIntoFileName {
+		filename = "segment-" + segmentID(candidate.getPath()) + segmentExtraInfo(candidate.getLevel(), candidate.getStrategy()) + ".db.tmp"
+	} else {
+		filename = "segment-" + segmentID(candidate.getPath()) + ".db.tmp"
+	}
+	tmpSegmentPath := filepath.Join(sg.dir, filename)
+	path := candidate.getPath()
+
+	start := time.Now()
+	if err := sg.encryption.Copy(path, tmpSegmentPath); err != nil {
+		return false, fmt.Errorf("rewrite segment %q: %w", path, err)
+	}
+
+	if _, err := sg.replaceSegment(candidateIdx, tmpSegmentPath); err != nil {
+		return false, fmt.Errorf("replace rewritten segment: %w", err)
+	}
+	// the rewritten segment takes over the path of the previous one
+	delete(sg.segmentKeyIDs, path)
+
+	sg.logger.WithFields(logrus.Fields{
+		"action":    "lsm_rekey",
+		"path":      sg.dir,
+		"segmentId": segmentID(path),
+		"keyId":     currentKeyID,
+		"took":      time.Since(start),
+	}).Debug("rewrote segment with current encryption key")
+
+	return true, nil
+}
@@ -12,7 +12,11 @@
 package lsmkv
 
 import (
+	"bufio"
 	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
 )
 
 // bufferedKeyAndTombstoneExtractor is a tool to build up the count stats for
@@ -157,3 +161,68 @@ func (e *bufferedKeyAndTombstoneExtractor) flushAndCallback() {
 
 	e.callbackCycle++
 }
+
+// extractKeysAndTombstonesFromReader is the counterpart of the
+// bufferedKeyAndTombstoneExtractor for segments whose data region is not part
+// of their contents, e.g. encrypted segments. The reader must start at the
+// data region and end with it. The keys passed to the callback are only valid
+// until it returns.
+func extractKeysAndTombstonesFromReader(r io.Reader, secondaryIndexCount uint16,
+	callback keyAndTombstoneCallbackFn,
+) error {
+	br := bufio.NewReaderSize(r, 1024*1024)
+	var lenBuf [9]byte
+	var key []byte
+
+	for {
+		// tombstone and value length
+		if _, err := io.ReadFull(br, lenBuf[:9]); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("read node: %w", err)
+		}
+		tombstone := lenBuf[0] == 0x01
+
+		// we're not actually interested in the value, so we can skip it entirely
+		if err := discard(br, binary.LittleEndian.Uint64(lenBuf[1:9])); err != nil {
+			return fmt.Errorf("skip value: %w", err)
+		}
+
+		if _, err := io.ReadFull(br, lenBuf[:4]); err != nil {
+			return fmt.Errorf("read key length: %w", noEOF(err))
+		}
+		keyLen := binary.LittleEndian.Uint32(lenBuf[:4])
+		if uint32(cap(key)) < keyLen {
+			key = make([]byte, keyLen)
+		}
+		key = key[:keyLen]
+		if _, err := io.ReadFull(br, key); err != nil {
+			return fmt.Errorf("read key: %w", noEOF(err))
+		}
+
+		for i := uint16(0); i < secondaryIndexCount; i++ {
+			if _, err := io.ReadFull(br, lenBuf[:4]); err != nil {
+				return fmt.Errorf("read secondary key length: %w", noEOF(err))
+			}
+			if err := discard(br, uint64(binary.LittleEndian.Uint32(lenBuf[:4]))); err != nil {
+				return fmt.Errorf("skip secondary key: %w", err)
+			}
+		}
+
+		callback(key, tombstone)
+	}
+}
+
+func discard(br *bufio.Reader, n uint64) error {
+	_, err := io.CopyN(io.Discard, br, int64(n))
+	return noEOF(err)
+}
+
+// noEOF turns io.EOF within a node into io.ErrUnexpectedEOF
+func noEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
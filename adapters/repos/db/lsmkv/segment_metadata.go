@@ -23,6 +23,7 @@ import (
 
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 	"github.com/weaviate/weaviate/usecases/byteops"
+	"github.com/weaviate/weaviate/usecases/encryption"
 )
 
 const (
@@ -124,7 +125,7 @@ func (s *segment) writeMetadataToDisk(path string, primaryBloom []byte, secondar
 			return err
 		}
 	}
-	return writeWithChecksum(rw, path, s.observeMetaWrite)
+	return writeWithChecksum(s.encryption, rw, path, s.observeMetaWrite)
 }
 
 func (s *segment) recalculateBloomFilters() ([]byte, [][]byte, error) {
@@ -196,7 +197,7 @@ func (s *segment) recalculateSecondaryBloomFilter() ([][]byte, error) {
 }
 
 func (s *segment) loadMetaFromDisk(path string) error {
-	data, err := loadWithChecksum(path, -1, s.metrics.ReadObserver("loadMetadata"))
+	data, err := loadWithChecksum(s.encryption, path, -1, s.metrics.ReadObserver("loadMetadata"))
 	if err != nil {
 		return err
 	}
@@ -281,10 +282,9 @@ func (s *segment) recalcCountNetAdditions(exists existsOnLowerSegmentsFn, precom
 			}
 		}
 
-		extr := newBufferedKeyAndTombstoneExtractor(s.contents, s.dataStartPos,
-			s.dataEndPos, 10e6, s.secondaryIndexCount, cb)
-
-		extr.do()
+		if err := s.extractKeysAndTombstones(cb); err != nil {
+			return nil, fmt.Errorf("extract keys and tombstones: %w", err)
+		}
 
 		if lastErr != nil {
 			return nil, lastErr
@@ -300,8 +300,8 @@ func (s *segment) recalcCountNetAdditions(exists existsOnLowerSegmentsFn, precom
 
 // ReadObjectCountFromMetadataFile reads a .metadata file and returns the count net additions value
 // Returns (count, nil) if successful, (0, error) if the file is invalid or corrupted
-func ReadObjectCountFromMetadataFile(path string) (int64, error) {
-	data, err := loadWithChecksum(path, -1, nil)
+func ReadObjectCountFromMetadataFile(enc *encryption.Encryptor, path string) (int64, error) {
+	data, err := loadWithChecksum(enc, path, -1, nil)
 	if err != nil {
 		return 0, fmt.Errorf("failed to read .metadata file: %w", err)
 	}
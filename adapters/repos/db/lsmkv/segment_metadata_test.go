@@ -254,11 +254,11 @@ func TestReadObjectCountFromMetadataFile(t *testing.T) {
 	rw.WriteUint64(42)              // CNA data
 
 	// Write with checksum
-	err := writeWithChecksum(rw, metadataPath, nil)
+	err := writeWithChecksum(nil, rw, metadataPath, nil)
 	require.NoError(t, err)
 
 	// Test reading the metadata file
-	count, err := ReadObjectCountFromMetadataFile(metadataPath)
+	count, err := ReadObjectCountFromMetadataFile(nil, metadataPath)
 	require.NoError(t, err)
 	require.Equal(t, int64(42), count)
 }
@@ -21,6 +21,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/weaviate/weaviate/usecases/byteops"
+	"github.com/weaviate/weaviate/usecases/encryption"
 )
 
 // ErrInvalidChecksum indicates that the read file should not be trusted. For
@@ -94,10 +95,9 @@ func (s *segment) initCountNetAdditions(exists existsOnLowerSegmentsFn, overwrit
 			}
 		}
 
-		extr := newBufferedKeyAndTombstoneExtractor(s.contents, s.dataStartPos,
-			s.dataEndPos, 10e6, s.secondaryIndexCount, cb)
-
-		extr.do()
+		if err := s.extractKeysAndTombstones(cb); err != nil {
+			return fmt.Errorf("extract keys and tombstones: %w", err)
+		}
 
 		s.countNetAdditions = countNet
 
@@ -114,19 +114,19 @@ func (s *segment) initCountNetAdditions(exists existsOnLowerSegmentsFn, overwrit
 }
 
 func (s *segment) storeCountNetOnDisk() error {
-	return storeCountNetOnDisk(s.countNetPath(), s.countNetAdditions, s.observeMetaWrite)
+	return storeCountNetOnDisk(s.encryption, s.countNetPath(), s.countNetAdditions, s.observeMetaWrite)
 }
 
-func storeCountNetOnDisk(path string, value int, observeWrite diskio.MeteredWriterCallback) error {
+func storeCountNetOnDisk(enc *encryption.Encryptor, path string, value int, observeWrite diskio.MeteredWriterCallback) error {
 	rw := byteops.NewReadWriter(make([]byte, byteops.Uint64Len+byteops.Uint32Len))
 	rw.MoveBufferPositionForward(byteops.Uint32Len) // leave space for checksum
 	rw.WriteUint64(uint64(value))
 
-	return writeWithChecksum(rw, path, observeWrite)
+	return writeWithChecksum(enc, rw, path, observeWrite)
 }
 
 func (s *segment) loadCountNetFromDisk() error {
-	data, err := loadWithChecksum(s.countNetPath(), 12, s.metrics.ReadObserver("netAdditions"))
+	data, err := loadWithChecksum(s.encryption, s.countNetPath(), 12, s.metrics.ReadObserver("netAdditions"))
 	if err != nil {
 		return err
 	}
@@ -138,8 +138,8 @@ func (s *segment) loadCountNetFromDisk() error {
 
 // ReadCountNetAdditionsFile reads a .cna file and returns the count net additions value
 // Returns (count, nil) if successful, (0, error) if the file is invalid or corrupted
-func ReadCountNetAdditionsFile(path string) (int64, error) {
-	data, err := loadWithChecksum(path, 12, nil)
+func ReadCountNetAdditionsFile(enc *encryption.Encryptor, path string) (int64, error) {
+	data, err := loadWithChecksum(enc, path, 12, nil)
 	if err != nil {
 		return 0, fmt.Errorf("failed to read .cna file: %w", err)
 	}
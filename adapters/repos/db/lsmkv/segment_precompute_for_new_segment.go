@@ -28,8 +28,10 @@ func (sg *SegmentGroup) initAndPrecomputeNewSegment(path string) (*segment, erro
 			calcCountNetAdditions:    sg.calcCountNetAdditions,
 			overwriteDerived:         true,
 			enableChecksumValidation: sg.enableChecksumValidation,
+			encryption:               sg.encryption,
 			MinMMapSize:              sg.MinMMapSize,
 			allocChecker:             sg.allocChecker,
+			zstdDict:                 sg.compression.zstdDictionary(),
 			writeMetadata:            sg.writeMetadata,
 		})
 	if err != nil {
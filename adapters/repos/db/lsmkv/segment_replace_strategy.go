@@ -59,7 +59,7 @@ func (s *segment) get(key []byte) ([]byte, error) {
 		return nil, err
 	}
 
-	return v, nil
+	return decompressValue(s.compression, s.zstdDict, nil, v)
 }
 
 func (s *segment) getBySecondary(pos int, key []byte, buffer []byte) ([]byte, []byte, []byte, error) {
@@ -106,6 +106,11 @@ func (s *segment) getBySecondary(pos int, key []byte, buffer []byte) ([]byte, []
 		return nil, nil, nil, err
 	}
 
+	currContent, err = decompressValue(s.compression, s.zstdDict, nil, currContent)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
 	return primaryKey, currContent, contentsCopy, err
 }
 
@@ -0,0 +1,570 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bits-and-blooms/bloom/v3"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/usecases/coldstorage"
+	"github.com/weaviate/weaviate/usecases/encryption"
+)
+
+// RemoteMarkerSuffix is the extension of the files which mark segments as
+// moved to cold storage. The marker is written next to the segment and
+// contains the key of the object and the properties of the segment which
+// are needed without loading it.
+//
+// Segments which were not rewritten for SegmentTiering.MinAge are uploaded
+// in the compaction cycle. The local copy is kept as a cache and removed once
+// the segment was not read for SegmentTiering.CacheTTL. Evicted segments are
+// downloaded again on the first read which is not ruled out by the bloom
+// filter, which stays on local disk like all other derived files.
+const RemoteMarkerSuffix = ".remote"
+
+// SegmentTiering moves segments which are rarely compacted to a cold storage
+// backend, see RemoteMarkerSuffix
+type SegmentTiering struct {
+	Backend coldstorage.Backend
+	// KeyPrefix is prepended to the keys of the objects, it needs to be
+	// unique for every bucket of the cluster
+	KeyPrefix string
+	// MinAge is the time since the last modification of a segment, before it
+	// is moved to cold storage
+	MinAge time.Duration
+	// MinSegmentSize excludes smaller segments, which are cheap to keep
+	MinSegmentSize int64
+	// CacheTTL is the time after the last read, before the local copy of a
+	// segment in cold storage is removed
+	CacheTTL time.Duration
+	// FetchTimeout bounds the download of an evicted segment, the read which
+	// fetches it fails once it is exceeded. defaultFetchTimeout is used if
+	// it is not set.
+	FetchTimeout time.Duration
+}
+
+const defaultFetchTimeout = 5 * time.Minute
+
+func (t *SegmentTiering) fetchTimeout() time.Duration {
+	if t.FetchTimeout > 0 {
+		return t.FetchTimeout
+	}
+	return defaultFetchTimeout
+}
+
+// WithKeyPrefix returns a copy with the elements appended to the key prefix,
+// nil if the tiering is disabled
+func (t *SegmentTiering) WithKeyPrefix(elems ...string) *SegmentTiering {
+	if t == nil {
+		return nil
+	}
+	c := *t
+	c.KeyPrefix = path.Join(append([]string{t.KeyPrefix}, elems...)...)
+	return &c
+}
+
+// objectKey contains the time of the upload, as compactions reuse the name
+// of the right segment for the compacted segment
+func (t *SegmentTiering) objectKey(segmentPath string) string {
+	return path.Join(t.KeyPrefix, filepath.Base(segmentPath)) + "." +
+		strconv.FormatInt(time.Now().UnixNano(), 10)
+}
+
+type remoteSegmentMarker struct {
+	Key                 string                `json:"key"`
+	Size                int64                 `json:"size"`
+	Level               uint16                `json:"level"`
+	Strategy            segmentindex.Strategy `json:"strategy"`
+	SecondaryIndexCount uint16                `json:"secondaryIndexCount"`
+	CountNetAdditions   int                   `json:"countNetAdditions"`
+}
+
+func remoteMarkerPath(segmentPath string) string {
+	return segmentPath + RemoteMarkerSuffix
+}
+
+func readRemoteMarker(path string) (remoteSegmentMarker, error) {
+	var marker remoteSegmentMarker
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return marker, err
+	}
+	if err := json.Unmarshal(data, &marker); err != nil {
+		return marker, fmt.Errorf("parse remote segment marker %q: %w", path, err)
+	}
+	return marker, nil
+}
+
+func writeRemoteMarker(path string, marker remoteSegmentMarker) error {
+	data, err := json.Marshal(marker)
+	if err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return err
+	}
+	return diskio.Fsync(filepath.Dir(path))
+}
+
+// remoteSegment holds the state of a lazySegment whose contents are in cold
+// storage
+type remoteSegment struct {
+	marker remoteSegmentMarker
+	// nil if the tiering was disabled after the segment was moved, the
+	// segment can only be read while the local copy exists then
+	tiering *SegmentTiering
+	logger  logrus.FieldLogger
+	// cancelled when the segment group is shut down, so that no reader waits
+	// for a stalled fetch
+	ctx context.Context
+
+	// refs of the consistent views, guarded by the segmentRefCounterLock of
+	// the segment group like the refs of the segments. The local copy is
+	// only evicted if there are none.
+	refCount int
+	lastRead atomic.Int64
+
+	bloomOnce   sync.Once
+	bloomFilter *bloom.BloomFilter
+	// encryption of the bloom filter files
+	encryption *encryption.Encryptor
+}
+
+func (r *remoteSegment) touch() {
+	r.lastRead.Store(time.Now().UnixNano())
+}
+
+func (r *remoteSegment) idleFor() time.Duration {
+	return time.Since(time.Unix(0, r.lastRead.Load()))
+}
+
+// fetch downloads the segment, unless the local copy still exists. It is
+// called with the lock of the lazySegment held, the timeout bounds how long
+// concurrent reads of the segment wait for it.
+func (r *remoteSegment) fetch(segmentPath string) error {
+	if ok, err := fileExists(segmentPath); err != nil {
+		return err
+	} else if ok {
+		return nil
+	}
+	if r.tiering == nil {
+		return fmt.Errorf("segment is in cold storage as %q, but segment tiering is not configured",
+			r.marker.Key)
+	}
+
+	ctx, cancel := context.WithTimeout(r.ctx, r.tiering.fetchTimeout())
+	defer cancel()
+
+	start := time.Now()
+	if err := r.tiering.Backend.Download(ctx, r.marker.Key, segmentPath); err != nil {
+		return fmt.Errorf("fetch segment from cold storage: %w", err)
+	}
+	r.logger.WithFields(logrus.Fields{
+		"action": "lsm_segment_tiering_fetch",
+		"path":   segmentPath,
+		"key":    r.marker.Key,
+		"size":   r.marker.Size,
+		"took":   time.Since(start),
+	}).Debug("fetched segment from cold storage")
+	return nil
+}
+
+// primaryBloomFilter is read from the derived files of the segment, which are
+// never evicted. It is nil if the bucket does not use bloom filters.
+func (r *remoteSegment) primaryBloomFilter(segmentPath string) *bloom.BloomFilter {
+	r.bloomOnce.Do(func() {
+		stub := &segment{
+			path:                segmentPath,
+			secondaryIndexCount: r.marker.SecondaryIndexCount,
+			encryption:          r.encryption,
+		}
+		primary, _, _, err := stub.readBloomFilters()
+		if err != nil {
+			r.logger.WithField("action", "lsm_segment_tiering_bloom_filter").
+				WithField("path", segmentPath).
+				WithError(err).
+				Warn("reading bloom filter of segment in cold storage failed, reads will fetch the segment")
+			return
+		}
+		r.bloomFilter = primary
+	})
+	return r.bloomFilter
+}
+
+// drop deletes the object once the segment was replaced, the marker is kept
+// if it fails, so that deleting is retried on the next startup
+func (r *remoteSegment) drop(segmentPath string) error {
+	markerPath := remoteMarkerPath(segmentPath) + DeleteMarkerSuffix
+	if r.tiering != nil {
+		if err := r.tiering.Backend.Delete(context.Background(), r.marker.Key); err != nil {
+			return fmt.Errorf("delete segment from cold storage: %w", err)
+		}
+	}
+	if err := os.Remove(markerPath); err != nil {
+		return fmt.Errorf("drop previously marked remote segment marker: %w", err)
+	}
+	return nil
+}
+
+// notInRemoteSegment rules out keys with the bloom filter, without fetching
+// an evicted segment
+func (s *lazySegment) notInRemoteSegment(key []byte) bool {
+	if s.remote == nil || s.loaded.Load() {
+		return false
+	}
+	bf := s.remote.primaryBloomFilter(s.path)
+	return bf != nil && !bf.Test(key)
+}
+
+// evict closes the segment and removes the local copy. The caller needs to
+// make sure that it is not read concurrently.
+func (s *lazySegment) evict() error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if s.segment == nil {
+		return nil
+	}
+	if err := s.segment.close(); err != nil {
+		return err
+	}
+	s.loaded.Store(false)
+	s.segment = nil
+	if err := os.Remove(s.path); err != nil {
+		return fmt.Errorf("remove local copy: %w", err)
+	}
+	return nil
+}
+
+func isRemoteSegment(seg Segment) bool {
+	lazy, ok := seg.(*lazySegment)
+	return ok && lazy.remote != nil
+}
+
+// derivedFileList lists the files of the segment, so that its bloom filters
+// and net additions are read instead of recomputed when it is fetched again
+func derivedFileList(segmentPath string) (map[string]int64, error) {
+	entries, err := os.ReadDir(filepath.Dir(segmentPath))
+	if err != nil {
+		return nil, err
+	}
+	prefix := strings.TrimSuffix(filepath.Base(segmentPath), filepath.Ext(segmentPath)) + "."
+	files := map[string]int64{}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasPrefix(entry.Name(), prefix) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, err
+		}
+		files[entry.Name()] = info.Size()
+	}
+	return files, nil
+}
+
+func (sg *SegmentGroup) remoteSegmentConfig() segmentConfig {
+	return segmentConfig{
+		mmapContents:             sg.mmapContents,
+		useBloomFilter:           sg.useBloomFilter,
+		calcCountNetAdditions:    sg.calcCountNetAdditions,
+		overwriteDerived:         false,
+		enableChecksumValidation: sg.enableChecksumValidation,
+		encryption:               sg.encryption,
+		MinMMapSize:              sg.MinMMapSize,
+		allocChecker:             sg.allocChecker,
+		zstdDict:                 sg.compression.zstdDictionary(),
+		writeMetadata:            sg.writeMetadata,
+	}
+}
+
+// initRemoteSegment initializes a segment in cold storage from its marker,
+// the local copy is only fetched when it is read
+func (sg *SegmentGroup) initRemoteSegment(segmentPath string, existsLower existsOnLowerSegmentsFn,
+) (*lazySegment, error) {
+	marker, err := readRemoteMarker(remoteMarkerPath(segmentPath))
+	if err != nil {
+		return nil, err
+	}
+	return sg.newRemoteSegment(segmentPath, marker, nil, existsLower), nil
+}
+
+func (sg *SegmentGroup) newRemoteSegment(segmentPath string, marker remoteSegmentMarker,
+	loaded *segment, existsLower existsOnLowerSegmentsFn,
+) *lazySegment {
+	s := &lazySegment{
+		path:        segmentPath,
+		size:        marker.Size,
+		logger:      sg.logger,
+		metrics:     sg.metrics,
+		existsLower: existsLower,
+		cfg:         sg.remoteSegmentConfig(),
+		remote: &remoteSegment{
+			marker:     marker,
+			tiering:    sg.segmentTiering,
+			logger:     sg.logger,
+			ctx:        sg.fetchCtx,
+			encryption: sg.encryption,
+		},
+	}
+	s.remote.touch()
+	if loaded != nil {
+		s.segment = loaded
+		s.loaded.Store(true)
+	}
+	return s
+}
+
+// deleteRemoteObject deletes the object of a marker of a segment which was
+// dropped, errors are only logged as it is called on startup
+func (sg *SegmentGroup) deleteRemoteObject(markerPath string) {
+	logger := sg.logger.WithField("action", "lsm_segment_tiering_delete").
+		WithField("path", markerPath)
+
+	marker, err := readRemoteMarker(markerPath)
+	if err != nil {
+		logger.WithError(err).Warn("reading marker of dropped remote segment failed")
+		return
+	}
+	if sg.segmentTiering == nil {
+		logger.WithField("key", marker.Key).
+			Warn("segment tiering is not configured, object of dropped remote segment is not deleted")
+		return
+	}
+	if err := sg.segmentTiering.Backend.Delete(context.Background(), marker.Key); err != nil {
+		logger.WithField("key", marker.Key).WithError(err).
+			Warn("deleting object of dropped remote segment failed")
+	}
+}
+
+// tierOnce evicts the local copies of segments in cold storage which were
+// not read for a while and moves at most one segment to cold storage
+func (sg *SegmentGroup) tierOnce() (bool, error) {
+	if sg.segmentTiering == nil || sg.isReadyOnly() {
+		return false, nil
+	}
+
+	evicted, err := sg.evictIdleSegments()
+	if err != nil {
+		return evicted, err
+	}
+	offloaded, err := sg.offloadOnce()
+	return evicted || offloaded, err
+}
+
+func (sg *SegmentGroup) evictIdleSegments() (bool, error) {
+	sg.maintenanceLock.Lock()
+	defer sg.maintenanceLock.Unlock()
+
+	// no new consistent views can be created while the maintenance lock is
+	// held, the ref counter lock protects the refs of the existing ones
+	sg.segmentRefCounterLock.Lock()
+	defer sg.segmentRefCounterLock.Unlock()
+
+	evicted := false
+	for _, seg := range sg.segments {
+		lazy, ok := seg.(*lazySegment)
+		if !ok || lazy.remote == nil || !lazy.loaded.Load() {
+			continue
+		}
+		if lazy.remote.idleFor() < sg.segmentTiering.CacheTTL || lazy.getRefs() > 0 {
+			continue
+		}
+
+		if err := lazy.evict(); err != nil {
+			return evicted, fmt.Errorf("evict segment %q: %w", lazy.path, err)
+		}
+		evicted = true
+		sg.logger.WithField("action", "lsm_segment_tiering_evict").
+			WithField("path", lazy.path).
+			Debug("removed local copy of segment in cold storage")
+	}
+	return evicted, nil
+}
+
+// fetchRemoteSegments fetches the local copies of all segments in cold
+// storage, so that backups contain the complete segments
+func (sg *SegmentGroup) fetchRemoteSegments() error {
+	sg.maintenanceLock.RLock()
+	defer sg.maintenanceLock.RUnlock()
+
+	for _, seg := range sg.segments {
+		if !isRemoteSegment(seg) {
+			continue
+		}
+		lazy := seg.(*lazySegment)
+		if err := lazy.load(); err != nil {
+			return fmt.Errorf("fetch segment %q: %w", lazy.path, err)
+		}
+	}
+	return nil
+}
+
+// offloadOnce uploads the oldest segment which is eligible. The latest segment
+// is never moved, as it is the most likely one to be compacted next.
+func (sg *SegmentGroup) offloadOnce() (bool, error) {
+	tiering := sg.segmentTiering
+
+	var candidate Segment
+	sg.maintenanceLock.RLock()
+	for i := 0; i < len(sg.segments)-1; i++ {
+		seg := sg.segments[i]
+		if isRemoteSegment(seg) || seg.Size() < tiering.MinSegmentSize {
+			continue
+		}
+		info, err := os.Stat(seg.getPath())
+		if err != nil || time.Since(info.ModTime()) < tiering.MinAge {
+			continue
+		}
+		candidate = seg
+		break
+	}
+	sg.maintenanceLock.RUnlock()
+
+	if candidate == nil {
+		return false, nil
+	}
+
+	// segments are only replaced by compactions and cleanups, which run in the
+	// same cycle, so the candidate stays in the segment group while it is
+	// uploaded
+	segmentPath := candidate.getPath()
+	marker := remoteSegmentMarker{
+		Key:                 tiering.objectKey(segmentPath),
+		Size:                candidate.Size(),
+		Level:               candidate.getLevel(),
+		Strategy:            candidate.getStrategy(),
+		SecondaryIndexCount: candidate.getSecondaryIndexCount(),
+		CountNetAdditions:   candidate.getCountNetAdditions(),
+	}
+
+	start := time.Now()
+	if err := tiering.Backend.Upload(context.Background(), marker.Key, segmentPath); err != nil {
+		return false, fmt.Errorf("move segment %q to cold storage: %w", segmentPath, err)
+	}
+	// from here on the segment is read from cold storage after a restart
+	if err := writeRemoteMarker(remoteMarkerPath(segmentPath), marker); err != nil {
+		if err := tiering.Backend.Delete(context.Background(), marker.Key); err != nil {
+			sg.logger.WithField("action", "lsm_segment_tiering_offload").
+				WithField("key", marker.Key).
+				WithError(err).
+				Warn("deleting object of segment which could not be marked as remote failed")
+		}
+		return false, fmt.Errorf("write remote marker of segment %q: %w", segmentPath, err)
+	}
+
+	sg.maintenanceLock.Lock()
+	defer sg.maintenanceLock.Unlock()
+
+	for i, seg := range sg.segments {
+		if seg != candidate {
+			continue
+		}
+
+		// consistent views of the previous instance keep reading the loaded
+		// segment, the local copy is only evicted once their refs are
+		// released, see lazySegment.getRefs
+		var loaded *segment
+		existsLower := sg.makeExistsOn(nil)
+		switch s := seg.(type) {
+		case *segment:
+			loaded = s
+		case *lazySegment:
+			s.mux.Lock()
+			loaded = s.segment
+			s.mux.Unlock()
+			existsLower = s.existsLower
+		}
+		sg.segments[i] = sg.newRemoteSegment(segmentPath, marker, loaded, existsLower)
+
+		sg.logger.WithFields(logrus.Fields{
+			"action": "lsm_segment_tiering_offload",
+			"path":   segmentPath,
+			"key":    marker.Key,
+			"size":   marker.Size,
+			"took":   time.Since(start),
+		}).Debug("moved segment to cold storage")
+		return true, nil
+	}
+
+	return false, fmt.Errorf("segment %q was replaced while moving it to cold storage", segmentPath)
+}
+
+// DeleteRemoteSegments deletes the objects of all segments below dir which
+// were moved to cold storage, it is called before the directory of a shard is
+// deleted
+func DeleteRemoteSegments(ctx context.Context, dir string, tiering *SegmentTiering) error {
+	if tiering == nil {
+		return nil
+	}
+
+	return filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		if !strings.HasSuffix(p, RemoteMarkerSuffix) &&
+			!strings.HasSuffix(p, RemoteMarkerSuffix+DeleteMarkerSuffix) {
+			return nil
+		}
+
+		marker, err := readRemoteMarker(p)
+		if err != nil {
+			return err
+		}
+		if err := tiering.Backend.Delete(ctx, marker.Key); err != nil {
+			return fmt.Errorf("delete %q: %w", p, err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,326 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/usecases/coldstorage"
+)
+
+func TestSegmentTiering(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	dir := t.TempDir()
+	storageDir := t.TempDir()
+	backend, err := coldstorage.NewFilesystem(storageDir)
+	require.NoError(t, err)
+	tiering := &SegmentTiering{Backend: backend}
+	tiering = tiering.WithKeyPrefix("node1", "objects")
+
+	key := func(i int) []byte { return []byte(fmt.Sprintf("key-%03d", i)) }
+	value := func(i int) []byte { return []byte(fmt.Sprintf("value-%03d", i)) }
+
+	open := func() *Bucket {
+		b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(StrategyReplace),
+			WithCalcCountNetAdditions(true),
+			WithSegmentTiering(tiering))
+		require.NoError(t, err)
+		b.SetMemtableThreshold(1e9)
+		return b
+	}
+
+	verify := func(t *testing.T, b *Bucket) {
+		for i := 0; i < 30; i++ {
+			v, err := b.Get(key(i))
+			require.NoError(t, err)
+			assert.Equal(t, value(i), v)
+		}
+		count, err := b.Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 30, count)
+	}
+
+	// evicts the local copies of all segments in cold storage as the cache
+	// ttl is 0
+	tierAll := func(t *testing.T, b *Bucket) {
+		for {
+			tiered, err := b.disk.tierOnce()
+			require.NoError(t, err)
+			if !tiered {
+				return
+			}
+		}
+	}
+
+	objects := func(t *testing.T) []string {
+		var keys []string
+		err := filepath.WalkDir(storageDir, func(p string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			rel, err := filepath.Rel(storageDir, p)
+			keys = append(keys, filepath.ToSlash(rel))
+			return err
+		})
+		require.NoError(t, err)
+		return keys
+	}
+
+	segmentFiles := func(t *testing.T, ext string) []string {
+		matches, err := filepath.Glob(filepath.Join(dir, "segment-*"+ext))
+		require.NoError(t, err)
+		return matches
+	}
+
+	b := open()
+	for s := 0; s < 3; s++ {
+		for i := s * 10; i < (s+1)*10; i++ {
+			require.NoError(t, b.Put(key(i), value(i)))
+		}
+		require.NoError(t, b.FlushAndSwitch())
+	}
+
+	t.Run("offload and evict all but the latest segment", func(t *testing.T) {
+		tierAll(t, b)
+
+		require.Len(t, objects(t), 2)
+		for _, k := range objects(t) {
+			assert.True(t, strings.HasPrefix(k, "node1/objects/segment-"), k)
+		}
+		assert.Len(t, segmentFiles(t, RemoteMarkerSuffix), 2)
+		assert.Len(t, segmentFiles(t, ".db"), 1)
+		// the derived files stay on local disk
+		assert.Len(t, segmentFiles(t, ".bloom"), 3)
+	})
+
+	t.Run("keys not in the bloom filter do not fetch", func(t *testing.T) {
+		missing := 0
+		for i := 100; i < 200; i++ {
+			rules := true
+			for _, seg := range b.disk.segments {
+				if isRemoteSegment(seg) && !seg.(*lazySegment).notInRemoteSegment(key(i)) {
+					rules = false
+				}
+			}
+			if !rules {
+				continue
+			}
+			v, err := b.Get(key(i))
+			require.NoError(t, err)
+			assert.Nil(t, v)
+			missing++
+		}
+		require.Greater(t, missing, 0)
+		assert.Len(t, segmentFiles(t, ".db"), 1)
+	})
+
+	t.Run("reads fetch evicted segments", func(t *testing.T) {
+		verify(t, b)
+		assert.Len(t, segmentFiles(t, ".db"), 3)
+
+		tierAll(t, b)
+		assert.Len(t, segmentFiles(t, ".db"), 1)
+	})
+
+	t.Run("restart with evicted segments", func(t *testing.T) {
+		require.NoError(t, b.Shutdown(ctx))
+
+		strategy, err := DetermineUnloadedBucketStrategy(dir)
+		require.NoError(t, err)
+		assert.Equal(t, StrategyReplace, strategy)
+
+		b = open()
+		assert.Len(t, segmentFiles(t, ".db"), 1)
+		verify(t, b)
+	})
+
+	t.Run("backups contain the fetched segments", func(t *testing.T) {
+		tierAll(t, b)
+
+		files, err := b.ListFiles(ctx, "bucket")
+		require.NoError(t, err)
+		dbFiles := 0
+		for _, f := range files {
+			assert.NotContains(t, f, RemoteMarkerSuffix)
+			if filepath.Ext(f) == ".db" {
+				dbFiles++
+			}
+		}
+		assert.Equal(t, 3, dbFiles)
+		assert.Len(t, segmentFiles(t, ".db"), 3)
+	})
+
+	t.Run("compaction deletes the objects of the replaced segments", func(t *testing.T) {
+		tierAll(t, b)
+		compacted, err := b.disk.compactOnce()
+		require.NoError(t, err)
+		require.True(t, compacted)
+
+		assert.Empty(t, objects(t))
+		assert.Empty(t, segmentFiles(t, RemoteMarkerSuffix))
+		assert.Empty(t, segmentFiles(t, RemoteMarkerSuffix+DeleteMarkerSuffix))
+		verify(t, b)
+	})
+
+	t.Run("delete remote segments of a dropped shard", func(t *testing.T) {
+		for i := 30; i < 40; i++ {
+			require.NoError(t, b.Put(key(i), value(i)))
+		}
+		require.NoError(t, b.FlushAndSwitch())
+		tierAll(t, b)
+		require.NotEmpty(t, objects(t))
+		require.NoError(t, b.Shutdown(ctx))
+
+		require.NoError(t, DeleteRemoteSegments(ctx, filepath.Dir(dir), tiering))
+		assert.Empty(t, objects(t))
+	})
+}
+
+// failingBackend fails or blocks downloads until they are enabled again
+type failingBackend struct {
+	coldstorage.Backend
+	fail  atomic.Bool
+	block atomic.Bool
+}
+
+func (f *failingBackend) Download(ctx context.Context, key, path string) error {
+	if f.block.Load() {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	if f.fail.Load() {
+		return fmt.Errorf("download %q: %w", key, coldstorage.ErrNotFound)
+	}
+	return f.Backend.Download(ctx, key, path)
+}
+
+func TestSegmentTieringFetchFails(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	dir := t.TempDir()
+	storage, err := coldstorage.NewFilesystem(t.TempDir())
+	require.NoError(t, err)
+	backend := &failingBackend{Backend: storage}
+	tiering := &SegmentTiering{Backend: backend, FetchTimeout: 50 * time.Millisecond}
+
+	b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategyReplace),
+		WithSegmentTiering(tiering))
+	require.NoError(t, err)
+	defer b.Shutdown(ctx)
+	b.SetMemtableThreshold(1e9)
+
+	key := func(i int) []byte { return []byte(fmt.Sprintf("key-%03d", i)) }
+	for s := 0; s < 2; s++ {
+		for i := s * 10; i < (s+1)*10; i++ {
+			require.NoError(t, b.Put(key(i), key(i)))
+		}
+		require.NoError(t, b.FlushAndSwitch())
+	}
+
+	// moves the older segment and evicts its local copy
+	tierAll := func(t *testing.T) {
+		for {
+			tiered, err := b.disk.tierOnce()
+			require.NoError(t, err)
+			if !tiered {
+				break
+			}
+		}
+		require.True(t, isRemoteSegment(b.disk.segments[0]))
+		require.False(t, b.disk.segments[0].(*lazySegment).isLoaded())
+	}
+	tierAll(t)
+
+	assertReadsFail := func(t *testing.T, expectedErr error) {
+		_, err := b.Get(key(0))
+		assert.ErrorIs(t, err, expectedErr)
+
+		c := b.Cursor()
+		k, _ := c.First()
+		assert.Nil(t, k)
+		assert.ErrorIs(t, c.Err(), expectedErr)
+		c.Close()
+
+		// keys of the local segment are still read
+		v, err := b.Get(key(10))
+		require.NoError(t, err)
+		assert.Equal(t, key(10), v)
+	}
+
+	t.Run("missing object", func(t *testing.T) {
+		backend.fail.Store(true)
+		defer backend.fail.Store(false)
+
+		assertReadsFail(t, coldstorage.ErrNotFound)
+	})
+
+	t.Run("stalled backend", func(t *testing.T) {
+		backend.block.Store(true)
+		defer backend.block.Store(false)
+
+		assertReadsFail(t, context.DeadlineExceeded)
+	})
+
+	t.Run("reads succeed once the backend recovered", func(t *testing.T) {
+		v, err := b.Get(key(0))
+		require.NoError(t, err)
+		assert.Equal(t, key(0), v)
+	})
+
+	t.Run("shutdown cancels the fetch", func(t *testing.T) {
+		tierAll(t)
+
+		tiering.FetchTimeout = time.Hour
+		backend.block.Store(true)
+		defer backend.block.Store(false)
+
+		// cancelled by the shutdown of the segment group
+		time.AfterFunc(50*time.Millisecond, b.disk.cancelFetches)
+		_, err := b.Get(key(0))
+		assert.True(t, errors.Is(err, context.Canceled), err)
+	})
+}
+
+func TestDetermineUnloadedBucketStrategyOfRemoteSegment(t *testing.T) {
+	dir := t.TempDir()
+	marker := remoteSegmentMarker{Key: "segment-1.db.1", Strategy: segmentindex.StrategyMapCollection}
+	require.NoError(t, writeRemoteMarker(filepath.Join(dir, "segment-1.db"+RemoteMarkerSuffix), marker))
+
+	strategy, err := DetermineUnloadedBucketStrategy(dir)
+	require.NoError(t, err)
+	assert.Equal(t, StrategyMapCollection, strategy)
+
+	_, err = os.Stat(filepath.Join(dir, "segment-1.db"))
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+}
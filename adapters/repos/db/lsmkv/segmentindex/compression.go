@@ -0,0 +1,48 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package segmentindex
+
+import "fmt"
+
+// Compression is the algorithm the values of a segment are compressed with.
+// It is stored in the upper byte of the header version, segments written
+// before compression was introduced therefore have CompressionNone.
+type Compression uint8
+
+const (
+	CompressionNone Compression = iota
+	CompressionZstd
+	CompressionSnappy
+)
+
+// consistent labels with adapters/repos/db/lsmkv/compression.go
+func (c Compression) String() string {
+	switch c {
+	case CompressionNone:
+		return "none"
+	case CompressionZstd:
+		return "zstd"
+	case CompressionSnappy:
+		return "snappy"
+	default:
+		return "n/a"
+	}
+}
+
+func CheckExpectedCompression(compression Compression) error {
+	switch compression {
+	case CompressionNone, CompressionZstd, CompressionSnappy:
+		return nil
+	default:
+		return fmt.Errorf("unsupported compression %d", compression)
+	}
+}
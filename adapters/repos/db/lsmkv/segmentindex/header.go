@@ -23,9 +23,9 @@ import (
 
 const (
 	// HeaderSize describes the general offset in a segment until the data
-	// starts, it is composed of 2 bytes for level, 2 bytes for version,
-	// 2 bytes for secondary index count, 2 bytes for strategy, 8 bytes
-	// for the pointer to the index part
+	// starts, it is composed of 2 bytes for level, 2 bytes for version (the
+	// upper byte holds the compression), 2 bytes for secondary index count,
+	// 2 bytes for strategy, 8 bytes for the pointer to the index part
 	HeaderSize = 16
 
 	// ChecksumSize describes the length of the segment file checksum.
@@ -39,13 +39,14 @@ type Header struct {
 	SecondaryIndices uint16
 	Strategy         Strategy
 	IndexStart       uint64
+	Compression      Compression
 }
 
 func (h *Header) WriteTo(w io.Writer) (int64, error) {
 	data := make([]byte, HeaderSize)
 	rw := byteops.NewReadWriter(data)
 	rw.WriteUint16(h.Level)
-	rw.WriteUint16(h.Version)
+	rw.WriteUint16(h.Version | uint16(h.Compression)<<8)
 	rw.WriteUint16(h.SecondaryIndices)
 	rw.WriteUint16(uint16(h.Strategy))
 	rw.WriteUint64(h.IndexStart)
@@ -121,7 +122,9 @@ func ParseHeader(data []byte) (*Header, error) {
 	rw := byteops.NewReadWriter(data)
 	out := &Header{}
 	out.Level = rw.ReadUint16()
-	out.Version = rw.ReadUint16()
+	version := rw.ReadUint16()
+	out.Version = version & 0xff
+	out.Compression = Compression(version >> 8)
 	out.SecondaryIndices = rw.ReadUint16()
 	out.Strategy = Strategy(rw.ReadUint16())
 	out.IndexStart = rw.ReadUint64()
@@ -129,6 +132,9 @@ func ParseHeader(data []byte) (*Header, error) {
 	if out.Version > CurrentSegmentVersion {
 		return nil, fmt.Errorf("unsupported version %d", out.Version)
 	}
+	if err := CheckExpectedCompression(out.Compression); err != nil {
+		return nil, err
+	}
 
 	return out, nil
 }
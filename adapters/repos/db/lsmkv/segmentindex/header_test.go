@@ -12,6 +12,7 @@
 package segmentindex
 
 import (
+	"bytes"
 	"os"
 	"testing"
 
@@ -44,3 +45,37 @@ func BenchmarkWriteHeader(b *testing.B) {
 		header.WriteTo(f)
 	}
 }
+
+func TestHeaderCompression(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		header := Header{
+			Version:          SegmentV1,
+			Level:            3,
+			SecondaryIndices: 1,
+			Strategy:         StrategyReplace,
+			IndexStart:       234,
+			Compression:      CompressionZstd,
+		}
+		buf := bytes.NewBuffer(nil)
+		_, err := header.WriteTo(buf)
+		require.NoError(t, err)
+
+		parsed, err := ParseHeader(buf.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, header, *parsed)
+	})
+
+	t.Run("segments without compression", func(t *testing.T) {
+		data := []byte{0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+		parsed, err := ParseHeader(data)
+		require.NoError(t, err)
+		require.Equal(t, SegmentV1, parsed.Version)
+		require.Equal(t, CompressionNone, parsed.Compression)
+	})
+
+	t.Run("unknown compression", func(t *testing.T) {
+		data := []byte{0, 0, 1, 9, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+		_, err := ParseHeader(data)
+		require.Error(t, err)
+	})
+}
@@ -32,6 +32,7 @@ import (
 	entsentry "github.com/weaviate/weaviate/entities/sentry"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	wsync "github.com/weaviate/weaviate/entities/sync"
+	"github.com/weaviate/weaviate/usecases/encryption"
 )
 
 var ErrAlreadyClosed = errors.New("store already closed")
@@ -52,6 +53,8 @@ type Store struct {
 
 	cycleCallbacks *storeCycleCallbacks
 	bcreator       BucketCreator
+	encryption     *encryption.Encryptor
+	segmentTiering *SegmentTiering
 	// Prevent concurrent manipulations to the same Bucket, specially if there is
 	// action on the bucket in the meantime.
 	bucketsLocks *wsync.KeyLocker
@@ -81,6 +84,18 @@ func New(dir, rootDir string, logger logrus.FieldLogger, metrics *Metrics,
 	return s, s.init()
 }
 
+// SetEncryption enables the encryption at rest for all buckets which are
+// created or loaded afterwards
+func (s *Store) SetEncryption(enc *encryption.Encryptor) {
+	s.encryption = enc
+}
+
+// SetSegmentTiering enables moving cold segments to object storage for all
+// buckets which are created or loaded afterwards
+func (s *Store) SetSegmentTiering(tiering *SegmentTiering) {
+	s.segmentTiering = tiering
+}
+
 func (s *Store) Bucket(name string) *Bucket {
 	s.bucketAccessLock.RLock()
 	defer s.bucketAccessLock.RUnlock()
@@ -187,7 +202,7 @@ func (s *Store) CreateOrLoadBucket(ctx context.Context, bucketName string,
 	// bucket can be concurrently loaded with another buckets but
 	// the same bucket will be loaded only once
 	b, err := s.bcreator.NewBucket(ctx, s.bucketDir(bucketName), s.rootDir, s.logger, s.metrics,
-		compactionCallbacks, s.cycleCallbacks.flushCallbacks, opts...)
+		compactionCallbacks, s.cycleCallbacks.flushCallbacks, s.bucketOptions(bucketName, opts)...)
 	if err != nil {
 		return err
 	}
@@ -197,6 +212,18 @@ func (s *Store) CreateOrLoadBucket(ctx context.Context, bucketName string,
 	return nil
 }
 
+// bucketOptions prepends the options which apply to all buckets of the store
+func (s *Store) bucketOptions(bucketName string, opts []BucketOption) []BucketOption {
+	var storeOpts []BucketOption
+	if s.encryption != nil {
+		storeOpts = append(storeOpts, WithEncryption(s.encryption))
+	}
+	if s.segmentTiering != nil {
+		storeOpts = append(storeOpts, WithSegmentTiering(s.segmentTiering.WithKeyPrefix(bucketName)))
+	}
+	return append(storeOpts, opts...)
+}
+
 func (s *Store) setBucket(name string, b *Bucket) {
 	s.bucketAccessLock.Lock()
 	defer s.bucketAccessLock.Unlock()
@@ -434,6 +461,9 @@ func (s *Store) CreateBucket(ctx context.Context, bucketName string,
 	}
 
 	bucketDir := s.bucketDir(bucketName)
+	if err := DeleteRemoteSegments(ctx, bucketDir, s.segmentTiering); err != nil {
+		return errors.Wrapf(err, "failed removing bucket %s segments in cold storage", bucketName)
+	}
 	if err := os.RemoveAll(bucketDir); err != nil {
 		return errors.Wrapf(err, "failed removing bucket %s files", bucketName)
 	}
@@ -444,7 +474,7 @@ func (s *Store) CreateBucket(ctx context.Context, bucketName string,
 	}
 
 	b, err := s.bcreator.NewBucket(ctx, bucketDir, s.rootDir, s.logger, s.metrics,
-		compactionCallbacks, s.cycleCallbacks.flushCallbacks, opts...)
+		compactionCallbacks, s.cycleCallbacks.flushCallbacks, s.bucketOptions(bucketName, opts)...)
 	if err != nil {
 		return err
 	}
@@ -540,6 +570,9 @@ func (s *Store) ReplaceBuckets(ctx context.Context, bucketName, replacementBucke
 	s.updateBucketDir(bucket, currBucketDir, newBucketDir)
 	s.updateBucketDir(replacementBucket, currReplacementBucketDir, newReplacementBucketDir)
 
+	if err := DeleteRemoteSegments(ctx, newBucketDir, s.segmentTiering); err != nil {
+		return errors.Wrapf(err, "failed removing segments of '%s' in cold storage", newBucketDir)
+	}
 	if err := os.RemoveAll(newBucketDir); err != nil {
 		return errors.Wrapf(err, "failed removing dir '%s'", newBucketDir)
 	}
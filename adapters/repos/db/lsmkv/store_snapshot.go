@@ -0,0 +1,245 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/storagestate"
+	wsync "github.com/weaviate/weaviate/entities/sync"
+)
+
+// Snapshot pins the memtables and segments of all buckets of the store and
+// returns a read-only store which reads from them, so that reads across
+// buckets see the same point in time.
+//
+// lockWrites is called once and has to block all writes to the store until
+// the returned unlock func is called. While writes are blocked the active
+// memtables are frozen, i.e. switched to flushing, and the segments are
+// pinned. The frozen memtables are not flushed by the snapshot, but by the
+// next flush of the bucket. Only a memtable frozen by a previous snapshot,
+// which is not flushed yet, is flushed before the snapshot is taken, as a
+// bucket holds a single flushing memtable.
+//
+// The returned release func has to be called once the snapshot is no longer
+// read and before the store is shut down, calling it again is a no-op. Compactions and cleanups of the
+// buckets are skipped until then.
+//
+// Tombstones of inverted buckets are merged into existing segments on flush,
+// deletes of those buckets may therefore become visible in the snapshot.
+func (s *Store) Snapshot(lockWrites func() (unlock func())) (*Store, func(), error) {
+	s.closeLock.RLock()
+	defer s.closeLock.RUnlock()
+
+	if s.closed {
+		return nil, nil, ErrAlreadyClosed
+	}
+
+	s.bucketAccessLock.RLock()
+	defer s.bucketAccessLock.RUnlock()
+
+	// buckets are locked in a fixed order, so that concurrent snapshots
+	// can not deadlock
+	names := make([]string, 0, len(s.bucketsByName))
+	for name := range s.bucketsByName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	buckets := make([]*Bucket, len(names))
+	for i, name := range names {
+		buckets[i] = s.bucketsByName[name]
+		buckets[i].flushMux.Lock()
+		defer buckets[i].flushMux.Unlock()
+	}
+
+	for i, b := range buckets {
+		if err := b.flushFrozenMemtable(); err != nil {
+			return nil, nil, fmt.Errorf("snapshot bucket %q: %w", names[i], err)
+		}
+	}
+
+	snapshot := &Store{
+		dir:           s.dir,
+		rootDir:       s.rootDir,
+		bucketsByName: make(map[string]*Bucket, len(names)),
+		logger:        s.logger,
+		metrics:       s.metrics,
+		bcreator:      s.bcreator,
+		encryption:    s.encryption,
+		bucketsLocks:  wsync.NewKeyLocker(),
+	}
+	releases := make([]func(), 0, len(buckets))
+	var releaseOnce sync.Once
+	release := func() {
+		releaseOnce.Do(func() {
+			for _, release := range releases {
+				release()
+			}
+		})
+	}
+
+	err := func() error {
+		unlock := lockWrites()
+		defer unlock()
+
+		for i, b := range buckets {
+			snap, releaseBucket, err := b.snapshot()
+			if err != nil {
+				return fmt.Errorf("snapshot bucket %q: %w", names[i], err)
+			}
+			snapshot.bucketsByName[names[i]] = snap
+			releases = append(releases, releaseBucket)
+		}
+		return nil
+	}()
+	if err != nil {
+		release()
+		return nil, nil, err
+	}
+	return snapshot, release, nil
+}
+
+// flushFrozenMemtable flushes a memtable frozen by a store snapshot which has
+// not been flushed yet. The caller has to hold flushMux.
+func (b *Bucket) flushFrozenMemtable() error {
+	b.flushLock.RLock()
+	frozen := b.flushing != nil
+	b.flushLock.RUnlock()
+	if !frozen {
+		return nil
+	}
+
+	if err := b.flushSwitchedMemtable(time.Now()); err != nil {
+		return fmt.Errorf("flush memtable frozen by snapshot: %w", err)
+	}
+	return nil
+}
+
+// snapshot freezes a non-empty active memtable, so that it is no longer
+// written to, and pins the segments. The frozen memtable becomes the flushing
+// memtable of the bucket and is flushed with the next flush. The caller has
+// to hold flushMux and there must not be a flushing memtable.
+func (b *Bucket) snapshot() (snapshot *Bucket, release func(), err error) {
+	b.flushLock.Lock()
+	defer b.flushLock.Unlock()
+
+	if b.flushing != nil {
+		return nil, nil, fmt.Errorf("memtable of previous flush was not flushed")
+	}
+
+	frozen := b.active
+	if frozen.Size() > 0 {
+		active, err := b.createNewActiveMemtable()
+		if err != nil {
+			return nil, nil, fmt.Errorf("switch active memtable: %w", err)
+		}
+		b.active = active
+		b.flushing = frozen
+	} else {
+		// the active memtable is empty, but may be written to later on
+		if frozen, err = b.createSnapshotMemtable(); err != nil {
+			return nil, nil, fmt.Errorf("create memtable: %w", err)
+		}
+	}
+
+	segments, releaseSegments := b.disk.getConsistentViewOfSegments()
+	b.disk.snapshotPins.Add(1)
+	release = func() {
+		releaseSegments()
+		b.disk.snapshotPins.Add(-1)
+	}
+
+	return b.snapshotBucket(frozen, segments), release, nil
+}
+
+// createSnapshotMemtable creates an empty memtable which is never written to,
+// it neither creates a write-ahead-log nor reports metrics
+func (b *Bucket) createSnapshotMemtable() (memtable, error) {
+	path := filepath.Join(b.dir, fmt.Sprintf("segment-%d", time.Now().UnixNano()))
+
+	cl, err := newLazyCommitLogger(path, b.strategy, b.encryption)
+	if err != nil {
+		return nil, fmt.Errorf("init commit logger: %w", err)
+	}
+
+	mt, err := newMemtable(path, b.strategy, b.secondaryIndices, cl,
+		nil, b.logger, b.enableChecksumValidation, b.bm25Config, b.writeSegmentInfoIntoFileName, b.allocChecker, b.shouldSkipKey)
+	if err != nil {
+		return nil, err
+	}
+	return mt, nil
+}
+
+// snapshotBucket returns a read-only bucket with the given memtable as active
+// memtable and the given segments as disk segments. It neither flushes nor
+// compacts.
+func (b *Bucket) snapshotBucket(active memtable, segments []Segment) *Bucket {
+	return &Bucket{
+		dir:                              b.dir,
+		rootDir:                          b.rootDir,
+		active:                           active,
+		disk:                             b.disk.snapshotSegmentGroup(segments),
+		logger:                           b.logger,
+		memtableThreshold:                b.memtableThreshold,
+		strategy:                         b.strategy,
+		desiredStrategy:                  b.desiredStrategy,
+		secondaryIndices:                 b.secondaryIndices,
+		mmapContents:                     b.mmapContents,
+		legacyMapSortingBeforeCompaction: b.legacyMapSortingBeforeCompaction,
+		status:                           storagestate.StatusReadOnly,
+		metrics:                          b.metrics,
+		keepTombstones:                   b.keepTombstones,
+		useBloomFilter:                   b.useBloomFilter,
+		calcCountNetAdditions:            b.calcCountNetAdditions,
+		enableChecksumValidation:         b.enableChecksumValidation,
+		compression:                      b.compression,
+		encryption:                       b.encryption,
+		bitmapBufPool:                    b.bitmapBufPool,
+		writeSegmentInfoIntoFileName:     b.writeSegmentInfoIntoFileName,
+		bm25Config:                       b.bm25Config,
+		shouldSkipKey:                    b.shouldSkipKey,
+	}
+}
+
+// snapshotSegmentGroup returns a segment group of the given segments, which
+// have to stay referenced by sg while the returned group is read. Buckets of
+// the roaringsetrange strategy read from the segments instead of the
+// segment-in-memory, as the segment-in-memory is not versioned.
+func (sg *SegmentGroup) snapshotSegmentGroup(segments []Segment) *SegmentGroup {
+	return &SegmentGroup{
+		segments:                 segments,
+		segmentsWithRefs:         map[string]Segment{},
+		isSnapshot:               true,
+		dir:                      sg.dir,
+		strategy:                 sg.strategy,
+		logger:                   sg.logger,
+		mapRequiresSorting:       sg.mapRequiresSorting,
+		status:                   storagestate.StatusReadOnly,
+		metrics:                  sg.metrics,
+		mmapContents:             sg.mmapContents,
+		keepTombstones:           sg.keepTombstones,
+		useBloomFilter:           sg.useBloomFilter,
+		calcCountNetAdditions:    sg.calcCountNetAdditions,
+		enableChecksumValidation: sg.enableChecksumValidation,
+		compression:              sg.compression,
+		encryption:               sg.encryption,
+		MinMMapSize:              sg.MinMMapSize,
+		bitmapBufPool:            sg.bitmapBufPool,
+		bm25config:               sg.bm25config,
+		shouldSkipKey:            sg.shouldSkipKey,
+	}
+}
@@ -0,0 +1,206 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/roaringset"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/filters"
+)
+
+func TestStoreSnapshot(t *testing.T) {
+	ctx := context.Background()
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	store, err := New(dirName, dirName, logger, nil,
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop())
+	require.NoError(t, err)
+
+	require.NoError(t, store.CreateOrLoadBucket(ctx, "objects", WithStrategy(StrategyReplace),
+		WithCalcCountNetAdditions(true)))
+	require.NoError(t, store.CreateOrLoadBucket(ctx, "property", WithStrategy(StrategyRoaringSet),
+		WithBitmapBufPool(roaringset.NewBitmapBufPoolNoop())))
+	require.NoError(t, store.CreateOrLoadBucket(ctx, "range", WithStrategy(StrategyRoaringSetRange),
+		WithKeepSegmentsInMemory(true), WithBitmapBufPool(roaringset.NewBitmapBufPoolNoop())))
+	require.NoError(t, store.CreateOrLoadBucket(ctx, "empty", WithStrategy(StrategyReplace)))
+
+	objects := store.Bucket("objects")
+	property := store.Bucket("property")
+	rangeable := store.Bucket("range")
+
+	// one object on disk, one object in the memtable
+	require.NoError(t, objects.Put([]byte("a"), []byte("a1")))
+	require.NoError(t, property.RoaringSetAddOne([]byte("value"), 1))
+	require.NoError(t, rangeable.RoaringSetRangeAdd(10, 1))
+	for _, b := range []*Bucket{objects, property, rangeable} {
+		require.NoError(t, b.FlushAndSwitch())
+	}
+	require.NoError(t, objects.Put([]byte("b"), []byte("b1")))
+	require.NoError(t, property.RoaringSetAddOne([]byte("value"), 2))
+	require.NoError(t, rangeable.RoaringSetRangeAdd(20, 2))
+
+	var writes sync.RWMutex
+	snapshot, release, err := store.Snapshot(func() func() {
+		writes.Lock()
+		return writes.Unlock
+	})
+	require.NoError(t, err)
+
+	// writes after the snapshot are not visible in the snapshot
+	require.NoError(t, objects.Put([]byte("a"), []byte("a2")))
+	require.NoError(t, objects.Delete([]byte("b")))
+	require.NoError(t, objects.Put([]byte("c"), []byte("c1")))
+	require.NoError(t, property.RoaringSetAddOne([]byte("value"), 3))
+	require.NoError(t, rangeable.RoaringSetRangeAdd(30, 3))
+	require.NoError(t, store.Bucket("empty").Put([]byte("d"), []byte("d1")))
+	for _, b := range []*Bucket{objects, property, rangeable} {
+		require.NoError(t, b.FlushAndSwitch())
+	}
+
+	t.Run("snapshot reads the pinned state", func(t *testing.T) {
+		snapObjects := snapshot.Bucket("objects")
+		require.NotNil(t, snapObjects)
+
+		for key, expected := range map[string][]byte{"a": []byte("a1"), "b": []byte("b1"), "c": nil} {
+			v, err := snapObjects.Get([]byte(key))
+			require.NoError(t, err)
+			assert.Equal(t, expected, v, key)
+		}
+		count, err := snapObjects.Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+
+		bm, releaseBm, err := snapshot.Bucket("property").RoaringSetGet([]byte("value"))
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []uint64{1, 2}, bm.ToArray())
+		releaseBm()
+
+		reader := snapshot.Bucket("range").ReaderRoaringSetRange()
+		defer reader.Close()
+		bm, releaseBm, err = reader.Read(ctx, 0, filters.OperatorGreaterThanEqual)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []uint64{1, 2}, bm.ToArray())
+		releaseBm()
+
+		v, err := snapshot.Bucket("empty").Get([]byte("d"))
+		require.NoError(t, err)
+		assert.Nil(t, v)
+	})
+
+	t.Run("store reads the current state", func(t *testing.T) {
+		for key, expected := range map[string][]byte{"a": []byte("a2"), "b": nil, "c": []byte("c1")} {
+			v, err := objects.Get([]byte(key))
+			require.NoError(t, err)
+			assert.Equal(t, expected, v, key)
+		}
+
+		bm, releaseBm, err := property.RoaringSetGet([]byte("value"))
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []uint64{1, 2, 3}, bm.ToArray())
+		releaseBm()
+	})
+
+	t.Run("compactions are skipped until the snapshot is released", func(t *testing.T) {
+		assert.False(t, objects.disk.compactOrCleanup(func() bool { return false }))
+		assert.Equal(t, int32(1), objects.disk.snapshotPins.Load())
+
+		release()
+		assert.Equal(t, int32(0), objects.disk.snapshotPins.Load())
+		assert.True(t, objects.disk.compactOrCleanup(func() bool { return false }))
+	})
+
+	shutdownCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	require.NoError(t, store.Shutdown(shutdownCtx))
+}
+
+func TestStoreSnapshotFreezesMemtable(t *testing.T) {
+	ctx := context.Background()
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	newStore := func(t *testing.T) (*Store, *Bucket) {
+		store, err := New(dirName, dirName, logger, nil,
+			cyclemanager.NewCallbackGroupNoop(),
+			cyclemanager.NewCallbackGroupNoop(),
+			cyclemanager.NewCallbackGroupNoop())
+		require.NoError(t, err)
+		require.NoError(t, store.CreateOrLoadBucket(ctx, "objects", WithStrategy(StrategyReplace),
+			WithDirtyThreshold(time.Hour), WithMinWalThreshold(0)))
+		return store, store.Bucket("objects")
+	}
+	lockWrites := func() func() { return func() {} }
+
+	store, objects := newStore(t)
+	require.NoError(t, objects.Put([]byte("a"), []byte("a1")))
+
+	snapshot1, release1, err := store.Snapshot(lockWrites)
+	require.NoError(t, err)
+
+	t.Run("memtable is frozen, not flushed", func(t *testing.T) {
+		assert.Equal(t, 0, objects.disk.Len())
+		require.NotNil(t, objects.flushing)
+		assert.Same(t, objects.flushing, snapshot1.Bucket("objects").active)
+
+		// the frozen memtable is not dirty for long enough to be flushed
+		assert.False(t, objects.flushAndSwitchIfThresholdsMet(func() bool { return false }))
+		assert.NotNil(t, objects.flushing)
+	})
+
+	require.NoError(t, objects.Put([]byte("b"), []byte("b1")))
+
+	snapshot2, release2, err := store.Snapshot(lockWrites)
+	require.NoError(t, err)
+
+	t.Run("next snapshot flushes the frozen memtable", func(t *testing.T) {
+		assert.Equal(t, 1, objects.disk.Len())
+		require.NotNil(t, objects.flushing)
+
+		for snapshot, expected := range map[*Store][]byte{snapshot1: nil, snapshot2: []byte("b1")} {
+			v, err := snapshot.Bucket("objects").Get([]byte("a"))
+			require.NoError(t, err)
+			assert.Equal(t, []byte("a1"), v)
+			v, err = snapshot.Bucket("objects").Get([]byte("b"))
+			require.NoError(t, err)
+			assert.Equal(t, expected, v)
+		}
+	})
+
+	require.NoError(t, objects.Put([]byte("c"), []byte("c1")))
+	release1()
+	release2()
+
+	t.Run("shutdown flushes the frozen memtable", func(t *testing.T) {
+		shutdownCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+		require.NoError(t, store.Shutdown(shutdownCtx))
+
+		store, objects := newStore(t)
+		for _, key := range []string{"a", "b", "c"} {
+			v, err := objects.Get([]byte(key))
+			require.NoError(t, err)
+			assert.Equal(t, []byte(key+"1"), v)
+		}
+		require.NoError(t, store.Shutdown(shutdownCtx))
+	})
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 
+	"github.com/weaviate/weaviate/adapters/repos/db/changestream"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	resolver "github.com/weaviate/weaviate/adapters/repos/db/sharding"
@@ -150,6 +151,12 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class) error {
 			ForceFullReplicasSearch:                      m.db.config.ForceFullReplicasSearch,
 			TransferInactivityTimeout:                    m.db.config.TransferInactivityTimeout,
 			LSMEnableSegmentsChecksumValidation:          m.db.config.LSMEnableSegmentsChecksumValidation,
+			ChangeStreamEnabled:                          changestream.IsEnabled(class.ChangeStreamConfig),
+			ChangeStreamRetention:                        m.db.config.ChangeStreamRetention,
+			RecallMonitoringSampleSize:                   m.db.config.RecallMonitoring.QuerySamples(class.Class),
+			ObjectsCompression:                           objectsCompression(class.ObjectStorageConfig),
+			Encryption:                                   m.db.config.Encryption,
+			SegmentTiering:                               m.db.segmentTiering(class.Class),
 			ReplicationFactor:                            class.ReplicationConfig.Factor,
 			AsyncReplicationEnabled:                      class.ReplicationConfig.AsyncEnabled,
 			DeletionStrategy:                             class.ReplicationConfig.DeletionStrategy,
@@ -431,13 +438,24 @@ func (m *Migrator) AddProperty(ctx context.Context, className string, prop ...*m
 		return errors.Errorf("cannot add property to a non-existing index for %s", className)
 	}
 
+	if err := idx.awaitDroppedProperties(ctx, prop...); err != nil {
+		return errors.Wrapf(err, "wait for deleted properties of %s to be removed", className)
+	}
 	return idx.addProperty(ctx, prop...)
 }
 
-// DropProperty is ignored, API compliant change
 func (m *Migrator) DropProperty(ctx context.Context, className string, propertyName string) error {
-	// ignore but don't error
-	return nil
+	indexID := indexID(schema.ClassName(className))
+
+	m.classLocks.Lock(indexID)
+	defer m.classLocks.Unlock(indexID)
+
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot drop property from a non-existing index for %s", className)
+	}
+
+	return idx.dropProperty(propertyName)
 }
 
 func (m *Migrator) UpdateProperty(ctx context.Context, className string, propName string, newName *string) error {
@@ -720,6 +738,77 @@ func (m *Migrator) UpdateVectorIndexConfigs(ctx context.Context,
 	return idx.updateVectorIndexConfigs(ctx, updated)
 }
 
+func (m *Migrator) ReindexVectorIndex(ctx context.Context,
+	className, targetVector string, updated schemaConfig.VectorIndexConfig,
+) error {
+	indexID := indexID(schema.ClassName(className))
+
+	m.classLocks.Lock(indexID)
+	defer m.classLocks.Unlock(indexID)
+
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot reindex vector of non-existing index for %s", className)
+	}
+
+	return idx.reindexVectorIndex(ctx, targetVector, updated)
+}
+
+// StartVectorIndexMaintenance starts a maintenance operation on the vector
+// index of the target vector of a shard on this node
+func (m *Migrator) StartVectorIndexMaintenance(ctx context.Context,
+	className, shardName, targetVector, operation string,
+) (*models.VectorIndexMaintenanceOperation, error) {
+	var op *models.VectorIndexMaintenanceOperation
+	err := m.withLocalShard(ctx, className, shardName, func(shard ShardLike) (err error) {
+		op, err = shard.startVectorIndexMaintenance(ctx, targetVector, operation)
+		return err
+	})
+	return op, err
+}
+
+// GetVectorIndexMaintenance returns the maintenance status of the vector index
+// of the target vector of a shard on this node
+func (m *Migrator) GetVectorIndexMaintenance(ctx context.Context,
+	className, shardName, targetVector string,
+) (*models.VectorIndexMaintenanceStatus, error) {
+	var status *models.VectorIndexMaintenanceStatus
+	err := m.withLocalShard(ctx, className, shardName, func(shard ShardLike) (err error) {
+		status, err = shard.getVectorIndexMaintenance(targetVector)
+		return err
+	})
+	return status, err
+}
+
+// CancelVectorIndexMaintenance cancels a maintenance operation on the vector
+// index of the target vector of a shard on this node
+func (m *Migrator) CancelVectorIndexMaintenance(ctx context.Context,
+	className, shardName, targetVector, id string,
+) error {
+	return m.withLocalShard(ctx, className, shardName, func(shard ShardLike) error {
+		return shard.cancelVectorIndexMaintenance(targetVector, id)
+	})
+}
+
+func (m *Migrator) withLocalShard(ctx context.Context, className, shardName string,
+	fn func(shard ShardLike) error,
+) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("index for %s not found", className)
+	}
+	shard, release, err := idx.GetShard(ctx, shardName)
+	if err != nil {
+		return err
+	}
+	if shard == nil {
+		return errors.Errorf("shard %s of %s not found on this node", shardName, className)
+	}
+	defer release()
+
+	return fn(shard)
+}
+
 func (m *Migrator) ValidateVectorIndexConfigUpdate(
 	old, updated schemaConfig.VectorIndexConfig,
 ) error {
@@ -797,6 +886,24 @@ func (m *Migrator) UpdateReplicationConfig(ctx context.Context, className string
 	return nil
 }
 
+func (m *Migrator) UpdateObjectStorageConfig(ctx context.Context, className string, cfg *models.ObjectStorageConfig) error {
+	indexID := indexID(schema.ClassName(className))
+
+	m.classLocks.Lock(indexID)
+	defer m.classLocks.Unlock(indexID)
+
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot update object storage config of non-existing index for %s", className)
+	}
+
+	if err := idx.updateObjectStorageConfig(cfg); err != nil {
+		return fmt.Errorf("update object storage config for class %q: %w", className, err)
+	}
+
+	return nil
+}
+
 func (m *Migrator) RecalculateVectorDimensions(ctx context.Context) error {
 	count := 0
 	m.logger.
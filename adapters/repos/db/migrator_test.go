@@ -15,6 +15,8 @@ import (
 	"context"
 	"hash/crc32"
 	"io"
+	"os"
+	"path/filepath"
 	"slices"
 	"testing"
 
@@ -23,10 +25,12 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/queue"
 	"github.com/weaviate/weaviate/adapters/repos/db/roaringset"
 	resolver "github.com/weaviate/weaviate/adapters/repos/db/sharding"
+	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storagestate"
@@ -337,6 +341,110 @@ func TestUpdateIndexShards(t *testing.T) {
 	}
 }
 
+func TestIndexDropProperty(t *testing.T) {
+	ctx := context.Background()
+	logger := logrus.New()
+
+	mockSchemaGetter := schemaUC.NewMockSchemaGetter(t)
+	mockSchemaGetter.On("NodeName").Return("node1").Maybe()
+
+	vFalse, vTrue := false, true
+	class := &models.Class{
+		Class:               "TestClass",
+		InvertedIndexConfig: &models.InvertedIndexConfig{},
+		MultiTenancyConfig: &models.MultiTenancyConfig{
+			Enabled: true,
+		},
+		Properties: []*models.Property{
+			{
+				Name:            "title",
+				DataType:        schema.DataTypeText.PropString(),
+				Tokenization:    models.PropertyTokenizationWord,
+				IndexFilterable: &vTrue,
+				IndexSearchable: &vTrue,
+			},
+			{
+				Name:            "count",
+				DataType:        schema.DataTypeInt.PropString(),
+				IndexFilterable: &vTrue,
+				IndexSearchable: &vFalse,
+			},
+		},
+	}
+	mockSchemaGetter.On("ReadOnlyClass", "TestClass").Return(class).Maybe()
+
+	shardState := &sharding.State{
+		Physical: map[string]sharding.Physical{
+			"loaded": {Name: "loaded", BelongsToNodes: []string{"node1"}},
+			"lazy":   {Name: "lazy", BelongsToNodes: []string{"node1"}},
+		},
+	}
+	shardState.SetLocalName("node1")
+	scheduler := queue.NewScheduler(queue.SchedulerOptions{
+		Logger:  logger,
+		Workers: 1,
+	})
+	mockSchemaReader := schemaUC.NewMockSchemaReader(t)
+	mockSchemaReader.EXPECT().Read(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(className string, retryIfClassNotFound bool, readFunc func(*models.Class, *sharding.State) error) error {
+		return readFunc(class, shardState)
+	}).Maybe()
+	shardResolver := resolver.NewShardResolver(class.Class, class.MultiTenancyConfig.Enabled, mockSchemaGetter)
+	index, err := NewIndex(ctx, IndexConfig{
+		ClassName:         schema.ClassName("TestClass"),
+		RootPath:          t.TempDir(),
+		ReplicationFactor: 1,
+		ShardLoadLimiter:  NewShardLoadLimiter(monitoring.NoopRegisterer, 1),
+	}, inverted.ConfigFromModel(class.InvertedIndexConfig),
+		hnsw.NewDefaultUserConfig(), nil, nil, shardResolver, mockSchemaGetter, mockSchemaReader, nil, logger, nil, nil, nil, nil, nil, class, nil, scheduler, nil, memwatch.NewDummyMonitor(), NewShardReindexerV3Noop(), roaringset.NewBitmapBufPoolNoop(), false)
+	require.NoError(t, err)
+
+	require.NoError(t, index.initLocalShardWithForcedLoading(ctx, class, "loaded", true, false))
+	require.NoError(t, index.initLocalShardWithForcedLoading(ctx, class, "lazy", false, false))
+
+	// buckets of a shard that is not part of the index, e.g. of a cold tenant
+	coldBucket := filepath.Join(shardPathLSM(index.path(), "cold"), helpers.BucketFromPropNameLSM("title"))
+	require.NoError(t, os.MkdirAll(coldBucket, os.ModePerm))
+
+	loaded := index.shards.Load("loaded").(*LazyLoadShard).shard
+	require.NotNil(t, loaded)
+	require.NotNil(t, loaded.Store().Bucket(helpers.BucketSearchableFromPropNameLSM("title")))
+
+	id := strfmt.UUID("40d3be3e-2ecc-49c8-b37c-d8983164848b")
+	require.NoError(t, loaded.PutObject(ctx, &storobj.Object{
+		MarshallerVersion: 1,
+		Object: models.Object{
+			ID:         id,
+			Class:      "TestClass",
+			Properties: map[string]interface{}{"title": "some title", "count": float64(3)},
+		},
+	}))
+
+	require.NoError(t, index.dropProperty("title"))
+	require.FileExists(t, filepath.Join(index.path(), droppedPropertiesFile))
+	require.NoError(t, index.awaitDroppedProperties(ctx, &models.Property{Name: "title"}))
+	require.NoFileExists(t, filepath.Join(index.path(), droppedPropertiesFile))
+
+	for _, bucketName := range propertyBucketNames("title") {
+		require.Nil(t, loaded.Store().Bucket(bucketName))
+		require.NoDirExists(t, filepath.Join(shardPathLSM(index.path(), "loaded"), bucketName))
+		require.NoDirExists(t, filepath.Join(shardPathLSM(index.path(), "lazy"), bucketName))
+	}
+	require.NoDirExists(t, coldBucket)
+	require.NotContains(t, loaded.getSearchableBlockmaxProperties(), "title")
+
+	// the values are stripped from the objects, the cold shard strips them
+	// once loaded
+	obj, err := loaded.ObjectByID(ctx, id, nil, additional.Properties{})
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"count": float64(3)}, obj.Properties())
+	props, err := loadDroppedProperties(shardPath(index.path(), "cold"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"title"}, props)
+
+	// other properties are untouched
+	require.NotNil(t, loaded.Store().Bucket(helpers.BucketFromPropNameLSM("count")))
+}
+
 func TestListAndGetFilesWithIntegrityChecking(t *testing.T) {
 	mockSchemaGetter := schemaUC.NewMockSchemaGetter(t)
 	mockSchemaGetter.On("NodeName").Return("node1")
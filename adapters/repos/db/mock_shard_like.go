@@ -19,6 +19,8 @@ import (
 
 	backup "github.com/weaviate/weaviate/entities/backup"
 
+	changestream "github.com/weaviate/weaviate/adapters/repos/db/changestream"
+
 	config "github.com/weaviate/weaviate/entities/schema/config"
 
 	context "context"
@@ -68,6 +70,8 @@ import (
 	time "time"
 
 	types "github.com/weaviate/weaviate/cluster/router/types"
+
+	vectorindex "github.com/weaviate/weaviate/entities/vectorindex"
 )
 
 // MockShardLike is an autogenerated mock type for the ShardLike type
@@ -314,6 +318,53 @@ func (_c *MockShardLike_AnalyzeObject_Call) RunAndReturn(run func(*storobj.Objec
 	return _c
 }
 
+// ChangeStream provides a mock function with no fields
+func (_m *MockShardLike) ChangeStream() *changestream.Log {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeStream")
+	}
+
+	var r0 *changestream.Log
+	if rf, ok := ret.Get(0).(func() *changestream.Log); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*changestream.Log)
+		}
+	}
+
+	return r0
+}
+
+// MockShardLike_ChangeStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeStream'
+type MockShardLike_ChangeStream_Call struct {
+	*mock.Call
+}
+
+// ChangeStream is a helper method to define mock.On call
+func (_e *MockShardLike_Expecter) ChangeStream() *MockShardLike_ChangeStream_Call {
+	return &MockShardLike_ChangeStream_Call{Call: _e.mock.On("ChangeStream")}
+}
+
+func (_c *MockShardLike_ChangeStream_Call) Run(run func()) *MockShardLike_ChangeStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockShardLike_ChangeStream_Call) Return(_a0 *changestream.Log) *MockShardLike_ChangeStream_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockShardLike_ChangeStream_Call) RunAndReturn(run func() *changestream.Log) *MockShardLike_ChangeStream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ConvertQueue provides a mock function with given fields: targetVector
 func (_m *MockShardLike) ConvertQueue(targetVector string) error {
 	ret := _m.Called(targetVector)
@@ -2230,6 +2281,79 @@ func (_c *MockShardLike_ObjectVectorSearch_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// ObjectVectorSearchBatch provides a mock function with given fields: ctx, searchVectors, targetVector, limit, _a4, _a5, properties
+func (_m *MockShardLike) ObjectVectorSearchBatch(ctx context.Context, searchVectors [][]float32, targetVector string, limit int, _a4 *filters.LocalFilter, _a5 additional.Properties, properties []string) ([][]*storobj.Object, [][]float32, error) {
+	ret := _m.Called(ctx, searchVectors, targetVector, limit, _a4, _a5, properties)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ObjectVectorSearchBatch")
+	}
+
+	var r0 [][]*storobj.Object
+	var r1 [][]float32
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, [][]float32, string, int, *filters.LocalFilter, additional.Properties, []string) ([][]*storobj.Object, [][]float32, error)); ok {
+		return rf(ctx, searchVectors, targetVector, limit, _a4, _a5, properties)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, [][]float32, string, int, *filters.LocalFilter, additional.Properties, []string) [][]*storobj.Object); ok {
+		r0 = rf(ctx, searchVectors, targetVector, limit, _a4, _a5, properties)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][]*storobj.Object)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, [][]float32, string, int, *filters.LocalFilter, additional.Properties, []string) [][]float32); ok {
+		r1 = rf(ctx, searchVectors, targetVector, limit, _a4, _a5, properties)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([][]float32)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, [][]float32, string, int, *filters.LocalFilter, additional.Properties, []string) error); ok {
+		r2 = rf(ctx, searchVectors, targetVector, limit, _a4, _a5, properties)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockShardLike_ObjectVectorSearchBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ObjectVectorSearchBatch'
+type MockShardLike_ObjectVectorSearchBatch_Call struct {
+	*mock.Call
+}
+
+// ObjectVectorSearchBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - searchVectors [][]float32
+//   - targetVector string
+//   - limit int
+//   - _a4 *filters.LocalFilter
+//   - _a5 additional.Properties
+//   - properties []string
+func (_e *MockShardLike_Expecter) ObjectVectorSearchBatch(ctx interface{}, searchVectors interface{}, targetVector interface{}, limit interface{}, _a4 interface{}, _a5 interface{}, properties interface{}) *MockShardLike_ObjectVectorSearchBatch_Call {
+	return &MockShardLike_ObjectVectorSearchBatch_Call{Call: _e.mock.On("ObjectVectorSearchBatch", ctx, searchVectors, targetVector, limit, _a4, _a5, properties)}
+}
+
+func (_c *MockShardLike_ObjectVectorSearchBatch_Call) Run(run func(ctx context.Context, searchVectors [][]float32, targetVector string, limit int, _a4 *filters.LocalFilter, _a5 additional.Properties, properties []string)) *MockShardLike_ObjectVectorSearchBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([][]float32), args[2].(string), args[3].(int), args[4].(*filters.LocalFilter), args[5].(additional.Properties), args[6].([]string))
+	})
+	return _c
+}
+
+func (_c *MockShardLike_ObjectVectorSearchBatch_Call) Return(_a0 [][]*storobj.Object, _a1 [][]float32, _a2 error) *MockShardLike_ObjectVectorSearchBatch_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockShardLike_ObjectVectorSearchBatch_Call) RunAndReturn(run func(context.Context, [][]float32, string, int, *filters.LocalFilter, additional.Properties, []string) ([][]*storobj.Object, [][]float32, error)) *MockShardLike_ObjectVectorSearchBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PutObject provides a mock function with given fields: _a0, _a1
 func (_m *MockShardLike) PutObject(_a0 context.Context, _a1 *storobj.Object) error {
 	ret := _m.Called(_a0, _a1)
@@ -3389,6 +3513,53 @@ func (_c *MockShardLike_batchExtendInvertedIndexItemsLSMNoFrequency_Call) RunAnd
 	return _c
 }
 
+// cancelVectorIndexMaintenance provides a mock function with given fields: targetVector, id
+func (_m *MockShardLike) cancelVectorIndexMaintenance(targetVector string, id string) error {
+	ret := _m.Called(targetVector, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for cancelVectorIndexMaintenance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(targetVector, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockShardLike_cancelVectorIndexMaintenance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'cancelVectorIndexMaintenance'
+type MockShardLike_cancelVectorIndexMaintenance_Call struct {
+	*mock.Call
+}
+
+// cancelVectorIndexMaintenance is a helper method to define mock.On call
+//   - targetVector string
+//   - id string
+func (_e *MockShardLike_Expecter) cancelVectorIndexMaintenance(targetVector interface{}, id interface{}) *MockShardLike_cancelVectorIndexMaintenance_Call {
+	return &MockShardLike_cancelVectorIndexMaintenance_Call{Call: _e.mock.On("cancelVectorIndexMaintenance", targetVector, id)}
+}
+
+func (_c *MockShardLike_cancelVectorIndexMaintenance_Call) Run(run func(targetVector string, id string)) *MockShardLike_cancelVectorIndexMaintenance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockShardLike_cancelVectorIndexMaintenance_Call) Return(_a0 error) *MockShardLike_cancelVectorIndexMaintenance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockShardLike_cancelVectorIndexMaintenance_Call) RunAndReturn(run func(string, string) error) *MockShardLike_cancelVectorIndexMaintenance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // commitReplication provides a mock function with given fields: _a0, _a1
 func (_m *MockShardLike) commitReplication(_a0 context.Context, _a1 string) interface{} {
 	ret := _m.Called(_a0, _a1)
@@ -3580,6 +3751,53 @@ func (_c *MockShardLike_drop_Call) RunAndReturn(run func(bool) error) *MockShard
 	return _c
 }
 
+// dropProperty provides a mock function with given fields: ctx, propName
+func (_m *MockShardLike) dropProperty(ctx context.Context, propName string) error {
+	ret := _m.Called(ctx, propName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for dropProperty")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, propName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockShardLike_dropProperty_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'dropProperty'
+type MockShardLike_dropProperty_Call struct {
+	*mock.Call
+}
+
+// dropProperty is a helper method to define mock.On call
+//   - ctx context.Context
+//   - propName string
+func (_e *MockShardLike_Expecter) dropProperty(ctx interface{}, propName interface{}) *MockShardLike_dropProperty_Call {
+	return &MockShardLike_dropProperty_Call{Call: _e.mock.On("dropProperty", ctx, propName)}
+}
+
+func (_c *MockShardLike_dropProperty_Call) Run(run func(ctx context.Context, propName string)) *MockShardLike_dropProperty_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockShardLike_dropProperty_Call) Return(_a0 error) *MockShardLike_dropProperty_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockShardLike_dropProperty_Call) RunAndReturn(run func(context.Context, string) error) *MockShardLike_dropProperty_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // extendDimensionTrackerLSM provides a mock function with given fields: dimLength, docID, targetVector
 func (_m *MockShardLike) extendDimensionTrackerLSM(dimLength int, docID uint64, targetVector string) error {
 	ret := _m.Called(dimLength, docID, targetVector)
@@ -3735,6 +3953,111 @@ func (_c *MockShardLike_getAsyncReplicationStats_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// getVectorIndexMaintenance provides a mock function with given fields: targetVector
+func (_m *MockShardLike) getVectorIndexMaintenance(targetVector string) (*models.VectorIndexMaintenanceStatus, error) {
+	ret := _m.Called(targetVector)
+
+	if len(ret) == 0 {
+		panic("no return value specified for getVectorIndexMaintenance")
+	}
+
+	var r0 *models.VectorIndexMaintenanceStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*models.VectorIndexMaintenanceStatus, error)); ok {
+		return rf(targetVector)
+	}
+	if rf, ok := ret.Get(0).(func(string) *models.VectorIndexMaintenanceStatus); ok {
+		r0 = rf(targetVector)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.VectorIndexMaintenanceStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(targetVector)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockShardLike_getVectorIndexMaintenance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'getVectorIndexMaintenance'
+type MockShardLike_getVectorIndexMaintenance_Call struct {
+	*mock.Call
+}
+
+// getVectorIndexMaintenance is a helper method to define mock.On call
+//   - targetVector string
+func (_e *MockShardLike_Expecter) getVectorIndexMaintenance(targetVector interface{}) *MockShardLike_getVectorIndexMaintenance_Call {
+	return &MockShardLike_getVectorIndexMaintenance_Call{Call: _e.mock.On("getVectorIndexMaintenance", targetVector)}
+}
+
+func (_c *MockShardLike_getVectorIndexMaintenance_Call) Run(run func(targetVector string)) *MockShardLike_getVectorIndexMaintenance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockShardLike_getVectorIndexMaintenance_Call) Return(_a0 *models.VectorIndexMaintenanceStatus, _a1 error) *MockShardLike_getVectorIndexMaintenance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockShardLike_getVectorIndexMaintenance_Call) RunAndReturn(run func(string) (*models.VectorIndexMaintenanceStatus, error)) *MockShardLike_getVectorIndexMaintenance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// getVectorReindexStatus provides a mock function with no fields
+func (_m *MockShardLike) getVectorReindexStatus() []*models.VectorReindexStatus {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for getVectorReindexStatus")
+	}
+
+	var r0 []*models.VectorReindexStatus
+	if rf, ok := ret.Get(0).(func() []*models.VectorReindexStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.VectorReindexStatus)
+		}
+	}
+
+	return r0
+}
+
+// MockShardLike_getVectorReindexStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'getVectorReindexStatus'
+type MockShardLike_getVectorReindexStatus_Call struct {
+	*mock.Call
+}
+
+// getVectorReindexStatus is a helper method to define mock.On call
+func (_e *MockShardLike_Expecter) getVectorReindexStatus() *MockShardLike_getVectorReindexStatus_Call {
+	return &MockShardLike_getVectorReindexStatus_Call{Call: _e.mock.On("getVectorReindexStatus")}
+}
+
+func (_c *MockShardLike_getVectorReindexStatus_Call) Run(run func()) *MockShardLike_getVectorReindexStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockShardLike_getVectorReindexStatus_Call) Return(_a0 []*models.VectorReindexStatus) *MockShardLike_getVectorReindexStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockShardLike_getVectorReindexStatus_Call) RunAndReturn(run func() []*models.VectorReindexStatus) *MockShardLike_getVectorReindexStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // hasGeoIndex provides a mock function with no fields
 func (_m *MockShardLike) hasGeoIndex() bool {
 	ret := _m.Called()
@@ -3923,6 +4246,65 @@ func (_c *MockShardLike_mayUpsertObjectHashTree_Call) RunAndReturn(run func(*sto
 	return _c
 }
 
+// measureRecall provides a mock function with given fields: ctx, targetVector, k, samples
+func (_m *MockShardLike) measureRecall(ctx context.Context, targetVector string, k int, samples int) (RecallMeasurement, error) {
+	ret := _m.Called(ctx, targetVector, k, samples)
+
+	if len(ret) == 0 {
+		panic("no return value specified for measureRecall")
+	}
+
+	var r0 RecallMeasurement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) (RecallMeasurement, error)); ok {
+		return rf(ctx, targetVector, k, samples)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) RecallMeasurement); ok {
+		r0 = rf(ctx, targetVector, k, samples)
+	} else {
+		r0 = ret.Get(0).(RecallMeasurement)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
+		r1 = rf(ctx, targetVector, k, samples)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockShardLike_measureRecall_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'measureRecall'
+type MockShardLike_measureRecall_Call struct {
+	*mock.Call
+}
+
+// measureRecall is a helper method to define mock.On call
+//   - ctx context.Context
+//   - targetVector string
+//   - k int
+//   - samples int
+func (_e *MockShardLike_Expecter) measureRecall(ctx interface{}, targetVector interface{}, k interface{}, samples interface{}) *MockShardLike_measureRecall_Call {
+	return &MockShardLike_measureRecall_Call{Call: _e.mock.On("measureRecall", ctx, targetVector, k, samples)}
+}
+
+func (_c *MockShardLike_measureRecall_Call) Run(run func(ctx context.Context, targetVector string, k int, samples int)) *MockShardLike_measureRecall_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockShardLike_measureRecall_Call) Return(_a0 RecallMeasurement, _a1 error) *MockShardLike_measureRecall_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockShardLike_measureRecall_Call) RunAndReturn(run func(context.Context, string, int, int) (RecallMeasurement, error)) *MockShardLike_measureRecall_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // mutableMergeObjectLSM provides a mock function with given fields: merge, idBytes
 func (_m *MockShardLike) mutableMergeObjectLSM(merge objects.MergeDocument, idBytes []byte) (mutableMergeResult, error) {
 	ret := _m.Called(merge, idBytes)
@@ -4478,6 +4860,55 @@ func (_c *MockShardLike_putObjectLSM_Call) RunAndReturn(run func(*storobj.Object
 	return _c
 }
 
+// reindexVectorIndex provides a mock function with given fields: ctx, targetVector, previous, updated
+func (_m *MockShardLike) reindexVectorIndex(ctx context.Context, targetVector string, previous config.VectorIndexConfig, updated config.VectorIndexConfig) error {
+	ret := _m.Called(ctx, targetVector, previous, updated)
+
+	if len(ret) == 0 {
+		panic("no return value specified for reindexVectorIndex")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, config.VectorIndexConfig, config.VectorIndexConfig) error); ok {
+		r0 = rf(ctx, targetVector, previous, updated)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockShardLike_reindexVectorIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'reindexVectorIndex'
+type MockShardLike_reindexVectorIndex_Call struct {
+	*mock.Call
+}
+
+// reindexVectorIndex is a helper method to define mock.On call
+//   - ctx context.Context
+//   - targetVector string
+//   - previous config.VectorIndexConfig
+//   - updated config.VectorIndexConfig
+func (_e *MockShardLike_Expecter) reindexVectorIndex(ctx interface{}, targetVector interface{}, previous interface{}, updated interface{}) *MockShardLike_reindexVectorIndex_Call {
+	return &MockShardLike_reindexVectorIndex_Call{Call: _e.mock.On("reindexVectorIndex", ctx, targetVector, previous, updated)}
+}
+
+func (_c *MockShardLike_reindexVectorIndex_Call) Run(run func(ctx context.Context, targetVector string, previous config.VectorIndexConfig, updated config.VectorIndexConfig)) *MockShardLike_reindexVectorIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(config.VectorIndexConfig), args[3].(config.VectorIndexConfig))
+	})
+	return _c
+}
+
+func (_c *MockShardLike_reindexVectorIndex_Call) Return(_a0 error) *MockShardLike_reindexVectorIndex_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockShardLike_reindexVectorIndex_Call) RunAndReturn(run func(context.Context, string, config.VectorIndexConfig, config.VectorIndexConfig) error) *MockShardLike_reindexVectorIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // removeAllTargetNodeOverrides provides a mock function with given fields: ctx
 func (_m *MockShardLike) removeAllTargetNodeOverrides(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -4696,6 +5127,66 @@ func (_c *MockShardLike_setFallbackToSearchable_Call) RunAndReturn(run func(bool
 	return _c
 }
 
+// startVectorIndexMaintenance provides a mock function with given fields: ctx, targetVector, operation
+func (_m *MockShardLike) startVectorIndexMaintenance(ctx context.Context, targetVector string, operation string) (*models.VectorIndexMaintenanceOperation, error) {
+	ret := _m.Called(ctx, targetVector, operation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for startVectorIndexMaintenance")
+	}
+
+	var r0 *models.VectorIndexMaintenanceOperation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.VectorIndexMaintenanceOperation, error)); ok {
+		return rf(ctx, targetVector, operation)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.VectorIndexMaintenanceOperation); ok {
+		r0 = rf(ctx, targetVector, operation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.VectorIndexMaintenanceOperation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, targetVector, operation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockShardLike_startVectorIndexMaintenance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'startVectorIndexMaintenance'
+type MockShardLike_startVectorIndexMaintenance_Call struct {
+	*mock.Call
+}
+
+// startVectorIndexMaintenance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - targetVector string
+//   - operation string
+func (_e *MockShardLike_Expecter) startVectorIndexMaintenance(ctx interface{}, targetVector interface{}, operation interface{}) *MockShardLike_startVectorIndexMaintenance_Call {
+	return &MockShardLike_startVectorIndexMaintenance_Call{Call: _e.mock.On("startVectorIndexMaintenance", ctx, targetVector, operation)}
+}
+
+func (_c *MockShardLike_startVectorIndexMaintenance_Call) Run(run func(ctx context.Context, targetVector string, operation string)) *MockShardLike_startVectorIndexMaintenance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockShardLike_startVectorIndexMaintenance_Call) Return(_a0 *models.VectorIndexMaintenanceOperation, _a1 error) *MockShardLike_startVectorIndexMaintenance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockShardLike_startVectorIndexMaintenance_Call) RunAndReturn(run func(context.Context, string, string) (*models.VectorIndexMaintenanceOperation, error)) *MockShardLike_startVectorIndexMaintenance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // updateMultiVectorIndexesIgnoreDelete provides a mock function with given fields: ctx, multiVectors, status
 func (_m *MockShardLike) updateMultiVectorIndexesIgnoreDelete(ctx context.Context, multiVectors map[string][][]float32, status objectInsertStatus) error {
 	ret := _m.Called(ctx, multiVectors, status)
@@ -4944,6 +5435,71 @@ func (_c *MockShardLike_uuidFromDocID_Call) RunAndReturn(run func(uint64) (strfm
 	return _c
 }
 
+// vectorReindexProgress provides a mock function with given fields: ctx, targetVector, payload
+func (_m *MockShardLike) vectorReindexProgress(ctx context.Context, targetVector string, payload vectorindex.ReindexTaskPayload) (bool, float64, error) {
+	ret := _m.Called(ctx, targetVector, payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for vectorReindexProgress")
+	}
+
+	var r0 bool
+	var r1 float64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, vectorindex.ReindexTaskPayload) (bool, float64, error)); ok {
+		return rf(ctx, targetVector, payload)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, vectorindex.ReindexTaskPayload) bool); ok {
+		r0 = rf(ctx, targetVector, payload)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, vectorindex.ReindexTaskPayload) float64); ok {
+		r1 = rf(ctx, targetVector, payload)
+	} else {
+		r1 = ret.Get(1).(float64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, vectorindex.ReindexTaskPayload) error); ok {
+		r2 = rf(ctx, targetVector, payload)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockShardLike_vectorReindexProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'vectorReindexProgress'
+type MockShardLike_vectorReindexProgress_Call struct {
+	*mock.Call
+}
+
+// vectorReindexProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - targetVector string
+//   - payload vectorindex.ReindexTaskPayload
+func (_e *MockShardLike_Expecter) vectorReindexProgress(ctx interface{}, targetVector interface{}, payload interface{}) *MockShardLike_vectorReindexProgress_Call {
+	return &MockShardLike_vectorReindexProgress_Call{Call: _e.mock.On("vectorReindexProgress", ctx, targetVector, payload)}
+}
+
+func (_c *MockShardLike_vectorReindexProgress_Call) Run(run func(ctx context.Context, targetVector string, payload vectorindex.ReindexTaskPayload)) *MockShardLike_vectorReindexProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(vectorindex.ReindexTaskPayload))
+	})
+	return _c
+}
+
+func (_c *MockShardLike_vectorReindexProgress_Call) Return(_a0 bool, _a1 float64, _a2 error) *MockShardLike_vectorReindexProgress_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockShardLike_vectorReindexProgress_Call) RunAndReturn(run func(context.Context, string, vectorindex.ReindexTaskPayload) (bool, float64, error)) *MockShardLike_vectorReindexProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockShardLike creates a new instance of MockShardLike. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockShardLike(t interface {
@@ -197,6 +197,7 @@ func (i *Index) getShardsNodeStatus(ctx context.Context,
 					Loaded:               false,
 					ReplicationFactor:    replicationFactor,
 					NumberOfReplicas:     numberOfReplicas,
+					EncryptionStatus:     i.getShardEncryptionStatus(name),
 					// don't add compression status as this would trigger loading the shard
 				}
 				*status = append(*status, shardStatus)
@@ -239,8 +240,10 @@ func (i *Index) getShardsNodeStatus(ctx context.Context,
 			Compressed:             isAnyVectorIndexCompressed(shard),
 			Loaded:                 true,
 			AsyncReplicationStatus: shard.getAsyncReplicationStats(ctx),
+			VectorReindexStatus:    shard.getVectorReindexStatus(),
 			ReplicationFactor:      replicationFactor,
 			NumberOfReplicas:       numberOfReplicas,
+			EncryptionStatus:       i.getShardEncryptionStatus(name),
 		}
 		*status = append(*status, shardStatus)
 		shardCount++
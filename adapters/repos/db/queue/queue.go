@@ -32,6 +32,8 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/usecases/encryption"
 )
 
 const (
@@ -85,6 +87,7 @@ type DiskQueue struct {
 	onBatchProcessed func()
 	metrics          *Metrics
 	chunkSize        uint64
+	encryption       *encryption.Encryptor
 
 	// m protects the disk operations
 	m            sync.RWMutex
@@ -112,6 +115,9 @@ type DiskQueueOptions struct {
 	ChunkSize        uint64
 	OnBatchProcessed func()
 	Metrics          *Metrics
+	// Encryption of the chunk files, which are written in plaintext if it is
+	// nil. Chunks written before the encryption was enabled stay readable.
+	Encryption *encryption.Encryptor
 }
 
 func NewDiskQueue(opt DiskQueueOptions) (*DiskQueue, error) {
@@ -158,6 +164,7 @@ func NewDiskQueue(opt DiskQueueOptions) (*DiskQueue, error) {
 		metrics:          opt.Metrics,
 		onBatchProcessed: opt.OnBatchProcessed,
 		chunkSize:        opt.ChunkSize,
+		encryption:       opt.Encryption,
 	}
 
 	return &q, nil
@@ -178,10 +185,10 @@ func (q *DiskQueue) Init() error {
 	}
 
 	// create chunk reader
-	q.r = newChunkReader(q.dir, chunkList)
+	q.r = newChunkReader(q.dir, chunkList, q.encryption)
 
 	// create chunk writer
-	q.w, err = newChunkWriter(q.dir, q.r, q.Logger, q.chunkSize)
+	q.w, err = newChunkWriter(q.dir, q.r, q.Logger, q.chunkSize, q.encryption)
 	if err != nil {
 		return errors.Wrap(err, "failed to create chunk writer")
 	}
@@ -525,13 +532,14 @@ func (q *DiskQueue) analyzeDisk() ([]string, error) {
 			continue
 		}
 
-		fi, err := entry.Info()
+		filePath := filepath.Join(q.dir, entry.Name())
+
+		// the size of the contents, without the header of encrypted chunks
+		fi, err := encryption.Stat(filePath)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to get file info")
 		}
 
-		filePath := filepath.Join(q.dir, entry.Name())
-
 		if fi.Size() == 0 {
 			// best effort to remove empty files
 			_ = os.Remove(filePath)
@@ -604,7 +612,7 @@ func (q *DiskQueue) ListFiles(ctx context.Context, basePath string) ([]string, e
 }
 
 func (q *DiskQueue) readChunkRecordCount(path string) (uint64, error) {
-	f, err := os.Open(path)
+	f, err := q.encryption.Open(path)
 	if err != nil {
 		return 0, err
 	}
@@ -640,18 +648,18 @@ var readerPool = sync.Pool{
 type chunk struct {
 	path  string
 	r     *bufio.Reader
-	f     *os.File
+	f     *encryption.File
 	count uint64
 	size  uint64
 }
 
-func openChunk(path string) (*chunk, error) {
+func openChunk(enc *encryption.Encryptor, path string) (*chunk, error) {
 	var err error
 	c := chunk{
 		path: path,
 	}
 
-	c.f, err = os.Open(path)
+	c.f, err = enc.Open(path)
 	if err != nil {
 		return nil, err
 	}
@@ -690,7 +698,7 @@ func openChunk(path string) (*chunk, error) {
 	return &c, nil
 }
 
-func chunkFromFile(f *os.File) (*chunk, error) {
+func chunkFromFile(f *encryption.File) (*chunk, error) {
 	var err error
 	c := chunk{
 		path: f.Name(),
@@ -766,7 +774,8 @@ type chunkWriter struct {
 	maxSize     uint64
 	dir         string
 	w           lazyBufferedWriter
-	f           *os.File
+	f           *encryption.File
+	enc         *encryption.Encryptor
 	size        uint64
 	recordCount uint64
 	buf         [8]byte
@@ -774,12 +783,15 @@ type chunkWriter struct {
 	reader *chunkReader
 }
 
-func newChunkWriter(dir string, reader *chunkReader, logger logrus.FieldLogger, maxSize uint64) (*chunkWriter, error) {
+func newChunkWriter(dir string, reader *chunkReader, logger logrus.FieldLogger, maxSize uint64,
+	enc *encryption.Encryptor,
+) (*chunkWriter, error) {
 	ch := &chunkWriter{
 		dir:     dir,
 		reader:  reader,
 		logger:  logger,
 		maxSize: maxSize,
+		enc:     enc,
 	}
 
 	err := ch.Open()
@@ -870,7 +882,7 @@ func (w *chunkWriter) Create() error {
 	var err error
 
 	path := filepath.Join(w.dir, fmt.Sprintf(chunkFileFmt, time.Now().UnixMicro()))
-	w.f, err = os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0o644)
+	w.f, err = w.enc.OpenFile(path, os.O_CREATE|os.O_RDWR, 0o644)
 	if err != nil {
 		return errors.Wrap(err, "failed to create chunk file")
 	}
@@ -910,7 +922,7 @@ func (w *chunkWriter) Open() error {
 
 	lastChunk := entries[len(entries)-1].Name()
 
-	w.f, err = os.OpenFile(filepath.Join(w.dir, lastChunk), os.O_RDWR, 0o644)
+	w.f, err = w.enc.OpenFile(filepath.Join(w.dir, lastChunk), os.O_RDWR, 0o644)
 	if err != nil {
 		return errors.Wrap(err, "failed to open chunk file")
 	}
@@ -985,13 +997,9 @@ func (w *chunkWriter) Open() error {
 				Error(errors.Wrap(err, "queue ended abruptly, some elements may not have been recovered"))
 
 			// truncate the file to the last complete record
-			err = w.f.Truncate(int64(w.size) - int64(n))
-			if err != nil {
-				return errors.Wrap(err, "failed to truncate chunk file")
-			}
-			err = w.f.Sync()
+			err = w.truncate(int64(w.size) - int64(n))
 			if err != nil {
-				return errors.Wrap(err, "failed to sync chunk file")
+				return err
 			}
 			w.size -= uint64(n)
 			break
@@ -1014,13 +1022,9 @@ func (w *chunkWriter) Open() error {
 					Error(errors.Wrap(err, "queue ended abruptly, some elements may not have been recovered"))
 
 				// truncate the file to the last complete record
-				err = w.f.Truncate(int64(w.size) - 4 - int64(n))
-				if err != nil {
-					return errors.Wrap(err, "failed to truncate chunk file")
-				}
-				err = w.f.Sync()
+				err = w.truncate(int64(w.size) - 4 - int64(n))
 				if err != nil {
-					return errors.Wrap(err, "failed to sync chunk file")
+					return err
 				}
 				w.size -= 4 + uint64(n)
 				break
@@ -1043,6 +1047,34 @@ func (w *chunkWriter) Open() error {
 	return nil
 }
 
+// truncate cuts off a record which was not fully written. Encrypted chunks
+// can't be truncated in place, they are rewritten with a new data encryption
+// key instead and opened again.
+func (w *chunkWriter) truncate(size int64) error {
+	if !w.f.Encrypted() {
+		if err := w.f.Truncate(size); err != nil {
+			return errors.Wrap(err, "failed to truncate chunk file")
+		}
+		return errors.Wrap(w.f.Sync(), "failed to sync chunk file")
+	}
+
+	path := w.f.Name()
+	if err := w.f.Close(); err != nil {
+		return errors.Wrap(err, "failed to close chunk file")
+	}
+	if err := w.enc.Truncate(path, size); err != nil {
+		return errors.Wrap(err, "failed to truncate chunk file")
+	}
+
+	var err error
+	w.f, err = w.enc.OpenFile(path, os.O_RDWR, 0o644)
+	if err != nil {
+		return errors.Wrap(err, "failed to open chunk file")
+	}
+	w.w.Reset(w.f)
+	return nil
+}
+
 func (w *chunkWriter) IsFull() bool {
 	return w.f != nil && w.size >= w.maxSize
 }
@@ -1086,14 +1118,16 @@ type chunkReader struct {
 	dir       string
 	cursor    int
 	chunkList []string
-	chunks    map[string]*os.File
+	chunks    map[string]*encryption.File
+	enc       *encryption.Encryptor
 }
 
-func newChunkReader(dir string, chunkList []string) *chunkReader {
+func newChunkReader(dir string, chunkList []string, enc *encryption.Encryptor) *chunkReader {
 	return &chunkReader{
 		dir:       dir,
-		chunks:    make(map[string]*os.File),
+		chunks:    make(map[string]*encryption.File),
 		chunkList: chunkList,
+		enc:       enc,
 	}
 }
 
@@ -1119,7 +1153,7 @@ func (r *chunkReader) ReadChunk() (*chunk, error) {
 		return chunkFromFile(f)
 	}
 
-	return openChunk(path)
+	return openChunk(r.enc, path)
 }
 
 func (r *chunkReader) Close() error {
@@ -1134,7 +1168,7 @@ func (r *chunkReader) Close() error {
 	return nil
 }
 
-func (r *chunkReader) PromoteChunk(f *os.File) error {
+func (r *chunkReader) PromoteChunk(f *encryption.File) error {
 	r.m.Lock()
 	// do not keep more than 10 files open
 	if len(r.chunks) > 10 {
@@ -1193,7 +1227,7 @@ var _ = Queue(new(DiskQueue))
 // the underlying buffer only when the first Write is called.
 type lazyBufferedWriter struct {
 	w *bufio.Writer
-	f *os.File
+	f *encryption.File
 }
 
 func (w *lazyBufferedWriter) Write(p []byte) (nn int, err error) {
@@ -1212,7 +1246,7 @@ func (w *lazyBufferedWriter) Flush() error {
 	return w.w.Flush()
 }
 
-func (w *lazyBufferedWriter) Reset(f *os.File) {
+func (w *lazyBufferedWriter) Reset(f *encryption.File) {
 	w.f = f
 	if w.w != nil {
 		w.w.Reset(f)
@@ -1245,7 +1279,7 @@ var bufioWriterPool = sync.Pool{
 	},
 }
 
-func getBufioWriter(f *os.File) *bufio.Writer {
+func getBufioWriter(f *encryption.File) *bufio.Writer {
 	w := bufioWriterPool.Get().(*bufio.Writer)
 	if f != nil {
 		w.Reset(f)
@@ -14,7 +14,9 @@ package queue
 import (
 	"bufio"
 	"bytes"
+	"encoding/base64"
 	"encoding/binary"
+	"encoding/json"
 	"io"
 	"os"
 	"path/filepath"
@@ -24,6 +26,8 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/usecases/encryption"
 )
 
 func TestNewDiskQueue(t *testing.T) {
@@ -489,6 +493,116 @@ func TestPartialChunkRecovery(t *testing.T) {
 	}
 }
 
+func TestEncryptedQueue(t *testing.T) {
+	s := makeScheduler(t)
+	enc := newTestEncryptor(t)
+	dir := t.TempDir()
+
+	open := func(dir string) *DiskQueue {
+		q, err := NewDiskQueue(DiskQueueOptions{
+			ID:           "test_queue",
+			Scheduler:    s,
+			Logger:       newTestLogger(),
+			Dir:          dir,
+			TaskDecoder:  &mockTaskDecoder{},
+			StaleTimeout: 500 * time.Millisecond,
+			ChunkSize:    50,
+			Encryption:   enc,
+		})
+		require.NoError(t, err)
+		require.NoError(t, q.Init())
+		return q
+	}
+
+	// a full chunk with 3 records and a partial one with 3 records
+	q := open(dir)
+	pushMany(t, q, 1, 100, 200, 300, 400, 500, 600)
+	require.NoError(t, q.Close())
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	t.Run("chunks are encrypted", func(t *testing.T) {
+		for _, entry := range entries {
+			path := filepath.Join(dir, entry.Name())
+			_, encrypted, err := encryption.FileKeyID(path)
+			require.NoError(t, err)
+			require.True(t, encrypted)
+
+			raw, err := os.ReadFile(path)
+			require.NoError(t, err)
+			require.NotContains(t, string(raw), magicHeader)
+		}
+	})
+
+	t.Run("read after reopening", func(t *testing.T) {
+		q := open(dir)
+		defer q.Close()
+		require.Equal(t, int64(6), q.Size())
+
+		batch, err := q.DequeueBatch()
+		require.NoError(t, err)
+		require.NotNil(t, batch)
+		require.Len(t, batch.Tasks, 3)
+		for i, task := range batch.Tasks {
+			require.Equal(t, uint64(100*(i+1)), task.Key())
+		}
+	})
+
+	t.Run("partial record is cut off", func(t *testing.T) {
+		partial := filepath.Join(dir, entries[1].Name())
+		stat, err := os.Stat(partial)
+		require.NoError(t, err)
+		require.NoError(t, os.Truncate(partial, stat.Size()-2))
+
+		q := open(dir)
+		defer q.Close()
+		require.Equal(t, int64(5), q.Size())
+
+		_, encrypted, err := encryption.FileKeyID(partial)
+		require.NoError(t, err)
+		require.True(t, encrypted)
+
+		require.NoError(t, q.Push(makeRecord(1, 700)))
+		require.NoError(t, q.w.Promote())
+		var keys []uint64
+		for batch, err := q.DequeueBatch(); batch != nil; batch, err = q.DequeueBatch() {
+			require.NoError(t, err)
+			for _, task := range batch.Tasks {
+				keys = append(keys, task.Key())
+			}
+		}
+		require.Equal(t, []uint64{100, 200, 300, 400, 500, 700}, keys)
+	})
+
+	t.Run("empty chunk", func(t *testing.T) {
+		dir := t.TempDir()
+		f, err := enc.Create(filepath.Join(dir, "chunk-1.bin"))
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+
+		q := open(dir)
+		defer q.Close()
+		require.Equal(t, int64(0), q.Size())
+	})
+}
+
+func newTestEncryptor(t *testing.T) *encryption.Encryptor {
+	raw, err := json.Marshal(map[string]any{
+		"currentKeyId": "key-1",
+		"keys":         map[string]string{"key-1": base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32))},
+	})
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "keyfile.json")
+	require.NoError(t, os.WriteFile(path, raw, 0o600))
+
+	provider, err := encryption.NewLocalKeyProvider(path)
+	require.NoError(t, err)
+	return encryption.New(provider)
+}
+
 func TestQueueAutoReleaseResources(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,213 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+// recallMonitor periodically measures the recall@k of the vector indexes of
+// the loaded shards against an exact search and publishes it per collection
+// and target vector. Every measurement runs an exhaustive search per sampled
+// query, the monitoring is therefore opt-in and runs at a low frequency.
+type recallMonitor struct {
+	db *DB
+
+	// measurements are aborted on shutdown
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newRecallMonitor(db *DB) *recallMonitor {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &recallMonitor{db: db, ctx: ctx, cancel: cancel, done: make(chan struct{})}
+}
+
+func (m *recallMonitor) Start() {
+	enterrors.GoWrapper(m.run, m.db.logger)
+}
+
+func (m *recallMonitor) Shutdown() {
+	m.cancel()
+	<-m.done
+}
+
+func (m *recallMonitor) run() {
+	defer close(m.done)
+
+	// the first measurement waits for an interval as well, so that the shards
+	// had a chance to record queries
+	tick := time.NewTicker(m.db.config.RecallMonitoring.Interval)
+	defer tick.Stop()
+
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-tick.C:
+			m.measure(m.ctx)
+		}
+	}
+}
+
+func (m *recallMonitor) measure(ctx context.Context) {
+	cfg := m.db.config.RecallMonitoring
+
+	var indices map[string]*Index
+	func() {
+		m.db.indexLock.RLock()
+		defer m.db.indexLock.RUnlock()
+		indices = maps.Clone(m.db.indices)
+	}()
+
+	start := time.Now()
+	for _, index := range indices {
+		className := index.Config.ClassName.String()
+		if cfg.QuerySamples(className) == 0 {
+			continue
+		}
+
+		measurements, err := index.measureRecall(ctx, "", nil, cfg.K, cfg.SampleSize)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			m.db.logger.WithField("action", "recall_monitoring").
+				WithField("collection", className).
+				WithError(err).
+				Warn("failed to measure vector index recall")
+			continue
+		}
+
+		m.setRecallMetrics(index, className, measurements)
+	}
+
+	m.db.logger.WithFields(logrus.Fields{
+		"action": "recall_monitoring",
+		"took":   time.Since(start),
+	}).Debug("measured vector index recall")
+}
+
+// setRecallMetrics sets the recall metrics of the collection unless it was
+// dropped in the meantime. The metrics of a dropped collection are deleted
+// after it is closed, so they are set while holding the close lock to not
+// leave them behind.
+func (m *recallMonitor) setRecallMetrics(index *Index, className string,
+	measurements map[string]RecallMeasurement,
+) {
+	index.closeLock.RLock()
+	defer index.closeLock.RUnlock()
+
+	if index.closed {
+		return
+	}
+
+	for targetVector, measurement := range measurements {
+		m.db.promMetrics.VectorIndexRecall.With(prometheus.Labels{
+			"class_name":    className,
+			"target_vector": targetVector,
+			"k":             strconv.Itoa(measurement.K),
+		}).Set(measurement.Recall)
+		m.db.promMetrics.VectorIndexRecallSamples.With(prometheus.Labels{
+			"class_name":    className,
+			"target_vector": targetVector,
+			"source":        "query",
+		}).Set(float64(measurement.Queries))
+		m.db.promMetrics.VectorIndexRecallSamples.With(prometheus.Labels{
+			"class_name":    className,
+			"target_vector": targetVector,
+			"source":        "stored",
+		}).Set(float64(measurement.StoredVectors))
+	}
+}
+
+// measureRecall measures the recall@k of the vector indexes of the shards
+// and merges them per target vector. Only the loaded shards are measured if
+// shardName is empty, target vectors are limited to the given ones unless
+// none are given. Vector indexes which do not support measuring the recall
+// are skipped.
+func (i *Index) measureRecall(ctx context.Context, shardName string, targetVectors []string,
+	k, samples int,
+) (map[string]RecallMeasurement, error) {
+	var shards []ShardLike
+	if shardName != "" {
+		shard, release, err := i.GetShard(ctx, shardName)
+		if err != nil {
+			return nil, err
+		}
+		if shard == nil {
+			return nil, errors.New("shard not found")
+		}
+		defer release()
+		shards = append(shards, shard)
+	} else {
+		i.ForEachLoadedShard(func(_ string, shard ShardLike) error {
+			shards = append(shards, shard)
+			return nil
+		})
+	}
+
+	measurements := map[string]RecallMeasurement{}
+	for _, shard := range shards {
+		// collect the target vectors first, measuring holds the vector index
+		// lock of the shard
+		var shardTargets []string
+		shard.ForEachVectorIndex(func(targetVector string, _ VectorIndex) error {
+			if len(targetVectors) == 0 || slices.Contains(targetVectors, targetVector) {
+				shardTargets = append(shardTargets, targetVector)
+			}
+			return nil
+		})
+
+		for _, targetVector := range shardTargets {
+			measurement, err := shard.measureRecall(ctx, targetVector, k, samples)
+			if errors.Is(err, errRecallNotSupported) {
+				continue
+			}
+			if err != nil {
+				return nil, errors.Wrapf(err, "shard %q target vector %q", shard.Name(), targetVector)
+			}
+			measurements[targetVector] = measurements[targetVector].merge(measurement)
+		}
+	}
+	return measurements, nil
+}
+
+// DebugMeasureRecall measures the recall@k of the vector index of the target
+// vector on demand, on the given shard or all loaded shards of the index.
+// Like the recall monitoring it replays recent queries if they are recorded
+// and samples stored vectors otherwise.
+func (i *Index) DebugMeasureRecall(ctx context.Context, shardName, targetVector string,
+	k, samples int,
+) (RecallMeasurement, error) {
+	measurements, err := i.measureRecall(ctx, shardName, []string{targetVector}, k, samples)
+	if err != nil {
+		return RecallMeasurement{}, err
+	}
+	measurement, ok := measurements[targetVector]
+	if !ok {
+		return RecallMeasurement{}, errors.Wrapf(errRecallNotSupported,
+			"no vector index for target vector %q", targetVector)
+	}
+	return measurement, nil
+}
@@ -0,0 +1,183 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	replicationTypes "github.com/weaviate/weaviate/cluster/replication/types"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestRecallMonitoring(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	shardState := singleShardState()
+
+	class := &models.Class{
+		Class:               "TestRecallMonitoring",
+		InvertedIndexConfig: invertedConfig(),
+		VectorConfig: map[string]models.VectorConfig{
+			"hnsw": {
+				VectorIndexType:   "hnsw",
+				VectorIndexConfig: hnsw.NewDefaultUserConfig(),
+				Vectorizer:        map[string]any{"none": map[string]any{}},
+			},
+			"flat": {
+				VectorIndexType:   "flat",
+				VectorIndexConfig: flat.NewDefaultUserConfig(),
+				Vectorizer:        map[string]any{"none": map[string]any{}},
+			},
+		},
+		Properties: []*models.Property{},
+	}
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}},
+		shardState: shardState,
+	}
+
+	mockSchemaReader := schemaUC.NewMockSchemaReader(t)
+	mockSchemaReader.EXPECT().ReadOnlySchema().RunAndReturn(func() models.Schema {
+		return *schemaGetter.schema.Objects
+	}).Maybe()
+	mockSchemaReader.EXPECT().Shards(mock.Anything).Return(shardState.AllPhysicalShards(), nil).Maybe()
+	mockSchemaReader.EXPECT().Read(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(className string, retryIfClassNotFound bool, readFunc func(*models.Class, *sharding.State) error) error {
+		return readFunc(&models.Class{Class: className}, shardState)
+	}).Maybe()
+	mockSchemaReader.EXPECT().ShardReplicas(mock.Anything, mock.Anything).Return([]string{"node1"}, nil).Maybe()
+	mockReplicationFSMReader := replicationTypes.NewMockReplicationFSMReader(t)
+	mockReplicationFSMReader.EXPECT().FilterOneShardReplicasRead(mock.Anything, mock.Anything, mock.Anything).Return([]string{"node1"}).Maybe()
+	mockReplicationFSMReader.EXPECT().FilterOneShardReplicasWrite(mock.Anything, mock.Anything, mock.Anything).Return([]string{"node1"}, nil).Maybe()
+	mockNodeSelector := cluster.NewMockNodeSelector(t)
+	mockNodeSelector.EXPECT().LocalName().Return("node1").Maybe()
+	mockNodeSelector.EXPECT().NodeHostname(mock.Anything).Return("node1", true).Maybe()
+	repo, err := New(logger, "node1", Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  t.TempDir(),
+		QueryMaximumResults:       100,
+		MaxImportGoroutinesFactor: 1,
+		DisableLazyLoadShards:     true,
+		RecallMonitoring: config.RecallMonitoringConfig{
+			Enabled: true,
+			// measurements are triggered by the test
+			Interval:   time.Hour,
+			SampleSize: 5,
+			K:          5,
+		},
+	}, &FakeRemoteClient{}, &FakeNodeResolver{}, &FakeRemoteNodeClient{}, &FakeReplicationClient{}, monitoring.GetMetrics(), memwatch.NewDummyMonitor(),
+		mockNodeSelector, mockSchemaReader, mockReplicationFSMReader)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(ctx))
+	defer repo.Shutdown(ctx)
+	require.NotNil(t, repo.recallMonitor)
+
+	r := rand.New(rand.NewSource(7))
+	randomVector := func() []float32 {
+		vec := make([]float32, 16)
+		for j := range vec {
+			vec[j] = r.Float32()
+		}
+		return vec
+	}
+	for i := 0; i < 200; i++ {
+		vec := randomVector()
+		require.Nil(t, repo.PutObject(ctx, &models.Object{ID: strfmt.UUID(uuid.New().String()), Class: class.Class},
+			nil, map[string][]float32{"hnsw": vec, "flat": vec}, nil, nil, 0))
+	}
+
+	index := repo.GetIndex(schema.ClassName(class.Class))
+	var shard ShardLike
+	index.shards.Range(func(_ string, s ShardLike) error {
+		shard = s
+		return nil
+	})
+
+	t.Run("samples stored vectors without queries", func(t *testing.T) {
+		measurement, err := index.DebugMeasureRecall(ctx, "", "hnsw", 5, 5)
+		require.Nil(t, err)
+		assert.Equal(t, 5, measurement.K)
+		assert.Equal(t, 0, measurement.Queries)
+		assert.Equal(t, 5, measurement.StoredVectors)
+		assert.Greater(t, measurement.Recall, 0.8)
+	})
+
+	t.Run("replays recorded queries", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			_, _, err := shard.ObjectVectorSearch(ctx, []models.Vector{randomVector()}, []string{"hnsw"},
+				0, 3, nil, nil, nil, additional.Properties{}, nil, nil)
+			require.Nil(t, err)
+		}
+
+		measurement, err := index.DebugMeasureRecall(ctx, shard.Name(), "hnsw", 5, 5)
+		require.Nil(t, err)
+		assert.Equal(t, 3, measurement.Queries)
+		assert.Equal(t, 2, measurement.StoredVectors)
+		assert.Greater(t, measurement.Recall, 0.8)
+	})
+
+	t.Run("flat index is not measured", func(t *testing.T) {
+		_, err := index.DebugMeasureRecall(ctx, "", "flat", 5, 5)
+		require.ErrorIs(t, err, errRecallNotSupported)
+	})
+
+	t.Run("monitoring publishes metrics", func(t *testing.T) {
+		repo.recallMonitor.measure(ctx)
+
+		metrics := monitoring.GetMetrics()
+		recall, err := metrics.VectorIndexRecall.GetMetricWith(prometheus.Labels{
+			"class_name":    class.Class,
+			"target_vector": "hnsw",
+			"k":             "5",
+		})
+		require.Nil(t, err)
+		assert.Greater(t, testutil.ToFloat64(recall), 0.8)
+
+		queries, err := metrics.VectorIndexRecallSamples.GetMetricWith(prometheus.Labels{
+			"class_name":    class.Class,
+			"target_vector": "hnsw",
+			"source":        "query",
+		})
+		require.Nil(t, err)
+		assert.Equal(t, float64(3), testutil.ToFloat64(queries))
+
+		// no metrics for indexes which do not support measuring the recall
+		assert.Equal(t, 1, testutil.CollectAndCount(metrics.VectorIndexRecall, "vector_index_recall"))
+
+		require.Nil(t, metrics.DeleteClass(class.Class))
+		assert.Equal(t, 0, testutil.CollectAndCount(metrics.VectorIndexRecall, "vector_index_recall"))
+	})
+}
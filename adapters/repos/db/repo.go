@@ -28,6 +28,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/weaviate/weaviate/adapters/repos/db/indexcheckpoint"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/queue"
 	"github.com/weaviate/weaviate/adapters/repos/db/roaringset"
 	clusterReplication "github.com/weaviate/weaviate/cluster/replication"
@@ -42,6 +43,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/config"
 	configRuntime "github.com/weaviate/weaviate/usecases/config/runtime"
+	"github.com/weaviate/weaviate/usecases/encryption"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/replica"
@@ -99,6 +101,8 @@ type DB struct {
 	// in the case of metrics grouping we need to observe some metrics
 	// node-centric, rather than shard-centric
 	metricsObserver *nodeWideMetricsObserver
+	// measures the recall of the vector indexes if enabled, see recall_monitor.go
+	recallMonitor *recallMonitor
 
 	shardLoadLimiter ShardLoadLimiter
 
@@ -305,11 +309,24 @@ type Config struct {
 	InvertedSorterDisabled      *configRuntime.DynamicValue[bool]
 	MaintenanceModeEnabled      func() bool
 	AsyncIndexingEnabled        bool
+	ChangeStreamRetention       int
+	RecallMonitoring            config.RecallMonitoringConfig
+	Encryption                  *encryption.Encryptor
+	SegmentTiering              func(collection string) *lsmkv.SegmentTiering
 
 	HFreshEnabled   bool
 	OperationalMode *configRuntime.DynamicValue[string]
 }
 
+// segmentTiering returns the tiering of the segments of the collection, nil
+// if they are kept on local disk
+func (db *DB) segmentTiering(collection string) *lsmkv.SegmentTiering {
+	if db.config.SegmentTiering == nil {
+		return nil
+	}
+	return db.config.SegmentTiering(collection)
+}
+
 // GetIndex returns the index if it exists or nil if it doesn't
 // by default it will retry 3 times between 0-150 ms to get the index
 // to handle the eventual consistency.
@@ -414,6 +431,10 @@ func (db *DB) Shutdown(ctx context.Context) error {
 		db.metricsObserver.Shutdown()
 	}
 
+	if db.recallMonitor != nil {
+		db.recallMonitor.Shutdown()
+	}
+
 	db.indexLock.Lock()
 	defer db.indexLock.Unlock()
 	for id, index := range db.indices {
@@ -12,6 +12,11 @@
 package roaringset
 
 import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 )
 
@@ -73,3 +78,76 @@ func (c *segmentCursor) Seek(key []byte) ([]byte, BitmapLayer, error) {
 	c.nextOffset = node.Start
 	return c.Next()
 }
+
+// SegmentCursorPread is the counterpart of the segment cursor for segments
+// whose payload is not part of a buffer, e.g. encrypted segments which are
+// decrypted on every read. Each node is read into a buffer of its own, so
+// that returned keys and bitmaps stay valid after the cursor moved on, just
+// like the ones of the buffer based cursor.
+type SegmentCursorPread struct {
+	index      Seeker
+	reader     io.ReaderAt
+	size       uint64
+	nextOffset uint64
+	lenBuf     []byte
+}
+
+// NewSegmentCursorPread creates a cursor for a single disk segment. Just like
+// for [NewSegmentCursor], the reader must start at the payload and size must
+// be the size of the payload only.
+func NewSegmentCursorPread(reader io.ReaderAt, size uint64, index Seeker) *SegmentCursorPread {
+	return &SegmentCursorPread{
+		index:  index,
+		reader: reader,
+		size:   size,
+		lenBuf: make([]byte, 8),
+	}
+}
+
+func (c *SegmentCursorPread) Next() ([]byte, BitmapLayer, error) {
+	if c.nextOffset >= c.size {
+		return nil, BitmapLayer{}, nil
+	}
+
+	if err := c.readAt(c.lenBuf, c.nextOffset); err != nil {
+		return nil, BitmapLayer{}, fmt.Errorf("read node length: %w", err)
+	}
+	nodeBuf := make([]byte, binary.LittleEndian.Uint64(c.lenBuf))
+	if err := c.readAt(nodeBuf, c.nextOffset); err != nil {
+		return nil, BitmapLayer{}, fmt.Errorf("read node: %w", err)
+	}
+
+	sn := NewSegmentNodeFromBuffer(nodeBuf)
+	c.nextOffset += sn.Len()
+	layer := BitmapLayer{
+		Additions: sn.Additions(),
+		Deletions: sn.Deletions(),
+	}
+	return sn.PrimaryKey(), layer, nil
+}
+
+func (c *SegmentCursorPread) First() ([]byte, BitmapLayer, error) {
+	c.nextOffset = 0
+	return c.Next()
+}
+
+func (c *SegmentCursorPread) Seek(key []byte) ([]byte, BitmapLayer, error) {
+	node, err := c.index.Seek(key)
+	if err != nil {
+		return nil, BitmapLayer{}, err
+	}
+	c.nextOffset = node.Start
+	return c.Next()
+}
+
+func (c *SegmentCursorPread) readAt(buf []byte, offset uint64) error {
+	n, err := c.reader.ReadAt(buf, int64(offset))
+	if n == len(buf) {
+		// a read up to the end of the reader may report io.EOF
+		return nil
+	}
+	if err == nil || errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
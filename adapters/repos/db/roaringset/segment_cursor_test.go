@@ -12,7 +12,9 @@
 package roaringset
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -80,6 +82,50 @@ func TestSegmentCursor(t *testing.T) {
 	})
 }
 
+func TestSegmentCursorPread(t *testing.T) {
+	seg, offsets := createDummySegment(t, 5)
+
+	t.Run("starting from beginning, page through all", func(t *testing.T) {
+		c := NewSegmentCursorPread(bytes.NewReader(seg), uint64(len(seg)), nil)
+		it := uint64(0)
+		for key, layer, err := c.First(); key != nil; key, layer, err = c.Next() {
+			require.Nil(t, err)
+			assert.Equal(t, []byte(fmt.Sprintf("%05d", it)), key)
+			assert.True(t, layer.Additions.Contains(it*4))
+			assert.True(t, layer.Additions.Contains(it*4+1))
+			assert.True(t, layer.Deletions.Contains(it*4+2))
+			assert.True(t, layer.Deletions.Contains(it*4+3))
+			it++
+		}
+
+		assert.Equal(t, uint64(5), it)
+	})
+
+	t.Run("seek and iterate from there", func(t *testing.T) {
+		seeker := createDummySeeker(t, offsets, 3)
+		c := NewSegmentCursorPread(bytes.NewReader(seg), uint64(len(seg)), seeker)
+
+		it := uint64(3)
+		for key, layer, err := c.Seek([]byte("dummyseeker")); key != nil; key, layer, err = c.Next() {
+			require.Nil(t, err)
+			assert.Equal(t, []byte(fmt.Sprintf("%05d", it)), key)
+			assert.True(t, layer.Additions.Contains(it*4))
+			assert.True(t, layer.Deletions.Contains(it*4+3))
+			it++
+		}
+
+		assert.Equal(t, uint64(5), it)
+	})
+
+	t.Run("truncated segment", func(t *testing.T) {
+		c := NewSegmentCursorPread(bytes.NewReader(seg[:len(seg)-1]), uint64(len(seg)), nil)
+		var err error
+		for _, _, err = c.First(); err == nil; _, _, err = c.Next() {
+		}
+		assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	})
+}
+
 func createDummySegment(t *testing.T, count uint64) ([]byte, []uint64) {
 	out := []byte{}
 	offsets := []uint64{}
@@ -0,0 +1,209 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/cluster/router/executor"
+	routerTypes "github.com/weaviate/weaviate/cluster/router/types"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// VectorSearchBatch searches the nearest objects of each of the query vectors
+// with the shared filters and pagination of the params. It returns one result
+// list per query vector, in the order of the query vectors.
+func (db *DB) VectorSearchBatch(ctx context.Context,
+	params dto.GetParams, targetVector string, searchVectors [][]float32,
+) ([][]search.Result, error) {
+	start := time.Now()
+	defer func() {
+		took := time.Since(start)
+		db.logger.WithFields(logrus.Fields{
+			"action":       "vector_search_batch_completed",
+			"took":         took,
+			"params":       params,
+			"targetVector": targetVector,
+			"queries":      len(searchVectors),
+		}).Debugf("batch vector search query completed in %s", took)
+	}()
+
+	totalLimit, err := db.getTotalLimit(params.Pagination, params.AdditionalProperties)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pagination params: %w", err)
+	}
+	if totalLimit < 0 {
+		return nil, errors.New("batch vector search requires a limit")
+	}
+
+	idx := db.GetIndex(schema.ClassName(params.ClassName))
+	if idx == nil {
+		return nil, fmt.Errorf("tried to browse non-existing index for %s", params.ClassName)
+	}
+
+	ress, distss, err := idx.objectVectorSearchBatch(ctx, searchVectors, targetVector,
+		totalLimit, params.Filters, params.AdditionalProperties, params.ReplicationProperties,
+		params.Tenant, params.Properties.GetPropertyNames())
+	if err != nil {
+		return nil, fmt.Errorf("object vector search batch at index %s: %w", idx.ID(), err)
+	}
+
+	out := make([][]search.Result, len(ress))
+	for i := range ress {
+		out[i], err = db.ResolveReferences(ctx,
+			storobj.SearchResultsWithDists(db.getStoreObjects(ress[i], params.Pagination),
+				params.AdditionalProperties, db.getDists(distss[i], params.Pagination)),
+			params.Properties, nil, params.AdditionalProperties, params.Tenant)
+		if err != nil {
+			return nil, fmt.Errorf("query %d: %w", i, err)
+		}
+	}
+	return out, nil
+}
+
+// objectVectorSearchBatch searches the query vectors together on the local
+// shards. Remote shards are searched one query after another.
+func (i *Index) objectVectorSearchBatch(ctx context.Context, searchVectors [][]float32,
+	targetVector string, limit int, localFilters *filters.LocalFilter,
+	additionalProps additional.Properties, replProps *additional.ReplicationProperties,
+	tenant string, properties []string,
+) ([][]*storobj.Object, [][]float32, error) {
+	cl := i.consistencyLevel(replProps, routerTypes.ConsistencyLevelOne)
+	readPlan, err := i.buildReadRoutingPlan(cl, tenant)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	eg := enterrors.NewErrorGroupWrapper(i.logger, "tenant:", tenant)
+	eg.SetLimit(_NUMCPU*2 + 1)
+	m := &sync.Mutex{}
+
+	outs := make([][]*storobj.Object, len(searchVectors))
+	distss := make([][]float32, len(searchVectors))
+	add := func(objss [][]*storobj.Object, shardDistss [][]float32) {
+		m.Lock()
+		defer m.Unlock()
+		for q := range objss {
+			outs[q] = append(outs[q], objss[q]...)
+			distss[q] = append(distss[q], shardDistss[q]...)
+		}
+	}
+
+	remoteSearch := func(shardName string) error {
+		objss := make([][]*storobj.Object, len(searchVectors))
+		shardDistss := make([][]float32, len(searchVectors))
+		for q, searchVector := range searchVectors {
+			objs, dists, err := i.remoteShardSearch(ctx, []models.Vector{searchVector},
+				[]string{targetVector}, 0, limit, localFilters, nil, nil, additionalProps,
+				nil, properties, tenant, shardName)
+			if err != nil {
+				return fmt.Errorf("remote shard object search %s: %w", shardName, err)
+			}
+			objss[q], shardDistss[q] = objs, dists
+		}
+		add(objss, shardDistss)
+		return nil
+	}
+	localSearch := func(shardName string) error {
+		shard, release, err := i.GetShard(ctx, shardName)
+		if err != nil {
+			return err
+		}
+		if shard == nil {
+			return remoteSearch(shardName)
+		}
+		defer release()
+
+		if shard.GetStatus() == storagestate.StatusLoading {
+			return enterrors.NewErrUnprocessable(fmt.Errorf("local %s shard is not ready", shardName))
+		}
+		localCtx := helpers.InitSlowQueryDetails(ctx)
+		helpers.AnnotateSlowQueryLog(localCtx, "is_coordinator", true)
+		objss, shardDistss, err := shard.ObjectVectorSearchBatch(localCtx, searchVectors,
+			targetVector, limit, localFilters, additionalProps, properties)
+		if err != nil {
+			return fmt.Errorf("local shard object search %s: %w", shard.ID(), err)
+		}
+		if i.shardHasMultipleReplicasRead(tenant, shardName) {
+			for _, objs := range objss {
+				storobj.AddOwnership(objs, i.getSchema.NodeName(), shardName)
+			}
+		}
+		add(objss, shardDistss)
+		return nil
+	}
+
+	err = executor.ExecuteForEachShard(readPlan,
+		func(replica routerTypes.Replica) error {
+			shardName := replica.ShardName
+			eg.Go(func() error {
+				return localSearch(shardName)
+			}, shardName)
+			return nil
+		},
+		func(replica routerTypes.Replica) error {
+			shardName := replica.ShardName
+			eg.Go(func() error {
+				return remoteSearch(shardName)
+			}, shardName)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error executing search for each shard: %w", err)
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, nil, err
+	}
+
+	checkConsistency := i.anyShardHasMultipleReplicasRead(tenant, readPlan.Shards())
+	for q := range outs {
+		if i.Config.ForceFullReplicasSearch {
+			outs[q], distss[q], err = searchResultDedup(outs[q], distss[q])
+			if err != nil {
+				return nil, nil, fmt.Errorf("could not deduplicate result after full replicas search: %w", err)
+			}
+		}
+		if len(readPlan.Shards()) == 1 {
+			continue
+		}
+
+		outs[q], distss[q] = newDistancesSorter().sort(outs[q], distss[q])
+		if len(outs[q]) > limit {
+			outs[q] = outs[q][:limit]
+			distss[q] = distss[q][:limit]
+		}
+		if checkConsistency {
+			if err := i.replicator.CheckConsistency(ctx, cl, outs[q]); err != nil {
+				i.logger.WithField("action", "object_vector_search_batch").
+					Errorf("failed to check consistency of search results: %v", err)
+			}
+		}
+	}
+
+	return outs, distss, nil
+}
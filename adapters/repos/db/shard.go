@@ -26,6 +26,7 @@ import (
 	shardusage "github.com/weaviate/weaviate/adapters/repos/db/shard_usage"
 	"go.etcd.io/bbolt"
 
+	"github.com/weaviate/weaviate/adapters/repos/db/changestream"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/indexcheckpoint"
 	"github.com/weaviate/weaviate/adapters/repos/db/indexcounter"
@@ -50,6 +51,7 @@ import (
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex"
 	"github.com/weaviate/weaviate/usecases/file"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
@@ -87,6 +89,7 @@ type ShardLike interface {
 	Exists(ctx context.Context, id strfmt.UUID) (bool, error)
 	ObjectSearch(ctx context.Context, limit int, filters *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking, sort []filters.Sort, cursor *filters.Cursor, additional additional.Properties, properties []string) ([]*storobj.Object, []float32, error)
 	ObjectVectorSearch(ctx context.Context, searchVectors []models.Vector, targetVectors []string, targetDist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties, targetCombination *dto.TargetCombination, properties []string) ([]*storobj.Object, []float32, error)
+	ObjectVectorSearchBatch(ctx context.Context, searchVectors [][]float32, targetVector string, limit int, filters *filters.LocalFilter, additional additional.Properties, properties []string) ([][]*storobj.Object, [][]float32, error)
 	UpdateVectorIndexConfig(ctx context.Context, updated schemaConfig.VectorIndexConfig) error
 	UpdateVectorIndexConfigs(ctx context.Context, updated map[string]schemaConfig.VectorIndexConfig) error
 	AddReferencesBatch(ctx context.Context, refs objects.BatchReferences) []error
@@ -98,6 +101,11 @@ type ShardLike interface {
 	drop(keepFiles bool) error
 	HaltForTransfer(ctx context.Context, offloading bool, inactivityTimeout time.Duration) error
 	initPropertyBuckets(ctx context.Context, eg *enterrors.ErrorGroupWrapper, lazyLoadSegments bool, props ...*models.Property)
+	dropProperty(ctx context.Context, propName string) error
+	// reindexVectorIndex rebuilds the vector index of the target vector if the
+	// updated config has another index type, distance or quantizer than the
+	// previous one
+	reindexVectorIndex(ctx context.Context, targetVector string, previous, updated schemaConfig.VectorIndexConfig) error
 	ListBackupFiles(ctx context.Context, ret *backup.ShardDescriptor) error
 	resumeMaintenanceCycles(ctx context.Context) error
 	GetFileMetadata(ctx context.Context, relativeFilePath string) (file.FileMetadata, error)
@@ -126,6 +134,9 @@ type ShardLike interface {
 
 	SetAsyncReplicationEnabled(ctx context.Context, enabled bool) error
 
+	// ChangeStream returns the log of object writes, nil if change data capture is disabled
+	ChangeStream() *changestream.Log
+
 	isReadOnly() error
 	pathLSM() string
 
@@ -177,6 +188,20 @@ type ShardLike interface {
 
 	// getAsyncReplicationStats returns all current sync replication stats for this node/shard
 	getAsyncReplicationStats(ctx context.Context) []*models.AsyncReplicationStatus
+	// getVectorReindexStatus returns the status of the vector indexes being rebuilt
+	getVectorReindexStatus() []*models.VectorReindexStatus
+	// vectorReindexProgress returns whether the target vector is served by the
+	// index described by the payload, and how far it is built otherwise
+	vectorReindexProgress(ctx context.Context, targetVector string, payload vectorindex.ReindexTaskPayload) (bool, float64, error)
+	// measureRecall measures the recall@k of the vector index of the target
+	// vector against an exact search, see shard_vector_recall.go
+	measureRecall(ctx context.Context, targetVector string, k, samples int) (RecallMeasurement, error)
+	// startVectorIndexMaintenance, getVectorIndexMaintenance and
+	// cancelVectorIndexMaintenance run and inspect maintenance operations on
+	// the vector index of the target vector, see shard_vector_maintenance.go
+	startVectorIndexMaintenance(ctx context.Context, targetVector, operation string) (*models.VectorIndexMaintenanceOperation, error)
+	getVectorIndexMaintenance(targetVector string) (*models.VectorIndexMaintenanceStatus, error)
+	cancelVectorIndexMaintenance(targetVector, id string) error
 
 	Metrics() *Metrics
 
@@ -221,6 +246,26 @@ type Shard struct {
 	queue         *VectorIndexQueue
 	vectorIndexes map[string]VectorIndex
 	queues        map[string]*VectorIndexQueue
+	// vectorIndexReaders holds a *sync.WaitGroup per target vector counting
+	// the searches on its vector index, see acquireVectorIndex
+	vectorIndexReaders sync.Map
+
+	// generations of the named vector indexes and the ones being rebuilt,
+	// see shard_vector_reindex.go
+	vectorReindexLock   sync.Mutex
+	vectorReindexStates vectorReindexStates
+	vectorReindexers    map[string]*vectorReindexer
+
+	// recent query vectors replayed by the recall monitoring, nil if the
+	// monitoring is disabled
+	recallQueries *recallQuerySamples
+
+	// maintenance operations run on demand on the vector indexes, see
+	// shard_vector_maintenance.go
+	vectorIndexMaintenance vectorIndexMaintenance
+
+	// point-in-time snapshots of the buckets, see shard_read_snapshot.go
+	readSnapshots readSnapshots
 
 	// async replication
 	asyncReplicationRWMux           sync.RWMutex
@@ -230,6 +275,9 @@ type Shard struct {
 	minimalHashtreeInitializationCh chan struct{}
 	asyncReplicationCancelFunc      context.CancelFunc
 
+	// change data capture, nil if disabled
+	changeStream *changestream.Log
+
 	lastComparedHosts                 []string
 	lastComparedHostsMux              sync.RWMutex
 	asyncReplicationStatsByTargetNode map[string]*hashBeatHostStats
@@ -293,6 +341,11 @@ type Shard struct {
 
 	usingBlockMaxWAND bool
 
+	// indexingPositions is set while the positions of existing objects are
+	// indexed, so that the write path indexes the positions of new objects
+	// before indexPositions is enabled
+	indexingPositions atomic.Bool
+
 	// shutdownRequested marks shard as requested for shutdown
 	shutdownRequested atomic.Bool
 
@@ -385,6 +438,14 @@ func (s *Shard) UpdateVectorIndexConfigs(ctx context.Context, updated map[string
 	var err error
 	for targetVector, targetCfg := range updated {
 		if index, ok := s.GetVectorIndex(targetVector); ok {
+			if rebuilding, ok := s.rebuildingVectorIndex(targetVector); ok {
+				// the serving index has another type or distance, the config
+				// only applies to the index which replaces it
+				if rebuilding == nil {
+					continue
+				}
+				index = rebuilding
+			}
 			wg.Add(1)
 			if err = index.UpdateUserConfig(targetCfg, wg.Done); err != nil {
 				break
@@ -430,7 +491,8 @@ func (s *Shard) ObjectCountAsync(_ context.Context) (int64, error) {
 }
 
 func (s *Shard) ObjectStorageSize(ctx context.Context) (int64, error) {
-	metrics, err := shardusage.CalculateUnloadedObjectsMetrics(s.index.logger, s.index.path(), s.name, false)
+	metrics, err := shardusage.CalculateUnloadedObjectsMetrics(s.index.logger, s.index.path(), s.name, false,
+		s.index.Config.Encryption)
 	if err != nil {
 		return 0, err
 	}
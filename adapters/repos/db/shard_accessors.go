@@ -14,6 +14,8 @@
 package db
 
 import (
+	"sync"
+
 	"github.com/weaviate/weaviate/adapters/repos/db/indexcounter"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
@@ -101,6 +103,26 @@ func (s *Shard) GetVectorIndex(targetVector string) (VectorIndex, bool) {
 	return index, ok
 }
 
+// acquireVectorIndex is GetVectorIndex for searches. The index is not dropped
+// until release is called, even if a reindex replaces it in the meantime,
+// see swapVectorIndex.
+func (s *Shard) acquireVectorIndex(targetVector string) (index VectorIndex, release func(), ok bool) {
+	s.vectorIndexMu.RLock()
+	defer s.vectorIndexMu.RUnlock()
+
+	if s.isTargetVectorLegacyWithLock(targetVector) {
+		return s.vectorIndex, func() {}, s.vectorIndex != nil
+	}
+
+	index, ok = s.vectorIndexes[targetVector]
+	if !ok {
+		return nil, func() {}, false
+	}
+	readers, _ := s.vectorIndexReaders.LoadOrStore(targetVector, &sync.WaitGroup{})
+	readers.(*sync.WaitGroup).Add(1)
+	return index, readers.(*sync.WaitGroup).Done, true
+}
+
 func (s *Shard) isTargetVectorLegacyWithLock(targetVector string) bool {
 	if targetVector == "" {
 		return true
@@ -17,6 +17,7 @@ import (
 
 	"github.com/weaviate/weaviate/adapters/repos/db/aggregator"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/usecases/modules"
 )
 
@@ -25,14 +26,28 @@ func (s *Shard) Aggregate(ctx context.Context, params aggregation.Params, module
 
 	// we only need the index queue for vector search
 	if params.NearObject != nil || params.NearVector != nil || params.Hybrid != nil || params.SearchVector != nil {
-		idx, ok := s.GetVectorIndex(params.TargetVector)
+		// the vector indexes are not part of the read snapshots
+		if params.Snapshot != "" {
+			return nil, errReadSnapshotVectorSearch
+		}
+		idx, release, ok := s.acquireVectorIndex(params.TargetVector)
 		if !ok {
 			return nil, fmt.Errorf("no vector index for target vector %q", params.TargetVector)
 		}
+		defer release()
 		vectorIndex = idx
 	}
 
-	return aggregator.New(s.store, params, s.index.getSchema, s.index.classSearcher,
+	if params.Snapshot != "" {
+		ctx = dto.ContextWithReadSnapshot(ctx, params.Snapshot)
+	}
+	store, done, err := s.readStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	return aggregator.New(store, params, s.index.getSchema, s.index.classSearcher,
 		s.index.stopwords, s.versioner.Version(), vectorIndex, s.index.logger, s.GetPropertyLengthTracker(),
 		s.isFallbackToSearchable, s.tenant(), s.index.Config.QueryNestedRefLimit, s.bitmapFactory, modules, s.index.Config.QueryHybridMaximumResults).
 		Do(ctx)
@@ -192,6 +192,12 @@ func (s *Shard) ListBackupFiles(ctx context.Context, ret *backup.ShardDescriptor
 		return err
 	}
 
+	files, err := s.listVectorReindexBackupFiles()
+	if err != nil {
+		return fmt.Errorf("list vector reindex files: %w", err)
+	}
+	ret.Files = append(ret.Files, files...)
+
 	err = s.ForEachVectorIndex(func(targetVector string, idx VectorIndex) error {
 		files, err := idx.ListFiles(ctx, s.index.Config.RootPath)
 		if err != nil {
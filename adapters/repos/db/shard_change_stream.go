@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/changestream"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+)
+
+func (s *Shard) initChangeStream(ctx context.Context) error {
+	err := s.store.CreateOrLoadBucket(ctx, helpers.ChangeStreamBucketLSM,
+		s.makeDefaultBucketOptions(lsmkv.StrategyReplace)...)
+	if err != nil {
+		return fmt.Errorf("create change stream bucket: %w", err)
+	}
+
+	log, err := changestream.New(s.store.Bucket(helpers.ChangeStreamBucketLSM),
+		uint64(s.index.Config.ChangeStreamRetention))
+	if err != nil {
+		return fmt.Errorf("init change stream: %w", err)
+	}
+
+	s.changeStream = log
+	return nil
+}
+
+// ChangeStream returns the log of all object writes to this shard. It is nil
+// if change data capture is disabled.
+func (s *Shard) ChangeStream() *changestream.Log {
+	return s.changeStream
+}
+
+// mayRecordChange appends a write to the change stream if change data capture
+// is enabled. It must be called while holding the object's docID lock, so that
+// the order of events matches the order of writes of the same object.
+func (s *Shard) mayRecordChange(op changestream.Operation, idBytes []byte,
+	updateTime int64, objBinary []byte,
+) error {
+	if s.changeStream == nil {
+		return nil
+	}
+	return s.changeStream.Append(op, idBytes, updateTime, objBinary)
+}
+
+func (s *Shard) mayRecordDeletion(idBytes []byte, deletionTime time.Time) error {
+	if deletionTime.IsZero() {
+		deletionTime = time.Now()
+	}
+	return s.mayRecordChange(changestream.OperationDelete, idBytes, deletionTime.UnixMilli(), nil)
+}
+
+func (s *Shard) mayCloseChangeStream() {
+	if s.changeStream != nil {
+		s.changeStream.Close()
+	}
+}
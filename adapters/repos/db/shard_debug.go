@@ -45,13 +45,17 @@ func (s *Shard) DebugResetVectorIndex(ctx context.Context, targetVector string)
 	}
 
 	var newConfig schemaConfig.VectorIndexConfig
+	var indexName string
 	if targetVector == "" {
 		newConfig = s.index.vectorIndexUserConfig
 	} else {
-		newConfig = s.index.vectorIndexUserConfigs[targetVector]
+		indexName, newConfig, err = s.servingVectorIndex(targetVector, s.index.vectorIndexUserConfigs[targetVector])
+		if err != nil {
+			return errors.Wrap(err, "read vector index state")
+		}
 	}
 
-	vidx, err = s.initVectorIndex(ctx, targetVector, newConfig, false)
+	vidx, err = s.initVectorIndex(ctx, targetVector, indexName, newConfig, false)
 	if err != nil {
 		return errors.Wrap(err, "init vector index")
 	}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 )
 
@@ -34,6 +35,7 @@ import (
 func (s *Shard) drop(keepFiles bool) (err error) {
 	s.shutCtxCancel(fmt.Errorf("drop %q", s.ID()))
 	s.reindexer.Stop(s, fmt.Errorf("shard drop"))
+	s.mayCloseChangeStream()
 
 	s.metrics.DeleteShardLabels(s.index.Config.ClassName.String(), s.name)
 	s.metrics.baseMetrics.StartUnloadingShard()
@@ -58,6 +60,11 @@ func (s *Shard) drop(keepFiles bool) (err error) {
 	ctx, cancel := context.WithTimeout(context.TODO(), 20*time.Second)
 	defer cancel()
 
+	s.stopVectorIndexMaintenance()
+	if err = s.stopVectorReindexes(ctx, !keepFiles); err != nil {
+		return fmt.Errorf("stop vector reindexes at %s: %w", s.path(), err)
+	}
+
 	// queues need to be closed first to make sure they are not writing anymore
 	// to their associated vector index, as they might still be using the store
 	// and other resources we are about to drop.
@@ -92,11 +99,14 @@ func (s *Shard) drop(keepFiles bool) (err error) {
 		return err
 	}
 
+	s.closeReadSnapshots()
+
 	if err = s.store.Shutdown(ctx); err != nil {
 		return errors.Wrap(err, "stop lsmkv store")
 	}
 
 	if _, err = os.Stat(s.pathLSM()); err == nil && !keepFiles {
+		deleteRemoteSegments(s.index.logger, s.index.Config.SegmentTiering, s.pathLSM())
 		err := os.RemoveAll(s.pathLSM())
 		if err != nil {
 			return errors.Wrapf(err, "remove lsm store at %s", s.pathLSM())
@@ -144,3 +154,15 @@ func (s *Shard) drop(keepFiles bool) (err error) {
 
 	return nil
 }
+
+// deleteRemoteSegments deletes the segments of the lsm store which were moved
+// to object storage. Failures do not prevent dropping the shard, the objects
+// are left behind then.
+func deleteRemoteSegments(logger logrus.FieldLogger, tiering *lsmkv.SegmentTiering, dir string) {
+	if err := lsmkv.DeleteRemoteSegments(context.Background(), dir, tiering); err != nil {
+		logger.WithField("action", "drop_shard").
+			WithField("path", dir).
+			WithError(err).
+			Warn("failed to delete segments in cold storage")
+	}
+}
@@ -0,0 +1,342 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/errorcompounder"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// A deleted property is removed from the schema right away, while its buckets
+// and the values stored in the objects are removed in the background, see
+// Index.dropProperty. Until then the property is recorded in
+// droppedPropertiesFile of the index, so that the removal is resumed after a
+// restart. Shards which are not loaded record the property in their own
+// droppedPropertiesFile instead and catch up on it once loaded.
+
+const (
+	droppedPropertiesFile = "dropped_properties.json"
+
+	// dropPropertyBatchSize is the number of objects which are collected
+	// before the values of a dropped property are removed from them
+	dropPropertyBatchSize = 1000
+)
+
+func loadDroppedProperties(dir string) ([]string, error) {
+	data, err := os.ReadFile(filepath.Join(dir, droppedPropertiesFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read dropped properties: %w", err)
+	}
+
+	var props []string
+	if err := json.Unmarshal(data, &props); err != nil {
+		return nil, fmt.Errorf("unmarshal dropped properties: %w", err)
+	}
+	return props, nil
+}
+
+// storeDroppedProperties removes the file if no properties are left
+func storeDroppedProperties(dir string, props []string) error {
+	path := filepath.Join(dir, droppedPropertiesFile)
+	if len(props) == 0 {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("remove dropped properties: %w", err)
+		}
+		return nil
+	}
+
+	data, err := json.Marshal(props)
+	if err != nil {
+		return fmt.Errorf("marshal dropped properties: %w", err)
+	}
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0o600); err != nil {
+		return fmt.Errorf("write dropped properties: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("rename dropped properties: %w", err)
+	}
+	return nil
+}
+
+// recordDroppedProperty adds the property to the file in dir, unless the
+// directory does not exist (anymore)
+func recordDroppedProperty(dir, propName string) error {
+	if _, err := os.Stat(dir); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	props, err := loadDroppedProperties(dir)
+	if err != nil {
+		return err
+	}
+	if slices.Contains(props, propName) {
+		return nil
+	}
+	return storeDroppedProperties(dir, append(props, propName))
+}
+
+func unrecordDroppedProperty(dir, propName string) error {
+	props, err := loadDroppedProperties(dir)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(props, propName) {
+		return nil
+	}
+	return storeDroppedProperties(dir, slices.DeleteFunc(props,
+		func(name string) bool { return name == propName }))
+}
+
+// propertyBucketNames returns the names of all buckets that can exist for a
+// property, depending on its data type and index settings.
+func propertyBucketNames(propName string) []string {
+	return []string{
+		helpers.BucketFromPropNameLSM(propName),
+		helpers.BucketFromPropNameMetaCountLSM(propName),
+		helpers.BucketSearchableFromPropNameLSM(propName),
+		helpers.BucketSearchablePositionsFromPropNameLSM(propName),
+		helpers.BucketRangeableFromPropNameLSM(propName),
+		helpers.BucketFromPropNameLengthLSM(propName),
+		helpers.BucketFromPropNameNullLSM(propName),
+	}
+}
+
+// dropProperty removes the buckets and values of a property that has been
+// deleted from the schema.
+func (s *Shard) dropProperty(ctx context.Context, propName string) error {
+	if err := s.isReadOnly(); err != nil {
+		return err
+	}
+
+	if err := s.dropPropertyBuckets(ctx, propName); err != nil {
+		return err
+	}
+	return s.stripPropertyValues(ctx, propName)
+}
+
+// dropPropertyBuckets shuts down and removes all buckets and the geo index
+// of a property that has been deleted from the schema.
+func (s *Shard) dropPropertyBuckets(ctx context.Context, propName string) error {
+	ec := errorcompounder.New()
+	for _, bucketName := range propertyBucketNames(propName) {
+		if s.store.Bucket(bucketName) != nil {
+			if err := s.store.ShutdownBucket(ctx, bucketName); err != nil {
+				ec.Add(err)
+				continue
+			}
+		}
+		ec.Add(os.RemoveAll(filepath.Join(s.pathLSM(), bucketName)))
+	}
+
+	s.propertyIndicesLock.Lock()
+	index, ok := s.propertyIndices[propName]
+	delete(s.propertyIndices, propName)
+	s.propertyIndicesLock.Unlock()
+	if ok && index.GeoIndex != nil {
+		if err := index.GeoIndex.Drop(ctx, false); err != nil {
+			ec.Add(fmt.Errorf("drop geo index: %w", err))
+		}
+	}
+
+	s.unmarkSearchableBlockmaxProperty(propName)
+
+	return ec.ToError()
+}
+
+func (s *Shard) unmarkSearchableBlockmaxProperty(propName string) {
+	s.searchableBlockmaxPropNamesLock.Lock()
+	defer s.searchableBlockmaxPropNamesLock.Unlock()
+
+	// the slice is handed out to readers without copying, so it must not be
+	// modified in place
+	s.searchableBlockmaxPropNames = slices.DeleteFunc(slices.Clone(s.searchableBlockmaxPropNames),
+		func(name string) bool { return name == propName })
+}
+
+// removePropertyFiles deletes all files of a property of a shard that is not
+// loaded.
+func removePropertyFiles(indexPath, shardName, propName string) error {
+	ec := errorcompounder.New()
+	for _, bucketName := range propertyBucketNames(propName) {
+		ec.Add(os.RemoveAll(filepath.Join(shardPathLSM(indexPath, shardName), bucketName)))
+	}
+
+	geoFiles, err := filepath.Glob(filepath.Join(shardPath(indexPath, shardName), geoPropID(propName)+".hnsw.*"))
+	if err != nil {
+		ec.Add(err)
+	}
+	for _, file := range geoFiles {
+		ec.Add(os.RemoveAll(file))
+	}
+
+	return ec.ToError()
+}
+
+// stripPropertyValues removes the values of a deleted property from the
+// objects, so that they are not read as values of a property which is added
+// later on with the same name. The last update time of the objects is kept.
+func (s *Shard) stripPropertyValues(ctx context.Context, propName string) error {
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	if bucket == nil {
+		return fmt.Errorf("objects bucket not found")
+	}
+
+	var after []byte
+	for {
+		ids, last, err := objectsWithProperty(bucket, after, propName)
+		if err != nil {
+			return fmt.Errorf("find objects with property %q: %w", propName, err)
+		}
+
+		for _, id := range ids {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := s.stripPropertyValue(bucket, id, propName); err != nil {
+				return fmt.Errorf("remove property %q from object: %w", propName, err)
+			}
+		}
+
+		if last == nil {
+			return nil
+		}
+		after = last
+	}
+}
+
+// objectsWithProperty collects the ids of up to dropPropertyBatchSize objects
+// after the given key which have a value of the property. The last key read
+// is nil once all objects were read.
+func objectsWithProperty(bucket *lsmkv.Bucket, after []byte, propName string,
+) (ids [][]byte, last []byte, err error) {
+	c := bucket.Cursor()
+	defer c.Close()
+
+	var k, v []byte
+	if after == nil {
+		k, v = c.First()
+	} else {
+		k, v = c.Seek(after)
+		if k != nil && string(k) == string(after) {
+			k, v = c.Next()
+		}
+	}
+
+	props := storobj.NewPropExtraction().Add(propName)
+	for read := 0; k != nil; k, v = c.Next() {
+		if read == dropPropertyBatchSize {
+			return ids, last, nil
+		}
+		read++
+		last = k
+
+		obj, err := storobj.FromBinaryOptional(v, additional.Properties{}, props)
+		if err != nil {
+			return nil, nil, err
+		}
+		if objProps, ok := obj.Properties().(map[string]interface{}); ok {
+			if _, ok := objProps[propName]; ok {
+				ids = append(ids, slices.Clone(k))
+			}
+		}
+	}
+	return ids, nil, nil
+}
+
+func (s *Shard) stripPropertyValue(bucket *lsmkv.Bucket, id []byte, propName string) error {
+	s.readSnapshots.writes.RLock()
+	defer s.readSnapshots.writes.RUnlock()
+
+	lock := &s.docIdLock[s.uuidToIdLockPoolId(id)]
+	lock.Lock()
+	defer lock.Unlock()
+
+	obj, err := fetchObject(bucket, id)
+	if err != nil || obj == nil {
+		return err
+	}
+	props, ok := obj.Properties().(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	if _, ok := props[propName]; !ok {
+		return nil
+	}
+
+	stripped := make(map[string]interface{}, len(props)-1)
+	for name, value := range props {
+		if name != propName {
+			stripped[name] = value
+		}
+	}
+	obj.SetProperties(models.PropertySchema(stripped))
+
+	obj.VectorDataTypes = s.vectorDataTypes()
+	objBinary, err := obj.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("marshal object %s: %w", obj.ID(), err)
+	}
+	return s.upsertObjectDataLSM(bucket, id, objBinary, obj.DocID)
+}
+
+// catchUpOnDroppedProperties removes the files of the properties which were
+// deleted while the shard was not loaded. It is called before the buckets are
+// loaded, the values are stripped once they are, see
+// stripDroppedPropertyValues.
+func (s *Shard) catchUpOnDroppedProperties() ([]string, error) {
+	props, err := loadDroppedProperties(s.path())
+	if err != nil {
+		return nil, err
+	}
+
+	ec := errorcompounder.New()
+	for _, propName := range props {
+		ec.Add(removePropertyFiles(s.index.path(), s.name, propName))
+	}
+	return props, ec.ToError()
+}
+
+func (s *Shard) stripDroppedPropertyValues(ctx context.Context, props []string) error {
+	for _, propName := range props {
+		if err := s.stripPropertyValues(ctx, propName); err != nil {
+			return err
+		}
+	}
+	return storeDroppedProperties(s.path(), nil)
+}
+
+// removeDroppedPropertyOfUnloadedShard removes the files of the property and
+// records it, so that the shard strips its values once loaded
+func removeDroppedPropertyOfUnloadedShard(indexPath, shardName, propName string) error {
+	if err := removePropertyFiles(indexPath, shardName, propName); err != nil {
+		return err
+	}
+	return recordDroppedProperty(shardPath(indexPath, shardName), propName)
+}
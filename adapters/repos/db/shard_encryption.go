@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/encryption"
+)
+
+// vectorFS is the file system of the commit logs and snapshots of the vector
+// indexes of the shard
+func (s *Shard) vectorFS() common.FS {
+	return common.NewEncryptedFS(s.index.Config.Encryption)
+}
+
+// getShardEncryptionStatus returns nil if the encryption is disabled or the
+// status could not be determined. It does not load lazy shards.
+func (i *Index) getShardEncryptionStatus(shardName string) *models.EncryptionStatus {
+	status, err := shardEncryptionStatus(i.Config.Encryption, shardPath(i.path(), shardName))
+	if err != nil {
+		i.logger.Warnf("error while getting encryption status for shard %s: %v", shardName, err)
+		return nil
+	}
+	return status
+}
+
+// shardEncryptionStatus reads the encryption headers of the files in dir
+// which are written with the encryption of the shard, see
+// isEncryptedShardFile. The files which are always plaintext, like the doc id
+// counter, are not counted.
+func shardEncryptionStatus(enc *encryption.Encryptor, dir string) (*models.EncryptionStatus, error) {
+	if !enc.Enabled() {
+		return nil, nil
+	}
+
+	var names []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// files may be removed concurrently, e.g. by compactions
+			if d != nil && d.IsDir() && path != dir {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			return nil
+		}
+		if isEncryptedShardFile(path) {
+			names = append(names, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := enc.Status(names)
+	if err != nil {
+		return nil, err
+	}
+	return &models.EncryptionStatus{
+		Enabled:        status.Enabled,
+		CurrentKeyID:   status.CurrentKeyID,
+		KeyIds:         status.KeyIDs,
+		EncryptedFiles: status.EncryptedFiles,
+		PlaintextFiles: status.PlaintextFiles,
+		StaleFiles:     status.StaleFiles,
+	}, nil
+}
+
+func isEncryptedShardFile(path string) bool {
+	if strings.HasSuffix(path, ".tmp") {
+		return false
+	}
+	switch filepath.Ext(path) {
+	case ".db", ".wal", ".dict", ".bloom", ".cna", ".metadata":
+		return true
+	}
+	if filepath.Base(path) == vectorReindexStateFile {
+		return true
+	}
+	dir := filepath.Base(filepath.Dir(path))
+	return strings.HasSuffix(dir, ".hnsw.commitlog.d") || strings.HasSuffix(dir, ".hnsw.snapshot.d") ||
+		strings.HasSuffix(dir, ".queue.d")
+}
@@ -44,6 +44,7 @@ func (s *Shard) initGeoProp(prop *models.Property) error {
 		SnapshotMinDeltaCommitlogsNumer:          s.index.Config.HNSWSnapshotMinDeltaCommitlogsNumber,
 		SnapshotMinDeltaCommitlogsSizePercentage: s.index.Config.HNSWSnapshotMinDeltaCommitlogsSizePercentage,
 		AllocChecker:                             s.index.allocChecker,
+		FS:                                       s.vectorFS(),
 	},
 		s.cycleCallbacks.geoPropsCommitLoggerCallbacks,
 		s.cycleCallbacks.geoPropsTombstoneCleanupCallbacks,
@@ -84,6 +84,7 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 		bitmapBufPool:                   bitmapBufPool,
 		HFreshEnabled:                   index.HFreshEnabled,
 		lazySegmentLoadingEnabled:       lazyLoadSegments,
+		recallQueries:                   newRecallQuerySamples(index.Config.RecallMonitoringSampleSize),
 	}
 
 	index.metrics.UpdateShardStatus("", storagestate.StatusLoading.String())
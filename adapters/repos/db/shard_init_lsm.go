@@ -46,6 +46,13 @@ func (s *Shard) initNonVector(ctx context.Context, class *models.Class) error {
 		return fmt.Errorf("init shard %q: %w", s.ID(), err)
 	}
 
+	// the buckets of properties deleted while the shard was not loaded must be
+	// removed before the property buckets are loaded
+	droppedProps, err := s.catchUpOnDroppedProperties()
+	if err != nil {
+		return fmt.Errorf("init shard %q: %w", s.ID(), err)
+	}
+
 	// Run all other inits in parallel and use a single error group to wait for
 	// all init tasks, the wait statement is at the end of this method. No other
 	// methods should attempt to wait on this error group.
@@ -59,6 +66,12 @@ func (s *Shard) initNonVector(ctx context.Context, class *models.Class) error {
 		return s.initProplenTracker()
 	})
 
+	if s.index.Config.ChangeStreamEnabled {
+		eg.Go(func() error {
+			return s.initChangeStream(ctx)
+		})
+	}
+
 	// geo props depend on the object bucket and we need to wait for its creation in this case
 	hasGeoProp := false
 	for _, prop := range class.Properties {
@@ -84,13 +97,17 @@ func (s *Shard) initNonVector(ctx context.Context, class *models.Class) error {
 	// the other initializations going on here.
 	s.initProperties(eg, class)
 
-	err := eg.Wait()
+	err = eg.Wait()
 	if err != nil {
 		// annotate error with shard id only once, all inner functions should only
 		// annotate what they do, but not repeat the shard id.
 		return fmt.Errorf("init shard %q: %w", s.ID(), err)
 	}
 
+	if err := s.stripDroppedPropertyValues(ctx, droppedProps); err != nil {
+		return fmt.Errorf("init shard %q: %w", s.ID(), err)
+	}
+
 	// Object bucket must be available, initAsyncReplication depends on it
 	if s.index.asyncReplicationEnabled() {
 		s.asyncReplicationRWMux.Lock()
@@ -140,6 +157,8 @@ func (s *Shard) initLSMStore() error {
 		return fmt.Errorf("init lsmkv store at %s: %w", s.pathLSM(), err)
 	}
 
+	store.SetEncryption(s.index.Config.Encryption)
+	store.SetSegmentTiering(s.index.Config.SegmentTiering.WithKeyPrefix(s.index.ID(), s.name))
 	s.store = store
 
 	return nil
@@ -161,6 +180,10 @@ func (s *Shard) initObjectBucket(ctx context.Context) error {
 		opts = append(opts, lsmkv.WithMonitorCount())
 	}
 
+	if compression := s.index.objectsCompression(); compression != "" {
+		opts = append(opts, lsmkv.WithCompression(compression))
+	}
+
 	err := s.store.CreateOrLoadBucket(ctx, helpers.ObjectsBucketLSM, opts...)
 	if err != nil {
 		return fmt.Errorf("create objects bucket: %w", err)
@@ -14,6 +14,7 @@ package db
 import (
 	"context"
 	"fmt"
+	"os"
 	"path/filepath"
 
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
@@ -133,10 +134,15 @@ func (s *Shard) createPropertyValueIndex(ctx context.Context, prop *models.Prope
 		}
 
 		bucketName := helpers.BucketSearchableFromPropNameLSM(prop.Name)
+		searchableExists := bucketDirExists(s.pathLSM(), bucketName)
 		if err := s.store.CreateOrLoadBucket(ctx, bucketName, searchableBucketOpts...); err != nil {
 			return err
 		}
 
+		if err := s.createPropertyPositionsIndex(ctx, prop, makeBucketOptions, !searchableExists); err != nil {
+			return err
+		}
+
 		if actualStrategy := s.store.Bucket(bucketName).Strategy(); actualStrategy == lsmkv.StrategyInverted {
 			s.markSearchableBlockmaxProperties(prop.Name)
 		}
@@ -154,6 +160,30 @@ func (s *Shard) createPropertyValueIndex(ctx context.Context, prop *models.Prope
 	return nil
 }
 
+// createPropertyPositionsIndex loads the positions bucket of a property if it
+// exists. A new one is only created along with a new searchable bucket, as the
+// positions of already indexed objects are added by the reindexing task only.
+func (s *Shard) createPropertyPositionsIndex(ctx context.Context, prop *models.Property,
+	makeBucketOptions lsmkv.MakeBucketOptions, searchableIsNew bool,
+) error {
+	if !inverted.HasPositionsIndex(prop) {
+		return nil
+	}
+
+	bucketName := helpers.BucketSearchablePositionsFromPropNameLSM(prop.Name)
+	if !bucketDirExists(s.pathLSM(), bucketName) &&
+		!(searchableIsNew && s.index.invertedIndexConfig.IndexPositions) {
+		return nil
+	}
+
+	return s.store.CreateOrLoadBucket(ctx, bucketName, makeBucketOptions(lsmkv.StrategyMapCollection)...)
+}
+
+func bucketDirExists(lsmPath, bucketName string) bool {
+	info, err := os.Stat(filepath.Join(lsmPath, bucketName))
+	return err == nil && info.IsDir()
+}
+
 func (s *Shard) createPropertyLengthIndex(ctx context.Context, prop *models.Property,
 	makeBucketOptions lsmkv.MakeBucketOptions,
 ) error {
@@ -209,7 +239,7 @@ func (s *Shard) createDimensionsBucket(ctx context.Context, name string) error {
 	}
 
 	bucketPath := filepath.Join(s.pathLSM(), name)
-	strategy, err := lsmkv.DetermineUnloadedBucketStrategyAmong(bucketPath, lsmkv.DimensionsBucketPrioritizedStrategies)
+	strategy, err := lsmkv.DetermineUnloadedEncryptedBucketStrategyAmong(bucketPath, lsmkv.DimensionsBucketPrioritizedStrategies, s.index.Config.Encryption)
 	if err != nil {
 		return fmt.Errorf("determine dimensions bucket strategy: %w", err)
 	}
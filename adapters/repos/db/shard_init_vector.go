@@ -50,8 +50,11 @@ func (s *Shard) initShardVectors(ctx context.Context) error {
 	return nil
 }
 
+// initVectorIndex creates the vector index of the target vector. Its files
+// are stored under the indexName, which differs from the target vector once
+// the vector index was rebuilt, see vectorIndexName.
 func (s *Shard) initVectorIndex(ctx context.Context,
-	targetVector string, vectorIndexUserConfig schemaConfig.VectorIndexConfig, lazyLoadSegments bool,
+	targetVector, indexName string, vectorIndexUserConfig schemaConfig.VectorIndexConfig, lazyLoadSegments bool,
 ) (VectorIndex, error) {
 	var distProv distancer.Provider
 
@@ -99,7 +102,7 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 			// - a geo property index for each geo prop in the schema
 			//
 			// here we label the main vector index as such.
-			vecIdxID := s.vectorIndexID(targetVector)
+			vecIdxID := s.vectorIndexID(indexName)
 
 			vi, err := hnsw.New(hnsw.Config{
 				Logger:                    s.index.logger,
@@ -124,6 +127,7 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 						hnsw.WithSnapshotCreateInterval(time.Duration(s.index.Config.HNSWSnapshotIntervalSeconds)*time.Second),
 						hnsw.WithSnapshotMinDeltaCommitlogsNumer(s.index.Config.HNSWSnapshotMinDeltaCommitlogsNumber),
 						hnsw.WithSnapshotMinDeltaCommitlogsSizePercentage(s.index.Config.HNSWSnapshotMinDeltaCommitlogsSizePercentage),
+						hnsw.WithFS(s.vectorFS()),
 					)
 				},
 				AllocChecker:           s.index.allocChecker,
@@ -134,6 +138,7 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 				DisableSnapshots:       s.index.Config.HNSWDisableSnapshots,
 				SnapshotOnStartup:      s.index.Config.HNSWSnapshotOnStartup,
 				MakeBucketOptions:      makeBucketOptions,
+				FS:                     s.vectorFS(),
 				AsyncIndexingEnabled:   s.index.AsyncIndexingEnabled,
 			}, hnswUserConfig, s.cycleCallbacks.vectorTombstoneCleanupCallbacks, s.store)
 			if err != nil {
@@ -154,11 +159,11 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 		// - a geo property index for each geo prop in the schema
 		//
 		// here we label the main vector index as such.
-		vecIdxID := s.vectorIndexID(targetVector)
+		vecIdxID := s.vectorIndexID(indexName)
 
 		vi, err := flat.New(flat.Config{
 			ID:                vecIdxID,
-			TargetVector:      targetVector,
+			TargetVector:      indexName,
 			RootPath:          s.path(),
 			Logger:            s.index.logger,
 			DistanceProvider:  distProv,
@@ -182,7 +187,7 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 		// - a geo property index for each geo prop in the schema
 		//
 		// here we label the main vector index as such.
-		vecIdxID := s.vectorIndexID(targetVector)
+		vecIdxID := s.vectorIndexID(indexName)
 
 		sharedDB, err := s.getOrInitDynamicVectorIndexDB()
 		if err != nil {
@@ -191,7 +196,7 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 
 		vi, err := dynamic.New(dynamic.Config{
 			ID:                   vecIdxID,
-			TargetVector:         targetVector,
+			TargetVector:         indexName,
 			Logger:               s.index.logger,
 			DistanceProvider:     distProv,
 			RootPath:             s.path(),
@@ -211,6 +216,7 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 					hnsw.WithSnapshotCreateInterval(time.Duration(s.index.Config.HNSWSnapshotIntervalSeconds)*time.Second),
 					hnsw.WithSnapshotMinDeltaCommitlogsNumer(s.index.Config.HNSWSnapshotMinDeltaCommitlogsNumber),
 					hnsw.WithSnapshotMinDeltaCommitlogsSizePercentage(s.index.Config.HNSWSnapshotMinDeltaCommitlogsSizePercentage),
+					hnsw.WithFS(s.vectorFS()),
 				)
 			},
 			TombstoneCallbacks:    s.cycleCallbacks.vectorTombstoneCleanupCallbacks,
@@ -219,6 +225,7 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 			HNSWSnapshotOnStartup: s.index.Config.HNSWSnapshotOnStartup,
 			AllocChecker:          s.index.allocChecker,
 			MakeBucketOptions:     makeBucketOptions,
+			FS:                    s.vectorFS(),
 			AsyncIndexingEnabled:  s.index.AsyncIndexingEnabled,
 		}, dynamicUserConfig, s.store)
 		if err != nil {
@@ -238,15 +245,19 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 		s.index.cycleCallbacks.vectorCommitLoggerCycle.Start()
 		s.index.cycleCallbacks.vectorTombstoneCleanupCycle.Start()
 
-		hfreshConfigID := s.vectorIndexID(targetVector)
+		hfreshConfigID := s.vectorIndexID(indexName)
 		rootPath := filepath.Join(s.path(), "hfresh")
+		if indexName != targetVector {
+			// keep the queues of a rebuilt index apart from the ones it replaces
+			rootPath = filepath.Join(rootPath, indexName)
+		}
 		hfreshConfig := &hfresh.Config{
 			Logger:            s.index.logger,
 			Scheduler:         s.index.scheduler,
 			DistanceProvider:  distProv,
 			RootPath:          rootPath,
 			ID:                hfreshConfigID,
-			TargetVector:      targetVector,
+			TargetVector:      indexName,
 			ShardName:         s.name,
 			ClassName:         s.index.Config.ClassName.String(),
 			PrometheusMetrics: s.promMetrics,
@@ -255,6 +266,7 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 			},
 			VectorForIDThunk:   hnsw.NewVectorForIDThunk(targetVector, s.vectorByIndexID),
 			TombstoneCallbacks: s.cycleCallbacks.vectorTombstoneCleanupCallbacks,
+			Encryption:         s.index.Config.Encryption,
 			Centroids: hfresh.CentroidConfig{
 				HNSWConfig: &hnsw.Config{
 					Logger:                    s.index.logger,
@@ -277,6 +289,7 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 							hnsw.WithSnapshotCreateInterval(time.Duration(s.index.Config.HNSWSnapshotIntervalSeconds)*time.Second),
 							hnsw.WithSnapshotMinDeltaCommitlogsNumer(s.index.Config.HNSWSnapshotMinDeltaCommitlogsNumber),
 							hnsw.WithSnapshotMinDeltaCommitlogsSizePercentage(s.index.Config.HNSWSnapshotMinDeltaCommitlogsSizePercentage),
+							hnsw.WithFS(s.vectorFS()),
 						)
 					},
 					AllocChecker:           s.index.allocChecker,
@@ -287,6 +300,7 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 					DisableSnapshots:       s.index.Config.HNSWDisableSnapshots,
 					SnapshotOnStartup:      s.index.Config.HNSWSnapshotOnStartup,
 					MakeBucketOptions:      makeBucketOptions,
+					FS:                     s.vectorFS(),
 				},
 			},
 		}
@@ -330,6 +344,7 @@ func (s *Shard) initTargetVectors(ctx context.Context, lazyLoadSegments bool) er
 
 	s.vectorIndexes = make(map[string]VectorIndex, len(s.index.vectorIndexUserConfigs))
 	s.queues = make(map[string]*VectorIndexQueue, len(s.index.vectorIndexUserConfigs))
+	s.vectorReindexers = make(map[string]*vectorReindexer)
 
 	for targetVector, vectorIndexConfig := range s.index.vectorIndexUserConfigs {
 		if err := s.initTargetVectorWithLock(ctx, targetVector, vectorIndexConfig, lazyLoadSegments); err != nil {
@@ -346,17 +361,32 @@ func (s *Shard) initTargetVector(ctx context.Context, targetVector string, cfg s
 }
 
 func (s *Shard) initTargetVectorWithLock(ctx context.Context, targetVector string, cfg schemaConfig.VectorIndexConfig, lazyLoadSegments bool) error {
-	vectorIndex, err := s.initVectorIndex(ctx, targetVector, cfg, lazyLoadSegments)
+	if cfg.IndexType() == vectorindex.VectorIndexTypeSparse {
+		return s.initSparseVector(ctx, targetVector, lazyLoadSegments)
+	}
+
+	indexName, servingCfg, err := s.servingVectorIndex(targetVector, cfg)
+	if err != nil {
+		return fmt.Errorf("cannot read vector index state for %q: %w", targetVector, err)
+	}
+	vectorIndex, err := s.initVectorIndex(ctx, targetVector, indexName, servingCfg, lazyLoadSegments)
 	if err != nil {
 		return fmt.Errorf("cannot create vector index for %q: %w", targetVector, err)
 	}
-	queue, err := NewVectorIndexQueue(s, targetVector, vectorIndex)
+	queue, err := NewVectorIndexQueue(s, targetVector, indexName, vectorIndex)
 	if err != nil {
 		return fmt.Errorf("cannot create index queue for %q: %w", targetVector, err)
 	}
 
 	s.vectorIndexes[targetVector] = vectorIndex
 	s.queues[targetVector] = queue
+
+	if err := s.reconcileVectorIndex(ctx, targetVector, cfg, queue); err != nil {
+		return fmt.Errorf("cannot rebuild vector index for %q: %w", targetVector, err)
+	}
+	if err := s.restoreVectorIndexMaintenancePause(ctx, targetVector, vectorIndex); err != nil {
+		return fmt.Errorf("cannot pause maintenance of vector index for %q: %w", targetVector, err)
+	}
 	return nil
 }
 
@@ -364,17 +394,20 @@ func (s *Shard) initLegacyVector(ctx context.Context, lazyLoadSegments bool) err
 	s.vectorIndexMu.Lock()
 	defer s.vectorIndexMu.Unlock()
 
-	vectorIndex, err := s.initVectorIndex(ctx, "", s.index.vectorIndexUserConfig, lazyLoadSegments)
+	vectorIndex, err := s.initVectorIndex(ctx, "", "", s.index.vectorIndexUserConfig, lazyLoadSegments)
 	if err != nil {
 		return err
 	}
 
-	queue, err := NewVectorIndexQueue(s, "", vectorIndex)
+	queue, err := NewVectorIndexQueue(s, "", "", vectorIndex)
 	if err != nil {
 		return err
 	}
 	s.vectorIndex = vectorIndex
 	s.queue = queue
+	if err := s.restoreVectorIndexMaintenancePause(ctx, "", vectorIndex); err != nil {
+		return fmt.Errorf("cannot pause maintenance of vector index: %w", err)
+	}
 	return nil
 }
 
@@ -22,6 +22,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 
+	"github.com/weaviate/weaviate/adapters/repos/db/changestream"
 	"github.com/weaviate/weaviate/adapters/repos/db/indexcheckpoint"
 	"github.com/weaviate/weaviate/adapters/repos/db/indexcounter"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
@@ -44,6 +45,7 @@ import (
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex"
 	"github.com/weaviate/weaviate/usecases/file"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 	"github.com/weaviate/weaviate/usecases/modules"
@@ -209,7 +211,8 @@ func (l *LazyLoadShard) ObjectCountAsync(ctx context.Context) (int64, error) {
 	}
 	l.mutex.Unlock()
 	idx := l.shardOpts.index
-	objectUsage, err := shardusage.CalculateUnloadedObjectsMetrics(idx.logger, idx.path(), l.shardOpts.name, true)
+	objectUsage, err := shardusage.CalculateUnloadedObjectsMetrics(idx.logger, idx.path(), l.shardOpts.name, true,
+		idx.Config.Encryption)
 	if err != nil {
 		return 0, fmt.Errorf("error while getting object count for shard %s: %w", l.shardOpts.name, err)
 	}
@@ -270,6 +273,13 @@ func (l *LazyLoadShard) ObjectVectorSearch(ctx context.Context, searchVectors []
 	return l.shard.ObjectVectorSearch(ctx, searchVectors, targetVectors, targetDist, limit, filters, sort, groupBy, additional, targetCombination, properties)
 }
 
+func (l *LazyLoadShard) ObjectVectorSearchBatch(ctx context.Context, searchVectors [][]float32, targetVector string, limit int, filters *filters.LocalFilter, additional additional.Properties, properties []string) ([][]*storobj.Object, [][]float32, error) {
+	if err := l.Load(ctx); err != nil {
+		return nil, nil, err
+	}
+	return l.shard.ObjectVectorSearchBatch(ctx, searchVectors, targetVector, limit, filters, additional, properties)
+}
+
 func (l *LazyLoadShard) UpdateVectorIndexConfig(ctx context.Context, updated schemaConfig.VectorIndexConfig) error {
 	if err := l.Load(ctx); err != nil {
 		return err
@@ -291,6 +301,11 @@ func (l *LazyLoadShard) SetAsyncReplicationEnabled(ctx context.Context, enabled
 	return l.shard.SetAsyncReplicationEnabled(ctx, enabled)
 }
 
+func (l *LazyLoadShard) ChangeStream() *changestream.Log {
+	l.mustLoad()
+	return l.shard.ChangeStream()
+}
+
 func (l *LazyLoadShard) addTargetNodeOverride(ctx context.Context, targetNodeOverride additional.AsyncReplicationTargetNodeOverride) error {
 	if err := l.Load(ctx); err != nil {
 		return err
@@ -321,6 +336,13 @@ func (l *LazyLoadShard) getAsyncReplicationStats(ctx context.Context) []*models.
 	return l.shard.getAsyncReplicationStats(ctx)
 }
 
+func (l *LazyLoadShard) getVectorReindexStatus() []*models.VectorReindexStatus {
+	if !l.isLoaded() {
+		return nil
+	}
+	return l.shard.getVectorReindexStatus()
+}
+
 func (l *LazyLoadShard) AddReferencesBatch(ctx context.Context, refs objects.BatchReferences) []error {
 	if err := l.Load(ctx); err != nil {
 		return []error{err}
@@ -394,6 +416,7 @@ func (l *LazyLoadShard) drop(keepFiles bool) error {
 
 		// remove shard dir
 		if !keepFiles {
+			deleteRemoteSegments(idx.logger, idx.Config.SegmentTiering, shardPathLSM(idx.path(), shardName))
 			if err := os.RemoveAll(shardPath(idx.path(), shardName)); err != nil {
 				return fmt.Errorf("delete shard dir: %w", err)
 			}
@@ -419,6 +442,81 @@ func (l *LazyLoadShard) initPropertyBuckets(ctx context.Context, eg *enterrors.E
 	l.shard.initPropertyBuckets(ctx, eg, lazyLoadSegments, props...)
 }
 
+func (l *LazyLoadShard) dropProperty(ctx context.Context, propName string) error {
+	// if not loaded, remove the files without loading the shard and record the
+	// property, whose values the shard strips once loaded. Use lock to prevent
+	// concurrent loading meanwhile
+	l.mutex.Lock()
+	if !l.loaded {
+		defer l.mutex.Unlock()
+		return removeDroppedPropertyOfUnloadedShard(l.shardOpts.index.path(), l.shardOpts.name, propName)
+	}
+	l.mutex.Unlock()
+
+	return l.shard.dropProperty(ctx, propName)
+}
+
+func (l *LazyLoadShard) reindexVectorIndex(ctx context.Context, targetVector string,
+	previous, updated schemaConfig.VectorIndexConfig,
+) error {
+	// if not loaded, record the vector index kept on disk, which the shard
+	// rebuilds once loaded. Use lock to prevent concurrent loading meanwhile
+	l.mutex.Lock()
+	if !l.loaded {
+		defer l.mutex.Unlock()
+		return recordVectorIndexGeneration(l.shardOpts.index.Config.Encryption,
+			shardPath(l.shardOpts.index.path(), l.shardOpts.name), targetVector, previous)
+	}
+	l.mutex.Unlock()
+
+	return l.shard.reindexVectorIndex(ctx, targetVector, previous, updated)
+}
+
+// vectorReindexProgress loads the shard, as it only rebuilds its vector index
+// once loaded
+func (l *LazyLoadShard) vectorReindexProgress(ctx context.Context, targetVector string,
+	payload vectorindex.ReindexTaskPayload,
+) (bool, float64, error) {
+	if err := l.Load(ctx); err != nil {
+		return false, 0, err
+	}
+	return l.shard.vectorReindexProgress(ctx, targetVector, payload)
+}
+
+func (l *LazyLoadShard) measureRecall(ctx context.Context, targetVector string, k, samples int,
+) (RecallMeasurement, error) {
+	if err := l.Load(ctx); err != nil {
+		return RecallMeasurement{}, err
+	}
+	return l.shard.measureRecall(ctx, targetVector, k, samples)
+}
+
+func (l *LazyLoadShard) startVectorIndexMaintenance(ctx context.Context, targetVector, operation string,
+) (*models.VectorIndexMaintenanceOperation, error) {
+	if err := l.Load(ctx); err != nil {
+		return nil, err
+	}
+	return l.shard.startVectorIndexMaintenance(ctx, targetVector, operation)
+}
+
+func (l *LazyLoadShard) getVectorIndexMaintenance(targetVector string) (*models.VectorIndexMaintenanceStatus, error) {
+	if !l.isLoaded() {
+		// maintenance operations only run on loaded shards
+		return &models.VectorIndexMaintenanceStatus{
+			Operations: []*models.VectorIndexMaintenanceOperation{},
+		}, nil
+	}
+	return l.shard.getVectorIndexMaintenance(targetVector)
+}
+
+func (l *LazyLoadShard) cancelVectorIndexMaintenance(targetVector, id string) error {
+	if !l.isLoaded() {
+		return fmt.Errorf("operation %q of target vector %q: %w", id, targetVector,
+			vectorindex.ErrMaintenanceNotFound)
+	}
+	return l.shard.cancelVectorIndexMaintenance(targetVector, id)
+}
+
 func (l *LazyLoadShard) HaltForTransfer(ctx context.Context, offloading bool, inactivityTimeout time.Duration) error {
 	if err := l.Load(ctx); err != nil {
 		return err
@@ -474,7 +572,7 @@ func (l *LazyLoadShard) Dimensions(ctx context.Context, targetVector string) (in
 
 	// For unloaded shards, get dimensions from unloaded shard/tenant calculation
 	idx := l.shardOpts.index
-	dimensionality, err := shardusage.CalculateUnloadedDimensionsUsage(ctx, idx.logger, idx.path(), l.shardOpts.name, targetVector)
+	dimensionality, err := shardusage.CalculateUnloadedDimensionsUsage(ctx, idx.logger, idx.path(), l.shardOpts.name, targetVector, idx.Config.Encryption)
 	if err != nil {
 		return 0, err
 	}
@@ -142,6 +142,9 @@ func (s *Shard) ObjectDigestsInRange(ctx context.Context,
 
 	cursor := bucket.Cursor()
 	defer cursor.Close()
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
 
 	n := 0
 
@@ -290,6 +293,13 @@ func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.Lo
 	}()
 
 	s.activityTrackerRead.Add(1)
+
+	store, done, err := s.readStore(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer done()
+
 	if keywordRanking != nil {
 		if v := s.versioner.Version(); v < 2 {
 			return nil, nil, errors.Errorf(
@@ -302,7 +312,7 @@ func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.Lo
 		var filterDocIds helpers.AllowList
 
 		if filters != nil {
-			filterDocIds, err = inverted.NewSearcher(s.index.logger, s.store,
+			filterDocIds, err = inverted.NewSearcher(s.index.logger, store,
 				s.index.getSchema.ReadOnlyClass, s.propertyIndices,
 				s.index.classSearcher, s.index.stopwords, s.versioner.Version(),
 				s.isFallbackToSearchable, s.tenant(), s.index.Config.QueryNestedRefLimit,
@@ -318,10 +328,14 @@ func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.Lo
 		className := s.index.Config.ClassName
 		bm25Config := s.index.GetInvertedIndexConfig().BM25
 		logger := s.index.logger.WithFields(logrus.Fields{"class": s.index.Config.ClassName, "shard": s.name})
-		bm25searcher := inverted.NewBM25Searcher(bm25Config, s.store,
+		bm25searcher := inverted.NewBM25Searcher(bm25Config, store,
 			s.index.getSchema.ReadOnlyClass, s.propertyIndices, s.index.classSearcher, s.index.stopwords,
 			s.GetPropertyLengthTracker(), logger, s.versioner.Version())
-		bm25objs, bm25count, err = bm25searcher.BM25F(ctx, filterDocIds, className, limit, *keywordRanking, additional)
+		if keywordRanking.SparseVector != nil {
+			bm25objs, bm25count, err = bm25searcher.SparseVectorSearch(ctx, filterDocIds, limit, *keywordRanking.SparseVector, additional)
+		} else {
+			bm25objs, bm25count, err = bm25searcher.BM25F(ctx, filterDocIds, className, limit, *keywordRanking, additional)
+		}
 		if err != nil {
 			return nil, nil, err
 		}
@@ -334,7 +348,7 @@ func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.Lo
 			cursor, additional, s.index.Config.ClassName)
 		return objs, nil, err
 	}
-	objs, err := inverted.NewSearcher(s.index.logger, s.store, s.index.getSchema.ReadOnlyClass,
+	objs, err := inverted.NewSearcher(s.index.logger, store, s.index.getSchema.ReadOnlyClass,
 		s.propertyIndices, s.index.classSearcher, s.index.stopwords, s.versioner.Version(),
 		s.isFallbackToSearchable, s.tenant(), s.index.Config.QueryNestedRefLimit, s.bitmapFactory).
 		Objects(ctx, limit, filters, sort, additional, s.index.Config.ClassName, properties,
@@ -349,20 +363,7 @@ func (s *Shard) VectorDistanceForQuery(ctx context.Context, docId uint64, search
 
 	distances := make([]float32, len(targetVectors))
 	for j, target := range targetVectors {
-		index, ok := s.GetVectorIndex(target)
-		if !ok {
-			return nil, fmt.Errorf("index %s not found", target)
-		}
-		var distancer common.QueryVectorDistancer
-		switch v := searchVectors[j].(type) {
-		case []float32:
-			distancer = index.QueryVectorDistancer(v)
-		case [][]float32:
-			distancer = index.(VectorIndexMulti).QueryMultiVectorDistancer(v)
-		default:
-			return nil, fmt.Errorf("unsupported vector type: %T", v)
-		}
-		dist, err := distancer.DistanceToNode(docId)
+		dist, err := s.vectorDistanceForQuery(ctx, docId, searchVectors[j], target)
 		if err != nil {
 			return nil, err
 		}
@@ -371,6 +372,25 @@ func (s *Shard) VectorDistanceForQuery(ctx context.Context, docId uint64, search
 	return distances, nil
 }
 
+func (s *Shard) vectorDistanceForQuery(ctx context.Context, docId uint64, searchVector models.Vector, target string) (float32, error) {
+	index, release, ok := s.acquireVectorIndex(target)
+	if !ok {
+		return 0, fmt.Errorf("index %s not found", target)
+	}
+	defer release()
+
+	var distancer common.QueryVectorDistancer
+	switch v := searchVector.(type) {
+	case []float32:
+		distancer = index.QueryVectorDistancer(v)
+	case [][]float32:
+		distancer = index.(VectorIndexMulti).QueryMultiVectorDistancer(ctx, v)
+	default:
+		return 0, fmt.Errorf("unsupported vector type: %T", v)
+	}
+	return distancer.DistanceToNode(docId)
+}
+
 func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors []models.Vector, targetVectors []string, targetDist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties, targetCombination *dto.TargetCombination, properties []string) ([]*storobj.Object, []float32, error) {
 	startTime := time.Now()
 
@@ -398,6 +418,11 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors []models.V
 
 	s.activityTrackerRead.Add(1)
 
+	// the vector indexes are not part of the read snapshots
+	if _, ok := dto.ReadSnapshotFromContext(ctx); ok {
+		return nil, nil, errReadSnapshotVectorSearch
+	}
+
 	var allowList helpers.AllowList
 	if filters != nil {
 		beforeFilter := time.Now()
@@ -416,6 +441,7 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors []models.V
 	eg.SetLimit(_NUMCPU)
 	idss := make([][]uint64, len(targetVectors))
 	distss := make([][]float32, len(targetVectors))
+	profiles := make(vectorSearchProfiles, len(targetVectors))
 	beforeVector := time.Now()
 
 	for i, targetVector := range targetVectors {
@@ -428,10 +454,16 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors []models.V
 				err   error
 			)
 
-			vidx, ok := s.GetVectorIndex(targetVector)
+			vidx, release, ok := s.acquireVectorIndex(targetVector)
 			if !ok {
 				return fmt.Errorf("index for target vector %q not found", targetVector)
 			}
+			defer release()
+
+			ctx := ctx
+			if additional.QueryProfile && allowList != nil {
+				ctx = profiles.init(ctx, i, s.name, targetVector)
+			}
 
 			if limit < 0 {
 				switch searchVector := searchVectors[i].(type) {
@@ -474,6 +506,7 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors []models.V
 							s.index.Config.ClassName, s.name, err))
 						return err
 					}
+					s.recallQueries.record(targetVector, searchVector)
 				case [][]float32:
 					ids, dists, err = vidx.(VectorIndexMulti).SearchByMultiVector(ctx, searchVector, limit, allowList)
 					if err != nil {
@@ -554,11 +587,53 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors []models.V
 	}
 
 	helpers.AnnotateSlowQueryLog(ctx, "objects_took", took)
+
+	if additional.QueryProfile {
+		profiles.addTo(objs)
+	}
 	return objs, distCombined, nil
 }
 
+// vectorSearchProfiles holds the filter strategies with which the vector
+// indexes of the target vectors were searched
+type vectorSearchProfiles []*additional.VectorSearchProfile
+
+func (p vectorSearchProfiles) init(ctx context.Context, i int, shard, targetVector string) context.Context {
+	ctx, p[i] = helpers.InitVectorSearchProfile(ctx)
+	p[i].Shard = shard
+	p[i].TargetVector = targetVector
+	return ctx
+}
+
+// addTo adds the recorded profiles to the additional properties of the results
+func (p vectorSearchProfiles) addTo(objs []*storobj.Object) {
+	recorded := make([]additional.VectorSearchProfile, 0, len(p))
+	for _, profile := range p {
+		if profile != nil && profile.FilterStrategy != "" {
+			recorded = append(recorded, *profile)
+		}
+	}
+	if len(recorded) == 0 {
+		return
+	}
+
+	for _, obj := range objs {
+		if obj.Object.Additional == nil {
+			obj.Object.Additional = models.AdditionalProperties{}
+		}
+		obj.Object.Additional["queryProfile"] = recorded
+	}
+}
+
 func (s *Shard) ObjectList(ctx context.Context, limit int, sort []filters.Sort, cursor *filters.Cursor, additional additional.Properties, className schema.ClassName) ([]*storobj.Object, error) {
 	s.activityTrackerRead.Add(1)
+
+	store, done, err := s.readStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
 	if len(sort) > 0 {
 		beforeSort := time.Now()
 		docIDs, err := s.sortedObjectList(ctx, limit, sort, className)
@@ -566,7 +641,7 @@ func (s *Shard) ObjectList(ctx context.Context, limit int, sort []filters.Sort,
 			return nil, err
 		}
 		helpers.AnnotateSlowQueryLog(ctx, "sort_took", time.Since(beforeSort))
-		bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+		bucket := store.Bucket(helpers.ObjectsBucketLSM)
 
 		beforeObjects := time.Now()
 		defer func() {
@@ -586,8 +661,17 @@ func (s *Shard) cursorObjectList(ctx context.Context, c *filters.Cursor,
 	additional additional.Properties,
 	className schema.ClassName,
 ) ([]*storobj.Object, error) {
-	cursor := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	store, done, err := s.readStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	cursor := store.Bucket(helpers.ObjectsBucketLSM).Cursor()
 	defer cursor.Close()
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
 
 	var key, val []byte
 	if c.After == "" {
@@ -623,7 +707,13 @@ func (s *Shard) cursorObjectList(ctx context.Context, c *filters.Cursor,
 func (s *Shard) sortedObjectList(ctx context.Context, limit int, sort []filters.Sort,
 	className schema.ClassName,
 ) ([]uint64, error) {
-	lsmSorter, err := sorter.NewLSMSorter(s.store, s.index.getSchema.ReadOnlyClass,
+	store, done, err := s.readStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	lsmSorter, err := sorter.NewLSMSorter(store, s.index.getSchema.ReadOnlyClass,
 		className, s.index.Config.InvertedSorterDisabled)
 	if err != nil {
 		return nil, errors.Wrap(err, "sort object list")
@@ -638,7 +728,13 @@ func (s *Shard) sortedObjectList(ctx context.Context, limit int, sort []filters.
 func (s *Shard) sortDocIDsAndDists(ctx context.Context, limit int, sort []filters.Sort,
 	className schema.ClassName, docIDs []uint64, dists []float32,
 ) ([]uint64, []float32, error) {
-	lsmSorter, err := sorter.NewLSMSorter(s.store, s.index.getSchema.ReadOnlyClass,
+	store, done, err := s.readStore(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer done()
+
+	lsmSorter, err := sorter.NewLSMSorter(store, s.index.getSchema.ReadOnlyClass,
 		className, s.index.Config.InvertedSorterDisabled)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "sort objects with distances")
@@ -651,7 +747,13 @@ func (s *Shard) sortDocIDsAndDists(ctx context.Context, limit int, sort []filter
 }
 
 func (s *Shard) buildAllowList(ctx context.Context, filters *filters.LocalFilter, addl additional.Properties) (helpers.AllowList, error) {
-	list, err := inverted.NewSearcher(s.index.logger, s.store, s.index.getSchema.ReadOnlyClass,
+	store, done, err := s.readStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	list, err := inverted.NewSearcher(s.index.logger, store, s.index.getSchema.ReadOnlyClass,
 		s.propertyIndices, s.index.classSearcher, s.index.stopwords, s.versioner.Version(),
 		s.isFallbackToSearchable, s.tenant(), s.index.Config.QueryNestedRefLimit, s.bitmapFactory).
 		DocIDs(ctx, filters, addl, s.index.Config.ClassName)
@@ -688,6 +790,10 @@ func (s *Shard) uuidFromDocID(docID uint64) (strfmt.UUID, error) {
 }
 
 func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID, deletionTime time.Time) error {
+	// see comment in shard_write_put.go::putObjectLSM
+	s.readSnapshots.writes.RLock()
+	defer s.readSnapshots.writes.RUnlock()
+
 	s.asyncReplicationRWMux.RLock()
 	defer s.asyncReplicationRWMux.RUnlock()
 
@@ -739,6 +845,10 @@ func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID, deletionT
 		return errors.Wrap(err, "object deletion in hashtree")
 	}
 
+	if err = s.mayRecordDeletion(idBytes, deletionTime); err != nil {
+		return errors.Wrap(err, "record deletion")
+	}
+
 	err = s.cleanupInvertedIndexOnDelete(existing, docID)
 	if err != nil {
 		return errors.Wrap(err, "delete object from bucket")
@@ -0,0 +1,179 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	entsentry "github.com/weaviate/weaviate/entities/sentry"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// batchVectorSearcher is implemented by vector indexes which search many
+// query vectors with a shared allow list at once, see hnsw/search_batch.go
+type batchVectorSearcher interface {
+	SearchByVectorBatch(ctx context.Context, vectors [][]float32, k int,
+		allowList helpers.AllowList) ([][]uint64, [][]float32, error)
+}
+
+// ObjectVectorSearchBatch returns the limit nearest objects of each of the
+// query vectors. The allow list of the filters is built once for all queries
+// and objects found by several queries are only read once.
+func (s *Shard) ObjectVectorSearchBatch(ctx context.Context, searchVectors [][]float32,
+	targetVector string, limit int, filters *filters.LocalFilter,
+	additional additional.Properties, properties []string,
+) ([][]*storobj.Object, [][]float32, error) {
+	startTime := time.Now()
+
+	defer func() {
+		s.slowQueryReporter.LogIfSlow(ctx, startTime, map[string]any{
+			"collection": s.index.Config.ClassName,
+			"shard":      s.ID(),
+			"tenant":     s.tenant(),
+			"query":      "ObjectVectorSearchBatch",
+			"queries":    len(searchVectors),
+			"filters":    filters,
+			"limit":      limit,
+			"version":    s.versioner.Version(),
+			"additional": additional,
+		})
+	}()
+
+	s.activityTrackerRead.Add(1)
+
+	vidx, release, ok := s.acquireVectorIndex(targetVector)
+	if !ok {
+		return nil, nil, fmt.Errorf("index for target vector %q not found", targetVector)
+	}
+	defer release()
+	if vidx.Multivector() {
+		return nil, nil, fmt.Errorf("batch vector search is not supported for multi vector %q", targetVector)
+	}
+
+	var allowList helpers.AllowList
+	if filters != nil {
+		beforeFilter := time.Now()
+		list, err := s.buildAllowList(ctx, filters, additional)
+		if err != nil {
+			return nil, nil, err
+		}
+		allowList = list
+		took := time.Since(beforeFilter)
+		s.metrics.FilteredVectorFilter(took)
+		helpers.AnnotateSlowQueryLog(ctx, "filters_build_allow_list_took", took)
+		helpers.AnnotateSlowQueryLog(ctx, "filters_ids_matched", allowList.Len())
+	}
+
+	beforeVector := time.Now()
+	idss, distss, err := s.searchVectorIndexBatch(ctx, vidx, searchVectors, limit, allowList)
+	if allowList != nil {
+		allowList.Close()
+	}
+	if err != nil {
+		// This should normally not fail. A failure here could indicate that more
+		// attention is required, for example because data is corrupted. That's
+		// why this error is explicitly pushed to sentry.
+		err = fmt.Errorf("batch vector search: %w", err)
+		entsentry.CaptureException(fmt.Errorf("collection %q shard %q: %w",
+			s.index.Config.ClassName, s.name, err))
+		return nil, nil, err
+	}
+	for _, searchVector := range searchVectors {
+		s.recallQueries.record(targetVector, searchVector)
+	}
+
+	if filters != nil {
+		s.metrics.FilteredVectorVector(time.Since(beforeVector))
+	}
+	helpers.AnnotateSlowQueryLog(ctx, "vector_search_took", time.Since(beforeVector))
+
+	beforeObjects := time.Now()
+
+	// queries of a batch are often close to each other, read every object only
+	// once
+	var unique []uint64
+	seen := map[uint64]struct{}{}
+	for _, ids := range idss {
+		for _, id := range ids {
+			if _, ok := seen[id]; !ok {
+				seen[id] = struct{}{}
+				unique = append(unique, id)
+			}
+		}
+	}
+
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	found, err := storobj.ObjectsByDocID(bucket, unique, additional, properties, s.index.logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	byDocID := make(map[uint64]*storobj.Object, len(found))
+	for _, obj := range found {
+		byDocID[obj.DocID] = obj
+	}
+
+	// the results of the queries must not share objects, as they are modified
+	// when the results are resolved
+	used := make(map[uint64]struct{}, len(found))
+	objss := make([][]*storobj.Object, len(idss))
+	outDistss := make([][]float32, len(idss))
+	for i, ids := range idss {
+		objss[i] = make([]*storobj.Object, 0, len(ids))
+		outDistss[i] = make([]float32, 0, len(ids))
+		for j, id := range ids {
+			obj, ok := byDocID[id]
+			if !ok {
+				continue
+			}
+			if _, ok := used[id]; ok {
+				obj = obj.DeepCopyDangerous()
+			}
+			used[id] = struct{}{}
+			objss[i] = append(objss[i], obj)
+			outDistss[i] = append(outDistss[i], distss[i][j])
+		}
+	}
+
+	took := time.Since(beforeObjects)
+	if filters != nil {
+		s.metrics.FilteredVectorObjects(took)
+	}
+	helpers.AnnotateSlowQueryLog(ctx, "objects_took", took)
+
+	return objss, outDistss, nil
+}
+
+// searchVectorIndexBatch searches the query vectors together if the vector
+// index supports it and one after another otherwise
+func (s *Shard) searchVectorIndexBatch(ctx context.Context, vidx VectorIndex,
+	searchVectors [][]float32, limit int, allowList helpers.AllowList,
+) ([][]uint64, [][]float32, error) {
+	if batcher, ok := vidx.(batchVectorSearcher); ok {
+		return batcher.SearchByVectorBatch(ctx, searchVectors, limit, allowList)
+	}
+
+	idss := make([][]uint64, len(searchVectors))
+	distss := make([][]float32, len(searchVectors))
+	for i, searchVector := range searchVectors {
+		ids, dists, err := vidx.SearchByVector(ctx, searchVector, limit, allowList)
+		if err != nil {
+			return nil, nil, fmt.Errorf("query %d: %w", i, err)
+		}
+		idss[i], distss[i] = ids, dists
+	}
+	return idss, distss, nil
+}
@@ -0,0 +1,231 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/dto"
+)
+
+// readSnapshotIdleTimeout is the time after which a read snapshot which is
+// no longer read from is released
+var readSnapshotIdleTimeout = 5 * time.Minute
+
+// readSnapshotMaxLifetime is the time after which a read snapshot is released
+// even if it is still read from, so that compactions can't be held off by a
+// client forever
+var readSnapshotMaxLifetime = 30 * time.Minute
+
+const (
+	// maxReadSnapshots limits the number of read snapshots a shard holds at a
+	// time, the buckets of a shard are not compacted while it holds any
+	maxReadSnapshots = 16
+	// maxReadSnapshotsPerOwner limits the number of read snapshots of a single
+	// principal, so that one client can't take up all snapshots of a shard
+	maxReadSnapshotsPerOwner = 4
+)
+
+// errReadSnapshotVectorSearch is returned for vector searches which are read
+// from a snapshot, the snapshots don't include the vector indexes
+var errReadSnapshotVectorSearch = errors.New("read snapshots of the vector indexes are not implemented, " +
+	"vector searches can't read from a snapshot")
+
+// readSnapshots are point-in-time views of all buckets of a shard, created on
+// request and identified by client chosen ids scoped to the principal, see
+// [dto.ReadSnapshotKey]. Writes of an object to the objects bucket and the
+// inverted index hold a read lock of writes, so that a snapshot never sees a
+// write half-applied.
+//
+// The snapshots don't include the vector indexes, this is not implemented:
+// the indexes are not versioned, so there is nothing to pin. A query with a
+// snapshot can therefore still see a write half-applied to a vector index.
+// Vector and hybrid searches with a snapshot fail with
+// errReadSnapshotVectorSearch, both in the traverser and in the shard.
+//
+// A snapshot is released once it has not been read for
+// readSnapshotIdleTimeout. It expires after readSnapshotMaxLifetime, reads of
+// an expired snapshot fail until it has not been read for the idle timeout,
+// the key can only be used for a new snapshot afterwards. The expired
+// snapshots count towards the limits until then.
+type readSnapshots struct {
+	// RLock() while an object is written, Lock() while a snapshot is taken
+	writes sync.RWMutex
+
+	sync.Mutex
+	byKey  map[string]*readSnapshot
+	closed bool
+}
+
+type readSnapshot struct {
+	owner   string
+	store   *lsmkv.Store
+	release func()
+	// number of requests reading from the snapshot, the idle timer is only
+	// running while there are none
+	readers int
+	idle    *time.Timer
+	// fires after the max lifetime, the store is released once there are no
+	// readers
+	lifetime *time.Timer
+	expired  bool
+}
+
+// releaseStore releases the store of the snapshot, if not done already
+func (rs *readSnapshot) releaseStore() {
+	if rs.store != nil {
+		rs.store = nil
+		rs.release()
+	}
+}
+
+// readStore returns the store the reads with the given context are served
+// from, which is the requested read snapshot if any. done has to be called
+// once the store is no longer read from.
+func (s *Shard) readStore(ctx context.Context) (store *lsmkv.Store, done func(), err error) {
+	key, ok := dto.ReadSnapshotFromContext(ctx)
+	if !ok {
+		return s.store, func() {}, nil
+	}
+	return s.acquireReadSnapshot(key)
+}
+
+func (s *Shard) acquireReadSnapshot(key string) (*lsmkv.Store, func(), error) {
+	rs := &s.readSnapshots
+	rs.Lock()
+	defer rs.Unlock()
+
+	if rs.closed {
+		return nil, nil, errAlreadyShutdown
+	}
+
+	owner, id := dto.ParseReadSnapshotKey(key)
+	snapshot, ok := rs.byKey[key]
+	if ok && snapshot.expired {
+		if snapshot.readers == 0 {
+			snapshot.idle.Reset(readSnapshotIdleTimeout)
+		}
+		return nil, nil, fmt.Errorf("read snapshot %q of shard %q expired after %s",
+			id, s.name, readSnapshotMaxLifetime)
+	}
+	if !ok {
+		if err := rs.checkLimits(owner); err != nil {
+			return nil, nil, fmt.Errorf("take read snapshot %q of shard %q: %w", id, s.name, err)
+		}
+
+		store, release, err := s.store.Snapshot(func() func() {
+			rs.writes.Lock()
+			return rs.writes.Unlock
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("take read snapshot %q of shard %q: %w", id, s.name, err)
+		}
+
+		snapshot = &readSnapshot{owner: owner, store: store, release: release}
+		snapshot.idle = time.AfterFunc(readSnapshotIdleTimeout, func() {
+			s.removeIdleReadSnapshot(key, snapshot)
+		})
+		snapshot.lifetime = time.AfterFunc(readSnapshotMaxLifetime, func() {
+			s.expireReadSnapshot(key, snapshot)
+		})
+		if rs.byKey == nil {
+			rs.byKey = map[string]*readSnapshot{}
+		}
+		rs.byKey[key] = snapshot
+	}
+
+	snapshot.readers++
+	snapshot.idle.Stop()
+
+	var once sync.Once
+	return snapshot.store, func() {
+		once.Do(func() {
+			rs.Lock()
+			defer rs.Unlock()
+
+			snapshot.readers--
+			if snapshot.readers == 0 && !rs.closed {
+				if snapshot.expired {
+					snapshot.releaseStore()
+				}
+				snapshot.idle.Reset(readSnapshotIdleTimeout)
+			}
+		})
+	}, nil
+}
+
+// checkLimits checks whether another snapshot of the owner can be taken,
+// the caller has to hold the lock
+func (rs *readSnapshots) checkLimits(owner string) error {
+	if len(rs.byKey) >= maxReadSnapshots {
+		return fmt.Errorf("shard already holds the maximum of %d read snapshots", maxReadSnapshots)
+	}
+	owned := 0
+	for _, snapshot := range rs.byKey {
+		if snapshot.owner == owner {
+			owned++
+		}
+	}
+	if owned >= maxReadSnapshotsPerOwner {
+		return fmt.Errorf("shard already holds the maximum of %d read snapshots per user", maxReadSnapshotsPerOwner)
+	}
+	return nil
+}
+
+func (s *Shard) removeIdleReadSnapshot(key string, snapshot *readSnapshot) {
+	rs := &s.readSnapshots
+	rs.Lock()
+	defer rs.Unlock()
+
+	// the snapshot may have been acquired again while the timer fired
+	if rs.byKey[key] != snapshot || snapshot.readers > 0 {
+		return
+	}
+	delete(rs.byKey, key)
+	snapshot.lifetime.Stop()
+	snapshot.releaseStore()
+}
+
+func (s *Shard) expireReadSnapshot(key string, snapshot *readSnapshot) {
+	rs := &s.readSnapshots
+	rs.Lock()
+	defer rs.Unlock()
+
+	if rs.byKey[key] != snapshot {
+		return
+	}
+	snapshot.expired = true
+	if snapshot.readers == 0 {
+		snapshot.releaseStore()
+	}
+}
+
+// closeReadSnapshots releases all read snapshots, it has to be called before
+// the store is shut down
+func (s *Shard) closeReadSnapshots() {
+	rs := &s.readSnapshots
+	rs.Lock()
+	defer rs.Unlock()
+
+	rs.closed = true
+	for key, snapshot := range rs.byKey {
+		snapshot.idle.Stop()
+		snapshot.lifetime.Stop()
+		snapshot.releaseStore()
+		delete(rs.byKey, key)
+	}
+}
@@ -0,0 +1,178 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestShardReadSnapshot(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, idx := testShard(t, ctx, className, func(i *Index) {
+		i.Config.DisableLazyLoadShards = true
+	})
+	defer func() { require.NoError(t, idx.drop()) }()
+
+	shard, ok := shd.(*Shard)
+	require.True(t, ok)
+
+	idleTimeout := readSnapshotIdleTimeout
+	readSnapshotIdleTimeout = 100 * time.Millisecond
+	defer func() { readSnapshotIdleTimeout = idleTimeout }()
+
+	ids := createDataForLockTests(t, shd, className, 5)
+
+	// reads all objects with the cursor, two at a time
+	export := func(t *testing.T, ctx context.Context) []strfmt.UUID {
+		var exported []strfmt.UUID
+		cursor := &filters.Cursor{Limit: 2}
+		for {
+			objs, err := shd.ObjectList(ctx, 2, nil, cursor, additional.Properties{}, idx.Config.ClassName)
+			require.NoError(t, err)
+			if len(objs) == 0 {
+				return exported
+			}
+			for _, obj := range objs {
+				exported = append(exported, obj.ID())
+			}
+			cursor = &filters.Cursor{After: objs[len(objs)-1].ID().String(), Limit: 2}
+		}
+	}
+
+	snapshotCtx := dto.ContextWithReadSnapshot(ctx, "export")
+
+	t.Run("take snapshot on first read", func(t *testing.T) {
+		objs, err := shd.ObjectList(snapshotCtx, 10, nil, nil, additional.Properties{}, idx.Config.ClassName)
+		require.NoError(t, err)
+		require.Len(t, objs, 5)
+	})
+
+	t.Run("write after snapshot", func(t *testing.T) {
+		for i := 0; i < 5; i++ {
+			require.NoError(t, shd.PutObject(ctx, testObject(className)))
+		}
+		require.NoError(t, shd.DeleteObject(ctx, ids[0], time.Now()))
+	})
+
+	t.Run("snapshot reads stable dataset", func(t *testing.T) {
+		assert.ElementsMatch(t, ids, export(t, snapshotCtx))
+		assert.Len(t, export(t, ctx), 9)
+	})
+
+	t.Run("snapshot is released when idle", func(t *testing.T) {
+		assert.Eventually(t, func() bool {
+			shard.readSnapshots.Lock()
+			defer shard.readSnapshots.Unlock()
+			return len(shard.readSnapshots.byKey) == 0
+		}, 5*time.Second, 10*time.Millisecond)
+
+		// the next read takes a new snapshot
+		assert.Len(t, export(t, snapshotCtx), 9)
+	})
+
+	t.Run("snapshot is not released while read", func(t *testing.T) {
+		store, done, err := shard.readStore(snapshotCtx)
+		require.NoError(t, err)
+		time.Sleep(3 * readSnapshotIdleTimeout)
+
+		shard.readSnapshots.Lock()
+		require.Len(t, shard.readSnapshots.byKey, 1)
+		assert.Same(t, store, shard.readSnapshots.byKey["export"].store)
+		shard.readSnapshots.Unlock()
+		done()
+	})
+
+	t.Run("vector searches are rejected", func(t *testing.T) {
+		_, _, err := shd.ObjectVectorSearch(snapshotCtx, []models.Vector{[]float32{1, 2, 3}}, []string{""},
+			0, 10, nil, nil, nil, additional.Properties{}, nil, nil)
+		assert.ErrorIs(t, err, errReadSnapshotVectorSearch)
+	})
+
+	t.Run("snapshots are scoped to the principal", func(t *testing.T) {
+		alice := &models.Principal{Username: "alice"}
+		bob := &models.Principal{Username: "bob"}
+
+		aliceCtx := dto.ContextWithReadSnapshot(ctx, dto.ReadSnapshotKey(alice, "export"))
+		_, err := shd.ObjectList(aliceCtx, 10, nil, nil, additional.Properties{}, idx.Config.ClassName)
+		require.NoError(t, err)
+
+		for i := 0; i < maxReadSnapshotsPerOwner; i++ {
+			bobCtx := dto.ContextWithReadSnapshot(ctx, dto.ReadSnapshotKey(bob, fmt.Sprintf("export-%d", i)))
+			_, err := shd.ObjectList(bobCtx, 10, nil, nil, additional.Properties{}, idx.Config.ClassName)
+			require.NoError(t, err)
+		}
+
+		shard.readSnapshots.Lock()
+		aliceSnapshot := shard.readSnapshots.byKey[dto.ReadSnapshotKey(alice, "export")]
+		require.NotNil(t, aliceSnapshot)
+		assert.Equal(t, "alice", aliceSnapshot.owner)
+		assert.Nil(t, shard.readSnapshots.byKey[dto.ReadSnapshotKey(bob, "export")])
+		shard.readSnapshots.Unlock()
+
+		bobCtx := dto.ContextWithReadSnapshot(ctx, dto.ReadSnapshotKey(bob, "export"))
+		_, err = shd.ObjectList(bobCtx, 10, nil, nil, additional.Properties{}, idx.Config.ClassName)
+		assert.ErrorContains(t, err, "read snapshots per user")
+
+		assert.Eventually(t, func() bool {
+			shard.readSnapshots.Lock()
+			defer shard.readSnapshots.Unlock()
+			return len(shard.readSnapshots.byKey) == 0
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("snapshot expires after its max lifetime", func(t *testing.T) {
+		maxLifetime := readSnapshotMaxLifetime
+		readSnapshotMaxLifetime = 50 * time.Millisecond
+		defer func() { readSnapshotMaxLifetime = maxLifetime }()
+
+		store, done, err := shard.readStore(snapshotCtx)
+		require.NoError(t, err)
+		time.Sleep(2 * readSnapshotMaxLifetime)
+
+		// reads in progress keep the store until they are done
+		_, _, err = shard.readStore(snapshotCtx)
+		assert.ErrorContains(t, err, "expired")
+		require.NotNil(t, store.Bucket(helpers.ObjectsBucketLSM))
+
+		shard.readSnapshots.Lock()
+		snapshot := shard.readSnapshots.byKey["export"]
+		assert.NotNil(t, snapshot.store)
+		shard.readSnapshots.Unlock()
+
+		done()
+		shard.readSnapshots.Lock()
+		assert.Nil(t, snapshot.store)
+		shard.readSnapshots.Unlock()
+
+		// the key can be used again once the expired snapshot is idle
+		time.Sleep(3 * readSnapshotIdleTimeout)
+		_, done, err = shard.readStore(snapshotCtx)
+		require.NoError(t, err)
+		done()
+	})
+}
@@ -85,6 +85,7 @@ func (s *Shard) performShutdown(ctx context.Context) (err error) {
 	}()
 
 	s.reindexer.Stop(s, fmt.Errorf("shard shutdown"))
+	s.mayCloseChangeStream()
 
 	s.haltForTransferMux.Lock()
 	if s.haltForTransferCancel != nil {
@@ -109,6 +110,11 @@ func (s *Shard) performShutdown(ctx context.Context) (err error) {
 
 	s.mayStopAsyncReplication()
 
+	s.stopVectorIndexMaintenance()
+
+	err = s.stopVectorReindexes(ctx, false)
+	ec.AddWrap(err, "stop vector reindexes")
+
 	_ = s.ForEachVectorQueue(func(targetVector string, queue *VectorIndexQueue) error {
 		if err = queue.Flush(); err != nil {
 			ec.Add(fmt.Errorf("flush vector index queue commitlog of vector %q: %w", targetVector, err))
@@ -140,6 +146,7 @@ func (s *Shard) performShutdown(ctx context.Context) (err error) {
 
 	if s.store != nil {
 		s.UpdateStatus(storagestate.StatusShutdown.String(), "shutdown")
+		s.closeReadSnapshots()
 
 		// store would be nil if loading the objects bucket failed, as we would
 		// only return the store on success from s.initLSMStore()
@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
+)
+
+// Sparse named vectors are not held in a VectorIndex. Every dimension of a
+// sparse vector is indexed as a term of an inverted bucket, with the
+// quantized weight as its frequency, so that the block-max WAND of keyword
+// search can retrieve the top dot products.
+
+// initSparseVector creates or loads the inverted bucket of a sparse named
+// vector.
+func (s *Shard) initSparseVector(ctx context.Context, targetVector string, lazyLoadSegments bool) error {
+	makeBucketOptions := s.makeDefaultBucketOptions
+	if lazyLoadSegments != s.lazySegmentLoadingEnabled {
+		makeBucketOptions = s.overwrittenMakeDefaultBucketOptions(lsmkv.WithLazySegmentLoading(lazyLoadSegments))
+	}
+
+	if err := s.store.CreateOrLoadBucket(ctx, helpers.BucketSparseVectorLSM(targetVector),
+		makeBucketOptions(lsmkv.StrategyInverted)...); err != nil {
+		return fmt.Errorf("init shard %q: sparse index: %w", s.ID(), err)
+	}
+	return nil
+}
+
+func (s *Shard) extendSparseVectorsLSM(vectors map[string]*models.SparseVector, docID uint64) error {
+	for targetVector, vector := range vectors {
+		bucket := s.store.Bucket(helpers.BucketSparseVectorLSM(targetVector))
+		if bucket == nil {
+			return fmt.Errorf("no sparse index for target vector %q found", targetVector)
+		}
+		for i, dim := range vector.Indices {
+			tf := sparse.Quantize(vector.Values[i])
+			if tf == 0 {
+				continue
+			}
+			if err := bucket.MapSet(sparseVectorDimensionKey(dim), sparseVectorPair(docID, tf)); err != nil {
+				return fmt.Errorf("add to sparse index of target vector %q: %w", targetVector, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Shard) deleteFromSparseVectorsLSM(vectors map[string]*models.SparseVector, docID uint64) error {
+	for targetVector, vector := range vectors {
+		bucket := s.store.Bucket(helpers.BucketSparseVectorLSM(targetVector))
+		if bucket == nil {
+			// the named vector may have been stored before its index was dropped
+			continue
+		}
+		docIDBytes := make([]byte, 8)
+		binary.BigEndian.PutUint64(docIDBytes, docID)
+		for i, dim := range vector.Indices {
+			if sparse.Quantize(vector.Values[i]) == 0 {
+				continue
+			}
+			if err := bucket.MapDeleteKey(sparseVectorDimensionKey(dim), docIDBytes); err != nil {
+				return fmt.Errorf("delete from sparse index of target vector %q: %w", targetVector, err)
+			}
+		}
+	}
+	return nil
+}
+
+func targetSparseVectorsEqual(prev, next map[string]*models.SparseVector) bool {
+	if len(prev) != len(next) {
+		return false
+	}
+	for name, prevVec := range prev {
+		nextVec, ok := next[name]
+		if !ok || len(prevVec.Indices) != len(nextVec.Indices) {
+			return false
+		}
+		for i := range prevVec.Indices {
+			if prevVec.Indices[i] != nextVec.Indices[i] || prevVec.Values[i] != nextVec.Values[i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// sparseVectorDimensionKey encodes a dimension as big endian, so that the
+// terms of the bucket are ordered by dimension
+func sparseVectorDimensionKey(dim uint32) []byte {
+	key := make([]byte, 4)
+	binary.BigEndian.PutUint32(key, dim)
+	return key
+}
+
+// sparseVectorPair stores the quantized weight as frequency. All postings
+// share the same property length, so that the block-max metadata of the
+// bucket holds the maximum weight of every block.
+func sparseVectorPair(docID uint64, tf uint32) lsmkv.MapPair {
+	buf := make([]byte, 16)
+	binary.BigEndian.PutUint64(buf[0:8], docID)
+	binary.LittleEndian.PutUint32(buf[8:12], math.Float32bits(float32(tf)))
+	binary.LittleEndian.PutUint32(buf[12:16], math.Float32bits(1))
+
+	return lsmkv.MapPair{
+		Key:   buf[:8],
+		Value: buf[8:],
+	}
+}
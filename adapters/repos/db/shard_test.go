@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"path/filepath"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -1006,3 +1008,71 @@ func getVectorIndexAndQueue(t *testing.T, shard ShardLike, targetVector string)
 	require.True(t, vok && qok)
 	return idx, q
 }
+
+func TestShard_ObjectsCompression(t *testing.T) {
+	ctx := testCtx()
+	class := &models.Class{Class: "TestClass"}
+	text := strings.Repeat("a long text property which compresses well ", 50)
+
+	segmentsSize := func(t *testing.T, compression string) int64 {
+		shd, idx := testShardWithSettings(t, ctx, class, hnsw.NewDefaultUserConfig(), false, false, false,
+			func(i *Index) { i.Config.ObjectsCompression = compression })
+		defer idx.drop()
+
+		objs := make([]*storobj.Object, 100)
+		for i := range objs {
+			objs[i] = testObject(class.Class)
+			objs[i].Object.Properties = map[string]interface{}{"text": text}
+			require.NoError(t, shd.PutObject(ctx, objs[i]))
+		}
+
+		bucket := shd.Store().Bucket(helpers.ObjectsBucketLSM)
+		require.NoError(t, bucket.FlushAndSwitch())
+
+		for _, obj := range objs {
+			found, err := shd.ObjectByID(ctx, obj.ID(), nil, additional.Properties{})
+			require.NoError(t, err)
+			require.NotNil(t, found)
+			assert.Equal(t, text, found.Properties().(map[string]interface{})["text"])
+		}
+
+		entries, err := os.ReadDir(bucket.GetDir())
+		require.NoError(t, err)
+		var size int64
+		for _, entry := range entries {
+			if path.Ext(entry.Name()) != ".db" {
+				continue
+			}
+			info, err := entry.Info()
+			require.NoError(t, err)
+			size += info.Size()
+		}
+		return size
+	}
+
+	uncompressed := segmentsSize(t, "")
+	assert.Less(t, segmentsSize(t, lsmkv.CompressionZstd), uncompressed/2)
+	assert.Less(t, segmentsSize(t, lsmkv.CompressionSnappy), uncompressed/2)
+
+	t.Run("updated by the class", func(t *testing.T) {
+		shd, idx := testShardWithSettings(t, ctx, class, hnsw.NewDefaultUserConfig(), false, false, false)
+		defer idx.drop()
+
+		require.NoError(t, idx.updateObjectStorageConfig(&models.ObjectStorageConfig{Compression: lsmkv.CompressionZstd}))
+		assert.Equal(t, lsmkv.CompressionZstd, idx.objectsCompression())
+
+		obj := testObject(class.Class)
+		obj.Object.Properties = map[string]interface{}{"text": text}
+		require.NoError(t, shd.PutObject(ctx, obj))
+		bucket := shd.Store().Bucket(helpers.ObjectsBucketLSM)
+		require.NoError(t, bucket.FlushAndSwitch())
+
+		segments, err := filepath.Glob(filepath.Join(bucket.GetDir(), "*.db"))
+		require.NoError(t, err)
+		require.Len(t, segments, 1)
+		seg, err := lsmkv.OpenSegmentForInspection(segments[0], nil, idx.logger)
+		require.NoError(t, err)
+		defer seg.Close()
+		assert.Equal(t, lsmkv.CompressionZstd, seg.Info().Compression)
+	})
+}
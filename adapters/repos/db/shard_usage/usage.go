@@ -29,6 +29,7 @@ import (
 	"github.com/weaviate/weaviate/cluster/usage/types"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/usecases/encryption"
 )
 
 func shardPathLSM(indexPath, shardName string) string {
@@ -99,9 +100,11 @@ func usageDisk(shardUsage *types.ShardUsage) *types.UsageDisk {
 }
 
 // CalculateUnloadedDimensionsUsage calculates dimensions and object count for an unloaded shard without loading it into memory
-func CalculateUnloadedDimensionsUsage(ctx context.Context, logger logrus.FieldLogger, path, tenantName, targetVector string) (types.Dimensionality, error) {
+func CalculateUnloadedDimensionsUsage(ctx context.Context, logger logrus.FieldLogger, path, tenantName, targetVector string,
+	enc *encryption.Encryptor,
+) (types.Dimensionality, error) {
 	bucketPath := shardPathDimensionsLSM(path, tenantName)
-	strategy, err := lsmkv.DetermineUnloadedBucketStrategyAmong(bucketPath, lsmkv.DimensionsBucketPrioritizedStrategies)
+	strategy, err := lsmkv.DetermineUnloadedEncryptedBucketStrategyAmong(bucketPath, lsmkv.DimensionsBucketPrioritizedStrategies, enc)
 	if err != nil {
 		return types.Dimensionality{}, fmt.Errorf("determine dimensions bucket strategy: %w", err)
 	}
@@ -114,6 +117,7 @@ func CalculateUnloadedDimensionsUsage(ctx context.Context, logger logrus.FieldLo
 		cyclemanager.NewCallbackGroupNoop(),
 		cyclemanager.NewCallbackGroupNoop(),
 		lsmkv.WithStrategy(strategy),
+		lsmkv.WithEncryption(enc),
 	)
 	if err != nil {
 		return types.Dimensionality{}, err
@@ -151,7 +155,9 @@ func CalculateUnloadedVectorsMetrics(lsmPath string, directories []string) (int6
 }
 
 // CalculateUnloadedObjectsMetrics calculates both object count and storage size from disk
-func CalculateUnloadedObjectsMetrics(logger logrus.FieldLogger, path, shardName string, includeCount bool) (types.ObjectUsage, error) {
+func CalculateUnloadedObjectsMetrics(logger logrus.FieldLogger, path, shardName string, includeCount bool,
+	enc *encryption.Encryptor,
+) (types.ObjectUsage, error) {
 	// Parse all .cna files in the object store and sum them up
 	totalObjectCount := int64(0)
 	totalDiskSize := int64(0)
@@ -169,7 +175,7 @@ func CalculateUnloadedObjectsMetrics(logger logrus.FieldLogger, path, shardName
 			filePath := filepath.Join(objectStore, file)
 			// Look for .cna files (net count additions)
 			if strings.HasSuffix(file, lsmkv.CountNetAdditionsFileSuffix) {
-				count, err := lsmkv.ReadCountNetAdditionsFile(filePath)
+				count, err := lsmkv.ReadCountNetAdditionsFile(enc, filePath)
 				if err != nil {
 					logger.WithField("path", filePath).WithField("shard", shardName).WithError(err).Warn("failed to read .cna file")
 					return types.ObjectUsage{}, err
@@ -179,7 +185,7 @@ func CalculateUnloadedObjectsMetrics(logger logrus.FieldLogger, path, shardName
 
 			// Look for .metadata files (bloom filters + count net additions)
 			if strings.HasSuffix(file, lsmkv.MetadataFileSuffix) {
-				count, err := lsmkv.ReadObjectCountFromMetadataFile(filePath)
+				count, err := lsmkv.ReadObjectCountFromMetadataFile(enc, filePath)
 				if err != nil {
 					logger.WithField("path", filePath).WithField("shard", shardName).WithError(err).Warn("failed to read .metadata file")
 					return types.ObjectUsage{}, err
@@ -309,6 +315,9 @@ func CalculateTargetVectorDimensionsFromBucket(ctx context.Context, b *lsmkv.Buc
 	default:
 		c := b.CursorRoaringSet()
 		defer c.Close()
+		if err := c.Err(); err != nil {
+			return dimensionality, fmt.Errorf("create cursor: %w", err)
+		}
 
 		var v *sroar.Bitmap
 		if nameLen == 0 {
@@ -178,7 +178,7 @@ func TestCalculateUnloadedObjectsMetrics(t *testing.T) {
 				require.Equal(t, 4, fileTypes[".cna"])
 			}
 
-			metrics, err := CalculateUnloadedObjectsMetrics(logger, dirName, "tenant", true)
+			metrics, err := CalculateUnloadedObjectsMetrics(logger, dirName, "tenant", true, nil)
 			require.NoError(t, err)
 			require.Equal(t, metrics.Count, int64(4))
 
@@ -194,7 +194,7 @@ func TestCalculateUnloadedObjectsMetrics(t *testing.T) {
 				require.Equal(t, 4, fileTypes[".cna"])
 			}
 
-			metrics, err = CalculateUnloadedObjectsMetrics(logger, dirName, "tenant", true)
+			metrics, err = CalculateUnloadedObjectsMetrics(logger, dirName, "tenant", true, nil)
 			require.NoError(t, err)
 			require.Equal(t, metrics.Count, int64(4))
 		})
@@ -251,7 +251,7 @@ func TestStorageCalculation(t *testing.T) {
 		expectedTotal += size
 	}
 
-	objectsBytes, err := CalculateUnloadedObjectsMetrics(logger, dirName, "shard1", false)
+	objectsBytes, err := CalculateUnloadedObjectsMetrics(logger, dirName, "shard1", false, nil)
 	require.NoError(t, err)
 	require.Equal(t, sizeTracker[helpers.ObjectsBucketLSM], uint64(objectsBytes.StorageBytes))
 
@@ -308,7 +308,7 @@ func BenchmarkStorageCalculation(b *testing.B) {
 			expectedTotal += size
 		}
 
-		objectsBytes, err := CalculateUnloadedObjectsMetrics(logger, dirName, "shard1", false)
+		objectsBytes, err := CalculateUnloadedObjectsMetrics(logger, dirName, "shard1", false, nil)
 		require.NoError(b, err)
 		require.Equal(b, sizeTracker[helpers.ObjectsBucketLSM], uint64(objectsBytes.StorageBytes))
 
@@ -0,0 +1,431 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/weaviate/weaviate/entities/errorcompounder"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modelsext"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+)
+
+// The tombstone cleanup and the commit log maintenance of a vector index run
+// on the cycles of the shard. Operators can run them on demand, pause the
+// cycles or rebuild the index from the stored vectors. Operations run in the
+// background, one at a time per target vector, and are only tracked in memory
+// while the shard is loaded. A rebuild continues and paused cycles stay paused
+// after the shard is loaded again, as both are recorded with the generations
+// of the vector index.
+//
+// The operations are only exposed by the REST API, on the node holding the
+// shard. The gRPC API only covers data and collection requests, so there are
+// no maintenance RPCs.
+
+const (
+	VectorIndexMaintenanceStatusRunning   = "RUNNING"
+	VectorIndexMaintenanceStatusFinished  = "FINISHED"
+	VectorIndexMaintenanceStatusFailed    = "FAILED"
+	VectorIndexMaintenanceStatusCancelled = "CANCELLED"
+
+	// number of finished operations kept per target vector
+	vectorIndexMaintenanceHistory      = 10
+	vectorIndexMaintenancePollInterval = time.Second
+)
+
+// vectorIndexMaintainer is implemented by vector indexes whose maintenance
+// can be run on demand and paused, see hnsw/maintenance_on_demand.go
+type vectorIndexMaintainer interface {
+	CleanUpTombstones(ctx context.Context) error
+	TombstoneCleanupProgress() float64
+	CondenseCommitLogs(ctx context.Context, progress func(float64)) error
+	CreateCommitLogSnapshot(ctx context.Context) (bool, error)
+	PauseMaintenance(ctx context.Context) error
+	ResumeMaintenance() error
+	MaintenancePaused() bool
+}
+
+type vectorIndexMaintenance struct {
+	sync.Mutex
+	// operations in the order they were started
+	operations []*vectorIndexMaintenanceOperation
+}
+
+type vectorIndexMaintenanceOperation struct {
+	id           string
+	operation    string
+	targetVector string
+	started      time.Time
+
+	cancel context.CancelFunc
+	// cancelled is set if the operation was cancelled on request rather than
+	// by the shard shutting down
+	cancelled atomic.Bool
+	done      chan struct{}
+
+	// progress is read while the operation is running, it is stored as
+	// float64 bits unless the vector index keeps track of it
+	progress   atomic.Uint64
+	progressFn func() float64
+
+	sync.Mutex
+	status   string
+	err      string
+	finished time.Time
+}
+
+func (op *vectorIndexMaintenanceOperation) setProgress(progress float64) {
+	op.progress.Store(math.Float64bits(progress))
+}
+
+func (op *vectorIndexMaintenanceOperation) finish(err error) {
+	op.Lock()
+	defer op.Unlock()
+
+	op.finished = time.Now()
+	switch {
+	case err == nil:
+		op.status = VectorIndexMaintenanceStatusFinished
+		op.setProgress(1)
+	case op.cancelled.Load() || errors.Is(err, context.Canceled):
+		op.status = VectorIndexMaintenanceStatusCancelled
+	default:
+		op.status = VectorIndexMaintenanceStatusFailed
+		op.err = err.Error()
+	}
+}
+
+func (op *vectorIndexMaintenanceOperation) running() bool {
+	op.Lock()
+	defer op.Unlock()
+	return op.status == VectorIndexMaintenanceStatusRunning
+}
+
+func (op *vectorIndexMaintenanceOperation) model() *models.VectorIndexMaintenanceOperation {
+	op.Lock()
+	defer op.Unlock()
+
+	m := &models.VectorIndexMaintenanceOperation{
+		ID:            op.id,
+		Operation:     op.operation,
+		Status:        op.status,
+		Error:         op.err,
+		Progress:      math.Float64frombits(op.progress.Load()),
+		StartTimeUnix: op.started.UnixMilli(),
+	}
+	if op.status == VectorIndexMaintenanceStatusRunning {
+		if op.progressFn != nil {
+			m.Progress = op.progressFn()
+		}
+	} else {
+		m.FinishTimeUnix = op.finished.UnixMilli()
+	}
+	return m
+}
+
+// startVectorIndexMaintenance starts a maintenance operation on the vector
+// index of the target vector. Pausing and resuming the maintenance cycles
+// complete right away, all other operations run in the background.
+func (s *Shard) startVectorIndexMaintenance(ctx context.Context, targetVector, operation string,
+) (*models.VectorIndexMaintenanceOperation, error) {
+	if !slices.Contains(vectorindex.MaintenanceOperations, operation) {
+		return nil, fmt.Errorf("unknown vector index maintenance operation %q", operation)
+	}
+	vidx, ok := s.GetVectorIndex(targetVector)
+	if !ok {
+		return nil, fmt.Errorf("vector index for target vector %q not found", targetVector)
+	}
+	maintainer, ok := vidx.(vectorIndexMaintainer)
+	if !ok && operation != vectorindex.MaintenanceRebuild {
+		return nil, fmt.Errorf("vector index of target vector %q does not support %s", targetVector, operation)
+	}
+
+	m := &s.vectorIndexMaintenance
+	m.Lock()
+	defer m.Unlock()
+
+	for _, running := range m.operations {
+		if running.targetVector == targetVector && running.running() {
+			return nil, fmt.Errorf("maintenance operation %s (%s) of target vector %q is still running",
+				running.operation, running.id, targetVector)
+		}
+	}
+
+	opCtx, cancel := context.WithCancel(s.shutCtx)
+	op := &vectorIndexMaintenanceOperation{
+		id:           uuid.NewString(),
+		operation:    operation,
+		targetVector: targetVector,
+		started:      time.Now(),
+		cancel:       cancel,
+		done:         make(chan struct{}),
+		status:       VectorIndexMaintenanceStatusRunning,
+	}
+
+	var run func(ctx context.Context) error
+	switch operation {
+	case vectorindex.MaintenancePause, vectorindex.MaintenanceResume:
+		defer cancel()
+		if err := s.pauseVectorIndexMaintenance(ctx, targetVector, maintainer,
+			operation == vectorindex.MaintenancePause); err != nil {
+			return nil, fmt.Errorf("%s maintenance of target vector %q: %w", operation, targetVector, err)
+		}
+		op.finish(nil)
+		close(op.done)
+		m.add(op)
+		return op.model(), nil
+
+	case vectorindex.MaintenanceTombstoneCleanup:
+		op.progressFn = maintainer.TombstoneCleanupProgress
+		run = maintainer.CleanUpTombstones
+
+	case vectorindex.MaintenanceCondense:
+		run = func(ctx context.Context) error {
+			return maintainer.CondenseCommitLogs(ctx, op.setProgress)
+		}
+
+	case vectorindex.MaintenanceSnapshot:
+		run = func(ctx context.Context) error {
+			_, err := maintainer.CreateCommitLogSnapshot(ctx)
+			return err
+		}
+
+	case vectorindex.MaintenanceRebuild:
+		if err := s.rebuildVectorIndex(ctx, targetVector); err != nil {
+			cancel()
+			return nil, err
+		}
+		op.progressFn = func() float64 {
+			_, progress, _ := s.vectorIndexRebuildStatus(targetVector)
+			return progress
+		}
+		run = func(ctx context.Context) error {
+			return s.waitForVectorIndexRebuild(ctx, op)
+		}
+	}
+
+	m.add(op)
+	enterrors.GoWrapper(func() {
+		defer close(op.done)
+		err := run(opCtx)
+		if err != nil && !op.cancelled.Load() && opCtx.Err() == nil {
+			s.index.logger.WithField("action", "vector_index_maintenance").
+				WithField("shard", s.ID()).
+				WithField("targetVector", targetVector).
+				WithField("operation", operation).
+				WithError(err).
+				Error("vector index maintenance failed")
+		}
+		op.finish(err)
+	}, s.index.logger)
+
+	return op.model(), nil
+}
+
+// pauseVectorIndexMaintenance pauses or resumes the maintenance cycles of the
+// vector index of the target vector and records it, see
+// restoreVectorIndexMaintenancePause. The cycles are switched back if it
+// cannot be recorded.
+func (s *Shard) pauseVectorIndexMaintenance(ctx context.Context, targetVector string,
+	maintainer vectorIndexMaintainer, pause bool,
+) error {
+	switchCycles := func(pause bool) error {
+		if pause {
+			return maintainer.PauseMaintenance(ctx)
+		}
+		return maintainer.ResumeMaintenance()
+	}
+
+	if err := switchCycles(pause); err != nil {
+		return err
+	}
+	if err := s.setVectorIndexMaintenancePaused(targetVector, pause); err != nil {
+		ec := errorcompounder.New()
+		ec.Add(fmt.Errorf("record maintenance state: %w", err))
+		ec.Add(switchCycles(!pause))
+		return ec.ToError()
+	}
+	return nil
+}
+
+// setVectorIndexMaintenancePaused records whether the maintenance cycles of
+// the vector index of the target vector are paused
+func (s *Shard) setVectorIndexMaintenancePaused(targetVector string, paused bool) error {
+	if targetVector == modelsext.DefaultNamedVectorName && s.hasLegacyVectorIndex() {
+		// the legacy vector index is restored as ""
+		targetVector = ""
+	}
+
+	s.vectorReindexLock.Lock()
+	defer s.vectorReindexLock.Unlock()
+
+	if err := s.loadVectorReindexStatesWithLock(); err != nil {
+		return err
+	}
+	state, ok := s.vectorReindexStates[targetVector]
+	if !ok {
+		if !paused {
+			return nil
+		}
+		// the legacy vector index has no generations
+		state = &vectorReindexState{}
+		s.vectorReindexStates[targetVector] = state
+	}
+	if state.MaintenancePaused == paused {
+		return nil
+	}
+
+	state.MaintenancePaused = paused
+	if err := s.vectorReindexStates.store(s.index.Config.Encryption, s.path()); err != nil {
+		state.MaintenancePaused = !paused
+		return err
+	}
+	return nil
+}
+
+// restoreVectorIndexMaintenancePause pauses the maintenance cycles of a vector
+// index which were paused before the shard was unloaded
+func (s *Shard) restoreVectorIndexMaintenancePause(ctx context.Context, targetVector string,
+	vectorIndex VectorIndex,
+) error {
+	maintainer, ok := vectorIndex.(vectorIndexMaintainer)
+	if !ok {
+		return nil
+	}
+
+	s.vectorReindexLock.Lock()
+	err := s.loadVectorReindexStatesWithLock()
+	state := s.vectorReindexStates[targetVector]
+	paused := state != nil && state.MaintenancePaused
+	s.vectorReindexLock.Unlock()
+	if err != nil || !paused {
+		return err
+	}
+	return maintainer.PauseMaintenance(ctx)
+}
+
+// waitForVectorIndexRebuild waits until the rebuilt index replaced the serving
+// one. A rebuild cancelled on request is stopped, while one interrupted by a
+// shutdown is resumed once the shard is loaded again.
+func (s *Shard) waitForVectorIndexRebuild(ctx context.Context, op *vectorIndexMaintenanceOperation) error {
+	ticker := time.NewTicker(vectorIndexMaintenancePollInterval)
+	defer ticker.Stop()
+
+	for {
+		done, _, err := s.vectorIndexRebuildStatus(op.targetVector)
+		if err != nil || done {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			if op.cancelled.Load() {
+				if err := s.cancelVectorIndexRebuild(op.targetVector); err != nil {
+					return fmt.Errorf("cancel rebuild: %w", err)
+				}
+			}
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// add records the operation and forgets the oldest finished operations of its
+// target vector. Expects the lock to be held.
+func (m *vectorIndexMaintenance) add(op *vectorIndexMaintenanceOperation) {
+	m.operations = append(m.operations, op)
+
+	var finished int
+	for i := len(m.operations) - 1; i >= 0; i-- {
+		if other := m.operations[i]; other.targetVector == op.targetVector && !other.running() {
+			finished++
+			if finished > vectorIndexMaintenanceHistory {
+				m.operations = slices.Delete(m.operations, i, i+1)
+			}
+		}
+	}
+}
+
+// getVectorIndexMaintenance returns whether the maintenance cycles of the
+// vector index of the target vector are paused and its operations, the most
+// recent first
+func (s *Shard) getVectorIndexMaintenance(targetVector string) (*models.VectorIndexMaintenanceStatus, error) {
+	vidx, ok := s.GetVectorIndex(targetVector)
+	if !ok {
+		return nil, fmt.Errorf("vector index for target vector %q not found", targetVector)
+	}
+
+	status := &models.VectorIndexMaintenanceStatus{
+		Operations: []*models.VectorIndexMaintenanceOperation{},
+	}
+	if maintainer, ok := vidx.(vectorIndexMaintainer); ok {
+		status.MaintenancePaused = maintainer.MaintenancePaused()
+	}
+
+	m := &s.vectorIndexMaintenance
+	m.Lock()
+	defer m.Unlock()
+
+	for i := len(m.operations) - 1; i >= 0; i-- {
+		if op := m.operations[i]; op.targetVector == targetVector {
+			status.Operations = append(status.Operations, op.model())
+		}
+	}
+	return status, nil
+}
+
+// cancelVectorIndexMaintenance cancels a running maintenance operation
+// without waiting for it to stop. Cancelling a finished operation does
+// nothing. The operations stop at different points: a tombstone cleanup
+// after the node it processes, condensing before the next commit log, a
+// snapshot not before it is written, and a rebuild drops the index it built
+// so far, see waitForVectorIndexRebuild.
+func (s *Shard) cancelVectorIndexMaintenance(targetVector, id string) error {
+	m := &s.vectorIndexMaintenance
+	m.Lock()
+	defer m.Unlock()
+
+	for _, op := range m.operations {
+		if op.id == id && op.targetVector == targetVector {
+			op.cancelled.Store(true)
+			op.cancel()
+			return nil
+		}
+	}
+	return fmt.Errorf("operation %q of target vector %q: %w", id, targetVector,
+		vectorindex.ErrMaintenanceNotFound)
+}
+
+// stopVectorIndexMaintenance cancels all running maintenance operations and
+// waits for them to stop, e.g. before the vector indexes are shut down
+func (s *Shard) stopVectorIndexMaintenance() {
+	m := &s.vectorIndexMaintenance
+	m.Lock()
+	operations := slices.Clone(m.operations)
+	m.Unlock()
+
+	for _, op := range operations {
+		op.cancel()
+		<-op.done
+	}
+}
@@ -0,0 +1,176 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// errRecallNotSupported is returned for vector indexes which cannot provide
+// the ground truth of a query, e.g. because they already search exhaustively
+var errRecallNotSupported = errors.New("recall measurement is not supported by the vector index")
+
+// recallMeasurer is implemented by vector indexes which can compute the exact
+// nearest neighbors of a query, see hnsw/flat_search.go
+type recallMeasurer interface {
+	ExactSearch(ctx context.Context, vector []float32, k int) ([]uint64, []float32, error)
+	SampleVectors(ctx context.Context, n int) ([][]float32, error)
+}
+
+// RecallMeasurement is the recall@k of a vector index, i.e. the share of the
+// exact k nearest neighbors the index returned, averaged over the sampled
+// queries. Queries is the number of recent user queries which were sampled,
+// StoredVectors the number of stored vectors used as queries when there were
+// not enough of them.
+type RecallMeasurement struct {
+	Recall        float64 `json:"recall"`
+	K             int     `json:"k"`
+	Queries       int     `json:"queries"`
+	StoredVectors int     `json:"storedVectors"`
+}
+
+func (m RecallMeasurement) samples() int {
+	return m.Queries + m.StoredVectors
+}
+
+// merge combines the measurements of two shards, weighted by their samples
+func (m RecallMeasurement) merge(other RecallMeasurement) RecallMeasurement {
+	total := m.samples() + other.samples()
+	if total == 0 {
+		return RecallMeasurement{K: max(m.K, other.K)}
+	}
+	return RecallMeasurement{
+		Recall: (m.Recall*float64(m.samples()) + other.Recall*float64(other.samples())) /
+			float64(total),
+		K:             max(m.K, other.K),
+		Queries:       m.Queries + other.Queries,
+		StoredVectors: m.StoredVectors + other.StoredVectors,
+	}
+}
+
+func (s *Shard) measureRecall(ctx context.Context, targetVector string, k, samples int,
+) (RecallMeasurement, error) {
+	vidx, release, ok := s.acquireVectorIndex(targetVector)
+	if !ok {
+		return RecallMeasurement{}, fmt.Errorf("vector index for target vector %q not found", targetVector)
+	}
+	defer release()
+	measurer, ok := vidx.(recallMeasurer)
+	if !ok {
+		return RecallMeasurement{}, errRecallNotSupported
+	}
+
+	queries := s.recallQueries.get(targetVector, samples)
+	measurement := RecallMeasurement{K: k, Queries: len(queries)}
+	if len(queries) < samples {
+		stored, err := measurer.SampleVectors(ctx, samples-len(queries))
+		if err != nil {
+			return RecallMeasurement{}, fmt.Errorf("sample stored vectors: %w", err)
+		}
+		measurement.StoredVectors = len(stored)
+		queries = append(queries, stored...)
+	}
+
+	var relevant, retrieved int
+	for _, query := range queries {
+		truth, _, err := measurer.ExactSearch(ctx, query, k)
+		if err != nil {
+			return RecallMeasurement{}, err
+		}
+		ids, _, err := vidx.SearchByVector(ctx, query, k, nil)
+		if err != nil {
+			return RecallMeasurement{}, fmt.Errorf("vector search: %w", err)
+		}
+
+		for _, id := range truth {
+			if slices.Contains(ids, id) {
+				relevant++
+			}
+		}
+		retrieved += len(truth)
+	}
+
+	if retrieved > 0 {
+		measurement.Recall = float64(relevant) / float64(retrieved)
+	} else {
+		// an empty index cannot miss anything
+		measurement.Recall = 1
+	}
+	return measurement, nil
+}
+
+// recallQuerySamples keeps the most recent query vectors of every target
+// vector of a shard. The recall monitoring replays them to measure the recall
+// on the queries users actually run rather than on stored vectors only.
+type recallQuerySamples struct {
+	size int
+
+	sync.Mutex
+	byTarget map[string]*querySampleRing
+}
+
+type querySampleRing struct {
+	vectors [][]float32
+	next    int
+}
+
+func newRecallQuerySamples(size int) *recallQuerySamples {
+	if size <= 0 {
+		return nil
+	}
+	return &recallQuerySamples{size: size, byTarget: map[string]*querySampleRing{}}
+}
+
+func (q *recallQuerySamples) record(targetVector string, vector []float32) {
+	if q == nil || len(vector) == 0 {
+		return
+	}
+
+	q.Lock()
+	defer q.Unlock()
+
+	ring, ok := q.byTarget[targetVector]
+	if !ok {
+		ring = &querySampleRing{vectors: make([][]float32, 0, q.size)}
+		q.byTarget[targetVector] = ring
+	}
+
+	// the caller keeps using the query vector, it must not be shared
+	vector = slices.Clone(vector)
+	if len(ring.vectors) < q.size {
+		ring.vectors = append(ring.vectors, vector)
+	} else {
+		ring.vectors[ring.next] = vector
+	}
+	ring.next = (ring.next + 1) % q.size
+}
+
+// get returns up to n of the recorded query vectors of the target vector
+func (q *recallQuerySamples) get(targetVector string, n int) [][]float32 {
+	if q == nil {
+		return nil
+	}
+
+	q.Lock()
+	defer q.Unlock()
+
+	ring, ok := q.byTarget[targetVector]
+	if !ok {
+		return nil
+	}
+	return slices.Clone(ring.vectors[:min(n, len(ring.vectors))])
+}
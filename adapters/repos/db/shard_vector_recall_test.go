@@ -0,0 +1,54 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecallQuerySamples(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		samples := newRecallQuerySamples(0)
+		samples.record("v", []float32{1, 2})
+		assert.Nil(t, samples.get("v", 10))
+	})
+
+	t.Run("keeps the most recent queries per target vector", func(t *testing.T) {
+		samples := newRecallQuerySamples(2)
+		query := []float32{1, 1}
+		samples.record("v", query)
+		samples.record("v", []float32{2, 2})
+		samples.record("v", []float32{3, 3})
+		samples.record("other", []float32{4, 4})
+
+		// the recorded vector must not be shared with the caller
+		query[0] = 100
+
+		assert.ElementsMatch(t, [][]float32{{3, 3}, {2, 2}}, samples.get("v", 10))
+		assert.Len(t, samples.get("v", 1), 1)
+		assert.Equal(t, [][]float32{{4, 4}}, samples.get("other", 10))
+		assert.Nil(t, samples.get("unknown", 10))
+	})
+}
+
+func TestRecallMeasurementMerge(t *testing.T) {
+	merged := RecallMeasurement{}.
+		merge(RecallMeasurement{Recall: 1, K: 10, Queries: 3, StoredVectors: 1}).
+		merge(RecallMeasurement{Recall: 0.5, K: 10, StoredVectors: 4})
+
+	assert.Equal(t, 10, merged.K)
+	assert.Equal(t, 3, merged.Queries)
+	assert.Equal(t, 5, merged.StoredVectors)
+	assert.InDelta(t, 0.75, merged.Recall, 1e-9)
+}
@@ -0,0 +1,1026 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/entities/errorcompounder"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+	"github.com/weaviate/weaviate/usecases/encryption"
+)
+
+// A named vector can be moved to another vector index type, distance or
+// quantizer without re-importing its objects. The shard then builds the next generation
+// of the vector index in the background from the vectors in the objects
+// bucket, while the queue of the serving generation forwards every write to
+// it. Once all vectors were added, the new generation replaces the serving
+// one. Searches acquire the index they run on, see acquireVectorIndex, so the
+// replaced generation is only dropped after the searches using it finished.
+//
+// Which generation serves a target vector and which one is built is recorded
+// in vectorReindexStateFile, so that both survive restarts. How far the
+// vectors were copied is stored as index checkpoint, which is not part of
+// backups, so a restored shard starts to copy from the beginning.
+//
+// A switch of the quantizer, e.g. from PQ to RQ, rebuilds the index like a
+// switch of its type: the rebuilt hnsw index is compressed once all vectors
+// were copied, see compress, or by its queue with asynchronous indexing.
+// Enabling compression does not need a rebuild, as the serving index can
+// compress its vectors in place. Disabling compression and switching the
+// quantizer of a flat index are not supported.
+
+const (
+	vectorReindexStateFile = "vector_reindex.json"
+
+	// vectors are copied into the new generation in batches of this size
+	vectorReindexBatchSize = 1000
+	// the copy progress is checkpointed at this interval, a restarted
+	// rebuild copies at most the vectors of this interval again
+	vectorReindexPersistInterval = 10 * time.Second
+
+	VectorReindexStatusIndexing = "INDEXING"
+	VectorReindexStatusFailed   = "FAILED"
+)
+
+// vectorIndexName returns the name under which the files of a generation of
+// the vector index of the target vector are stored. The first generation
+// keeps the name of the target vector, so that existing indexes are picked up
+// as they are. Vector names cannot contain a "-", so names do not collide.
+func vectorIndexName(targetVector string, generation int) string {
+	if generation == 0 {
+		return targetVector
+	}
+	return fmt.Sprintf("%s-%d", targetVector, generation)
+}
+
+func distanceOrDefault(cfg schemaConfig.VectorIndexConfig) string {
+	if distance := cfg.DistanceName(); distance != "" {
+		return distance
+	}
+	return vectorIndexCommon.DefaultDistanceMetric
+}
+
+type vectorIndexGeneration struct {
+	Generation  int             `json:"generation"`
+	IndexType   string          `json:"indexType"`
+	Distance    string          `json:"distance"`
+	MultiVector bool            `json:"multiVector,omitempty"`
+	Compression string          `json:"compression,omitempty"`
+	Config      json.RawMessage `json:"config"`
+}
+
+func newVectorIndexGeneration(generation int, cfg schemaConfig.VectorIndexConfig) (vectorIndexGeneration, error) {
+	raw, err := json.Marshal(cfg)
+	if err != nil {
+		return vectorIndexGeneration{}, fmt.Errorf("marshal vector index config: %w", err)
+	}
+	return vectorIndexGeneration{
+		Generation:  generation,
+		IndexType:   cfg.IndexType(),
+		Distance:    distanceOrDefault(cfg),
+		MultiVector: cfg.IsMultiVector(),
+		Compression: vectorindex.Compression(cfg),
+		Config:      raw,
+	}, nil
+}
+
+// serves tells whether the index of the generation can serve the config
+// without being rebuilt. Compression can be enabled in place, but switching
+// to another quantizer needs the vectors to be encoded again.
+func (g vectorIndexGeneration) serves(cfg schemaConfig.VectorIndexConfig) bool {
+	compression := vectorindex.Compression(cfg)
+	return g.IndexType == cfg.IndexType() &&
+		g.Distance == distanceOrDefault(cfg) &&
+		g.MultiVector == cfg.IsMultiVector() &&
+		(g.Compression == "" || compression == "" || g.Compression == compression)
+}
+
+// matches tells whether the generation is the index described by the
+// payload of a reindex task
+func (g *vectorIndexGeneration) matches(payload vectorindex.ReindexTaskPayload) bool {
+	return g != nil &&
+		g.IndexType == payload.IndexType &&
+		g.Distance == payload.Distance &&
+		(payload.Compression == "" || g.Compression == payload.Compression)
+}
+
+func (g vectorIndexGeneration) config() (schemaConfig.VectorIndexConfig, error) {
+	var input map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(g.Config))
+	dec.UseNumber()
+	if err := dec.Decode(&input); err != nil {
+		return nil, fmt.Errorf("unmarshal config of vector index generation %d: %w", g.Generation, err)
+	}
+	return vectorindex.ParseAndValidateConfig(input, g.IndexType, g.MultiVector)
+}
+
+type vectorReindexState struct {
+	Serving  vectorIndexGeneration  `json:"serving"`
+	Building *vectorIndexGeneration `json:"building,omitempty"`
+	// Rebuild is set while the index is rebuilt on request even though the
+	// serving generation matches the config, see rebuildVectorIndex
+	Rebuild bool `json:"rebuild,omitempty"`
+	// Obsolete generations are dropped, what is left over from an
+	// interrupted drop is retried on the next load
+	Obsolete []vectorIndexGeneration `json:"obsolete,omitempty"`
+	// MaintenancePaused is set while the maintenance cycles of the vector
+	// index are paused, so that they stay paused once the shard is loaded
+	// again, see setVectorIndexMaintenancePaused
+	MaintenancePaused bool `json:"maintenancePaused,omitempty"`
+}
+
+func (st *vectorReindexState) nextGeneration() int {
+	next := st.Serving.Generation + 1
+	if st.Building != nil && st.Building.Generation >= next {
+		next = st.Building.Generation + 1
+	}
+	for _, g := range st.Obsolete {
+		if g.Generation >= next {
+			next = g.Generation + 1
+		}
+	}
+	return next
+}
+
+// vectorReindexStates holds the state of the vector index of each named
+// vector of a shard
+type vectorReindexStates map[string]*vectorReindexState
+
+// loadVectorReindexStates reads the states of a shard, the file is written
+// with the encryption of the shard
+func loadVectorReindexStates(enc *encryption.Encryptor, shardPath string) (vectorReindexStates, error) {
+	f, err := enc.Open(filepath.Join(shardPath, vectorReindexStateFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return vectorReindexStates{}, nil
+		}
+		return nil, fmt.Errorf("open vector reindex state: %w", err)
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, fmt.Errorf("read vector reindex state: %w", err)
+	}
+
+	states := vectorReindexStates{}
+	if err := json.Unmarshal(data, &states); err != nil {
+		return nil, fmt.Errorf("unmarshal vector reindex state: %w", err)
+	}
+	return states, nil
+}
+
+func (st vectorReindexStates) store(enc *encryption.Encryptor, shardPath string) error {
+	data, err := json.Marshal(st)
+	if err != nil {
+		return fmt.Errorf("marshal vector reindex state: %w", err)
+	}
+
+	path := filepath.Join(shardPath, vectorReindexStateFile)
+	tmpPath := path + ".tmp"
+	f, err := enc.OpenFile(tmpPath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("create vector reindex state: %w", err)
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return fmt.Errorf("write vector reindex state: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("close vector reindex state: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("rename vector reindex state: %w", err)
+	}
+	return nil
+}
+
+// recordVectorIndexGeneration records the config of the vector index a shard
+// that is not loaded keeps on disk, unless already known. Once loaded, the
+// shard then rebuilds the index if the config of the class no longer matches.
+func recordVectorIndexGeneration(enc *encryption.Encryptor, shardPath, targetVector string,
+	cfg schemaConfig.VectorIndexConfig,
+) error {
+	if _, err := os.Stat(shardPath); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	states, err := loadVectorReindexStates(enc, shardPath)
+	if err != nil {
+		return err
+	}
+	if _, ok := states[targetVector]; ok {
+		return nil
+	}
+
+	serving, err := newVectorIndexGeneration(0, cfg)
+	if err != nil {
+		return err
+	}
+	states[targetVector] = &vectorReindexState{Serving: serving}
+	return states.store(enc, shardPath)
+}
+
+// loadVectorReindexStatesWithLock reads the states of the shard unless they
+// were read already. Expects vectorReindexLock to be held.
+func (s *Shard) loadVectorReindexStatesWithLock() error {
+	if s.vectorReindexStates != nil {
+		return nil
+	}
+	states, err := loadVectorReindexStates(s.index.Config.Encryption, s.path())
+	if err != nil {
+		return err
+	}
+	s.vectorReindexStates = states
+	return nil
+}
+
+// servingVectorIndex returns the name and config of the vector index serving
+// the target vector. This is the config of the class, unless the serving
+// generation has another type or distance and is still being replaced.
+func (s *Shard) servingVectorIndex(targetVector string, cfg schemaConfig.VectorIndexConfig,
+) (string, schemaConfig.VectorIndexConfig, error) {
+	s.vectorReindexLock.Lock()
+	defer s.vectorReindexLock.Unlock()
+
+	if err := s.loadVectorReindexStatesWithLock(); err != nil {
+		return "", nil, err
+	}
+
+	state, ok := s.vectorReindexStates[targetVector]
+	if !ok {
+		serving, err := newVectorIndexGeneration(0, cfg)
+		if err != nil {
+			return "", nil, err
+		}
+		state = &vectorReindexState{Serving: serving}
+		s.vectorReindexStates[targetVector] = state
+	}
+
+	name := vectorIndexName(targetVector, state.Serving.Generation)
+	if state.Serving.serves(cfg) {
+		return name, cfg, nil
+	}
+	servingCfg, err := state.Serving.config()
+	if err != nil {
+		return "", nil, err
+	}
+	return name, servingCfg, nil
+}
+
+// reconcileVectorIndex makes sure the vector index of the target vector
+// matches the config, starting to rebuild the index if its type, distance or
+// quantizer changed and cancelling a rebuild which is no longer needed.
+func (s *Shard) reconcileVectorIndex(ctx context.Context, targetVector string,
+	cfg schemaConfig.VectorIndexConfig, serving *VectorIndexQueue,
+) error {
+	s.vectorReindexLock.Lock()
+	defer s.vectorReindexLock.Unlock()
+
+	state, ok := s.vectorReindexStates[targetVector]
+	if !ok {
+		return fmt.Errorf("no vector index state of target vector %q", targetVector)
+	}
+	needed := !state.Serving.serves(cfg) || state.Rebuild
+	if state.Building != nil {
+		if needed && state.Building.serves(cfg) {
+			// already building the requested index
+			if r, ok := s.vectorReindexers[targetVector]; ok && r.failed() {
+				// retry a failed build from the start
+				s.stopVectorReindexWithLock(targetVector, true)
+			} else if ok {
+				return s.vectorReindexStates.store(s.index.Config.Encryption, s.path())
+			}
+		} else {
+			s.stopVectorReindexWithLock(targetVector, false)
+			state.Obsolete = append(state.Obsolete, *state.Building)
+			state.Building = nil
+		}
+	}
+
+	if !needed {
+		serving, err := newVectorIndexGeneration(state.Serving.Generation, cfg)
+		if err != nil {
+			return err
+		}
+		if serving.Compression == "" {
+			// vectors stay compressed once compression was enabled
+			serving.Compression = state.Serving.Compression
+		}
+		state.Serving = serving
+	} else if state.Building == nil {
+		building, err := newVectorIndexGeneration(state.nextGeneration(), cfg)
+		if err != nil {
+			return err
+		}
+		state.Building = &building
+	}
+
+	s.dropObsoleteVectorIndexes(ctx, targetVector, state)
+	if err := s.vectorReindexStates.store(s.index.Config.Encryption, s.path()); err != nil {
+		return err
+	}
+
+	if state.Building == nil {
+		return nil
+	}
+	if _, ok := s.vectorReindexers[targetVector]; ok {
+		return nil
+	}
+	return s.startVectorReindex(ctx, targetVector, cfg, state.Building.Generation, serving)
+}
+
+// dropObsoleteVectorIndexes removes the files of all obsolete generations of
+// the target vector. Generations that could not be dropped are kept in the
+// state to retry later.
+func (s *Shard) dropObsoleteVectorIndexes(ctx context.Context, targetVector string, state *vectorReindexState) {
+	var failed []vectorIndexGeneration
+	for _, g := range state.Obsolete {
+		if err := s.dropVectorIndexGeneration(ctx, targetVector, g); err != nil {
+			s.index.logger.WithField("action", "vector_reindex").
+				WithField("shard", s.ID()).
+				WithField("targetVector", targetVector).
+				WithField("generation", g.Generation).
+				WithError(err).
+				Error("failed to drop obsolete vector index")
+			failed = append(failed, g)
+		}
+	}
+	state.Obsolete = failed
+}
+
+func (s *Shard) dropVectorIndexGeneration(ctx context.Context, targetVector string, g vectorIndexGeneration) error {
+	cfg, err := g.config()
+	if err != nil {
+		return err
+	}
+
+	name := vectorIndexName(targetVector, g.Generation)
+	index, err := s.initVectorIndex(ctx, targetVector, name, cfg, false)
+	if err != nil {
+		return fmt.Errorf("init vector index: %w", err)
+	}
+	if err := index.Drop(ctx, false); err != nil {
+		return fmt.Errorf("drop vector index: %w", err)
+	}
+	return s.removeVectorIndexGenerationFiles(targetVector, g.Generation)
+}
+
+// removeVectorIndexGenerationFiles removes what the vector index of a
+// generation leaves behind once dropped
+func (s *Shard) removeVectorIndexGenerationFiles(targetVector string, generation int) error {
+	name := vectorIndexName(targetVector, generation)
+	paths := []string{
+		filepath.Join(s.path(), fmt.Sprintf("%s.queue.d", s.vectorIndexID(name))),
+	}
+	if generation > 0 {
+		paths = append(paths, filepath.Join(s.path(), "hfresh", name))
+	}
+	for _, path := range paths {
+		if err := os.RemoveAll(path); err != nil {
+			return fmt.Errorf("remove %q: %w", path, err)
+		}
+	}
+	if s.indexCheckpoints != nil {
+		if err := s.indexCheckpoints.Delete(s.ID(), name); err != nil {
+			return fmt.Errorf("delete vector reindex checkpoint: %w", err)
+		}
+	}
+	return nil
+}
+
+// reindexVectorIndex rebuilds the vector index of the target vector if the
+// updated config has another type or distance than the serving index. The
+// previous config is only needed by shards which are not loaded.
+func (s *Shard) reindexVectorIndex(ctx context.Context, targetVector string,
+	previous, updated schemaConfig.VectorIndexConfig,
+) error {
+	queue, ok := s.GetVectorIndexQueue(targetVector)
+	if !ok {
+		return fmt.Errorf("vector index of target vector %q not found", targetVector)
+	}
+	return s.reconcileVectorIndex(ctx, targetVector, updated, queue)
+}
+
+// startVectorReindex creates the building generation of the target vector
+// and starts to copy the vectors into it. Expects vectorReindexLock to be held.
+func (s *Shard) startVectorReindex(ctx context.Context, targetVector string,
+	cfg schemaConfig.VectorIndexConfig, generation int, serving *VectorIndexQueue,
+) error {
+	// the queue was replaced if a rebuild completed before the caller got
+	// hold of vectorReindexLock, its successor serves the target vector now
+	for serving.replaced.Load() {
+		serving = serving.successor.Load()
+	}
+
+	name := vectorIndexName(targetVector, generation)
+	index, err := s.initVectorIndex(ctx, targetVector, name, cfg, false)
+	if err != nil {
+		return fmt.Errorf("init rebuilt vector index of %q: %w", targetVector, err)
+	}
+	queue, err := NewVectorIndexQueue(s, targetVector, name, index)
+	if err != nil {
+		return fmt.Errorf("init queue of rebuilt vector index of %q: %w", targetVector, err)
+	}
+
+	var progress uint64
+	if s.indexCheckpoints != nil {
+		if progress, _, err = s.indexCheckpoints.Get(s.ID(), name); err != nil {
+			return fmt.Errorf("get vector reindex checkpoint of %q: %w", targetVector, err)
+		}
+	}
+
+	buildCtx, cancel := context.WithCancel(s.shutCtx)
+	r := &vectorReindexer{
+		shard:        s,
+		targetVector: targetVector,
+		generation:   generation,
+		indexType:    cfg.IndexType(),
+		serving:      serving,
+		index:        index,
+		queue:        queue,
+		cancel:       cancel,
+		done:         make(chan struct{}),
+	}
+	r.progress.Store(progress)
+
+	// from now on, every write to the serving index is also applied to the
+	// building one, so the copy only needs to catch up with what was there
+	serving.successor.Store(queue)
+	s.vectorReindexers[targetVector] = r
+
+	enterrors.GoWrapper(func() { r.run(buildCtx) }, s.index.logger)
+	return nil
+}
+
+// stopVectorReindexWithLock cancels the rebuild of the target vector and
+// waits for it to finish. The building index is closed, or dropped with all
+// its files if drop is set. Expects vectorReindexLock, but not vectorIndexMu
+// to be held, as the rebuild might be about to swap in its index.
+func (s *Shard) stopVectorReindexWithLock(targetVector string, drop bool) {
+	r, ok := s.vectorReindexers[targetVector]
+	if !ok {
+		return
+	}
+	delete(s.vectorReindexers, targetVector)
+
+	// the rebuild needs the lock to swap in the index once it is done
+	s.vectorReindexLock.Unlock()
+	r.stop()
+	s.vectorReindexLock.Lock()
+
+	if err := r.close(context.Background(), drop); err != nil {
+		s.index.logger.WithField("action", "vector_reindex").
+			WithField("shard", s.ID()).
+			WithField("targetVector", targetVector).
+			WithError(err).
+			Error("failed to close rebuilt vector index")
+	}
+}
+
+// stopVectorReindexes stops all rebuilds of the shard, e.g. on shutdown. The
+// building indexes are dropped if drop is set, otherwise they are resumed
+// once the shard is loaded again.
+func (s *Shard) stopVectorReindexes(ctx context.Context, drop bool) error {
+	s.vectorReindexLock.Lock()
+	reindexers := s.vectorReindexers
+	s.vectorReindexers = map[string]*vectorReindexer{}
+	s.vectorReindexLock.Unlock()
+
+	ec := errorcompounder.New()
+	for targetVector, r := range reindexers {
+		r.stop()
+		if err := r.close(ctx, drop); err != nil {
+			ec.Add(fmt.Errorf("close rebuilt vector index of %q: %w", targetVector, err))
+		}
+	}
+	return ec.ToError()
+}
+
+// swapVectorIndex replaces the serving vector index of the target vector
+// with the one built by the reindexer. vectorIndexMu is always taken before
+// vectorReindexLock, as the shard holds it while initializing vector indexes.
+func (s *Shard) swapVectorIndex(ctx context.Context, r *vectorReindexer) error {
+	s.vectorIndexMu.Lock()
+	defer s.vectorIndexMu.Unlock()
+	s.vectorReindexLock.Lock()
+	defer s.vectorReindexLock.Unlock()
+
+	if current, ok := s.vectorReindexers[r.targetVector]; !ok || current != r || ctx.Err() != nil {
+		// superseded by another config or stopped
+		return ctx.Err()
+	}
+
+	state := s.vectorReindexStates[r.targetVector]
+	previous := state.Serving
+	rebuild := state.Rebuild
+	state.Serving = *state.Building
+	state.Building = nil
+	state.Rebuild = false
+	state.Obsolete = append(state.Obsolete, previous)
+	if err := s.vectorReindexStates.store(s.index.Config.Encryption, s.path()); err != nil {
+		state.Building = &state.Serving
+		state.Serving = previous
+		state.Rebuild = rebuild
+		state.Obsolete = state.Obsolete[:len(state.Obsolete)-1]
+		return err
+	}
+
+	previousIndex := s.vectorIndexes[r.targetVector]
+	previousQueue := s.queues[r.targetVector]
+	s.vectorIndexes[r.targetVector] = r.index
+	s.queues[r.targetVector] = r.queue
+
+	// writes which still reach the previous queue only go to its successor
+	previousQueue.replaced.Store(true)
+	delete(s.vectorReindexers, r.targetVector)
+
+	if m, ok := previousIndex.(vectorIndexMaintainer); ok && m.MaintenancePaused() {
+		if m, ok := r.index.(vectorIndexMaintainer); ok {
+			if err := m.PauseMaintenance(ctx); err != nil {
+				s.index.logger.WithField("action", "vector_reindex").
+					WithField("shard", s.ID()).
+					WithField("targetVector", r.targetVector).
+					WithError(err).
+					Warn("failed to pause maintenance of rebuilt vector index")
+			}
+		}
+	}
+
+	// searches which acquired the previous index before the swap may still
+	// use it, it is dropped once they finished
+	readers, _ := s.vectorIndexReaders.Swap(r.targetVector, &sync.WaitGroup{})
+	enterrors.GoWrapper(func() {
+		if readers != nil {
+			readers.(*sync.WaitGroup).Wait()
+		}
+		s.dropReplacedVectorIndex(r.targetVector, previous, previousIndex, previousQueue)
+	}, s.index.logger)
+	if s.indexCheckpoints != nil {
+		if err := s.indexCheckpoints.Delete(s.ID(), r.name()); err != nil {
+			s.index.logger.WithField("action", "vector_reindex").
+				WithField("shard", s.ID()).
+				WithField("targetVector", r.targetVector).
+				WithError(err).
+				Warn("failed to delete vector reindex checkpoint")
+		}
+	}
+	return nil
+}
+
+// dropReplacedVectorIndex drops a generation replaced by swapVectorIndex.
+// If it fails, the generation stays obsolete and is dropped on the next load.
+func (s *Shard) dropReplacedVectorIndex(targetVector string, generation vectorIndexGeneration,
+	index VectorIndex, queue *VectorIndexQueue,
+) {
+	err := queue.Drop()
+	if err == nil {
+		err = index.Drop(context.Background(), false)
+	}
+	if err == nil {
+		err = s.removeVectorIndexGenerationFiles(targetVector, generation.Generation)
+	}
+	if err == nil {
+		s.vectorReindexLock.Lock()
+		if state, ok := s.vectorReindexStates[targetVector]; ok {
+			state.Obsolete = slices.DeleteFunc(state.Obsolete, func(g vectorIndexGeneration) bool {
+				return g.Generation == generation.Generation
+			})
+			err = s.vectorReindexStates.store(s.index.Config.Encryption, s.path())
+		}
+		s.vectorReindexLock.Unlock()
+	}
+	if err != nil {
+		s.index.logger.WithField("action", "vector_reindex").
+			WithField("shard", s.ID()).
+			WithField("targetVector", targetVector).
+			WithError(err).
+			Warn("failed to drop replaced vector index")
+	}
+}
+
+// rebuildVectorIndex builds the vector index of the target vector again from
+// the stored vectors with the config of the class, e.g. to get rid of a graph
+// which degraded after many updates and deletes. The new generation replaces
+// the serving one like a rebuild into another index type does.
+func (s *Shard) rebuildVectorIndex(ctx context.Context, targetVector string) error {
+	queue, ok := s.GetVectorIndexQueue(targetVector)
+	if !ok {
+		return fmt.Errorf("vector index of target vector %q not found", targetVector)
+	}
+	cfg := s.index.GetVectorIndexConfig(targetVector)
+
+	s.vectorReindexLock.Lock()
+	state, ok := s.vectorReindexStates[targetVector]
+	if !ok {
+		s.vectorReindexLock.Unlock()
+		return fmt.Errorf("vector index of target vector %q can not be rebuilt", targetVector)
+	}
+	if state.Building != nil {
+		s.vectorReindexLock.Unlock()
+		return fmt.Errorf("vector index of target vector %q is already being rebuilt", targetVector)
+	}
+	state.Rebuild = true
+	s.vectorReindexLock.Unlock()
+
+	return s.reconcileVectorIndex(ctx, targetVector, cfg, queue)
+}
+
+// vectorIndexRebuildStatus returns whether the rebuild requested by
+// rebuildVectorIndex completed and how far it got otherwise
+func (s *Shard) vectorIndexRebuildStatus(targetVector string) (bool, float64, error) {
+	s.vectorReindexLock.Lock()
+	defer s.vectorReindexLock.Unlock()
+
+	state, ok := s.vectorReindexStates[targetVector]
+	if !ok || state.Building == nil {
+		return true, 1, nil
+	}
+	r, ok := s.vectorReindexers[targetVector]
+	if !ok {
+		return false, 0, nil
+	}
+	status := r.status()
+	if status.Status == VectorReindexStatusFailed {
+		return false, status.Progress, fmt.Errorf("rebuild vector index of %q: %s", targetVector, status.Error)
+	}
+	return false, status.Progress, nil
+}
+
+// cancelVectorIndexRebuild stops the rebuild requested by rebuildVectorIndex
+// and drops the partially built index. A rebuild which is needed because the
+// config changed in the meantime keeps running.
+func (s *Shard) cancelVectorIndexRebuild(targetVector string) error {
+	s.vectorReindexLock.Lock()
+	defer s.vectorReindexLock.Unlock()
+
+	state, ok := s.vectorReindexStates[targetVector]
+	if !ok || !state.Rebuild {
+		return nil
+	}
+	state.Rebuild = false
+	if state.Building != nil && state.Serving.serves(s.index.GetVectorIndexConfig(targetVector)) {
+		s.stopVectorReindexWithLock(targetVector, true)
+		state.Building = nil
+	}
+	return s.vectorReindexStates.store(s.index.Config.Encryption, s.path())
+}
+
+// rebuildingVectorIndex returns the vector index which is built for the
+// target vector, and whether the serving index has another type or distance
+// than the config of the class, which is then only applied to the former.
+func (s *Shard) rebuildingVectorIndex(targetVector string) (VectorIndex, bool) {
+	s.vectorReindexLock.Lock()
+	defer s.vectorReindexLock.Unlock()
+
+	if r, ok := s.vectorReindexers[targetVector]; ok {
+		return r.index, true
+	}
+	state, ok := s.vectorReindexStates[targetVector]
+	return nil, ok && state.Building != nil
+}
+
+func (s *Shard) getVectorReindexStatus() []*models.VectorReindexStatus {
+	s.vectorReindexLock.Lock()
+	defer s.vectorReindexLock.Unlock()
+
+	if len(s.vectorReindexers) == 0 {
+		return nil
+	}
+
+	statuses := make([]*models.VectorReindexStatus, 0, len(s.vectorReindexers))
+	for _, r := range s.vectorReindexers {
+		statuses = append(statuses, r.status())
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].TargetVector < statuses[j].TargetVector
+	})
+	return statuses
+}
+
+func (s *Shard) vectorReindexProgress(_ context.Context, targetVector string,
+	payload vectorindex.ReindexTaskPayload,
+) (bool, float64, error) {
+	s.vectorReindexLock.Lock()
+	defer s.vectorReindexLock.Unlock()
+
+	state, ok := s.vectorReindexStates[targetVector]
+	if !ok {
+		// the vector index is not initialized yet
+		return false, 0, nil
+	}
+	if state.Building == nil && state.Serving.matches(payload) {
+		return true, 1, nil
+	}
+
+	r, ok := s.vectorReindexers[targetVector]
+	if !ok || !state.Building.matches(payload) {
+		// the shard did not pick up the config of the payload yet
+		return false, 0, nil
+	}
+	status := r.status()
+	if status.Status == VectorReindexStatusFailed {
+		return false, status.Progress, fmt.Errorf("rebuild vector index of %q: %s", targetVector, status.Error)
+	}
+	return false, status.Progress, nil
+}
+
+func (s *Shard) listVectorReindexBackupFiles() ([]string, error) {
+	path := filepath.Join(s.path(), vectorReindexStateFile)
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	rel, err := filepath.Rel(s.index.Config.RootPath, path)
+	if err != nil {
+		return nil, err
+	}
+	return []string{rel}, nil
+}
+
+// vectorReindexer builds one generation of the vector index of a target
+// vector from the vectors stored in the objects bucket
+type vectorReindexer struct {
+	shard        *Shard
+	targetVector string
+	generation   int
+	indexType    string
+	serving      *VectorIndexQueue
+	index        VectorIndex
+	queue        *VectorIndexQueue
+
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	// progress is the doc id up to which the vectors were copied, total the
+	// number of doc ids when the copy started
+	progress atomic.Uint64
+	total    atomic.Uint64
+	err      atomic.Pointer[string]
+}
+
+func (r *vectorReindexer) name() string {
+	return vectorIndexName(r.targetVector, r.generation)
+}
+
+func (r *vectorReindexer) run(ctx context.Context) {
+	defer close(r.done)
+
+	err := r.build(ctx)
+	if err == nil {
+		err = r.shard.swapVectorIndex(ctx, r)
+	}
+	if err == nil || ctx.Err() != nil {
+		return
+	}
+
+	r.shard.index.logger.WithField("action", "vector_reindex").
+		WithField("shard", r.shard.ID()).
+		WithField("targetVector", r.targetVector).
+		WithField("generation", r.generation).
+		WithError(err).
+		Error("failed to rebuild vector index")
+
+	msg := err.Error()
+	r.err.Store(&msg)
+	// writes must not fail because of an index that no longer catches up,
+	// it is rebuilt from the start once the shard is loaded again
+	r.serving.successor.Store(nil)
+	r.progress.Store(0)
+	r.persistProgress()
+}
+
+func (r *vectorReindexer) build(ctx context.Context) error {
+	s := r.shard
+	r.total.Store(s.Counter().Get())
+
+	var batch []common.VectorRecord
+	lastPersisted := time.Now()
+
+	add := func(docID uint64, rec common.VectorRecord, empty bool) error {
+		if !empty && !r.index.ContainsDoc(docID) {
+			batch = append(batch, rec)
+		}
+		if len(batch) >= vectorReindexBatchSize {
+			if err := common.AddVectorsToIndex(ctx, batch, r.index); err != nil {
+				return fmt.Errorf("add vectors to rebuilt index: %w", err)
+			}
+			batch = batch[:0]
+			r.progress.Store(docID + 1)
+		}
+		if time.Since(lastPersisted) > vectorReindexPersistInterval {
+			r.persistProgress()
+			lastPersisted = time.Now()
+		}
+		return nil
+	}
+
+	var err error
+	if r.index.Multivector() {
+		err = s.iterateOnLSMMultiVectors(ctx, r.progress.Load(), r.targetVector, func(docID uint64, vector [][]float32) error {
+			return add(docID, &common.Vector[[][]float32]{ID: docID, Vector: vector}, len(vector) == 0)
+		})
+	} else {
+		err = s.iterateOnLSMVectors(ctx, r.progress.Load(), r.targetVector, func(docID uint64, vector []float32) error {
+			return add(docID, &common.Vector[[]float32]{ID: docID, Vector: vector}, len(vector) == 0)
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("copy vectors: %w", err)
+	}
+	if len(batch) > 0 {
+		if err := common.AddVectorsToIndex(ctx, batch, r.index); err != nil {
+			return fmt.Errorf("add vectors to rebuilt index: %w", err)
+		}
+	}
+	r.progress.Store(r.total.Load())
+	r.persistProgress()
+
+	if err := r.removeDeleted(ctx); err != nil {
+		return err
+	}
+	if err := r.compress(); err != nil {
+		return err
+	}
+	return r.waitForQueue(ctx)
+}
+
+// removeDeleted deletes vectors from the rebuilt index of which the object
+// was deleted while the copy was interrupted
+func (r *vectorReindexer) removeDeleted(ctx context.Context) error {
+	bucket := r.shard.Store().Bucket(helpers.ObjectsBucketLSM)
+	buf := make([]byte, 8)
+
+	var deleted []uint64
+	var iterErr error
+	r.index.Iterate(func(docID uint64) bool {
+		if iterErr = ctx.Err(); iterErr != nil {
+			return false
+		}
+		binary.LittleEndian.PutUint64(buf, docID)
+		v, err := bucket.GetBySecondary(ctx, 0, buf)
+		if err != nil {
+			iterErr = fmt.Errorf("get object of doc id %d: %w", docID, err)
+			return false
+		}
+		if v == nil {
+			deleted = append(deleted, docID)
+		}
+		return true
+	})
+	if iterErr != nil {
+		return iterErr
+	}
+	if len(deleted) == 0 {
+		return nil
+	}
+	if err := r.index.Delete(deleted...); err != nil {
+		return fmt.Errorf("delete removed objects from rebuilt index: %w", err)
+	}
+	return nil
+}
+
+// compress trains the quantizer of a rebuilt hnsw index on the copied
+// vectors and encodes them, as enabling compression on the serving index
+// would. With asynchronous indexing, the queue of the rebuilt index takes
+// care of it once enough vectors were indexed.
+func (r *vectorReindexer) compress() error {
+	if r.indexType != vectorindex.VectorIndexTypeHNSW || r.queue.asyncEnabled {
+		return nil
+	}
+	ci, ok := r.index.(upgradableIndexer)
+	if !ok || ci.Upgraded() || ci.AlreadyIndexed() == 0 {
+		return nil
+	}
+	if shouldUpgrade, _ := ci.ShouldUpgrade(); !shouldUpgrade {
+		return nil
+	}
+
+	// compressing cannot be interrupted, the index must not be closed before
+	done := make(chan struct{})
+	if err := ci.Upgrade(func() { close(done) }); err != nil {
+		return fmt.Errorf("compress rebuilt index: %w", err)
+	}
+	<-done
+	if !ci.Upgraded() {
+		return fmt.Errorf("compress rebuilt index: compression did not complete")
+	}
+	return nil
+}
+
+// waitForQueue waits until the queue of the rebuilt index has indexed all
+// writes it received while vectors were copied
+func (r *vectorReindexer) waitForQueue(ctx context.Context) error {
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	for r.queue.Size() > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
+
+func (r *vectorReindexer) persistProgress() {
+	if r.shard.indexCheckpoints == nil {
+		return
+	}
+	if err := r.shard.indexCheckpoints.Update(r.shard.ID(), r.name(), r.progress.Load()); err != nil {
+		r.shard.index.logger.WithField("action", "vector_reindex").
+			WithField("shard", r.shard.ID()).
+			WithField("targetVector", r.targetVector).
+			WithError(err).
+			Warn("failed to persist vector reindex progress")
+	}
+}
+
+func (r *vectorReindexer) stop() {
+	r.cancel()
+	<-r.done
+}
+
+func (r *vectorReindexer) failed() bool {
+	return r.err.Load() != nil
+}
+
+// close detaches the rebuilt index from the serving one and closes it,
+// dropping its files if drop is set
+func (r *vectorReindexer) close(ctx context.Context, drop bool) error {
+	r.serving.successor.CompareAndSwap(r.queue, nil)
+
+	if drop {
+		if err := r.queue.Drop(); err != nil {
+			return fmt.Errorf("drop queue: %w", err)
+		}
+		if err := r.index.Drop(ctx, false); err != nil {
+			return fmt.Errorf("drop index: %w", err)
+		}
+		return r.shard.removeVectorIndexGenerationFiles(r.targetVector, r.generation)
+	}
+
+	if !r.failed() {
+		r.persistProgress()
+	}
+	if err := r.queue.Flush(); err != nil {
+		return fmt.Errorf("flush queue: %w", err)
+	}
+	if err := r.queue.Close(); err != nil {
+		return fmt.Errorf("close queue: %w", err)
+	}
+	if err := r.index.Flush(); err != nil {
+		return fmt.Errorf("flush index: %w", err)
+	}
+	return r.index.Shutdown(ctx)
+}
+
+func (r *vectorReindexer) status() *models.VectorReindexStatus {
+	status := &models.VectorReindexStatus{
+		TargetVector:    r.targetVector,
+		VectorIndexType: r.indexType,
+		Status:          VectorReindexStatusIndexing,
+	}
+	if total := r.total.Load(); total > 0 {
+		status.Progress = min(float64(r.progress.Load())/float64(total), 1)
+	}
+	if msg := r.err.Load(); msg != nil {
+		status.Status = VectorReindexStatusFailed
+		status.Error = *msg
+	}
+	return status
+}
@@ -33,6 +33,12 @@ func (s *Shard) AddReferencesBatch(ctx context.Context, refs objects.BatchRefere
 		return []error{err}
 	}
 
+	// the references of a batch are added to the objects bucket and the
+	// inverted index at once, see comment in
+	// shard_write_put.go::putObjectLSM
+	s.readSnapshots.writes.RLock()
+	defer s.readSnapshots.writes.RUnlock()
+
 	return newReferencesBatcher(s).References(ctx, refs)
 }
 
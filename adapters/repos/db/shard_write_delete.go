@@ -28,6 +28,10 @@ func (s *Shard) DeleteObject(ctx context.Context, id strfmt.UUID, deletionTime t
 		return err
 	}
 
+	// see comment in shard_write_put.go::putObjectLSM
+	s.readSnapshots.writes.RLock()
+	defer s.readSnapshots.writes.RUnlock()
+
 	s.asyncReplicationRWMux.RLock()
 	defer s.asyncReplicationRWMux.RUnlock()
 
@@ -79,6 +83,10 @@ func (s *Shard) DeleteObject(ctx context.Context, id strfmt.UUID, deletionTime t
 		return fmt.Errorf("object deletion in hashtree: %w", err)
 	}
 
+	if err = s.mayRecordDeletion(idBytes, deletionTime); err != nil {
+		return fmt.Errorf("record deletion: %w", err)
+	}
+
 	err = s.cleanupInvertedIndexOnDelete(existing, docID)
 	if err != nil {
 		return fmt.Errorf("delete object from bucket: %w", err)
@@ -131,6 +139,10 @@ func (s *Shard) cleanupInvertedIndexOnDelete(previous []byte, docID uint64) erro
 		return fmt.Errorf("put inverted indices props: %w", err)
 	}
 
+	if err = s.deleteFromSparseVectorsLSM(previousObject.SparseVectors, docID); err != nil {
+		return err
+	}
+
 	if s.index.Config.TrackVectorDimensions {
 		err = previousObject.IterateThroughVectorDimensions(func(targetVector string, dims int) error {
 			if err = s.removeDimensionsLSM(dims, docID, targetVector); err != nil {
@@ -23,6 +23,8 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
 )
 
 func (s *Shard) extendInvertedIndicesLSM(props []inverted.Property, nilProps []inverted.NilProperty,
@@ -325,3 +327,79 @@ func isMetaCountProperty(property inverted.Property) bool {
 func isInternalProperty(property inverted.Property) bool {
 	return property.Name[0] == '_'
 }
+
+// extendPositionsIndexLSM adds the positions of all terms of the object.
+// Other than the remaining inverted indices, the positions are not updated by
+// delta, as the positions of unchanged terms may have moved.
+func (s *Shard) extendPositionsIndexLSM(object *storobj.Object, docID uint64) error {
+	class := s.index.getSchema.ReadOnlyClass(object.Class().String())
+	if class == nil {
+		return fmt.Errorf("could not find class %s in schema", object.Class().String())
+	}
+
+	props, err := analyzeObjectPositions(s.store, class, object)
+	if err != nil {
+		return err
+	}
+	return addToPositionsIndex(s.store, props, docID)
+}
+
+// analyzeObjectPositions returns the positions of the terms of all properties
+// of the object whose positions bucket is loaded
+func analyzeObjectPositions(store *lsmkv.Store, class *models.Class, object *storobj.Object,
+) ([]inverted.PropertyPositions, error) {
+	values, ok := object.Properties().(map[string]interface{})
+	if !ok || len(values) == 0 {
+		return nil, nil
+	}
+
+	var analyzer *inverted.Analyzer
+	var props []inverted.PropertyPositions
+	for _, prop := range class.Properties {
+		value, ok := values[prop.Name]
+		if !ok || !inverted.HasPositionsIndex(prop) {
+			continue
+		}
+		if store.Bucket(helpers.BucketSearchablePositionsFromPropNameLSM(prop.Name)) == nil {
+			continue
+		}
+
+		if analyzer == nil {
+			analyzer = inverted.NewAnalyzer(nil, class.Class)
+		}
+		items, err := analyzer.PropertyPositions(prop, value)
+		if err != nil {
+			return nil, fmt.Errorf("analyze positions: %w", err)
+		}
+		props = append(props, inverted.PropertyPositions{Name: prop.Name, Items: items})
+	}
+	return props, nil
+}
+
+func addToPositionsIndex(store *lsmkv.Store, props []inverted.PropertyPositions, docID uint64) error {
+	for _, prop := range props {
+		bucket := store.Bucket(helpers.BucketSearchablePositionsFromPropNameLSM(prop.Name))
+		if bucket == nil {
+			return errors.Errorf("no bucket positions for prop '%s' found", prop.Name)
+		}
+
+		for _, item := range prop.Items {
+			pair := lsmkv.MapPair{
+				Key:   positionsDocIDKey(docID),
+				Value: inverted.EncodePositions(item.Positions),
+			}
+			if err := bucket.MapSet(item.Data, pair); err != nil {
+				return errors.Wrapf(err, "failed adding to prop '%s' positions bucket", prop.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// positionsDocIDKey encodes the doc id as big endian regardless of the shard
+// version, as positions buckets did not exist before version 2
+func positionsDocIDKey(docID uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, docID)
+	return key
+}
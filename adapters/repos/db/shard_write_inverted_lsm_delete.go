@@ -53,6 +53,15 @@ func (s *Shard) deleteFromInvertedIndicesLSM(props []inverted.Property, nilProps
 						string(item.Data))
 				}
 			}
+
+			if bucket := s.store.Bucket(helpers.BucketSearchablePositionsFromPropNameLSM(prop.Name)); bucket != nil {
+				for _, item := range prop.Items {
+					if err := bucket.MapDeleteKey(item.Data, positionsDocIDKey(docID)); err != nil {
+						return errors.Wrapf(err, "delete item '%s' from positions index",
+							string(item.Data))
+					}
+				}
+			}
 		}
 
 		if prop.HasRangeableIndex {
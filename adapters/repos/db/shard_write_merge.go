@@ -17,6 +17,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/changestream"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -117,6 +118,10 @@ func (s *Shard) merge(ctx context.Context, idBytes []byte, doc objects.MergeDocu
 func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
 	idBytes []byte,
 ) (*storobj.Object, objectInsertStatus, error) {
+	// see comment in shard_write_put.go::putObjectLSM
+	s.readSnapshots.writes.RLock()
+	defer s.readSnapshots.writes.RUnlock()
+
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 
 	var prevObj, obj *storobj.Object
@@ -162,6 +167,7 @@ func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
 			return nil
 		}
 
+		obj.VectorDataTypes = s.vectorDataTypes()
 		objBytes, err := obj.MarshalBinary()
 		if err != nil {
 			return errors.Wrapf(err, "marshal object %s to binary", obj.ID())
@@ -253,6 +259,7 @@ func (s *Shard) mutableMergeObjectLSM(merge objects.MergeDocument,
 	out.status = status
 
 	obj.DocID = status.docID // is not changed
+	obj.VectorDataTypes = s.vectorDataTypes()
 	objBytes, err := obj.MarshalBinary()
 	if err != nil {
 		return out, errors.Wrapf(err, "marshal object %s to binary", obj.ID())
@@ -266,6 +273,10 @@ func (s *Shard) mutableMergeObjectLSM(merge objects.MergeDocument,
 		return out, fmt.Errorf("object merge in hashtree: %w", err)
 	}
 
+	if err := s.mayRecordChange(changestream.OperationUpdate, idBytes, obj.LastUpdateTimeUnix(), objBytes); err != nil {
+		return out, fmt.Errorf("record change: %w", err)
+	}
+
 	// do not updated inverted index, since this requires delta analysis, which
 	// must be done by the caller!
 
@@ -332,9 +343,11 @@ func mergeProps(previous *storobj.Object,
 	if len(merge.Vectors) == 0 {
 		next.Vectors = previous.Vectors
 		next.MultiVectors = previous.MultiVectors
+		next.SparseVectors = previous.SparseVectors
 	} else {
 		next.Vectors = vectorsAsMap(merge.Vectors)
 		next.MultiVectors = multiVectorsAsMap(merge.Vectors)
+		next.SparseVectors = sparseVectorsAsMap(merge.Vectors)
 	}
 
 	next.Object.LastUpdateTimeUnix = merge.UpdateTime
@@ -368,3 +381,16 @@ func multiVectorsAsMap(in models.Vectors) map[string][][]float32 {
 	}
 	return nil
 }
+
+func sparseVectorsAsMap(in models.Vectors) map[string]*models.SparseVector {
+	var out map[string]*models.SparseVector
+	for targetVector, vector := range in {
+		if v, ok := vector.(*models.SparseVector); ok {
+			if out == nil {
+				out = make(map[string]*models.SparseVector)
+			}
+			out[targetVector] = v
+		}
+	}
+	return out
+}
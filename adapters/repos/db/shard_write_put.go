@@ -21,6 +21,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/changestream"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
@@ -28,6 +29,8 @@ import (
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 )
 
 func (s *Shard) PutObject(ctx context.Context, object *storobj.Object) error {
@@ -232,6 +235,11 @@ func (s *Shard) putObjectLSM(obj *storobj.Object, idBytes []byte,
 		}
 	}
 
+	// the objects bucket and the inverted index are updated atomically for
+	// read snapshots, see shard_read_snapshot.go
+	s.readSnapshots.writes.RLock()
+	defer s.readSnapshots.writes.RUnlock()
+
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 	var prevObj *storobj.Object
 
@@ -270,6 +278,7 @@ func (s *Shard) putObjectLSM(obj *storobj.Object, idBytes []byte,
 			return nil
 		}
 
+		obj.VectorDataTypes = s.vectorDataTypes()
 		objBinary, err := obj.MarshalBinary()
 		if err != nil {
 			return errors.Wrapf(err, "marshal object %s to binary", obj.ID())
@@ -285,6 +294,14 @@ func (s *Shard) putObjectLSM(obj *storobj.Object, idBytes []byte,
 			return errors.Wrap(err, "object creation in hashtree")
 		}
 
+		op := changestream.OperationUpdate
+		if prevObj == nil {
+			op = changestream.OperationInsert
+		}
+		if err := s.mayRecordChange(op, idBytes, obj.LastUpdateTimeUnix(), objBinary); err != nil {
+			return errors.Wrap(err, "record change")
+		}
+
 		return nil
 	}(); err != nil {
 		return objectInsertStatus{}, err
@@ -512,12 +529,30 @@ func (s *Shard) updateInvertedIndexLSM(object *storobj.Object,
 		}
 	}
 
+	// sparse vectors are equal if the doc id is preserved
+	if !status.docIDPreserved {
+		if prevObject != nil {
+			if err := s.deleteFromSparseVectorsLSM(prevObject.SparseVectors, status.oldDocID); err != nil {
+				return err
+			}
+		}
+		if err := s.extendSparseVectorsLSM(object.SparseVectors, status.docID); err != nil {
+			return err
+		}
+	}
+
 	before := time.Now()
 	if err := s.extendInvertedIndicesLSM(propsToAdd, nilpropsToAdd, status.docID); err != nil {
 		return fmt.Errorf("put inverted indices props: %w", err)
 	}
 	s.metrics.InvertedExtend(before, len(propsToAdd))
 
+	if s.index.invertedIndexConfig.IndexPositions || s.indexingPositions.Load() {
+		if err := s.extendPositionsIndexLSM(object, status.docID); err != nil {
+			return fmt.Errorf("put positions index: %w", err)
+		}
+	}
+
 	if s.index.Config.TrackVectorDimensions {
 		err = object.IterateThroughVectorDimensions(func(targetVector string, dims int) error {
 			if err = s.extendDimensionTrackerLSM(dims, status.docID, targetVector); err != nil {
@@ -554,6 +589,9 @@ func compareObjsForInsertStatus(prevObj, nextObj *storobj.Object) (preserve, ski
 	if !targetMultiVectorsEqual(prevObj.MultiVectors, nextObj.MultiVectors) {
 		return false, false
 	}
+	if !targetSparseVectorsEqual(prevObj.SparseVectors, nextObj.SparseVectors) {
+		return false, false
+	}
 	if !addPropsEqual(prevObj.Object.Additional, nextObj.Object.Additional) {
 		return true, false
 	}
@@ -783,3 +821,21 @@ func updateVectorInVectorIndex[T dto.Embedding](ctx context.Context, shard *Shar
 
 	return nil
 }
+
+// vectorDataTypes returns the data types of the named vectors which are not
+// stored as float32, so they are encoded accordingly in the objects bucket
+func (s *Shard) vectorDataTypes() map[string]string {
+	var dataTypes map[string]string
+	for targetVector, config := range s.index.GetVectorIndexConfigs() {
+		if targetVector == "" {
+			continue
+		}
+		if dataType := vectorindex.DataType(config); dataType != vectorIndexCommon.DataTypeFloat32 {
+			if dataTypes == nil {
+				dataTypes = map[string]string{}
+			}
+			dataTypes[targetVector] = dataType
+		}
+	}
+	return dataTypes
+}
@@ -154,6 +154,9 @@ func (is *invertedSorter) sortRoaringSetASC(ctx context.Context, bucket *lsmkv.B
 	hasMoreNesting := len(sort) > 1
 	cursor := bucket.CursorRoaringSet()
 	defer cursor.Close()
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
 
 	foundIDs := make([]uint64, 0, limit)
 	rowsEvaluated := 0
@@ -280,6 +283,9 @@ func (is *invertedSorter) processDESCWindow(
 	idsFoundInWindow := make([]uint64, 0, limit)
 	cursor := bucket.CursorRoaringSet()
 	defer cursor.Close()
+	if err := cursor.Err(); err != nil {
+		return nil, 0, err
+	}
 
 	for k, v := cursor.Seek(startKey); k != nil; k, v = cursor.Next() {
 		if endKey != nil && bytes.Compare(k, endKey) >= 0 {
@@ -152,6 +152,9 @@ func newLsmSorterHelper(bucket *lsmkv.Bucket, comparator *comparator,
 func (h *lsmSorterHelper) getSorted(ctx context.Context) ([]uint64, error) {
 	cursor := h.bucket.Cursor()
 	defer cursor.Close()
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
 
 	sorter := newInsertSorter(h.comparator, h.limit)
 
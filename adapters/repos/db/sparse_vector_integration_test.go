@@ -0,0 +1,159 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	replicationTypes "github.com/weaviate/weaviate/cluster/replication/types"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestSparseVectorSearch(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	dirName := t.TempDir()
+	shardState := singleShardState()
+	mockSchemaReader := schemaUC.NewMockSchemaReader(t)
+	mockSchemaReader.EXPECT().Shards(mock.Anything).Return(shardState.AllPhysicalShards(), nil).Maybe()
+	mockSchemaReader.EXPECT().Read(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(className string, retryIfClassNotFound bool, readFunc func(*models.Class, *sharding.State) error) error {
+		class := &models.Class{Class: className}
+		return readFunc(class, shardState)
+	}).Maybe()
+	mockSchemaReader.EXPECT().ShardReplicas(mock.Anything, mock.Anything).Return([]string{"node1"}, nil).Maybe()
+	mockReplicationFSMReader := replicationTypes.NewMockReplicationFSMReader(t)
+	mockReplicationFSMReader.EXPECT().FilterOneShardReplicasRead(mock.Anything, mock.Anything, mock.Anything).Return([]string{"node1"}).Maybe()
+	mockReplicationFSMReader.EXPECT().FilterOneShardReplicasWrite(mock.Anything, mock.Anything, mock.Anything).Return([]string{"node1"}, nil).Maybe()
+	mockNodeSelector := cluster.NewMockNodeSelector(t)
+	mockNodeSelector.EXPECT().LocalName().Return("node1").Maybe()
+	mockNodeSelector.EXPECT().NodeHostname(mock.Anything).Return("node1", true).Maybe()
+	repo, err := New(logger, "node1", Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10,
+		MaxImportGoroutinesFactor: 1,
+		DisableLazyLoadShards:     true,
+	}, &FakeRemoteClient{}, &FakeNodeResolver{}, &FakeRemoteNodeClient{}, &FakeReplicationClient{}, nil, memwatch.NewDummyMonitor(),
+		mockNodeSelector, mockSchemaReader, mockReplicationFSMReader)
+	require.Nil(t, err)
+
+	class := &models.Class{
+		Class:               "TestSparseVectors",
+		InvertedIndexConfig: invertedConfig(),
+		VectorConfig: map[string]models.VectorConfig{
+			"splade": {
+				VectorIndexType:   "sparse",
+				VectorIndexConfig: sparse.UserConfig{Distance: vectorIndexCommon.DistanceDot},
+				Vectorizer:        map[string]any{"none": map[string]any{}},
+			},
+		},
+		Properties: []*models.Property{},
+	}
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}},
+		shardState: shardState,
+	}
+	repo.SetSchemaGetter(schemaGetter)
+	migrator := NewMigrator(repo, logger, "node1")
+	require.Nil(t, migrator.AddClass(ctx, class))
+
+	vectors := map[strfmt.UUID]*models.SparseVector{
+		strfmt.UUID(uuid.New().String()): {Indices: []uint32{1, 7, 100}, Values: []float32{0.5, 2, 1}},
+		strfmt.UUID(uuid.New().String()): {Indices: []uint32{7, 42}, Values: []float32{0.25, 3}},
+		strfmt.UUID(uuid.New().String()): {Indices: []uint32{100, 70000}, Values: []float32{4, 1.5}},
+	}
+	for id, vector := range vectors {
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			ID: id, Class: class.Class, Vectors: models.Vectors{"splade": vector},
+		}, nil, nil, nil, nil, 0))
+	}
+
+	var shard ShardLike
+	repo.GetIndex(schema.ClassName(class.Class)).shards.Range(func(_ string, s ShardLike) error {
+		shard = s
+		return nil
+	})
+
+	search := func(t *testing.T, query *models.SparseVector) ([]strfmt.UUID, []float32) {
+		objs, scores, err := shard.ObjectSearch(ctx, 10, nil, &searchparams.KeywordRanking{
+			Type:         "sparse",
+			SparseVector: &searchparams.SparseVectorSearch{TargetVector: "splade", Vector: query},
+		}, nil, nil, additional.Properties{}, nil)
+		require.Nil(t, err)
+		ids := make([]strfmt.UUID, len(objs))
+		for i := range objs {
+			ids[i] = objs[i].ID()
+		}
+		return ids, scores
+	}
+
+	query := &models.SparseVector{Indices: []uint32{7, 100}, Values: []float32{1, 0.5}}
+
+	t.Run("results are ordered by dot product", func(t *testing.T) {
+		ids, scores := search(t, query)
+		require.Len(t, ids, 3)
+		for i, id := range ids {
+			require.InDelta(t, sparse.Dot(query, vectors[id]), scores[i], 1e-3)
+			if i > 0 {
+				require.GreaterOrEqual(t, scores[i-1], scores[i])
+			}
+		}
+	})
+
+	t.Run("updated vectors replace the previous postings", func(t *testing.T) {
+		var id strfmt.UUID
+		for id = range vectors {
+			break
+		}
+		vectors[id] = &models.SparseVector{Indices: []uint32{5}, Values: []float32{1}}
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			ID: id, Class: class.Class, Vectors: models.Vectors{"splade": vectors[id]},
+		}, nil, nil, nil, nil, 0))
+
+		ids, _ := search(t, query)
+		require.Len(t, ids, 2)
+		require.NotContains(t, ids, id)
+
+		ids, scores := search(t, &models.SparseVector{Indices: []uint32{5}, Values: []float32{2}})
+		require.Equal(t, []strfmt.UUID{id}, ids)
+		require.InDelta(t, 2, scores[0], 1e-3)
+	})
+
+	t.Run("deleted objects are not returned", func(t *testing.T) {
+		ids, _ := search(t, query)
+		require.NotEmpty(t, ids)
+		require.Nil(t, repo.DeleteObject(ctx, class.Class, ids[0], time.Now(), nil, "", 0))
+
+		remaining, _ := search(t, query)
+		require.Len(t, remaining, len(ids)-1)
+		require.NotContains(t, remaining, ids[0])
+	})
+}
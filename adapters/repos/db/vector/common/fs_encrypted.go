@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/weaviate/weaviate/usecases/encryption"
+)
+
+var (
+	_ File = (*encryption.File)(nil)
+	_ FS   = (*encryptedFS)(nil)
+)
+
+// encryptedFS encrypts all files it creates at rest. Existing plaintext files
+// stay readable, sizes reported by Stat and ReadDir are the sizes of the
+// plaintext contents.
+type encryptedFS struct {
+	osFS
+	enc *encryption.Encryptor
+}
+
+// NewEncryptedFS returns the os file system if enc is disabled
+func NewEncryptedFS(enc *encryption.Encryptor) FS {
+	if !enc.Enabled() {
+		return NewOSFS()
+	}
+	return &encryptedFS{enc: enc}
+}
+
+func (fs *encryptedFS) Create(name string) (File, error) {
+	return fs.enc.Create(name)
+}
+
+func (fs *encryptedFS) Open(name string) (File, error) {
+	return fs.enc.Open(name)
+}
+
+func (fs *encryptedFS) OpenFile(name string, flag int, perm os.FileMode) (File, error) {
+	return fs.enc.OpenFile(name, flag, perm)
+}
+
+func (fs *encryptedFS) ReadDir(name string) ([]os.DirEntry, error) {
+	entries, err := os.ReadDir(name)
+	if err != nil {
+		return nil, err
+	}
+	for i, entry := range entries {
+		if !entry.IsDir() {
+			entries[i] = encryptedDirEntry{DirEntry: entry, path: filepath.Join(name, entry.Name())}
+		}
+	}
+	return entries, nil
+}
+
+func (fs *encryptedFS) Stat(name string) (os.FileInfo, error) {
+	return encryption.Stat(name)
+}
+
+// Truncate replaces encrypted files by a truncated copy with a new key, files
+// opened before keep the previous contents
+func (fs *encryptedFS) Truncate(name string, size int64) error {
+	return fs.enc.Truncate(name, size)
+}
+
+type encryptedDirEntry struct {
+	os.DirEntry
+	path string
+}
+
+func (e encryptedDirEntry) Info() (os.FileInfo, error) {
+	return encryption.Stat(e.path)
+}
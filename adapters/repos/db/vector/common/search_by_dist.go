@@ -0,0 +1,90 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common
+
+import (
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/usecases/floatcomp"
+)
+
+// WithinDistance reports whether dist does not exceed the target distance,
+// allowing for floating point imprecision
+func WithinDistance(dist, targetDistance float32) bool {
+	return dist <= targetDistance ||
+		floatcomp.InDelta(float64(dist), float64(targetDistance), 1e-6)
+}
+
+// SearchByVectorDistance returns all results within the target distance of an
+// approximate index. searchByVector is called with growing limits until the
+// index is exhausted or a result exceeds the target distance, so the result
+// is complete rather than cut off at the first limit. Every search repeats
+// the previous ones, so only the results of the last search are kept, which
+// avoids duplicates when the order of equal distances changes. With a
+// non-negative maxLimit at most maxLimit results are searched; once that limit
+// is reached a warning is logged and the results found so far are returned.
+//
+// The result is only as complete as the index search is exact at the final
+// limit. It is used by hfresh; hnsw has its own loop with the same semantics
+// and flat scans once, see their SearchByVectorDistance.
+func SearchByVectorDistance(targetDistance float32, maxLimit int64,
+	searchByVector func(k int) ([]uint64, []float32, error),
+	logger logrus.FieldLogger,
+) ([]uint64, []float32, error) {
+	if maxLimit == 0 {
+		return nil, nil, nil
+	}
+
+	params := NewSearchByDistParams(0, DefaultSearchByDistInitialLimit,
+		DefaultSearchByDistInitialLimit, maxLimit)
+	if params.MaxLimitReached() {
+		params = NewSearchByDistParams(0, int(maxLimit), int(maxLimit), maxLimit)
+	}
+
+	var resultIDs []uint64
+	var resultDist []float32
+	for {
+		totalLimit := params.TotalLimit()
+		ids, dist, err := searchByVector(totalLimit)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "vector search")
+		}
+
+		resultIDs, resultDist = resultIDs[:0], resultDist[:0]
+		complete := len(ids) < totalLimit
+		for i := range ids {
+			if !WithinDistance(dist[i], targetDistance) {
+				// results are sorted by distance, so no later one can be in range
+				complete = true
+				break
+			}
+			resultIDs = append(resultIDs, ids[i])
+			resultDist = append(resultDist, dist[i])
+		}
+		if complete {
+			return resultIDs, resultDist, nil
+		}
+
+		params.Iterate()
+		if params.MaxLimitReached() && int64(totalLimit) < maxLimit {
+			// search once more with the maximum limit itself
+			params = NewSearchByDistParams(0, int(maxLimit), int(maxLimit), maxLimit)
+		} else if params.MaxLimitReached() {
+			logger.
+				WithField("action", "unlimited_vector_search").
+				Warnf("maximum search limit of %d results has been reached",
+					params.MaximumSearchLimit())
+			return resultIDs, resultDist, nil
+		}
+	}
+}
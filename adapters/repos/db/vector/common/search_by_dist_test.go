@@ -0,0 +1,86 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSearch returns the first k of n results with distance i for result i
+func fakeSearch(n int, calls *[]int) func(k int) ([]uint64, []float32, error) {
+	return func(k int) ([]uint64, []float32, error) {
+		*calls = append(*calls, k)
+		k = min(k, n)
+		ids := make([]uint64, k)
+		dists := make([]float32, k)
+		for i := range ids {
+			ids[i] = uint64(i)
+			dists[i] = float32(i)
+		}
+		return ids, dists, nil
+	}
+}
+
+func TestSearchByVectorDistance(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	t.Run("returns all results beyond the initial limit", func(t *testing.T) {
+		var calls []int
+		ids, dists, err := SearchByVectorDistance(349, -1, fakeSearch(1000, &calls), logger)
+		require.NoError(t, err)
+		require.Len(t, ids, 350)
+		require.Len(t, dists, 350)
+		for i := range ids {
+			assert.Equal(t, uint64(i), ids[i])
+		}
+		assert.Equal(t, []int{100, 1100}, calls)
+	})
+
+	t.Run("stops once the index is exhausted", func(t *testing.T) {
+		var calls []int
+		ids, _, err := SearchByVectorDistance(1e6, -1, fakeSearch(250, &calls), logger)
+		require.NoError(t, err)
+		assert.Len(t, ids, 250)
+		assert.Equal(t, []int{100, 1100}, calls)
+	})
+
+	t.Run("respects the maximum limit", func(t *testing.T) {
+		logger, hook := test.NewNullLogger()
+		var calls []int
+		ids, _, err := SearchByVectorDistance(1e6, 500, fakeSearch(10000, &calls), logger)
+		require.NoError(t, err)
+		assert.Len(t, ids, 500)
+		assert.Equal(t, []int{100, 500}, calls)
+		require.NotNil(t, hook.LastEntry())
+		assert.Equal(t, "unlimited_vector_search", hook.LastEntry().Data["action"])
+	})
+
+	t.Run("maximum limit below the initial limit", func(t *testing.T) {
+		var calls []int
+		ids, _, err := SearchByVectorDistance(1e6, 20, fakeSearch(10000, &calls), logger)
+		require.NoError(t, err)
+		assert.Len(t, ids, 20)
+		assert.Equal(t, []int{20}, calls)
+	})
+
+	t.Run("maximum limit of zero", func(t *testing.T) {
+		var calls []int
+		ids, _, err := SearchByVectorDistance(1e6, 0, fakeSearch(10000, &calls), logger)
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+		assert.Empty(t, calls)
+	})
+}
@@ -603,6 +603,36 @@ func NewBQCompressor(
 	return bqVectorsCompressor, nil
 }
 
+// NewDataTypeCompressor keeps the vectors in the given data type instead of
+// float32, see DataTypeQuantizer
+func NewDataTypeCompressor(
+	dataType string,
+	distance distancer.Provider,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	store *lsmkv.Store,
+	makeBucketOptions lsmkv.MakeBucketOptions,
+	allocChecker memwatch.AllocChecker,
+	targetVector string,
+) (VectorCompressor, error) {
+	dataTypeVectorsCompressor := &quantizedVectorsCompressor[byte]{
+		quantizer:         NewDataTypeQuantizer(dataType, distance),
+		compressedStore:   store,
+		storeId:           binary.BigEndian.PutUint64,
+		loadId:            binary.BigEndian.Uint64,
+		logger:            logger,
+		targetVector:      targetVector,
+		makeBucketOptions: makeBucketOptions,
+	}
+	if err := dataTypeVectorsCompressor.initCompressedStore(); err != nil {
+		return nil, err
+	}
+	dataTypeVectorsCompressor.cache = cache.NewShardedByteLockCache(
+		dataTypeVectorsCompressor.getCompressedVectorForID, vectorCacheMaxObjects, 1, logger,
+		0, allocChecker)
+	return dataTypeVectorsCompressor, nil
+}
+
 func NewBQMultiCompressor(
 	distance distancer.Provider,
 	vectorCacheMaxObjects int,
@@ -0,0 +1,157 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers
+
+import (
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+// DataTypeQuantizer keeps vectors in the data type of the vector index
+// config, see common.EncodeVector. Unlike the other quantizers it does not
+// lose precision: the vectors are validated to be representable in the data
+// type before they are inserted, so the distances are exact and need no
+// rescoring. Integer vectors are compared without decoding them.
+type DataTypeQuantizer struct {
+	dataType  string
+	provider  distancer.Provider
+	distancer distancer.EncodedProvider
+}
+
+func NewDataTypeQuantizer(dataType string, provider distancer.Provider) *DataTypeQuantizer {
+	return &DataTypeQuantizer{
+		dataType:  dataType,
+		provider:  provider,
+		distancer: distancer.NewEncodedProvider(provider, dataType),
+	}
+}
+
+// Encode returns nil if the vector can not be represented in the data type,
+// which makes every distance to it fail with a length error. Vectors are
+// validated before they are inserted, so this only happens for a corrupted
+// vector.
+func (q *DataTypeQuantizer) Encode(vec []float32) []byte {
+	encoded, err := common.EncodeVector(q.dataType, vec)
+	if err != nil {
+		return nil
+	}
+	return encoded
+}
+
+func (q *DataTypeQuantizer) Decode(compressed []byte) []float32 {
+	return common.DecodeVector(q.dataType, compressed, nil)
+}
+
+func (q *DataTypeQuantizer) DistanceBetweenCompressedVectors(x, y []byte) (float32, error) {
+	return q.distancer.SingleDist(x, y)
+}
+
+func (q *DataTypeQuantizer) CompressedBytes(compressed []byte) []byte {
+	return compressed
+}
+
+func (q *DataTypeQuantizer) FromCompressedBytes(compressed []byte) []byte {
+	return compressed
+}
+
+func (q *DataTypeQuantizer) FromCompressedBytesWithSubsliceBuffer(compressed []byte, buffer *[]byte) []byte {
+	if len(*buffer) < len(compressed) {
+		*buffer = make([]byte, len(compressed)*1000)
+	}
+
+	// take from end so we can address the start of the buffer
+	out := (*buffer)[len(*buffer)-len(compressed):]
+	copy(out, compressed)
+	*buffer = (*buffer)[:len(*buffer)-len(compressed)]
+
+	return out
+}
+
+// PersistCompression is a no-op, the data type is part of the vector index
+// config and the vectors are encoded again from the objects on startup.
+func (q *DataTypeQuantizer) PersistCompression(logger CommitLogger) {}
+
+func (q *DataTypeQuantizer) Stats() CompressionStats {
+	return DataTypeStats{DataType: q.dataType}
+}
+
+// NewQuantizerDistancer encodes the query if it can be represented in the
+// data type. Otherwise, e.g. for a query with fractions against int8 vectors,
+// and always for float16 to not round the query, the vectors are decoded and
+// compared as float32.
+func (q *DataTypeQuantizer) NewQuantizerDistancer(vec []float32) quantizerDistancer[byte] {
+	if q.dataType != common.DataTypeFloat16 {
+		if encoded, err := common.EncodeVector(q.dataType, vec); err == nil {
+			return &DataTypeDistancer{q: q, compressed: encoded}
+		}
+	}
+	if q.provider.Type() == "cosine-dot" {
+		vec = distancer.Normalize(vec)
+	}
+	return &DataTypeDistancer{q: q, x: vec}
+}
+
+func (q *DataTypeQuantizer) NewCompressedQuantizerDistancer(a []byte) quantizerDistancer[byte] {
+	return &DataTypeDistancer{q: q, compressed: a}
+}
+
+func (q *DataTypeQuantizer) ReturnQuantizerDistancer(distancer quantizerDistancer[byte]) {}
+
+// DataTypeDistancer compares either an encoded or a float32 query, see
+// DataTypeQuantizer.NewQuantizerDistancer
+type DataTypeDistancer struct {
+	q          *DataTypeQuantizer
+	x          []float32
+	compressed []byte
+}
+
+func (d *DataTypeDistancer) Distance(x []byte) (float32, error) {
+	if d.compressed != nil {
+		return d.q.distancer.SingleDist(d.compressed, x)
+	}
+	return d.distanceToDecoded(d.q.Decode(x))
+}
+
+func (d *DataTypeDistancer) DistanceToFloat(x []float32) (float32, error) {
+	if d.compressed != nil {
+		if encoded, err := common.EncodeVector(d.q.dataType, x); err == nil {
+			return d.q.distancer.SingleDist(d.compressed, encoded)
+		}
+		return d.q.provider.SingleDist(d.normalized(d.q.Decode(d.compressed)), d.normalized(x))
+	}
+	return d.distanceToDecoded(x)
+}
+
+func (d *DataTypeDistancer) distanceToDecoded(x []float32) (float32, error) {
+	return d.q.provider.SingleDist(d.x, d.normalized(x))
+}
+
+func (d *DataTypeDistancer) normalized(x []float32) []float32 {
+	if d.q.provider.Type() == "cosine-dot" {
+		return distancer.Normalize(x)
+	}
+	return x
+}
+
+type DataTypeStats struct {
+	DataType string
+}
+
+func (s DataTypeStats) CompressionType() string {
+	return s.DataType
+}
+
+func (s DataTypeStats) CompressionRatio(_ int) float64 {
+	// float32 takes 4 bytes per dimension, the ratio is 2 for float16 and 4
+	// for int8 and uint8
+	return 4 / float64(common.BytesPerDimension(s.DataType))
+}
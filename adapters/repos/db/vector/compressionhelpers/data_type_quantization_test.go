@@ -0,0 +1,92 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+func TestDataTypeQuantizerDistances(t *testing.T) {
+	a := []float32{-128, 3, 0, 127, -5}
+	b := []float32{4, -2, 100, 1, -128}
+	query := []float32{0.5, -1.25, 3, 7, 2}
+
+	providers := []distancer.Provider{
+		distancer.NewL2SquaredProvider(),
+		distancer.NewDotProductProvider(),
+		distancer.NewCosineDistanceProvider(),
+	}
+	for _, provider := range providers {
+		t.Run(provider.Type(), func(t *testing.T) {
+			normalized := func(v []float32) []float32 {
+				if provider.Type() == "cosine-dot" {
+					return distancer.Normalize(v)
+				}
+				return v
+			}
+			expected, err := provider.SingleDist(normalized(a), normalized(b))
+			require.NoError(t, err)
+			expectedQuery, err := provider.SingleDist(normalized(query), normalized(b))
+			require.NoError(t, err)
+
+			q := compressionhelpers.NewDataTypeQuantizer(common.DataTypeInt8, provider)
+			encodedA, encodedB := q.Encode(a), q.Encode(b)
+			assert.Len(t, encodedA, len(a))
+			assert.Equal(t, b, q.Decode(encodedB))
+
+			dist, err := q.DistanceBetweenCompressedVectors(encodedA, encodedB)
+			require.NoError(t, err)
+			assert.InDelta(t, expected, dist, 1e-5)
+
+			dist, err = q.NewQuantizerDistancer(a).Distance(encodedB)
+			require.NoError(t, err)
+			assert.InDelta(t, expected, dist, 1e-5)
+
+			// a query with fractions can not be encoded, it is compared to the
+			// decoded vectors instead
+			dist, err = q.NewQuantizerDistancer(query).Distance(encodedB)
+			require.NoError(t, err)
+			assert.InDelta(t, expectedQuery, dist, 1e-5)
+
+			dist, err = q.NewCompressedQuantizerDistancer(encodedB).DistanceToFloat(query)
+			require.NoError(t, err)
+			assert.InDelta(t, expectedQuery, dist, 1e-5)
+		})
+	}
+}
+
+func TestDataTypeQuantizerInvalidVector(t *testing.T) {
+	q := compressionhelpers.NewDataTypeQuantizer(common.DataTypeUint8, distancer.NewL2SquaredProvider())
+	assert.Nil(t, q.Encode([]float32{1, -1}))
+
+	_, err := q.DistanceBetweenCompressedVectors(q.Encode([]float32{1, 2}), q.Encode([]float32{1, -1}))
+	assert.Error(t, err)
+}
+
+func TestDataTypeQuantizerStats(t *testing.T) {
+	for dataType, ratio := range map[string]float64{
+		common.DataTypeFloat16: 2,
+		common.DataTypeInt8:    4,
+		common.DataTypeUint8:   4,
+	} {
+		stats := compressionhelpers.NewDataTypeQuantizer(dataType, distancer.NewL2SquaredProvider()).Stats()
+		assert.Equal(t, dataType, stats.CompressionType())
+		assert.Equal(t, ratio, stats.CompressionRatio(128))
+	}
+}
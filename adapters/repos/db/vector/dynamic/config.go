@@ -24,6 +24,7 @@ import (
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 	schemaconfig "github.com/weaviate/weaviate/entities/schema/config"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 	"github.com/weaviate/weaviate/usecases/monitoring"
@@ -49,6 +50,7 @@ type Config struct {
 	AllocChecker            memwatch.AllocChecker
 	MakeBucketOptions       lsmkv.MakeBucketOptions
 	AsyncIndexingEnabled    bool
+	FS                      common.FS // see hnsw.Config for details
 }
 
 func (c Config) Validate() error {
@@ -85,6 +87,10 @@ func ValidateUserConfigUpdate(initial, updated schemaconfig.VectorIndexConfig) e
 			name:     "distance",
 			accessor: func(c ent.UserConfig) interface{} { return c.Distance },
 		},
+		{
+			name:     "dataType",
+			accessor: func(c ent.UserConfig) interface{} { return vectorIndexCommon.DataTypeOrDefault(c.DataType) },
+		},
 	}
 
 	for _, u := range immutableFields {
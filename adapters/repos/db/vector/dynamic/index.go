@@ -17,7 +17,6 @@ import (
 	simpleErrors "errors"
 	"fmt"
 	"io"
-	"math"
 	"os"
 	"path/filepath"
 	"sync"
@@ -37,6 +36,7 @@ import (
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	schemaconfig "github.com/weaviate/weaviate/entities/schema/config"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 	"github.com/weaviate/weaviate/usecases/monitoring"
@@ -118,6 +118,7 @@ type dynamic struct {
 	AllocChecker            memwatch.AllocChecker
 	MakeBucketOptions       lsmkv.MakeBucketOptions
 	AsyncIndexingEnabled    bool
+	fs                      common.FS
 }
 
 func New(cfg Config, uc ent.UserConfig, store *lsmkv.Store) (*dynamic, error) {
@@ -169,6 +170,7 @@ func New(cfg Config, uc ent.UserConfig, store *lsmkv.Store) (*dynamic, error) {
 		AllocChecker:            cfg.AllocChecker,
 		MakeBucketOptions:       cfg.MakeBucketOptions,
 		AsyncIndexingEnabled:    cfg.AsyncIndexingEnabled,
+		fs:                      cfg.FS,
 	}
 
 	upgraded, err := index.init(&cfg)
@@ -196,6 +198,7 @@ func New(cfg Config, uc ent.UserConfig, store *lsmkv.Store) (*dynamic, error) {
 				AllocChecker:          index.AllocChecker,
 				MakeBucketOptions:     index.MakeBucketOptions,
 				AsyncIndexingEnabled:  index.AsyncIndexingEnabled,
+				FS:                    index.fs,
 			},
 			index.uc.HnswUC,
 			index.tombstoneCallbacks,
@@ -342,6 +345,8 @@ func (dynamic *dynamic) SearchByVector(ctx context.Context, vector []float32, k
 	return dynamic.index.SearchByVector(ctx, vector, k, allow)
 }
 
+// SearchByVectorDistance delegates to the current index, i.e. the single scan
+// of flat before the upgrade and the growing limit search of hnsw after it.
 func (dynamic *dynamic) SearchByVectorDistance(ctx context.Context, vector []float32, targetDistance float32, maxLimit int64, allow helpers.AllowList) ([]uint64, []float32, error) {
 	dynamic.RLock()
 	defer dynamic.RUnlock()
@@ -474,13 +479,6 @@ func (dynamic *dynamic) Upgraded() bool {
 	return dynamic.upgraded.Load() && dynamic.index.(upgradableIndexer).Upgraded()
 }
 
-func float32SliceFromByteSlice(vector []byte, slice []float32) []float32 {
-	for i := range slice {
-		slice[i] = math.Float32frombits(binary.LittleEndian.Uint32(vector[i*4:]))
-	}
-	return slice
-}
-
 func (dynamic *dynamic) Upgrade(callback func()) error {
 	if dynamic.ctx.Err() != nil {
 		// already closed
@@ -533,6 +531,7 @@ func (dynamic *dynamic) doUpgrade() error {
 			AllocChecker:          dynamic.AllocChecker,
 			MakeBucketOptions:     dynamic.MakeBucketOptions,
 			AsyncIndexingEnabled:  dynamic.AsyncIndexingEnabled,
+			FS:                    dynamic.fs,
 		},
 		dynamic.uc.HnswUC,
 		dynamic.tombstoneCallbacks,
@@ -643,8 +642,7 @@ func (dynamic *dynamic) copyToVectorIndex(index VectorIndex) error {
 			}
 
 			id := binary.BigEndian.Uint64(k)
-			vc := make([]float32, len(v)/4)
-			float32SliceFromByteSlice(v, vc)
+			vc := vectorIndexCommon.DecodeVector(vectorIndexCommon.DataTypeOrDefault(dynamic.uc.FlatUC.DataType), v, nil)
 
 			ids = append(ids, id)
 			vectors = append(vectors, vc)
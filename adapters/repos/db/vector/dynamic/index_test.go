@@ -108,6 +108,15 @@ func TestDynamic(t *testing.T) {
 	recall1, latency1 := testinghelpers.RecallAndLatency(ctx, queries, k, dynamic, truths)
 	t.Logf("recall: %f, latency %f\n", recall1, latency1)
 	assert.True(t, recall1 > 0.99)
+
+	// range searches return every vector within the distance, also beyond the
+	// initial search limit of 100
+	_, rangeDists := testinghelpers.BruteForce(logger, vectors, queries[0], 250, testinghelpers.DistanceWrapper(distancer))
+	targetDistance := rangeDists[len(rangeDists)-1]
+	ids, _, err := dynamic.SearchByVectorDistance(ctx, queries[0], targetDistance, -1, nil)
+	require.NoError(t, err)
+	assert.Len(t, ids, 250)
+
 	wg := sync.WaitGroup{}
 	wg.Add(1)
 	err = dynamic.Upgrade(func() {
@@ -121,6 +130,13 @@ func TestDynamic(t *testing.T) {
 	t.Logf("recall: %f, latency %f\n", recall2, latency2)
 	assert.True(t, recall2 > 0.9)
 	assert.True(t, latency1 > latency2)
+
+	ids, dists, err := dynamic.SearchByVectorDistance(ctx, queries[0], targetDistance, -1, nil)
+	require.NoError(t, err)
+	assert.Greater(t, len(ids), 200)
+	for _, dist := range dists {
+		assert.LessOrEqual(t, dist, targetDistance+1e-6)
+	}
 }
 
 func TestDynamicReturnsErrorIfNoAsync(t *testing.T) {
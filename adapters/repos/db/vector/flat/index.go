@@ -20,6 +20,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -34,12 +35,13 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/additional"
 	entcfg "github.com/weaviate/weaviate/entities/config"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	entlsmkv "github.com/weaviate/weaviate/entities/lsmkv"
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
-	"github.com/weaviate/weaviate/usecases/floatcomp"
 )
 
 const (
@@ -56,8 +58,12 @@ type flat struct {
 	store             *lsmkv.Store
 	logger            logrus.FieldLogger
 	distancerProvider distancer.Provider
-	initOnce          sync.Once
-	rescore           int64
+	// dataType of the vectors in the vectors bucket, encoded vectors other
+	// than float32 are not normalized and compared with encodedDistancer
+	dataType         string
+	encodedDistancer distancer.EncodedProvider
+	initOnce         sync.Once
+	rescore          int64
 
 	compressed      atomic.Bool
 	compressionType CompressionType
@@ -91,6 +97,8 @@ func New(cfg Config, uc flatent.UserConfig, store *lsmkv.Store) (*flat, error) {
 		rootPath:             cfg.RootPath,
 		logger:               logger,
 		distancerProvider:    cfg.DistanceProvider,
+		dataType:             vectorIndexCommon.DataTypeOrDefault(uc.DataType),
+		encodedDistancer:     distancer.NewEncodedProvider(cfg.DistanceProvider, uc.DataType),
 		metadataLock:         &sync.RWMutex{},
 		rescore:              extractCompressionRescore(uc),
 		pqResults:            common.NewPqMaxPool(100),
@@ -373,9 +381,18 @@ func (index *flat) Add(ctx context.Context, id uint64, vector []float32) error {
 		index.initializeDimensionsAndRQ(vector)
 	})
 
-	vector = index.normalized(vector)
-	slice := make([]byte, len(vector)*4)
-	index.storeVector(id, byteSliceFromFloat32Slice(vector, slice))
+	var vectorBytes []byte
+	if index.dataType == vectorIndexCommon.DataTypeFloat32 {
+		vector = index.normalized(vector)
+		vectorBytes = byteSliceFromFloat32Slice(vector, make([]byte, len(vector)*4))
+	} else {
+		var err error
+		if vectorBytes, err = vectorIndexCommon.EncodeVector(index.dataType, vector); err != nil {
+			return err
+		}
+		vector = index.normalized(vector)
+	}
+	index.storeVector(id, vectorBytes)
 
 	index.Preload(id, vector)
 
@@ -421,6 +438,10 @@ func (index *flat) searchTimeRescore(k int) int {
 }
 
 func (index *flat) SearchByVector(ctx context.Context, vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	if allow != nil {
+		helpers.RecordFilterStrategy(ctx, additional.FilterStrategyFlat, "flat index",
+			allow.Len(), int(index.AlreadyIndexed()))
+	}
 	switch index.compressionType {
 	case CompressionBQ, CompressionRQ1, CompressionRQ8:
 		return index.searchByVectorQuantized(ctx, vector, k, allow)
@@ -434,8 +455,6 @@ func (index *flat) searchByVector(ctx context.Context, vector []float32, k int,
 	heap := index.pqResults.GetMax(k)
 	defer index.pqResults.Put(heap)
 
-	vector = index.normalized(vector)
-
 	if err := index.findTopVectors(heap, allow, k,
 		index.store.Bucket(index.getBucketName()).Cursor,
 		index.createDistanceCalc(vector),
@@ -447,7 +466,15 @@ func (index *flat) searchByVector(ctx context.Context, vector []float32, k int,
 	return ids, dists, nil
 }
 
+// createDistanceCalc calculates the distance between the query and vectors of
+// the vectors bucket. Integer vectors are compared without decoding them if
+// the query can be encoded in their data type.
 func (index *flat) createDistanceCalc(vector []float32) distanceCalc {
+	if index.dataType != vectorIndexCommon.DataTypeFloat32 {
+		return index.createEncodedDistanceCalc(vector)
+	}
+
+	vector = index.normalized(vector)
 	return func(vecAsBytes []byte) (float32, error) {
 		vecSlice := index.pool.float32SlicePool.Get(len(vecAsBytes) / 4)
 		defer index.pool.float32SlicePool.Put(vecSlice)
@@ -457,6 +484,26 @@ func (index *flat) createDistanceCalc(vector []float32) distanceCalc {
 	}
 }
 
+func (index *flat) createEncodedDistanceCalc(vector []float32) distanceCalc {
+	if index.dataType != vectorIndexCommon.DataTypeFloat16 {
+		if encoded, err := vectorIndexCommon.EncodeVector(index.dataType, vector); err == nil {
+			return func(vecAsBytes []byte) (float32, error) {
+				return index.encodedDistancer.SingleDist(encoded, vecAsBytes)
+			}
+		}
+	}
+
+	vector = index.normalized(vector)
+	bytesPerDimension := vectorIndexCommon.BytesPerDimension(index.dataType)
+	return func(vecAsBytes []byte) (float32, error) {
+		vecSlice := index.pool.float32SlicePool.Get(len(vecAsBytes) / bytesPerDimension)
+		defer index.pool.float32SlicePool.Put(vecSlice)
+
+		candidate := vectorIndexCommon.DecodeVector(index.dataType, vecAsBytes, vecSlice.slice)
+		return index.distancerProvider.SingleDist(vector, index.normalized(candidate))
+	}
+}
+
 func (index *flat) searchByVectorQuantized(ctx context.Context, vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
 	// Ensure quantizer is initialized
 	if index.quantizer == nil {
@@ -468,6 +515,7 @@ func (index *flat) searchByVectorQuantized(ctx context.Context, vector []float32
 	heap := index.pqResults.GetMax(rescore)
 	defer index.pqResults.Put(heap)
 
+	distanceCalc := index.createDistanceCalc(vector)
 	vector = index.normalized(vector)
 
 	if index.Compressed() && index.Cached() {
@@ -483,7 +531,6 @@ func (index *flat) searchByVectorQuantized(ctx context.Context, vector []float32
 		}
 	}
 
-	distanceCalc := index.createDistanceCalc(vector)
 	idsSlice := index.pool.uint64SlicePool.Get(heap.Len())
 	defer index.pool.uint64SlicePool.Put(idsSlice)
 
@@ -578,6 +625,20 @@ func (index *flat) vectorById(id uint64) ([]byte, error) {
 func (index *flat) findTopVectors(heap *priorityqueue.Queue[any],
 	allow helpers.AllowList, limit int, cursorFn func() *lsmkv.CursorReplace,
 	distanceCalc distanceCalc,
+) error {
+	return index.iterateVectors(allow, cursorFn, func(id uint64, v []byte) error {
+		distance, err := distanceCalc(v)
+		if err != nil {
+			return err
+		}
+		index.insertToHeap(heap, limit, id, distance)
+		return nil
+	})
+}
+
+// iterateVectors calls fn for every vector of the cursor that is allowed
+func (index *flat) iterateVectors(allow helpers.AllowList,
+	cursorFn func() *lsmkv.CursorReplace, fn func(id uint64, v []byte) error,
 ) error {
 	var key []byte
 	var v []byte
@@ -608,11 +669,9 @@ func (index *flat) findTopVectors(heap *priorityqueue.Queue[any],
 	for ; key != nil && (allow == nil || id <= allowMax); key, v = cursor.Next() {
 		id = binary.BigEndian.Uint64(key)
 		if allow == nil || allow.Contains(id) {
-			distance, err := distanceCalc(v)
-			if err != nil {
+			if err := fn(id, v); err != nil {
 				return err
 			}
-			index.insertToHeap(heap, limit, id, distance)
 		}
 	}
 	return nil
@@ -696,68 +755,61 @@ func (index *flat) normalized(vector []float32) []float32 {
 	return vector
 }
 
+// SearchByVectorDistance returns all vectors within the target distance sorted
+// by distance. Unlike approximate indexes the flat index compares the query
+// with every vector, so a single scan finds the complete result set. The scan
+// uses the uncompressed vectors even if the index is compressed, as quantized
+// distances could miss vectors close to the target distance. With a
+// non-negative maxLimit only the maxLimit closest vectors are returned.
 func (index *flat) SearchByVectorDistance(ctx context.Context, vector []float32,
 	targetDistance float32, maxLimit int64, allow helpers.AllowList,
 ) ([]uint64, []float32, error) {
-	var (
-		searchParams = newSearchByDistParams(maxLimit)
-
-		resultIDs  []uint64
-		resultDist []float32
-	)
-
-	recursiveSearch := func() (bool, error) {
-		totalLimit := searchParams.TotalLimit()
-		ids, dist, err := index.SearchByVector(ctx, vector, totalLimit, allow)
-		if err != nil {
-			return false, errors.Wrap(err, "vector search")
-		}
-
-		// if there is less results than given limit search can be stopped
-		shouldContinue := !(len(ids) < totalLimit)
-
-		// ensures the indexes aren't out of range
-		offsetCap := searchParams.OffsetCapacity(ids)
-		totalLimitCap := searchParams.TotalLimitCapacity(ids)
-
-		if offsetCap == totalLimitCap {
-			return false, nil
-		}
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
 
-		ids, dist = ids[offsetCap:totalLimitCap], dist[offsetCap:totalLimitCap]
-		for i := range ids {
-			if aboveThresh := dist[i] <= targetDistance; aboveThresh ||
-				floatcomp.InDelta(float64(dist[i]), float64(targetDistance), 1e-6) {
-				resultIDs = append(resultIDs, ids[i])
-				resultDist = append(resultDist, dist[i])
-			} else {
-				// as soon as we encounter a certainty which
-				// is below threshold, we can stop searching
-				shouldContinue = false
-				break
+	var results []distanceResult
+	distanceCalc := index.createDistanceCalc(vector)
+	if err := index.iterateVectors(allow, index.store.Bucket(index.getBucketName()).Cursor,
+		func(id uint64, v []byte) error {
+			distance, err := distanceCalc(v)
+			if err != nil {
+				return err
 			}
-		}
-
-		return shouldContinue, nil
+			if common.WithinDistance(distance, targetDistance) {
+				results = append(results, distanceResult{id: id, distance: distance})
+			}
+			return nil
+		},
+	); err != nil {
+		return nil, nil, errors.Wrap(err, "vector search")
 	}
 
-	var shouldContinue bool
-	var err error
-	for shouldContinue, err = recursiveSearch(); shouldContinue && err == nil; {
-		searchParams.Iterate()
-		if searchParams.MaxLimitReached() {
-			index.logger.
-				WithField("action", "unlimited_vector_search").
-				Warnf("maximum search limit of %d results has been reached",
-					searchParams.MaximumSearchLimit())
-			break
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].distance != results[j].distance {
+			return results[i].distance < results[j].distance
 		}
+		return results[i].id < results[j].id
+	})
+	if maxLimit >= 0 && int64(len(results)) > maxLimit {
+		index.logger.
+			WithField("action", "unlimited_vector_search").
+			Warnf("maximum search limit of %d results has been reached", maxLimit)
+		results = results[:maxLimit]
 	}
-	if err != nil {
-		return nil, nil, err
+
+	ids := make([]uint64, len(results))
+	dists := make([]float32, len(results))
+	for i, result := range results {
+		ids[i] = result.id
+		dists[i] = result.distance
 	}
+	return ids, dists, nil
+}
 
-	return resultIDs, resultDist, nil
+type distanceResult struct {
+	id       uint64
+	distance float32
 }
 
 func (index *flat) UpdateUserConfig(updated schemaConfig.VectorIndexConfig, callback func()) error {
@@ -1078,13 +1130,6 @@ func (index *flat) Iterate(fn func(docID uint64) bool) {
 	}
 }
 
-func newSearchByDistParams(maxLimit int64) *common.SearchByDistParams {
-	initialOffset := 0
-	initialLimit := common.DefaultSearchByDistInitialLimit
-
-	return common.NewSearchByDistParams(initialOffset, initialLimit, initialOffset+initialLimit, maxLimit)
-}
-
 type immutableParameter struct {
 	accessor func(c flatent.UserConfig) interface{}
 	name     string
@@ -1139,6 +1184,10 @@ func ValidateUserConfigUpdate(initial, updated schemaConfig.VectorIndexConfig) e
 			name:     "rq.bits",
 			accessor: func(c flatent.UserConfig) interface{} { return c.RQ.Bits },
 		},
+		{
+			name:     "dataType",
+			accessor: func(c flatent.UserConfig) interface{} { return vectorIndexCommon.DataTypeOrDefault(c.DataType) },
+		},
 		// as of v1.25.2, updating the BQ cache setting is now possible.
 		// Note that the change does not take effect until the tenant is
 		// reloaded, either from a complete restart or from
@@ -1159,17 +1208,14 @@ func (index *flat) AlreadyIndexed() uint64 {
 
 func (index *flat) QueryVectorDistancer(queryVector []float32) common.QueryVectorDistancer {
 	var distFunc func(nodeID uint64) (float32, error)
+	distanceCalc := index.createDistanceCalc(queryVector)
 	queryVector = index.normalized(queryVector)
 	defaultDistFunc := func(nodeID uint64) (float32, error) {
 		vec, err := index.vectorById(nodeID)
 		if err != nil {
 			return 0, err
 		}
-		dist, err := index.distancerProvider.SingleDist(queryVector, float32SliceFromByteSlice(vec, make([]float32, len(vec)/4)))
-		if err != nil {
-			return 0, err
-		}
-		return dist, nil
+		return distanceCalc(vec)
 	}
 	switch index.compressionType {
 	case CompressionBQ, CompressionRQ1, CompressionRQ8:
@@ -1224,17 +1270,7 @@ func (index *flat) QueryVectorDistancer(queryVector []float32) common.QueryVecto
 			}
 		}
 	default:
-		distFunc = func(nodeID uint64) (float32, error) {
-			vec, err := index.vectorById(nodeID)
-			if err != nil {
-				return 0, err
-			}
-			dist, err := index.distancerProvider.SingleDist(queryVector, float32SliceFromByteSlice(vec, make([]float32, len(vec)/4)))
-			if err != nil {
-				return 0, err
-			}
-			return dist, nil
-		}
+		distFunc = defaultDistFunc
 	}
 	return common.QueryVectorDistancer{DistanceFunc: distFunc}
 }
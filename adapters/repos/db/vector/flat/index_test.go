@@ -1227,6 +1227,87 @@ func TestQuantizedSearchFunctionality(t *testing.T) {
 	}
 }
 
+func TestFlat_DataTypes(t *testing.T) {
+	ctx := context.Background()
+	vectors := [][]float32{
+		{1, 2, 3, 4},
+		{4, 3, 2, 1},
+		{10, 20, 30, 40},
+		{100, 0, 0, 1},
+	}
+
+	for _, dataType := range []string{"float32", "float16", "int8", "uint8"} {
+		for _, provider := range []distancer.Provider{
+			distancer.NewCosineDistanceProvider(),
+			distancer.NewL2SquaredProvider(),
+			distancer.NewDotProductProvider(),
+		} {
+			t.Run(dataType+" "+provider.Type(), func(t *testing.T) {
+				store, dirName := createTestStore(t)
+				config := flatent.UserConfig{}
+				config.SetDefaults()
+				config.DataType = dataType
+
+				index, err := New(Config{
+					ID:                "data-type",
+					RootPath:          dirName,
+					DistanceProvider:  provider,
+					MakeBucketOptions: lsmkv.MakeNoopBucketOptions,
+				}, config, store)
+				require.Nil(t, err)
+				defer index.Shutdown(context.Background())
+
+				for i, vec := range vectors {
+					require.Nil(t, index.Add(ctx, uint64(i), vec))
+				}
+
+				for _, query := range [][]float32{{1, 2, 3, 5}, {1.5, 2, 3, 5}} {
+					ids, dists, err := index.SearchByVector(ctx, query, len(vectors), nil)
+					require.Nil(t, err)
+					require.Len(t, ids, len(vectors))
+
+					for i, id := range ids {
+						expected, err := provider.SingleDist(normalizeIfCosine(provider, query),
+							normalizeIfCosine(provider, vectors[id]))
+						require.Nil(t, err)
+						assert.InDelta(t, expected, dists[i], 0.0001)
+					}
+
+					distancer := index.QueryVectorDistancer(query)
+					dist, err := distancer.DistanceToNode(ids[0])
+					require.Nil(t, err)
+					assert.InDelta(t, dists[0], dist, 0.0001)
+				}
+			})
+		}
+	}
+
+	t.Run("value not representable in data type", func(t *testing.T) {
+		store, dirName := createTestStore(t)
+		config := flatent.UserConfig{}
+		config.SetDefaults()
+		config.DataType = "uint8"
+
+		index, err := New(Config{
+			ID:                "data-type",
+			RootPath:          dirName,
+			DistanceProvider:  distancer.NewL2SquaredProvider(),
+			MakeBucketOptions: lsmkv.MakeNoopBucketOptions,
+		}, config, store)
+		require.Nil(t, err)
+		defer index.Shutdown(context.Background())
+
+		require.ErrorContains(t, index.Add(ctx, 0, []float32{-1, 2}), "uint8")
+	})
+}
+
+func normalizeIfCosine(provider distancer.Provider, vector []float32) []float32 {
+	if provider.Type() == "cosine-dot" {
+		return distancer.Normalize(vector)
+	}
+	return vector
+}
+
 func TestEdgeCases(t *testing.T) {
 	ctx := context.Background()
 	distancer := distancer.NewCosineDistanceProvider()
@@ -1757,3 +1838,65 @@ func TestRQ1PersistenceAndRestoration(t *testing.T) {
 		require.Equal(t, uint64(1), results2[0])
 	})
 }
+
+func TestFlat_SearchByVectorDistance(t *testing.T) {
+	ctx := context.Background()
+	query := []float32{0, 0}
+
+	for _, compressed := range []bool{false, true} {
+		name := "uncompressed"
+		if compressed {
+			name = "bq"
+		}
+		t.Run(name, func(t *testing.T) {
+			store, dirName := createTestStore(t)
+			config := flatent.UserConfig{}
+			config.SetDefaults()
+			config.BQ.Enabled = compressed
+
+			index, err := New(Config{
+				ID:                "search-by-distance",
+				RootPath:          dirName,
+				DistanceProvider:  distancer.NewL2SquaredProvider(),
+				MakeBucketOptions: lsmkv.MakeNoopBucketOptions,
+			}, config, store)
+			require.Nil(t, err)
+			defer index.Shutdown(context.Background())
+
+			// the squared distance of vector i to the query is i*i
+			for i := 0; i < 1000; i++ {
+				require.Nil(t, index.Add(ctx, uint64(i), []float32{float32(i), 0}))
+			}
+
+			t.Run("all results within distance", func(t *testing.T) {
+				ids, dists, err := index.SearchByVectorDistance(ctx, query, 500*500, -1, nil)
+				require.Nil(t, err)
+				require.Len(t, ids, 501)
+				for i, id := range ids {
+					assert.Equal(t, uint64(i), id)
+					assert.Equal(t, float32(i*i), dists[i])
+				}
+			})
+
+			t.Run("with allow list", func(t *testing.T) {
+				allow := helpers.NewAllowList()
+				for i := uint64(0); i < 1000; i += 2 {
+					allow.Insert(i)
+				}
+				ids, _, err := index.SearchByVectorDistance(ctx, query, 500*500, -1, allow)
+				require.Nil(t, err)
+				require.Len(t, ids, 251)
+				for i, id := range ids {
+					assert.Equal(t, uint64(i*2), id)
+				}
+			})
+
+			t.Run("maximum limit keeps the closest results", func(t *testing.T) {
+				ids, _, err := index.SearchByVectorDistance(ctx, query, 500*500, 150, nil)
+				require.Nil(t, err)
+				require.Len(t, ids, 150)
+				assert.Equal(t, uint64(149), ids[149])
+			})
+		})
+	}
+}
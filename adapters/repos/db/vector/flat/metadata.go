@@ -21,6 +21,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/vmihailenco/msgpack/v5"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 	bolt "go.etcd.io/bbolt"
 )
 
@@ -205,7 +206,7 @@ func (index *flat) calculateDimensions() int32 {
 	i := 0
 	for key, v = cursor.First(); key != nil; key, v = cursor.Next() {
 		if len(v) > 0 {
-			return int32(len(v) / 4)
+			return int32(len(v) / vectorIndexCommon.BytesPerDimension(index.dataType))
 		}
 		if i > maxCursorSize {
 			break
@@ -19,6 +19,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
@@ -68,6 +69,7 @@ type Config struct {
 	SnapshotMinDeltaCommitlogsNumer          int
 	SnapshotMinDeltaCommitlogsSizePercentage int
 	AllocChecker                             memwatch.AllocChecker
+	FS                                       common.FS // optional, defaults to the os file system
 }
 
 func (c Config) hnswEF() int {
@@ -89,6 +91,7 @@ func NewIndex(config Config,
 		DisableSnapshots:      config.SnapshotDisabled,
 		SnapshotOnStartup:     config.SnapshotOnStartup,
 		AllocChecker:          config.AllocChecker,
+		FS:                    config.FS,
 	}, hnswent.UserConfig{
 		MaxConnections:         64,
 		EFConstruction:         128,
@@ -124,12 +127,16 @@ func makeCommitLoggerFromConfig(config Config, maintenanceCallbacks cyclemanager
 	makeCL := hnsw.MakeNoopCommitLogger
 	if !config.DisablePersistence {
 		makeCL = func() (hnsw.CommitLogger, error) {
-			return hnsw.NewCommitLogger(config.RootPath, config.ID, config.Logger, maintenanceCallbacks,
+			opts := []hnsw.CommitlogOption{
 				hnsw.WithSnapshotDisabled(config.SnapshotDisabled),
 				hnsw.WithSnapshotCreateInterval(config.SnapshotCreateInterval),
 				hnsw.WithSnapshotMinDeltaCommitlogsNumer(config.SnapshotMinDeltaCommitlogsNumer),
 				hnsw.WithSnapshotMinDeltaCommitlogsSizePercentage(config.SnapshotMinDeltaCommitlogsSizePercentage),
-			)
+			}
+			if config.FS != nil {
+				opts = append(opts, hnsw.WithFS(config.FS))
+			}
+			return hnsw.NewCommitLogger(config.RootPath, config.ID, config.Logger, maintenanceCallbacks, opts...)
 		}
 	}
 	return makeCL
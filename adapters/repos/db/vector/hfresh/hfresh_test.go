@@ -167,4 +167,14 @@ func TestHFreshRecall(t *testing.T) {
 	fmt.Println(index.searchProbe, recall, latency)
 
 	require.Greater(t, recall, float32(0.7))
+
+	// range searches keep searching past the initial limit of 100 results
+	_, truthDists := testinghelpers.BruteForce(logger, vectors, queries[0], 300, distanceWrapper(distancer.NewL2SquaredProvider()))
+	targetDistance := truthDists[len(truthDists)-1]
+	ids, dists, err := index.SearchByVectorDistance(t.Context(), queries[0], targetDistance, -1, nil)
+	require.NoError(t, err)
+	require.Greater(t, len(ids), 210)
+	for _, dist := range dists {
+		require.LessOrEqual(t, dist, targetDistance+1e-6)
+	}
 }
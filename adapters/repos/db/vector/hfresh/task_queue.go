@@ -71,6 +71,7 @@ func NewTaskQueue(index *HFresh) (*TaskQueue, error) {
 			Dir:              filepath.Join(index.config.RootPath, "split.queue.d"),
 			TaskDecoder:      &tq,
 			OnBatchProcessed: tq.OnBatchProcessed,
+			Encryption:       index.config.Encryption,
 			ChunkSize:        splitTaskQueueChunkSize,
 		},
 	)
@@ -91,6 +92,7 @@ func NewTaskQueue(index *HFresh) (*TaskQueue, error) {
 			Dir:              filepath.Join(index.config.RootPath, "reassign.queue.d"),
 			TaskDecoder:      &tq,
 			OnBatchProcessed: tq.OnBatchProcessed,
+			Encryption:       index.config.Encryption,
 			ChunkSize:        reassignTaskQueueChunkSize,
 		},
 	)
@@ -111,6 +113,7 @@ func NewTaskQueue(index *HFresh) (*TaskQueue, error) {
 			Dir:              filepath.Join(index.config.RootPath, "merge.queue.d"),
 			TaskDecoder:      &tq,
 			OnBatchProcessed: tq.OnBatchProcessed,
+			Encryption:       index.config.Encryption,
 			ChunkSize:        mergeTaskQueueChunkSize,
 		},
 	)
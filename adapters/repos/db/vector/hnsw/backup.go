@@ -49,7 +49,7 @@ func (h *hnsw) ListFiles(ctx context.Context, basePath string) ([]string, error)
 			return nil
 		}
 
-		st, statErr := os.Stat(pth)
+		st, statErr := h.fs.Stat(pth)
 		if statErr != nil {
 			return statErr
 		}
@@ -98,7 +98,7 @@ func (h *hnsw) ListFiles(ctx context.Context, basePath string) ([]string, error)
 
 func (h *hnsw) listSnapshotFiles(ctx context.Context, basePath string) ([]string, error) {
 	snapshotDir := snapshotDirectory(h.commitLog.RootPath(), h.commitLog.ID())
-	entries, err := os.ReadDir(snapshotDir)
+	entries, err := h.fs.ReadDir(snapshotDir)
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
 			// no snapshot directory, no files
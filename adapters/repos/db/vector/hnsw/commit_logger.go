@@ -110,6 +110,11 @@ func NewCommitLogger(rootPath, name string, logger logrus.FieldLogger,
 		}
 	}
 
+	if c, ok := l.condensor.(*MemoryCondensor); ok {
+		// the condensed logs are written by the same fs
+		c.fs = l.fs
+	}
+
 	fd, err := getLatestCommitFileOrCreate(rootPath, name, l.fs)
 	if err != nil {
 		return nil, err
@@ -157,15 +162,103 @@ func getLatestCommitFileOrCreate(rootPath, name string, fs common.FS) (common.Fi
 	if !ok {
 		// this is a new commit log, initialize with the current time stamp
 		fileName = fmt.Sprintf("%d", time.Now().Unix())
+
+		fd, err := fs.OpenFile(commitLogFileName(rootPath, name, fileName),
+			os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o666)
+		if err != nil {
+			return nil, errors.Wrap(err, "create commit log file")
+		}
+		if err := fd.Close(); err != nil {
+			return nil, errors.Wrap(err, "create commit log file")
+		}
 	}
 
-	fd, err := fs.OpenFile(commitLogFileName(rootPath, name, fileName),
-		os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o666)
+	return &lazyCommitLogFile{fs: fs, name: commitLogFileName(rootPath, name, fileName)}, nil
+}
+
+// lazyCommitLogFile opens the current commit log on its first use. The
+// commit logger is created before the index is restored, and restoring
+// truncates a corrupt tail of the current commit log. Encrypted commit logs
+// are not truncated in place, but replaced by a copy with a new key, see
+// common.FS, which a file opened before the restore would not see.
+type lazyCommitLogFile struct {
+	fs   common.FS
+	name string
+
+	once sync.Once
+	file common.File
+	err  error
+}
+
+func (f *lazyCommitLogFile) open() (common.File, error) {
+	f.once.Do(func() {
+		f.file, f.err = f.fs.OpenFile(f.name, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o666)
+		if f.err != nil {
+			f.err = errors.Wrap(f.err, "open commit log file")
+		}
+	})
+	return f.file, f.err
+}
+
+func (f *lazyCommitLogFile) Read(p []byte) (int, error) {
+	file, err := f.open()
+	if err != nil {
+		return 0, err
+	}
+	return file.Read(p)
+}
+
+func (f *lazyCommitLogFile) ReadAt(p []byte, off int64) (int, error) {
+	file, err := f.open()
+	if err != nil {
+		return 0, err
+	}
+	return file.ReadAt(p, off)
+}
+
+func (f *lazyCommitLogFile) Write(p []byte) (int, error) {
+	file, err := f.open()
+	if err != nil {
+		return 0, err
+	}
+	return file.Write(p)
+}
+
+func (f *lazyCommitLogFile) Seek(offset int64, whence int) (int64, error) {
+	file, err := f.open()
+	if err != nil {
+		return 0, err
+	}
+	return file.Seek(offset, whence)
+}
+
+func (f *lazyCommitLogFile) Sync() error {
+	file, err := f.open()
+	if err != nil {
+		return err
+	}
+	return file.Sync()
+}
+
+func (f *lazyCommitLogFile) Stat() (os.FileInfo, error) {
+	file, err := f.open()
 	if err != nil {
-		return nil, errors.Wrap(err, "create commit log file")
+		return nil, err
 	}
+	return file.Stat()
+}
 
-	return fd, nil
+func (f *lazyCommitLogFile) Close() error {
+	closed := false
+	f.once.Do(func() {
+		// never opened, there is nothing to close
+		f.err = os.ErrClosed
+		closed = true
+	})
+	if closed || f.file == nil {
+		return nil
+	}
+	return f.file.Close()
 }
 
 // getCommitFileNames in order, from old to new
@@ -721,6 +814,75 @@ func (l *hnswCommitLogger) combineLogs() (bool, error) {
 	return NewCommitLogCombiner(l.rootPath, l.id, threshold, l.logger, l.fs).Do(l.snapshotPartitions...)
 }
 
+// MaintenanceCtrl controls the cycle which combines and condenses the commit
+// logs and creates snapshots. Switching to a new commit log is not affected.
+func (l *hnswCommitLogger) MaintenanceCtrl() cyclemanager.CycleCallbackCtrl {
+	if l.maintainLogsCallbackCtrl == nil {
+		// maintenance was not initialized
+		return cyclemanager.NewCallbackCtrlNoop()
+	}
+	return l.maintainLogsCallbackCtrl
+}
+
+// CondenseLogs switches to a new commit log, then combines and condenses all
+// previous ones right away rather than waiting for the maintenance cycle. It
+// must not run concurrently with the cycle. progress receives the share of
+// the logs which were condensed.
+func (l *hnswCommitLogger) CondenseLogs(ctx context.Context, progress func(float64)) error {
+	if err := l.SwitchCommitLogs(true); err != nil {
+		return errors.Wrap(err, "switch commit logs")
+	}
+	if _, err := l.combineLogs(); err != nil {
+		return errors.Wrap(err, "combine commit logs")
+	}
+
+	files, err := getCommitFileNames(l.rootPath, l.id, 0, l.fs)
+	if err != nil {
+		return err
+	}
+	var candidates int
+	for _, file := range files[:max(len(files)-1, 0)] {
+		if !strings.HasSuffix(file, ".condensed") {
+			candidates++
+		}
+	}
+
+	// every run condenses the oldest log which is not condensed yet
+	for condensed := 0; condensed < candidates; condensed++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		progress(float64(condensed) / float64(candidates))
+		executed, err := l.condenseLogs()
+		if err != nil {
+			return errors.Wrap(err, "condense commit logs")
+		}
+		if !executed {
+			break
+		}
+	}
+
+	// condensed logs are a lot smaller and can likely be combined further
+	if _, err := l.combineLogs(); err != nil {
+		return errors.Wrap(err, "combine commit logs")
+	}
+	progress(1)
+	return nil
+}
+
+// ForceSnapshot switches to a new commit log and creates a snapshot of all
+// previous ones right away, regardless of the snapshot interval
+func (l *hnswCommitLogger) ForceSnapshot() (bool, error) {
+	if l.snapshotDisabled {
+		return false, errors.New("snapshots are disabled")
+	}
+	if err := l.SwitchCommitLogs(true); err != nil {
+		return false, errors.Wrap(err, "switch commit logs")
+	}
+	created, _, err := l.CreateSnapshot()
+	return created, err
+}
+
 // TODO al:snapshot handle should abort
 func (l *hnswCommitLogger) createSnapshot(shouldAbort cyclemanager.ShouldAbortCallback) (bool, error) {
 	if l.snapshotDisabled || l.snapshotCreateInterval <= 0 {
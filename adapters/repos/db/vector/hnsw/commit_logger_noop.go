@@ -16,6 +16,7 @@ import (
 
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/multivector"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
 )
 
 // NoopCommitLogger implements the CommitLogger interface, but does not
@@ -135,3 +136,15 @@ func (n *NoopCommitLogger) SwitchCommitLogs(force bool) error {
 }
 
 func (n *NoopCommitLogger) InitMaintenance() {}
+
+func (n *NoopCommitLogger) MaintenanceCtrl() cyclemanager.CycleCallbackCtrl {
+	return cyclemanager.NewCallbackCtrlNoop()
+}
+
+func (n *NoopCommitLogger) CondenseLogs(ctx context.Context, progress func(float64)) error {
+	return nil
+}
+
+func (n *NoopCommitLogger) ForceSnapshot() (bool, error) {
+	return false, nil
+}
@@ -46,6 +46,10 @@ type Config struct {
 	SnapshotOnStartup         bool
 	MakeBucketOptions         lsmkv.MakeBucketOptions
 
+	// FS is used to read the commit logs and snapshots, defaults to the os
+	// file system. It has to match the FS of the commit logger.
+	FS common.FS
+
 	// metadata for monitoring
 	ShardName string
 	ClassName string
@@ -19,6 +19,7 @@ import (
 
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/schema/config"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
@@ -70,6 +71,10 @@ func ValidateUserConfigUpdate(initial, updated config.VectorIndexConfig) error {
 			name:     "trackDefaultQuantization",
 			accessor: func(c ent.UserConfig) interface{} { return c.TrackDefaultQuantization },
 		},
+		{
+			name:     "dataType",
+			accessor: func(c ent.UserConfig) interface{} { return vectorIndexCommon.DataTypeOrDefault(c.DataType) },
+		},
 	}
 
 	for _, u := range immutableFields {
@@ -78,6 +83,14 @@ func ValidateUserConfigUpdate(initial, updated config.VectorIndexConfig) error {
 		}
 	}
 
+	// compression can be enabled on an existing index, but switching to
+	// another quantizer requires the vectors to be encoded again
+	initialCompression, updatedCompression := initialParsed.Compression(), updatedParsed.Compression()
+	if initialCompression != "" && updatedCompression != "" && initialCompression != updatedCompression {
+		return errors.Errorf("compression is immutable: attempted change from \"%v\" to \"%v\", "+
+			"reindex the vector index to switch the quantizer", initialCompression, updatedCompression)
+	}
+
 	return nil
 }
 
@@ -114,7 +127,9 @@ func (h *hnsw) UpdateUserConfig(updated config.VectorIndexConfig, callback func(
 	atomic.StoreInt64(&h.efFactor, int64(parsed.DynamicEFFactor))
 	atomic.StoreInt64(&h.flatSearchCutoff, int64(parsed.FlatSearchCutoff))
 
-	h.acornSearch.Store(parsed.FilterStrategy == ent.FilterStrategyAcorn)
+	h.acornSearch.Store(parsed.FilterStrategy == ent.FilterStrategyAcorn || parsed.FilterStrategy == ent.FilterStrategyAdaptive)
+	h.adaptiveFilterSearch.Store(parsed.FilterStrategy == ent.FilterStrategyAdaptive)
+	h.multivectorConfig.Store(&parsed.Multivector)
 
 	if !parsed.PQ.Enabled && !parsed.BQ.Enabled && !parsed.SQ.Enabled && !parsed.RQ.Enabled {
 		callback()
@@ -152,6 +152,39 @@ func TestUserConfigUpdates(t *testing.T) {
 				},
 				expectedError: nil,
 			},
+			{
+				name: "attempting to switch from pq to rq compression",
+				initial: ent.UserConfig{
+					PQ: ent.PQConfig{
+						Enabled: true,
+					},
+				},
+				update: ent.UserConfig{
+					RQ: ent.RQConfig{
+						Enabled: true,
+						Bits:    8,
+					},
+				},
+				expectedError: errors.Errorf("compression is immutable: attempted change " +
+					"from \"pq\" to \"rq-8\", reindex the vector index to switch the quantizer"),
+			},
+			{
+				name: "attempting to change rq bits",
+				initial: ent.UserConfig{
+					RQ: ent.RQConfig{
+						Enabled: true,
+						Bits:    8,
+					},
+				},
+				update: ent.UserConfig{
+					RQ: ent.RQConfig{
+						Enabled: true,
+						Bits:    1,
+					},
+				},
+				expectedError: errors.Errorf("compression is immutable: attempted change " +
+					"from \"rq-8\" to \"rq-1\", reindex the vector index to switch the quantizer"),
+			},
 		}
 
 		for _, test := range tests {
@@ -221,7 +221,9 @@ func (h *hnsw) getEntrypoint() uint64 {
 	return h.entryPointID
 }
 
-func (h *hnsw) copyTombstonesToAllowList(breakCleanUpTombstonedNodes breakCleanUpTombstonedNodesFunc) (ok bool, deleteList helpers.AllowList) {
+func (h *hnsw) copyTombstonesToAllowList(breakCleanUpTombstonedNodes breakCleanUpTombstonedNodesFunc,
+	minTombstones int64,
+) (ok bool, deleteList helpers.AllowList) {
 	h.resetLock.Lock()
 	defer h.resetLock.Unlock()
 
@@ -247,13 +249,13 @@ func (h *hnsw) copyTombstonesToAllowList(breakCleanUpTombstonedNodes breakCleanU
 		return false, nil
 	}
 
-	if numberOfTombstones < minTombstonesPerCycle() {
+	if numberOfTombstones < minTombstones {
 		h.logger.WithFields(logrus.Fields{
 			"action":           "tombstone_cleanup_skipped",
 			"class":            h.className,
 			"shard":            h.shardName,
 			"tombstones_total": numberOfTombstones,
-			"tombstones_min":   minTombstonesPerCycle(),
+			"tombstones_min":   minTombstones,
 			"tombstones_max":   maxTombstonesPerCycle(),
 		}).Debugf("class %s: shard %s: skipping tombstone cleanup, not enough tombstones", h.className, h.shardName)
 		return false, nil
@@ -290,11 +292,15 @@ func (h *hnsw) copyTombstonesToAllowList(breakCleanUpTombstonedNodes breakCleanU
 // CleanUpTombstonedNodes removes nodes with a tombstone and reassigns
 // edges that were previously pointing to the tombstoned nodes
 func (h *hnsw) CleanUpTombstonedNodes(shouldAbort cyclemanager.ShouldAbortCallback) error {
-	_, err := h.cleanUpTombstonedNodes(shouldAbort)
+	_, err := h.cleanUpTombstonedNodes(shouldAbort, minTombstonesPerCycle())
 	return err
 }
 
-func (h *hnsw) cleanUpTombstonedNodes(shouldAbort cyclemanager.ShouldAbortCallback) (bool, error) {
+// cleanUpTombstonedNodes runs a single cleanup cycle, which is skipped if
+// there are less than minTombstones tombstones
+func (h *hnsw) cleanUpTombstonedNodes(shouldAbort cyclemanager.ShouldAbortCallback,
+	minTombstones int64,
+) (bool, error) {
 	if !h.tombstoneCleanupRunning.CompareAndSwap(false, true) {
 		return false, errors.New("tombstone cleanup already running")
 	}
@@ -322,7 +328,7 @@ func (h *hnsw) cleanUpTombstonedNodes(shouldAbort cyclemanager.ShouldAbortCallba
 	}
 
 	executed := false
-	ok, deleteList := h.copyTombstonesToAllowList(breakCleanUpTombstonedNodes)
+	ok, deleteList := h.copyTombstonesToAllowList(breakCleanUpTombstonedNodes, minTombstones)
 	if !ok {
 		return executed, nil
 	}
@@ -515,7 +521,9 @@ LOOP:
 		case ch <- uint64(i):
 			if i%1000 == 0 {
 				// updating the metric has virtually no cost, so we can do it every 1k
-				h.metrics.TombstoneCycleProgress(float64(i) / float64(size))
+				progress := float64(i) / float64(size)
+				h.metrics.TombstoneCycleProgress(progress)
+				h.tombstoneCleanupProgress.Store(math.Float64bits(progress))
 			}
 			if i%1_000_000 == 0 {
 				// the interval of 1M is rather arbitrary, but if we have less than 1M
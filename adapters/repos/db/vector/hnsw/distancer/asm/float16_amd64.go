@@ -0,0 +1,24 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package asm
+
+// DotFloat16AVX256 returns the dot product of two vectors of float16 values
+// stored as little endian bytes, see float16_amd64.s
+//
+//go:noescape
+func DotFloat16AVX256(x []byte, y []byte) float32
+
+// L2Float16AVX256 returns the squared euclidean distance of two vectors of
+// float16 values stored as little endian bytes, see float16_amd64.s
+//
+//go:noescape
+func L2Float16AVX256(x []byte, y []byte) float32
@@ -0,0 +1,124 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+
+#include "textflag.h"
+
+// The float16 kernels convert 8 values at a time to float32 lanes with
+// VCVTPH2PS and accumulate their products, or the squares of their
+// differences, with VFMADD231PS into two accumulators. The remaining values
+// are converted and accumulated one at a time. The length of x is in bytes,
+// two per value.
+
+// func DotFloat16AVX256(x []byte, y []byte) float32
+// Requires: AVX, AVX2, F16C, FMA3
+TEXT ·DotFloat16AVX256(SB), NOSPLIT, $0-52
+	MOVQ   x_base+0(FP), AX
+	MOVQ   y_base+24(FP), CX
+	MOVQ   x_len+8(FP), DX
+	SHRQ   $0x01, DX
+	VXORPS Y0, Y0, Y0
+	VXORPS Y1, Y1, Y1
+	VXORPS X4, X4, X4
+
+dotblockloop:
+	CMPQ        DX, $0x00000010
+	JL          dottailloop
+	VCVTPH2PS   (AX), Y2
+	VCVTPH2PS   (CX), Y3
+	VFMADD231PS Y3, Y2, Y0
+	VCVTPH2PS   16(AX), Y5
+	VCVTPH2PS   16(CX), Y6
+	VFMADD231PS Y6, Y5, Y1
+	ADDQ        $0x00000020, AX
+	ADDQ        $0x00000020, CX
+	SUBQ        $0x00000010, DX
+	JMP         dotblockloop
+
+dottailloop:
+	CMPQ        DX, $0x00000000
+	JE          dotreduce
+	MOVWLZX     (AX), R8
+	MOVWLZX     (CX), R9
+	VMOVD       R8, X2
+	VMOVD       R9, X3
+	VCVTPH2PS   X2, X2
+	VCVTPH2PS   X3, X3
+	VFMADD231SS X3, X2, X4
+	ADDQ        $0x00000002, AX
+	ADDQ        $0x00000002, CX
+	DECQ        DX
+	JMP         dottailloop
+
+dotreduce:
+	VADDPS       Y1, Y0, Y0
+	VEXTRACTF128 $0x01, Y0, X1
+	VADDPS       X1, X0, X0
+	VHADDPS      X0, X0, X0
+	VHADDPS      X0, X0, X0
+	VADDSS       X4, X0, X0
+	VMOVSS       X0, ret+48(FP)
+	VZEROUPPER
+	RET
+
+// func L2Float16AVX256(x []byte, y []byte) float32
+// Requires: AVX, AVX2, F16C, FMA3
+TEXT ·L2Float16AVX256(SB), NOSPLIT, $0-52
+	MOVQ   x_base+0(FP), AX
+	MOVQ   y_base+24(FP), CX
+	MOVQ   x_len+8(FP), DX
+	SHRQ   $0x01, DX
+	VXORPS Y0, Y0, Y0
+	VXORPS Y1, Y1, Y1
+	VXORPS X4, X4, X4
+
+l2blockloop:
+	CMPQ        DX, $0x00000010
+	JL          l2tailloop
+	VCVTPH2PS   (AX), Y2
+	VCVTPH2PS   (CX), Y3
+	VSUBPS      Y3, Y2, Y2
+	VFMADD231PS Y2, Y2, Y0
+	VCVTPH2PS   16(AX), Y5
+	VCVTPH2PS   16(CX), Y6
+	VSUBPS      Y6, Y5, Y5
+	VFMADD231PS Y5, Y5, Y1
+	ADDQ        $0x00000020, AX
+	ADDQ        $0x00000020, CX
+	SUBQ        $0x00000010, DX
+	JMP         l2blockloop
+
+l2tailloop:
+	CMPQ        DX, $0x00000000
+	JE          l2reduce
+	MOVWLZX     (AX), R8
+	MOVWLZX     (CX), R9
+	VMOVD       R8, X2
+	VMOVD       R9, X3
+	VCVTPH2PS   X2, X2
+	VCVTPH2PS   X3, X3
+	VSUBSS      X3, X2, X2
+	VFMADD231SS X2, X2, X4
+	ADDQ        $0x00000002, AX
+	ADDQ        $0x00000002, CX
+	DECQ        DX
+	JMP         l2tailloop
+
+l2reduce:
+	VADDPS       Y1, Y0, Y0
+	VEXTRACTF128 $0x01, Y0, X1
+	VADDPS       X1, X0, X0
+	VHADDPS      X0, X0, X0
+	VHADDPS      X0, X0, X0
+	VADDSS       X4, X0, X0
+	VMOVSS       X0, ret+48(FP)
+	VZEROUPPER
+	RET
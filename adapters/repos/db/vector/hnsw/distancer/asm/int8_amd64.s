@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+
+#include "textflag.h"
+
+// The int8 kernels sign extend 16 bytes at a time to 16-bit lanes, so that
+// VPMADDWD multiplies and adds pairs of them into 32-bit lanes. Products of
+// int8 values, and squares of their differences, fit into 16 bits and sums of
+// two of them into 32 bits. The remaining bytes are handled one at a time.
+
+// func DotInt8AVX256(x []byte, y []byte) int32
+// Requires: AVX, AVX2
+TEXT ·DotInt8AVX256(SB), NOSPLIT, $0-52
+	MOVQ  x_base+0(FP), AX
+	MOVQ  y_base+24(FP), CX
+	MOVQ  x_len+8(FP), DX
+	VPXOR Y0, Y0, Y0
+	VPXOR Y1, Y1, Y1
+	XORQ  BX, BX
+
+dotblockloop:
+	CMPQ      DX, $0x00000020
+	JL        dottailloop
+	VPMOVSXBW (AX), Y2
+	VPMOVSXBW (CX), Y3
+	VPMADDWD  Y3, Y2, Y2
+	VPADDD    Y2, Y0, Y0
+	VPMOVSXBW 16(AX), Y4
+	VPMOVSXBW 16(CX), Y5
+	VPMADDWD  Y5, Y4, Y4
+	VPADDD    Y4, Y1, Y1
+	ADDQ      $0x00000020, AX
+	ADDQ      $0x00000020, CX
+	SUBQ      $0x00000020, DX
+	JMP       dotblockloop
+
+dottailloop:
+	CMPQ    DX, $0x00000000
+	JE      dotreduce
+	MOVBQSX (AX), R8
+	MOVBQSX (CX), R9
+	IMULQ   R9, R8
+	ADDQ    R8, BX
+	INCQ    AX
+	INCQ    CX
+	DECQ    DX
+	JMP     dottailloop
+
+dotreduce:
+	VPADDD       Y1, Y0, Y0
+	VEXTRACTI128 $0x01, Y0, X1
+	VPADDD       X1, X0, X0
+	VPSHUFD      $0x4e, X0, X1
+	VPADDD       X1, X0, X0
+	VPSHUFD      $0xb1, X0, X1
+	VPADDD       X1, X0, X0
+	VMOVD        X0, R8
+	ADDL         R8, BX
+	MOVL         BX, ret+48(FP)
+	VZEROUPPER
+	RET
+
+// func L2Int8AVX256(x []byte, y []byte) uint32
+// Requires: AVX, AVX2
+TEXT ·L2Int8AVX256(SB), NOSPLIT, $0-52
+	MOVQ  x_base+0(FP), AX
+	MOVQ  y_base+24(FP), CX
+	MOVQ  x_len+8(FP), DX
+	VPXOR Y0, Y0, Y0
+	VPXOR Y1, Y1, Y1
+	XORQ  BX, BX
+
+l2blockloop:
+	CMPQ      DX, $0x00000020
+	JL        l2tailloop
+	VPMOVSXBW (AX), Y2
+	VPMOVSXBW (CX), Y3
+	VPSUBW    Y3, Y2, Y2
+	VPMADDWD  Y2, Y2, Y2
+	VPADDD    Y2, Y0, Y0
+	VPMOVSXBW 16(AX), Y4
+	VPMOVSXBW 16(CX), Y5
+	VPSUBW    Y5, Y4, Y4
+	VPMADDWD  Y4, Y4, Y4
+	VPADDD    Y4, Y1, Y1
+	ADDQ      $0x00000020, AX
+	ADDQ      $0x00000020, CX
+	SUBQ      $0x00000020, DX
+	JMP       l2blockloop
+
+l2tailloop:
+	CMPQ    DX, $0x00000000
+	JE      l2reduce
+	MOVBQSX (AX), R8
+	MOVBQSX (CX), R9
+	SUBQ    R9, R8
+	IMULQ   R8, R8
+	ADDQ    R8, BX
+	INCQ    AX
+	INCQ    CX
+	DECQ    DX
+	JMP     l2tailloop
+
+l2reduce:
+	VPADDD       Y1, Y0, Y0
+	VEXTRACTI128 $0x01, Y0, X1
+	VPADDD       X1, X0, X0
+	VPSHUFD      $0x4e, X0, X1
+	VPADDD       X1, X0, X0
+	VPSHUFD      $0xb1, X0, X1
+	VPADDD       X1, X0, X0
+	VMOVD        X0, R8
+	ADDL         R8, BX
+	MOVL         BX, ret+48(FP)
+	VZEROUPPER
+	RET
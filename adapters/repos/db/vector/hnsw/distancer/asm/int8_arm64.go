@@ -0,0 +1,24 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package asm
+
+// DotInt8NEON returns the dot product of two vectors of int8 values stored
+// as bytes, see int8_arm64.s
+//
+//go:noescape
+func DotInt8NEON(x []byte, y []byte) int32
+
+// L2Int8NEON returns the squared euclidean distance of two vectors of int8
+// values stored as bytes, see int8_arm64.s
+//
+//go:noescape
+func L2Int8NEON(x []byte, y []byte) uint32
@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+
+#include "textflag.h"
+
+// The int8 kernels load 16 bytes at a time. The dot product multiplies them
+// into 16-bit lanes with SMULL and SMULL2, which SADALP adds pairwise into
+// 32-bit lanes. The squared euclidean distance subtracts them into 16-bit lanes
+// with SSUBL and SSUBL2, which SMLAL and SMLAL2 square into 32-bit lanes. The
+// remaining bytes are handled one at a time. SADALP and SSUBL are not known to
+// the assembler, so they are encoded as WORDs.
+
+// func DotInt8NEON(x []byte, y []byte) int32
+TEXT ·DotInt8NEON(SB), NOSPLIT, $0-52
+	MOVD x_base+0(FP), R0
+	MOVD y_base+24(FP), R1
+	MOVD x_len+8(FP), R2
+	VEOR V0.B16, V0.B16, V0.B16
+	VEOR V1.B16, V1.B16, V1.B16
+	MOVD $0, R6
+
+dotblockloop:
+	CMP     $16, R2
+	BLT     dottailloop
+	VLD1.P  16(R0), [V2.B16]
+	VLD1.P  16(R1), [V3.B16]
+	VSMULL  V3.B8, V2.B8, V4.H8
+	VSMULL2 V3.B16, V2.B16, V5.H8
+	WORD    $0x4e606880 // sadalp v0.4s, v4.8h
+	WORD    $0x4e6068a1 // sadalp v1.4s, v5.8h
+	SUB     $16, R2
+	B       dotblockloop
+
+dottailloop:
+	CBZ    R2, dotreduce
+	MOVB.P 1(R0), R3
+	MOVB.P 1(R1), R4
+	MADD   R3, R6, R4, R6
+	SUB    $1, R2
+	B      dottailloop
+
+dotreduce:
+	VADD  V1.S4, V0.S4, V0.S4
+	VADDV V0.S4, V0
+	VMOV  V0.S[0], R7
+	ADDW  R7, R6
+	MOVW  R6, ret+48(FP)
+	RET
+
+// func L2Int8NEON(x []byte, y []byte) uint32
+TEXT ·L2Int8NEON(SB), NOSPLIT, $0-52
+	MOVD x_base+0(FP), R0
+	MOVD y_base+24(FP), R1
+	MOVD x_len+8(FP), R2
+	VEOR V0.B16, V0.B16, V0.B16
+	VEOR V1.B16, V1.B16, V1.B16
+	MOVD $0, R6
+
+l2blockloop:
+	CMP     $16, R2
+	BLT     l2tailloop
+	VLD1.P  16(R0), [V2.B16]
+	VLD1.P  16(R1), [V3.B16]
+	WORD    $0x0e232044 // ssubl v4.8h, v2.8b, v3.8b
+	WORD    $0x4e232045 // ssubl2 v5.8h, v2.16b, v3.16b
+	VSMLAL  V4.H4, V4.H4, V0.S4
+	VSMLAL2 V4.H8, V4.H8, V1.S4
+	VSMLAL  V5.H4, V5.H4, V0.S4
+	VSMLAL2 V5.H8, V5.H8, V1.S4
+	SUB     $16, R2
+	B       l2blockloop
+
+l2tailloop:
+	CBZ    R2, l2reduce
+	MOVB.P 1(R0), R3
+	MOVB.P 1(R1), R4
+	SUB    R4, R3, R5
+	MADD   R5, R6, R5, R6
+	SUB    $1, R2
+	B      l2tailloop
+
+l2reduce:
+	VADD  V1.S4, V0.S4, V0.S4
+	VADDV V0.S4, V0
+	VMOV  V0.S[0], R7
+	ADDW  R7, R6
+	MOVW  R6, ret+48(FP)
+	RET
@@ -0,0 +1,173 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"encoding/binary"
+	"math"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+// can be set depending on architecture, the defaults always work. An init
+// function overwrites them on amd64 and arm64 with assembly implementations.
+var (
+	dotProductUint8Impl func(a, b []uint8) uint32 = DotProductByteGo
+	l2SquaredUint8Impl  func(a, b []uint8) uint32 = func(a, b []uint8) uint32 {
+		var sum uint32
+		for i := range a {
+			diff := int32(a[i]) - int32(b[i])
+			sum += uint32(diff * diff)
+		}
+		return sum
+	}
+	dotProductInt8Impl func(a, b []byte) int32  = dotProductInt8
+	l2SquaredInt8Impl  func(a, b []byte) uint32 = l2SquaredInt8
+
+	dotProductFloat16Impl func(a, b []byte) float32 = dotProductFloat16
+	l2SquaredFloat16Impl  func(a, b []byte) float32 = l2SquaredFloat16
+)
+
+func dotProductInt8(a, b []byte) int32 {
+	var sum int32
+	for i := range a {
+		sum += int32(int8(a[i])) * int32(int8(b[i]))
+	}
+	return sum
+}
+
+func l2SquaredInt8(a, b []byte) uint32 {
+	var sum uint32
+	for i := range a {
+		diff := int32(int8(a[i])) - int32(int8(b[i]))
+		sum += uint32(diff * diff)
+	}
+	return sum
+}
+
+// float16Values holds the float32 value of every float16, so that the Go
+// float16 kernels convert without branching. It is only built once a float16
+// vector is compared without an assembly kernel.
+var float16Values = sync.OnceValue(func() *[1 << 16]float32 {
+	var values [1 << 16]float32
+	for i := range values {
+		values[i] = common.Float32FromFloat16(uint16(i))
+	}
+	return &values
+})
+
+func dotProductFloat16(a, b []byte) float32 {
+	values := float16Values()
+	var sum float32
+	for i := 0; i+1 < len(a); i += 2 {
+		sum += values[binary.LittleEndian.Uint16(a[i:])] * values[binary.LittleEndian.Uint16(b[i:])]
+	}
+	return sum
+}
+
+func l2SquaredFloat16(a, b []byte) float32 {
+	values := float16Values()
+	var sum float32
+	for i := 0; i+1 < len(a); i += 2 {
+		diff := values[binary.LittleEndian.Uint16(a[i:])] - values[binary.LittleEndian.Uint16(b[i:])]
+		sum += diff * diff
+	}
+	return sum
+}
+
+// decodeBuffers holds the float32 buffers float16 vectors are decoded into
+// for the distances without a float16 kernel
+var decodeBuffers = sync.Pool{New: func() any { return new([]float32) }}
+
+// EncodedProvider calculates distances between vectors encoded in one of the
+// data types of the vector index config, see common.EncodeVector. The dot
+// product, l2-squared and cosine distances are calculated without decoding
+// the vectors. Other distances of float16 vectors decode them into pooled
+// buffers and use the float32 implementation of the wrapped provider.
+// Encoded vectors are not normalized, the cosine distance normalizes them or
+// is calculated from the dot products of the vectors.
+type EncodedProvider struct {
+	provider Provider
+	dataType string
+}
+
+func NewEncodedProvider(provider Provider, dataType string) EncodedProvider {
+	return EncodedProvider{provider: provider, dataType: common.DataTypeOrDefault(dataType)}
+}
+
+func (p EncodedProvider) DataType() string {
+	return p.dataType
+}
+
+func (p EncodedProvider) Type() string {
+	return p.provider.Type()
+}
+
+func (p EncodedProvider) SingleDist(a, b []byte) (float32, error) {
+	if len(a) != len(b) {
+		return 0, errors.Wrapf(ErrVectorLength, "%d vs %d",
+			len(a), len(b))
+	}
+
+	switch p.dataType {
+	case common.DataTypeUint8:
+		switch p.provider.Type() {
+		case "dot":
+			return -float32(dotProductUint8Impl(a, b)), nil
+		case "l2-squared":
+			return float32(l2SquaredUint8Impl(a, b)), nil
+		case "cosine-dot":
+			return cosineFromDots(float32(dotProductUint8Impl(a, b)),
+				float32(dotProductUint8Impl(a, a)), float32(dotProductUint8Impl(b, b))), nil
+		}
+	case common.DataTypeInt8:
+		switch p.provider.Type() {
+		case "dot":
+			return -float32(dotProductInt8Impl(a, b)), nil
+		case "l2-squared":
+			return float32(l2SquaredInt8Impl(a, b)), nil
+		case "cosine-dot":
+			return cosineFromDots(float32(dotProductInt8Impl(a, b)),
+				float32(dotProductInt8Impl(a, a)), float32(dotProductInt8Impl(b, b))), nil
+		}
+	case common.DataTypeFloat16:
+		switch p.provider.Type() {
+		case "dot":
+			return -dotProductFloat16Impl(a, b), nil
+		case "l2-squared":
+			return l2SquaredFloat16Impl(a, b), nil
+		case "cosine-dot":
+			return cosineFromDots(dotProductFloat16Impl(a, b),
+				dotProductFloat16Impl(a, a), dotProductFloat16Impl(b, b)), nil
+		}
+	}
+
+	bufA := decodeBuffers.Get().(*[]float32)
+	bufB := decodeBuffers.Get().(*[]float32)
+	defer decodeBuffers.Put(bufA)
+	defer decodeBuffers.Put(bufB)
+	*bufA = common.DecodeVector(p.dataType, a, *bufA)
+	*bufB = common.DecodeVector(p.dataType, b, *bufB)
+	if p.provider.Type() == "cosine-dot" {
+		*bufA, *bufB = Normalize(*bufA), Normalize(*bufB)
+	}
+	return p.provider.SingleDist(*bufA, *bufB)
+}
+
+func cosineFromDots(ab, aa, bb float32) float32 {
+	if aa == 0 || bb == 0 {
+		return 1
+	}
+	return 1 - ab/float32(math.Sqrt(float64(aa))*math.Sqrt(float64(bb)))
+}
@@ -0,0 +1,32 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer/asm"
+	"golang.org/x/sys/cpu"
+)
+
+func init() {
+	if cpu.X86.HasAVX2 {
+		dotProductUint8Impl = asm.DotByteAVX256
+		dotProductInt8Impl = asm.DotInt8AVX256
+		l2SquaredInt8Impl = asm.L2Int8AVX256
+		l2SquaredUint8Impl = asm.L2ByteAVX256
+	}
+	// every cpu with AVX2 and FMA also supports the F16C conversions, which
+	// x/sys/cpu does not report
+	if cpu.X86.HasAVX2 && cpu.X86.HasFMA {
+		dotProductFloat16Impl = asm.DotFloat16AVX256
+		l2SquaredFloat16Impl = asm.L2Float16AVX256
+	}
+}
@@ -0,0 +1,166 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+func TestEncodedProvider(t *testing.T) {
+	r := getRandomSeed()
+	providers := []Provider{
+		NewDotProductProvider(),
+		NewL2SquaredProvider(),
+		NewCosineDistanceProvider(),
+		NewManhattanProvider(),
+	}
+	dataTypes := map[string]func() float32{
+		common.DataTypeFloat32: func() float32 { return r.Float32()*2 - 1 },
+		common.DataTypeFloat16: func() float32 { return float32(r.Intn(2048)-1024) / 64 },
+		common.DataTypeInt8:    func() float32 { return float32(r.Intn(256) - 128) },
+		common.DataTypeUint8:   func() float32 { return float32(r.Intn(256)) },
+	}
+
+	for dataType, random := range dataTypes {
+		for _, provider := range providers {
+			for _, dims := range []int{1, 7, 32, 67, 300} {
+				t.Run(fmt.Sprintf("%s %s %d", dataType, provider.Type(), dims), func(t *testing.T) {
+					a := make([]float32, dims)
+					b := make([]float32, dims)
+					for i := range a {
+						a[i], b[i] = random(), random()
+					}
+					encodedA, err := common.EncodeVector(dataType, a)
+					require.Nil(t, err)
+					encodedB, err := common.EncodeVector(dataType, b)
+					require.Nil(t, err)
+
+					if provider.Type() == "cosine-dot" {
+						a, b = Normalize(a), Normalize(b)
+					}
+					expected, err := provider.SingleDist(a, b)
+					require.Nil(t, err)
+
+					dist, err := NewEncodedProvider(provider, dataType).SingleDist(encodedA, encodedB)
+					require.Nil(t, err)
+					assert.InDelta(t, expected, dist, 0.0001*float64(max(1, abs(expected))))
+				})
+			}
+		}
+	}
+
+	t.Run("different lengths", func(t *testing.T) {
+		_, err := NewEncodedProvider(NewL2SquaredProvider(), common.DataTypeInt8).
+			SingleDist([]byte{1, 2}, []byte{1})
+		assert.ErrorIs(t, err, ErrVectorLength)
+	})
+
+	t.Run("empty data type is float32", func(t *testing.T) {
+		assert.Equal(t, common.DataTypeFloat32,
+			NewEncodedProvider(NewL2SquaredProvider(), "").DataType())
+	})
+}
+
+func TestInt8Impl(t *testing.T) {
+	r := getRandomSeed()
+	for _, dims := range []int{0, 1, 15, 16, 17, 31, 32, 33, 63, 64, 65, 300, 1536} {
+		t.Run(fmt.Sprintf("%d dims", dims), func(t *testing.T) {
+			a := make([]byte, dims)
+			b := make([]byte, dims)
+			r.Read(a)
+			r.Read(b)
+			assert.Equal(t, dotProductInt8(a, b), dotProductInt8Impl(a, b))
+			assert.Equal(t, l2SquaredInt8(a, b), l2SquaredInt8Impl(a, b))
+
+			// the extremes of int8 do not overflow the intermediate lanes
+			for i := range a {
+				a[i], b[i] = 0x80, 0x80
+			}
+			assert.Equal(t, int32(dims*128*128), dotProductInt8Impl(a, b))
+			for i := range b {
+				b[i] = 0x7f
+			}
+			assert.Equal(t, int32(-dims*128*127), dotProductInt8Impl(a, b))
+			assert.Equal(t, uint32(dims*255*255), l2SquaredInt8Impl(a, b))
+		})
+	}
+}
+
+func TestFloat16Impl(t *testing.T) {
+	r := getRandomSeed()
+	for _, dims := range []int{0, 1, 7, 8, 15, 16, 17, 31, 32, 33, 300, 1536} {
+		t.Run(fmt.Sprintf("%d dims", dims), func(t *testing.T) {
+			a := make([]float32, dims)
+			b := make([]float32, dims)
+			var dot, l2 float32
+			for i := range a {
+				a[i], b[i] = float32(r.Intn(2048)-1024)/64, float32(r.Intn(2048)-1024)/64
+				dot += a[i] * b[i]
+				l2 += (a[i] - b[i]) * (a[i] - b[i])
+			}
+			encodedA, err := common.EncodeVector(common.DataTypeFloat16, a)
+			require.Nil(t, err)
+			encodedB, err := common.EncodeVector(common.DataTypeFloat16, b)
+			require.Nil(t, err)
+
+			delta := func(expected float32) float64 { return 0.0001 * float64(max(1, abs(expected))) }
+			assert.InDelta(t, dot, dotProductFloat16(encodedA, encodedB), delta(dot))
+			assert.InDelta(t, dot, dotProductFloat16Impl(encodedA, encodedB), delta(dot))
+			assert.InDelta(t, l2, l2SquaredFloat16(encodedA, encodedB), delta(l2))
+			assert.InDelta(t, l2, l2SquaredFloat16Impl(encodedA, encodedB), delta(l2))
+		})
+	}
+
+	t.Run("special values", func(t *testing.T) {
+		a, err := common.EncodeVector(common.DataTypeFloat16, []float32{0.5, -2, 65504, 6e-8})
+		require.Nil(t, err)
+		b, err := common.EncodeVector(common.DataTypeFloat16, []float32{2, 0.25, 0, 1})
+		require.Nil(t, err)
+		expected := float32(0.5*2 - 2*0.25 + float64(common.Float32FromFloat16(binary.LittleEndian.Uint16(a[6:]))))
+		assert.Equal(t, expected, dotProductFloat16(a, b))
+		assert.Equal(t, expected, dotProductFloat16Impl(a, b))
+	})
+}
+
+func BenchmarkEncodedProviderFloat16(b *testing.B) {
+	r := getRandomSeed()
+	vecA := make([]float32, 1536)
+	vecB := make([]float32, 1536)
+	for i := range vecA {
+		vecA[i], vecB[i] = r.Float32()*2-1, r.Float32()*2-1
+	}
+	encodedA, _ := common.EncodeVector(common.DataTypeFloat16, vecA)
+	encodedB, _ := common.EncodeVector(common.DataTypeFloat16, vecB)
+
+	for _, provider := range []Provider{NewDotProductProvider(), NewL2SquaredProvider(), NewCosineDistanceProvider(), NewManhattanProvider()} {
+		b.Run(provider.Type(), func(b *testing.B) {
+			p := NewEncodedProvider(provider, common.DataTypeFloat16)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				p.SingleDist(encodedA, encodedB)
+			}
+		})
+	}
+}
+
+func abs(x float32) float32 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,154 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/commitlog"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/usecases/encryption"
+)
+
+func newTestEncryptedFS(t *testing.T) common.FS {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	raw, err := json.Marshal(map[string]any{
+		"currentKeyId": "k1",
+		"keys":         map[string]string{"k1": base64.StdEncoding.EncodeToString(key)},
+	})
+	require.NoError(t, err)
+	keyfile := filepath.Join(t.TempDir(), "keyfile.json")
+	require.NoError(t, os.WriteFile(keyfile, raw, 0o600))
+	provider, err := encryption.NewLocalKeyProvider(keyfile)
+	require.NoError(t, err)
+	return common.NewEncryptedFS(encryption.New(provider))
+}
+
+func TestCreateAndLoadEncryptedSnapshot(t *testing.T) {
+	fs := newTestEncryptedFS(t)
+
+	load := func(t *testing.T, fs common.FS, expectEncrypted bool) *DeserializationResult {
+		dir := t.TempDir()
+		id := "main"
+		cl := createTestCommitLoggerForSnapshotsWithOpts(t, dir, id, WithFS(fs))
+		clDir := commitLogDirectory(dir, id)
+
+		for _, name := range []string{"1000.condensed", "1001.condensed", "1002.condensed"} {
+			logger := commitlog.NewLogger(filepath.Join(clDir, name), fs)
+			generateFakeCommitLogData(t, logger, 1000)
+			require.NoError(t, logger.Close())
+		}
+
+		created, _, err := cl.CreateSnapshot()
+		require.NoError(t, err)
+		require.True(t, created)
+
+		for _, dir := range []string{clDir, snapshotDirectory(dir, id)} {
+			for _, name := range readDir(t, dir) {
+				_, encrypted, err := encryption.FileKeyID(filepath.Join(dir, name))
+				require.NoError(t, err)
+				require.Equal(t, expectEncrypted, encrypted, name)
+			}
+		}
+
+		// a new commit logger reads the snapshot from disk
+		cl, err = NewCommitLogger(dir, id, logrus.New(), cyclemanager.NewCallbackGroupNoop(),
+			WithCondensor(&fakeCondensor{}), WithSnapshotDisabled(false), WithFS(fs))
+		require.NoError(t, err)
+		cl.snapshotBlockSize = 4096
+		state, createdAt, err := cl.LoadSnapshot()
+		require.NoError(t, err)
+		require.NotNil(t, state)
+		require.NotZero(t, createdAt)
+		return state
+	}
+
+	plaintext := load(t, common.NewOSFS(), false)
+	encrypted := load(t, fs, true)
+	require.Equal(t, plaintext.Entrypoint, encrypted.Entrypoint)
+	require.Equal(t, plaintext.Level, encrypted.Level)
+	require.Equal(t, len(plaintext.Nodes), len(encrypted.Nodes))
+	require.Equal(t, plaintext.Tombstones, encrypted.Tombstones)
+}
+
+func TestEncryptedCommitLogWithCorruptTail(t *testing.T) {
+	fs := newTestEncryptedFS(t)
+	logger := logrus.New()
+	dir := t.TempDir()
+	id := "main"
+
+	open := func(t *testing.T) *hnswCommitLogger {
+		cl, err := NewCommitLogger(dir, id, logger, cyclemanager.NewCallbackGroupNoop(), WithFS(fs))
+		require.NoError(t, err)
+		return cl
+	}
+
+	load := func(t *testing.T) *DeserializationResult {
+		fileNames, err := getCommitFileNames(dir, id, 0, fs)
+		require.NoError(t, err)
+		require.Len(t, fileNames, 1)
+		state, err := loadCommitLoggerState(fs, logger, fileNames, nil, nil)
+		require.NoError(t, err)
+		return state
+	}
+
+	cl := open(t)
+	require.NoError(t, cl.AddNode(&vertex{id: 1}))
+	require.NoError(t, cl.Flush())
+	require.NoError(t, cl.commitLogger.Close())
+
+	// simulate a crash in the middle of a record
+	fileNames, err := getCommitFileNames(dir, id, 0, fs)
+	require.NoError(t, err)
+	f, err := fs.OpenFile(fileNames[0], os.O_WRONLY|os.O_APPEND, 0o666)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{byte(commitlog.AddNode), 2})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	keyID, _, err := encryption.FileKeyID(fileNames[0])
+	require.NoError(t, err)
+	before, err := os.ReadFile(fileNames[0])
+	require.NoError(t, err)
+
+	// the commit logger is created before the index is restored, restoring
+	// replaces the encrypted commit log with a truncated copy
+	cl = open(t)
+	state := load(t)
+	require.NotNil(t, state.Nodes[1])
+	require.Nil(t, state.Nodes[2])
+
+	after, err := os.ReadFile(fileNames[0])
+	require.NoError(t, err)
+	require.NotEqual(t, before[:encryption.HeaderSize], after[:encryption.HeaderSize])
+	afterKeyID, _, err := encryption.FileKeyID(fileNames[0])
+	require.NoError(t, err)
+	require.Equal(t, keyID, afterKeyID)
+
+	// writes after the restore go to the replaced commit log
+	require.NoError(t, cl.AddNode(&vertex{id: 3}))
+	require.NoError(t, cl.Flush())
+	require.NoError(t, cl.commitLogger.Close())
+
+	state = load(t)
+	require.NotNil(t, state.Nodes[1])
+	require.NotNil(t, state.Nodes[3])
+}
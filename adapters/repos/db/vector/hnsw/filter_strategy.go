@@ -0,0 +1,122 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/additional"
+)
+
+// flatDistanceSpeedup is how much cheaper a distance of a brute force search
+// is compared to one of a graph search. Brute force reads the vectors in
+// order of their ids and takes no node locks.
+const flatDistanceSpeedup = 4
+
+// filteredSearchPlan is the strategy of a search with an allow list together
+// with the statistics it was chosen from
+type filteredSearchPlan struct {
+	flat          bool
+	strategy      FilterStrategy
+	reason        string
+	allowListSize int
+	indexSize     int
+}
+
+func (p filteredSearchPlan) strategyName() string {
+	switch {
+	case p.flat:
+		return additional.FilterStrategyFlat
+	case p.strategy == ACORN:
+		return additional.FilterStrategyAcorn
+	default:
+		return additional.FilterStrategySweeping
+	}
+}
+
+// planFilteredSearch decides how to search with the allow list. Allow lists
+// below the flatSearchCutoff are always searched by brute force. Beyond it,
+// ACORN is used if the share of allowed vectors is below the
+// acornFilterRatio, as most neighbors of a node would be skipped by a
+// sweeping search, and sweeping otherwise.
+//
+// With the adaptive filter strategy, brute force is also chosen if it needs
+// fewer distance computations than the graph search is estimated to need. A
+// graph search expands about ef candidates with maxConnections neighbors on
+// the lowest layer, of which only the allowed share counts towards the
+// results. ACORN keeps this share at about the acornFilterRatio by also
+// visiting the neighbors of skipped nodes.
+func (h *hnsw) planFilteredSearch(allowList helpers.AllowList, k int) filteredSearchPlan {
+	plan := filteredSearchPlan{
+		allowListSize: allowList.Len(),
+		indexSize:     int(h.cacheSize()),
+	}
+	selectivity := 1.0
+	if plan.indexSize > 0 {
+		selectivity = min(1, float64(plan.allowListSize)/float64(plan.indexSize))
+	}
+
+	flatSearchCutoff := int(atomic.LoadInt64(&h.flatSearchCutoff))
+	if !h.forbidFlat && plan.allowListSize < flatSearchCutoff {
+		plan.flat = true
+		plan.reason = fmt.Sprintf("allow list of %d ids is below the flatSearchCutoff of %d",
+			plan.allowListSize, flatSearchCutoff)
+		return plan
+	}
+
+	plan.strategy = SWEEPING
+	switch {
+	case !h.acornSearch.Load():
+		plan.reason = "filterStrategy is sweeping"
+	case h.acornEnabled(allowList):
+		plan.strategy = ACORN
+		plan.reason = fmt.Sprintf("selectivity of %.4f is below the acornFilterRatio of %.2f",
+			selectivity, h.acornFilterRatio)
+	default:
+		plan.reason = fmt.Sprintf("selectivity of %.4f is above the acornFilterRatio of %.2f",
+			selectivity, h.acornFilterRatio)
+	}
+
+	if !h.adaptiveFilterSearch.Load() || h.forbidFlat {
+		return plan
+	}
+
+	allowedShare := selectivity
+	if plan.strategy == ACORN {
+		allowedShare = max(selectivity, h.acornFilterRatio)
+	}
+	if allowedShare <= 0 {
+		plan.flat = true
+		plan.reason = "no allowed vectors in the index"
+		return plan
+	}
+	graphDistances := float64(h.searchTimeEF(k)*h.maximumConnectionsLayerZero) / allowedShare
+	flatDistances := float64(plan.allowListSize) / flatDistanceSpeedup
+	if flatDistances < graphDistances {
+		plan.reason = fmt.Sprintf("brute force over %d allowed vectors is cheaper than the estimated "+
+			"%.0f distances of a %s search with a selectivity of %.4f",
+			plan.allowListSize, graphDistances, plan.strategyName(), selectivity)
+		plan.flat = true
+	}
+	return plan
+}
+
+// recordFilteredSearchPlan records the plan in the query profile and the slow
+// query details of the context
+func recordFilteredSearchPlan(ctx context.Context, plan filteredSearchPlan) {
+	helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", plan.flat)
+	helpers.RecordFilterStrategy(ctx, plan.strategyName(), plan.reason,
+		plan.allowListSize, plan.indexSize)
+}
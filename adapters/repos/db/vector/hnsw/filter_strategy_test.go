@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+func TestPlanFilteredSearch(t *testing.T) {
+	vectors, queries := testinghelpers.RandomVecs(1000, 1, 8)
+	store := testinghelpers.NewDummyStore(t)
+	defer store.Shutdown(context.Background())
+
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "filter-strategy-test",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		AllocChecker:          memwatch.NewDummyMonitor(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk: TempVectorForIDThunk(vectors),
+		AcornFilterRatio:     0.4,
+	}, ent.UserConfig{
+		MaxConnections:        8,
+		EFConstruction:        16,
+		EF:                    1,
+		VectorCacheMaxObjects: 10000,
+		FilterStrategy:        ent.FilterStrategyAcorn,
+	}, cyclemanager.NewCallbackGroupNoop(), store)
+	require.Nil(t, err)
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(context.Background(), uint64(i), vec))
+	}
+
+	allowListOf := func(size int) helpers.AllowList {
+		allowList := helpers.NewAllowList()
+		for i := 0; i < size; i++ {
+			allowList.Insert(uint64(i))
+		}
+		return allowList
+	}
+
+	t.Run("below the flat search cutoff", func(t *testing.T) {
+		atomic.StoreInt64(&index.flatSearchCutoff, 100)
+		defer atomic.StoreInt64(&index.flatSearchCutoff, 0)
+
+		plan := index.planFilteredSearch(allowListOf(50), 1)
+		assert.True(t, plan.flat)
+		assert.Equal(t, additional.FilterStrategyFlat, plan.strategyName())
+		assert.Contains(t, plan.reason, "flatSearchCutoff")
+	})
+
+	t.Run("fixed strategies", func(t *testing.T) {
+		plan := index.planFilteredSearch(allowListOf(100), 1)
+		assert.Equal(t, additional.FilterStrategyAcorn, plan.strategyName())
+		assert.Equal(t, 100, plan.allowListSize)
+		assert.Equal(t, 1000, plan.indexSize)
+
+		plan = index.planFilteredSearch(allowListOf(900), 1)
+		assert.Equal(t, additional.FilterStrategySweeping, plan.strategyName())
+
+		index.acornSearch.Store(false)
+		defer index.acornSearch.Store(true)
+		plan = index.planFilteredSearch(allowListOf(100), 1)
+		assert.Equal(t, additional.FilterStrategySweeping, plan.strategyName())
+		assert.Equal(t, "filterStrategy is sweeping", plan.reason)
+	})
+
+	t.Run("adaptive", func(t *testing.T) {
+		index.adaptiveFilterSearch.Store(true)
+		defer index.adaptiveFilterSearch.Store(false)
+
+		// ef=1 and 16 connections on the lowest layer: ACORN is estimated at
+		// 16/0.4=40 distances, brute force at a quarter of the allow list
+		plan := index.planFilteredSearch(allowListOf(100), 1)
+		assert.Equal(t, additional.FilterStrategyFlat, plan.strategyName())
+		assert.Contains(t, plan.reason, "acorn search")
+
+		plan = index.planFilteredSearch(allowListOf(300), 1)
+		assert.Equal(t, additional.FilterStrategyAcorn, plan.strategyName())
+
+		plan = index.planFilteredSearch(allowListOf(900), 1)
+		assert.Equal(t, additional.FilterStrategySweeping, plan.strategyName())
+
+		t.Run("a larger ef for a larger k makes brute force preferable", func(t *testing.T) {
+			plan := index.planFilteredSearch(allowListOf(300), 10)
+			assert.Equal(t, additional.FilterStrategyFlat, plan.strategyName())
+		})
+	})
+
+	t.Run("search records the plan in the profile", func(t *testing.T) {
+		index.adaptiveFilterSearch.Store(true)
+		defer index.adaptiveFilterSearch.Store(false)
+
+		ctx, profile := helpers.InitVectorSearchProfile(context.Background())
+		ids, _, err := index.SearchByVector(ctx, queries[0], 1, allowListOf(300))
+		require.Nil(t, err)
+		require.Len(t, ids, 1)
+		assert.Less(t, ids[0], uint64(300))
+
+		assert.Equal(t, additional.FilterStrategyAcorn, profile.FilterStrategy)
+		assert.Equal(t, 300, profile.AllowListSize)
+		assert.Equal(t, 1000, profile.IndexSize)
+		assert.InDelta(t, 0.3, profile.Selectivity, 1e-9)
+		assert.NotEmpty(t, profile.Reason)
+	})
+}
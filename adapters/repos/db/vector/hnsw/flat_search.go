@@ -14,6 +14,7 @@ package hnsw
 import (
 	"context"
 	"fmt"
+	"math/rand"
 	"sync"
 	"time"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/storobj"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 )
 
 func (h *hnsw) flatSearch(ctx context.Context, queryVector []float32, k, limit int,
@@ -43,9 +45,6 @@ func (h *hnsw) flatSearch(ctx context.Context, queryVector []float32, k, limit i
 		compressorDistancer = distancer
 	}
 
-	aggregateMu := &sync.Mutex{}
-	results := priorityqueue.NewMax[any](limit)
-
 	beforeIter := time.Now()
 	// first extract all candidates, this reduces the amount of coordination
 	// needed for the workers
@@ -55,14 +54,63 @@ func (h *hnsw) flatSearch(ctx context.Context, queryVector []float32, k, limit i
 		candidates = append(candidates, candidate)
 	}
 
+	results, err := h.scanNodes(len(candidates), func(pos int) uint64 { return candidates[pos] },
+		nodeSize, limit, func(candidate uint64) (float32, error) {
+			return h.distToNode(compressorDistancer, candidate, queryVector)
+		})
+	if err != nil {
+		return nil, nil, err
+	}
+	took := time.Since(beforeIter)
+	helpers.AnnotateSlowQueryLog(ctx, "flat_search_iteration_took", took)
+
+	beforeRescore := time.Now()
+	if h.shouldRescore() && !h.multivector.Load() {
+		compressorDistancer, fn := h.compressor.NewDistancer(queryVector)
+		if err := h.rescore(ctx, results, k, compressorDistancer); err != nil {
+			helpers.AnnotateSlowQueryLog(ctx, "context_error", "flat_search_rescore")
+			took := time.Since(beforeRescore)
+			helpers.AnnotateSlowQueryLog(ctx, "flat_search_rescore_took", took)
+			return nil, nil, fmt.Errorf("flat search: %w", err)
+		}
+		fn()
+		took := time.Since(beforeRescore)
+		helpers.AnnotateSlowQueryLog(ctx, "flat_search_rescore_took", took)
+	}
+
+	ids := make([]uint64, results.Len())
+	dists := make([]float32, results.Len())
+
+	// results is ordered in reverse, we need to flip the order before presenting
+	// to the user!
+	i := len(ids) - 1
+	for results.Len() > 0 {
+		res := results.Pop()
+		ids[i] = res.ID
+		dists[i] = res.Dist
+		i--
+	}
+
+	return ids, dists, nil
+}
+
+// scanNodes computes the distance to each of the n candidates, skipping
+// nodes which are deleted or tombstoned, and returns the limit closest ones.
+// The work is spread across flatSearchConcurrency workers.
+func (h *hnsw) scanNodes(n int, candidateAt func(pos int) uint64, nodeSize uint64,
+	limit int, distance func(candidate uint64) (float32, error),
+) (*priorityqueue.Queue[any], error) {
+	aggregateMu := &sync.Mutex{}
+	results := priorityqueue.NewMax[any](limit)
+
 	eg := enterrors.NewErrorGroupWrapper(h.logger)
 	for workerID := 0; workerID < h.flatSearchConcurrency; workerID++ {
 		workerID := workerID
 		eg.Go(func() error {
 			localResults := priorityqueue.NewMax[any](limit)
 			var e storobj.ErrNotFound
-			for idPos := workerID; idPos < len(candidates); idPos += h.flatSearchConcurrency {
-				candidate := candidates[idPos]
+			for idPos := workerID; idPos < n; idPos += h.flatSearchConcurrency {
+				candidate := candidateAt(idPos)
 
 				// Hot fix for https://github.com/weaviate/weaviate/issues/1937
 				// this if statement mitigates the problem but it doesn't resolve the issue
@@ -81,7 +129,7 @@ func (h *hnsw) flatSearch(ctx context.Context, queryVector []float32, k, limit i
 					continue
 				}
 
-				dist, err := h.distToNode(compressorDistancer, candidate, queryVector)
+				dist, err := distance(candidate)
 				if errors.As(err, &e) {
 					h.handleDeletedNode(e.DocID, "flatSearch")
 					continue
@@ -105,44 +153,132 @@ func (h *hnsw) flatSearch(ctx context.Context, queryVector []float32, k, limit i
 	}
 
 	if err := eg.Wait(); err != nil {
-		return nil, nil, err
+		return nil, err
 	}
-	took := time.Since(beforeIter)
-	helpers.AnnotateSlowQueryLog(ctx, "flat_search_iteration_took", took)
+	return results, nil
+}
 
-	beforeRescore := time.Now()
-	if h.shouldRescore() && !h.multivector.Load() {
-		compressorDistancer, fn := h.compressor.NewDistancer(queryVector)
-		if err := h.rescore(ctx, results, k, compressorDistancer); err != nil {
-			helpers.AnnotateSlowQueryLog(ctx, "context_error", "flat_search_rescore")
-			took := time.Since(beforeRescore)
-			helpers.AnnotateSlowQueryLog(ctx, "flat_search_rescore_took", took)
-			return nil, nil, fmt.Errorf("flat search: %w", err)
-		}
-		fn()
-		took := time.Since(beforeRescore)
-		helpers.AnnotateSlowQueryLog(ctx, "flat_search_rescore_took", took)
+// ExactSearch returns the exact k nearest neighbors of the vector. Unlike a
+// flat search it ignores any compression and compares the vector to the
+// original vector of every node in the index, which makes it expensive, it
+// is meant to provide the ground truth to measure the recall of the graph.
+func (h *hnsw) ExactSearch(ctx context.Context, vector []float32, k int) ([]uint64, []float32, error) {
+	if h.multivector.Load() {
+		return nil, nil, errors.New("exact search is not supported for multi vector indexes")
+	}
+
+	h.compressActionLock.RLock()
+	defer h.compressActionLock.RUnlock()
+
+	distanceTo := h.distancerProvider.New(h.normalizeVec(vector)).Distance
+	if h.dataType != vectorIndexCommon.DataTypeFloat32 {
+		// the compressor keeps the vectors in their data type without loss
+		queryDistancer, returnFn := h.compressor.NewDistancer(vector)
+		defer returnFn()
+		distanceTo = queryDistancer.DistanceToFloat
+	}
+	compressed := h.compressed.Load()
+
+	h.RLock()
+	nodeSize := uint64(len(h.nodes))
+	h.RUnlock()
+
+	results, err := h.scanNodes(int(nodeSize), func(pos int) uint64 { return uint64(pos) },
+		nodeSize, k, func(candidate uint64) (float32, error) {
+			if err := ctx.Err(); err != nil {
+				return 0, err
+			}
+			if !compressed {
+				vec, err := h.vectorForID(ctx, candidate)
+				if err != nil {
+					return 0, err
+				}
+				return distanceTo(vec)
+			}
+
+			slice := h.pools.tempVectors.Get(int(h.dims))
+			defer h.pools.tempVectors.Put(slice)
+			vec, err := h.TempVectorForIDThunk(ctx, candidate, slice)
+			if err != nil {
+				return 0, err
+			}
+			return distanceTo(h.normalizeVec(vec))
+		})
+	if err != nil {
+		return nil, nil, fmt.Errorf("exact search: %w", err)
 	}
 
 	ids := make([]uint64, results.Len())
 	dists := make([]float32, results.Len())
-
-	// results is ordered in reverse, we need to flip the order before presenting
-	// to the user!
-	i := len(ids) - 1
-	for results.Len() > 0 {
+	for i := len(ids) - 1; results.Len() > 0; i-- {
 		res := results.Pop()
 		ids[i] = res.ID
 		dists[i] = res.Dist
-		i--
 	}
-
 	return ids, dists, nil
 }
 
+// SampleVectors returns the original vectors of up to n randomly picked
+// nodes, to be used as queries when measuring the recall of the graph.
+func (h *hnsw) SampleVectors(ctx context.Context, n int) ([][]float32, error) {
+	if h.multivector.Load() {
+		return nil, errors.New("sampling vectors is not supported for multi vector indexes")
+	}
+
+	// the node list grows proactively, so ids are only drawn up to the last
+	// node in use
+	h.RLock()
+	nodeSize := len(h.nodes)
+	for ; nodeSize > 0; nodeSize-- {
+		id := uint64(nodeSize - 1)
+		h.shardedNodeLocks.RLock(id)
+		inUse := h.nodes[id] != nil
+		h.shardedNodeLocks.RUnlock(id)
+		if inUse {
+			break
+		}
+	}
+	h.RUnlock()
+	if nodeSize == 0 {
+		return nil, nil
+	}
+
+	vectors := make([][]float32, 0, n)
+	// give up after a bounded number of attempts rather than looping on a
+	// sparse index
+	for attempt := 0; attempt < 10*n && len(vectors) < n; attempt++ {
+		id := uint64(rand.Intn(nodeSize))
+
+		h.shardedNodeLocks.RLock(id)
+		node := h.nodes[id]
+		h.shardedNodeLocks.RUnlock(id)
+		if node == nil || h.hasTombstone(id) {
+			continue
+		}
+
+		slice := h.pools.tempVectors.Get(int(h.dims))
+		vec, err := h.TempVectorForIDThunk(ctx, id, slice)
+		if err == nil {
+			vectors = append(vectors, append([]float32(nil), vec...))
+		}
+		h.pools.tempVectors.Put(slice)
+
+		var e storobj.ErrNotFound
+		if err != nil && !errors.As(err, &e) {
+			return nil, fmt.Errorf("sample vector of node %d: %w", id, err)
+		}
+	}
+	return vectors, nil
+}
+
 func (h *hnsw) flatMultiSearch(ctx context.Context, queryVector [][]float32, limit int,
 	allowList helpers.AllowList,
 ) ([]uint64, []float32, error) {
+	aggregation, err := h.multivectorAggregation(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	aggregateMu := &sync.Mutex{}
 	results := priorityqueue.NewMax[any](limit)
 
@@ -160,7 +296,7 @@ func (h *hnsw) flatMultiSearch(ctx context.Context, queryVector [][]float32, lim
 			for idPos := workerID; idPos < len(candidates); idPos += h.flatSearchConcurrency {
 				candidate := candidates[idPos]
 
-				dist, err := h.computeScore(queryVector, candidate)
+				dist, err := h.computeScore(ctx, aggregation, queryVector, candidate)
 
 				if errors.As(err, &e) {
 					h.RLock()
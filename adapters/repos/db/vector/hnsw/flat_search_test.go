@@ -23,6 +23,7 @@ import (
 
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
@@ -156,6 +157,56 @@ func Test_NoRaceCompressionRecall(t *testing.T) {
 	}
 }
 
+func TestExactSearch(t *testing.T) {
+	for _, compressed := range []bool{false, true} {
+		t.Run(fmt.Sprintf("compressed=%v", compressed), func(t *testing.T) {
+			ctx := context.Background()
+			logger, _ := test.NewNullLogger()
+			dimensions := 16
+			vectors, queries := testinghelpers.RandomVecs(1_000, 10, dimensions)
+			distancer := distancer.NewL2SquaredProvider()
+			k := 10
+
+			uc := userConfig(dimensions/4, 256, 8, 16, 16, 500)
+			index, err := New(indexConfig("exact", t.TempDir(), logger, vectors, distancer), uc,
+				cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+			require.Nil(t, err)
+			for id, vec := range vectors {
+				require.Nil(t, index.Add(ctx, uint64(id), vec))
+			}
+			if compressed {
+				require.Nil(t, index.compress(uc))
+			}
+			require.Equal(t, compressed, index.compressed.Load())
+			// deleted nodes must not be part of the ground truth
+			require.Nil(t, index.Delete(0, 1, 2))
+
+			for _, query := range queries {
+				ids, dists, err := index.ExactSearch(ctx, query, k)
+				require.Nil(t, err)
+				require.Len(t, ids, k)
+
+				truth, truthDists := testinghelpers.BruteForce(logger, vectors[3:], query, k,
+					testinghelpers.DistanceWrapper(distancer))
+				for i := range truth {
+					assert.Equal(t, truth[i]+3, ids[i])
+					assert.InDelta(t, truthDists[i], dists[i], 1e-4)
+				}
+			}
+
+			samples, err := index.SampleVectors(ctx, 5)
+			require.Nil(t, err)
+			assert.NotEmpty(t, samples)
+			for _, sample := range samples {
+				ids, dists, err := index.ExactSearch(ctx, sample, 1)
+				require.Nil(t, err)
+				assert.Equal(t, sample, vectors[ids[0]])
+				assert.InDelta(t, 0, dists[0], 1e-4)
+			}
+		})
+	}
+}
+
 func makeRange(min, max uint64) []uint64 {
 	a := make([]uint64, max-min+1)
 	for i := range a {
@@ -36,6 +36,7 @@ import (
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/schema/config"
 	"github.com/weaviate/weaviate/entities/storobj"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 )
@@ -168,6 +169,8 @@ type hnsw struct {
 	doNotRescore     bool
 	acornSearch      atomic.Bool
 	acornFilterRatio float64
+	// adaptiveFilterSearch plans every filtered search, see planFilteredSearch
+	adaptiveFilterSearch atomic.Bool
 
 	disableSnapshots  bool
 	snapshotOnStartup bool
@@ -178,6 +181,10 @@ type hnsw struct {
 	sqConfig   ent.SQConfig
 	rqConfig   ent.RQConfig
 	rqActive   bool
+	// dataType is the data type the vectors are stored and compared in. Any
+	// other data type than float32 is kept by a compressor, see
+	// compressionhelpers.NewDataTypeCompressor
+	dataType string
 	// rescoring compressed vectors is disk-bound. On cold starts, we cannot
 	// rescore sequentially, as that would take very long. This setting allows us
 	// to define the rescoring concurrency.
@@ -193,6 +200,13 @@ type hnsw struct {
 
 	allocChecker            memwatch.AllocChecker
 	tombstoneCleanupRunning atomic.Bool
+	// share of the nodes processed by the running tombstone cleanup cycle,
+	// stored as float64 bits
+	tombstoneCleanupProgress atomic.Uint64
+
+	// serializes maintenance which is run on demand, see maintenance_on_demand.go
+	maintenanceLock   sync.Mutex
+	maintenancePaused atomic.Bool
 
 	visitedListPoolMaxSize int
 
@@ -201,6 +215,7 @@ type hnsw struct {
 	// only used for multivector mode
 	multivector       atomic.Bool
 	muvera            atomic.Bool
+	multivectorConfig atomic.Pointer[ent.MultivectorConfig]
 	muveraEncoder     *multivector.MuveraEncoder
 	docIDVectors      map[uint64][]uint64
 	vecIDcounter      uint64
@@ -240,8 +255,13 @@ type CommitLogger interface {
 	AddRQCompression(compressionhelpers.RQData) error
 	AddBRQCompression(compressionhelpers.BRQData) error
 	InitMaintenance()
+	// MaintenanceCtrl controls the cycle which combines and condenses the
+	// commit logs and creates snapshots of them
+	MaintenanceCtrl() cyclemanager.CycleCallbackCtrl
+	CondenseLogs(ctx context.Context, progress func(float64)) error
 
 	CreateSnapshot() (bool, int64, error)
+	ForceSnapshot() (bool, error)
 	CreateAndLoadSnapshot() (*DeserializationResult, int64, error)
 	LoadSnapshot() (*DeserializationResult, int64, error)
 }
@@ -361,6 +381,7 @@ func New(cfg Config, uc ent.UserConfig,
 		bqConfig:                  uc.BQ,
 		sqConfig:                  uc.SQ,
 		rqConfig:                  uc.RQ,
+		dataType:                  vectorIndexCommon.DataTypeOrDefault(uc.DataType),
 		rescoreConcurrency:        2 * runtime.GOMAXPROCS(0), // our default for IO-bound activties
 		shardedNodeLocks:          common.NewDefaultShardedRWLocks(),
 
@@ -374,10 +395,15 @@ func New(cfg Config, uc ent.UserConfig,
 		makeBucketOptions: cfg.MakeBucketOptions,
 		fs:                common.NewOSFS(),
 	}
-	index.acornSearch.Store(uc.FilterStrategy == ent.FilterStrategyAcorn)
+	if cfg.FS != nil {
+		index.fs = cfg.FS
+	}
+	index.acornSearch.Store(uc.FilterStrategy == ent.FilterStrategyAcorn || uc.FilterStrategy == ent.FilterStrategyAdaptive)
+	index.adaptiveFilterSearch.Store(uc.FilterStrategy == ent.FilterStrategyAdaptive)
 
 	index.multivector.Store(uc.Multivector.Enabled)
 	index.muvera.Store(uc.Multivector.MuveraConfig.Enabled)
+	index.multivectorConfig.Store(&uc.Multivector)
 
 	if uc.BQ.Enabled {
 		var err error
@@ -398,6 +424,19 @@ func New(cfg Config, uc ent.UserConfig,
 		index.cache = nil
 	}
 
+	if index.dataType != vectorIndexCommon.DataTypeFloat32 {
+		var err error
+		index.compressor, err = compressionhelpers.NewDataTypeCompressor(index.dataType,
+			index.distancerProvider, uc.VectorCacheMaxObjects, cfg.Logger, store,
+			cfg.MakeBucketOptions, cfg.AllocChecker, index.getTargetVector())
+		if err != nil {
+			return nil, err
+		}
+		index.compressed.Store(true)
+		index.cache.Drop()
+		index.cache = nil
+	}
+
 	if uc.RQ.Enabled {
 		index.rqActive = true
 	}
@@ -899,7 +938,13 @@ func (h *hnsw) CurrentVectorsLen() uint64 {
 	return uint64(h.cache.Len())
 }
 
+// normalizeVec normalizes the vector for the cosine distance. Vectors stored
+// in another data type than float32 are left as is, they could no longer be
+// encoded once normalized, the distancer of their compressor normalizes them.
 func (h *hnsw) normalizeVec(vec []float32) []float32 {
+	if h.dataType != vectorIndexCommon.DataTypeFloat32 {
+		return vec
+	}
 	if h.distancerProvider.Type() == "cosine-dot" {
 		// cosine-dot requires normalized vectors, as the dot product and cosine
 		// similarity are only identical if the vector is normalized
@@ -26,6 +26,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/packedconn"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/multivector"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 )
 
 const (
@@ -37,6 +38,12 @@ const (
 )
 
 func (h *hnsw) ValidateBeforeInsert(vector []float32) error {
+	if h.dataType != vectorIndexCommon.DataTypeFloat32 {
+		if _, err := vectorIndexCommon.EncodeVector(h.dataType, vector); err != nil {
+			return err
+		}
+	}
+
 	dims := int(atomic.LoadInt32(&h.dims))
 
 	// no vectors exist
@@ -0,0 +1,156 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"math"
+
+	"github.com/pkg/errors"
+
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/errorcompounder"
+)
+
+// The tombstone cleanup and the commit log maintenance run on the cycles of
+// the shard. The methods below run them on demand or pause their cycles.
+// While maintenance runs on demand, the callback of its cycle is deactivated,
+// so that both never run at the same time.
+
+// CleanUpTombstones removes all tombstoned nodes right away rather than
+// waiting for the tombstone cleanup cycle, ignoring the minimum number of
+// tombstones per cycle. It stops once ctx is done.
+func (h *hnsw) CleanUpTombstones(ctx context.Context) error {
+	h.maintenanceLock.Lock()
+	defer h.maintenanceLock.Unlock()
+
+	return h.runWithCycleDeactivated(ctx, h.tombstoneCleanupCallbackCtrl, func() error {
+		shouldAbort := func() bool { return ctx.Err() != nil }
+		for {
+			h.tombstoneCleanupProgress.Store(0)
+			before := h.tombstoneCount()
+			if _, err := h.cleanUpTombstonedNodes(shouldAbort, 1); err != nil {
+				return err
+			}
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			// a cycle is limited to TOMBSTONE_DELETION_MAX_PER_CYCLE tombstones,
+			// cycles continue as long as they make progress
+			if after := h.tombstoneCount(); after == 0 || after >= before {
+				h.tombstoneCleanupProgress.Store(math.Float64bits(1))
+				return nil
+			}
+		}
+	})
+}
+
+// TombstoneCleanupProgress returns the share of the nodes the running
+// tombstone cleanup cycle processed
+func (h *hnsw) TombstoneCleanupProgress() float64 {
+	return math.Float64frombits(h.tombstoneCleanupProgress.Load())
+}
+
+func (h *hnsw) tombstoneCount() int {
+	h.tombstoneLock.Lock()
+	defer h.tombstoneLock.Unlock()
+	return len(h.tombstones)
+}
+
+// CondenseCommitLogs combines and condenses all commit logs right away rather
+// than waiting for the commit log maintenance cycle
+func (h *hnsw) CondenseCommitLogs(ctx context.Context, progress func(float64)) error {
+	h.maintenanceLock.Lock()
+	defer h.maintenanceLock.Unlock()
+
+	return h.runWithCycleDeactivated(ctx, h.commitLog.MaintenanceCtrl(), func() error {
+		return h.commitLog.CondenseLogs(ctx, progress)
+	})
+}
+
+// CreateCommitLogSnapshot creates a snapshot of the commit logs right away
+// rather than waiting for the snapshot interval. It returns whether a
+// snapshot was created, which is not the case if no commit log was written
+// since the last one.
+func (h *hnsw) CreateCommitLogSnapshot(ctx context.Context) (bool, error) {
+	h.maintenanceLock.Lock()
+	defer h.maintenanceLock.Unlock()
+
+	var created bool
+	err := h.runWithCycleDeactivated(ctx, h.commitLog.MaintenanceCtrl(), func() (err error) {
+		created, err = h.commitLog.ForceSnapshot()
+		return err
+	})
+	return created, err
+}
+
+// runWithCycleDeactivated runs the maintenance while the callback of its
+// cycle is deactivated, waiting for a running cycle to finish first. The
+// callback is activated again afterwards, unless maintenance was paused.
+func (h *hnsw) runWithCycleDeactivated(ctx context.Context, ctrl cyclemanager.CycleCallbackCtrl,
+	maintain func() error,
+) error {
+	if err := ctrl.Deactivate(ctx); err != nil {
+		return errors.Wrap(err, "deactivate maintenance cycle")
+	}
+	defer func() {
+		if h.maintenancePaused.Load() {
+			return
+		}
+		if err := ctrl.Activate(); err != nil {
+			h.logger.WithField("action", "hnsw_maintenance").
+				WithField("class", h.className).
+				WithField("shard", h.shardName).
+				WithError(err).
+				Error("failed to activate maintenance cycle")
+		}
+	}()
+	return maintain()
+}
+
+// PauseMaintenance deactivates the tombstone cleanup and the commit log
+// maintenance cycles of the index until resumed. Running cycles are aborted.
+// Tombstones and commit logs pile up while paused. The pause is not persisted,
+// an index loaded again has to be paused again.
+func (h *hnsw) PauseMaintenance(ctx context.Context) error {
+	h.maintenanceLock.Lock()
+	defer h.maintenanceLock.Unlock()
+
+	if err := h.tombstoneCleanupCallbackCtrl.Deactivate(ctx); err != nil {
+		return errors.Wrap(err, "pause tombstone cleanup")
+	}
+	if err := h.commitLog.MaintenanceCtrl().Deactivate(ctx); err != nil {
+		ec := errorcompounder.New()
+		ec.Add(errors.Wrap(err, "pause commit log maintenance"))
+		ec.Add(h.tombstoneCleanupCallbackCtrl.Activate())
+		return ec.ToError()
+	}
+	h.maintenancePaused.Store(true)
+	return nil
+}
+
+// ResumeMaintenance activates the cycles deactivated by PauseMaintenance
+func (h *hnsw) ResumeMaintenance() error {
+	h.maintenanceLock.Lock()
+	defer h.maintenanceLock.Unlock()
+
+	h.maintenancePaused.Store(false)
+	ec := errorcompounder.New()
+	ec.Add(h.tombstoneCleanupCallbackCtrl.Activate())
+	ec.Add(h.commitLog.MaintenanceCtrl().Activate())
+	return ec.ToError()
+}
+
+// MaintenancePaused tells whether the maintenance cycles were paused
+func (h *hnsw) MaintenancePaused() bool {
+	return h.maintenancePaused.Load()
+}
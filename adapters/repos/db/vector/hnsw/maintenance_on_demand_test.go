@@ -0,0 +1,153 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+func TestMaintenanceOnDemand(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	rootPath := t.TempDir()
+	id := "maintenance-test"
+	vectors, _ := testinghelpers.RandomVecs(200, 0, 16)
+
+	store := testinghelpers.NewDummyStore(t)
+	defer store.Shutdown(ctx)
+
+	commitLoggerCallbacks := cyclemanager.NewCallbackGroup("commitLogger", logger, 1)
+	tombstoneCleanupCallbacks := cyclemanager.NewCallbackGroup("tombstoneCleanup", logger, 1)
+
+	index, err := New(Config{
+		RootPath:         rootPath,
+		ID:               id,
+		Logger:           logger,
+		DistanceProvider: distancer.NewL2SquaredProvider(),
+		MakeCommitLoggerThunk: func() (CommitLogger, error) {
+			return NewCommitLogger(rootPath, id, logger, commitLoggerCallbacks)
+		},
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk: TempVectorForIDThunk(vectors),
+		AllocChecker:         memwatch.NewDummyMonitor(),
+	}, ent.NewDefaultUserConfig(), tombstoneCleanupCallbacks, store)
+	require.NoError(t, err)
+	index.PostStartup(ctx)
+	defer index.Shutdown(ctx)
+
+	for i, vec := range vectors {
+		require.NoError(t, index.Add(ctx, uint64(i), vec))
+	}
+
+	t.Run("pause and resume", func(t *testing.T) {
+		require.NoError(t, index.PauseMaintenance(ctx))
+		assert.True(t, index.MaintenancePaused())
+		assert.False(t, index.tombstoneCleanupCallbackCtrl.IsActive())
+		assert.False(t, index.commitLog.MaintenanceCtrl().IsActive())
+
+		require.NoError(t, index.ResumeMaintenance())
+		assert.False(t, index.MaintenancePaused())
+		assert.True(t, index.tombstoneCleanupCallbackCtrl.IsActive())
+		assert.True(t, index.commitLog.MaintenanceCtrl().IsActive())
+	})
+
+	t.Run("clean up tombstones", func(t *testing.T) {
+		for i := 0; i < len(vectors); i += 2 {
+			require.NoError(t, index.Delete(uint64(i)))
+		}
+		require.Equal(t, len(vectors)/2, index.tombstoneCount())
+
+		require.NoError(t, index.CleanUpTombstones(ctx))
+		assert.Equal(t, 0, index.tombstoneCount())
+		assert.Equal(t, float64(1), index.TombstoneCleanupProgress())
+		assert.True(t, index.tombstoneCleanupCallbackCtrl.IsActive())
+
+		ids, _, err := index.SearchByVector(ctx, vectors[1], 10, nil)
+		require.NoError(t, err)
+		require.Len(t, ids, 10)
+		for _, id := range ids {
+			assert.Equal(t, uint64(1), id%2)
+		}
+	})
+
+	t.Run("clean up tombstones while paused", func(t *testing.T) {
+		require.NoError(t, index.PauseMaintenance(ctx))
+		require.NoError(t, index.Delete(1))
+
+		require.NoError(t, index.CleanUpTombstones(ctx))
+		assert.Equal(t, 0, index.tombstoneCount())
+		assert.False(t, index.tombstoneCleanupCallbackCtrl.IsActive(), "cycle stays paused")
+
+		require.NoError(t, index.ResumeMaintenance())
+	})
+
+	t.Run("clean up tombstones with cancelled context", func(t *testing.T) {
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+		require.ErrorIs(t, index.CleanUpTombstones(cancelled), context.Canceled)
+		assert.True(t, index.tombstoneCleanupCallbackCtrl.IsActive())
+	})
+
+	t.Run("condense commit logs", func(t *testing.T) {
+		// commit logs are named by the second they were created in
+		time.Sleep(time.Second)
+
+		var reported []float64
+		err := index.CondenseCommitLogs(ctx, func(progress float64) {
+			reported = append(reported, progress)
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, reported)
+		assert.Equal(t, float64(1), reported[len(reported)-1])
+		assert.True(t, index.commitLog.MaintenanceCtrl().IsActive())
+
+		entries, err := os.ReadDir(commitLogDirectory(rootPath, id))
+		require.NoError(t, err)
+		var condensed int
+		for _, entry := range entries {
+			if strings.HasSuffix(entry.Name(), ".condensed") {
+				condensed++
+			}
+		}
+		assert.Equal(t, 1, condensed)
+	})
+
+	t.Run("create snapshot", func(t *testing.T) {
+		time.Sleep(time.Second)
+		require.NoError(t, index.Delete(3))
+
+		created, err := index.CreateCommitLogSnapshot(ctx)
+		require.NoError(t, err)
+		assert.True(t, created)
+		assert.True(t, index.commitLog.MaintenanceCtrl().IsActive())
+
+		entries, err := os.ReadDir(snapshotDirectory(rootPath, id))
+		require.NoError(t, err)
+		assert.NotEmpty(t, entries)
+	})
+}
@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"testing"
 
 	"github.com/sirupsen/logrus/hooks/test"
@@ -569,3 +570,118 @@ func TestEmptyMuvera(t *testing.T) {
 		require.Equal(t, []uint64{}, ids)
 	})
 }
+
+func TestMultiVectorAggregations(t *testing.T) {
+	ctx := context.Background()
+
+	// expectedDistance calculates the aggregated distance of a document with
+	// the negative dot product as the distance of two vectors
+	expectedDistance := func(aggregation string, query, doc [][]float32) float32 {
+		total := float32(0)
+		for _, q := range query {
+			dists := make([]float32, len(doc))
+			for j, d := range doc {
+				for dim := range q {
+					dists[j] -= q[dim] * d[dim]
+				}
+			}
+			sort.Slice(dists, func(a, b int) bool { return dists[a] < dists[b] })
+			switch aggregation {
+			case ent.MultivectorAggregationAvgPool:
+				mean := float32(0)
+				for _, dist := range dists {
+					mean += dist
+				}
+				total += mean / float32(len(dists))
+			case ent.MultivectorAggregationTopKMean:
+				top := dists[:min(2, len(dists))]
+				total += (top[0] + top[len(top)-1]) / 2
+			default:
+				total += dists[0]
+			}
+		}
+		switch aggregation {
+		case ent.MultivectorAggregationMaxSimNormalized, ent.MultivectorAggregationAvgPool:
+			return total / float32(len(query))
+		default:
+			return total
+		}
+	}
+
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "multivector-aggregations",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewDotProductProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return []float32{0}, errors.New("can not use VectorForIDThunk with multivector")
+		},
+		MultiVectorForIDThunk: func(ctx context.Context, id uint64) ([][]float32, error) {
+			return multiVectors[id], nil
+		},
+		MakeBucketOptions: lsmkv.MakeNoopBucketOptions,
+	}, ent.UserConfig{
+		VectorCacheMaxObjects: 1e12,
+		MaxConnections:        8,
+		EFConstruction:        64,
+		EF:                    64,
+		Multivector: ent.MultivectorConfig{
+			Enabled:     true,
+			Aggregation: ent.MultivectorAggregationTopKMean,
+			TopK:        2,
+		},
+	}, cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+	require.Nil(t, err)
+
+	for i, vec := range multiVectors {
+		require.Nil(t, index.AddMulti(ctx, uint64(i), vec))
+	}
+
+	for _, aggregation := range []string{
+		"",
+		ent.MultivectorAggregationMaxSim,
+		ent.MultivectorAggregationMaxSimNormalized,
+		ent.MultivectorAggregationAvgPool,
+		ent.MultivectorAggregationTopKMean,
+	} {
+		t.Run("aggregation "+aggregation, func(t *testing.T) {
+			queryCtx := ctx
+			expected := ent.MultivectorAggregationTopKMean // configured
+			if aggregation != "" {
+				queryCtx = ent.ContextWithMultivectorAggregation(ctx, aggregation)
+				expected = aggregation
+			}
+
+			for _, query := range multiQueries {
+				ids, dists, err := index.SearchByMultiVector(queryCtx, query, len(multiVectors), nil)
+				require.Nil(t, err)
+				require.Len(t, ids, len(multiVectors))
+				for i, id := range ids {
+					require.InDelta(t, expectedDistance(expected, query, multiVectors[id]), dists[i], 1e-5)
+					if i > 0 {
+						require.LessOrEqual(t, dists[i-1], dists[i])
+					}
+				}
+
+				// the distancer used to rescore target vector combinations
+				// must honor the override as well
+				dist := index.QueryMultiVectorDistancer(queryCtx, query)
+				for id := range multiVectors {
+					distance, err := dist.DistanceToNode(uint64(id))
+					require.Nil(t, err)
+					require.InDelta(t, expectedDistance(expected, query, multiVectors[id]), distance, 1e-5)
+				}
+			}
+		})
+	}
+
+	t.Run("invalid aggregation override", func(t *testing.T) {
+		queryCtx := ent.ContextWithMultivectorAggregation(ctx, "minSim")
+		_, _, err := index.SearchByMultiVector(queryCtx, multiQueries[0], 1, nil)
+		require.ErrorContains(t, err, "invalid aggregation type minSim")
+
+		dist := index.QueryMultiVectorDistancer(queryCtx, multiQueries[0])
+		_, err = dist.DistanceToNode(0)
+		require.ErrorContains(t, err, "invalid aggregation type minSim")
+	})
+}
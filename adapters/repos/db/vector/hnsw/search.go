@@ -27,9 +27,12 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/visited"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/multivector"
 	"github.com/weaviate/weaviate/entities/dto"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/storobj"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/floatcomp"
 )
 
@@ -82,12 +85,15 @@ func (h *hnsw) SearchByVector(ctx context.Context, vector []float32,
 	defer h.compressActionLock.RUnlock()
 
 	vector = h.normalizeVec(vector)
-	flatSearchCutoff := int(atomic.LoadInt64(&h.flatSearchCutoff))
-	if allowList != nil && !h.forbidFlat && allowList.Len() < flatSearchCutoff {
-		helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", true)
-		return h.flatSearch(ctx, vector, k, h.searchTimeEF(k), allowList)
+	if allowList != nil {
+		plan := h.planFilteredSearch(allowList, k)
+		recordFilteredSearchPlan(ctx, plan)
+		if plan.flat {
+			return h.flatSearch(ctx, vector, k, h.searchTimeEF(k), allowList)
+		}
+	} else {
+		helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", false)
 	}
-	helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", false)
 	return h.knnSearchByVector(ctx, vector, k, h.searchTimeEF(k), allowList)
 }
 
@@ -112,19 +118,22 @@ func (h *hnsw) SearchByMultiVector(ctx context.Context, vectors [][]float32, k i
 		for _, docID := range docIDs {
 			candidateSet[docID] = struct{}{}
 		}
-		return h.computeLateInteraction(vectors, k, candidateSet)
+		return h.computeLateInteraction(ctx, vectors, k, candidateSet)
 	}
 
 	h.compressActionLock.RLock()
 	defer h.compressActionLock.RUnlock()
 
 	vectors = h.normalizeVecs(vectors)
-	flatSearchCutoff := int(atomic.LoadInt64(&h.flatSearchCutoff))
-	if allowList != nil && !h.forbidFlat && allowList.Len() < flatSearchCutoff {
-		helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", true)
-		return h.flatMultiSearch(ctx, vectors, k, allowList)
+	if allowList != nil {
+		plan := h.planFilteredSearch(allowList, k)
+		recordFilteredSearchPlan(ctx, plan)
+		if plan.flat {
+			return h.flatMultiSearch(ctx, vectors, k, allowList)
+		}
+	} else {
+		helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", false)
 	}
-	helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", false)
 	return h.knnSearchByMultiVector(ctx, vectors, k, allowList)
 }
 
@@ -181,6 +190,10 @@ func (h *hnsw) initMuveraEncoder(vectors [][]float32) error {
 
 func (h *hnsw) shouldRescore() bool {
 	if h.compressed.Load() {
+		// vectors stored in their data type are exact, see ValidateBeforeInsert
+		if h.dataType != vectorIndexCommon.DataTypeFloat32 {
+			return false
+		}
 		if (h.sqConfig.Enabled && h.sqConfig.RescoreLimit == 0) || (h.rqConfig.Enabled && h.rqConfig.RescoreLimit == 0) {
 			return false
 		}
@@ -734,11 +747,59 @@ func (h *hnsw) knnSearchByVector(ctx context.Context, searchVec []float32, k int
 		return nil, nil, fmt.Errorf("k must be greater than zero")
 	}
 
+	return h.knnSearchByVectorFrom(ctx, searchVec, k, ef, allowList, h.knnSearchStart(allowList))
+}
+
+// knnSearchEntrypoints are the parts of a knn search which do not depend on
+// the query vector, a batch of queries looks them up only once
+type knnSearchEntrypoints struct {
+	entryPointID uint64
+	maxLayer     int
+	useAcorn     bool
+	// filteredEntryPointID is the first node of the allow list present in the
+	// graph, it is an additional entrypoint at layer 0 if ACORN is used
+	filteredEntryPointID uint64
+}
+
+func (h *hnsw) knnSearchStart(allowList helpers.AllowList) knnSearchEntrypoints {
 	h.RLock()
-	entryPointID := h.entryPointID
-	maxLayer := h.currentMaximumLayer
+	start := knnSearchEntrypoints{
+		entryPointID: h.entryPointID,
+		maxLayer:     h.currentMaximumLayer,
+	}
 	h.RUnlock()
 
+	start.useAcorn = h.acornEnabled(allowList)
+
+	if allowList != nil && start.useAcorn {
+		isMultivec := h.multivector.Load() && !h.muvera.Load()
+		it := allowList.Iterator()
+		idx, ok := it.Next()
+		h.shardedNodeLocks.RLockAll()
+		if !isMultivec {
+			for ok && h.nodes[idx] == nil && h.hasTombstone(idx) {
+				idx, ok = it.Next()
+			}
+		} else {
+			_, exists := h.docIDVectors[idx]
+			for ok && !exists {
+				idx, ok = it.Next()
+				_, exists = h.docIDVectors[idx]
+			}
+		}
+		h.shardedNodeLocks.RUnlockAll()
+		start.filteredEntryPointID = idx
+	}
+
+	return start
+}
+
+func (h *hnsw) knnSearchByVectorFrom(ctx context.Context, searchVec []float32, k int,
+	ef int, allowList helpers.AllowList, start knnSearchEntrypoints,
+) ([]uint64, []float32, error) {
+	entryPointID := start.entryPointID
+	maxLayer := start.maxLayer
+
 	var compressorDistancer compressionhelpers.CompressorDistancer
 	if h.compressed.Load() {
 		var returnFn compressionhelpers.ReturnDistancerFn
@@ -808,7 +869,7 @@ func (h *hnsw) knnSearchByVector(ctx context.Context, searchVec []float32, k int
 	h.shardedNodeLocks.RLock(entryPointID)
 	entryPointNode := h.nodes[entryPointID]
 	h.shardedNodeLocks.RUnlock(entryPointID)
-	useAcorn := h.acornEnabled(allowList)
+	useAcorn := start.useAcorn
 	isMultivec := h.multivector.Load() && !h.muvera.Load()
 	if useAcorn {
 		if entryPointNode == nil {
@@ -846,22 +907,7 @@ func (h *hnsw) knnSearchByVector(ctx context.Context, searchVec []float32, k int
 	}
 
 	if allowList != nil && useAcorn {
-		it := allowList.Iterator()
-		idx, ok := it.Next()
-		h.shardedNodeLocks.RLockAll()
-		if !isMultivec {
-			for ok && h.nodes[idx] == nil && h.hasTombstone(idx) {
-				idx, ok = it.Next()
-			}
-		} else {
-			_, exists := h.docIDVectors[idx]
-			for ok && !exists {
-				idx, ok = it.Next()
-				_, exists = h.docIDVectors[idx]
-			}
-		}
-		h.shardedNodeLocks.RUnlockAll()
-
+		idx := start.filteredEntryPointID
 		entryPointDistance, _ := h.distToNode(compressorDistancer, idx, searchVec)
 		eps.Insert(idx, entryPointDistance)
 	}
@@ -921,13 +967,18 @@ func (h *hnsw) knnSearchByMultiVector(ctx context.Context, queryVectors [][]floa
 			candidateSet[docId] = struct{}{}
 		}
 	}
-	return h.computeLateInteraction(queryVectors, k, candidateSet)
+	return h.computeLateInteraction(ctx, queryVectors, k, candidateSet)
 }
 
-func (h *hnsw) computeLateInteraction(queryVectors [][]float32, k int, candidateSet map[uint64]struct{}) ([]uint64, []float32, error) {
+func (h *hnsw) computeLateInteraction(ctx context.Context, queryVectors [][]float32, k int, candidateSet map[uint64]struct{}) ([]uint64, []float32, error) {
+	aggregation, err := h.multivectorAggregation(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	resultsQueue := priorityqueue.NewMax[any](1)
 	for docID := range candidateSet {
-		sim, err := h.computeScore(queryVectors, docID)
+		sim, err := h.computeScore(ctx, aggregation, queryVectors, docID)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -951,7 +1002,9 @@ func (h *hnsw) computeLateInteraction(queryVectors [][]float32, k int, candidate
 	return ids, distances, nil
 }
 
-func (h *hnsw) computeScore(searchVecs [][]float32, docID uint64) (float32, error) {
+func (h *hnsw) computeScore(ctx context.Context, aggregation multivector.Aggregation,
+	searchVecs [][]float32, docID uint64,
+) (float32, error) {
 	h.RLock()
 	vecIDs := h.docIDVectors[docID]
 	h.RUnlock()
@@ -959,7 +1012,7 @@ func (h *hnsw) computeScore(searchVecs [][]float32, docID uint64) (float32, erro
 	if h.compressed.Load() {
 		slice := h.pools.tempVectors.Get(int(h.dims))
 		var err error
-		docVecs, err = h.TempMultiVectorForIDThunk(context.Background(), docID, slice)
+		docVecs, err = h.TempMultiVectorForIDThunk(ctx, docID, slice)
 		if err != nil {
 			return 0.0, errors.Wrap(err, "get vector for docID")
 		}
@@ -967,7 +1020,7 @@ func (h *hnsw) computeScore(searchVecs [][]float32, docID uint64) (float32, erro
 	} else {
 		if !h.muvera.Load() {
 			var errs []error
-			docVecs, errs = h.multiVectorForID(context.Background(), vecIDs)
+			docVecs, errs = h.multiVectorForID(ctx, vecIDs)
 			for _, err := range errs {
 				if err != nil {
 					return 0.0, errors.Wrap(err, "get vector for docID")
@@ -975,34 +1028,41 @@ func (h *hnsw) computeScore(searchVecs [][]float32, docID uint64) (float32, erro
 			}
 		} else {
 			var err error
-			docVecs, err = h.cache.GetDoc(context.Background(), docID)
+			docVecs, err = h.cache.GetDoc(ctx, docID)
 			if err != nil {
 				return 0.0, errors.Wrap(err, "get muvera vector for docID")
 			}
 		}
 	}
 
-	similarity := float32(0.0)
+	distancers := make([]distancer.Distancer, len(searchVecs))
+	for i, searchVec := range searchVecs {
+		distancers[i] = h.multiDistancerProvider.New(searchVec)
+	}
 
-	var distancer distancer.Distancer
-	for _, searchVec := range searchVecs {
-		maxSim := float32(math.MaxFloat32)
-		distancer = h.multiDistancerProvider.New(searchVec)
+	score, err := multivector.Score(aggregation, len(searchVecs), len(docVecs),
+		func(i, j int) (float32, error) {
+			return distancers[i].Distance(docVecs[j])
+		})
+	if err != nil {
+		return 0.0, errors.Wrap(err, "calculate distance between candidate and query")
+	}
 
-		for _, docVec := range docVecs {
-			dist, err := distancer.Distance(docVec)
-			if err != nil {
-				return 0.0, errors.Wrap(err, "calculate distance between candidate and query")
-			}
-			if dist < maxSim {
-				maxSim = dist
-			}
-		}
+	return score, nil
+}
 
-		similarity += maxSim
+// multivectorAggregation returns the aggregation of multi vector distances,
+// which a query can override through its context. It is resolved once per
+// query and shared by all candidates.
+func (h *hnsw) multivectorAggregation(ctx context.Context) (multivector.Aggregation, error) {
+	cfg := h.multivectorConfig.Load()
+	if cfg == nil {
+		cfg = &ent.MultivectorConfig{}
 	}
-
-	return similarity, nil
+	if name, ok := ent.MultivectorAggregationFromContext(ctx); ok {
+		return multivector.NewAggregation(name, cfg.TopK)
+	}
+	return multivector.NewAggregation(cfg.Aggregation, cfg.TopK)
 }
 
 func (h *hnsw) QueryVectorDistancer(queryVector []float32) common.QueryVectorDistancer {
@@ -1030,16 +1090,20 @@ func (h *hnsw) QueryVectorDistancer(queryVector []float32) common.QueryVectorDis
 	}
 }
 
-func (h *hnsw) QueryMultiVectorDistancer(queryVector [][]float32) common.QueryVectorDistancer {
+func (h *hnsw) QueryMultiVectorDistancer(ctx context.Context, queryVector [][]float32) common.QueryVectorDistancer {
 	queryVector = h.normalizeVecs(queryVector)
+	aggregation, aggregationErr := h.multivectorAggregation(ctx)
 	f := func(docID uint64) (float32, error) {
+		if aggregationErr != nil {
+			return -1, aggregationErr
+		}
 		h.RLock()
 		_, ok := h.docIDVectors[docID]
 		h.RUnlock()
 		if !ok {
 			return -1, fmt.Errorf("docID %v is not in the vector index", docID)
 		}
-		return h.computeScore(queryVector, docID)
+		return h.computeScore(ctx, aggregation, queryVector, docID)
 	}
 	return common.QueryVectorDistancer{DistanceFunc: f}
 }
@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+
+	"github.com/pkg/errors"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+// SearchByVectorBatch returns the k nearest neighbors of each of the query
+// vectors. The queries share the allow list, so the filter strategy, the
+// entrypoint of the graph and the entrypoint into the allowed nodes are only
+// looked up once for the whole batch.
+func (h *hnsw) SearchByVectorBatch(ctx context.Context, vectors [][]float32,
+	k int, allowList helpers.AllowList,
+) ([][]uint64, [][]float32, error) {
+	if h.multivector.Load() {
+		return nil, nil, errors.New("batch search is not supported for multivector indexes")
+	}
+
+	if k < 0 {
+		return nil, nil, fmt.Errorf("k must not be negative, got %d", k)
+	}
+
+	h.compressActionLock.RLock()
+	defer h.compressActionLock.RUnlock()
+
+	idss := make([][]uint64, len(vectors))
+	distss := make([][]float32, len(vectors))
+	if len(vectors) == 0 || h.isEmpty() {
+		return idss, distss, nil
+	}
+
+	ef := h.searchTimeEF(k)
+	flat := false
+	if allowList != nil {
+		plan := h.planFilteredSearch(allowList, k)
+		recordFilteredSearchPlan(ctx, plan)
+		flat = plan.flat
+	} else {
+		helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", false)
+	}
+
+	var start knnSearchEntrypoints
+	if !flat {
+		start = h.knnSearchStart(allowList)
+	}
+	search := func(ctx context.Context, vector []float32) ([]uint64, []float32, error) {
+		if flat {
+			return h.flatSearch(ctx, vector, k, ef, allowList)
+		}
+		return h.knnSearchByVectorFrom(ctx, vector, k, ef, allowList, start)
+	}
+
+	eg := enterrors.NewErrorGroupWrapper(h.logger)
+	eg.SetLimit(runtime.GOMAXPROCS(0))
+	for i := range vectors {
+		i := i
+		eg.Go(func() error {
+			ids, dists, err := search(ctx, h.normalizeVec(vectors[i]))
+			if err != nil {
+				return fmt.Errorf("query %d: %w", i, err)
+			}
+			idss[i], distss[i] = ids, dists
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, nil, err
+	}
+	return idss, distss, nil
+}
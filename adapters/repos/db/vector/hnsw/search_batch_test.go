@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+func TestSearchByVectorBatch(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	vectors, queries := testinghelpers.RandomVecs(1000, 20, 16)
+
+	store := testinghelpers.NewDummyStore(t)
+	defer store.Shutdown(ctx)
+
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "batch-search-test",
+		Logger:                logger,
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewCosineDistanceProvider(),
+		AllocChecker:          memwatch.NewDummyMonitor(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk: TempVectorForIDThunk(vectors),
+	}, ent.UserConfig{
+		MaxConnections:        16,
+		EFConstruction:        64,
+		VectorCacheMaxObjects: 100000,
+		FlatSearchCutoff:      100,
+		FilterStrategy:        ent.FilterStrategyAcorn,
+	}, cyclemanager.NewCallbackGroupNoop(), store)
+	require.NoError(t, err)
+	defer index.Shutdown(ctx)
+
+	for i, vec := range vectors {
+		require.NoError(t, index.Add(ctx, uint64(i), vec))
+	}
+
+	allowed := func(every int) helpers.AllowList {
+		allowList := helpers.NewAllowList()
+		for i := 0; i < len(vectors); i += every {
+			allowList.Insert(uint64(i))
+		}
+		return allowList
+	}
+
+	tests := []struct {
+		name      string
+		allowList helpers.AllowList
+	}{
+		{name: "unfiltered"},
+		{name: "filtered graph search", allowList: allowed(3)},
+		{name: "filtered flat search", allowList: allowed(20)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idss, distss, err := index.SearchByVectorBatch(ctx, queries, 10, tt.allowList)
+			require.NoError(t, err)
+			require.Len(t, idss, len(queries))
+			require.Len(t, distss, len(queries))
+
+			for i, query := range queries {
+				ids, dists, err := index.SearchByVector(ctx, query, 10, tt.allowList)
+				require.NoError(t, err)
+				assert.Equal(t, ids, idss[i], "query %d", i)
+				assert.Equal(t, dists, distss[i], "query %d", i)
+				for _, id := range idss[i] {
+					if tt.allowList != nil {
+						assert.True(t, tt.allowList.Contains(id))
+					}
+				}
+			}
+		})
+	}
+
+	t.Run("empty batch", func(t *testing.T) {
+		idss, distss, err := index.SearchByVectorBatch(ctx, nil, 10, nil)
+		require.NoError(t, err)
+		assert.Empty(t, idss)
+		assert.Empty(t, distss)
+	})
+
+	t.Run("negative k", func(t *testing.T) {
+		_, _, err := index.SearchByVectorBatch(ctx, nil, -1, nil)
+		assert.ErrorContains(t, err, "k must not be negative")
+	})
+}
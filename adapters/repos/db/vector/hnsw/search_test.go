@@ -174,7 +174,7 @@ func TestQueryMultiVectorDistancer(t *testing.T) {
 
 	index.AddMulti(context.TODO(), uint64(0), vectors[0])
 
-	dist := index.QueryMultiVectorDistancer([][]float32{{0.2, 0}, {1, 0}})
+	dist := index.QueryMultiVectorDistancer(context.Background(), [][]float32{{0.2, 0}, {1, 0}})
 	require.NotNil(t, dist)
 	distance, err := dist.DistanceToNode(0)
 	require.Nil(t, err)
@@ -450,7 +450,7 @@ func (h *hnsw) tombstoneCleanup(shouldAbort cyclemanager.ShouldAbortCallback) bo
 			return false
 		}
 	}
-	executed, err := h.cleanUpTombstonedNodes(shouldAbort)
+	executed, err := h.cleanUpTombstonedNodes(shouldAbort, minTombstonesPerCycle())
 	if err != nil {
 		h.logger.WithField("action", "hnsw_tombstone_cleanup").
 			WithError(err).Error("tombstone cleanup errord")
@@ -0,0 +1,136 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package multivector
+
+import (
+	"math"
+	"slices"
+
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// Aggregation combines the distances between the vectors of a multi vector
+// query and the vectors of a document into the distance of the document. The
+// distances of each query vector to all document vectors are reduced first,
+// then the reduced distances of all query vectors are combined. Lower
+// distances are better, the dot product distance being the negative
+// similarity.
+type Aggregation interface {
+	// Reduce returns the distance of a single query vector to the document. It
+	// may reorder distances.
+	Reduce(distances []float32) float32
+	// Combine returns the distance of the document from the reduced distances
+	// of all query vectors
+	Combine(reduced []float32) float32
+}
+
+// NewAggregation returns the aggregation of the given name. topK is only used
+// by the topKMean aggregation, DefaultMultivectorTopK is used if it is not
+// positive. An empty name selects the default aggregation.
+func NewAggregation(name string, topK int) (Aggregation, error) {
+	switch name {
+	case "", ent.MultivectorAggregationMaxSim:
+		return maxSim{}, nil
+	case ent.MultivectorAggregationMaxSimNormalized:
+		return maxSimNormalized{}, nil
+	case ent.MultivectorAggregationAvgPool:
+		return avgPool{}, nil
+	case ent.MultivectorAggregationTopKMean:
+		if topK <= 0 {
+			topK = ent.DefaultMultivectorTopK
+		}
+		return topKMean{k: topK}, nil
+	default:
+		return nil, ent.ValidateMultivectorAggregation(name)
+	}
+}
+
+// Score aggregates the distances of all query vectors to all document vectors
+// calculated by distance(i, j) for query vector i and document vector j. A
+// document without vectors has the maximum distance.
+func Score(aggregation Aggregation, queryLen, docLen int,
+	distance func(i, j int) (float32, error),
+) (float32, error) {
+	if docLen == 0 {
+		return math.MaxFloat32, nil
+	}
+
+	reduced := make([]float32, queryLen)
+	distances := make([]float32, docLen)
+	for i := range reduced {
+		for j := range distances {
+			dist, err := distance(i, j)
+			if err != nil {
+				return 0, err
+			}
+			distances[j] = dist
+		}
+		reduced[i] = aggregation.Reduce(distances)
+	}
+	return aggregation.Combine(reduced), nil
+}
+
+// maxSim is the ColBERT late interaction: the best match of every query
+// vector, summed up
+type maxSim struct{}
+
+func (maxSim) Reduce(distances []float32) float32 { return minimum(distances) }
+func (maxSim) Combine(reduced []float32) float32  { return sum(reduced) }
+
+// maxSimNormalized is maxSim divided by the number of query vectors
+type maxSimNormalized struct{}
+
+func (maxSimNormalized) Reduce(distances []float32) float32 { return minimum(distances) }
+func (maxSimNormalized) Combine(reduced []float32) float32  { return mean(reduced) }
+
+// avgPool is the mean of all pairwise distances, which for the dot product
+// equals the distance between the mean query and the mean document vector
+type avgPool struct{}
+
+func (avgPool) Reduce(distances []float32) float32 { return mean(distances) }
+func (avgPool) Combine(reduced []float32) float32  { return mean(reduced) }
+
+// topKMean averages the k best matches of every query vector and sums them up.
+// It is less sensitive to a single document vector matching by chance than
+// maxSim.
+type topKMean struct {
+	k int
+}
+
+func (a topKMean) Reduce(distances []float32) float32 {
+	if len(distances) > a.k {
+		slices.Sort(distances)
+		distances = distances[:a.k]
+	}
+	return mean(distances)
+}
+
+func (topKMean) Combine(reduced []float32) float32 { return sum(reduced) }
+
+func minimum(values []float32) float32 {
+	return slices.Min(values)
+}
+
+func sum(values []float32) float32 {
+	var total float32
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
+func mean(values []float32) float32 {
+	if len(values) == 0 {
+		return 0
+	}
+	return sum(values) / float32(len(values))
+}
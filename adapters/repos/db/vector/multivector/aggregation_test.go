@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package multivector
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestAggregations(t *testing.T) {
+	// distances of 2 query vectors (rows) to 3 document vectors (columns)
+	distances := [][]float32{
+		{-1, -4, -2},
+		{-3, 0, -1},
+	}
+	distance := func(i, j int) (float32, error) { return distances[i][j], nil }
+
+	tests := []struct {
+		name     string
+		topK     int
+		expected float32
+	}{
+		{name: "", expected: -7},
+		{name: ent.MultivectorAggregationMaxSim, expected: -7},
+		{name: ent.MultivectorAggregationMaxSimNormalized, expected: -3.5},
+		{name: ent.MultivectorAggregationAvgPool, expected: -11.0 / 6},
+		{name: ent.MultivectorAggregationTopKMean, topK: 2, expected: -3 + -2},
+		{name: ent.MultivectorAggregationTopKMean, topK: 5, expected: -7.0/3 + -4.0/3},
+		{name: ent.MultivectorAggregationTopKMean, expected: -7.0/3 + -4.0/3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			aggregation, err := NewAggregation(tt.name, tt.topK)
+			require.NoError(t, err)
+			score, err := Score(aggregation, len(distances), len(distances[0]), distance)
+			require.NoError(t, err)
+			assert.InDelta(t, tt.expected, score, 1e-6)
+		})
+	}
+
+	t.Run("document without vectors", func(t *testing.T) {
+		aggregation, err := NewAggregation(ent.MultivectorAggregationMaxSim, 0)
+		require.NoError(t, err)
+		score, err := Score(aggregation, 2, 0, distance)
+		require.NoError(t, err)
+		assert.Equal(t, float32(math.MaxFloat32), score)
+	})
+
+	t.Run("unknown aggregation", func(t *testing.T) {
+		_, err := NewAggregation("minSim", 0)
+		require.ErrorContains(t, err, "invalid aggregation type minSim")
+	})
+}
@@ -176,7 +176,7 @@ func (i *Index) QueryVectorDistancer(queryVector []float32) common.QueryVectorDi
 	return common.QueryVectorDistancer{}
 }
 
-func (i *Index) QueryMultiVectorDistancer(queryVector [][]float32) common.QueryVectorDistancer {
+func (i *Index) QueryMultiVectorDistancer(ctx context.Context, queryVector [][]float32) common.QueryVectorDistancer {
 	return common.QueryVectorDistancer{}
 }
 
@@ -17,6 +17,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/weaviate/weaviate/entities/additional"
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/sharding"
 
@@ -219,6 +220,116 @@ func TestVectorDistanceQuery(t *testing.T) {
 	})
 }
 
+func TestVectorDataTypes(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	dirName := t.TempDir()
+	shardState := singleShardState()
+	mockSchemaReader := schemaUC.NewMockSchemaReader(t)
+	mockSchemaReader.EXPECT().Shards(mock.Anything).Return(shardState.AllPhysicalShards(), nil).Maybe()
+	mockSchemaReader.EXPECT().Read(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(className string, retryIfClassNotFound bool, readFunc func(*models.Class, *sharding.State) error) error {
+		class := &models.Class{Class: className}
+		return readFunc(class, shardState)
+	}).Maybe()
+	mockSchemaReader.EXPECT().ShardReplicas(mock.Anything, mock.Anything).Return([]string{"node1"}, nil).Maybe()
+	mockReplicationFSMReader := replicationTypes.NewMockReplicationFSMReader(t)
+	mockReplicationFSMReader.EXPECT().FilterOneShardReplicasRead(mock.Anything, mock.Anything, mock.Anything).Return([]string{"node1"}).Maybe()
+	mockReplicationFSMReader.EXPECT().FilterOneShardReplicasWrite(mock.Anything, mock.Anything, mock.Anything).Return([]string{"node1"}, nil).Maybe()
+	mockNodeSelector := cluster.NewMockNodeSelector(t)
+	mockNodeSelector.EXPECT().LocalName().Return("node1").Maybe()
+	mockNodeSelector.EXPECT().NodeHostname(mock.Anything).Return("node1", true).Maybe()
+	repo, err := New(logger, "node1", Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10,
+		MaxImportGoroutinesFactor: 1,
+		DisableLazyLoadShards:     true, // need access to the shard directly to convert UUIDs to docIds
+	}, &FakeRemoteClient{}, &FakeNodeResolver{}, &FakeRemoteNodeClient{}, &FakeReplicationClient{}, nil, memwatch.NewDummyMonitor(),
+		mockNodeSelector, mockSchemaReader, mockReplicationFSMReader)
+	require.Nil(t, err)
+
+	class := &models.Class{
+		Class:               "TestDataTypes",
+		InvertedIndexConfig: invertedConfig(),
+		VectorConfig: map[string]models.VectorConfig{
+			"int8":  {VectorIndexType: "hnsw", VectorIndexConfig: hnsw.UserConfig{DataType: "int8"}},
+			"uint8": {VectorIndexType: "flat", VectorIndexConfig: flatent.UserConfig{DataType: "uint8"}},
+			"fp16":  {VectorIndexType: "flat", VectorIndexConfig: flatent.UserConfig{DataType: "float16"}},
+		},
+		Properties: []*models.Property{},
+	}
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}},
+		shardState: shardState,
+	}
+	repo.SetSchemaGetter(schemaGetter)
+	migrator := NewMigrator(repo, logger, "node1")
+	require.Nil(t, migrator.AddClass(context.Background(), class))
+
+	id := strfmt.UUID(uuid.New().String())
+	vectors := map[string][]float32{
+		"int8":  {-128, 3, 127, 0},
+		"uint8": {0, 3, 255, 1},
+		"fp16":  {0.5, -1.25, 2048, 0},
+	}
+	require.Nil(t, repo.PutObject(context.Background(),
+		&models.Object{ID: id, Class: class.Class}, nil, vectors, nil, nil, 0))
+
+	t.Run("vectors are returned in full precision", func(t *testing.T) {
+		res, err := repo.Object(context.Background(), class.Class, id, nil,
+			additional.Properties{Vectors: []string{"int8", "uint8", "fp16"}}, nil, "")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		for name, vector := range vectors {
+			require.Equal(t, vector, res.Vectors[name], name)
+		}
+	})
+
+	t.Run("distances on stored vectors", func(t *testing.T) {
+		var shard ShardLike
+		repo.GetIndex(schema.ClassName(class.Class)).shards.Range(func(_ string, s ShardLike) error {
+			shard = s
+			return nil
+		})
+		docId, err := docIdFromUUID(shard.(*Shard), id)
+		require.Nil(t, err)
+
+		distances, err := shard.VectorDistanceForQuery(context.Background(), docId,
+			[]models.Vector{vectors["int8"], vectors["uint8"], vectors["fp16"]},
+			[]string{"int8", "uint8", "fp16"})
+		require.Nil(t, err)
+		require.Len(t, distances, 3)
+		for _, dist := range distances {
+			require.InDelta(t, 0, dist, 1e-6)
+		}
+	})
+
+	t.Run("hnsw searches the int8 vectors", func(t *testing.T) {
+		var shard ShardLike
+		repo.GetIndex(schema.ClassName(class.Class)).shards.Range(func(_ string, s ShardLike) error {
+			shard = s
+			return nil
+		})
+		vectorIndex, ok := shard.GetVectorIndex("int8")
+		require.True(t, ok)
+		require.Equal(t, "int8", vectorIndex.CompressionStats().CompressionType())
+
+		docId, err := docIdFromUUID(shard.(*Shard), id)
+		require.Nil(t, err)
+
+		ids, dists, err := vectorIndex.SearchByVector(context.Background(), vectors["int8"], 1, nil)
+		require.Nil(t, err)
+		require.Equal(t, []uint64{docId}, ids)
+		require.InDelta(t, 0, dists[0], 1e-6)
+	})
+
+	t.Run("values which can not be stored are rejected", func(t *testing.T) {
+		err := repo.PutObject(context.Background(),
+			&models.Object{ID: strfmt.UUID(uuid.New().String()), Class: class.Class}, nil,
+			map[string][]float32{"int8": {0.5, 3, 127, 0}}, nil, nil, 0)
+		require.ErrorContains(t, err, "int8")
+	})
+}
+
 func docIdFromUUID(s *Shard, id strfmt.UUID) (uint64, error) {
 	idBytes, err := uuid.MustParse(id.String()).MarshalBinary()
 	if err != nil {
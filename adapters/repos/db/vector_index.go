@@ -61,6 +61,6 @@ type VectorIndexMulti interface {
 	SearchByMultiVector(ctx context.Context, vector [][]float32, k int, allow helpers.AllowList) ([]uint64, []float32, error)
 	SearchByMultiVectorDistance(ctx context.Context, vector [][]float32, dist float32,
 		maxLimit int64, allow helpers.AllowList) ([]uint64, []float32, error)
-	QueryMultiVectorDistancer(queryVector [][]float32) common.QueryVectorDistancer
+	QueryMultiVectorDistancer(ctx context.Context, queryVector [][]float32) common.QueryVectorDistancer
 	ValidateMultiBeforeInsert(vector [][]float32) error
 }
@@ -0,0 +1,229 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	replicationTypes "github.com/weaviate/weaviate/cluster/replication/types"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestVectorIndexMaintenance(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	dirName := t.TempDir()
+	shardState := singleShardState()
+
+	class := &models.Class{
+		Class:               "TestVectorIndexMaintenance",
+		InvertedIndexConfig: invertedConfig(),
+		VectorConfig: map[string]models.VectorConfig{
+			"v": {
+				VectorIndexType:   "hnsw",
+				VectorIndexConfig: hnsw.NewDefaultUserConfig(),
+				Vectorizer:        map[string]any{"none": map[string]any{}},
+			},
+		},
+		Properties: []*models.Property{},
+	}
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}},
+		shardState: shardState,
+	}
+
+	newRepo := func(t *testing.T) *DB {
+		mockSchemaReader := schemaUC.NewMockSchemaReader(t)
+		mockSchemaReader.EXPECT().ReadOnlySchema().Return(*schemaGetter.schema.Objects).Maybe()
+		mockSchemaReader.EXPECT().Shards(mock.Anything).Return(shardState.AllPhysicalShards(), nil).Maybe()
+		mockSchemaReader.EXPECT().Read(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(className string, retryIfClassNotFound bool, readFunc func(*models.Class, *sharding.State) error) error {
+			return readFunc(&models.Class{Class: className}, shardState)
+		}).Maybe()
+		mockSchemaReader.EXPECT().ShardReplicas(mock.Anything, mock.Anything).Return([]string{"node1"}, nil).Maybe()
+		mockReplicationFSMReader := replicationTypes.NewMockReplicationFSMReader(t)
+		mockReplicationFSMReader.EXPECT().FilterOneShardReplicasRead(mock.Anything, mock.Anything, mock.Anything).Return([]string{"node1"}).Maybe()
+		mockReplicationFSMReader.EXPECT().FilterOneShardReplicasWrite(mock.Anything, mock.Anything, mock.Anything).Return([]string{"node1"}, nil).Maybe()
+		mockNodeSelector := cluster.NewMockNodeSelector(t)
+		mockNodeSelector.EXPECT().LocalName().Return("node1").Maybe()
+		mockNodeSelector.EXPECT().NodeHostname(mock.Anything).Return("node1", true).Maybe()
+		repo, err := New(logger, "node1", Config{
+			MemtablesFlushDirtyAfter:  60,
+			RootPath:                  dirName,
+			QueryMaximumResults:       100,
+			MaxImportGoroutinesFactor: 1,
+			DisableLazyLoadShards:     true,
+		}, &FakeRemoteClient{}, &FakeNodeResolver{}, &FakeRemoteNodeClient{}, &FakeReplicationClient{}, nil, memwatch.NewDummyMonitor(),
+			mockNodeSelector, mockSchemaReader, mockReplicationFSMReader)
+		require.Nil(t, err)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(ctx))
+		return repo
+	}
+
+	getShard := func(repo *DB) ShardLike {
+		var shard ShardLike
+		repo.GetIndex(schema.ClassName(class.Class)).shards.Range(func(_ string, s ShardLike) error {
+			shard = s
+			return nil
+		})
+		return shard
+	}
+
+	repo := newRepo(t)
+	defer func() { repo.Shutdown(ctx) }()
+	migrator := NewMigrator(repo, logger, "node1")
+	shard := getShard(repo)
+	shardName := shard.Name()
+
+	r := rand.New(rand.NewSource(7))
+	vectors := map[strfmt.UUID][]float32{}
+	for i := 0; i < 200; i++ {
+		id := strfmt.UUID(uuid.New().String())
+		vec := make([]float32, 16)
+		for j := range vec {
+			vec[j] = r.Float32()
+		}
+		vectors[id] = vec
+		require.Nil(t, repo.PutObject(ctx, &models.Object{ID: id, Class: class.Class},
+			nil, map[string][]float32{"v": vec}, nil, nil, 0))
+	}
+	var deleted int
+	for id := range vectors {
+		if deleted == 50 {
+			break
+		}
+		require.Nil(t, repo.DeleteObject(ctx, class.Class, id, time.Now(), nil, "", 0))
+		delete(vectors, id)
+		deleted++
+	}
+
+	searchAll := func(t *testing.T) {
+		for id, vector := range vectors {
+			found, _, err := shard.ObjectVectorSearch(ctx, []models.Vector{vector}, []string{"v"},
+				0, 1, nil, nil, nil, additional.Properties{}, nil, nil)
+			require.Nil(t, err)
+			require.Len(t, found, 1)
+			require.Equal(t, id, found[0].Object.ID)
+		}
+	}
+
+	start := func(t *testing.T, operation string) *models.VectorIndexMaintenanceOperation {
+		op, err := migrator.StartVectorIndexMaintenance(ctx, class.Class, shardName, "v", operation)
+		require.Nil(t, err)
+		require.Equal(t, operation, op.Operation)
+		return op
+	}
+
+	waitFor := func(t *testing.T, id string) *models.VectorIndexMaintenanceOperation {
+		var finished *models.VectorIndexMaintenanceOperation
+		require.EventuallyWithT(t, func(collect *assert.CollectT) {
+			status, err := migrator.GetVectorIndexMaintenance(ctx, class.Class, shardName, "v")
+			if !assert.Nil(collect, err) {
+				return
+			}
+			for _, op := range status.Operations {
+				if op.ID == id {
+					assert.NotEqual(collect, VectorIndexMaintenanceStatusRunning, op.Status)
+					finished = op
+					return
+				}
+			}
+			assert.Fail(collect, "operation not found")
+		}, 30*time.Second, 100*time.Millisecond)
+		return finished
+	}
+
+	t.Run("pause the maintenance cycles", func(t *testing.T) {
+		op := start(t, vectorindex.MaintenancePause)
+		assert.Equal(t, VectorIndexMaintenanceStatusFinished, op.Status)
+
+		status, err := migrator.GetVectorIndexMaintenance(ctx, class.Class, shardName, "v")
+		require.Nil(t, err)
+		assert.True(t, status.MaintenancePaused)
+	})
+
+	t.Run("clean up tombstones on demand", func(t *testing.T) {
+		op := waitFor(t, start(t, vectorindex.MaintenanceTombstoneCleanup).ID)
+		require.Equal(t, VectorIndexMaintenanceStatusFinished, op.Status, op.Error)
+		assert.Equal(t, float64(1), op.Progress)
+		searchAll(t)
+	})
+
+	t.Run("rebuild the vector index", func(t *testing.T) {
+		before, ok := shard.GetVectorIndex("v")
+		require.True(t, ok)
+
+		op := waitFor(t, start(t, vectorindex.MaintenanceRebuild).ID)
+		require.Equal(t, VectorIndexMaintenanceStatusFinished, op.Status, op.Error)
+
+		after, ok := shard.GetVectorIndex("v")
+		require.True(t, ok)
+		assert.NotSame(t, before, after)
+		searchAll(t)
+
+		status, err := migrator.GetVectorIndexMaintenance(ctx, class.Class, shardName, "v")
+		require.Nil(t, err)
+		assert.True(t, status.MaintenancePaused, "the rebuilt index stays paused")
+	})
+
+	t.Run("the cycles stay paused after a restart", func(t *testing.T) {
+		require.Nil(t, repo.Shutdown(ctx))
+		repo = newRepo(t)
+		migrator = NewMigrator(repo, logger, "node1")
+		shard = getShard(repo)
+
+		status, err := migrator.GetVectorIndexMaintenance(ctx, class.Class, shardName, "v")
+		require.Nil(t, err)
+		assert.True(t, status.MaintenancePaused)
+		assert.Empty(t, status.Operations, "operations are only tracked in memory")
+	})
+
+	t.Run("resume the maintenance cycles", func(t *testing.T) {
+		start(t, vectorindex.MaintenanceResume)
+
+		status, err := migrator.GetVectorIndexMaintenance(ctx, class.Class, shardName, "v")
+		require.Nil(t, err)
+		assert.False(t, status.MaintenancePaused)
+		require.Len(t, status.Operations, 1)
+		assert.Equal(t, vectorindex.MaintenanceResume, status.Operations[0].Operation)
+
+		states, err := loadVectorReindexStates(nil, shardPath(shard.Index().path(), shardName))
+		require.Nil(t, err)
+		require.Contains(t, states, "v")
+		assert.False(t, states["v"].MaintenancePaused)
+	})
+
+	t.Run("cancel an unknown operation", func(t *testing.T) {
+		err := migrator.CancelVectorIndexMaintenance(ctx, class.Class, shardName, "v", "unknown")
+		require.ErrorIs(t, err, vectorindex.ErrMaintenanceNotFound)
+	})
+}
@@ -52,11 +52,18 @@ type VectorIndexQueue struct {
 	batchSize int
 
 	vectorIndex VectorIndex
+
+	// successor receives a copy of every write while the vector index is
+	// rebuilt into another index, see shard_vector_reindex.go. Once the
+	// rebuilt index replaced this one, writes only go to the successor.
+	successor atomic.Pointer[VectorIndexQueue]
+	replaced  atomic.Bool
 }
 
 func NewVectorIndexQueue(
 	shard *Shard,
 	targetVector string,
+	indexName string,
 	index VectorIndex,
 ) (*VectorIndexQueue, error) {
 	viq := VectorIndexQueue{
@@ -80,16 +87,18 @@ func NewVectorIndexQueue(
 
 	q, err := queue.NewDiskQueue(
 		queue.DiskQueueOptions{
-			ID:        fmt.Sprintf("vector_index_queue_%s_%s", shard.ID(), shard.vectorIndexID(targetVector)),
+			ID:        fmt.Sprintf("vector_index_queue_%s_%s", shard.ID(), shard.vectorIndexID(indexName)),
 			Logger:    logger,
 			Scheduler: shard.scheduler,
-			Dir:       filepath.Join(shard.path(), fmt.Sprintf("%s.queue.d", shard.vectorIndexID(targetVector))),
+			Dir:       filepath.Join(shard.path(), fmt.Sprintf("%s.queue.d", shard.vectorIndexID(indexName))),
 			TaskDecoder: &vectorIndexQueueDecoder{
 				q: &viq,
 			},
 			OnBatchProcessed: viq.OnBatchProcessed,
 			StaleTimeout:     staleTimeout,
 			Metrics:          viq.metrics.QueueMetrics(),
+			// the chunks hold the vectors until they are indexed
+			Encryption: shard.index.Config.Encryption,
 		},
 	)
 	if err != nil {
@@ -119,6 +128,15 @@ func (iq *VectorIndexQueue) Close() error {
 }
 
 func (iq *VectorIndexQueue) Insert(ctx context.Context, vectors ...common.VectorRecord) error {
+	if successor := iq.successor.Load(); successor != nil {
+		if err := successor.Insert(ctx, vectors...); err != nil {
+			return errors.Wrap(err, "insert into rebuilt vector index")
+		}
+		if iq.replaced.Load() {
+			return nil
+		}
+	}
+
 	if !iq.asyncEnabled {
 		return common.AddVectorsToIndex(ctx, vectors, iq.vectorIndex)
 	}
@@ -197,6 +215,15 @@ func (iq *VectorIndexQueue) DequeueBatch() (*queue.Batch, error) {
 }
 
 func (iq *VectorIndexQueue) Delete(ids ...uint64) error {
+	if successor := iq.successor.Load(); successor != nil {
+		if err := successor.Delete(ids...); err != nil {
+			return errors.Wrap(err, "delete from rebuilt vector index")
+		}
+		if iq.replaced.Load() {
+			return nil
+		}
+	}
+
 	if !iq.asyncEnabled {
 		return iq.vectorIndex.Delete(ids...)
 	}
@@ -0,0 +1,421 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/cluster/distributedtask"
+	replicationTypes "github.com/weaviate/weaviate/cluster/replication/types"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestVectorReindex(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	dirName := t.TempDir()
+	shardState := singleShardState()
+
+	class := &models.Class{
+		Class:               "TestVectorReindex",
+		InvertedIndexConfig: invertedConfig(),
+		VectorConfig: map[string]models.VectorConfig{
+			"v": {
+				VectorIndexType:   "hnsw",
+				VectorIndexConfig: hnsw.NewDefaultUserConfig(),
+				Vectorizer:        map[string]any{"none": map[string]any{}},
+			},
+		},
+		Properties: []*models.Property{},
+	}
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}},
+		shardState: shardState,
+	}
+
+	newRepo := func(t *testing.T) *DB {
+		mockSchemaReader := schemaUC.NewMockSchemaReader(t)
+		mockSchemaReader.EXPECT().ReadOnlySchema().RunAndReturn(func() models.Schema {
+			return *schemaGetter.schema.Objects
+		}).Maybe()
+		mockSchemaReader.EXPECT().Shards(mock.Anything).Return(shardState.AllPhysicalShards(), nil).Maybe()
+		mockSchemaReader.EXPECT().Read(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(className string, retryIfClassNotFound bool, readFunc func(*models.Class, *sharding.State) error) error {
+			return readFunc(&models.Class{Class: className}, shardState)
+		}).Maybe()
+		mockSchemaReader.EXPECT().ShardReplicas(mock.Anything, mock.Anything).Return([]string{"node1"}, nil).Maybe()
+		mockReplicationFSMReader := replicationTypes.NewMockReplicationFSMReader(t)
+		mockReplicationFSMReader.EXPECT().FilterOneShardReplicasRead(mock.Anything, mock.Anything, mock.Anything).Return([]string{"node1"}).Maybe()
+		mockReplicationFSMReader.EXPECT().FilterOneShardReplicasWrite(mock.Anything, mock.Anything, mock.Anything).Return([]string{"node1"}, nil).Maybe()
+		mockNodeSelector := cluster.NewMockNodeSelector(t)
+		mockNodeSelector.EXPECT().LocalName().Return("node1").Maybe()
+		mockNodeSelector.EXPECT().NodeHostname(mock.Anything).Return("node1", true).Maybe()
+		repo, err := New(logger, "node1", Config{
+			MemtablesFlushDirtyAfter:  60,
+			RootPath:                  dirName,
+			QueryMaximumResults:       100,
+			MaxImportGoroutinesFactor: 1,
+			DisableLazyLoadShards:     true,
+		}, &FakeRemoteClient{}, &FakeNodeResolver{}, &FakeRemoteNodeClient{}, &FakeReplicationClient{}, nil, memwatch.NewDummyMonitor(),
+			mockNodeSelector, mockSchemaReader, mockReplicationFSMReader)
+		require.Nil(t, err)
+		repo.SetSchemaGetter(schemaGetter)
+		return repo
+	}
+
+	getShard := func(repo *DB) ShardLike {
+		var shard ShardLike
+		repo.GetIndex(schema.ClassName(class.Class)).shards.Range(func(_ string, s ShardLike) error {
+			shard = s
+			return nil
+		})
+		return shard
+	}
+
+	search := func(t *testing.T, shard ShardLike, vector []float32) []strfmt.UUID {
+		found, _, err := shard.ObjectVectorSearch(ctx, []models.Vector{vector}, []string{"v"},
+			0, 3, nil, nil, nil, additional.Properties{}, nil, nil)
+		require.Nil(t, err)
+		ids := make([]strfmt.UUID, len(found))
+		for i := range found {
+			ids[i] = found[i].Object.ID
+		}
+		return ids
+	}
+
+	r := rand.New(rand.NewSource(7))
+	randomVector := func() []float32 {
+		vec := make([]float32, 16)
+		for i := range vec {
+			vec[i] = r.Float32()
+		}
+		return vec
+	}
+
+	repo := newRepo(t)
+	require.Nil(t, repo.WaitForStartup(ctx))
+	migrator := NewMigrator(repo, logger, "node1")
+
+	vectors := map[strfmt.UUID][]float32{}
+	put := func(t *testing.T) strfmt.UUID {
+		id := strfmt.UUID(uuid.New().String())
+		vectors[id] = randomVector()
+		require.Nil(t, repo.PutObject(ctx, &models.Object{ID: id, Class: class.Class},
+			nil, map[string][]float32{"v": vectors[id]}, nil, nil, 0))
+		return id
+	}
+	for i := 0; i < 200; i++ {
+		put(t)
+	}
+
+	t.Run("search the hnsw index", func(t *testing.T) {
+		shard := getShard(repo)
+		for id, vector := range vectors {
+			ids := search(t, shard, vector)
+			require.NotEmpty(t, ids)
+			require.Equal(t, id, ids[0])
+		}
+	})
+
+	t.Run("rebuild the hnsw index into a flat index", func(t *testing.T) {
+		flatCfg := flat.NewDefaultUserConfig()
+		class.VectorConfig = map[string]models.VectorConfig{
+			"v": {
+				VectorIndexType:   "flat",
+				VectorIndexConfig: flatCfg,
+				Vectorizer:        class.VectorConfig["v"].Vectorizer,
+			},
+		}
+		shard := getShard(repo)
+		// a search which started before the swap keeps the hnsw index
+		hnswIndex, release, ok := shard.(*Shard).acquireVectorIndex("v")
+		require.True(t, ok)
+
+		// searches keep running while the indexes are swapped
+		searchCtx, stopSearches := context.WithCancel(ctx)
+		searchErrs := make(chan error, 4)
+		wg := sync.WaitGroup{}
+		for i := 0; i < cap(searchErrs); i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for searchCtx.Err() == nil {
+					for id, vector := range vectors {
+						found, _, err := shard.ObjectVectorSearch(ctx, []models.Vector{vector}, []string{"v"},
+							0, 1, nil, nil, nil, additional.Properties{}, nil, nil)
+						if err == nil && (len(found) == 0 || found[0].Object.ID != id) {
+							err = fmt.Errorf("search for %s found %v", id, found)
+						}
+						if err != nil {
+							searchErrs <- err
+							return
+						}
+					}
+				}
+			}()
+		}
+
+		require.Nil(t, migrator.ReindexVectorIndex(ctx, class.Class, "v", flatCfg))
+
+		require.EventuallyWithT(t, func(collect *assert.CollectT) {
+			index, ok := shard.GetVectorIndex("v")
+			if !assert.True(collect, ok) {
+				return
+			}
+			assert.Equal(collect, common.IndexTypeFlat, string(index.Type()))
+			assert.Empty(collect, shard.getVectorReindexStatus())
+		}, 30*time.Second, 100*time.Millisecond)
+
+		stopSearches()
+		wg.Wait()
+		close(searchErrs)
+		for err := range searchErrs {
+			require.NoError(t, err)
+		}
+
+		obsolete := func() []vectorIndexGeneration {
+			shard.(*Shard).vectorReindexLock.Lock()
+			defer shard.(*Shard).vectorReindexLock.Unlock()
+			return shard.(*Shard).vectorReindexStates["v"].Obsolete
+		}
+		require.Equal(t, common.IndexTypeHNSW, string(hnswIndex.Type()))
+		_, _, err := hnswIndex.SearchByVector(ctx, randomVector(), 3, nil)
+		require.NoError(t, err)
+		require.Len(t, obsolete(), 1)
+
+		release()
+		require.Eventually(t, func() bool { return len(obsolete()) == 0 },
+			10*time.Second, 10*time.Millisecond)
+
+		for id, vector := range vectors {
+			ids := search(t, shard, vector)
+			require.NotEmpty(t, ids)
+			require.Equal(t, id, ids[0])
+		}
+	})
+
+	t.Run("writes reach the rebuilt index", func(t *testing.T) {
+		shard := getShard(repo)
+		id := put(t)
+		require.EventuallyWithT(t, func(collect *assert.CollectT) {
+			ids := search(t, shard, vectors[id])
+			assert.NotEmpty(collect, ids)
+			if len(ids) > 0 {
+				assert.Equal(collect, id, ids[0])
+			}
+		}, 10*time.Second, 100*time.Millisecond)
+
+		require.Nil(t, repo.DeleteObject(ctx, class.Class, id, time.Now(), nil, "", 0))
+		deleted := vectors[id]
+		delete(vectors, id)
+		require.NotContains(t, search(t, shard, deleted), id)
+	})
+
+	t.Run("the rebuilt index is served after a restart", func(t *testing.T) {
+		require.Nil(t, repo.Shutdown(ctx))
+
+		repo = newRepo(t)
+		require.Nil(t, repo.WaitForStartup(ctx))
+		defer repo.Shutdown(ctx)
+
+		shard := getShard(repo)
+		index, ok := shard.GetVectorIndex("v")
+		require.True(t, ok)
+		require.Equal(t, common.IndexTypeFlat, string(index.Type()))
+
+		for id, vector := range vectors {
+			ids := search(t, shard, vector)
+			require.NotEmpty(t, ids)
+			require.Equal(t, id, ids[0])
+		}
+	})
+}
+
+type fakeVectorReindexRecorder struct {
+	mu        sync.Mutex
+	progress  []float32
+	completed bool
+	failure   string
+}
+
+func (f *fakeVectorReindexRecorder) RecordDistributedTaskNodeCompletion(context.Context, string, string, uint64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.completed = true
+	return nil
+}
+
+func (f *fakeVectorReindexRecorder) RecordDistributedTaskNodeFailure(_ context.Context, _, _ string, _ uint64, errMsg string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.failure = errMsg
+	return nil
+}
+
+func (f *fakeVectorReindexRecorder) RecordDistributedTaskNodeProgress(_ context.Context, _, _ string, _ uint64, progress float32) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.progress = append(f.progress, progress)
+	return nil
+}
+
+func TestVectorReindexCompression(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	shardState := singleShardState()
+
+	rqCfg := hnsw.NewDefaultUserConfig()
+	rqCfg.RQ.Enabled = true
+	rqCfg.RQ.Bits = 8
+	class := &models.Class{
+		Class:               "TestVectorReindexCompression",
+		InvertedIndexConfig: invertedConfig(),
+		VectorConfig: map[string]models.VectorConfig{
+			"v": {
+				VectorIndexType:   "hnsw",
+				VectorIndexConfig: rqCfg,
+				Vectorizer:        map[string]any{"none": map[string]any{}},
+			},
+		},
+		Properties: []*models.Property{},
+	}
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}},
+		shardState: shardState,
+	}
+
+	mockSchemaReader := schemaUC.NewMockSchemaReader(t)
+	mockSchemaReader.EXPECT().ReadOnlySchema().RunAndReturn(func() models.Schema {
+		return *schemaGetter.schema.Objects
+	}).Maybe()
+	mockSchemaReader.EXPECT().Shards(mock.Anything).Return(shardState.AllPhysicalShards(), nil).Maybe()
+	mockSchemaReader.EXPECT().Read(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(className string, retryIfClassNotFound bool, readFunc func(*models.Class, *sharding.State) error) error {
+		return readFunc(&models.Class{Class: className}, shardState)
+	}).Maybe()
+	mockSchemaReader.EXPECT().ShardReplicas(mock.Anything, mock.Anything).Return([]string{"node1"}, nil).Maybe()
+	mockReplicationFSMReader := replicationTypes.NewMockReplicationFSMReader(t)
+	mockReplicationFSMReader.EXPECT().FilterOneShardReplicasRead(mock.Anything, mock.Anything, mock.Anything).Return([]string{"node1"}).Maybe()
+	mockReplicationFSMReader.EXPECT().FilterOneShardReplicasWrite(mock.Anything, mock.Anything, mock.Anything).Return([]string{"node1"}, nil).Maybe()
+	mockNodeSelector := cluster.NewMockNodeSelector(t)
+	mockNodeSelector.EXPECT().LocalName().Return("node1").Maybe()
+	mockNodeSelector.EXPECT().NodeHostname(mock.Anything).Return("node1", true).Maybe()
+	repo, err := New(logger, "node1", Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  t.TempDir(),
+		QueryMaximumResults:       100,
+		MaxImportGoroutinesFactor: 1,
+		DisableLazyLoadShards:     true,
+	}, &FakeRemoteClient{}, &FakeNodeResolver{}, &FakeRemoteNodeClient{}, &FakeReplicationClient{}, nil, memwatch.NewDummyMonitor(),
+		mockNodeSelector, mockSchemaReader, mockReplicationFSMReader)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(ctx))
+	defer repo.Shutdown(ctx)
+	migrator := NewMigrator(repo, logger, "node1")
+
+	r := rand.New(rand.NewSource(7))
+	vectors := map[strfmt.UUID][]float32{}
+	for i := 0; i < 300; i++ {
+		id := strfmt.UUID(uuid.New().String())
+		vec := make([]float32, 32)
+		for j := range vec {
+			vec[j] = r.Float32()
+		}
+		vectors[id] = vec
+		require.Nil(t, repo.PutObject(ctx, &models.Object{ID: id, Class: class.Class},
+			nil, map[string][]float32{"v": vec}, nil, nil, 0))
+	}
+
+	var shard ShardLike
+	repo.GetIndex(schema.ClassName(class.Class)).shards.Range(func(_ string, s ShardLike) error {
+		shard = s
+		return nil
+	})
+	index, ok := shard.GetVectorIndex("v")
+	require.True(t, ok)
+	require.Equal(t, "rq", index.CompressionStats().CompressionType())
+
+	sqCfg := hnsw.NewDefaultUserConfig()
+	sqCfg.SQ.Enabled = true
+	class.VectorConfig = map[string]models.VectorConfig{
+		"v": {
+			VectorIndexType:   "hnsw",
+			VectorIndexConfig: sqCfg,
+			Vectorizer:        class.VectorConfig["v"].Vectorizer,
+		},
+	}
+	require.Nil(t, migrator.ReindexVectorIndex(ctx, class.Class, "v", sqCfg))
+
+	payload, err := json.Marshal(vectorindex.ReindexTaskPayload{
+		Collection:   class.Class,
+		TargetVector: "v",
+		IndexType:    "hnsw",
+		Distance:     "cosine",
+		Compression:  "sq",
+	})
+	require.Nil(t, err)
+	recorder := &fakeVectorReindexRecorder{}
+	provider := NewVectorReindexTaskProvider(repo, logger)
+	provider.interval = 10 * time.Millisecond
+	provider.SetCompletionRecorder(recorder)
+	handle, err := provider.StartTask(&distributedtask.Task{
+		Namespace:      vectorindex.ReindexTaskNamespace,
+		TaskDescriptor: distributedtask.TaskDescriptor{ID: vectorindex.ReindexTaskID(class.Class, "v"), Version: 1},
+		Payload:        payload,
+	})
+	require.Nil(t, err)
+	defer handle.Terminate()
+
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		recorder.mu.Lock()
+		defer recorder.mu.Unlock()
+		assert.True(collect, recorder.completed)
+		assert.Empty(collect, recorder.failure)
+	}, 30*time.Second, 50*time.Millisecond)
+
+	index, ok = shard.GetVectorIndex("v")
+	require.True(t, ok)
+	require.True(t, index.Compressed())
+	require.Equal(t, "sq", index.CompressionStats().CompressionType())
+
+	for id, vector := range vectors {
+		found, _, err := shard.ObjectVectorSearch(ctx, []models.Vector{vector}, []string{"v"},
+			0, 3, nil, nil, nil, additional.Properties{}, nil, nil)
+		require.Nil(t, err)
+		require.NotEmpty(t, found)
+		require.Equal(t, id, found[0].Object.ID)
+	}
+}
@@ -0,0 +1,178 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/cluster/distributedtask"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+)
+
+// vectorReindexTaskInterval is how often a node checks the rebuild of its
+// shards. The progress is only recorded in whole percents, as every record
+// is a raft command.
+const vectorReindexTaskInterval = 5 * time.Second
+
+// VectorReindexTaskProvider runs the distributed tasks which track the
+// rebuild of the vector index of a named vector across the cluster. The
+// shards start to rebuild once the schema change reaches them, a task only
+// reports the progress of the local shards and completes once all of them
+// serve the rebuilt index.
+//
+// Shards of inactive tenants rebuild their index once activated, they are
+// not waited for.
+type VectorReindexTaskProvider struct {
+	db       *DB
+	logger   logrus.FieldLogger
+	interval time.Duration
+	recorder distributedtask.TaskCompletionRecorder
+}
+
+func NewVectorReindexTaskProvider(db *DB, logger logrus.FieldLogger) *VectorReindexTaskProvider {
+	return &VectorReindexTaskProvider{
+		db:       db,
+		logger:   logger,
+		interval: vectorReindexTaskInterval,
+	}
+}
+
+func (p *VectorReindexTaskProvider) SetCompletionRecorder(recorder distributedtask.TaskCompletionRecorder) {
+	p.recorder = recorder
+}
+
+// GetLocalTasks returns no tasks, as the progress is tracked by the shards
+// themselves and there is no task state to clean up
+func (p *VectorReindexTaskProvider) GetLocalTasks() []distributedtask.TaskDescriptor {
+	return nil
+}
+
+func (p *VectorReindexTaskProvider) CleanupTask(distributedtask.TaskDescriptor) error {
+	return nil
+}
+
+func (p *VectorReindexTaskProvider) StartTask(task *distributedtask.Task) (distributedtask.TaskHandle, error) {
+	var payload vectorindex.ReindexTaskPayload
+	if err := json.Unmarshal(task.Payload, &payload); err != nil {
+		return nil, fmt.Errorf("unmarshal payload: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	h := &vectorReindexTaskHandle{cancel: cancel, done: make(chan struct{})}
+	enterrors.GoWrapper(func() {
+		defer close(h.done)
+		p.run(ctx, task, payload)
+	}, p.logger)
+	return h, nil
+}
+
+func (p *VectorReindexTaskProvider) run(ctx context.Context, task *distributedtask.Task,
+	payload vectorindex.ReindexTaskPayload,
+) {
+	logger := p.logger.WithField("action", "vector_reindex_task").
+		WithField("collection", payload.Collection).
+		WithField("targetVector", payload.TargetVector).
+		WithField("version", task.Version)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	reported := float32(-1)
+	for {
+		done, progress, err := p.localProgress(ctx, payload)
+		switch {
+		case ctx.Err() != nil:
+			return
+		case err != nil:
+			if err := p.recorder.RecordDistributedTaskNodeFailure(ctx, task.Namespace, task.ID,
+				task.Version, err.Error()); err != nil {
+				logger.WithError(err).Error("failed to record vector reindex failure")
+			}
+			return
+		case done:
+			err := p.recorder.RecordDistributedTaskNodeCompletion(ctx, task.Namespace, task.ID, task.Version)
+			if err == nil {
+				return
+			}
+			// retried on the next tick
+			logger.WithError(err).Error("failed to record vector reindex completion")
+		default:
+			// only report whole percents, as every report goes through raft
+			if rounded := float32(math.Floor(progress*100) / 100); rounded != reported {
+				err := p.recorder.RecordDistributedTaskNodeProgress(ctx, task.Namespace, task.ID,
+					task.Version, rounded)
+				if err != nil {
+					logger.WithError(err).Warn("failed to record vector reindex progress")
+				} else {
+					reported = rounded
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// localProgress returns whether all local shards of the collection serve the
+// index of the payload, and the mean progress of their rebuilds otherwise
+func (p *VectorReindexTaskProvider) localProgress(ctx context.Context,
+	payload vectorindex.ReindexTaskPayload,
+) (bool, float64, error) {
+	index := p.db.GetIndex(schema.ClassName(payload.Collection))
+	if index == nil {
+		// the collection was deleted or has no shards on this node
+		return true, 1, nil
+	}
+
+	var shards []ShardLike
+	index.ForEachShard(func(_ string, shard ShardLike) error {
+		shards = append(shards, shard)
+		return nil
+	})
+	if len(shards) == 0 {
+		return true, 1, nil
+	}
+
+	allDone := true
+	var total float64
+	for _, shard := range shards {
+		done, progress, err := shard.vectorReindexProgress(ctx, payload.TargetVector, payload)
+		if err != nil {
+			return false, 0, fmt.Errorf("shard %q: %w", shard.Name(), err)
+		}
+		allDone = allDone && done
+		total += progress
+	}
+	return allDone, total / float64(len(shards)), nil
+}
+
+type vectorReindexTaskHandle struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func (h *vectorReindexTaskHandle) Terminate() {
+	h.cancel()
+	<-h.done
+}
@@ -0,0 +1,172 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	replicationTypes "github.com/weaviate/weaviate/cluster/replication/types"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestVectorSearchBatch(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	dirName := t.TempDir()
+	shardState := singleShardState()
+
+	class := &models.Class{
+		Class:               "TestVectorSearchBatch",
+		InvertedIndexConfig: invertedConfig(),
+		VectorConfig: map[string]models.VectorConfig{
+			"v": {
+				VectorIndexType:   "hnsw",
+				VectorIndexConfig: hnsw.NewDefaultUserConfig(),
+				Vectorizer:        map[string]any{"none": map[string]any{}},
+			},
+		},
+		Properties: []*models.Property{
+			{Name: "parity", DataType: schema.DataTypeInt.PropString()},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}},
+		shardState: shardState,
+	}
+
+	mockSchemaReader := schemaUC.NewMockSchemaReader(t)
+	mockSchemaReader.EXPECT().ReadOnlySchema().Return(*schemaGetter.schema.Objects).Maybe()
+	mockSchemaReader.EXPECT().Shards(mock.Anything).Return(shardState.AllPhysicalShards(), nil).Maybe()
+	mockSchemaReader.EXPECT().Read(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(className string, retryIfClassNotFound bool, readFunc func(*models.Class, *sharding.State) error) error {
+		return readFunc(&models.Class{Class: className}, shardState)
+	}).Maybe()
+	mockSchemaReader.EXPECT().ShardReplicas(mock.Anything, mock.Anything).Return([]string{"node1"}, nil).Maybe()
+	mockReplicationFSMReader := replicationTypes.NewMockReplicationFSMReader(t)
+	mockReplicationFSMReader.EXPECT().FilterOneShardReplicasRead(mock.Anything, mock.Anything, mock.Anything).Return([]string{"node1"}).Maybe()
+	mockReplicationFSMReader.EXPECT().FilterOneShardReplicasWrite(mock.Anything, mock.Anything, mock.Anything).Return([]string{"node1"}, nil).Maybe()
+	mockNodeSelector := cluster.NewMockNodeSelector(t)
+	mockNodeSelector.EXPECT().LocalName().Return("node1").Maybe()
+	mockNodeSelector.EXPECT().NodeHostname(mock.Anything).Return("node1", true).Maybe()
+	repo, err := New(logger, "node1", Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       100,
+		MaxImportGoroutinesFactor: 1,
+		DisableLazyLoadShards:     true,
+	}, &FakeRemoteClient{}, &FakeNodeResolver{}, &FakeRemoteNodeClient{}, &FakeReplicationClient{}, nil, memwatch.NewDummyMonitor(),
+		mockNodeSelector, mockSchemaReader, mockReplicationFSMReader)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(ctx))
+	defer repo.Shutdown(ctx)
+
+	r := rand.New(rand.NewSource(7))
+	randomVector := func() []float32 {
+		vec := make([]float32, 16)
+		for j := range vec {
+			vec[j] = r.Float32()
+		}
+		return vec
+	}
+	for i := 0; i < 300; i++ {
+		id := strfmt.UUID(uuid.New().String())
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			ID:         id,
+			Class:      class.Class,
+			Properties: map[string]interface{}{"parity": float64(i % 2)},
+		}, nil, map[string][]float32{"v": randomVector()}, nil, nil, 0))
+	}
+
+	queries := make([][]float32, 20)
+	for i := range queries {
+		queries[i] = randomVector()
+	}
+	even := &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorEqual,
+		On:       &filters.Path{Class: schema.ClassName(class.Class), Property: "parity"},
+		Value:    &filters.Value{Value: 0, Type: schema.DataTypeInt},
+	}}
+
+	tests := []struct {
+		name    string
+		filters *filters.LocalFilter
+	}{
+		{name: "unfiltered"},
+		{name: "filtered", filters: even},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := dto.GetParams{
+				ClassName:            class.Class,
+				Pagination:           &filters.Pagination{Limit: 10},
+				Filters:              tt.filters,
+				Properties:           search.SelectProperties{{Name: "parity"}},
+				AdditionalProperties: additional.Properties{Distance: true},
+			}
+
+			batch, err := repo.VectorSearchBatch(ctx, params, "v", queries)
+			require.Nil(t, err)
+			require.Len(t, batch, len(queries))
+
+			for i, query := range queries {
+				single, err := repo.VectorSearch(ctx, params, []string{"v"}, []models.Vector{query})
+				require.Nil(t, err)
+				require.Len(t, batch[i], len(single), "query %d", i)
+				for j := range single {
+					assert.Equal(t, single[j].ID, batch[i][j].ID, "query %d", i)
+					assert.Equal(t, single[j].Dist, batch[i][j].Dist, "query %d", i)
+					if tt.filters != nil {
+						assert.Equal(t, float64(0), batch[i][j].Schema.(map[string]interface{})["parity"])
+					}
+				}
+			}
+		})
+	}
+
+	t.Run("objects found by several queries are not shared", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 5},
+			Properties: search.SelectProperties{{Name: "parity"}},
+		}
+		batch, err := repo.VectorSearchBatch(ctx, params, "v", [][]float32{queries[0], queries[0]})
+		require.Nil(t, err)
+		require.Len(t, batch, 2)
+		require.Len(t, batch[0], 5)
+		for j := range batch[0] {
+			assert.Equal(t, batch[0][j].ID, batch[1][j].ID)
+			batch[0][j].Schema.(map[string]interface{})["parity"] = float64(5)
+			assert.NotEqual(t, float64(5), batch[1][j].Schema.(map[string]interface{})["parity"])
+		}
+	})
+}
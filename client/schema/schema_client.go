@@ -61,12 +61,22 @@ type ClientService interface {
 
 	SchemaObjectsPropertiesAdd(params *SchemaObjectsPropertiesAddParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesAddOK, error)
 
+	SchemaObjectsPropertiesDelete(params *SchemaObjectsPropertiesDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesDeleteOK, error)
+
 	SchemaObjectsShardsGet(params *SchemaObjectsShardsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsGetOK, error)
 
 	SchemaObjectsShardsUpdate(params *SchemaObjectsShardsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsUpdateOK, error)
 
 	SchemaObjectsUpdate(params *SchemaObjectsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsUpdateOK, error)
 
+	SchemaObjectsVectorsMaintenanceCancel(params *SchemaObjectsVectorsMaintenanceCancelParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsVectorsMaintenanceCancelOK, error)
+
+	SchemaObjectsVectorsMaintenanceGet(params *SchemaObjectsVectorsMaintenanceGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsVectorsMaintenanceGetOK, error)
+
+	SchemaObjectsVectorsMaintenanceStart(params *SchemaObjectsVectorsMaintenanceStartParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsVectorsMaintenanceStartOK, error)
+
+	SchemaObjectsVectorsReindex(params *SchemaObjectsVectorsReindexParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsVectorsReindexOK, error)
+
 	TenantExists(params *TenantExistsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantExistsOK, error)
 
 	TenantsCreate(params *TenantsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsCreateOK, error)
@@ -492,6 +502,47 @@ func (a *Client) SchemaObjectsPropertiesAdd(params *SchemaObjectsPropertiesAddPa
 	panic(msg)
 }
 
+/*
+SchemaObjectsPropertiesDelete deletes a property from a collection
+
+Removes a property from the definition of a collection. WARNING: This action permanently deletes the values of the property of all data objects stored within the collection.
+*/
+func (a *Client) SchemaObjectsPropertiesDelete(params *SchemaObjectsPropertiesDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesDeleteOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsPropertiesDeleteParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.properties.delete",
+		Method:             "DELETE",
+		PathPattern:        "/schema/{className}/properties/{propertyName}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsPropertiesDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsPropertiesDeleteOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.properties.delete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 SchemaObjectsShardsGet gets the shards status of a collection
 
@@ -615,6 +666,170 @@ func (a *Client) SchemaObjectsUpdate(params *SchemaObjectsUpdateParams, authInfo
 	panic(msg)
 }
 
+/*
+SchemaObjectsVectorsMaintenanceCancel cancels a vector index maintenance operation
+
+Cancels a running maintenance operation on the vector index of a named vector of a shard on this node. The request returns right away, the operation reports the status `CANCELLED` once it stopped. A tombstone cleanup stops after the node it is processing and keeps the tombstones removed so far. Condensing stops before the next commit log file. A snapshot which is being written is not interrupted. A cancelled rebuild keeps the current vector index and drops the partly built one. Cancelling a finished operation has no effect. Operations are only tracked while the shard is loaded, a rebuild interrupted by a shutdown continues once the shard is loaded again.
+*/
+func (a *Client) SchemaObjectsVectorsMaintenanceCancel(params *SchemaObjectsVectorsMaintenanceCancelParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsVectorsMaintenanceCancelOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsVectorsMaintenanceCancelParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.vectors.maintenance.cancel",
+		Method:             "DELETE",
+		PathPattern:        "/schema/{className}/shards/{shardName}/vectors/{vectorName}/maintenance/{operationId}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsVectorsMaintenanceCancelReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsVectorsMaintenanceCancelOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.vectors.maintenance.cancel: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsVectorsMaintenanceGet gets the maintenance status of a vector index
+
+Returns whether the maintenance cycles of the vector index of a named vector of a shard on this node are paused, together with its recent maintenance operations and their progress, the most recent first.
+*/
+func (a *Client) SchemaObjectsVectorsMaintenanceGet(params *SchemaObjectsVectorsMaintenanceGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsVectorsMaintenanceGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsVectorsMaintenanceGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.vectors.maintenance.get",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/shards/{shardName}/vectors/{vectorName}/maintenance",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsVectorsMaintenanceGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsVectorsMaintenanceGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.vectors.maintenance.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsVectorsMaintenanceStart starts a vector index maintenance operation
+
+Starts a maintenance operation on the vector index of a named vector of a shard on this node. `tombstoneCleanup`, `condense`, `snapshot` and `rebuild` run in the background, their progress is reported by the maintenance status. `pause` and `resume` stop and restart the maintenance cycles of the vector index right away.
+*/
+func (a *Client) SchemaObjectsVectorsMaintenanceStart(params *SchemaObjectsVectorsMaintenanceStartParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsVectorsMaintenanceStartOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsVectorsMaintenanceStartParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.vectors.maintenance.start",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/shards/{shardName}/vectors/{vectorName}/maintenance",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsVectorsMaintenanceStartReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsVectorsMaintenanceStartOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.vectors.maintenance.start: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsVectorsReindex rebuilds the vector index of a named vector
+
+Moves a named vector to a vector index of another type, distance or quantizer. Every shard builds the new index in the background from the vectors already stored, encoding them with the quantizer of the new index, and swaps it in once complete, searches are served by the previous index until then. Compression is enabled in place by updating the collection, a reindex is only needed to switch to another quantizer, e.g. from `pq` to `rq`; compression can not be disabled and the quantizer of a flat index can not be switched. The progress is reported per shard in the verbose nodes status and, if distributed tasks are enabled, per node in the `vector-reindex` task.
+*/
+func (a *Client) SchemaObjectsVectorsReindex(params *SchemaObjectsVectorsReindexParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsVectorsReindexOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsVectorsReindexParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.vectors.reindex",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/vectors/{vectorName}/reindex",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsVectorsReindexReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsVectorsReindexOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.vectors.reindex: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 TenantExists checks if a tenant exists
 
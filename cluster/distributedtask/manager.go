@@ -119,6 +119,31 @@ func (m *Manager) RecordNodeCompletion(c *api.ApplyRequest, numberOfNodesInTheCl
 	return nil
 }
 
+func (m *Manager) RecordNodeProgress(c *api.ApplyRequest) error {
+	var r api.RecordDistributedTaskNodeProgressRequest
+	if err := json.Unmarshal(c.SubCommand, &r); err != nil {
+		return fmt.Errorf("unmarshal record task node progress request: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	task, err := m.findVersionedTaskWithLock(r.Namespace, r.Id, r.Version)
+	if err != nil {
+		return err
+	}
+
+	if task.Status != TaskStatusStarted {
+		return fmt.Errorf("task %s/%s/%d is no longer running", r.Namespace, r.Id, task.Version)
+	}
+
+	if task.NodesProgress == nil {
+		task.NodesProgress = map[string]float32{}
+	}
+	task.NodesProgress[r.NodeId] = r.Progress
+	return nil
+}
+
 func (m *Manager) CancelTask(a *api.ApplyRequest) error {
 	var r api.CancelDistributedTaskRequest
 	if err := json.Unmarshal(a.SubCommand, &r); err != nil {
@@ -184,6 +184,59 @@ func TestManager_RecordNodeCompletion_Failures(t *testing.T) {
 	})
 }
 
+func TestManager_RecordNodeProgress(t *testing.T) {
+	var (
+		h = newTestHarness(t).init(t)
+
+		namespace        = "test"
+		taskID           = "1"
+		version   uint64 = 10
+
+		addCmd = toCmd(t, &cmd.AddDistributedTaskRequest{
+			Namespace:             namespace,
+			Id:                    taskID,
+			SubmittedAtUnixMillis: h.clock.Now().UnixMilli(),
+		})
+
+		progressCmd = func(nodeID string, progress float32) *cmd.ApplyRequest {
+			return toCmd(t, &cmd.RecordDistributedTaskNodeProgressRequest{
+				Namespace: namespace,
+				Id:        taskID,
+				Version:   version,
+				NodeId:    nodeID,
+				Progress:  progress,
+			})
+		}
+	)
+
+	err := h.manager.RecordNodeProgress(progressCmd("local-node", 0.5))
+	require.ErrorContains(t, err, "does not exist")
+
+	err = h.manager.AddTask(addCmd, version)
+	require.NoError(t, err)
+
+	require.NoError(t, h.manager.RecordNodeProgress(progressCmd("local-node", 0.25)))
+	require.NoError(t, h.manager.RecordNodeProgress(progressCmd("remote-node", 0.5)))
+	require.NoError(t, h.manager.RecordNodeProgress(progressCmd("local-node", 0.75)))
+
+	tasks, err := h.manager.ListDistributedTasks(context.Background())
+	require.NoError(t, err)
+	require.Len(t, tasks[namespace], 1)
+	require.Equal(t, map[string]float32{"local-node": 0.75, "remote-node": 0.5}, tasks[namespace][0].NodesProgress)
+
+	err = h.manager.RecordNodeCompletion(toCmd(t, &cmd.RecordDistributedTaskNodeCompletionRequest{
+		Namespace:            namespace,
+		Id:                   taskID,
+		Version:              version,
+		NodeId:               "local-node",
+		FinishedAtUnixMillis: h.clock.Now().UnixMilli(),
+	}), 1)
+	require.NoError(t, err)
+
+	err = h.manager.RecordNodeProgress(progressCmd("local-node", 1))
+	require.ErrorContains(t, err, "no longer running")
+}
+
 func TestManager_CancelTask_Failures(t *testing.T) {
 	t.Run("task does not exist", func(t *testing.T) {
 		var (
@@ -131,6 +131,56 @@ func (_c *MockTaskCompletionRecorder_RecordDistributedTaskNodeFailure_Call) RunA
 	return _c
 }
 
+// RecordDistributedTaskNodeProgress provides a mock function with given fields: ctx, namespace, taskID, version, progress
+func (_m *MockTaskCompletionRecorder) RecordDistributedTaskNodeProgress(ctx context.Context, namespace string, taskID string, version uint64, progress float32) error {
+	ret := _m.Called(ctx, namespace, taskID, version, progress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordDistributedTaskNodeProgress")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, uint64, float32) error); ok {
+		r0 = rf(ctx, namespace, taskID, version, progress)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskCompletionRecorder_RecordDistributedTaskNodeProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordDistributedTaskNodeProgress'
+type MockTaskCompletionRecorder_RecordDistributedTaskNodeProgress_Call struct {
+	*mock.Call
+}
+
+// RecordDistributedTaskNodeProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespace string
+//   - taskID string
+//   - version uint64
+//   - progress float32
+func (_e *MockTaskCompletionRecorder_Expecter) RecordDistributedTaskNodeProgress(ctx interface{}, namespace interface{}, taskID interface{}, version interface{}, progress interface{}) *MockTaskCompletionRecorder_RecordDistributedTaskNodeProgress_Call {
+	return &MockTaskCompletionRecorder_RecordDistributedTaskNodeProgress_Call{Call: _e.mock.On("RecordDistributedTaskNodeProgress", ctx, namespace, taskID, version, progress)}
+}
+
+func (_c *MockTaskCompletionRecorder_RecordDistributedTaskNodeProgress_Call) Run(run func(ctx context.Context, namespace string, taskID string, version uint64, progress float32)) *MockTaskCompletionRecorder_RecordDistributedTaskNodeProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(uint64), args[4].(float32))
+	})
+	return _c
+}
+
+func (_c *MockTaskCompletionRecorder_RecordDistributedTaskNodeProgress_Call) Return(_a0 error) *MockTaskCompletionRecorder_RecordDistributedTaskNodeProgress_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTaskCompletionRecorder_RecordDistributedTaskNodeProgress_Call) RunAndReturn(run func(context.Context, string, string, uint64, float32) error) *MockTaskCompletionRecorder_RecordDistributedTaskNodeProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockTaskCompletionRecorder creates a new instance of MockTaskCompletionRecorder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockTaskCompletionRecorder(t interface {
@@ -31,6 +31,7 @@ type TaskCleaner interface {
 type TaskCompletionRecorder interface {
 	RecordDistributedTaskNodeCompletion(ctx context.Context, namespace, taskID string, version uint64) error
 	RecordDistributedTaskNodeFailure(ctx context.Context, namespace, taskID string, version uint64, errMsg string) error
+	RecordDistributedTaskNodeProgress(ctx context.Context, namespace, taskID string, version uint64, progress float32) error
 }
 
 // TaskHandle is an interface to control a locally running task.
@@ -110,11 +111,16 @@ type Task struct {
 
 	// FinishedNodes is a map of nodeIDs that successfully finished the task.
 	FinishedNodes map[string]bool `json:"finishedNodes"`
+
+	// NodesProgress is an optional map of nodeIDs to the fraction of the task they have completed,
+	// for tasks which report their progress while running.
+	NodesProgress map[string]float32 `json:"nodesProgress,omitempty"`
 }
 
 func (t *Task) Clone() *Task {
 	clone := *t
 	clone.FinishedNodes = maps.Clone(t.FinishedNodes)
+	clone.NodesProgress = maps.Clone(t.NodesProgress)
 	return &clone
 }
 
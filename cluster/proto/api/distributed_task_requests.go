@@ -0,0 +1,22 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package api
+
+// RecordDistributedTaskNodeProgressRequest records how far a node got with a
+// running distributed task. Progress is a fraction between 0 and 1.
+type RecordDistributedTaskNodeProgressRequest struct {
+	Namespace string  `json:"namespace,omitempty"`
+	Id        string  `json:"id,omitempty"`
+	Version   uint64  `json:"version,omitempty"`
+	NodeId    string  `json:"node_id,omitempty"`
+	Progress  float32 `json:"progress,omitempty"`
+}
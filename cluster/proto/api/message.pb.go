@@ -32,6 +32,8 @@ const (
 	ApplyRequest_TYPE_DELETE_CLASS                                               ApplyRequest_Type = 3
 	ApplyRequest_TYPE_RESTORE_CLASS                                              ApplyRequest_Type = 4
 	ApplyRequest_TYPE_ADD_PROPERTY                                               ApplyRequest_Type = 5
+	ApplyRequest_TYPE_DELETE_PROPERTY                                            ApplyRequest_Type = 6
+	ApplyRequest_TYPE_REINDEX_VECTOR_INDEX                                       ApplyRequest_Type = 7
 	ApplyRequest_TYPE_UPDATE_SHARD_STATUS                                        ApplyRequest_Type = 10
 	ApplyRequest_TYPE_ADD_REPLICA_TO_SHARD                                       ApplyRequest_Type = 11
 	ApplyRequest_TYPE_DELETE_REPLICA_FROM_SHARD                                  ApplyRequest_Type = 12
@@ -75,6 +77,7 @@ const (
 	ApplyRequest_TYPE_DISTRIBUTED_TASK_CANCEL                                    ApplyRequest_Type = 301
 	ApplyRequest_TYPE_DISTRIBUTED_TASK_RECORD_NODE_COMPLETED                     ApplyRequest_Type = 302
 	ApplyRequest_TYPE_DISTRIBUTED_TASK_CLEAN_UP                                  ApplyRequest_Type = 303
+	ApplyRequest_TYPE_DISTRIBUTED_TASK_RECORD_NODE_PROGRESS                      ApplyRequest_Type = 304
 )
 
 // Enum value maps for ApplyRequest_Type.
@@ -86,6 +89,8 @@ var (
 		3:   "TYPE_DELETE_CLASS",
 		4:   "TYPE_RESTORE_CLASS",
 		5:   "TYPE_ADD_PROPERTY",
+		6:   "TYPE_DELETE_PROPERTY",
+		7:   "TYPE_REINDEX_VECTOR_INDEX",
 		10:  "TYPE_UPDATE_SHARD_STATUS",
 		11:  "TYPE_ADD_REPLICA_TO_SHARD",
 		12:  "TYPE_DELETE_REPLICA_FROM_SHARD",
@@ -129,6 +134,7 @@ var (
 		301: "TYPE_DISTRIBUTED_TASK_CANCEL",
 		302: "TYPE_DISTRIBUTED_TASK_RECORD_NODE_COMPLETED",
 		303: "TYPE_DISTRIBUTED_TASK_CLEAN_UP",
+		304: "TYPE_DISTRIBUTED_TASK_RECORD_NODE_PROGRESS",
 	}
 	ApplyRequest_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED":                                                0,
@@ -137,6 +143,8 @@ var (
 		"TYPE_DELETE_CLASS":                                               3,
 		"TYPE_RESTORE_CLASS":                                              4,
 		"TYPE_ADD_PROPERTY":                                               5,
+		"TYPE_DELETE_PROPERTY":                                            6,
+		"TYPE_REINDEX_VECTOR_INDEX":                                       7,
 		"TYPE_UPDATE_SHARD_STATUS":                                        10,
 		"TYPE_ADD_REPLICA_TO_SHARD":                                       11,
 		"TYPE_DELETE_REPLICA_FROM_SHARD":                                  12,
@@ -180,6 +188,7 @@ var (
 		"TYPE_DISTRIBUTED_TASK_CANCEL":                                    301,
 		"TYPE_DISTRIBUTED_TASK_RECORD_NODE_COMPLETED":                     302,
 		"TYPE_DISTRIBUTED_TASK_CLEAN_UP":                                  303,
+		"TYPE_DISTRIBUTED_TASK_RECORD_NODE_PROGRESS":                      304,
 	}
 )
 
@@ -1757,20 +1766,22 @@ const file_api_message_proto_rawDesc = "" +
 	"\x11NotifyPeerRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
 	"\aaddress\x18\x02 \x01(\tR\aaddress\"\x14\n" +
-	"\x12NotifyPeerResponse\"\xfd\x0e\n" +
+	"\x12NotifyPeerResponse\"\xe7\x0f\n" +
 	"\fApplyRequest\x12@\n" +
 	"\x04type\x18\x01 \x01(\x0e2,.weaviate.internal.cluster.ApplyRequest.TypeR\x04type\x12\x14\n" +
 	"\x05class\x18\x02 \x01(\tR\x05class\x12\x18\n" +
 	"\aversion\x18\x03 \x01(\x04R\aversion\x12\x1f\n" +
 	"\vsub_command\x18\x04 \x01(\fR\n" +
-	"subCommand\"\xd9\r\n" +
+	"subCommand\"\xc3\x0e\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x12\n" +
 	"\x0eTYPE_ADD_CLASS\x10\x01\x12\x15\n" +
 	"\x11TYPE_UPDATE_CLASS\x10\x02\x12\x15\n" +
 	"\x11TYPE_DELETE_CLASS\x10\x03\x12\x16\n" +
 	"\x12TYPE_RESTORE_CLASS\x10\x04\x12\x15\n" +
-	"\x11TYPE_ADD_PROPERTY\x10\x05\x12\x1c\n" +
+	"\x11TYPE_ADD_PROPERTY\x10\x05\x12\x18\n" +
+	"\x14TYPE_DELETE_PROPERTY\x10\x06\x12\x1d\n" +
+	"\x19TYPE_REINDEX_VECTOR_INDEX\x10\a\x12\x1c\n" +
 	"\x18TYPE_UPDATE_SHARD_STATUS\x10\n" +
 	"\x12\x1d\n" +
 	"\x19TYPE_ADD_REPLICA_TO_SHARD\x10\v\x12\"\n" +
@@ -1814,7 +1825,8 @@ const file_api_message_proto_rawDesc = "" +
 	"\x19TYPE_DISTRIBUTED_TASK_ADD\x10\xac\x02\x12!\n" +
 	"\x1cTYPE_DISTRIBUTED_TASK_CANCEL\x10\xad\x02\x120\n" +
 	"+TYPE_DISTRIBUTED_TASK_RECORD_NODE_COMPLETED\x10\xae\x02\x12#\n" +
-	"\x1eTYPE_DISTRIBUTED_TASK_CLEAN_UP\x10\xaf\x02\"\x04\bc\x10c\"A\n" +
+	"\x1eTYPE_DISTRIBUTED_TASK_CLEAN_UP\x10\xaf\x02\x12/\n" +
+	"*TYPE_DISTRIBUTED_TASK_RECORD_NODE_PROGRESS\x10\xb0\x02\"\x04\bc\x10c\"A\n" +
 	"\rApplyResponse\x12\x18\n" +
 	"\aversion\x18\x01 \x01(\x04R\aversion\x12\x16\n" +
 	"\x06leader\x18\x02 \x01(\tR\x06leader\"\x91\b\n" +
@@ -31,6 +31,15 @@ type AddPropertyRequest struct {
 	Properties []*models.Property
 }
 
+type DeletePropertyRequest struct {
+	Name string
+}
+
+type ReindexVectorIndexRequest struct {
+	TargetVector string
+	VectorConfig models.VectorConfig
+}
+
 type DeleteClassRequest struct {
 	Name string
 }
@@ -110,6 +110,40 @@ func (s *Raft) AddProperty(ctx context.Context, class string, props ...*models.P
 	return s.Execute(ctx, command)
 }
 
+func (s *Raft) DeleteProperty(ctx context.Context, class, property string) (uint64, error) {
+	if class == "" || property == "" {
+		return 0, fmt.Errorf("empty property or empty class name: %w", schema.ErrBadRequest)
+	}
+	req := cmd.DeletePropertyRequest{Name: property}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_DELETE_PROPERTY,
+		Class:      class,
+		SubCommand: subCommand,
+	}
+	return s.Execute(ctx, command)
+}
+
+func (s *Raft) ReindexVectorIndex(ctx context.Context, class, targetVector string, cfg models.VectorConfig) (uint64, error) {
+	if class == "" || targetVector == "" {
+		return 0, fmt.Errorf("empty target vector or empty class name: %w", schema.ErrBadRequest)
+	}
+	req := cmd.ReindexVectorIndexRequest{TargetVector: targetVector, VectorConfig: cfg}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_REINDEX_VECTOR_INDEX,
+		Class:      class,
+		SubCommand: subCommand,
+	}
+	return s.Execute(ctx, command)
+}
+
 func (s *Raft) AddReplicaToShard(ctx context.Context, class, shard, targetNode string) (uint64, error) {
 	if class == "" || shard == "" || targetNode == "" {
 		return 0, fmt.Errorf("empty class or shard or sourceNode or targetNode: %w", schema.ErrBadRequest)
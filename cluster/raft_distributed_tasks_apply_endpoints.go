@@ -77,6 +77,28 @@ func (s *Raft) recordDistributedTaskNodeCompletion(ctx context.Context, namespac
 	return nil
 }
 
+func (s *Raft) RecordDistributedTaskNodeProgress(ctx context.Context, namespace, taskID string, version uint64, progress float32) error {
+	req := cmd.RecordDistributedTaskNodeProgressRequest{
+		Namespace: namespace,
+		Id:        taskID,
+		Version:   version,
+		NodeId:    s.nodeSelector.LocalName(),
+		Progress:  progress,
+	}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_DISTRIBUTED_TASK_RECORD_NODE_PROGRESS,
+		SubCommand: subCommand,
+	}
+	if _, err = s.Execute(ctx, command); err != nil {
+		return fmt.Errorf("executing command: %w", err)
+	}
+	return nil
+}
+
 func (s *Raft) CancelDistributedTask(ctx context.Context, namespace, taskID string, taskVersion uint64) error {
 	req := cmd.CancelDistributedTaskRequest{
 		Namespace:             namespace,
@@ -258,6 +258,7 @@ func (s *SchemaManager) UpdateClass(cmd *command.ApplyRequest, nodeID string, sc
 		meta.Class.ReplicationConfig = u.ReplicationConfig
 		meta.Class.MultiTenancyConfig = u.MultiTenancyConfig
 		meta.Class.ObjectTTLConfig = u.ObjectTTLConfig
+		meta.Class.ObjectStorageConfig = u.ObjectStorageConfig
 		meta.Class.Description = u.Description
 		meta.Class.Properties = u.Properties
 		meta.ClassVersion = cmd.Version
@@ -350,6 +351,81 @@ func (s *SchemaManager) AddProperty(cmd *command.ApplyRequest, schemaOnly bool,
 	)
 }
 
+func (s *SchemaManager) DeleteProperty(cmd *command.ApplyRequest, schemaOnly bool, enableSchemaCallback bool) error {
+	req := command.DeletePropertyRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+	if req.Name == "" {
+		return fmt.Errorf("%w: empty property", ErrBadRequest)
+	}
+
+	return s.apply(
+		applyOp{
+			op:                   cmd.GetType().String(),
+			updateSchema:         func() error { return s.schema.deleteProperty(cmd.Class, cmd.Version, req.Name) },
+			updateStore:          func() error { return s.db.DeleteProperty(cmd.Class, req) },
+			schemaOnly:           schemaOnly,
+			enableSchemaCallback: enableSchemaCallback,
+		},
+	)
+}
+
+func (s *SchemaManager) ReindexVectorIndex(cmd *command.ApplyRequest, schemaOnly bool, enableSchemaCallback bool) error {
+	req := command.ReindexVectorIndexRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+	if req.TargetVector == "" {
+		return fmt.Errorf("%w: empty target vector", ErrBadRequest)
+	}
+
+	update := func(meta *metaClass) error {
+		current, ok := meta.Class.VectorConfig[req.TargetVector]
+		if !ok {
+			return fmt.Errorf("%w: target vector %q not found", ErrBadRequest, req.TargetVector)
+		}
+
+		// parse the new index config the same way as the one of a new class,
+		// the vectorizer of the target vector stays as it is
+		parsed := &models.Class{
+			Class:              meta.Class.Class,
+			MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+			VectorConfig: map[string]models.VectorConfig{req.TargetVector: {
+				Vectorizer:        current.Vectorizer,
+				VectorIndexType:   req.VectorConfig.VectorIndexType,
+				VectorIndexConfig: req.VectorConfig.VectorIndexConfig,
+			}},
+		}
+		if err := s.parser.ParseClass(parsed); err != nil {
+			return fmt.Errorf("%w: parse vector config: %w", ErrBadRequest, err)
+		}
+		req.VectorConfig = parsed.VectorConfig[req.TargetVector]
+
+		// replace the map instead of modifying it in place to prevent a race
+		// condition with concurrent readers
+		vectorConfig := make(map[string]models.VectorConfig, len(meta.Class.VectorConfig))
+		for name, cfg := range meta.Class.VectorConfig {
+			vectorConfig[name] = cfg
+		}
+		vectorConfig[req.TargetVector] = req.VectorConfig
+
+		meta.Class.VectorConfig = vectorConfig
+		meta.ClassVersion = cmd.Version
+		return nil
+	}
+
+	return s.apply(
+		applyOp{
+			op:                   cmd.GetType().String(),
+			updateSchema:         func() error { return s.schema.updateClass(cmd.Class, update) },
+			updateStore:          func() error { return s.db.ReindexVectorIndex(cmd.Class, req) },
+			schemaOnly:           schemaOnly,
+			enableSchemaCallback: enableSchemaCallback,
+		},
+	)
+}
+
 func (s *SchemaManager) UpdateShardStatus(cmd *command.ApplyRequest, schemaOnly bool) error {
 	req := command.UpdateShardStatusRequest{}
 	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
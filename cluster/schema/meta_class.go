@@ -162,6 +162,27 @@ func (m *metaClass) AddProperty(v uint64, props ...*models.Property) error {
 	return nil
 }
 
+func (m *metaClass) DeleteProperty(v uint64, name string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	// replace the slice instead of modifying it in place to prevent a race
+	// condition with concurrent readers
+	props := make([]*models.Property, 0, len(m.Class.Properties))
+	for _, prop := range m.Class.Properties {
+		if !strings.EqualFold(prop.Name, name) {
+			props = append(props, prop)
+		}
+	}
+	if len(props) == len(m.Class.Properties) {
+		return fmt.Errorf("property %q: %w", name, ErrPropertyNotFound)
+	}
+
+	m.Class.Properties = props
+	m.ClassVersion = v
+	return nil
+}
+
 func (m *metaClass) AddReplicaToShard(v uint64, shard string, replica string) error {
 	m.Lock()
 	defer m.Unlock()
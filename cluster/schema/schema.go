@@ -31,12 +31,13 @@ import (
 )
 
 var (
-	ErrClassExists   = errors.New("class already exists")
-	ErrClassNotFound = errors.New("class not found")
-	ErrShardNotFound = errors.New("shard not found")
-	ErrAliasExists   = errors.New("alias already exists")
-	ErrAliasNotFound = errors.New("alias not found")
-	ErrMTDisabled    = errors.New("multi-tenancy is not enabled")
+	ErrClassExists      = errors.New("class already exists")
+	ErrClassNotFound    = errors.New("class not found")
+	ErrPropertyNotFound = errors.New("property not found")
+	ErrShardNotFound    = errors.New("shard not found")
+	ErrAliasExists      = errors.New("alias already exists")
+	ErrAliasNotFound    = errors.New("alias not found")
+	ErrMTDisabled       = errors.New("multi-tenancy is not enabled")
 )
 
 type ClassInfo struct {
@@ -435,6 +436,17 @@ func (s *schema) addProperty(class string, v uint64, props ...*models.Property)
 	return meta.AddProperty(v, props...)
 }
 
+func (s *schema) deleteProperty(class string, v uint64, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	meta := s.unsafeResolveClass(class)
+	if meta == nil {
+		return ErrClassNotFound
+	}
+	return meta.DeleteProperty(v, name)
+}
+
 func (s *schema) addReplicaToShard(class string, v uint64, shard string, replica string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -217,6 +217,14 @@ func (st *Store) Apply(l *raft.Log) any {
 		f = func() {
 			ret.Error = st.schemaManager.AddProperty(&cmd, schemaOnly, !catchingUp)
 		}
+	case api.ApplyRequest_TYPE_DELETE_PROPERTY:
+		f = func() {
+			ret.Error = st.schemaManager.DeleteProperty(&cmd, schemaOnly, !catchingUp)
+		}
+	case api.ApplyRequest_TYPE_REINDEX_VECTOR_INDEX:
+		f = func() {
+			ret.Error = st.schemaManager.ReindexVectorIndex(&cmd, schemaOnly, !catchingUp)
+		}
 	case api.ApplyRequest_TYPE_CREATE_ALIAS:
 		f = func() {
 			ret.Error = st.schemaManager.CreateAlias(&cmd)
@@ -389,6 +397,10 @@ func (st *Store) Apply(l *raft.Log) any {
 		f = func() {
 			ret.Error = st.distributedTasksManager.RecordNodeCompletion(&cmd, st.numberOfNodesInTheCluster())
 		}
+	case api.ApplyRequest_TYPE_DISTRIBUTED_TASK_RECORD_NODE_PROGRESS:
+		f = func() {
+			ret.Error = st.distributedTasksManager.RecordNodeProgress(&cmd)
+		}
 	case api.ApplyRequest_TYPE_DISTRIBUTED_TASK_CANCEL:
 		f = func() {
 			ret.Error = st.distributedTasksManager.CancelTask(&cmd)
@@ -371,6 +371,130 @@ func TestStoreApply(t *testing.T) {
 				return nil
 			},
 		},
+		{
+			name: "DeleteProperty/Unmarshal",
+			req: raft.Log{Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_DELETE_PROPERTY,
+				nil, &cmd.AddTenantsRequest{})},
+			resp:     Response{Error: schema.ErrBadRequest},
+			doBefore: doFirst,
+		},
+		{
+			name: "DeleteProperty/ClassNotFound",
+			req: raft.Log{Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_DELETE_PROPERTY,
+				cmd.DeletePropertyRequest{Name: "P1"}, nil)},
+			resp:     Response{Error: schema.ErrSchema},
+			doBefore: doFirst,
+		},
+		{
+			name: "DeleteProperty/PropertyNotFound",
+			req: raft.Log{Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_DELETE_PROPERTY,
+				cmd.DeletePropertyRequest{Name: "P1"}, nil)},
+			resp: Response{Error: schema.ErrSchema},
+			doBefore: func(m *MockStore) {
+				doFirst(m)
+				m.indexer.On("AddClass", mock.Anything).Return(nil)
+				m.store.Apply(&raft.Log{
+					Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_ADD_CLASS, cmd.AddClassRequest{Class: cls, State: ss}, nil),
+				})
+			},
+		},
+		{
+			name: "DeleteProperty/Success",
+			req: raft.Log{Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_DELETE_PROPERTY,
+				cmd.DeletePropertyRequest{Name: "P1"}, nil)},
+			resp: Response{Error: nil},
+			doBefore: func(m *MockStore) {
+				doFirst(m)
+				m.indexer.On("AddClass", mock.Anything).Return(nil)
+				m.store.Apply(&raft.Log{
+					Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_ADD_CLASS, cmd.AddClassRequest{Class: cls, State: ss}, nil),
+				})
+				m.indexer.On("AddProperty", mock.Anything, mock.Anything).Return(nil)
+				m.indexer.On("TriggerSchemaUpdateCallbacks").Return()
+				m.store.Apply(&raft.Log{
+					Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_ADD_PROPERTY,
+						cmd.AddPropertyRequest{Properties: []*models.Property{{Name: "P1"}, {Name: "P2"}}}, nil),
+				})
+				m.indexer.On("DeleteProperty", "C1", cmd.DeletePropertyRequest{Name: "P1"}).Return(nil)
+			},
+			doAfter: func(ms *MockStore) error {
+				class := ms.store.SchemaReader().ReadOnlyClass("C1")
+				if class == nil {
+					return fmt.Errorf("class not found")
+				}
+				if len(class.Properties) != 1 || class.Properties[0].Name != "P2" {
+					return fmt.Errorf("unexpected properties after deletion: %v", class.Properties)
+				}
+				return nil
+			},
+		},
+		{
+			name: "ReindexVectorIndex/Unmarshal",
+			req: raft.Log{Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_REINDEX_VECTOR_INDEX,
+				nil, &cmd.AddTenantsRequest{})},
+			resp:     Response{Error: schema.ErrBadRequest},
+			doBefore: doFirst,
+		},
+		{
+			name: "ReindexVectorIndex/ClassNotFound",
+			req: raft.Log{Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_REINDEX_VECTOR_INDEX,
+				cmd.ReindexVectorIndexRequest{TargetVector: "v1"}, nil)},
+			resp:     Response{Error: schema.ErrSchema},
+			doBefore: doFirst,
+		},
+		{
+			name: "ReindexVectorIndex/TargetVectorNotFound",
+			req: raft.Log{Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_REINDEX_VECTOR_INDEX,
+				cmd.ReindexVectorIndexRequest{TargetVector: "v1"}, nil)},
+			resp: Response{Error: schema.ErrSchema},
+			doBefore: func(m *MockStore) {
+				doFirst(m)
+				m.indexer.On("AddClass", mock.Anything).Return(nil)
+				m.store.Apply(&raft.Log{
+					Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_ADD_CLASS, cmd.AddClassRequest{Class: cls, State: ss}, nil),
+				})
+			},
+		},
+		{
+			name: "ReindexVectorIndex/Success",
+			req: raft.Log{Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_REINDEX_VECTOR_INDEX,
+				cmd.ReindexVectorIndexRequest{
+					TargetVector: "v1",
+					VectorConfig: models.VectorConfig{VectorIndexType: "flat"},
+				}, nil)},
+			resp: Response{Error: nil},
+			doBefore: func(m *MockStore) {
+				doFirst(m)
+				m.indexer.On("AddClass", mock.Anything).Return(nil)
+				m.store.Apply(&raft.Log{
+					Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_ADD_CLASS, cmd.AddClassRequest{
+						Class: &models.Class{
+							Class:              "C1",
+							MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+							VectorConfig: map[string]models.VectorConfig{
+								"v1": {VectorIndexType: "hnsw"},
+								"v2": {VectorIndexType: "hnsw"},
+							},
+						},
+						State: ss,
+					}, nil),
+				})
+				m.indexer.On("ReindexVectorIndex", "C1", mock.Anything).Return(nil)
+			},
+			doAfter: func(ms *MockStore) error {
+				class := ms.store.SchemaReader().ReadOnlyClass("C1")
+				if class == nil {
+					return fmt.Errorf("class not found")
+				}
+				if got := class.VectorConfig["v1"].VectorIndexType; got != "flat" {
+					return fmt.Errorf("unexpected index type of v1 after reindex: %q", got)
+				}
+				if got := class.VectorConfig["v2"].VectorIndexType; got != "hnsw" {
+					return fmt.Errorf("unexpected index type of v2 after reindex: %q", got)
+				}
+				return nil
+			},
+		},
 		{
 			name: "UpdateShard/Unmarshal",
 			req: raft.Log{Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_UPDATE_SHARD_STATUS,
@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+)
+
+// dumpCommand prints the entries of a single segment or write-ahead-log, one
+// key per line. Logs don't contain the strategy and the number of secondary
+// indices of their bucket, both are read from the segments next to the log
+// unless given with --strategy and --secondary-indices.
+type dumpCommand struct {
+	MaxValueBytes    int    `long:"max-value-bytes" default:"64" description:"truncate keys and values longer than this, 0 prints them completely"`
+	Strategy         string `long:"strategy" description:"strategy of a write-ahead-log, read from the segments of its bucket or guessed if not given"`
+	SecondaryIndices int    `long:"secondary-indices" default:"-1" description:"number of secondary indices of a write-ahead-log of the replace strategy, read from the segments of its bucket if not given"`
+
+	Args struct {
+		File string `positional-arg-name:"file" required:"yes"`
+	} `positional-args:"yes"`
+}
+
+func (c *dumpCommand) Execute(args []string) error {
+	enc, err := newEncryptor()
+	if err != nil {
+		return err
+	}
+	logger := newLogger()
+
+	w := bufio.NewWriter(os.Stdout)
+	defer w.Flush()
+
+	path := c.Args.File
+	switch filepath.Ext(path) {
+	case ".db":
+		seg, err := lsmkv.OpenSegmentForInspection(path, enc, logger)
+		if err != nil {
+			return err
+		}
+		defer seg.Close()
+
+		info := seg.Info()
+		fmt.Fprintf(w, "# strategy=%s level=%d version=%d secondary_indices=%d compression=%s size=%s encrypted=%t\n",
+			info.Strategy, info.Level, info.Version, info.SecondaryIndices, info.Compression,
+			formatSize(info.Size), info.Encrypted)
+		return seg.Dump(func(e lsmkv.DumpEntry) error {
+			_, err := fmt.Fprintln(w, formatEntry(info.Strategy, e, c.MaxValueBytes))
+			return err
+		})
+
+	case ".wal":
+		b := bucketDir{path: filepath.Dir(path)}
+		if err := b.listFiles(); err != nil {
+			return err
+		}
+		strategy, secondaryIndices := b.walConfig(enc)
+		if c.Strategy != "" {
+			strategy = c.Strategy
+		}
+		if c.SecondaryIndices >= 0 {
+			secondaryIndices = uint16(c.SecondaryIndices)
+		}
+
+		wal, err := lsmkv.ReplayWAL(path, strategy, secondaryIndices, enc, logger)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "# strategy=%s size=%s\n", wal.Strategy, formatSize(wal.Size))
+		if err := wal.Dump(func(e lsmkv.DumpEntry) error {
+			_, err := fmt.Fprintln(w, formatEntry(wal.Strategy, e, c.MaxValueBytes))
+			return err
+		}); err != nil {
+			return err
+		}
+		if wal.Corruption != nil {
+			fmt.Fprintf(w, "# log ends with an incomplete or corrupt entry: %v\n", wal.Corruption)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("%q is neither a segment (.db) nor a write-ahead-log (.wal)", path)
+	}
+}
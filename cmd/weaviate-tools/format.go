@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+)
+
+func formatSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%dB", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
+
+// formatBytes prints printable text quoted and everything else as hex. Values
+// longer than maxLen are truncated, unless maxLen is 0.
+func formatBytes(b []byte, maxLen int) string {
+	suffix := ""
+	if maxLen > 0 && len(b) > maxLen {
+		suffix = fmt.Sprintf("...(%d bytes)", len(b))
+		b = b[:maxLen]
+	}
+
+	if isPrintable(b) {
+		return strconv.Quote(string(b)) + suffix
+	}
+	return "0x" + hex.EncodeToString(b) + suffix
+}
+
+func isPrintable(b []byte) bool {
+	if !utf8.Valid(b) {
+		return false
+	}
+	for _, r := range string(b) {
+		if !unicode.IsPrint(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func formatUint64s(values []uint64) string {
+	s := make([]string, len(values))
+	for i, v := range values {
+		s[i] = strconv.FormatUint(v, 10)
+	}
+	return "[" + strings.Join(s, " ") + "]"
+}
+
+// formatEntry prints an entry of a segment or write-ahead-log of the given
+// strategy, values of collections are printed in separate lines
+func formatEntry(strategy string, e lsmkv.DumpEntry, maxLen int) string {
+	var sb strings.Builder
+
+	switch strategy {
+	case lsmkv.StrategyReplace:
+		fmt.Fprintf(&sb, "key=%s", formatBytes(e.Key, maxLen))
+		for i, sk := range e.SecondaryKeys {
+			fmt.Fprintf(&sb, " secondary%d=%s", i, formatBytes(sk, maxLen))
+		}
+		if e.Tombstone {
+			sb.WriteString(" tombstone")
+		} else {
+			fmt.Fprintf(&sb, " value=%s", formatBytes(e.Value, maxLen))
+		}
+
+	case lsmkv.StrategySetCollection, lsmkv.StrategyMapCollection, lsmkv.StrategyInverted:
+		fmt.Fprintf(&sb, "key=%s values=%d", formatBytes(e.Key, maxLen), len(e.Pairs))
+		for _, p := range e.Pairs {
+			sb.WriteString("\n  ")
+			if strategy != lsmkv.StrategySetCollection {
+				fmt.Fprintf(&sb, "%s: ", formatBytes(p.Key, maxLen))
+			}
+			if p.Tombstone {
+				sb.WriteString("tombstone")
+			} else {
+				sb.WriteString(formatBytes(p.Value, maxLen))
+			}
+		}
+
+	case lsmkv.StrategyRoaringSet:
+		fmt.Fprintf(&sb, "key=%s additions=%s deletions=%s", formatBytes(e.Key, maxLen),
+			formatUint64s(e.Additions), formatUint64s(e.Deletions))
+
+	case lsmkv.StrategyRoaringSetRange:
+		fmt.Fprintf(&sb, "bit=%d additions=%s deletions=%s", e.Key[0],
+			formatUint64s(e.Additions), formatUint64s(e.Deletions))
+
+	default:
+		fmt.Fprintf(&sb, "key=%s", formatBytes(e.Key, maxLen))
+	}
+
+	return sb.String()
+}
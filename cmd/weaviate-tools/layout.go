@@ -0,0 +1,137 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/usecases/encryption"
+)
+
+// bucketDir is the directory of a bucket, which is located at
+// <data dir>/<collection>/<shard>/lsm/<bucket>
+type bucketDir struct {
+	path       string
+	collection string
+	shard      string
+	bucket     string
+	segments   []string
+	wals       []string
+}
+
+// relPath is the path of the bucket relative to the data directory
+func (b bucketDir) relPath() string {
+	return filepath.Join(b.collection, b.shard, "lsm", b.bucket)
+}
+
+// findBuckets returns the buckets below the given path, which can be the data
+// directory or the directory of a collection, shard or bucket
+func findBuckets(root string) ([]bucketDir, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+
+	var buckets []bucketDir
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() || filepath.Base(filepath.Dir(path)) != "lsm" {
+			return nil
+		}
+		if strings.HasPrefix(d.Name(), ".") {
+			// e.g. migrations
+			return filepath.SkipDir
+		}
+
+		shardDir := filepath.Dir(filepath.Dir(path))
+		bucket := bucketDir{
+			path:       path,
+			collection: filepath.Base(filepath.Dir(shardDir)),
+			shard:      filepath.Base(shardDir),
+			bucket:     d.Name(),
+		}
+		if err := bucket.listFiles(); err != nil {
+			return err
+		}
+		buckets = append(buckets, bucket)
+		return filepath.SkipDir
+	})
+	if err != nil {
+		return nil, err
+	}
+	return buckets, nil
+}
+
+func (b *bucketDir) listFiles() error {
+	entries, err := os.ReadDir(b.path)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		switch filepath.Ext(entry.Name()) {
+		case ".db":
+			b.segments = append(b.segments, filepath.Join(b.path, entry.Name()))
+		case ".wal":
+			b.wals = append(b.wals, filepath.Join(b.path, entry.Name()))
+		}
+	}
+	// segments are named by their creation time
+	sort.Strings(b.segments)
+	sort.Strings(b.wals)
+	return nil
+}
+
+// readSegmentHeader reads the header of a segment without loading it
+func readSegmentHeader(path string, enc *encryption.Encryptor) (*segmentindex.Header, error) {
+	f, err := enc.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	buf := make([]byte, segmentindex.HeaderSize)
+	if _, err := io.ReadFull(f, buf); err != nil {
+		return nil, fmt.Errorf("read header of %q: %w", path, err)
+	}
+	return segmentindex.ParseHeader(buf)
+}
+
+// walConfig returns the strategy and the number of secondary indices of the
+// write-ahead-logs of the bucket, which are read from its segments. The
+// strategy is empty if the bucket has no readable segment.
+func (b bucketDir) walConfig(enc *encryption.Encryptor) (string, uint16) {
+	for _, path := range b.segments {
+		header, err := readSegmentHeader(path, enc)
+		if err != nil {
+			continue
+		}
+		return header.Strategy.String(), header.SecondaryIndices
+	}
+	return "", 0
+}
+
+func fileSize(path string) int64 {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0
+	}
+	return info.Size()
+}
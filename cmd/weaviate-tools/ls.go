@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+
+	"github.com/weaviate/weaviate/usecases/encryption"
+)
+
+// lsCommand lists the buckets below the paths. The strategy of a bucket is
+// read from the header of its first readable segment.
+type lsCommand struct {
+	Segments bool `short:"s" long:"segments" description:"list the segments and write-ahead-logs of every bucket"`
+
+	Args struct {
+		Paths []string `positional-arg-name:"path"`
+	} `positional-args:"yes"`
+}
+
+func (c *lsCommand) Execute(args []string) error {
+	enc, err := newEncryptor()
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	defer w.Flush()
+
+	if c.Segments {
+		fmt.Fprintln(w, "COLLECTION\tSHARD\tBUCKET\tFILE\tSTRATEGY\tLEVEL\tVERSION\tSIZE")
+	} else {
+		fmt.Fprintln(w, "COLLECTION\tSHARD\tBUCKET\tSTRATEGY\tSEGMENTS\tWALS\tSIZE")
+	}
+
+	for _, path := range pathsOrDataDir(c.Args.Paths) {
+		buckets, err := findBuckets(path)
+		if err != nil {
+			return err
+		}
+
+		for _, b := range buckets {
+			if c.Segments {
+				c.printSegments(w, b, enc)
+				continue
+			}
+
+			strategy, _ := b.walConfig(enc)
+			if strategy == "" {
+				strategy = "-"
+			}
+			var size int64
+			for _, p := range b.segments {
+				size += fileSize(p)
+			}
+			for _, p := range b.wals {
+				size += fileSize(p)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%d\t%s\n", b.collection, b.shard, b.bucket,
+				strategy, len(b.segments), len(b.wals), formatSize(size))
+		}
+	}
+	return nil
+}
+
+func (c *lsCommand) printSegments(w *tabwriter.Writer, b bucketDir, enc *encryption.Encryptor) {
+	for _, path := range b.segments {
+		strategy, level, version := "?", "?", "?"
+		if header, err := readSegmentHeader(path, enc); err == nil {
+			strategy = header.Strategy.String()
+			level = fmt.Sprint(header.Level)
+			version = fmt.Sprint(header.Version)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", b.collection, b.shard, b.bucket,
+			filepath.Base(path), strategy, level, version, formatSize(fileSize(path)))
+	}
+	for _, path := range b.wals {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", b.collection, b.shard, b.bucket,
+			filepath.Base(path), "-", "-", "-", formatSize(fileSize(path)))
+	}
+}
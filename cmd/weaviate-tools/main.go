@@ -0,0 +1,142 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// weaviate-tools inspects and repairs the LSM stores in the data directory of
+// a node which is not running.
+//
+// The commands read the files directly with the segment and write-ahead-log
+// code of the lsmkv package, without loading the buckets, so they see the
+// files the same way a node does on startup:
+//
+//	ls          lists the buckets and, with -s, their segments and logs
+//	verify      validates the segment checksums and the persisted bloom filters
+//	dump        prints the keys and values of a segment or write-ahead-log
+//	wal         replays the write-ahead-logs and reports corrupt ones
+//	quarantine  moves segments out of their bucket
+//
+// Paths can be the data directory or the directory of a collection, shard or
+// bucket, the data directory is used if none is given. It is set with
+// --data-dir or PERSISTENCE_DATA_PATH. Encrypted files are read with the keys
+// of --keyfile or ENCRYPTION_AT_REST_LOCAL_KEYFILE, only the local key
+// provider is supported. verify and wal exit with 1 if they find corrupt
+// files.
+//
+// Only quarantine and verify with --quarantine-dir change the data
+// directory, all other commands are read-only. Limits:
+//   - the node must not be running, the files are not locked and the
+//     results are undefined while a node writes to them
+//   - only the LSM buckets of the shards are inspected, not the vector index
+//     commit logs, the async indexing queues or the schema
+//   - the write-ahead-logs are replayed into memory, as on startup
+//   - bloom filters are only verified if they are persisted, missing ones are
+//     built by the node on startup. Segments of version 0 have no checksum and
+//     are only checked for a readable structure
+//   - a quarantined segment is no longer part of its bucket. Its keys and
+//     tombstones are gone, i.e. older values of the keys in other segments of
+//     replace and collection buckets become visible again and deleted objects
+//     may reappear. Moving the files back restores the bucket.
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	flags "github.com/jessevdk/go-flags"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/usecases/encryption"
+)
+
+type options struct {
+	DataDir string `long:"data-dir" env:"PERSISTENCE_DATA_PATH" default:"./data" description:"data directory of the node, used if no path is given"`
+	Keyfile string `long:"keyfile" env:"ENCRYPTION_AT_REST_LOCAL_KEYFILE" description:"keyfile of the local key provider, required if the files are encrypted at rest"`
+	Verbose bool   `short:"v" long:"verbose" description:"log the details of loading segments"`
+}
+
+var opts options
+
+func main() {
+	parser := flags.NewParser(&opts, flags.Default)
+	parser.ShortDescription = "Weaviate offline tools"
+	parser.LongDescription = "Inspects and repairs the LSM stores in the data directory of a node. " +
+		"The node must not be running."
+
+	commands := []struct {
+		name, short, long string
+		data              any
+	}{
+		{"ls", "List collections, shards, buckets and segments",
+			"Lists the buckets of all shards below the given paths, which can be the data " +
+				"directory or the directory of a collection, shard or bucket.", &lsCommand{}},
+		{"verify", "Verify segment checksums and bloom filters",
+			"Validates the checksums of all segments below the given paths and checks that " +
+				"their bloom filters contain all keys. Corrupt segments are moved to the " +
+				"quarantine directory if one is given.", &verifyCommand{}},
+		{"dump", "Dump the keys and values of a segment or write-ahead-log",
+			"Prints the keys and values of a single segment (.db) or write-ahead-log (.wal) " +
+				"in a human-readable form.", &dumpCommand{}},
+		{"wal", "Replay and validate write-ahead-logs",
+			"Replays all write-ahead-logs below the given paths the same way a bucket " +
+				"recovers from them on startup and reports incomplete or corrupt logs.", &walCommand{}},
+		{"quarantine", "Move segments out of their bucket",
+			"Moves the given segments together with their bloom filters and metadata into " +
+				"the quarantine directory, so that their buckets are loaded without them.", &quarantineCommand{}},
+	}
+	for _, c := range commands {
+		if _, err := parser.AddCommand(c.name, c.short, c.long, c.data); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
+	// errors, including the ones returned by the commands, are printed by the
+	// parser
+	if _, err := parser.Parse(); err != nil {
+		code := 1
+		var fe *flags.Error
+		if errors.As(err, &fe) && fe.Type == flags.ErrHelp {
+			code = 0
+		}
+		os.Exit(code)
+	}
+}
+
+func newLogger() logrus.FieldLogger {
+	logger := logrus.New()
+	logger.SetOutput(os.Stderr)
+	logger.SetLevel(logrus.WarnLevel)
+	if opts.Verbose {
+		logger.SetLevel(logrus.DebugLevel)
+	}
+	return logger
+}
+
+// newEncryptor returns nil if no keyfile is configured, plaintext files can
+// be read either way
+func newEncryptor() (*encryption.Encryptor, error) {
+	if opts.Keyfile == "" {
+		return nil, nil
+	}
+	provider, err := encryption.NewLocalKeyProvider(opts.Keyfile)
+	if err != nil {
+		return nil, fmt.Errorf("load keyfile: %w", err)
+	}
+	return encryption.New(provider), nil
+}
+
+// pathsOrDataDir returns the data directory if no paths are given
+func pathsOrDataDir(paths []string) []string {
+	if len(paths) == 0 {
+		return []string{opts.DataDir}
+	}
+	return paths
+}
@@ -0,0 +1,61 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+)
+
+// quarantineCommand moves the given segments with their bloom filters and
+// metadata into the quarantine directory, see [lsmkv.QuarantineSegment]
+type quarantineCommand struct {
+	QuarantineDir string `long:"quarantine-dir" required:"yes" description:"directory the segments are moved into, below <collection>/<shard>/lsm/<bucket>"`
+
+	Args struct {
+		Segments []string `positional-arg-name:"segment" required:"1"`
+	} `positional-args:"yes"`
+}
+
+func (c *quarantineCommand) Execute(args []string) error {
+	for _, path := range c.Args.Segments {
+		path, err := filepath.Abs(path)
+		if err != nil {
+			return err
+		}
+
+		bucketPath := filepath.Dir(path)
+		shardPath := filepath.Dir(filepath.Dir(bucketPath))
+		b := bucketDir{
+			collection: filepath.Base(filepath.Dir(shardPath)),
+			shard:      filepath.Base(shardPath),
+			bucket:     filepath.Base(bucketPath),
+		}
+		if err := quarantine(path, filepath.Join(c.QuarantineDir, b.relPath())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func quarantine(path, dir string) error {
+	moved, err := lsmkv.QuarantineSegment(path, dir)
+	for _, p := range moved {
+		fmt.Printf("moved %s to %s\n", filepath.Base(p), dir)
+	}
+	if err != nil {
+		return fmt.Errorf("quarantine %q: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+)
+
+// verifyCommand opens every segment to validate its checksum and the keys of
+// its persisted bloom filters. Segments which can't be opened are corrupt and
+// moved to the quarantine directory if one is given, segments with invalid
+// bloom filters are reported only, as the node rebuilds missing filters.
+type verifyCommand struct {
+	QuarantineDir string `long:"quarantine-dir" description:"move corrupt segments into this directory, below <collection>/<shard>/lsm/<bucket>"`
+
+	Args struct {
+		Paths []string `positional-arg-name:"path"`
+	} `positional-args:"yes"`
+}
+
+func (c *verifyCommand) Execute(args []string) error {
+	enc, err := newEncryptor()
+	if err != nil {
+		return err
+	}
+	logger := newLogger()
+
+	var verified, corrupt, invalidBloomFilters int
+	for _, path := range pathsOrDataDir(c.Args.Paths) {
+		buckets, err := findBuckets(path)
+		if err != nil {
+			return err
+		}
+
+		for _, b := range buckets {
+			for _, segPath := range b.segments {
+				name := filepath.Join(b.relPath(), filepath.Base(segPath))
+				verified++
+
+				seg, err := lsmkv.OpenSegmentForInspection(segPath, enc, logger)
+				if errors.Is(err, lsmkv.ErrNoKeyProvider) {
+					// the segment may be intact, it must not be quarantined
+					return fmt.Errorf("%w, set --keyfile", err)
+				}
+				if err != nil {
+					corrupt++
+					fmt.Printf("CORRUPT  %s: %v\n", name, err)
+					if c.QuarantineDir != "" {
+						if err := quarantine(segPath, filepath.Join(c.QuarantineDir, b.relPath())); err != nil {
+							return err
+						}
+					}
+					continue
+				}
+
+				checksumValidated := seg.Info().ChecksumValidated
+				found, err := seg.VerifyBloomFilters()
+				seg.Close()
+				if err != nil {
+					// the segment itself is intact, so it is not quarantined
+					invalidBloomFilters++
+					fmt.Printf("BLOOM    %s: %v\n", name, err)
+					continue
+				}
+
+				var notes []string
+				if !checksumValidated {
+					notes = append(notes, "no checksum")
+				}
+				if !found {
+					notes = append(notes, "no bloom filter")
+				}
+				if len(notes) > 0 {
+					fmt.Printf("OK       %s (%s)\n", name, strings.Join(notes, ", "))
+				} else {
+					fmt.Printf("OK       %s\n", name)
+				}
+			}
+		}
+	}
+
+	fmt.Printf("\nverified %d segments: %d corrupt, %d with invalid bloom filters\n",
+		verified, corrupt, invalidBloomFilters)
+	if corrupt > 0 || invalidBloomFilters > 0 {
+		return fmt.Errorf("verification failed")
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+)
+
+// walCommand replays the write-ahead-logs below the paths. A corrupt or
+// incomplete last entry is reported, the entries before it are recovered by
+// the node on startup and everything behind it is lost.
+type walCommand struct {
+	Args struct {
+		Paths []string `positional-arg-name:"path"`
+	} `positional-args:"yes"`
+}
+
+func (c *walCommand) Execute(args []string) error {
+	enc, err := newEncryptor()
+	if err != nil {
+		return err
+	}
+	logger := newLogger()
+
+	var replayed, failed int
+	for _, path := range pathsOrDataDir(c.Args.Paths) {
+		buckets, err := findBuckets(path)
+		if err != nil {
+			return err
+		}
+
+		for _, b := range buckets {
+			strategy, secondaryIndices := b.walConfig(enc)
+			for _, walPath := range b.wals {
+				name := filepath.Join(b.relPath(), filepath.Base(walPath))
+				replayed++
+
+				if fileSize(walPath) == 0 {
+					// empty logs are removed on startup
+					fmt.Printf("OK       %s (empty)\n", name)
+					continue
+				}
+
+				wal, err := lsmkv.ReplayWAL(walPath, strategy, secondaryIndices, enc, logger)
+				if err != nil {
+					failed++
+					fmt.Printf("FAILED   %s: %v\n", name, err)
+					continue
+				}
+
+				keys := 0
+				wal.Dump(func(lsmkv.DumpEntry) error {
+					keys++
+					return nil
+				})
+
+				if wal.Corruption != nil {
+					// the entries before are recovered on startup, the rest is lost
+					failed++
+					fmt.Printf("CORRUPT  %s: %d keys recovered, %v\n", name, keys, wal.Corruption)
+					continue
+				}
+				fmt.Printf("OK       %s (%s, %d keys, %s)\n", name, wal.Strategy, keys, formatSize(wal.Size))
+			}
+		}
+	}
+
+	fmt.Printf("\nreplayed %d write-ahead-logs: %d incomplete or corrupt\n", replayed, failed)
+	if failed > 0 {
+		return fmt.Errorf("validation failed")
+	}
+	return nil
+}
@@ -36,6 +36,9 @@ type Properties struct {
 	ExplainScore       bool                   `json:"explainScore"`
 	IsConsistent       bool                   `json:"isConsistent"`
 	Group              bool                   `json:"group"`
+	Highlights         *Highlights            `json:"highlights"`
+	Facets             []Facet                `json:"facets"`
+	QueryProfile       bool                   `json:"queryProfile"`
 
 	// The User is not interested in returning props, we can skip any costly
 	// operation that isn't required.
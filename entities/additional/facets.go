@@ -0,0 +1,50 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package additional
+
+// DefaultFacetLimit is the number of values of a facet if no limit is set
+const DefaultFacetLimit = 10
+
+// Facet selects a property of which the value counts are returned. All
+// results of a vector, bm25 or hybrid search are counted, independent of the
+// limit and offset and up to the maximum number of results of a query,
+// otherwise all objects matching the where filter. If ranges are set, the
+// values of a numerical property are counted per range instead.
+type Facet struct {
+	Property string       `json:"property"`
+	Limit    int          `json:"limit"`
+	Ranges   []FacetRange `json:"ranges"`
+}
+
+// FacetRange includes From and excludes To, a nil bound leaves the range open
+// on that side
+type FacetRange struct {
+	From *float64 `json:"from"`
+	To   *float64 `json:"to"`
+}
+
+type FacetResult struct {
+	Property string            `json:"property"`
+	Values   []FacetValue      `json:"values"`
+	Ranges   []FacetRangeCount `json:"ranges"`
+}
+
+type FacetValue struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+type FacetRangeCount struct {
+	From  *float64 `json:"from"`
+	To    *float64 `json:"to"`
+	Count int      `json:"count"`
+}
@@ -0,0 +1,49 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package additional
+
+// DefaultHighlightsFragmentSize is the number of characters of a fragment if
+// no fragment size is set
+const DefaultHighlightsFragmentSize = 100
+
+// Highlights selects the properties of which the fragments containing terms
+// of a bm25 or hybrid query are returned
+type Highlights struct {
+	Properties   []string `json:"properties"`
+	FragmentSize int      `json:"fragmentSize"`
+}
+
+// Highlight holds the fragments of a property value containing query terms.
+// The fragments are HTML-escaped and the terms are enclosed in <em> tags.
+type Highlight struct {
+	Property  string   `json:"property"`
+	Fragments []string `json:"fragments"`
+}
+
+// HighlightTerms are the terms a bm25 search looked up, after its query was
+// expanded. The searcher adds them to its results if highlights are
+// requested, so that the highlights mark the fuzzy matches and phrases which
+// were scored rather than only the terms of the raw query.
+type HighlightTerms struct {
+	// Terms are the query terms per tokenization, without stopwords and
+	// including the terms fuzzy query terms were expanded to
+	Terms map[string][]string `json:"terms"`
+	// Phrases are the quoted phrases of the query
+	Phrases []HighlightPhrase `json:"phrases"`
+}
+
+// HighlightPhrase is a quoted phrase of a bm25 query, allowing up to Slop
+// other terms between its terms
+type HighlightPhrase struct {
+	Text string `json:"text"`
+	Slop int    `json:"slop"`
+}
@@ -0,0 +1,30 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package additional
+
+const (
+	FilterStrategyFlat     = "flat"
+	FilterStrategyAcorn    = "acorn"
+	FilterStrategySweeping = "sweeping"
+)
+
+// VectorSearchProfile describes how a shard searched the vector index of a
+// target vector with the allow list of a filter, and why it chose to do so.
+type VectorSearchProfile struct {
+	Shard          string  `json:"shard"`
+	TargetVector   string  `json:"targetVector"`
+	FilterStrategy string  `json:"filterStrategy"`
+	Reason         string  `json:"reason"`
+	Selectivity    float64 `json:"selectivity"`
+	AllowListSize  int     `json:"allowListSize"`
+	IndexSize      int     `json:"indexSize"`
+}
@@ -14,6 +14,8 @@ package aggregation
 import (
 	"encoding/json"
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
@@ -37,6 +39,7 @@ type Params struct {
 	NearVector       *searchparams.NearVector   `json:"nearVector"`
 	NearObject       *searchparams.NearObject   `json:"nearObject"`
 	Hybrid           *searchparams.HybridSearch `json:"hybrid"`
+	Snapshot         string                     `json:"snapshot,omitempty"` // id of the point-in-time snapshot the shards are read from
 }
 
 func (p *Params) UnmarshalJSON(data []byte) error {
@@ -83,8 +86,28 @@ type ParamProperty struct {
 }
 
 type Aggregator struct {
-	Type  string `json:"type"`
-	Limit *int   `json:"limit"` // used on TopOccurrence Agg
+	Type      string     `json:"type"`
+	Limit     *int       `json:"limit"`               // used on TopOccurrence Agg
+	Ranges    *Ranges    `json:"ranges,omitempty"`    // used on Ranges Agg
+	Histogram *Histogram `json:"histogram,omitempty"` // used on Histogram and DateHistogram Agg
+}
+
+// Ranges are the buckets of a Ranges Agg. It is referenced by pointer, so
+// that aggregators stay comparable.
+type Ranges struct {
+	Buckets []Range `json:"buckets"`
+}
+
+// Range is a numeric bucket including From and excluding To. A nil bound
+// leaves the bucket open on that side.
+type Range struct {
+	From *float64 `json:"from"`
+	To   *float64 `json:"to"`
+}
+
+// Contains indicates whether the value falls into the range
+func (r Range) Contains(value float64) bool {
+	return (r.From == nil || value >= *r.From) && (r.To == nil || value < *r.To)
 }
 
 func (a Aggregator) String() string {
@@ -123,6 +146,86 @@ func NewTopOccurrencesAggregator(limit *int) Aggregator {
 	return Aggregator{Type: TopOccurrencesType, Limit: limit}
 }
 
+const RangesType = "ranges"
+
+// NewRangesAggregator creates an aggregator counting the values of a
+// numerical prop per range
+func NewRangesAggregator(ranges []Range) Aggregator {
+	return Aggregator{Type: RangesType, Ranges: &Ranges{Buckets: ranges}}
+}
+
+const (
+	HistogramType     = "histogram"
+	DateHistogramType = "dateHistogram"
+)
+
+// Calendar intervals of a DateHistogram Agg
+const (
+	CalendarIntervalHour  = "hour"
+	CalendarIntervalDay   = "day"
+	CalendarIntervalWeek  = "week"
+	CalendarIntervalMonth = "month"
+)
+
+// Histogram configures the buckets of a Histogram or DateHistogram Agg. It is
+// referenced by pointer, so that aggregators stay comparable.
+type Histogram struct {
+	// Interval is the width of the buckets of a numerical prop
+	Interval float64 `json:"interval,omitempty"`
+	// CalendarInterval is the unit of the buckets of a date prop
+	CalendarInterval string `json:"calendarInterval,omitempty"`
+	// TimeZone is the IANA name of the time zone in which date buckets start,
+	// defaults to UTC
+	TimeZone string `json:"timeZone,omitempty"`
+	// Metrics are numerical props which are aggregated per bucket
+	Metrics []schema.PropertyName `json:"metrics,omitempty"`
+}
+
+// NewHistogramAggregator creates an aggregator counting the objects per
+// fixed-interval bucket of a numerical prop
+func NewHistogramAggregator(interval float64, metrics []schema.PropertyName) Aggregator {
+	return Aggregator{Type: HistogramType, Histogram: &Histogram{Interval: interval, Metrics: metrics}}
+}
+
+// NewDateHistogramAggregator creates an aggregator counting the objects per
+// calendar-interval bucket of a date prop
+func NewDateHistogramAggregator(calendarInterval, timeZone string, metrics []schema.PropertyName) Aggregator {
+	return Aggregator{Type: DateHistogramType, Histogram: &Histogram{
+		CalendarInterval: calendarInterval,
+		TimeZone:         timeZone,
+		Metrics:          metrics,
+	}}
+}
+
+// ValidateHistogram checks the interval and time zone of a Histogram or DateHistogram
+// Agg
+func (a Aggregator) ValidateHistogram() error {
+	h := a.Histogram
+	if h == nil {
+		return fmt.Errorf("%s: no interval set", a.Type)
+	}
+
+	switch a.Type {
+	case HistogramType:
+		if h.Interval <= 0 || math.IsInf(h.Interval, 0) || math.IsNaN(h.Interval) {
+			return fmt.Errorf("histogram: interval must be a positive number, got %v", h.Interval)
+		}
+	case DateHistogramType:
+		switch h.CalendarInterval {
+		case CalendarIntervalHour, CalendarIntervalDay, CalendarIntervalWeek, CalendarIntervalMonth:
+		default:
+			return fmt.Errorf("dateHistogram: interval must be one of %q, %q, %q or %q, got %q",
+				CalendarIntervalHour, CalendarIntervalDay, CalendarIntervalWeek, CalendarIntervalMonth, h.CalendarInterval)
+		}
+		if _, err := time.LoadLocation(h.TimeZone); err != nil {
+			return fmt.Errorf("dateHistogram: invalid time zone %q: %w", h.TimeZone, err)
+		}
+	default:
+		return fmt.Errorf("aggregator %s is not a histogram", a.Type)
+	}
+	return nil
+}
+
 // Aggregators used in ref props
 var (
 	PointingToAggregator = Aggregator{Type: "pointingTo"}
@@ -164,6 +267,12 @@ func ParseAggregatorProp(name string) (Aggregator, error) {
 	case TopOccurrencesType:
 		return NewTopOccurrencesAggregator(ptInt(5)), nil // default to limit 5, can be overwritten
 
+	// histograms, the buckets are set from the arguments of the prop
+	case HistogramType:
+		return Aggregator{Type: HistogramType}, nil
+	case DateHistogramType:
+		return Aggregator{Type: DateHistogramType}, nil
+
 	// ref
 	case PointingToAggregator.String():
 		return PointingToAggregator, nil
@@ -29,6 +29,12 @@ type Property struct {
 	SchemaType            string                 `json:"schemaType"`
 	ReferenceAggregation  Reference              `json:"referenceAggregation"`
 	DateAggregations      map[string]interface{} `json:"dateAggregation"`
+
+	// TopOccurrences are the most frequent values of numerical and date
+	// props, text props use TextAggregation instead
+	TopOccurrences []Occurrence      `json:"topOccurrences,omitempty"`
+	RangeCounts    []RangeCount      `json:"rangeCounts,omitempty"`
+	Histogram      []HistogramBucket `json:"histogram,omitempty"`
 }
 
 type Text struct {
@@ -56,6 +62,70 @@ type TextOccurrence struct {
 	Occurs int    `json:"occurs"`
 }
 
+// Occurrence is the number of times a value of a numerical (float64) or date
+// (RFC3339 string) prop occurs
+type Occurrence struct {
+	Value  interface{} `json:"value"`
+	Occurs int         `json:"occurs"`
+}
+
+type RangeCount struct {
+	Range
+	Count int `json:"count"`
+}
+
+// HistogramBucket holds the number of objects with a value in the bucket. The
+// Key is the lower bound (float64) of a numerical bucket or the start (RFC3339
+// string) of a date bucket.
+type HistogramBucket struct {
+	Key     interface{}                 `json:"key"`
+	Count   int                         `json:"count"`
+	Metrics map[string]HistogramMetrics `json:"metrics,omitempty"`
+}
+
+// HistogramMetrics aggregates the values of a numerical prop of the objects
+// in a bucket
+type HistogramMetrics struct {
+	Count   int     `json:"count"`
+	Sum     float64 `json:"sum"`
+	Minimum float64 `json:"minimum"`
+	Maximum float64 `json:"maximum"`
+}
+
+// Add includes a value in the metrics
+func (m *HistogramMetrics) Add(value float64) {
+	if m.Count == 0 || value < m.Minimum {
+		m.Minimum = value
+	}
+	if m.Count == 0 || value > m.Maximum {
+		m.Maximum = value
+	}
+	m.Count++
+	m.Sum += value
+}
+
+// Merge combines the metrics of the same bucket of another shard
+func (m *HistogramMetrics) Merge(other HistogramMetrics) {
+	if other.Count == 0 {
+		return
+	}
+	if m.Count == 0 || other.Minimum < m.Minimum {
+		m.Minimum = other.Minimum
+	}
+	if m.Count == 0 || other.Maximum > m.Maximum {
+		m.Maximum = other.Maximum
+	}
+	m.Count += other.Count
+	m.Sum += other.Sum
+}
+
+func (m HistogramMetrics) Mean() float64 {
+	if m.Count == 0 {
+		return 0
+	}
+	return m.Sum / float64(m.Count)
+}
+
 type Boolean struct {
 	Count           int     `json:"count"`
 	TotalTrue       int     `json:"totalTrue"`
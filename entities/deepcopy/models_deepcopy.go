@@ -96,6 +96,7 @@ func InvertedIndexConfig(i *models.InvertedIndexConfig) *models.InvertedIndexCon
 		Bm25:                   bm25,
 		CleanupIntervalSeconds: i.CleanupIntervalSeconds,
 		IndexNullState:         i.IndexNullState,
+		IndexPositions:         i.IndexPositions,
 		IndexPropertyLength:    i.IndexPropertyLength,
 		IndexTimestamps:        i.IndexTimestamps,
 		Stopwords:              stopwords,
@@ -66,6 +66,8 @@ type GetParams struct {
 	Tenant                  string
 	IsRefOrigin             bool   // is created by ref filter
 	Alias                   string // used only to transfer alias passed in search request, not used for actual search
+	MultivectorAggregation  string // overrides the configured aggregation of multi vector distances
+	Snapshot                string // id of the point-in-time snapshot the shards are read from
 }
 
 type Embedding interface {
@@ -82,6 +84,8 @@ func IsVectorEmpty(vector models.Vector) (bool, error) {
 		return len(v) == 0, nil
 	case [][]float32:
 		return len(v) == 0, nil
+	case *models.SparseVector:
+		return v == nil || len(v.Indices) == 0, nil
 	default:
 		return false, fmt.Errorf("unrecognized vector type: %T", vector)
 	}
@@ -150,6 +154,9 @@ func GetVectors(in models.Vectors) (map[string][]float32, map[string][][]float32
 					multiVectors = make(map[string][][]float32)
 				}
 				multiVectors[targetVector] = vec
+			case *models.SparseVector:
+				// sparse vectors stay on the object and are picked up by storobj.FromObject
+				continue
 			default:
 				return nil, nil, fmt.Errorf("unrecognized vector type: %T for target vector: %s", vector, targetVector)
 			}
@@ -0,0 +1,80 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dto
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// MaxReadSnapshotIDLength limits the length of the client chosen ids of read
+// snapshots
+const MaxReadSnapshotIDLength = 128
+
+type readSnapshotKey struct{}
+
+// ContextWithReadSnapshot makes the shards searched with the context read
+// from the point-in-time snapshot with the given key, see ReadSnapshotKey. A
+// shard creates the snapshot when it is first read with the key and keeps it
+// until it has not been read for a while.
+func ContextWithReadSnapshot(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, readSnapshotKey{}, key)
+}
+
+// ReadSnapshotFromContext returns the key set with ContextWithReadSnapshot, if
+// any
+func ReadSnapshotFromContext(ctx context.Context) (string, bool) {
+	key, ok := ctx.Value(readSnapshotKey{}).(string)
+	return key, ok && key != ""
+}
+
+// ReadSnapshotKey scopes the client chosen id of a read snapshot to the
+// principal, so that clients can't read the snapshots of others by guessing
+// their ids. The owner is encoded with its length, so that it is parsed back
+// unambiguously by ParseReadSnapshotKey.
+func ReadSnapshotKey(principal *models.Principal, id string) string {
+	var owner string
+	if principal != nil {
+		owner = principal.Username
+	}
+	return fmt.Sprintf("%d:%s:%s", len(owner), owner, id)
+}
+
+// ParseReadSnapshotKey returns the owner and the client chosen id of a key
+// created with ReadSnapshotKey. Keys which were not created with it are
+// owned by the anonymous principal.
+func ParseReadSnapshotKey(key string) (owner, id string) {
+	length, rest, ok := strings.Cut(key, ":")
+	if !ok {
+		return "", key
+	}
+	n, err := strconv.Atoi(length)
+	if err != nil || n < 0 || n >= len(rest) || rest[n] != ':' {
+		return "", key
+	}
+	return rest[:n], rest[n+1:]
+}
+
+// ValidateReadSnapshotID checks a client chosen id of a read snapshot
+func ValidateReadSnapshotID(id string) error {
+	if id == "" {
+		return fmt.Errorf("snapshot id must not be empty")
+	}
+	if len(id) > MaxReadSnapshotIDLength {
+		return fmt.Errorf("snapshot id must not be longer than %d characters", MaxReadSnapshotIDLength)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestReadSnapshotKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal *models.Principal
+		id        string
+		owner     string
+	}{
+		{name: "anonymous", principal: nil, id: "export", owner: ""},
+		{name: "user", principal: &models.Principal{Username: "alice"}, id: "export", owner: "alice"},
+		{name: "separators", principal: &models.Principal{Username: "a:1:b"}, id: "3:c:d", owner: "a:1:b"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner, id := ParseReadSnapshotKey(ReadSnapshotKey(tt.principal, tt.id))
+			assert.Equal(t, tt.owner, owner)
+			assert.Equal(t, tt.id, id)
+		})
+	}
+
+	t.Run("keys of different users differ", func(t *testing.T) {
+		assert.NotEqual(t,
+			ReadSnapshotKey(&models.Principal{Username: "alice"}, "export"),
+			ReadSnapshotKey(&models.Principal{Username: "bob"}, "export"))
+	})
+
+	t.Run("unscoped keys are owned by the anonymous principal", func(t *testing.T) {
+		for _, key := range []string{"export", "x:export", "9:short:id"} {
+			owner, id := ParseReadSnapshotKey(key)
+			assert.Equal(t, "", owner)
+			assert.Equal(t, key, id)
+		}
+	})
+}
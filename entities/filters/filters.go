@@ -36,6 +36,8 @@ const (
 	ContainsAll
 	ContainsNone
 	OperatorNot
+	OperatorRegex
+	OperatorStartsWith
 )
 
 func (o Operator) OnValue() bool {
@@ -51,7 +53,9 @@ func (o Operator) OnValue() bool {
 		OperatorIsNull,
 		ContainsAny,
 		ContainsAll,
-		ContainsNone:
+		ContainsNone,
+		OperatorRegex,
+		OperatorStartsWith:
 		return true
 
 	case OperatorOr, OperatorAnd, OperatorNot:
@@ -94,6 +98,10 @@ func (o Operator) Name() string {
 		return "ContainsNone"
 	case OperatorNot:
 		return "Not"
+	case OperatorRegex:
+		return "Regex"
+	case OperatorStartsWith:
+		return "StartsWith"
 	default:
 		panic("Unknown operator")
 	}
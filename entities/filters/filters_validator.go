@@ -13,6 +13,7 @@ package filters
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -113,6 +114,10 @@ func validateClause(authorizedGetClass func(string) (*models.Class, error), cw *
 		return nil
 	}
 
+	if op := cw.getOperator(); op == OperatorRegex || op == OperatorStartsWith {
+		return validateTextMatchOperators(prop, cw)
+	}
+
 	if isUUIDType(prop.DataType[0]) {
 		return validateUUIDType(propName, cw)
 	}
@@ -218,6 +223,38 @@ func validateUUIDOperators(propName schema.PropertyName, cw *clauseWrapper) erro
 	}
 }
 
+// validateTextMatchOperators validates the Regex and StartsWith operators,
+// which match the indexed values of text properties.
+func validateTextMatchOperators(prop *models.Property, cw *clauseWrapper) error {
+	op := cw.getOperator()
+
+	dt := schema.DataType(prop.DataType[0])
+	if alias, ok := deprecatedDataTypeAliases[dt]; ok {
+		dt = alias
+	}
+	if dt != schema.DataTypeText && dt != schema.DataTypeTextArray {
+		return errors.Errorf("operator %q can only be used on text/text[] props, got %q",
+			op.Name(), prop.DataType[0])
+	}
+	if !cw.isType(schema.DataTypeText) {
+		return errors.Errorf("operator %q requires a valueText, got %q instead",
+			op.Name(), cw.getValueNameFromType())
+	}
+
+	value, ok := cw.getValue().(string)
+	if !ok {
+		return errors.Errorf("operator %q requires a string value, got %T", op.Name(), cw.getValue())
+	}
+	if op == OperatorRegex {
+		if _, err := regexp.Compile(value); err != nil {
+			return errors.Wrap(err, "invalid regular expression")
+		}
+	} else if value == "" {
+		return errors.Errorf("operator %q requires a non-empty prefix", op.Name())
+	}
+	return nil
+}
+
 type clauseWrapper struct {
 	clause    *Clause
 	origType  schema.DataType
@@ -227,6 +227,111 @@ func TestValidateUUIDFilter(t *testing.T) {
 	}
 }
 
+func TestValidateTextMatchOperators(t *testing.T) {
+	tests := []struct {
+		name      string
+		operator  Operator
+		property  schema.PropertyName
+		valueType schema.DataType
+		value     interface{}
+		valid     bool
+	}{
+		{
+			name:      "regex on text",
+			operator:  OperatorRegex,
+			property:  "modelName",
+			valueType: schema.DataTypeText,
+			value:     "^mod[a-z]+l$",
+			valid:     true,
+		},
+		{
+			name:      "regex on text[]",
+			operator:  OperatorRegex,
+			property:  "tags",
+			valueType: schema.DataTypeText,
+			value:     "(?i)sport",
+			valid:     true,
+		},
+		{
+			name:      "invalid regex",
+			operator:  OperatorRegex,
+			property:  "modelName",
+			valueType: schema.DataTypeText,
+			value:     "mod(el",
+			valid:     false,
+		},
+		{
+			name:      "starts with on text",
+			operator:  OperatorStartsWith,
+			property:  "modelName",
+			valueType: schema.DataTypeText,
+			value:     "mod",
+			valid:     true,
+		},
+		{
+			name:      "[deprecated string] starts with",
+			operator:  OperatorStartsWith,
+			property:  "modelName",
+			valueType: schema.DataTypeString,
+			value:     "mod",
+			valid:     true,
+		},
+		{
+			name:      "starts with empty prefix",
+			operator:  OperatorStartsWith,
+			property:  "modelName",
+			valueType: schema.DataTypeText,
+			value:     "",
+			valid:     false,
+		},
+		{
+			name:      "starts with on int",
+			operator:  OperatorStartsWith,
+			property:  "horsepower",
+			valueType: schema.DataTypeInt,
+			value:     1,
+			valid:     false,
+		},
+		{
+			name:      "regex on uuid",
+			operator:  OperatorRegex,
+			property:  "my_id",
+			valueType: schema.DataTypeText,
+			value:     "^abc",
+			valid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := Clause{
+				Operator: tt.operator,
+				Value:    &Value{Value: tt.value, Type: tt.valueType},
+				On:       &Path{Class: "Car", Property: tt.property},
+			}
+
+			f := &fakeFinder{}
+			f.On("ReadOnlyClass", mock.Anything).Return(
+				&models.Class{
+					Class: "Car",
+					Properties: []*models.Property{
+						{Name: "modelName", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationField},
+						{Name: "tags", DataType: schema.DataTypeTextArray.PropString(), Tokenization: models.PropertyTokenizationWord},
+						{Name: "horsepower", DataType: []string{"int"}},
+						{Name: "my_id", DataType: []string{string(schema.DataTypeUUID)}},
+					},
+				},
+			)
+			err := validateClause(f.ReadOnlyClass, newClauseWrapper(&cl))
+			if tt.valid {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
 func TestClauseWrapper(t *testing.T) {
 	type testCase struct {
 		name         string
@@ -31,6 +31,9 @@ import (
 // swagger:model Class
 type Class struct {
 
+	// change stream config
+	ChangeStreamConfig *ChangeStreamConfig `json:"changeStreamConfig,omitempty"`
+
 	// Name of the collection (formerly 'class') (required). Multiple words should be concatenated in CamelCase, e.g. `ArticleAuthor`.
 	Class string `json:"class,omitempty"`
 
@@ -46,6 +49,9 @@ type Class struct {
 	// multi tenancy config
 	MultiTenancyConfig *MultiTenancyConfig `json:"multiTenancyConfig,omitempty"`
 
+	// object storage config
+	ObjectStorageConfig *ObjectStorageConfig `json:"objectStorageConfig,omitempty"`
+
 	// object Ttl config
 	ObjectTTLConfig *ObjectTTLConfig `json:"objectTtlConfig,omitempty"`
 
@@ -75,6 +81,10 @@ type Class struct {
 func (m *Class) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateChangeStreamConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateInvertedIndexConfig(formats); err != nil {
 		res = append(res, err)
 	}
@@ -83,6 +93,10 @@ func (m *Class) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateObjectStorageConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateObjectTTLConfig(formats); err != nil {
 		res = append(res, err)
 	}
@@ -105,6 +119,25 @@ func (m *Class) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Class) validateChangeStreamConfig(formats strfmt.Registry) error {
+	if swag.IsZero(m.ChangeStreamConfig) { // not required
+		return nil
+	}
+
+	if m.ChangeStreamConfig != nil {
+		if err := m.ChangeStreamConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("changeStreamConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("changeStreamConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) validateInvertedIndexConfig(formats strfmt.Registry) error {
 	if swag.IsZero(m.InvertedIndexConfig) { // not required
 		return nil
@@ -143,6 +176,25 @@ func (m *Class) validateMultiTenancyConfig(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Class) validateObjectStorageConfig(formats strfmt.Registry) error {
+	if swag.IsZero(m.ObjectStorageConfig) { // not required
+		return nil
+	}
+
+	if m.ObjectStorageConfig != nil {
+		if err := m.ObjectStorageConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("objectStorageConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("objectStorageConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) validateObjectTTLConfig(formats strfmt.Registry) error {
 	if swag.IsZero(m.ObjectTTLConfig) { // not required
 		return nil
@@ -237,6 +289,10 @@ func (m *Class) validateVectorConfig(formats strfmt.Registry) error {
 func (m *Class) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateChangeStreamConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateInvertedIndexConfig(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -245,6 +301,10 @@ func (m *Class) ContextValidate(ctx context.Context, formats strfmt.Registry) er
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateObjectStorageConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateObjectTTLConfig(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -267,6 +327,22 @@ func (m *Class) ContextValidate(ctx context.Context, formats strfmt.Registry) er
 	return nil
 }
 
+func (m *Class) contextValidateChangeStreamConfig(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ChangeStreamConfig != nil {
+		if err := m.ChangeStreamConfig.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("changeStreamConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("changeStreamConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) contextValidateInvertedIndexConfig(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.InvertedIndexConfig != nil {
@@ -299,6 +375,22 @@ func (m *Class) contextValidateMultiTenancyConfig(ctx context.Context, formats s
 	return nil
 }
 
+func (m *Class) contextValidateObjectStorageConfig(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ObjectStorageConfig != nil {
+		if err := m.ObjectStorageConfig.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("objectStorageConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("objectStorageConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) contextValidateObjectTTLConfig(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.ObjectTTLConfig != nil {
@@ -43,6 +43,9 @@ type DistributedTask struct {
 	// The ID of the task.
 	ID string `json:"id,omitempty"`
 
+	// The fraction of the task completed by each node, for tasks which report their progress.
+	NodesProgress map[string]float32 `json:"nodesProgress,omitempty"`
+
 	// The payload of the task.
 	Payload interface{} `json:"payload,omitempty"`
 
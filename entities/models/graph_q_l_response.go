@@ -35,6 +35,9 @@ type GraphQLResponse struct {
 
 	// Array with errors.
 	Errors []*GraphQLError `json:"errors,omitempty"`
+
+	// GraphQL extensions object, contains the facets of the queried classes.
+	Extensions map[string]JSONObject `json:"extensions,omitempty"`
 }
 
 // Validate validates this graph q l response
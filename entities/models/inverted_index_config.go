@@ -39,6 +39,9 @@ type InvertedIndexConfig struct {
 	// Index each object with the null state (default: `false`).
 	IndexNullState bool `json:"indexNullState,omitempty"`
 
+	// Index the positions of the terms of searchable properties, which is required for phrase and proximity queries (default: `false`).
+	IndexPositions bool `json:"indexPositions,omitempty"`
+
 	// Index length of properties (default: `false`).
 	IndexPropertyLength bool `json:"indexPropertyLength,omitempty"`
 
@@ -39,6 +39,9 @@ type NodeShardStatus struct {
 	// The status of vector compression/quantization.
 	Compressed bool `json:"compressed"`
 
+	// The encryption at rest of the files of the shard, only set if the encryption is enabled.
+	EncryptionStatus *EncryptionStatus `json:"encryptionStatus,omitempty"`
+
 	// The load status of the shard.
 	Loaded bool `json:"loaded"`
 
@@ -59,6 +62,9 @@ type NodeShardStatus struct {
 
 	// The length of the vector indexing queue.
 	VectorQueueLength int64 `json:"vectorQueueLength"`
+
+	// The status of named vector indexes being rebuilt into another index type or distance.
+	VectorReindexStatus []*VectorReindexStatus `json:"vectorReindexStatus"`
 }
 
 // Validate validates this node shard status
@@ -69,6 +75,14 @@ func (m *NodeShardStatus) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateEncryptionStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVectorReindexStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -101,6 +115,51 @@ func (m *NodeShardStatus) validateAsyncReplicationStatus(formats strfmt.Registry
 	return nil
 }
 
+func (m *NodeShardStatus) validateEncryptionStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.EncryptionStatus) { // not required
+		return nil
+	}
+
+	if m.EncryptionStatus != nil {
+		if err := m.EncryptionStatus.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("encryptionStatus")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("encryptionStatus")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *NodeShardStatus) validateVectorReindexStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.VectorReindexStatus) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.VectorReindexStatus); i++ {
+		if swag.IsZero(m.VectorReindexStatus[i]) { // not required
+			continue
+		}
+
+		if m.VectorReindexStatus[i] != nil {
+			if err := m.VectorReindexStatus[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("vectorReindexStatus" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("vectorReindexStatus" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // ContextValidate validate this node shard status based on the context it is used
 func (m *NodeShardStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -109,6 +168,14 @@ func (m *NodeShardStatus) ContextValidate(ctx context.Context, formats strfmt.Re
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateEncryptionStatus(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateVectorReindexStatus(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -135,6 +202,47 @@ func (m *NodeShardStatus) contextValidateAsyncReplicationStatus(ctx context.Cont
 	return nil
 }
 
+func (m *NodeShardStatus) contextValidateEncryptionStatus(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.EncryptionStatus != nil {
+
+		if swag.IsZero(m.EncryptionStatus) { // not required
+			return nil
+		}
+
+		if err := m.EncryptionStatus.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("encryptionStatus")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("encryptionStatus")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *NodeShardStatus) contextValidateVectorReindexStatus(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.VectorReindexStatus); i++ {
+
+		if m.VectorReindexStatus[i] != nil {
+			if err := m.VectorReindexStatus[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("vectorReindexStatus" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("vectorReindexStatus" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *NodeShardStatus) MarshalBinary() ([]byte, error) {
 	if m == nil {
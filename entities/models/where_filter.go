@@ -37,7 +37,7 @@ type WhereFilter struct {
 
 	// Operator to use.
 	// Example: GreaterThanEqual
-	// Enum: [And Or Equal Like NotEqual GreaterThan GreaterThanEqual LessThan LessThanEqual WithinGeoRange IsNull ContainsAny ContainsAll ContainsNone Not]
+	// Enum: [And Or Equal Like NotEqual GreaterThan GreaterThanEqual LessThan LessThanEqual WithinGeoRange IsNull ContainsAny ContainsAll ContainsNone Not Regex StartsWith]
 	Operator string `json:"operator,omitempty"`
 
 	// Path to the property currently being filtered.
@@ -148,7 +148,7 @@ var whereFilterTypeOperatorPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["And","Or","Equal","Like","NotEqual","GreaterThan","GreaterThanEqual","LessThan","LessThanEqual","WithinGeoRange","IsNull","ContainsAny","ContainsAll","ContainsNone","Not"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["And","Or","Equal","Like","NotEqual","GreaterThan","GreaterThanEqual","LessThan","LessThanEqual","WithinGeoRange","IsNull","ContainsAny","ContainsAll","ContainsNone","Not","Regex","StartsWith"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -202,6 +202,12 @@ const (
 
 	// WhereFilterOperatorNot captures enum value "Not"
 	WhereFilterOperatorNot string = "Not"
+
+	// WhereFilterOperatorRegex captures enum value "Regex"
+	WhereFilterOperatorRegex string = "Regex"
+
+	// WhereFilterOperatorStartsWith captures enum value "StartsWith"
+	WhereFilterOperatorStartsWith string = "StartsWith"
 )
 
 // prop value enum
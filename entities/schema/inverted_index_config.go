@@ -22,6 +22,7 @@ type InvertedIndexConfig struct {
 	IndexTimestamps        bool
 	IndexNullState         bool
 	IndexPropertyLength    bool
+	IndexPositions         bool
 	UsingBlockMaxWAND      bool
 	TokenizerUserDict      []*models.TokenizerUserDictConfig
 }
@@ -45,6 +46,7 @@ func InvertedIndexConfigFromModel(m models.InvertedIndexConfig) InvertedIndexCon
 	i.IndexTimestamps = m.IndexTimestamps
 	i.IndexNullState = m.IndexNullState
 	i.IndexPropertyLength = m.IndexPropertyLength
+	i.IndexPositions = m.IndexPositions
 	i.UsingBlockMaxWAND = m.UsingBlockMaxWAND
 	i.TokenizerUserDict = m.TokenizerUserDict
 
@@ -66,6 +68,7 @@ func InvertedIndexConfigToModel(i InvertedIndexConfig) models.InvertedIndexConfi
 	m.IndexTimestamps = i.IndexTimestamps
 	m.IndexNullState = i.IndexNullState
 	m.IndexPropertyLength = i.IndexPropertyLength
+	m.IndexPositions = i.IndexPositions
 	m.UsingBlockMaxWAND = i.UsingBlockMaxWAND
 
 	if i.TokenizerUserDict != nil {
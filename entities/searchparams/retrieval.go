@@ -30,12 +30,67 @@ type NearVector struct {
 }
 
 type KeywordRanking struct {
-	Type                   string   `json:"type"`
-	Properties             []string `json:"properties"`
-	Query                  string   `json:"query"`
-	AdditionalExplanations bool     `json:"additionalExplanations"`
-	MinimumOrTokensMatch   int      `json:"minimumOrTokensMatch"`
-	SearchOperator         string   `json:"searchOperator"`
+	Type                   string     `json:"type"`
+	Properties             []string   `json:"properties"`
+	Query                  string     `json:"query"`
+	AdditionalExplanations bool       `json:"additionalExplanations"`
+	MinimumOrTokensMatch   int        `json:"minimumOrTokensMatch"`
+	SearchOperator         string     `json:"searchOperator"`
+	Fuzziness              *Fuzziness `json:"fuzziness"`
+	// SparseVector replaces the BM25 query with a dot product search on a
+	// sparse named vector. It is set for keyword rankings of type "sparse".
+	SparseVector *SparseVectorSearch `json:"sparseVector"`
+}
+
+// SparseVectorSearch ranks objects by the dot product of the query vector and
+// their vector of the sparse index of TargetVector.
+type SparseVectorSearch struct {
+	TargetVector string               `json:"targetVector"`
+	Vector       *models.SparseVector `json:"vector"`
+}
+
+const (
+	// MaxFuzzyEdits is the largest supported edit distance of a fuzzy query
+	MaxFuzzyEdits = 2
+	// DefaultFuzzyMaxExpansions limits the number of indexed terms a single
+	// query term is expanded to if no limit was set
+	DefaultFuzzyMaxExpansions = 50
+)
+
+// Fuzziness expands every term of a keyword query to the indexed terms within
+// a maximum Levenshtein distance. The first PrefixLength characters of an
+// indexed term have to match the query term exactly. Without a prefix, the
+// default, the keys of the whole searchable bucket are compared to the query
+// term, a prefix limits this to the keys sharing it.
+type Fuzziness struct {
+	MaxEdits      int `json:"maxEdits"`
+	PrefixLength  int `json:"prefixLength"`
+	MaxExpansions int `json:"maxExpansions"`
+}
+
+func (f *Fuzziness) Validate() error {
+	if f == nil {
+		return nil
+	}
+	if f.MaxEdits < 0 || f.MaxEdits > MaxFuzzyEdits {
+		return fmt.Errorf("fuzziness: maxEdits must be between 0 and %d, got %d", MaxFuzzyEdits, f.MaxEdits)
+	}
+	if f.PrefixLength < 0 {
+		return fmt.Errorf("fuzziness: prefixLength must not be negative, got %d", f.PrefixLength)
+	}
+	if f.MaxExpansions < 0 {
+		return fmt.Errorf("fuzziness: maxExpansions must not be negative, got %d", f.MaxExpansions)
+	}
+	return nil
+}
+
+// Expansions returns the maximum number of indexed terms a query term is
+// expanded to.
+func (f *Fuzziness) Expansions() int {
+	if f.MaxExpansions == 0 {
+		return DefaultFuzzyMaxExpansions
+	}
+	return f.MaxExpansions
 }
 
 // Indicates whether property should be indexed
@@ -112,8 +167,12 @@ type HybridSearch struct {
 	WithDistance         bool          `json:"withDistance"`
 	MinimumOrTokensMatch int           `json:"minimumOrTokenMatch"`
 	SearchOperator       string        `json:"searchOperator"`
-	NearTextParams       *NearTextParams
-	NearVectorParams     *NearVector
+	Fuzziness            *Fuzziness    `json:"fuzziness"`
+	// SparseVector, if set, is used as the keyword side of the fusion instead
+	// of a BM25 search on Query
+	SparseVector     *SparseVectorSearch `json:"sparseVector"`
+	NearTextParams   *NearTextParams
+	NearVectorParams *NearVector
 }
 
 type NearObject struct {
@@ -32,6 +32,8 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
 	"github.com/weaviate/weaviate/usecases/byteops"
 )
 
@@ -58,6 +60,11 @@ type Object struct {
 	DocID             uint64
 	Vectors           map[string][]float32   `json:"vectors"`
 	MultiVectors      map[string][][]float32 `json:"multivectors"`
+	// VectorDataTypes holds the data types in which named vectors are stored,
+	// named vectors without an entry are stored as float32
+	VectorDataTypes map[string]string `json:"-"`
+	// SparseVectors holds the named vectors of sparse indexes
+	SparseVectors map[string]*models.SparseVector `json:"sparsevectors"`
 }
 
 func New(docID uint64) *Object {
@@ -97,6 +104,16 @@ func FromObject(object *models.Object, vector []float32, vectors map[string][]fl
 		}
 	}
 
+	var sparseVectors map[string]*models.SparseVector
+	for targetVector, vec := range object.Vectors {
+		if sv, ok := vec.(*models.SparseVector); ok {
+			if sparseVectors == nil {
+				sparseVectors = make(map[string]*models.SparseVector)
+			}
+			sparseVectors[targetVector] = sv
+		}
+	}
+
 	return &Object{
 		Object:            *object,
 		Vector:            vector,
@@ -104,6 +121,7 @@ func FromObject(object *models.Object, vector []float32, vectors map[string][]fl
 		VectorLen:         len(vector),
 		Vectors:           vecs,
 		MultiVectors:      multiVectors,
+		SparseVectors:     sparseVectors,
 	}
 }
 
@@ -206,11 +224,12 @@ func FromBinaryOptional(data []byte,
 	vectorWeights := rw.ReadBytesFromBuffer(uint64(vectorWeightsLength))
 
 	if len(addProp.Vectors) > 0 {
-		vectors, err := unmarshalTargetVectors(&rw)
+		vectors, dataTypes, err := unmarshalTargetVectors(&rw)
 		if err != nil {
 			return nil, err
 		}
 		ko.Vectors = vectors
+		ko.VectorDataTypes = dataTypes
 
 		if vectors != nil {
 			// If parseObject is called, ko.Object will be overwritten making this effectively a
@@ -228,6 +247,7 @@ func FromBinaryOptional(data []byte,
 			rw.MoveBufferToAbsolutePosition(pos + uint64(targetVectorsSegmentLength))
 		}
 	}
+	multiVectorsPos := rw.Position
 
 	if rw.Position < uint64(len(rw.Buffer)) && len(addProp.Vectors) > 0 {
 		vectorNamesToUnmarshal := map[string]interface{}{}
@@ -251,6 +271,21 @@ func FromBinaryOptional(data []byte,
 				ko.Object.Vectors[vecName] = vec
 			}
 		}
+
+		sparseVectors, err := unmarshalSparseVectors(rw.Buffer, multiVectorsPos, vectorNamesToUnmarshal)
+		if err != nil {
+			return nil, err
+		}
+		ko.SparseVectors = sparseVectors
+
+		if sparseVectors != nil {
+			if ko.Object.Vectors == nil {
+				ko.Object.Vectors = make(models.Vectors)
+			}
+			for vecName, vec := range sparseVectors {
+				ko.Object.Vectors[vecName] = vec
+			}
+		}
 	}
 
 	// some object members need additional "enrichment". Only do this if necessary, ie if they are actually present
@@ -547,6 +582,12 @@ func (ko *Object) SearchResult(additional additional.Properties, tenant string)
 		if additional.Group {
 			additionalProperties["group"] = ko.AdditionalProperties()["group"]
 		}
+		if additional.QueryProfile && ko.AdditionalProperties()["queryProfile"] != nil {
+			additionalProperties["queryProfile"] = ko.AdditionalProperties()["queryProfile"]
+		}
+		if additional.Highlights != nil && ko.AdditionalProperties()["highlightTerms"] != nil {
+			additionalProperties["highlightTerms"] = ko.AdditionalProperties()["highlightTerms"]
+		}
 	}
 	if ko.ExplainScore() != "" {
 		additionalProperties["explainScore"] = ko.ExplainScore()
@@ -558,7 +599,7 @@ func (ko *Object) SearchResult(additional additional.Properties, tenant string)
 		ClassName: ko.Class().String(),
 		Schema:    ko.Properties(),
 		Vector:    ko.Vector,
-		Vectors:   ko.asVectors(ko.Vectors, ko.MultiVectors),
+		Vectors:   ko.asVectors(ko.Vectors, ko.MultiVectors, ko.SparseVectors),
 		Dims:      ko.VectorLen,
 		// VectorWeights: ko.VectorWeights(), // TODO: add vector weights
 		Created:              ko.CreationTimeUnix(),
@@ -572,8 +613,10 @@ func (ko *Object) SearchResult(additional additional.Properties, tenant string)
 	}
 }
 
-func (ko *Object) asVectors(vectors map[string][]float32, multiVectors map[string][][]float32) models.Vectors {
-	if (len(vectors) + len(multiVectors)) > 0 {
+func (ko *Object) asVectors(vectors map[string][]float32, multiVectors map[string][][]float32,
+	sparseVectors map[string]*models.SparseVector,
+) models.Vectors {
+	if (len(vectors) + len(multiVectors) + len(sparseVectors)) > 0 {
 		out := make(models.Vectors)
 		for targetVector, vector := range vectors {
 			out[targetVector] = vector
@@ -581,13 +624,16 @@ func (ko *Object) asVectors(vectors map[string][]float32, multiVectors map[strin
 		for targetVector, vector := range multiVectors {
 			out[targetVector] = vector
 		}
+		for targetVector, vector := range sparseVectors {
+			out[targetVector] = vector
+		}
 		return out
 	}
 	return nil
 }
 
 func (ko *Object) GetVectors() models.Vectors {
-	return ko.asVectors(ko.Vectors, ko.MultiVectors)
+	return ko.asVectors(ko.Vectors, ko.MultiVectors, ko.SparseVectors)
 }
 
 func (ko *Object) SearchResultWithDist(addl additional.Properties, dist float32) search.Result {
@@ -748,6 +794,13 @@ func DocIDAndTimeFromBinary(in []byte) (docID uint64, updateTime int64, err erro
 // n             | []byte                   | packed multivector offsets map { name : offset_in_bytes }
 // 4             | uint32                   | length of multivectors segment (in bytes)
 // 4 + (2 + n*4) | uint32 + (uint16+[]byte) | multivectors segment: num vecs + (vec length + vec floats), ...
+// 4             | uint32                   | length of packed vector data types (in bytes), optional
+// n             | []byte                   | packed vector data types map { name : data_type }, optional
+//
+// Target vectors are encoded in their data type (see common.EncodeVector),
+// the vec_length of the target vectors segment is the number of dimensions.
+// The vector data types are only written if a target vector is not stored as
+// float32.
 // TODO vec lengths immediately following num vecs so you can jump straight to specific vec?
 
 const (
@@ -760,6 +813,8 @@ const (
 	maxTargetVectorsOffsetsLength int = math.MaxUint32
 	maxMultiVectorsSegmentLength  int = math.MaxUint32
 	maxMultiVectorsOffsetsLength  int = math.MaxUint32
+	maxVectorDataTypesLength      int = math.MaxUint32
+	maxSparseVectorsLength        int = math.MaxUint32
 )
 
 func (ko *Object) MarshalBinary() ([]byte, error) {
@@ -822,16 +877,26 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 	var targetVectorsOffsetsLength uint32
 	var targetVectorsSegmentLength int
 
+	var vectorDataTypes []byte
+	var vectorDataTypesLength uint32
+
 	targetVectorsOffsetOrder := make([]string, 0, len(ko.Vectors))
 	if len(ko.Vectors) > 0 {
 		offsetsMap := map[string]uint32{}
+		dataTypesMap := map[string]string{}
 		for name, vec := range ko.Vectors {
 			if len(vec) > maxVectorLength {
 				return nil, fmt.Errorf("could not marshal '%s' max length exceeded (%d/%d)", "vector", len(vec), maxVectorLength)
 			}
 
+			dataType := common.DataTypeOrDefault(ko.VectorDataTypes[name])
+			if dataType != common.DataTypeFloat32 {
+				dataTypesMap[name] = dataType
+			}
+
 			offsetsMap[name] = uint32(targetVectorsSegmentLength)
-			targetVectorsSegmentLength += 2 + 4*len(vec) // 2 for vec length + vec bytes
+			// 2 for vec length + vec bytes
+			targetVectorsSegmentLength += 2 + common.BytesPerDimension(dataType)*len(vec)
 
 			if targetVectorsSegmentLength > maxTargetVectorsSegmentLength {
 				return nil,
@@ -850,6 +915,17 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 			return nil, fmt.Errorf("could not marshal '%s' max length exceeded (%d/%d)", "targetVectorsOffsets", len(targetVectorsOffsets), maxTargetVectorsOffsetsLength)
 		}
 		targetVectorsOffsetsLength = uint32(len(targetVectorsOffsets))
+
+		if len(dataTypesMap) > 0 {
+			vectorDataTypes, err = msgpack.Marshal(dataTypesMap)
+			if err != nil {
+				return nil, fmt.Errorf("could not marshal vector data types: %w", err)
+			}
+			if len(vectorDataTypes) > maxVectorDataTypesLength {
+				return nil, fmt.Errorf("could not marshal '%s' max length exceeded (%d/%d)", "vectorDataTypes", len(vectorDataTypes), maxVectorDataTypesLength)
+			}
+			vectorDataTypesLength = uint32(len(vectorDataTypes))
+		}
 	}
 
 	var multiVectorsOffsets []byte
@@ -889,6 +965,23 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		multiVectorsOffsetsLength = uint32(len(multiVectorsOffsets))
 	}
 
+	var sparseVectors []byte
+	var sparseVectorsLength uint32
+	if len(ko.SparseVectors) > 0 {
+		encoded := make(map[string][]byte, len(ko.SparseVectors))
+		for name, vec := range ko.SparseVectors {
+			encoded[name] = sparse.Encode(vec)
+		}
+		sparseVectors, err = msgpack.Marshal(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal sparse vectors: %w", err)
+		}
+		if len(sparseVectors) > maxSparseVectorsLength {
+			return nil, fmt.Errorf("could not marshal '%s' max length exceeded (%d/%d)", "sparseVectors", len(sparseVectors), maxSparseVectorsLength)
+		}
+		sparseVectorsLength = uint32(len(sparseVectors))
+	}
+
 	totalBufferLength := 1 + 8 + 1 + 16 + 8 + 8 +
 		2 + vectorLength*4 +
 		2 + classNameLength +
@@ -899,6 +992,14 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		4 + uint32(targetVectorsSegmentLength) +
 		4 + multiVectorsOffsetsLength +
 		4 + uint32(multiVectorsSegmentLength)
+	if vectorDataTypesLength > 0 || sparseVectorsLength > 0 {
+		// sparse vectors follow the data types, so the data types section is
+		// written (possibly empty) whenever there are sparse vectors
+		totalBufferLength += 4 + vectorDataTypesLength
+	}
+	if sparseVectorsLength > 0 {
+		totalBufferLength += 4 + sparseVectorsLength
+	}
 
 	byteBuffer := make([]byte, totalBufferLength)
 	rw := byteops.NewReadWriter(byteBuffer)
@@ -954,9 +1055,12 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		vecLen := len(vec)
 
 		rw.WriteUint16(uint16(vecLen))
-		for j := 0; j < vecLen; j++ {
-			rw.WriteUint32(math.Float32bits(vec[j]))
+		dataType := common.DataTypeOrDefault(ko.VectorDataTypes[name])
+		vecBytes := common.BytesPerDimension(dataType) * vecLen
+		if err := common.EncodeVectorInto(dataType, vec, rw.Buffer[rw.Position:rw.Position+uint64(vecBytes)]); err != nil {
+			return nil, fmt.Errorf("could not marshal target vector %q: %w", name, err)
 		}
+		rw.MoveBufferPositionForward(uint64(vecBytes))
 	}
 
 	rw.WriteUint32(multiVectorsOffsetsLength)
@@ -980,6 +1084,22 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		}
 	}
 
+	if vectorDataTypesLength > 0 || sparseVectorsLength > 0 {
+		rw.WriteUint32(vectorDataTypesLength)
+		err = rw.CopyBytesToBuffer(vectorDataTypes)
+		if err != nil {
+			return byteBuffer, errors.Wrap(err, "Could not copy vectorDataTypes")
+		}
+	}
+
+	if sparseVectorsLength > 0 {
+		rw.WriteUint32(sparseVectorsLength)
+		err = rw.CopyBytesToBuffer(sparseVectors)
+		if err != nil {
+			return byteBuffer, errors.Wrap(err, "Could not copy sparseVectors")
+		}
+	}
+
 	return byteBuffer, nil
 }
 
@@ -1165,18 +1285,26 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 		return errors.Wrap(err, "Could not copy vectorWeights")
 	}
 
-	vectors, err := unmarshalTargetVectors(&rw)
+	vectors, dataTypes, err := unmarshalTargetVectors(&rw)
 	if err != nil {
 		return err
 	}
 	ko.Vectors = vectors
+	ko.VectorDataTypes = dataTypes
 
+	multiVectorsPos := rw.Position
 	multiVectors, err := unmarshalMultiVectors(&rw, nil)
 	if err != nil {
 		return err
 	}
 	ko.MultiVectors = multiVectors
 
+	sparseVectors, err := unmarshalSparseVectors(rw.Buffer, multiVectorsPos, nil)
+	if err != nil {
+		return err
+	}
+	ko.SparseVectors = sparseVectors
+
 	return ko.parseObject(
 		strfmt.UUID(uuidParsed.String()),
 		createTime,
@@ -1188,7 +1316,7 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 	)
 }
 
-func unmarshalTargetVectors(rw *byteops.ReadWriter) (map[string][]float32, error) {
+func unmarshalTargetVectors(rw *byteops.ReadWriter) (map[string][]float32, map[string]string, error) {
 	// This check prevents from panic when somebody is upgrading from version that
 	// didn't have multiple target vector support. This check is needed bc with named vectors
 	// feature storage object can have vectors data appended at the end of the file
@@ -1200,25 +1328,101 @@ func unmarshalTargetVectors(rw *byteops.ReadWriter) (map[string][]float32, error
 		if len(targetVectorsOffsets) > 0 {
 			var tvOffsets map[string]uint32
 			if err := msgpack.Unmarshal(targetVectorsOffsets, &tvOffsets); err != nil {
-				return nil, fmt.Errorf("could not unmarshal target vectors offset: %w", err)
+				return nil, nil, fmt.Errorf("could not unmarshal target vectors offset: %w", err)
+			}
+
+			dataTypes, err := unmarshalVectorDataTypes(rw.Buffer, pos+uint64(targetVectorsSegmentLength))
+			if err != nil {
+				return nil, nil, err
 			}
 
 			targetVectors := map[string][]float32{}
 			for name, offset := range tvOffsets {
 				rw.MoveBufferToAbsolutePosition(pos + uint64(offset))
 				vecLen := rw.ReadUint16()
-				vec := make([]float32, vecLen)
-				for j := uint16(0); j < vecLen; j++ {
-					vec[j] = math.Float32frombits(rw.ReadUint32())
-				}
-				targetVectors[name] = vec
+				dataType := common.DataTypeOrDefault(dataTypes[name])
+				vecBytes := rw.ReadBytesFromBuffer(uint64(vecLen) * uint64(common.BytesPerDimension(dataType)))
+				targetVectors[name] = common.DecodeVector(dataType, vecBytes, nil)
 			}
 
 			rw.MoveBufferToAbsolutePosition(pos + uint64(targetVectorsSegmentLength))
-			return targetVectors, nil
+			return targetVectors, dataTypes, nil
 		}
 	}
-	return nil, nil
+	return nil, nil, nil
+}
+
+// unmarshalVectorDataTypes reads the optional vector data types that follow
+// the multi vectors, starting at the position right after the target vectors
+// segment. Objects written before data types existed have none.
+func unmarshalVectorDataTypes(in []byte, pos uint64) (map[string]string, error) {
+	for i := 0; i < 2; i++ {
+		// skip the multi vectors offsets and segment
+		if pos+4 > uint64(len(in)) {
+			return nil, nil
+		}
+		pos += 4 + uint64(binary.LittleEndian.Uint32(in[pos:pos+4]))
+	}
+	if pos+4 > uint64(len(in)) {
+		return nil, nil
+	}
+	length := uint64(binary.LittleEndian.Uint32(in[pos : pos+4]))
+	pos += 4
+	if pos+length > uint64(len(in)) {
+		return nil, fmt.Errorf("vector data types exceed object length")
+	}
+	if length == 0 {
+		// written empty when only sparse vectors follow
+		return nil, nil
+	}
+
+	var dataTypes map[string]string
+	if err := msgpack.Unmarshal(in[pos:pos+length], &dataTypes); err != nil {
+		return nil, fmt.Errorf("could not unmarshal vector data types: %w", err)
+	}
+	return dataTypes, nil
+}
+
+// unmarshalSparseVectors reads the optional sparse vectors that follow the
+// vector data types, starting at the position of the multi vectors offsets.
+// If onlyUnmarshalNames is non-empty, only the named sparse vectors are
+// returned.
+func unmarshalSparseVectors(in []byte, pos uint64, onlyUnmarshalNames map[string]interface{}) (map[string]*models.SparseVector, error) {
+	for i := 0; i < 3; i++ {
+		// skip the multi vectors offsets and segment and the vector data types
+		if pos+4 > uint64(len(in)) {
+			return nil, nil
+		}
+		pos += 4 + uint64(binary.LittleEndian.Uint32(in[pos:pos+4]))
+	}
+	if pos+4 > uint64(len(in)) {
+		return nil, nil
+	}
+	length := uint64(binary.LittleEndian.Uint32(in[pos : pos+4]))
+	pos += 4
+	if pos+length > uint64(len(in)) {
+		return nil, fmt.Errorf("sparse vectors exceed object length")
+	}
+
+	var encoded map[string][]byte
+	if err := msgpack.Unmarshal(in[pos:pos+length], &encoded); err != nil {
+		return nil, fmt.Errorf("could not unmarshal sparse vectors: %w", err)
+	}
+
+	sparseVectors := make(map[string]*models.SparseVector, len(encoded))
+	for name, vecBytes := range encoded {
+		if len(onlyUnmarshalNames) > 0 {
+			if _, ok := onlyUnmarshalNames[name]; !ok {
+				continue
+			}
+		}
+		vec, err := sparse.Decode(vecBytes)
+		if err != nil {
+			return nil, fmt.Errorf("sparse vector %q: %w", name, err)
+		}
+		sparseVectors[name] = vec
+	}
+	return sparseVectors, nil
 }
 
 // unmarshalMultiVectors unmarshals the multi vectors from the buffer. If onlyUnmarshalNames is set and non-empty,
@@ -1302,7 +1506,7 @@ func VectorFromBinary(in []byte, buffer []float32, targetVector string) ([]float
 		vectorWeightsLength := uint64(rw.ReadUint32())
 		rw.MoveBufferPositionForward(vectorWeightsLength)
 
-		targetVectors, err := unmarshalTargetVectors(&rw)
+		targetVectors, _, err := unmarshalTargetVectors(&rw)
 		if err != nil {
 			return nil, errors.Errorf("unable to unmarshal vector for target vector: %s", targetVector)
 		}
@@ -1455,6 +1659,36 @@ func (ko *Object) parseObject(uuid strfmt.UUID, create, update int64, className
 			}
 		}
 
+		if prop, ok := additionalProperties["queryProfile"]; ok {
+			if profileList, ok := prop.([]interface{}); ok {
+				marshalled, err := json.Marshal(profileList)
+				if err != nil {
+					return err
+				}
+				var profiles []additional.VectorSearchProfile
+				err = json.Unmarshal(marshalled, &profiles)
+				if err != nil {
+					return err
+				}
+				additionalProperties["queryProfile"] = profiles
+			}
+		}
+
+		if prop, ok := additionalProperties["highlightTerms"]; ok {
+			if termsMap, ok := prop.(map[string]interface{}); ok {
+				marshalled, err := json.Marshal(termsMap)
+				if err != nil {
+					return err
+				}
+				var terms additional.HighlightTerms
+				err = json.Unmarshal(marshalled, &terms)
+				if err != nil {
+					return err
+				}
+				additionalProperties["highlightTerms"] = &terms
+			}
+		}
+
 		if prop, ok := additionalProperties["group"]; ok {
 			if groupMap, ok := prop.(map[string]interface{}); ok {
 				marshalled, err := json.Marshal(groupMap)
@@ -1519,6 +1753,8 @@ func (ko *Object) DeepCopyDangerous() *Object {
 		Vector:            deepCopyVector(ko.Vector),
 		Vectors:           deepCopyVectorsMap(ko.Vectors),
 		MultiVectors:      deepCopyMultiVectorsMap(ko.MultiVectors),
+		VectorDataTypes:   deepCopyVectorDataTypes(ko.VectorDataTypes),
+		SparseVectors:     deepCopySparseVectorsMap(ko.SparseVectors),
 	}
 
 	return o
@@ -1531,6 +1767,41 @@ func AddOwnership(objs []*Object, node, shard string) {
 	}
 }
 
+func deepCopyVectorDataTypes(orig map[string]string) map[string]string {
+	if orig == nil {
+		return nil
+	}
+	out := make(map[string]string, len(orig))
+	for name, dataType := range orig {
+		out[name] = dataType
+	}
+	return out
+}
+
+func deepCopySparseVectorsMap(orig map[string]*models.SparseVector) map[string]*models.SparseVector {
+	if orig == nil {
+		return nil
+	}
+	out := make(map[string]*models.SparseVector, len(orig))
+	for name, vec := range orig {
+		out[name] = deepCopySparseVector(vec)
+	}
+	return out
+}
+
+func deepCopySparseVector(orig *models.SparseVector) *models.SparseVector {
+	if orig == nil {
+		return nil
+	}
+	out := &models.SparseVector{
+		Indices: make([]uint32, len(orig.Indices)),
+		Values:  make([]float32, len(orig.Values)),
+	}
+	copy(out.Indices, orig.Indices)
+	copy(out.Values, orig.Values)
+	return out
+}
+
 func deepCopyVector(orig []float32) []float32 {
 	out := make([]float32, len(orig))
 	copy(out, orig)
@@ -1551,6 +1822,8 @@ func deepCopyVectors(orig models.Vectors) models.Vectors {
 			out[key] = deepCopyVector(v)
 		case [][]float32:
 			out[key] = deepCopyMultiVector(v)
+		case *models.SparseVector:
+			out[key] = deepCopySparseVector(v)
 		default:
 			// do nothing
 		}
@@ -398,6 +398,146 @@ func TestStorageObjectUnMarshallingMultiVector(t *testing.T) {
 	})
 }
 
+func TestStorageObjectMarshallingVectorDataTypes(t *testing.T) {
+	before := FromObject(
+		&models.Object{
+			Class:              "MyFavoriteClass",
+			CreationTimeUnix:   123456,
+			LastUpdateTimeUnix: 56789,
+			ID:                 strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties: map[string]interface{}{
+				"name": "MyName",
+			},
+		},
+		nil,
+		map[string][]float32{
+			"fp32":  {0.1, 0.2, 0.3},
+			"fp16":  {0.5, -1.25, 2048},
+			"int8":  {-128, 0, 127},
+			"uint8": {0, 128, 255},
+		},
+		map[string][][]float32{
+			"multi": {{1, 2}, {3, 4}},
+		},
+	)
+	before.DocID = 7
+	before.VectorDataTypes = map[string]string{
+		"fp16":  "float16",
+		"int8":  "int8",
+		"uint8": "uint8",
+	}
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	t.Run("float32 only keeps previous layout", func(t *testing.T) {
+		float32Only := FromObject(&before.Object, nil,
+			map[string][]float32{"fp32": before.Vectors["fp32"]}, nil)
+		withoutDataTypes, err := float32Only.MarshalBinary()
+		require.Nil(t, err)
+		float32Only.VectorDataTypes = map[string]string{"fp32": "float32"}
+		withDataTypes, err := float32Only.MarshalBinary()
+		require.Nil(t, err)
+		assert.Equal(t, withoutDataTypes, withDataTypes)
+	})
+
+	t.Run("UnmarshalBinary", func(t *testing.T) {
+		after := &Object{}
+		require.Nil(t, after.UnmarshalBinary(asBinary))
+		assert.Equal(t, before.Vectors, after.Vectors)
+		assert.Equal(t, before.MultiVectors, after.MultiVectors)
+		assert.Equal(t, before.VectorDataTypes, after.VectorDataTypes)
+	})
+
+	t.Run("FromBinaryOptional", func(t *testing.T) {
+		after, err := FromBinaryOptional(asBinary, additional.Properties{
+			Vectors: []string{"int8", "multi"},
+		}, nil)
+		require.Nil(t, err)
+		assert.Equal(t, before.Vectors, after.Vectors)
+		assert.Equal(t, before.MultiVectors, after.MultiVectors)
+	})
+
+	t.Run("VectorFromBinary", func(t *testing.T) {
+		for name, vec := range before.Vectors {
+			after, err := VectorFromBinary(asBinary, nil, name)
+			require.Nil(t, err)
+			assert.Equal(t, vec, after)
+		}
+	})
+
+	t.Run("MultiVectorFromBinary", func(t *testing.T) {
+		after, err := MultiVectorFromBinary(asBinary, nil, "multi")
+		require.Nil(t, err)
+		assert.Equal(t, before.MultiVectors["multi"], after)
+	})
+
+	t.Run("value not representable in data type", func(t *testing.T) {
+		before.Vectors["int8"] = []float32{0.5, 0, 1}
+		defer func() { before.Vectors["int8"] = []float32{-128, 0, 127} }()
+		_, err := before.MarshalBinary()
+		require.ErrorContains(t, err, "int8")
+	})
+}
+
+func TestStorageObjectMarshallingSparseVectors(t *testing.T) {
+	sparseVec := &models.SparseVector{Indices: []uint32{3, 17, 2048}, Values: []float32{0.5, 1.25, 0.125}}
+	obj := &models.Object{
+		Class: "MyFavoriteClass",
+		ID:    strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+		Properties: map[string]interface{}{
+			"name": "MyName",
+		},
+		Vectors: models.Vectors{"sparse": sparseVec},
+	}
+
+	t.Run("with dense and multi vectors", func(t *testing.T) {
+		before := FromObject(obj, nil,
+			map[string][]float32{"int8": {-128, 0, 127}},
+			map[string][][]float32{"multi": {{1, 2}, {3, 4}}},
+		)
+		before.DocID = 7
+		before.VectorDataTypes = map[string]string{"int8": "int8"}
+		require.Equal(t, sparseVec, before.SparseVectors["sparse"])
+
+		asBinary, err := before.MarshalBinary()
+		require.Nil(t, err)
+
+		after := &Object{}
+		require.Nil(t, after.UnmarshalBinary(asBinary))
+		assert.Equal(t, before.Vectors, after.Vectors)
+		assert.Equal(t, before.MultiVectors, after.MultiVectors)
+		assert.Equal(t, before.VectorDataTypes, after.VectorDataTypes)
+		assert.Equal(t, before.SparseVectors, after.SparseVectors)
+
+		optional, err := FromBinaryOptional(asBinary, additional.Properties{
+			Vectors: []string{"sparse"},
+		}, nil)
+		require.Nil(t, err)
+		assert.Equal(t, before.SparseVectors, optional.SparseVectors)
+		assert.Equal(t, sparseVec, optional.GetVectors()["sparse"])
+	})
+
+	t.Run("sparse vectors only", func(t *testing.T) {
+		before := FromObject(obj, nil, nil, nil)
+		asBinary, err := before.MarshalBinary()
+		require.Nil(t, err)
+
+		after := &Object{}
+		require.Nil(t, after.UnmarshalBinary(asBinary))
+		assert.Nil(t, after.Vectors)
+		assert.Nil(t, after.VectorDataTypes)
+		assert.Equal(t, before.SparseVectors, after.SparseVectors)
+	})
+
+	t.Run("deep copy", func(t *testing.T) {
+		before := FromObject(obj, nil, nil, nil)
+		copied := before.DeepCopyDangerous()
+		copied.SparseVectors["sparse"].Values[0] = 9
+		assert.Equal(t, float32(0.5), before.SparseVectors["sparse"].Values[0])
+	})
+}
+
 func TestFilteringNilProperty(t *testing.T) {
 	object := FromObject(
 		&models.Object{
@@ -845,6 +985,69 @@ func TestStorageObjectMarshallingWithGroup(t *testing.T) {
 	})
 }
 
+func TestStorageObjectMarshallingWithQueryProfile(t *testing.T) {
+	profiles := []additional.VectorSearchProfile{{
+		Shard:          "shard1",
+		TargetVector:   "title",
+		FilterStrategy: additional.FilterStrategyAcorn,
+		Reason:         "selectivity of 0.0100 is below the acornFilterRatio of 0.40",
+		Selectivity:    0.01,
+		AllowListSize:  100,
+		IndexSize:      10000,
+	}}
+	before := FromObject(
+		&models.Object{
+			Class: "MyFavoriteClass",
+			ID:    strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Additional: models.AdditionalProperties{
+				"queryProfile": profiles,
+			},
+			Properties: map[string]interface{}{},
+		},
+		nil, nil, nil,
+	)
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	after, err := FromBinary(asBinary)
+	require.Nil(t, err)
+	assert.Equal(t, profiles, after.AdditionalProperties()["queryProfile"])
+
+	res := after.SearchResult(additional.Properties{QueryProfile: true}, "")
+	assert.Equal(t, profiles, res.AdditionalProperties["queryProfile"])
+}
+
+func TestStorageObjectMarshallingWithHighlightTerms(t *testing.T) {
+	terms := &additional.HighlightTerms{
+		Terms:   map[string][]string{"word": {"quick", "quack"}},
+		Phrases: []additional.HighlightPhrase{{Text: "brown fox", Slop: 1}},
+	}
+	before := FromObject(
+		&models.Object{
+			Class: "MyFavoriteClass",
+			ID:    strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Additional: models.AdditionalProperties{
+				"highlightTerms": terms,
+			},
+			Properties: map[string]interface{}{},
+		},
+		nil, nil, nil,
+	)
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	after, err := FromBinary(asBinary)
+	require.Nil(t, err)
+	assert.Equal(t, terms, after.AdditionalProperties()["highlightTerms"])
+
+	res := after.SearchResult(additional.Properties{}, "")
+	assert.NotContains(t, res.AdditionalProperties, "highlightTerms")
+	res = after.SearchResult(additional.Properties{Highlights: &additional.Highlights{}}, "")
+	assert.Equal(t, terms, res.AdditionalProperties["highlightTerms"])
+}
+
 func TestStorageMaxVectorDimensionsObjectMarshalling(t *testing.T) {
 	generateVector := func(dims uint16) []float32 {
 		vector := make([]float32, dims)
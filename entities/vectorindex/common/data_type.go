@@ -0,0 +1,221 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// Data types in which the dimensions of a vector can be stored. Vectors are
+// always passed around as float32, the data type only determines how they are
+// encoded at rest and which distancer is used on the encoded vectors.
+const (
+	DataTypeFloat32 = "float32"
+	DataTypeFloat16 = "float16"
+	DataTypeInt8    = "int8"
+	DataTypeUint8   = "uint8"
+
+	DefaultDataType = DataTypeFloat32
+)
+
+// ValidateDataType checks that the given data type is supported, an empty data
+// type stands for the default.
+func ValidateDataType(dataType string) error {
+	switch dataType {
+	case "", DataTypeFloat32, DataTypeFloat16, DataTypeInt8, DataTypeUint8:
+		return nil
+	default:
+		return fmt.Errorf("invalid dataType %q, must be one of %q, %q, %q or %q", dataType,
+			DataTypeFloat32, DataTypeFloat16, DataTypeInt8, DataTypeUint8)
+	}
+}
+
+// ValidateFloat32DataType checks that no data type other than float32 is set.
+// It is used by the indexes which keep their vectors in memory as float32
+// instead of storing and comparing them in their data type.
+func ValidateFloat32DataType(dataType string) error {
+	if err := ValidateDataType(dataType); err != nil {
+		return err
+	}
+	if DataTypeOrDefault(dataType) != DataTypeFloat32 {
+		return fmt.Errorf("dataType %q is only supported by the flat, hnsw and dynamic indexes", dataType)
+	}
+	return nil
+}
+
+// DataTypeOrDefault returns the data type, or float32 if it is not set
+func DataTypeOrDefault(dataType string) string {
+	if dataType == "" {
+		return DefaultDataType
+	}
+	return dataType
+}
+
+// BytesPerDimension returns the size of a single encoded dimension
+func BytesPerDimension(dataType string) int {
+	switch dataType {
+	case DataTypeFloat16:
+		return 2
+	case DataTypeInt8, DataTypeUint8:
+		return 1
+	default:
+		return 4
+	}
+}
+
+// EncodeVector encodes the vector in the given data type. Integer data types
+// only accept whole numbers within their range, so that no precision is lost
+// silently.
+func EncodeVector(dataType string, vector []float32) ([]byte, error) {
+	out := make([]byte, len(vector)*BytesPerDimension(dataType))
+	return out, EncodeVectorInto(dataType, vector, out)
+}
+
+// EncodeVectorInto is EncodeVector writing into a buffer of at least
+// len(vector)*BytesPerDimension(dataType) bytes
+func EncodeVectorInto(dataType string, vector []float32, out []byte) error {
+	switch dataType {
+	case DataTypeFloat16:
+		for i, v := range vector {
+			if math.Abs(float64(v)) > maxFloat16 {
+				return fmt.Errorf("float16 vector value %v at position %d is out of range", v, i)
+			}
+			binary.LittleEndian.PutUint16(out[i*2:], Float16FromFloat32(v))
+		}
+	case DataTypeInt8:
+		for i, v := range vector {
+			if v != float32(math.Trunc(float64(v))) || v < math.MinInt8 || v > math.MaxInt8 {
+				return fmt.Errorf("int8 vector value %v at position %d is not an integer in [%d, %d]",
+					v, i, math.MinInt8, math.MaxInt8)
+			}
+			out[i] = byte(int8(v))
+		}
+	case DataTypeUint8:
+		for i, v := range vector {
+			if v != float32(math.Trunc(float64(v))) || v < 0 || v > math.MaxUint8 {
+				return fmt.Errorf("uint8 vector value %v at position %d is not an integer in [0, %d]",
+					v, i, math.MaxUint8)
+			}
+			out[i] = byte(v)
+		}
+	default:
+		for i, v := range vector {
+			binary.LittleEndian.PutUint32(out[i*4:], math.Float32bits(v))
+		}
+	}
+	return nil
+}
+
+// DecodeVector decodes a vector encoded with EncodeVector. The buffer is used
+// if it is large enough.
+func DecodeVector(dataType string, in []byte, buffer []float32) []float32 {
+	dims := len(in) / BytesPerDimension(dataType)
+
+	var out []float32
+	if cap(buffer) >= dims {
+		out = buffer[:dims]
+	} else {
+		out = make([]float32, dims)
+	}
+
+	switch dataType {
+	case DataTypeFloat16:
+		for i := range out {
+			out[i] = Float32FromFloat16(binary.LittleEndian.Uint16(in[i*2:]))
+		}
+	case DataTypeInt8:
+		for i := range out {
+			out[i] = float32(int8(in[i]))
+		}
+	case DataTypeUint8:
+		for i := range out {
+			out[i] = float32(in[i])
+		}
+	default:
+		for i := range out {
+			out[i] = math.Float32frombits(binary.LittleEndian.Uint32(in[i*4:]))
+		}
+	}
+	return out
+}
+
+// maxFloat16 is the largest finite value of an IEEE 754 half-precision float
+const maxFloat16 = 65504
+
+// Float16FromFloat32 converts to the bits of the nearest IEEE 754
+// half-precision float, rounding half to even
+func Float16FromFloat32(f float32) uint16 {
+	bits := math.Float32bits(f)
+	sign := uint16(bits>>16) & 0x8000
+	exp := int32(bits>>23) & 0xff
+	mant := bits & 0x7fffff
+
+	switch {
+	case exp == 0xff:
+		// infinity and NaN
+		if mant != 0 {
+			return sign | 0x7e00
+		}
+		return sign | 0x7c00
+	case exp-127 > 15:
+		return sign | 0x7c00
+	case exp-127 >= -14:
+		// normal half
+		half := uint32(exp-127+15)<<10 | mant>>13
+		rest := mant & 0x1fff
+		if rest > 0x1000 || (rest == 0x1000 && half&1 == 1) {
+			// may carry into the exponent, which is the correct rounding
+			half++
+		}
+		return sign | uint16(half)
+	case exp-127 >= -25:
+		// subnormal half
+		mant |= 0x800000
+		shift := uint32(-(exp - 127) - 14 + 13)
+		half := mant >> shift
+		rest := mant & (1<<shift - 1)
+		halfway := uint32(1) << (shift - 1)
+		if rest > halfway || (rest == halfway && half&1 == 1) {
+			half++
+		}
+		return sign | uint16(half)
+	default:
+		return sign
+	}
+}
+
+// Float32FromFloat16 converts the bits of an IEEE 754 half-precision float
+func Float32FromFloat16(h uint16) float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mant := uint32(h & 0x3ff)
+
+	switch exp {
+	case 0:
+		if mant == 0 {
+			return math.Float32frombits(sign)
+		}
+		// subnormal half, normalize it
+		e := uint32(127 - 15 + 1)
+		for mant&0x400 == 0 {
+			mant <<= 1
+			e--
+		}
+		return math.Float32frombits(sign | e<<23 | (mant&0x3ff)<<13)
+	case 0x1f:
+		return math.Float32frombits(sign | 0x7f800000 | mant<<13)
+	default:
+		return math.Float32frombits(sign | (exp+127-15)<<23 | mant<<13)
+	}
+}
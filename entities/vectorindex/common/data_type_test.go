@@ -0,0 +1,102 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecodeVector(t *testing.T) {
+	tests := []struct {
+		dataType string
+		vector   []float32
+		size     int
+	}{
+		{dataType: "", vector: []float32{0.1, -2.5, 3e10}, size: 12},
+		{dataType: DataTypeFloat32, vector: []float32{0.1, -2.5, 3e10}, size: 12},
+		{dataType: DataTypeFloat16, vector: []float32{0.5, -2.5, 1024, 65504, 0}, size: 10},
+		{dataType: DataTypeInt8, vector: []float32{-128, -1, 0, 1, 127}, size: 5},
+		{dataType: DataTypeUint8, vector: []float32{0, 1, 128, 255}, size: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(DataTypeOrDefault(tt.dataType), func(t *testing.T) {
+			encoded, err := EncodeVector(tt.dataType, tt.vector)
+			require.NoError(t, err)
+			assert.Len(t, encoded, tt.size)
+			assert.Equal(t, tt.vector, DecodeVector(tt.dataType, encoded, nil))
+		})
+	}
+}
+
+func TestEncodeVectorOutOfRange(t *testing.T) {
+	tests := []struct {
+		dataType string
+		vector   []float32
+		error    string
+	}{
+		{dataType: DataTypeFloat16, vector: []float32{1, 70000}, error: "float16 vector value 70000 at position 1 is out of range"},
+		{dataType: DataTypeInt8, vector: []float32{128}, error: "int8 vector value 128 at position 0 is not an integer in [-128, 127]"},
+		{dataType: DataTypeInt8, vector: []float32{0.5}, error: "int8 vector value 0.5 at position 0 is not an integer in [-128, 127]"},
+		{dataType: DataTypeUint8, vector: []float32{-1}, error: "uint8 vector value -1 at position 0 is not an integer in [0, 255]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dataType, func(t *testing.T) {
+			_, err := EncodeVector(tt.dataType, tt.vector)
+			require.EqualError(t, err, tt.error)
+		})
+	}
+}
+
+func TestFloat16Conversion(t *testing.T) {
+	t.Run("exact values", func(t *testing.T) {
+		for bits, value := range map[uint16]float32{
+			0x0000: 0,
+			0x3c00: 1,
+			0xc000: -2,
+			0x3555: 0.333251953125,
+			0x7bff: 65504,
+			0x0400: 6.103515625e-05,       // smallest normal
+			0x0001: 5.960464477539063e-08, // smallest subnormal
+			0x03ff: 6.097555160522461e-05, // largest subnormal
+		} {
+			assert.Equal(t, bits, Float16FromFloat32(value), "%v", value)
+			assert.Equal(t, value, Float32FromFloat16(bits), "%#x", bits)
+		}
+	})
+
+	t.Run("rounding", func(t *testing.T) {
+		assert.Equal(t, uint16(0x3c00), Float16FromFloat32(1+1.0/4096)) // below halfway
+		assert.Equal(t, uint16(0x3c01), Float16FromFloat32(1+3.0/4096)) // above halfway
+		assert.Equal(t, uint16(0x3c00), Float16FromFloat32(1+1.0/2048)) // halfway, to even
+		assert.Equal(t, uint16(0x3c02), Float16FromFloat32(1+3.0/2048)) // halfway, to even
+		assert.Equal(t, uint16(0x0000), Float16FromFloat32(1e-10))      // underflow
+		assert.Equal(t, uint16(0x7c00), Float16FromFloat32(1e10))       // overflow
+		assert.Equal(t, uint16(0xfc00), Float16FromFloat32(float32(math.Inf(-1))))
+		assert.True(t, math.IsNaN(float64(Float32FromFloat16(Float16FromFloat32(float32(math.NaN()))))))
+	})
+
+	t.Run("round trip of all halfs", func(t *testing.T) {
+		for bits := 0; bits <= math.MaxUint16; bits++ {
+			h := uint16(bits)
+			if h&0x7c00 == 0x7c00 && h&0x3ff != 0 {
+				continue // NaN payloads are not preserved
+			}
+			assert.Equal(t, h, Float16FromFloat32(Float32FromFloat16(h)))
+		}
+	})
+}
@@ -15,10 +15,12 @@ import (
 	"fmt"
 
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
 	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	hfresh "github.com/weaviate/weaviate/entities/vectorindex/hfresh"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
 )
 
 const (
@@ -27,6 +29,7 @@ const (
 	VectorIndexTypeFLAT    = "flat"
 	VectorIndexTypeDYNAMIC = "dynamic"
 	VectorIndexTypeHFresh  = "hfresh"
+	VectorIndexTypeSparse  = "sparse"
 )
 
 // ParseAndValidateConfig from an unknown input value, as this is not further
@@ -45,7 +48,43 @@ func ParseAndValidateConfig(input interface{}, vectorIndexType string, isMultiVe
 		return dynamic.ParseAndValidateConfig(input, isMultiVector)
 	case VectorIndexTypeHFresh:
 		return hfresh.ParseAndValidateConfig(input, isMultiVector)
+	case VectorIndexTypeSparse:
+		if isMultiVector {
+			return nil, fmt.Errorf("sparse index does not support multi vectors")
+		}
+		return sparse.ParseAndValidateConfig(input)
 	default:
 		return nil, fmt.Errorf("invalid vector index %q. Supported types are hnsw and flat", vectorIndexType)
 	}
 }
+
+// DataType returns the data type in which the vectors of an index are stored,
+// float32 if none is configured
+func DataType(config schemaConfig.VectorIndexConfig) string {
+	var dataType string
+	switch uc := config.(type) {
+	case hnsw.UserConfig:
+		dataType = uc.DataType
+	case flat.UserConfig:
+		dataType = uc.DataType
+	case dynamic.UserConfig:
+		dataType = uc.DataType
+	case hfresh.UserConfig:
+		dataType = uc.DataType
+	}
+	return common.DataTypeOrDefault(dataType)
+}
+
+// Compression returns the quantizer the graph of an index compresses its
+// vectors with, see hnsw.UserConfig.Compression. Indexes that cannot switch
+// their quantizer in place return an empty string.
+func Compression(config schemaConfig.VectorIndexConfig) string {
+	switch uc := config.(type) {
+	case hnsw.UserConfig:
+		return uc.Compression()
+	case dynamic.UserConfig:
+		return uc.HnswUC.Compression()
+	default:
+		return ""
+	}
+}
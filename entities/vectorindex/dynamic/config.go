@@ -30,6 +30,7 @@ type UserConfig struct {
 	Threshold uint64          `json:"threshold"`
 	HnswUC    hnsw.UserConfig `json:"hnsw"`
 	FlatUC    flat.UserConfig `json:"flat"`
+	DataType  string          `json:"dataType,omitempty"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -87,6 +88,15 @@ func ParseAndValidateConfig(input interface{}, isMultiVector bool) (schemaConfig
 		return uc, err
 	}
 
+	if err := common.OptionalStringFromMap(asMap, "dataType", func(v string) {
+		uc.DataType = v
+	}); err != nil {
+		return uc, err
+	}
+	if err := common.ValidateDataType(uc.DataType); err != nil {
+		return uc, err
+	}
+
 	hnswConfig, ok := asMap["hnsw"]
 	if ok && hnswConfig != nil {
 		hnswUC, err := hnsw.ParseAndValidateConfig(hnswConfig, isMultiVector)
@@ -106,20 +116,27 @@ func ParseAndValidateConfig(input interface{}, isMultiVector bool) (schemaConfig
 	}
 
 	flatConfig, ok := asMap["flat"]
-	if !ok || flatConfig == nil {
-		return uc, nil
-	}
+	if ok && flatConfig != nil {
+		flatUC, err := flat.ParseAndValidateConfig(flatConfig)
+		if err != nil {
+			return uc, err
+		}
 
-	flatUC, err := flat.ParseAndValidateConfig(flatConfig)
-	if err != nil {
-		return uc, err
+		castedFlatUC, ok := flatUC.(flat.UserConfig)
+		if !ok {
+			return uc, fmt.Errorf("invalid flat configuration")
+		}
+		uc.FlatUC = castedFlatUC
 	}
 
-	castedFlatUC, ok := flatUC.(flat.UserConfig)
-	if !ok {
-		return uc, fmt.Errorf("invalid flat configuration")
+	// both indexes store the vectors of the dynamic index, so they need to
+	// agree on the data type
+	uc.HnswUC.DataType = uc.DataType
+	uc.FlatUC.DataType = uc.DataType
+	hnswCompressed := uc.HnswUC.PQ.Enabled || uc.HnswUC.BQ.Enabled || uc.HnswUC.SQ.Enabled || uc.HnswUC.RQ.Enabled
+	if hnswCompressed && common.DataTypeOrDefault(uc.DataType) != common.DataTypeFloat32 {
+		return uc, fmt.Errorf("dataType %q can not be combined with hnsw compression", uc.DataType)
 	}
-	uc.FlatUC = castedFlatUC
 
 	return uc, nil
 }
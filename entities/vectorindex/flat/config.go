@@ -49,6 +49,7 @@ type UserConfig struct {
 	RQ                       RQUserConfig          `json:"rq"`
 	SkipDefaultQuantization  bool                  `json:"skipDefaultQuantization"`
 	TrackDefaultQuantization bool                  `json:"trackDefaultQuantization"`
+	DataType                 string                `json:"dataType,omitempty"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -122,6 +123,15 @@ func ParseAndValidateConfig(input interface{}) (schemaConfig.VectorIndexConfig,
 		return uc, err
 	}
 
+	if err := vectorindexcommon.OptionalStringFromMap(asMap, "dataType", func(v string) {
+		uc.DataType = v
+	}); err != nil {
+		return uc, err
+	}
+	if err := vectorindexcommon.ValidateDataType(uc.DataType); err != nil {
+		return uc, err
+	}
+
 	if err := parseCompression(asMap, &uc); err != nil {
 		return uc, err
 	}
@@ -255,6 +255,46 @@ func Test_FlatUserConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "float16 data type",
+			input: map[string]interface{}{
+				"dataType": "float16",
+			},
+			expected: UserConfig{
+				VectorCacheMaxObjects: common.DefaultVectorCacheMaxObjects,
+				Distance:              common.DefaultDistanceMetric,
+				DataType:              common.DataTypeFloat16,
+				PQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+				BQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+				SQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+				RQ: RQUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+					Bits:         DefaultRQBits,
+				},
+			},
+		},
+		{
+			name: "invalid data type",
+			input: map[string]interface{}{
+				"dataType": "bfloat16",
+			},
+			expectErr:    true,
+			expectErrMsg: `invalid dataType "bfloat16", must be one of "float32", "float16", "int8" or "uint8"`,
+		},
 		{
 			name: "rq enabled with invalid bits",
 			input: map[string]interface{}{
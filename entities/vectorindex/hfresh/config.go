@@ -35,6 +35,7 @@ type UserConfig struct {
 	RNGFactor      float32 `json:"rngFactor"`
 	SearchProbe    uint32  `json:"searchProbe"`
 	Distance       string  `json:"distance"`
+	DataType       string  `json:"dataType,omitempty"`
 	// TODO: add quantization config
 }
 
@@ -121,6 +122,15 @@ func ParseAndValidateConfig(input interface{}, isMultiVector bool) (schemaConfig
 		return uc, err
 	}
 
+	if err := vectorIndexCommon.OptionalStringFromMap(asMap, "dataType", func(v string) {
+		uc.DataType = v
+	}); err != nil {
+		return uc, err
+	}
+	if err := vectorIndexCommon.ValidateFloat32DataType(uc.DataType); err != nil {
+		return uc, err
+	}
+
 	// TODO: add quantization config
 
 	return uc, nil
@@ -35,6 +35,9 @@ const (
 
 	FilterStrategySweeping = "sweeping"
 	FilterStrategyAcorn    = "acorn"
+	// FilterStrategyAdaptive picks brute force, ACORN or sweeping per query
+	// from the estimated selectivity of the filter
+	FilterStrategyAdaptive = "adaptive"
 
 	DefaultFilterStrategy = FilterStrategyAcorn
 
@@ -65,6 +68,7 @@ type UserConfig struct {
 	Multivector              MultivectorConfig `json:"multivector"`
 	SkipDefaultQuantization  bool              `json:"skipDefaultQuantization"`
 	TrackDefaultQuantization bool              `json:"trackDefaultQuantization"`
+	DataType                 string            `json:"dataType,omitempty"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -81,6 +85,24 @@ func (u UserConfig) IsMultiVector() bool {
 	return u.Multivector.Enabled
 }
 
+// Compression names the quantizer the vectors are compressed with, including
+// its settings which require the compressed vectors to be encoded again, or
+// an empty string if the vectors are not compressed
+func (u UserConfig) Compression() string {
+	switch {
+	case u.PQ.Enabled:
+		return "pq"
+	case u.BQ.Enabled:
+		return "bq"
+	case u.SQ.Enabled:
+		return "sq"
+	case u.RQ.Enabled:
+		return fmt.Sprintf("rq-%d", u.RQ.Bits)
+	default:
+		return ""
+	}
+}
+
 // SetDefaults in the user-specifyable part of the config
 func (u *UserConfig) SetDefaults() {
 	u.MaxConnections = DefaultMaxConnections
@@ -118,8 +140,9 @@ func (u *UserConfig) SetDefaults() {
 		Bits:         DefaultRQBits,
 		RescoreLimit: DefaultRQRescoreLimit,
 	}
-	if strategy := os.Getenv("HNSW_DEFAULT_FILTER_STRATEGY"); strategy == FilterStrategySweeping {
-		u.FilterStrategy = FilterStrategySweeping
+	if strategy := os.Getenv("HNSW_DEFAULT_FILTER_STRATEGY"); strategy == FilterStrategySweeping ||
+		strategy == FilterStrategyAdaptive {
+		u.FilterStrategy = strategy
 	} else {
 		u.FilterStrategy = FilterStrategyAcorn
 	}
@@ -254,6 +277,12 @@ func ParseAndValidateConfig(input interface{}, isMultiVector bool) (config.Vecto
 		return uc, err
 	}
 
+	if err := vectorIndexCommon.OptionalStringFromMap(asMap, "dataType", func(v string) {
+		uc.DataType = v
+	}); err != nil {
+		return uc, err
+	}
+
 	return uc, uc.validate()
 }
 
@@ -280,8 +309,19 @@ func (u *UserConfig) validate() error {
 		))
 	}
 
-	if u.FilterStrategy != FilterStrategySweeping && u.FilterStrategy != FilterStrategyAcorn {
-		errMsgs = append(errMsgs, "filterStrategy must be either 'sweeping' or 'acorn'")
+	if u.FilterStrategy != FilterStrategySweeping && u.FilterStrategy != FilterStrategyAcorn &&
+		u.FilterStrategy != FilterStrategyAdaptive {
+		errMsgs = append(errMsgs, "filterStrategy must be one of 'sweeping', 'acorn' or 'adaptive'")
+	}
+
+	if err := vectorIndexCommon.ValidateDataType(u.DataType); err != nil {
+		errMsgs = append(errMsgs, err.Error())
+	} else if u.Multivector.Enabled && vectorIndexCommon.DataTypeOrDefault(u.DataType) != vectorIndexCommon.DataTypeFloat32 {
+		errMsgs = append(errMsgs, "dataType must be float32 for multi vectors")
+	}
+
+	if err := ValidateMultivectorConfig(u.Multivector); err != nil {
+		errMsgs = append(errMsgs, err.Error())
 	}
 
 	if len(errMsgs) > 0 {
@@ -305,6 +345,11 @@ func (u *UserConfig) validate() error {
 	if enabled > 1 {
 		return fmt.Errorf("invalid hnsw config: more than a single compression methods enabled")
 	}
+	// vectors stored in another data type than float32 take the place of the
+	// compressed vectors in memory
+	if enabled > 0 && vectorIndexCommon.DataTypeOrDefault(u.DataType) != vectorIndexCommon.DataTypeFloat32 {
+		return fmt.Errorf("invalid hnsw config: dataType %q can not be combined with compression", u.DataType)
+	}
 
 	err := ValidateRQConfig(u.RQ)
 	if err != nil {
@@ -337,7 +382,8 @@ func ParseDefaultQuantization(vectorIndexConfig config.VectorIndexConfig, compre
 	sqEnabled := hnswConfig.SQ.Enabled
 	rqEnabled := hnswConfig.RQ.Enabled
 	bqEnabled := hnswConfig.BQ.Enabled
-	skipDefaultQuantization := hnswConfig.SkipDefaultQuantization
+	skipDefaultQuantization := hnswConfig.SkipDefaultQuantization ||
+		vectorIndexCommon.DataTypeOrDefault(hnswConfig.DataType) != vectorIndexCommon.DataTypeFloat32
 	hnswConfig.TrackDefaultQuantization = false
 	if pqEnabled || sqEnabled || rqEnabled || bqEnabled || skipDefaultQuantization {
 		return hnswConfig, nil
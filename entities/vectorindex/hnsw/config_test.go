@@ -785,7 +785,37 @@ func Test_UserConfig(t *testing.T) {
 				"filterStrategy": "chestnut",
 			},
 			expectErr:    true,
-			expectErrMsg: "invalid hnsw config: filterStrategy must be either 'sweeping' or 'acorn'",
+			expectErrMsg: "invalid hnsw config: filterStrategy must be one of 'sweeping', 'acorn' or 'adaptive'",
+		},
+		{
+			name: "with invalid data type",
+			input: map[string]interface{}{
+				"dataType": "float64",
+			},
+			expectErr:    true,
+			expectErrMsg: `invalid hnsw config: invalid dataType "float64", must be one of "float32", "float16", "int8" or "uint8"`,
+		},
+		{
+			name: "with int8 multi vectors",
+			input: map[string]interface{}{
+				"dataType": "int8",
+				"multivector": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "invalid hnsw config: dataType must be float32 for multi vectors",
+		},
+		{
+			name: "with int8 and bq",
+			input: map[string]interface{}{
+				"dataType": "int8",
+				"bq": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: `invalid hnsw config: dataType "int8" can not be combined with compression`,
 		},
 		{
 			name: "acorn enabled, all defaults",
@@ -1145,4 +1175,56 @@ func Test_UserConfigFilterStrategy(t *testing.T) {
 		assert.Equal(t, FilterStrategySweeping, cfg.FilterStrategy)
 		assert.Nil(t, os.Unsetenv("HNSW_DEFAULT_FILTER_STRATEGY"))
 	})
+
+	t.Run("can default to the adaptive strategy", func(t *testing.T) {
+		t.Setenv("HNSW_DEFAULT_FILTER_STRATEGY", FilterStrategyAdaptive)
+		cfg := UserConfig{}
+		cfg.SetDefaults()
+		assert.Equal(t, FilterStrategyAdaptive, cfg.FilterStrategy)
+	})
+}
+
+func Test_UserConfigMultivectorAggregation(t *testing.T) {
+	t.Run("aggregation without muvera settings", func(t *testing.T) {
+		cfg, err := ParseAndValidateConfig(map[string]interface{}{
+			"multivector": map[string]interface{}{
+				"enabled":     true,
+				"aggregation": "topKMean",
+				"topK":        json.Number("5"),
+			},
+		}, false)
+		require.Nil(t, err)
+		multivector := cfg.(UserConfig).Multivector
+		assert.Equal(t, MultivectorAggregationTopKMean, multivector.Aggregation)
+		assert.Equal(t, 5, multivector.TopK)
+	})
+
+	for _, aggregation := range []string{
+		MultivectorAggregationMaxSim, MultivectorAggregationMaxSimNormalized,
+		MultivectorAggregationAvgPool, MultivectorAggregationTopKMean,
+	} {
+		t.Run("valid "+aggregation, func(t *testing.T) {
+			assert.Nil(t, ValidateMultivectorAggregation(aggregation))
+		})
+	}
+
+	t.Run("invalid aggregation", func(t *testing.T) {
+		_, err := ParseAndValidateConfig(map[string]interface{}{
+			"multivector": map[string]interface{}{
+				"enabled":     true,
+				"aggregation": "minSim",
+			},
+		}, false)
+		assert.ErrorContains(t, err, "invalid aggregation type minSim")
+	})
+
+	t.Run("negative topK", func(t *testing.T) {
+		_, err := ParseAndValidateConfig(map[string]interface{}{
+			"multivector": map[string]interface{}{
+				"enabled": true,
+				"topK":    json.Number("-1"),
+			},
+		}, false)
+		assert.ErrorContains(t, err, "topK")
+	})
 }
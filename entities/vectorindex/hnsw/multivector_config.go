@@ -18,7 +18,17 @@ import (
 )
 
 const (
+	// MultivectorAggregationMaxSim sums the best match of every query vector
 	MultivectorAggregationMaxSim = "maxSim"
+	// MultivectorAggregationMaxSimNormalized divides maxSim by the number of
+	// query vectors, so that scores of queries of different length compare
+	MultivectorAggregationMaxSimNormalized = "maxSimNormalized"
+	// MultivectorAggregationAvgPool compares the mean of the query vectors with
+	// the mean of the document vectors
+	MultivectorAggregationAvgPool = "avgPool"
+	// MultivectorAggregationTopKMean sums the mean of the topK best matches of
+	// every query vector
+	MultivectorAggregationTopKMean = "topKMean"
 )
 
 const (
@@ -28,6 +38,7 @@ const (
 	DefaultMultivectorDProjections  = 16
 	DefaultMultivectorRepetitions   = 10
 	DefaultMultivectorAggregation   = "maxSim"
+	DefaultMultivectorTopK          = 3
 )
 
 // Multivector configuration
@@ -35,6 +46,9 @@ type MultivectorConfig struct {
 	Enabled      bool         `json:"enabled"`
 	MuveraConfig MuveraConfig `json:"muvera"`
 	Aggregation  string       `json:"aggregation"`
+	// TopK is the number of best matches per query vector averaged by the
+	// topKMean aggregation, DefaultMultivectorTopK if not set
+	TopK int `json:"topK,omitempty"`
 }
 
 type MuveraConfig struct {
@@ -44,9 +58,11 @@ type MuveraConfig struct {
 	Repetitions  int  `json:"repetitions"`
 }
 
-func validAggregation(v string) error {
+// ValidateMultivectorAggregation checks that v is a supported aggregation
+func ValidateMultivectorAggregation(v string) error {
 	switch v {
-	case MultivectorAggregationMaxSim:
+	case MultivectorAggregationMaxSim, MultivectorAggregationMaxSimNormalized,
+		MultivectorAggregationAvgPool, MultivectorAggregationTopKMean:
 	default:
 		return fmt.Errorf("invalid aggregation type %s", v)
 	}
@@ -58,9 +74,14 @@ func ValidateMultivectorConfig(cfg MultivectorConfig) error {
 	if !cfg.Enabled {
 		return nil
 	}
-	err := validAggregation(cfg.Aggregation)
-	if err != nil {
-		return err
+	if cfg.Aggregation != "" {
+		// an empty aggregation falls back to the default
+		if err := ValidateMultivectorAggregation(cfg.Aggregation); err != nil {
+			return err
+		}
+	}
+	if cfg.TopK < 0 {
+		return fmt.Errorf("multivector topK must not be negative, got %d", cfg.TopK)
 	}
 
 	return nil
@@ -88,6 +109,18 @@ func parseMultivectorMap(in map[string]interface{}, multivector *MultivectorConf
 		return err
 	}
 
+	if err := common.OptionalStringFromMap(multivectorConfigMap, "aggregation", func(v string) {
+		multivector.Aggregation = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalIntFromMap(multivectorConfigMap, "topK", func(v int) {
+		multivector.TopK = v
+	}); err != nil {
+		return err
+	}
+
 	muveraValue, ok := multivectorConfigMap["muvera"]
 	if !ok {
 		return nil
@@ -122,11 +155,5 @@ func parseMultivectorMap(in map[string]interface{}, multivector *MultivectorConf
 		return err
 	}
 
-	if err := common.OptionalStringFromMap(multivectorConfigMap, "aggregation", func(v string) {
-		multivector.Aggregation = v
-	}); err != nil {
-		return err
-	}
-
 	return nil
 }
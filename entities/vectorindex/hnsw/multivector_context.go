@@ -0,0 +1,29 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import "context"
+
+type multivectorAggregationKey struct{}
+
+// ContextWithMultivectorAggregation overrides the configured multivector
+// aggregation for the searches of a single query
+func ContextWithMultivectorAggregation(ctx context.Context, aggregation string) context.Context {
+	return context.WithValue(ctx, multivectorAggregationKey{}, aggregation)
+}
+
+// MultivectorAggregationFromContext returns the aggregation set with
+// ContextWithMultivectorAggregation, if any
+func MultivectorAggregationFromContext(ctx context.Context) (string, bool) {
+	aggregation, ok := ctx.Value(multivectorAggregationKey{}).(string)
+	return aggregation, ok && aggregation != ""
+}
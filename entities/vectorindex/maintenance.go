@@ -0,0 +1,41 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorindex
+
+import "errors"
+
+// Maintenance operations which can be run on demand on the vector index of a
+// named vector of a shard
+const (
+	// MaintenanceTombstoneCleanup removes the nodes of deleted objects from
+	// the graph
+	MaintenanceTombstoneCleanup = "tombstoneCleanup"
+	// MaintenanceCondense condenses and combines the commit logs
+	MaintenanceCondense = "condense"
+	// MaintenanceSnapshot creates a snapshot of the commit logs
+	MaintenanceSnapshot = "snapshot"
+	// MaintenanceRebuild builds the index again from the stored vectors
+	MaintenanceRebuild = "rebuild"
+	// MaintenancePause and MaintenanceResume stop and restart the maintenance
+	// cycles running in the background
+	MaintenancePause  = "pause"
+	MaintenanceResume = "resume"
+)
+
+var MaintenanceOperations = []string{
+	MaintenanceTombstoneCleanup, MaintenanceCondense, MaintenanceSnapshot,
+	MaintenanceRebuild, MaintenancePause, MaintenanceResume,
+}
+
+// ErrMaintenanceNotFound is returned for maintenance operations which are not
+// known to the shard, e.g. because it was loaded again since
+var ErrMaintenanceNotFound = errors.New("vector index maintenance operation not found")
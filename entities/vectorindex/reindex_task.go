@@ -0,0 +1,36 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorindex
+
+import "fmt"
+
+// ReindexTaskNamespace is the namespace of the distributed tasks which track
+// the rebuild of the vector index of a named vector on all nodes
+const ReindexTaskNamespace = "vector-reindex"
+
+// ReindexTaskPayload describes the vector index a named vector is rebuilt
+// into. A node has finished the task once all of its shards of the
+// collection serve an index matching the payload.
+type ReindexTaskPayload struct {
+	Collection   string `json:"collection"`
+	TargetVector string `json:"targetVector"`
+	IndexType    string `json:"indexType"`
+	Distance     string `json:"distance"`
+	Compression  string `json:"compression,omitempty"`
+}
+
+// ReindexTaskID returns the id of the task rebuilding the vector index of the
+// target vector of a collection. A newer rebuild of the same target vector
+// replaces the task of the previous one.
+func ReindexTaskID(collection, targetVector string) string {
+	return fmt.Sprintf("%s/%s", collection, targetVector)
+}
@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sparse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+func Test_SparseUserConfig(t *testing.T) {
+	t.Run("nothing specified, all defaults", func(t *testing.T) {
+		cfg, err := ParseAndValidateConfig(nil)
+		require.Nil(t, err)
+		assert.Equal(t, UserConfig{Distance: common.DistanceDot}, cfg)
+	})
+
+	t.Run("dot distance", func(t *testing.T) {
+		cfg, err := ParseAndValidateConfig(map[string]interface{}{"distance": "dot"})
+		require.Nil(t, err)
+		assert.Equal(t, "sparse", cfg.IndexType())
+		assert.Equal(t, common.DistanceDot, cfg.DistanceName())
+	})
+
+	t.Run("other distances are rejected", func(t *testing.T) {
+		_, err := ParseAndValidateConfig(map[string]interface{}{"distance": "cosine"})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "only supports distance")
+	})
+}
+
+func Test_SparseVector(t *testing.T) {
+	t.Run("validate", func(t *testing.T) {
+		assert.Nil(t, Validate(&models.SparseVector{Indices: []uint32{3, 1}, Values: []float32{0.5, 2}}))
+		assert.NotNil(t, Validate(&models.SparseVector{Indices: []uint32{3}, Values: []float32{0.5, 2}}))
+		assert.NotNil(t, Validate(&models.SparseVector{Indices: []uint32{3, 3}, Values: []float32{0.5, 2}}))
+		assert.NotNil(t, Validate(&models.SparseVector{Indices: []uint32{3}, Values: []float32{-1}}))
+		assert.NotNil(t, Validate(&models.SparseVector{Indices: []uint32{3}, Values: []float32{MaxWeight + 1}}))
+	})
+
+	t.Run("sort, encode and decode", func(t *testing.T) {
+		vec := &models.SparseVector{Indices: []uint32{7, 2, 5}, Values: []float32{0.7, 0.2, 0.5}}
+		sorted := Sorted(vec)
+		assert.Equal(t, []uint32{2, 5, 7}, sorted.Indices)
+		assert.Equal(t, []float32{0.2, 0.5, 0.7}, sorted.Values)
+		assert.Equal(t, []uint32{7, 2, 5}, vec.Indices)
+
+		decoded, err := Decode(Encode(sorted))
+		require.Nil(t, err)
+		assert.Equal(t, sorted, decoded)
+
+		_, err = Decode([]byte{1, 2, 3})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("dot product", func(t *testing.T) {
+		a := &models.SparseVector{Indices: []uint32{1, 2, 3}, Values: []float32{1, 2, 3}}
+		b := &models.SparseVector{Indices: []uint32{3, 4, 1}, Values: []float32{2, 5, 1}}
+		assert.Equal(t, float32(7), Dot(a, b))
+	})
+}
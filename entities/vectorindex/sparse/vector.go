@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sparse
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const (
+	// WeightScale is the factor weights are multiplied with before they are
+	// stored as integer term frequencies in the inverted segments. It keeps
+	// 12 fractional bits of every weight.
+	WeightScale = 1 << 12
+
+	// MaxWeight is the largest weight that still has an exact fixed-point
+	// representation with WeightScale inside a float32 mantissa.
+	MaxWeight = float32(1<<24) / WeightScale
+
+	// entrySize is the number of bytes one (index, weight) pair takes up in
+	// the binary representation: a uint32 index followed by a float32 weight.
+	entrySize = 8
+)
+
+// Validate checks that vec is a well-formed sparse vector: indices and
+// values have the same length, indices are unique and all weights are
+// finite, non-negative and at most MaxWeight.
+func Validate(vec *models.SparseVector) error {
+	if vec == nil {
+		return fmt.Errorf("sparse vector is nil")
+	}
+	if len(vec.Indices) != len(vec.Values) {
+		return fmt.Errorf("sparse vector has %d indices but %d values", len(vec.Indices), len(vec.Values))
+	}
+
+	seen := make(map[uint32]struct{}, len(vec.Indices))
+	for i, idx := range vec.Indices {
+		if _, ok := seen[idx]; ok {
+			return fmt.Errorf("sparse vector contains index %d more than once", idx)
+		}
+		seen[idx] = struct{}{}
+
+		w := vec.Values[i]
+		if math.IsNaN(float64(w)) || math.IsInf(float64(w), 0) {
+			return fmt.Errorf("sparse vector has non-finite weight at index %d", idx)
+		}
+		if w < 0 || w > MaxWeight {
+			return fmt.Errorf("sparse vector weight %v at index %d is out of range [0, %v]", w, idx, MaxWeight)
+		}
+	}
+	return nil
+}
+
+// Sorted returns a copy of vec with its entries ordered by ascending index.
+func Sorted(vec *models.SparseVector) *models.SparseVector {
+	out := &models.SparseVector{
+		Indices: make([]uint32, len(vec.Indices)),
+		Values:  make([]float32, len(vec.Values)),
+	}
+	copy(out.Indices, vec.Indices)
+	copy(out.Values, vec.Values)
+	sort.Sort(byIndex{out})
+	return out
+}
+
+type byIndex struct{ *models.SparseVector }
+
+func (b byIndex) Len() int           { return len(b.Indices) }
+func (b byIndex) Less(i, j int) bool { return b.Indices[i] < b.Indices[j] }
+func (b byIndex) Swap(i, j int) {
+	b.Indices[i], b.Indices[j] = b.Indices[j], b.Indices[i]
+	b.Values[i], b.Values[j] = b.Values[j], b.Values[i]
+}
+
+// Quantize converts a weight to the integer term frequency that is stored in
+// the inverted segments. Weights that round to zero are not indexed.
+func Quantize(w float32) uint32 {
+	return uint32(math.Round(float64(w) * WeightScale))
+}
+
+// Dot returns the dot product of two sparse vectors.
+func Dot(a, b *models.SparseVector) float32 {
+	weights := make(map[uint32]float32, len(a.Indices))
+	for i, idx := range a.Indices {
+		weights[idx] = a.Values[i]
+	}
+	var sum float32
+	for i, idx := range b.Indices {
+		sum += weights[idx] * b.Values[i]
+	}
+	return sum
+}
+
+// Encode serializes vec as consecutive little endian (uint32 index, float32
+// weight) pairs. This is the format used for sparse vectors in storage
+// objects and in the gRPC API.
+func Encode(vec *models.SparseVector) []byte {
+	out := make([]byte, len(vec.Indices)*entrySize)
+	for i, idx := range vec.Indices {
+		binary.LittleEndian.PutUint32(out[i*entrySize:], idx)
+		binary.LittleEndian.PutUint32(out[i*entrySize+4:], math.Float32bits(vec.Values[i]))
+	}
+	return out
+}
+
+// Decode is the inverse of Encode.
+func Decode(in []byte) (*models.SparseVector, error) {
+	if len(in)%entrySize != 0 {
+		return nil, fmt.Errorf("sparse vector bytes must be a multiple of %d, got %d", entrySize, len(in))
+	}
+	n := len(in) / entrySize
+	out := &models.SparseVector{
+		Indices: make([]uint32, n),
+		Values:  make([]float32, n),
+	}
+	for i := 0; i < n; i++ {
+		out.Indices[i] = binary.LittleEndian.Uint32(in[i*entrySize:])
+		out.Values[i] = math.Float32frombits(binary.LittleEndian.Uint32(in[i*entrySize+4:]))
+	}
+	return out, nil
+}
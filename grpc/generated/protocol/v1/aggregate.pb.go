@@ -24,6 +24,9 @@ type AggregateRequest struct {
 	Collection string `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
 	// parameters
 	Tenant string `protobuf:"bytes,10,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// id of the point-in-time snapshot the shards are read from, see
+	// SearchRequest.snapshot. Can't be combined with near and hybrid searches.
+	Snapshot *string `protobuf:"bytes,11,opt,name=snapshot,proto3,oneof" json:"snapshot,omitempty"`
 	// what is returned
 	ObjectsCount bool                            `protobuf:"varint,20,opt,name=objects_count,json=objectsCount,proto3" json:"objects_count,omitempty"`
 	Aggregations []*AggregateRequest_Aggregation `protobuf:"bytes,21,rep,name=aggregations,proto3" json:"aggregations,omitempty"`
@@ -94,6 +97,13 @@ func (x *AggregateRequest) GetTenant() string {
 	return ""
 }
 
+func (x *AggregateRequest) GetSnapshot() string {
+	if x != nil && x.Snapshot != nil {
+		return *x.Snapshot
+	}
+	return ""
+}
+
 func (x *AggregateRequest) GetObjectsCount() bool {
 	if x != nil {
 		return x.ObjectsCount
@@ -593,23 +603,142 @@ func (x *AggregateRequest_GroupBy) GetProperty() string {
 	return ""
 }
 
+// fixed-interval buckets of int and number properties
+type AggregateRequest_Aggregation_Histogram struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Interval float64                `protobuf:"fixed64,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	// int or number properties aggregated per bucket
+	Metrics       []string `protobuf:"bytes,2,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateRequest_Aggregation_Histogram) Reset() {
+	*x = AggregateRequest_Aggregation_Histogram{}
+	mi := &file_v1_aggregate_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateRequest_Aggregation_Histogram) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateRequest_Aggregation_Histogram) ProtoMessage() {}
+
+func (x *AggregateRequest_Aggregation_Histogram) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_aggregate_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateRequest_Aggregation_Histogram.ProtoReflect.Descriptor instead.
+func (*AggregateRequest_Aggregation_Histogram) Descriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *AggregateRequest_Aggregation_Histogram) GetInterval() float64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *AggregateRequest_Aggregation_Histogram) GetMetrics() []string {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+// calendar-interval buckets of date properties
+type AggregateRequest_Aggregation_DateHistogram struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// one of hour, day, week or month
+	Interval string `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	// IANA time zone the buckets are aligned to, UTC if unset
+	TimeZone *string `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3,oneof" json:"time_zone,omitempty"`
+	// int or number properties aggregated per bucket
+	Metrics       []string `protobuf:"bytes,3,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateRequest_Aggregation_DateHistogram) Reset() {
+	*x = AggregateRequest_Aggregation_DateHistogram{}
+	mi := &file_v1_aggregate_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateRequest_Aggregation_DateHistogram) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateRequest_Aggregation_DateHistogram) ProtoMessage() {}
+
+func (x *AggregateRequest_Aggregation_DateHistogram) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_aggregate_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateRequest_Aggregation_DateHistogram.ProtoReflect.Descriptor instead.
+func (*AggregateRequest_Aggregation_DateHistogram) Descriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{0, 0, 1}
+}
+
+func (x *AggregateRequest_Aggregation_DateHistogram) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *AggregateRequest_Aggregation_DateHistogram) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
+func (x *AggregateRequest_Aggregation_DateHistogram) GetMetrics() []string {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
 type AggregateRequest_Aggregation_Integer struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Count         bool                   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	Type          bool                   `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
-	Sum           bool                   `protobuf:"varint,3,opt,name=sum,proto3" json:"sum,omitempty"`
-	Mean          bool                   `protobuf:"varint,4,opt,name=mean,proto3" json:"mean,omitempty"`
-	Mode          bool                   `protobuf:"varint,5,opt,name=mode,proto3" json:"mode,omitempty"`
-	Median        bool                   `protobuf:"varint,6,opt,name=median,proto3" json:"median,omitempty"`
-	Maximum       bool                   `protobuf:"varint,7,opt,name=maximum,proto3" json:"maximum,omitempty"`
-	Minimum       bool                   `protobuf:"varint,8,opt,name=minimum,proto3" json:"minimum,omitempty"`
+	state         protoimpl.MessageState                  `protogen:"open.v1"`
+	Count         bool                                    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Type          bool                                    `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	Sum           bool                                    `protobuf:"varint,3,opt,name=sum,proto3" json:"sum,omitempty"`
+	Mean          bool                                    `protobuf:"varint,4,opt,name=mean,proto3" json:"mean,omitempty"`
+	Mode          bool                                    `protobuf:"varint,5,opt,name=mode,proto3" json:"mode,omitempty"`
+	Median        bool                                    `protobuf:"varint,6,opt,name=median,proto3" json:"median,omitempty"`
+	Maximum       bool                                    `protobuf:"varint,7,opt,name=maximum,proto3" json:"maximum,omitempty"`
+	Minimum       bool                                    `protobuf:"varint,8,opt,name=minimum,proto3" json:"minimum,omitempty"`
+	Histogram     *AggregateRequest_Aggregation_Histogram `protobuf:"bytes,9,opt,name=histogram,proto3,oneof" json:"histogram,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AggregateRequest_Aggregation_Integer) Reset() {
 	*x = AggregateRequest_Aggregation_Integer{}
-	mi := &file_v1_aggregate_proto_msgTypes[4]
+	mi := &file_v1_aggregate_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -621,7 +750,7 @@ func (x *AggregateRequest_Aggregation_Integer) String() string {
 func (*AggregateRequest_Aggregation_Integer) ProtoMessage() {}
 
 func (x *AggregateRequest_Aggregation_Integer) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_aggregate_proto_msgTypes[4]
+	mi := &file_v1_aggregate_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -634,7 +763,7 @@ func (x *AggregateRequest_Aggregation_Integer) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use AggregateRequest_Aggregation_Integer.ProtoReflect.Descriptor instead.
 func (*AggregateRequest_Aggregation_Integer) Descriptor() ([]byte, []int) {
-	return file_v1_aggregate_proto_rawDescGZIP(), []int{0, 0, 0}
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{0, 0, 2}
 }
 
 func (x *AggregateRequest_Aggregation_Integer) GetCount() bool {
@@ -693,23 +822,31 @@ func (x *AggregateRequest_Aggregation_Integer) GetMinimum() bool {
 	return false
 }
 
+func (x *AggregateRequest_Aggregation_Integer) GetHistogram() *AggregateRequest_Aggregation_Histogram {
+	if x != nil {
+		return x.Histogram
+	}
+	return nil
+}
+
 type AggregateRequest_Aggregation_Number struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Count         bool                   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	Type          bool                   `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
-	Sum           bool                   `protobuf:"varint,3,opt,name=sum,proto3" json:"sum,omitempty"`
-	Mean          bool                   `protobuf:"varint,4,opt,name=mean,proto3" json:"mean,omitempty"`
-	Mode          bool                   `protobuf:"varint,5,opt,name=mode,proto3" json:"mode,omitempty"`
-	Median        bool                   `protobuf:"varint,6,opt,name=median,proto3" json:"median,omitempty"`
-	Maximum       bool                   `protobuf:"varint,7,opt,name=maximum,proto3" json:"maximum,omitempty"`
-	Minimum       bool                   `protobuf:"varint,8,opt,name=minimum,proto3" json:"minimum,omitempty"`
+	state         protoimpl.MessageState                  `protogen:"open.v1"`
+	Count         bool                                    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Type          bool                                    `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	Sum           bool                                    `protobuf:"varint,3,opt,name=sum,proto3" json:"sum,omitempty"`
+	Mean          bool                                    `protobuf:"varint,4,opt,name=mean,proto3" json:"mean,omitempty"`
+	Mode          bool                                    `protobuf:"varint,5,opt,name=mode,proto3" json:"mode,omitempty"`
+	Median        bool                                    `protobuf:"varint,6,opt,name=median,proto3" json:"median,omitempty"`
+	Maximum       bool                                    `protobuf:"varint,7,opt,name=maximum,proto3" json:"maximum,omitempty"`
+	Minimum       bool                                    `protobuf:"varint,8,opt,name=minimum,proto3" json:"minimum,omitempty"`
+	Histogram     *AggregateRequest_Aggregation_Histogram `protobuf:"bytes,9,opt,name=histogram,proto3,oneof" json:"histogram,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AggregateRequest_Aggregation_Number) Reset() {
 	*x = AggregateRequest_Aggregation_Number{}
-	mi := &file_v1_aggregate_proto_msgTypes[5]
+	mi := &file_v1_aggregate_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -721,7 +858,7 @@ func (x *AggregateRequest_Aggregation_Number) String() string {
 func (*AggregateRequest_Aggregation_Number) ProtoMessage() {}
 
 func (x *AggregateRequest_Aggregation_Number) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_aggregate_proto_msgTypes[5]
+	mi := &file_v1_aggregate_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -734,7 +871,7 @@ func (x *AggregateRequest_Aggregation_Number) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use AggregateRequest_Aggregation_Number.ProtoReflect.Descriptor instead.
 func (*AggregateRequest_Aggregation_Number) Descriptor() ([]byte, []int) {
-	return file_v1_aggregate_proto_rawDescGZIP(), []int{0, 0, 1}
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{0, 0, 3}
 }
 
 func (x *AggregateRequest_Aggregation_Number) GetCount() bool {
@@ -793,6 +930,13 @@ func (x *AggregateRequest_Aggregation_Number) GetMinimum() bool {
 	return false
 }
 
+func (x *AggregateRequest_Aggregation_Number) GetHistogram() *AggregateRequest_Aggregation_Histogram {
+	if x != nil {
+		return x.Histogram
+	}
+	return nil
+}
+
 type AggregateRequest_Aggregation_Text struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	Count              bool                   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
@@ -805,7 +949,7 @@ type AggregateRequest_Aggregation_Text struct {
 
 func (x *AggregateRequest_Aggregation_Text) Reset() {
 	*x = AggregateRequest_Aggregation_Text{}
-	mi := &file_v1_aggregate_proto_msgTypes[6]
+	mi := &file_v1_aggregate_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -817,7 +961,7 @@ func (x *AggregateRequest_Aggregation_Text) String() string {
 func (*AggregateRequest_Aggregation_Text) ProtoMessage() {}
 
 func (x *AggregateRequest_Aggregation_Text) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_aggregate_proto_msgTypes[6]
+	mi := &file_v1_aggregate_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -830,7 +974,7 @@ func (x *AggregateRequest_Aggregation_Text) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use AggregateRequest_Aggregation_Text.ProtoReflect.Descriptor instead.
 func (*AggregateRequest_Aggregation_Text) Descriptor() ([]byte, []int) {
-	return file_v1_aggregate_proto_rawDescGZIP(), []int{0, 0, 2}
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{0, 0, 4}
 }
 
 func (x *AggregateRequest_Aggregation_Text) GetCount() bool {
@@ -875,7 +1019,7 @@ type AggregateRequest_Aggregation_Boolean struct {
 
 func (x *AggregateRequest_Aggregation_Boolean) Reset() {
 	*x = AggregateRequest_Aggregation_Boolean{}
-	mi := &file_v1_aggregate_proto_msgTypes[7]
+	mi := &file_v1_aggregate_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -887,7 +1031,7 @@ func (x *AggregateRequest_Aggregation_Boolean) String() string {
 func (*AggregateRequest_Aggregation_Boolean) ProtoMessage() {}
 
 func (x *AggregateRequest_Aggregation_Boolean) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_aggregate_proto_msgTypes[7]
+	mi := &file_v1_aggregate_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -900,7 +1044,7 @@ func (x *AggregateRequest_Aggregation_Boolean) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use AggregateRequest_Aggregation_Boolean.ProtoReflect.Descriptor instead.
 func (*AggregateRequest_Aggregation_Boolean) Descriptor() ([]byte, []int) {
-	return file_v1_aggregate_proto_rawDescGZIP(), []int{0, 0, 3}
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{0, 0, 5}
 }
 
 func (x *AggregateRequest_Aggregation_Boolean) GetCount() bool {
@@ -946,20 +1090,21 @@ func (x *AggregateRequest_Aggregation_Boolean) GetPercentageFalse() bool {
 }
 
 type AggregateRequest_Aggregation_Date struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Count         bool                   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	Type          bool                   `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
-	Median        bool                   `protobuf:"varint,3,opt,name=median,proto3" json:"median,omitempty"`
-	Mode          bool                   `protobuf:"varint,4,opt,name=mode,proto3" json:"mode,omitempty"`
-	Maximum       bool                   `protobuf:"varint,5,opt,name=maximum,proto3" json:"maximum,omitempty"`
-	Minimum       bool                   `protobuf:"varint,6,opt,name=minimum,proto3" json:"minimum,omitempty"`
+	state         protoimpl.MessageState                      `protogen:"open.v1"`
+	Count         bool                                        `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Type          bool                                        `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	Median        bool                                        `protobuf:"varint,3,opt,name=median,proto3" json:"median,omitempty"`
+	Mode          bool                                        `protobuf:"varint,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	Maximum       bool                                        `protobuf:"varint,5,opt,name=maximum,proto3" json:"maximum,omitempty"`
+	Minimum       bool                                        `protobuf:"varint,6,opt,name=minimum,proto3" json:"minimum,omitempty"`
+	DateHistogram *AggregateRequest_Aggregation_DateHistogram `protobuf:"bytes,7,opt,name=date_histogram,json=dateHistogram,proto3,oneof" json:"date_histogram,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AggregateRequest_Aggregation_Date) Reset() {
 	*x = AggregateRequest_Aggregation_Date{}
-	mi := &file_v1_aggregate_proto_msgTypes[8]
+	mi := &file_v1_aggregate_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -971,7 +1116,7 @@ func (x *AggregateRequest_Aggregation_Date) String() string {
 func (*AggregateRequest_Aggregation_Date) ProtoMessage() {}
 
 func (x *AggregateRequest_Aggregation_Date) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_aggregate_proto_msgTypes[8]
+	mi := &file_v1_aggregate_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -984,7 +1129,7 @@ func (x *AggregateRequest_Aggregation_Date) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use AggregateRequest_Aggregation_Date.ProtoReflect.Descriptor instead.
 func (*AggregateRequest_Aggregation_Date) Descriptor() ([]byte, []int) {
-	return file_v1_aggregate_proto_rawDescGZIP(), []int{0, 0, 4}
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{0, 0, 6}
 }
 
 func (x *AggregateRequest_Aggregation_Date) GetCount() bool {
@@ -1029,6 +1174,13 @@ func (x *AggregateRequest_Aggregation_Date) GetMinimum() bool {
 	return false
 }
 
+func (x *AggregateRequest_Aggregation_Date) GetDateHistogram() *AggregateRequest_Aggregation_DateHistogram {
+	if x != nil {
+		return x.DateHistogram
+	}
+	return nil
+}
+
 type AggregateRequest_Aggregation_Reference struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Type          bool                   `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
@@ -1039,7 +1191,7 @@ type AggregateRequest_Aggregation_Reference struct {
 
 func (x *AggregateRequest_Aggregation_Reference) Reset() {
 	*x = AggregateRequest_Aggregation_Reference{}
-	mi := &file_v1_aggregate_proto_msgTypes[9]
+	mi := &file_v1_aggregate_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1051,7 +1203,7 @@ func (x *AggregateRequest_Aggregation_Reference) String() string {
 func (*AggregateRequest_Aggregation_Reference) ProtoMessage() {}
 
 func (x *AggregateRequest_Aggregation_Reference) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_aggregate_proto_msgTypes[9]
+	mi := &file_v1_aggregate_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1064,7 +1216,7 @@ func (x *AggregateRequest_Aggregation_Reference) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use AggregateRequest_Aggregation_Reference.ProtoReflect.Descriptor instead.
 func (*AggregateRequest_Aggregation_Reference) Descriptor() ([]byte, []int) {
-	return file_v1_aggregate_proto_rawDescGZIP(), []int{0, 0, 5}
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{0, 0, 7}
 }
 
 func (x *AggregateRequest_Aggregation_Reference) GetType() bool {
@@ -1090,7 +1242,7 @@ type AggregateReply_Aggregations struct {
 
 func (x *AggregateReply_Aggregations) Reset() {
 	*x = AggregateReply_Aggregations{}
-	mi := &file_v1_aggregate_proto_msgTypes[10]
+	mi := &file_v1_aggregate_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1102,7 +1254,7 @@ func (x *AggregateReply_Aggregations) String() string {
 func (*AggregateReply_Aggregations) ProtoMessage() {}
 
 func (x *AggregateReply_Aggregations) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_aggregate_proto_msgTypes[10]
+	mi := &file_v1_aggregate_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1135,7 +1287,7 @@ type AggregateReply_Single struct {
 
 func (x *AggregateReply_Single) Reset() {
 	*x = AggregateReply_Single{}
-	mi := &file_v1_aggregate_proto_msgTypes[11]
+	mi := &file_v1_aggregate_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1147,7 +1299,7 @@ func (x *AggregateReply_Single) String() string {
 func (*AggregateReply_Single) ProtoMessage() {}
 
 func (x *AggregateReply_Single) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_aggregate_proto_msgTypes[11]
+	mi := &file_v1_aggregate_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1188,7 +1340,7 @@ type AggregateReply_Group struct {
 
 func (x *AggregateReply_Group) Reset() {
 	*x = AggregateReply_Group{}
-	mi := &file_v1_aggregate_proto_msgTypes[12]
+	mi := &file_v1_aggregate_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1200,7 +1352,7 @@ func (x *AggregateReply_Group) String() string {
 func (*AggregateReply_Group) ProtoMessage() {}
 
 func (x *AggregateReply_Group) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_aggregate_proto_msgTypes[12]
+	mi := &file_v1_aggregate_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1246,7 +1398,7 @@ type AggregateReply_Grouped struct {
 
 func (x *AggregateReply_Grouped) Reset() {
 	*x = AggregateReply_Grouped{}
-	mi := &file_v1_aggregate_proto_msgTypes[13]
+	mi := &file_v1_aggregate_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1258,7 +1410,7 @@ func (x *AggregateReply_Grouped) String() string {
 func (*AggregateReply_Grouped) ProtoMessage() {}
 
 func (x *AggregateReply_Grouped) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_aggregate_proto_msgTypes[13]
+	mi := &file_v1_aggregate_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1299,7 +1451,7 @@ type AggregateReply_Aggregations_Aggregation struct {
 
 func (x *AggregateReply_Aggregations_Aggregation) Reset() {
 	*x = AggregateReply_Aggregations_Aggregation{}
-	mi := &file_v1_aggregate_proto_msgTypes[14]
+	mi := &file_v1_aggregate_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1311,7 +1463,7 @@ func (x *AggregateReply_Aggregations_Aggregation) String() string {
 func (*AggregateReply_Aggregations_Aggregation) ProtoMessage() {}
 
 func (x *AggregateReply_Aggregations_Aggregation) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_aggregate_proto_msgTypes[14]
+	mi := &file_v1_aggregate_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1441,23 +1593,230 @@ func (*AggregateReply_Aggregations_Aggregation_Date_) isAggregateReply_Aggregati
 func (*AggregateReply_Aggregations_Aggregation_Reference_) isAggregateReply_Aggregations_Aggregation_Aggregation() {
 }
 
-type AggregateReply_Aggregations_Aggregation_Integer struct {
+type AggregateReply_Aggregations_Aggregation_HistogramMetrics struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Count         *int64                 `protobuf:"varint,1,opt,name=count,proto3,oneof" json:"count,omitempty"`
-	Type          *string                `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
-	Mean          *float64               `protobuf:"fixed64,3,opt,name=mean,proto3,oneof" json:"mean,omitempty"`
-	Median        *float64               `protobuf:"fixed64,4,opt,name=median,proto3,oneof" json:"median,omitempty"`
-	Mode          *int64                 `protobuf:"varint,5,opt,name=mode,proto3,oneof" json:"mode,omitempty"`
-	Maximum       *int64                 `protobuf:"varint,6,opt,name=maximum,proto3,oneof" json:"maximum,omitempty"`
-	Minimum       *int64                 `protobuf:"varint,7,opt,name=minimum,proto3,oneof" json:"minimum,omitempty"`
-	Sum           *int64                 `protobuf:"varint,8,opt,name=sum,proto3,oneof" json:"sum,omitempty"`
+	Property      string                 `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Sum           float64                `protobuf:"fixed64,3,opt,name=sum,proto3" json:"sum,omitempty"`
+	Mean          float64                `protobuf:"fixed64,4,opt,name=mean,proto3" json:"mean,omitempty"`
+	Minimum       float64                `protobuf:"fixed64,5,opt,name=minimum,proto3" json:"minimum,omitempty"`
+	Maximum       float64                `protobuf:"fixed64,6,opt,name=maximum,proto3" json:"maximum,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_HistogramMetrics) Reset() {
+	*x = AggregateReply_Aggregations_Aggregation_HistogramMetrics{}
+	mi := &file_v1_aggregate_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_HistogramMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateReply_Aggregations_Aggregation_HistogramMetrics) ProtoMessage() {}
+
+func (x *AggregateReply_Aggregations_Aggregation_HistogramMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_aggregate_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateReply_Aggregations_Aggregation_HistogramMetrics.ProtoReflect.Descriptor instead.
+func (*AggregateReply_Aggregations_Aggregation_HistogramMetrics) Descriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{1, 0, 0, 0}
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_HistogramMetrics) GetProperty() string {
+	if x != nil {
+		return x.Property
+	}
+	return ""
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_HistogramMetrics) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_HistogramMetrics) GetSum() float64 {
+	if x != nil {
+		return x.Sum
+	}
+	return 0
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_HistogramMetrics) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_HistogramMetrics) GetMinimum() float64 {
+	if x != nil {
+		return x.Minimum
+	}
+	return 0
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_HistogramMetrics) GetMaximum() float64 {
+	if x != nil {
+		return x.Maximum
+	}
+	return 0
+}
+
+type AggregateReply_Aggregations_Aggregation_HistogramBucket struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// lower bound of the bucket
+	Key           float64                                                     `protobuf:"fixed64,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count         int64                                                       `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Metrics       []*AggregateReply_Aggregations_Aggregation_HistogramMetrics `protobuf:"bytes,3,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_HistogramBucket) Reset() {
+	*x = AggregateReply_Aggregations_Aggregation_HistogramBucket{}
+	mi := &file_v1_aggregate_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_HistogramBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateReply_Aggregations_Aggregation_HistogramBucket) ProtoMessage() {}
+
+func (x *AggregateReply_Aggregations_Aggregation_HistogramBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_aggregate_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateReply_Aggregations_Aggregation_HistogramBucket.ProtoReflect.Descriptor instead.
+func (*AggregateReply_Aggregations_Aggregation_HistogramBucket) Descriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{1, 0, 0, 1}
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_HistogramBucket) GetKey() float64 {
+	if x != nil {
+		return x.Key
+	}
+	return 0
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_HistogramBucket) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_HistogramBucket) GetMetrics() []*AggregateReply_Aggregations_Aggregation_HistogramMetrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+type AggregateReply_Aggregations_Aggregation_DateHistogramBucket struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// RFC3339 start of the bucket in the requested time zone
+	Key           string                                                      `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count         int64                                                       `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Metrics       []*AggregateReply_Aggregations_Aggregation_HistogramMetrics `protobuf:"bytes,3,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_DateHistogramBucket) Reset() {
+	*x = AggregateReply_Aggregations_Aggregation_DateHistogramBucket{}
+	mi := &file_v1_aggregate_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_DateHistogramBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateReply_Aggregations_Aggregation_DateHistogramBucket) ProtoMessage() {}
+
+func (x *AggregateReply_Aggregations_Aggregation_DateHistogramBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_aggregate_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateReply_Aggregations_Aggregation_DateHistogramBucket.ProtoReflect.Descriptor instead.
+func (*AggregateReply_Aggregations_Aggregation_DateHistogramBucket) Descriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{1, 0, 0, 2}
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_DateHistogramBucket) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_DateHistogramBucket) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_DateHistogramBucket) GetMetrics() []*AggregateReply_Aggregations_Aggregation_HistogramMetrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+type AggregateReply_Aggregations_Aggregation_Integer struct {
+	state         protoimpl.MessageState                                     `protogen:"open.v1"`
+	Count         *int64                                                     `protobuf:"varint,1,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	Type          *string                                                    `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Mean          *float64                                                   `protobuf:"fixed64,3,opt,name=mean,proto3,oneof" json:"mean,omitempty"`
+	Median        *float64                                                   `protobuf:"fixed64,4,opt,name=median,proto3,oneof" json:"median,omitempty"`
+	Mode          *int64                                                     `protobuf:"varint,5,opt,name=mode,proto3,oneof" json:"mode,omitempty"`
+	Maximum       *int64                                                     `protobuf:"varint,6,opt,name=maximum,proto3,oneof" json:"maximum,omitempty"`
+	Minimum       *int64                                                     `protobuf:"varint,7,opt,name=minimum,proto3,oneof" json:"minimum,omitempty"`
+	Sum           *int64                                                     `protobuf:"varint,8,opt,name=sum,proto3,oneof" json:"sum,omitempty"`
+	Histogram     []*AggregateReply_Aggregations_Aggregation_HistogramBucket `protobuf:"bytes,9,rep,name=histogram,proto3" json:"histogram,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AggregateReply_Aggregations_Aggregation_Integer) Reset() {
 	*x = AggregateReply_Aggregations_Aggregation_Integer{}
-	mi := &file_v1_aggregate_proto_msgTypes[15]
+	mi := &file_v1_aggregate_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1469,7 +1828,7 @@ func (x *AggregateReply_Aggregations_Aggregation_Integer) String() string {
 func (*AggregateReply_Aggregations_Aggregation_Integer) ProtoMessage() {}
 
 func (x *AggregateReply_Aggregations_Aggregation_Integer) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_aggregate_proto_msgTypes[15]
+	mi := &file_v1_aggregate_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1482,7 +1841,7 @@ func (x *AggregateReply_Aggregations_Aggregation_Integer) ProtoReflect() protore
 
 // Deprecated: Use AggregateReply_Aggregations_Aggregation_Integer.ProtoReflect.Descriptor instead.
 func (*AggregateReply_Aggregations_Aggregation_Integer) Descriptor() ([]byte, []int) {
-	return file_v1_aggregate_proto_rawDescGZIP(), []int{1, 0, 0, 0}
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{1, 0, 0, 3}
 }
 
 func (x *AggregateReply_Aggregations_Aggregation_Integer) GetCount() int64 {
@@ -1541,23 +1900,31 @@ func (x *AggregateReply_Aggregations_Aggregation_Integer) GetSum() int64 {
 	return 0
 }
 
+func (x *AggregateReply_Aggregations_Aggregation_Integer) GetHistogram() []*AggregateReply_Aggregations_Aggregation_HistogramBucket {
+	if x != nil {
+		return x.Histogram
+	}
+	return nil
+}
+
 type AggregateReply_Aggregations_Aggregation_Number struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Count         *int64                 `protobuf:"varint,1,opt,name=count,proto3,oneof" json:"count,omitempty"`
-	Type          *string                `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
-	Mean          *float64               `protobuf:"fixed64,3,opt,name=mean,proto3,oneof" json:"mean,omitempty"`
-	Median        *float64               `protobuf:"fixed64,4,opt,name=median,proto3,oneof" json:"median,omitempty"`
-	Mode          *float64               `protobuf:"fixed64,5,opt,name=mode,proto3,oneof" json:"mode,omitempty"`
-	Maximum       *float64               `protobuf:"fixed64,6,opt,name=maximum,proto3,oneof" json:"maximum,omitempty"`
-	Minimum       *float64               `protobuf:"fixed64,7,opt,name=minimum,proto3,oneof" json:"minimum,omitempty"`
-	Sum           *float64               `protobuf:"fixed64,8,opt,name=sum,proto3,oneof" json:"sum,omitempty"`
+	state         protoimpl.MessageState                                     `protogen:"open.v1"`
+	Count         *int64                                                     `protobuf:"varint,1,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	Type          *string                                                    `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Mean          *float64                                                   `protobuf:"fixed64,3,opt,name=mean,proto3,oneof" json:"mean,omitempty"`
+	Median        *float64                                                   `protobuf:"fixed64,4,opt,name=median,proto3,oneof" json:"median,omitempty"`
+	Mode          *float64                                                   `protobuf:"fixed64,5,opt,name=mode,proto3,oneof" json:"mode,omitempty"`
+	Maximum       *float64                                                   `protobuf:"fixed64,6,opt,name=maximum,proto3,oneof" json:"maximum,omitempty"`
+	Minimum       *float64                                                   `protobuf:"fixed64,7,opt,name=minimum,proto3,oneof" json:"minimum,omitempty"`
+	Sum           *float64                                                   `protobuf:"fixed64,8,opt,name=sum,proto3,oneof" json:"sum,omitempty"`
+	Histogram     []*AggregateReply_Aggregations_Aggregation_HistogramBucket `protobuf:"bytes,9,rep,name=histogram,proto3" json:"histogram,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AggregateReply_Aggregations_Aggregation_Number) Reset() {
 	*x = AggregateReply_Aggregations_Aggregation_Number{}
-	mi := &file_v1_aggregate_proto_msgTypes[16]
+	mi := &file_v1_aggregate_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1569,7 +1936,7 @@ func (x *AggregateReply_Aggregations_Aggregation_Number) String() string {
 func (*AggregateReply_Aggregations_Aggregation_Number) ProtoMessage() {}
 
 func (x *AggregateReply_Aggregations_Aggregation_Number) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_aggregate_proto_msgTypes[16]
+	mi := &file_v1_aggregate_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1582,7 +1949,7 @@ func (x *AggregateReply_Aggregations_Aggregation_Number) ProtoReflect() protoref
 
 // Deprecated: Use AggregateReply_Aggregations_Aggregation_Number.ProtoReflect.Descriptor instead.
 func (*AggregateReply_Aggregations_Aggregation_Number) Descriptor() ([]byte, []int) {
-	return file_v1_aggregate_proto_rawDescGZIP(), []int{1, 0, 0, 1}
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{1, 0, 0, 4}
 }
 
 func (x *AggregateReply_Aggregations_Aggregation_Number) GetCount() int64 {
@@ -1641,6 +2008,13 @@ func (x *AggregateReply_Aggregations_Aggregation_Number) GetSum() float64 {
 	return 0
 }
 
+func (x *AggregateReply_Aggregations_Aggregation_Number) GetHistogram() []*AggregateReply_Aggregations_Aggregation_HistogramBucket {
+	if x != nil {
+		return x.Histogram
+	}
+	return nil
+}
+
 type AggregateReply_Aggregations_Aggregation_Text struct {
 	state         protoimpl.MessageState                                       `protogen:"open.v1"`
 	Count         *int64                                                       `protobuf:"varint,1,opt,name=count,proto3,oneof" json:"count,omitempty"`
@@ -1652,7 +2026,7 @@ type AggregateReply_Aggregations_Aggregation_Text struct {
 
 func (x *AggregateReply_Aggregations_Aggregation_Text) Reset() {
 	*x = AggregateReply_Aggregations_Aggregation_Text{}
-	mi := &file_v1_aggregate_proto_msgTypes[17]
+	mi := &file_v1_aggregate_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1664,7 +2038,7 @@ func (x *AggregateReply_Aggregations_Aggregation_Text) String() string {
 func (*AggregateReply_Aggregations_Aggregation_Text) ProtoMessage() {}
 
 func (x *AggregateReply_Aggregations_Aggregation_Text) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_aggregate_proto_msgTypes[17]
+	mi := &file_v1_aggregate_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1677,7 +2051,7 @@ func (x *AggregateReply_Aggregations_Aggregation_Text) ProtoReflect() protorefle
 
 // Deprecated: Use AggregateReply_Aggregations_Aggregation_Text.ProtoReflect.Descriptor instead.
 func (*AggregateReply_Aggregations_Aggregation_Text) Descriptor() ([]byte, []int) {
-	return file_v1_aggregate_proto_rawDescGZIP(), []int{1, 0, 0, 2}
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{1, 0, 0, 5}
 }
 
 func (x *AggregateReply_Aggregations_Aggregation_Text) GetCount() int64 {
@@ -1715,7 +2089,7 @@ type AggregateReply_Aggregations_Aggregation_Boolean struct {
 
 func (x *AggregateReply_Aggregations_Aggregation_Boolean) Reset() {
 	*x = AggregateReply_Aggregations_Aggregation_Boolean{}
-	mi := &file_v1_aggregate_proto_msgTypes[18]
+	mi := &file_v1_aggregate_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1727,7 +2101,7 @@ func (x *AggregateReply_Aggregations_Aggregation_Boolean) String() string {
 func (*AggregateReply_Aggregations_Aggregation_Boolean) ProtoMessage() {}
 
 func (x *AggregateReply_Aggregations_Aggregation_Boolean) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_aggregate_proto_msgTypes[18]
+	mi := &file_v1_aggregate_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1740,7 +2114,7 @@ func (x *AggregateReply_Aggregations_Aggregation_Boolean) ProtoReflect() protore
 
 // Deprecated: Use AggregateReply_Aggregations_Aggregation_Boolean.ProtoReflect.Descriptor instead.
 func (*AggregateReply_Aggregations_Aggregation_Boolean) Descriptor() ([]byte, []int) {
-	return file_v1_aggregate_proto_rawDescGZIP(), []int{1, 0, 0, 3}
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{1, 0, 0, 6}
 }
 
 func (x *AggregateReply_Aggregations_Aggregation_Boolean) GetCount() int64 {
@@ -1786,20 +2160,21 @@ func (x *AggregateReply_Aggregations_Aggregation_Boolean) GetPercentageFalse() f
 }
 
 type AggregateReply_Aggregations_Aggregation_Date struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Count         *int64                 `protobuf:"varint,1,opt,name=count,proto3,oneof" json:"count,omitempty"`
-	Type          *string                `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
-	Median        *string                `protobuf:"bytes,3,opt,name=median,proto3,oneof" json:"median,omitempty"`
-	Mode          *string                `protobuf:"bytes,4,opt,name=mode,proto3,oneof" json:"mode,omitempty"`
-	Maximum       *string                `protobuf:"bytes,5,opt,name=maximum,proto3,oneof" json:"maximum,omitempty"`
-	Minimum       *string                `protobuf:"bytes,6,opt,name=minimum,proto3,oneof" json:"minimum,omitempty"`
+	state         protoimpl.MessageState                                         `protogen:"open.v1"`
+	Count         *int64                                                         `protobuf:"varint,1,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	Type          *string                                                        `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Median        *string                                                        `protobuf:"bytes,3,opt,name=median,proto3,oneof" json:"median,omitempty"`
+	Mode          *string                                                        `protobuf:"bytes,4,opt,name=mode,proto3,oneof" json:"mode,omitempty"`
+	Maximum       *string                                                        `protobuf:"bytes,5,opt,name=maximum,proto3,oneof" json:"maximum,omitempty"`
+	Minimum       *string                                                        `protobuf:"bytes,6,opt,name=minimum,proto3,oneof" json:"minimum,omitempty"`
+	Histogram     []*AggregateReply_Aggregations_Aggregation_DateHistogramBucket `protobuf:"bytes,7,rep,name=histogram,proto3" json:"histogram,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AggregateReply_Aggregations_Aggregation_Date) Reset() {
 	*x = AggregateReply_Aggregations_Aggregation_Date{}
-	mi := &file_v1_aggregate_proto_msgTypes[19]
+	mi := &file_v1_aggregate_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1811,7 +2186,7 @@ func (x *AggregateReply_Aggregations_Aggregation_Date) String() string {
 func (*AggregateReply_Aggregations_Aggregation_Date) ProtoMessage() {}
 
 func (x *AggregateReply_Aggregations_Aggregation_Date) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_aggregate_proto_msgTypes[19]
+	mi := &file_v1_aggregate_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1824,7 +2199,7 @@ func (x *AggregateReply_Aggregations_Aggregation_Date) ProtoReflect() protorefle
 
 // Deprecated: Use AggregateReply_Aggregations_Aggregation_Date.ProtoReflect.Descriptor instead.
 func (*AggregateReply_Aggregations_Aggregation_Date) Descriptor() ([]byte, []int) {
-	return file_v1_aggregate_proto_rawDescGZIP(), []int{1, 0, 0, 4}
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{1, 0, 0, 7}
 }
 
 func (x *AggregateReply_Aggregations_Aggregation_Date) GetCount() int64 {
@@ -1869,6 +2244,13 @@ func (x *AggregateReply_Aggregations_Aggregation_Date) GetMinimum() string {
 	return ""
 }
 
+func (x *AggregateReply_Aggregations_Aggregation_Date) GetHistogram() []*AggregateReply_Aggregations_Aggregation_DateHistogramBucket {
+	if x != nil {
+		return x.Histogram
+	}
+	return nil
+}
+
 type AggregateReply_Aggregations_Aggregation_Reference struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Type  *string                `protobuf:"bytes,1,opt,name=type,proto3,oneof" json:"type,omitempty"`
@@ -1880,7 +2262,7 @@ type AggregateReply_Aggregations_Aggregation_Reference struct {
 
 func (x *AggregateReply_Aggregations_Aggregation_Reference) Reset() {
 	*x = AggregateReply_Aggregations_Aggregation_Reference{}
-	mi := &file_v1_aggregate_proto_msgTypes[20]
+	mi := &file_v1_aggregate_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1892,7 +2274,7 @@ func (x *AggregateReply_Aggregations_Aggregation_Reference) String() string {
 func (*AggregateReply_Aggregations_Aggregation_Reference) ProtoMessage() {}
 
 func (x *AggregateReply_Aggregations_Aggregation_Reference) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_aggregate_proto_msgTypes[20]
+	mi := &file_v1_aggregate_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1905,7 +2287,7 @@ func (x *AggregateReply_Aggregations_Aggregation_Reference) ProtoReflect() proto
 
 // Deprecated: Use AggregateReply_Aggregations_Aggregation_Reference.ProtoReflect.Descriptor instead.
 func (*AggregateReply_Aggregations_Aggregation_Reference) Descriptor() ([]byte, []int) {
-	return file_v1_aggregate_proto_rawDescGZIP(), []int{1, 0, 0, 5}
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{1, 0, 0, 8}
 }
 
 func (x *AggregateReply_Aggregations_Aggregation_Reference) GetType() string {
@@ -1931,7 +2313,7 @@ type AggregateReply_Aggregations_Aggregation_Text_TopOccurrences struct {
 
 func (x *AggregateReply_Aggregations_Aggregation_Text_TopOccurrences) Reset() {
 	*x = AggregateReply_Aggregations_Aggregation_Text_TopOccurrences{}
-	mi := &file_v1_aggregate_proto_msgTypes[21]
+	mi := &file_v1_aggregate_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1943,7 +2325,7 @@ func (x *AggregateReply_Aggregations_Aggregation_Text_TopOccurrences) String() s
 func (*AggregateReply_Aggregations_Aggregation_Text_TopOccurrences) ProtoMessage() {}
 
 func (x *AggregateReply_Aggregations_Aggregation_Text_TopOccurrences) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_aggregate_proto_msgTypes[21]
+	mi := &file_v1_aggregate_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1956,7 +2338,7 @@ func (x *AggregateReply_Aggregations_Aggregation_Text_TopOccurrences) ProtoRefle
 
 // Deprecated: Use AggregateReply_Aggregations_Aggregation_Text_TopOccurrences.ProtoReflect.Descriptor instead.
 func (*AggregateReply_Aggregations_Aggregation_Text_TopOccurrences) Descriptor() ([]byte, []int) {
-	return file_v1_aggregate_proto_rawDescGZIP(), []int{1, 0, 0, 2, 0}
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{1, 0, 0, 5, 0}
 }
 
 func (x *AggregateReply_Aggregations_Aggregation_Text_TopOccurrences) GetItems() []*AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence {
@@ -1976,7 +2358,7 @@ type AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence s
 
 func (x *AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence) Reset() {
 	*x = AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence{}
-	mi := &file_v1_aggregate_proto_msgTypes[22]
+	mi := &file_v1_aggregate_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1988,7 +2370,7 @@ func (x *AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurren
 func (*AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence) ProtoMessage() {}
 
 func (x *AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_aggregate_proto_msgTypes[22]
+	mi := &file_v1_aggregate_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2001,7 +2383,7 @@ func (x *AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurren
 
 // Deprecated: Use AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence.ProtoReflect.Descriptor instead.
 func (*AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence) Descriptor() ([]byte, []int) {
-	return file_v1_aggregate_proto_rawDescGZIP(), []int{1, 0, 0, 2, 0, 0}
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{1, 0, 0, 5, 0, 0}
 }
 
 func (x *AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence) GetValue() string {
@@ -2040,7 +2422,7 @@ type AggregateReply_Group_GroupedBy struct {
 
 func (x *AggregateReply_Group_GroupedBy) Reset() {
 	*x = AggregateReply_Group_GroupedBy{}
-	mi := &file_v1_aggregate_proto_msgTypes[23]
+	mi := &file_v1_aggregate_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2052,7 +2434,7 @@ func (x *AggregateReply_Group_GroupedBy) String() string {
 func (*AggregateReply_Group_GroupedBy) ProtoMessage() {}
 
 func (x *AggregateReply_Group_GroupedBy) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_aggregate_proto_msgTypes[23]
+	mi := &file_v1_aggregate_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2225,19 +2607,20 @@ var File_v1_aggregate_proto protoreflect.FileDescriptor
 
 const file_v1_aggregate_proto_rawDesc = "" +
 	"\n" +
-	"\x12v1/aggregate.proto\x12\vweaviate.v1\x1a\rv1/base.proto\x1a\x14v1/base_search.proto\"\x9d\x14\n" +
+	"\x12v1/aggregate.proto\x12\vweaviate.v1\x1a\rv1/base.proto\x1a\x14v1/base_search.proto\"\xc9\x18\n" +
 	"\x10AggregateRequest\x12\x1e\n" +
 	"\n" +
 	"collection\x18\x01 \x01(\tR\n" +
 	"collection\x12\x16\n" +
 	"\x06tenant\x18\n" +
-	" \x01(\tR\x06tenant\x12#\n" +
+	" \x01(\tR\x06tenant\x12\x1f\n" +
+	"\bsnapshot\x18\v \x01(\tH\x01R\bsnapshot\x88\x01\x01\x12#\n" +
 	"\robjects_count\x18\x14 \x01(\bR\fobjectsCount\x12M\n" +
 	"\faggregations\x18\x15 \x03(\v2).weaviate.v1.AggregateRequest.AggregationR\faggregations\x12&\n" +
-	"\fobject_limit\x18\x1e \x01(\rH\x01R\vobjectLimit\x88\x01\x01\x12E\n" +
-	"\bgroup_by\x18\x1f \x01(\v2%.weaviate.v1.AggregateRequest.GroupByH\x02R\agroupBy\x88\x01\x01\x12\x19\n" +
-	"\x05limit\x18  \x01(\rH\x03R\x05limit\x88\x01\x01\x123\n" +
-	"\afilters\x18( \x01(\v2\x14.weaviate.v1.FiltersH\x04R\afilters\x88\x01\x01\x12-\n" +
+	"\fobject_limit\x18\x1e \x01(\rH\x02R\vobjectLimit\x88\x01\x01\x12E\n" +
+	"\bgroup_by\x18\x1f \x01(\v2%.weaviate.v1.AggregateRequest.GroupByH\x03R\agroupBy\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18  \x01(\rH\x04R\x05limit\x88\x01\x01\x123\n" +
+	"\afilters\x18( \x01(\v2\x14.weaviate.v1.FiltersH\x05R\afilters\x88\x01\x01\x12-\n" +
 	"\x06hybrid\x18) \x01(\v2\x13.weaviate.v1.HybridH\x00R\x06hybrid\x12:\n" +
 	"\vnear_vector\x18* \x01(\v2\x17.weaviate.v1.NearVectorH\x00R\n" +
 	"nearVector\x12:\n" +
@@ -2253,7 +2636,7 @@ const file_v1_aggregate_proto_rawDesc = "" +
 	"\n" +
 	"near_depth\x180 \x01(\v2\x1c.weaviate.v1.NearDepthSearchH\x00R\tnearDepth\x12C\n" +
 	"\fnear_thermal\x181 \x01(\v2\x1e.weaviate.v1.NearThermalSearchH\x00R\vnearThermal\x127\n" +
-	"\bnear_imu\x182 \x01(\v2\x1a.weaviate.v1.NearIMUSearchH\x00R\anearImu\x1a\xbb\v\n" +
+	"\bnear_imu\x182 \x01(\v2\x1a.weaviate.v1.NearIMUSearchH\x00R\anearImu\x1a\xb9\x0f\n" +
 	"\vAggregation\x12\x1a\n" +
 	"\bproperty\x18\x01 \x01(\tR\bproperty\x12E\n" +
 	"\x03int\x18\x02 \x01(\v21.weaviate.v1.AggregateRequest.Aggregation.IntegerH\x00R\x03int\x12J\n" +
@@ -2261,7 +2644,16 @@ const file_v1_aggregate_proto_rawDesc = "" +
 	"\x04text\x18\x04 \x01(\v2..weaviate.v1.AggregateRequest.Aggregation.TextH\x00R\x04text\x12M\n" +
 	"\aboolean\x18\x05 \x01(\v21.weaviate.v1.AggregateRequest.Aggregation.BooleanH\x00R\aboolean\x12D\n" +
 	"\x04date\x18\x06 \x01(\v2..weaviate.v1.AggregateRequest.Aggregation.DateH\x00R\x04date\x12S\n" +
-	"\treference\x18\a \x01(\v23.weaviate.v1.AggregateRequest.Aggregation.ReferenceH\x00R\treference\x1a\xb9\x01\n" +
+	"\treference\x18\a \x01(\v23.weaviate.v1.AggregateRequest.Aggregation.ReferenceH\x00R\treference\x1aA\n" +
+	"\tHistogram\x12\x1a\n" +
+	"\binterval\x18\x01 \x01(\x01R\binterval\x12\x18\n" +
+	"\ametrics\x18\x02 \x03(\tR\ametrics\x1au\n" +
+	"\rDateHistogram\x12\x1a\n" +
+	"\binterval\x18\x01 \x01(\tR\binterval\x12 \n" +
+	"\ttime_zone\x18\x02 \x01(\tH\x00R\btimeZone\x88\x01\x01\x12\x18\n" +
+	"\ametrics\x18\x03 \x03(\tR\ametricsB\f\n" +
+	"\n" +
+	"_time_zone\x1a\x9f\x02\n" +
 	"\aInteger\x12\x14\n" +
 	"\x05count\x18\x01 \x01(\bR\x05count\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\bR\x04type\x12\x10\n" +
@@ -2270,7 +2662,10 @@ const file_v1_aggregate_proto_rawDesc = "" +
 	"\x04mode\x18\x05 \x01(\bR\x04mode\x12\x16\n" +
 	"\x06median\x18\x06 \x01(\bR\x06median\x12\x18\n" +
 	"\amaximum\x18\a \x01(\bR\amaximum\x12\x18\n" +
-	"\aminimum\x18\b \x01(\bR\aminimum\x1a\xb8\x01\n" +
+	"\aminimum\x18\b \x01(\bR\aminimum\x12V\n" +
+	"\thistogram\x18\t \x01(\v23.weaviate.v1.AggregateRequest.Aggregation.HistogramH\x00R\thistogram\x88\x01\x01B\f\n" +
+	"\n" +
+	"_histogram\x1a\x9e\x02\n" +
 	"\x06Number\x12\x14\n" +
 	"\x05count\x18\x01 \x01(\bR\x05count\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\bR\x04type\x12\x10\n" +
@@ -2279,7 +2674,10 @@ const file_v1_aggregate_proto_rawDesc = "" +
 	"\x04mode\x18\x05 \x01(\bR\x04mode\x12\x16\n" +
 	"\x06median\x18\x06 \x01(\bR\x06median\x12\x18\n" +
 	"\amaximum\x18\a \x01(\bR\amaximum\x12\x18\n" +
-	"\aminimum\x18\b \x01(\bR\aminimum\x1a\xa7\x01\n" +
+	"\aminimum\x18\b \x01(\bR\aminimum\x12V\n" +
+	"\thistogram\x18\t \x01(\v23.weaviate.v1.AggregateRequest.Aggregation.HistogramH\x00R\thistogram\x88\x01\x01B\f\n" +
+	"\n" +
+	"_histogram\x1a\xa7\x01\n" +
 	"\x04Text\x12\x14\n" +
 	"\x05count\x18\x01 \x01(\bR\x05count\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\bR\x04type\x12%\n" +
@@ -2294,14 +2692,16 @@ const file_v1_aggregate_proto_rawDesc = "" +
 	"\vtotal_false\x18\x04 \x01(\bR\n" +
 	"totalFalse\x12'\n" +
 	"\x0fpercentage_true\x18\x05 \x01(\bR\x0epercentageTrue\x12)\n" +
-	"\x10percentage_false\x18\x06 \x01(\bR\x0fpercentageFalse\x1a\x90\x01\n" +
+	"\x10percentage_false\x18\x06 \x01(\bR\x0fpercentageFalse\x1a\x88\x02\n" +
 	"\x04Date\x12\x14\n" +
 	"\x05count\x18\x01 \x01(\bR\x05count\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\bR\x04type\x12\x16\n" +
 	"\x06median\x18\x03 \x01(\bR\x06median\x12\x12\n" +
 	"\x04mode\x18\x04 \x01(\bR\x04mode\x12\x18\n" +
 	"\amaximum\x18\x05 \x01(\bR\amaximum\x12\x18\n" +
-	"\aminimum\x18\x06 \x01(\bR\aminimum\x1a@\n" +
+	"\aminimum\x18\x06 \x01(\bR\aminimum\x12c\n" +
+	"\x0edate_histogram\x18\a \x01(\v27.weaviate.v1.AggregateRequest.Aggregation.DateHistogramH\x00R\rdateHistogram\x88\x01\x01B\x11\n" +
+	"\x0f_date_histogram\x1a@\n" +
 	"\tReference\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\bR\x04type\x12\x1f\n" +
 	"\vpointing_to\x18\x02 \x01(\bR\n" +
@@ -2312,18 +2712,19 @@ const file_v1_aggregate_proto_rawDesc = "" +
 	"collection\x18\x01 \x01(\tR\n" +
 	"collection\x12\x1a\n" +
 	"\bproperty\x18\x02 \x01(\tR\bpropertyB\b\n" +
-	"\x06searchB\x0f\n" +
+	"\x06searchB\v\n" +
+	"\t_snapshotB\x0f\n" +
 	"\r_object_limitB\v\n" +
 	"\t_group_byB\b\n" +
 	"\x06_limitB\n" +
 	"\n" +
-	"\b_filters\"\x80\x1b\n" +
+	"\b_filters\"\x8f!\n" +
 	"\x0eAggregateReply\x12\x12\n" +
 	"\x04took\x18\x01 \x01(\x02R\x04took\x12I\n" +
 	"\rsingle_result\x18\x02 \x01(\v2\".weaviate.v1.AggregateReply.SingleH\x00R\fsingleResult\x12N\n" +
-	"\x0fgrouped_results\x18\x03 \x01(\v2#.weaviate.v1.AggregateReply.GroupedH\x00R\x0egroupedResults\x1a\xab\x12\n" +
+	"\x0fgrouped_results\x18\x03 \x01(\v2#.weaviate.v1.AggregateReply.GroupedH\x00R\x0egroupedResults\x1a\xba\x18\n" +
 	"\fAggregations\x12X\n" +
-	"\faggregations\x18\x01 \x03(\v24.weaviate.v1.AggregateReply.Aggregations.AggregationR\faggregations\x1a\xc0\x11\n" +
+	"\faggregations\x18\x01 \x03(\v24.weaviate.v1.AggregateReply.Aggregations.AggregationR\faggregations\x1a\xcf\x17\n" +
 	"\vAggregation\x12\x1a\n" +
 	"\bproperty\x18\x01 \x01(\tR\bproperty\x12P\n" +
 	"\x03int\x18\x02 \x01(\v2<.weaviate.v1.AggregateReply.Aggregations.Aggregation.IntegerH\x00R\x03int\x12U\n" +
@@ -2331,7 +2732,22 @@ const file_v1_aggregate_proto_rawDesc = "" +
 	"\x04text\x18\x04 \x01(\v29.weaviate.v1.AggregateReply.Aggregations.Aggregation.TextH\x00R\x04text\x12X\n" +
 	"\aboolean\x18\x05 \x01(\v2<.weaviate.v1.AggregateReply.Aggregations.Aggregation.BooleanH\x00R\aboolean\x12O\n" +
 	"\x04date\x18\x06 \x01(\v29.weaviate.v1.AggregateReply.Aggregations.Aggregation.DateH\x00R\x04date\x12^\n" +
-	"\treference\x18\a \x01(\v2>.weaviate.v1.AggregateReply.Aggregations.Aggregation.ReferenceH\x00R\treference\x1a\xb1\x02\n" +
+	"\treference\x18\a \x01(\v2>.weaviate.v1.AggregateReply.Aggregations.Aggregation.ReferenceH\x00R\treference\x1a\x9e\x01\n" +
+	"\x10HistogramMetrics\x12\x1a\n" +
+	"\bproperty\x18\x01 \x01(\tR\bproperty\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12\x10\n" +
+	"\x03sum\x18\x03 \x01(\x01R\x03sum\x12\x12\n" +
+	"\x04mean\x18\x04 \x01(\x01R\x04mean\x12\x18\n" +
+	"\aminimum\x18\x05 \x01(\x01R\aminimum\x12\x18\n" +
+	"\amaximum\x18\x06 \x01(\x01R\amaximum\x1a\x9a\x01\n" +
+	"\x0fHistogramBucket\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x01R\x03key\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12_\n" +
+	"\ametrics\x18\x03 \x03(\v2E.weaviate.v1.AggregateReply.Aggregations.Aggregation.HistogramMetricsR\ametrics\x1a\x9e\x01\n" +
+	"\x13DateHistogramBucket\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12_\n" +
+	"\ametrics\x18\x03 \x03(\v2E.weaviate.v1.AggregateReply.Aggregations.Aggregation.HistogramMetricsR\ametrics\x1a\x95\x03\n" +
 	"\aInteger\x12\x19\n" +
 	"\x05count\x18\x01 \x01(\x03H\x00R\x05count\x88\x01\x01\x12\x17\n" +
 	"\x04type\x18\x02 \x01(\tH\x01R\x04type\x88\x01\x01\x12\x17\n" +
@@ -2340,7 +2756,8 @@ const file_v1_aggregate_proto_rawDesc = "" +
 	"\x04mode\x18\x05 \x01(\x03H\x04R\x04mode\x88\x01\x01\x12\x1d\n" +
 	"\amaximum\x18\x06 \x01(\x03H\x05R\amaximum\x88\x01\x01\x12\x1d\n" +
 	"\aminimum\x18\a \x01(\x03H\x06R\aminimum\x88\x01\x01\x12\x15\n" +
-	"\x03sum\x18\b \x01(\x03H\aR\x03sum\x88\x01\x01B\b\n" +
+	"\x03sum\x18\b \x01(\x03H\aR\x03sum\x88\x01\x01\x12b\n" +
+	"\thistogram\x18\t \x03(\v2D.weaviate.v1.AggregateReply.Aggregations.Aggregation.HistogramBucketR\thistogramB\b\n" +
 	"\x06_countB\a\n" +
 	"\x05_typeB\a\n" +
 	"\x05_meanB\t\n" +
@@ -2350,7 +2767,7 @@ const file_v1_aggregate_proto_rawDesc = "" +
 	"\b_maximumB\n" +
 	"\n" +
 	"\b_minimumB\x06\n" +
-	"\x04_sum\x1a\xb0\x02\n" +
+	"\x04_sum\x1a\x94\x03\n" +
 	"\x06Number\x12\x19\n" +
 	"\x05count\x18\x01 \x01(\x03H\x00R\x05count\x88\x01\x01\x12\x17\n" +
 	"\x04type\x18\x02 \x01(\tH\x01R\x04type\x88\x01\x01\x12\x17\n" +
@@ -2359,7 +2776,8 @@ const file_v1_aggregate_proto_rawDesc = "" +
 	"\x04mode\x18\x05 \x01(\x01H\x04R\x04mode\x88\x01\x01\x12\x1d\n" +
 	"\amaximum\x18\x06 \x01(\x01H\x05R\amaximum\x88\x01\x01\x12\x1d\n" +
 	"\aminimum\x18\a \x01(\x01H\x06R\aminimum\x88\x01\x01\x12\x15\n" +
-	"\x03sum\x18\b \x01(\x01H\aR\x03sum\x88\x01\x01B\b\n" +
+	"\x03sum\x18\b \x01(\x01H\aR\x03sum\x88\x01\x01\x12b\n" +
+	"\thistogram\x18\t \x03(\v2D.weaviate.v1.AggregateReply.Aggregations.Aggregation.HistogramBucketR\thistogramB\b\n" +
 	"\x06_countB\a\n" +
 	"\x05_typeB\a\n" +
 	"\x05_meanB\t\n" +
@@ -2396,14 +2814,15 @@ const file_v1_aggregate_proto_rawDesc = "" +
 	"\v_total_trueB\x0e\n" +
 	"\f_total_falseB\x12\n" +
 	"\x10_percentage_trueB\x13\n" +
-	"\x11_percentage_false\x1a\xed\x01\n" +
+	"\x11_percentage_false\x1a\xd5\x02\n" +
 	"\x04Date\x12\x19\n" +
 	"\x05count\x18\x01 \x01(\x03H\x00R\x05count\x88\x01\x01\x12\x17\n" +
 	"\x04type\x18\x02 \x01(\tH\x01R\x04type\x88\x01\x01\x12\x1b\n" +
 	"\x06median\x18\x03 \x01(\tH\x02R\x06median\x88\x01\x01\x12\x17\n" +
 	"\x04mode\x18\x04 \x01(\tH\x03R\x04mode\x88\x01\x01\x12\x1d\n" +
 	"\amaximum\x18\x05 \x01(\tH\x04R\amaximum\x88\x01\x01\x12\x1d\n" +
-	"\aminimum\x18\x06 \x01(\tH\x05R\aminimum\x88\x01\x01B\b\n" +
+	"\aminimum\x18\x06 \x01(\tH\x05R\aminimum\x88\x01\x01\x12f\n" +
+	"\thistogram\x18\a \x03(\v2H.weaviate.v1.AggregateReply.Aggregations.Aggregation.DateHistogramBucketR\thistogramB\b\n" +
 	"\x06_countB\a\n" +
 	"\x05_typeB\t\n" +
 	"\a_medianB\a\n" +
@@ -2461,94 +2880,107 @@ func file_v1_aggregate_proto_rawDescGZIP() []byte {
 	return file_v1_aggregate_proto_rawDescData
 }
 
-var file_v1_aggregate_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_v1_aggregate_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_v1_aggregate_proto_goTypes = []any{
 	(*AggregateRequest)(nil),                                                          // 0: weaviate.v1.AggregateRequest
 	(*AggregateReply)(nil),                                                            // 1: weaviate.v1.AggregateReply
 	(*AggregateRequest_Aggregation)(nil),                                              // 2: weaviate.v1.AggregateRequest.Aggregation
 	(*AggregateRequest_GroupBy)(nil),                                                  // 3: weaviate.v1.AggregateRequest.GroupBy
-	(*AggregateRequest_Aggregation_Integer)(nil),                                      // 4: weaviate.v1.AggregateRequest.Aggregation.Integer
-	(*AggregateRequest_Aggregation_Number)(nil),                                       // 5: weaviate.v1.AggregateRequest.Aggregation.Number
-	(*AggregateRequest_Aggregation_Text)(nil),                                         // 6: weaviate.v1.AggregateRequest.Aggregation.Text
-	(*AggregateRequest_Aggregation_Boolean)(nil),                                      // 7: weaviate.v1.AggregateRequest.Aggregation.Boolean
-	(*AggregateRequest_Aggregation_Date)(nil),                                         // 8: weaviate.v1.AggregateRequest.Aggregation.Date
-	(*AggregateRequest_Aggregation_Reference)(nil),                                    // 9: weaviate.v1.AggregateRequest.Aggregation.Reference
-	(*AggregateReply_Aggregations)(nil),                                               // 10: weaviate.v1.AggregateReply.Aggregations
-	(*AggregateReply_Single)(nil),                                                     // 11: weaviate.v1.AggregateReply.Single
-	(*AggregateReply_Group)(nil),                                                      // 12: weaviate.v1.AggregateReply.Group
-	(*AggregateReply_Grouped)(nil),                                                    // 13: weaviate.v1.AggregateReply.Grouped
-	(*AggregateReply_Aggregations_Aggregation)(nil),                                   // 14: weaviate.v1.AggregateReply.Aggregations.Aggregation
-	(*AggregateReply_Aggregations_Aggregation_Integer)(nil),                           // 15: weaviate.v1.AggregateReply.Aggregations.Aggregation.Integer
-	(*AggregateReply_Aggregations_Aggregation_Number)(nil),                            // 16: weaviate.v1.AggregateReply.Aggregations.Aggregation.Number
-	(*AggregateReply_Aggregations_Aggregation_Text)(nil),                              // 17: weaviate.v1.AggregateReply.Aggregations.Aggregation.Text
-	(*AggregateReply_Aggregations_Aggregation_Boolean)(nil),                           // 18: weaviate.v1.AggregateReply.Aggregations.Aggregation.Boolean
-	(*AggregateReply_Aggregations_Aggregation_Date)(nil),                              // 19: weaviate.v1.AggregateReply.Aggregations.Aggregation.Date
-	(*AggregateReply_Aggregations_Aggregation_Reference)(nil),                         // 20: weaviate.v1.AggregateReply.Aggregations.Aggregation.Reference
-	(*AggregateReply_Aggregations_Aggregation_Text_TopOccurrences)(nil),               // 21: weaviate.v1.AggregateReply.Aggregations.Aggregation.Text.TopOccurrences
-	(*AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence)(nil), // 22: weaviate.v1.AggregateReply.Aggregations.Aggregation.Text.TopOccurrences.TopOccurrence
-	(*AggregateReply_Group_GroupedBy)(nil),                                            // 23: weaviate.v1.AggregateReply.Group.GroupedBy
-	(*Filters)(nil),                                                                   // 24: weaviate.v1.Filters
-	(*Hybrid)(nil),                                                                    // 25: weaviate.v1.Hybrid
-	(*NearVector)(nil),                                                                // 26: weaviate.v1.NearVector
-	(*NearObject)(nil),                                                                // 27: weaviate.v1.NearObject
-	(*NearTextSearch)(nil),                                                            // 28: weaviate.v1.NearTextSearch
-	(*NearImageSearch)(nil),                                                           // 29: weaviate.v1.NearImageSearch
-	(*NearAudioSearch)(nil),                                                           // 30: weaviate.v1.NearAudioSearch
-	(*NearVideoSearch)(nil),                                                           // 31: weaviate.v1.NearVideoSearch
-	(*NearDepthSearch)(nil),                                                           // 32: weaviate.v1.NearDepthSearch
-	(*NearThermalSearch)(nil),                                                         // 33: weaviate.v1.NearThermalSearch
-	(*NearIMUSearch)(nil),                                                             // 34: weaviate.v1.NearIMUSearch
-	(*TextArray)(nil),                                                                 // 35: weaviate.v1.TextArray
-	(*IntArray)(nil),                                                                  // 36: weaviate.v1.IntArray
-	(*BooleanArray)(nil),                                                              // 37: weaviate.v1.BooleanArray
-	(*NumberArray)(nil),                                                               // 38: weaviate.v1.NumberArray
-	(*GeoCoordinatesFilter)(nil),                                                      // 39: weaviate.v1.GeoCoordinatesFilter
+	(*AggregateRequest_Aggregation_Histogram)(nil),                                    // 4: weaviate.v1.AggregateRequest.Aggregation.Histogram
+	(*AggregateRequest_Aggregation_DateHistogram)(nil),                                // 5: weaviate.v1.AggregateRequest.Aggregation.DateHistogram
+	(*AggregateRequest_Aggregation_Integer)(nil),                                      // 6: weaviate.v1.AggregateRequest.Aggregation.Integer
+	(*AggregateRequest_Aggregation_Number)(nil),                                       // 7: weaviate.v1.AggregateRequest.Aggregation.Number
+	(*AggregateRequest_Aggregation_Text)(nil),                                         // 8: weaviate.v1.AggregateRequest.Aggregation.Text
+	(*AggregateRequest_Aggregation_Boolean)(nil),                                      // 9: weaviate.v1.AggregateRequest.Aggregation.Boolean
+	(*AggregateRequest_Aggregation_Date)(nil),                                         // 10: weaviate.v1.AggregateRequest.Aggregation.Date
+	(*AggregateRequest_Aggregation_Reference)(nil),                                    // 11: weaviate.v1.AggregateRequest.Aggregation.Reference
+	(*AggregateReply_Aggregations)(nil),                                               // 12: weaviate.v1.AggregateReply.Aggregations
+	(*AggregateReply_Single)(nil),                                                     // 13: weaviate.v1.AggregateReply.Single
+	(*AggregateReply_Group)(nil),                                                      // 14: weaviate.v1.AggregateReply.Group
+	(*AggregateReply_Grouped)(nil),                                                    // 15: weaviate.v1.AggregateReply.Grouped
+	(*AggregateReply_Aggregations_Aggregation)(nil),                                   // 16: weaviate.v1.AggregateReply.Aggregations.Aggregation
+	(*AggregateReply_Aggregations_Aggregation_HistogramMetrics)(nil),                  // 17: weaviate.v1.AggregateReply.Aggregations.Aggregation.HistogramMetrics
+	(*AggregateReply_Aggregations_Aggregation_HistogramBucket)(nil),                   // 18: weaviate.v1.AggregateReply.Aggregations.Aggregation.HistogramBucket
+	(*AggregateReply_Aggregations_Aggregation_DateHistogramBucket)(nil),               // 19: weaviate.v1.AggregateReply.Aggregations.Aggregation.DateHistogramBucket
+	(*AggregateReply_Aggregations_Aggregation_Integer)(nil),                           // 20: weaviate.v1.AggregateReply.Aggregations.Aggregation.Integer
+	(*AggregateReply_Aggregations_Aggregation_Number)(nil),                            // 21: weaviate.v1.AggregateReply.Aggregations.Aggregation.Number
+	(*AggregateReply_Aggregations_Aggregation_Text)(nil),                              // 22: weaviate.v1.AggregateReply.Aggregations.Aggregation.Text
+	(*AggregateReply_Aggregations_Aggregation_Boolean)(nil),                           // 23: weaviate.v1.AggregateReply.Aggregations.Aggregation.Boolean
+	(*AggregateReply_Aggregations_Aggregation_Date)(nil),                              // 24: weaviate.v1.AggregateReply.Aggregations.Aggregation.Date
+	(*AggregateReply_Aggregations_Aggregation_Reference)(nil),                         // 25: weaviate.v1.AggregateReply.Aggregations.Aggregation.Reference
+	(*AggregateReply_Aggregations_Aggregation_Text_TopOccurrences)(nil),               // 26: weaviate.v1.AggregateReply.Aggregations.Aggregation.Text.TopOccurrences
+	(*AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence)(nil), // 27: weaviate.v1.AggregateReply.Aggregations.Aggregation.Text.TopOccurrences.TopOccurrence
+	(*AggregateReply_Group_GroupedBy)(nil),                                            // 28: weaviate.v1.AggregateReply.Group.GroupedBy
+	(*Filters)(nil),                                                                   // 29: weaviate.v1.Filters
+	(*Hybrid)(nil),                                                                    // 30: weaviate.v1.Hybrid
+	(*NearVector)(nil),                                                                // 31: weaviate.v1.NearVector
+	(*NearObject)(nil),                                                                // 32: weaviate.v1.NearObject
+	(*NearTextSearch)(nil),                                                            // 33: weaviate.v1.NearTextSearch
+	(*NearImageSearch)(nil),                                                           // 34: weaviate.v1.NearImageSearch
+	(*NearAudioSearch)(nil),                                                           // 35: weaviate.v1.NearAudioSearch
+	(*NearVideoSearch)(nil),                                                           // 36: weaviate.v1.NearVideoSearch
+	(*NearDepthSearch)(nil),                                                           // 37: weaviate.v1.NearDepthSearch
+	(*NearThermalSearch)(nil),                                                         // 38: weaviate.v1.NearThermalSearch
+	(*NearIMUSearch)(nil),                                                             // 39: weaviate.v1.NearIMUSearch
+	(*TextArray)(nil),                                                                 // 40: weaviate.v1.TextArray
+	(*IntArray)(nil),                                                                  // 41: weaviate.v1.IntArray
+	(*BooleanArray)(nil),                                                              // 42: weaviate.v1.BooleanArray
+	(*NumberArray)(nil),                                                               // 43: weaviate.v1.NumberArray
+	(*GeoCoordinatesFilter)(nil),                                                      // 44: weaviate.v1.GeoCoordinatesFilter
 }
 var file_v1_aggregate_proto_depIdxs = []int32{
 	2,  // 0: weaviate.v1.AggregateRequest.aggregations:type_name -> weaviate.v1.AggregateRequest.Aggregation
 	3,  // 1: weaviate.v1.AggregateRequest.group_by:type_name -> weaviate.v1.AggregateRequest.GroupBy
-	24, // 2: weaviate.v1.AggregateRequest.filters:type_name -> weaviate.v1.Filters
-	25, // 3: weaviate.v1.AggregateRequest.hybrid:type_name -> weaviate.v1.Hybrid
-	26, // 4: weaviate.v1.AggregateRequest.near_vector:type_name -> weaviate.v1.NearVector
-	27, // 5: weaviate.v1.AggregateRequest.near_object:type_name -> weaviate.v1.NearObject
-	28, // 6: weaviate.v1.AggregateRequest.near_text:type_name -> weaviate.v1.NearTextSearch
-	29, // 7: weaviate.v1.AggregateRequest.near_image:type_name -> weaviate.v1.NearImageSearch
-	30, // 8: weaviate.v1.AggregateRequest.near_audio:type_name -> weaviate.v1.NearAudioSearch
-	31, // 9: weaviate.v1.AggregateRequest.near_video:type_name -> weaviate.v1.NearVideoSearch
-	32, // 10: weaviate.v1.AggregateRequest.near_depth:type_name -> weaviate.v1.NearDepthSearch
-	33, // 11: weaviate.v1.AggregateRequest.near_thermal:type_name -> weaviate.v1.NearThermalSearch
-	34, // 12: weaviate.v1.AggregateRequest.near_imu:type_name -> weaviate.v1.NearIMUSearch
-	11, // 13: weaviate.v1.AggregateReply.single_result:type_name -> weaviate.v1.AggregateReply.Single
-	13, // 14: weaviate.v1.AggregateReply.grouped_results:type_name -> weaviate.v1.AggregateReply.Grouped
-	4,  // 15: weaviate.v1.AggregateRequest.Aggregation.int:type_name -> weaviate.v1.AggregateRequest.Aggregation.Integer
-	5,  // 16: weaviate.v1.AggregateRequest.Aggregation.number:type_name -> weaviate.v1.AggregateRequest.Aggregation.Number
-	6,  // 17: weaviate.v1.AggregateRequest.Aggregation.text:type_name -> weaviate.v1.AggregateRequest.Aggregation.Text
-	7,  // 18: weaviate.v1.AggregateRequest.Aggregation.boolean:type_name -> weaviate.v1.AggregateRequest.Aggregation.Boolean
-	8,  // 19: weaviate.v1.AggregateRequest.Aggregation.date:type_name -> weaviate.v1.AggregateRequest.Aggregation.Date
-	9,  // 20: weaviate.v1.AggregateRequest.Aggregation.reference:type_name -> weaviate.v1.AggregateRequest.Aggregation.Reference
-	14, // 21: weaviate.v1.AggregateReply.Aggregations.aggregations:type_name -> weaviate.v1.AggregateReply.Aggregations.Aggregation
-	10, // 22: weaviate.v1.AggregateReply.Single.aggregations:type_name -> weaviate.v1.AggregateReply.Aggregations
-	10, // 23: weaviate.v1.AggregateReply.Group.aggregations:type_name -> weaviate.v1.AggregateReply.Aggregations
-	23, // 24: weaviate.v1.AggregateReply.Group.grouped_by:type_name -> weaviate.v1.AggregateReply.Group.GroupedBy
-	12, // 25: weaviate.v1.AggregateReply.Grouped.groups:type_name -> weaviate.v1.AggregateReply.Group
-	15, // 26: weaviate.v1.AggregateReply.Aggregations.Aggregation.int:type_name -> weaviate.v1.AggregateReply.Aggregations.Aggregation.Integer
-	16, // 27: weaviate.v1.AggregateReply.Aggregations.Aggregation.number:type_name -> weaviate.v1.AggregateReply.Aggregations.Aggregation.Number
-	17, // 28: weaviate.v1.AggregateReply.Aggregations.Aggregation.text:type_name -> weaviate.v1.AggregateReply.Aggregations.Aggregation.Text
-	18, // 29: weaviate.v1.AggregateReply.Aggregations.Aggregation.boolean:type_name -> weaviate.v1.AggregateReply.Aggregations.Aggregation.Boolean
-	19, // 30: weaviate.v1.AggregateReply.Aggregations.Aggregation.date:type_name -> weaviate.v1.AggregateReply.Aggregations.Aggregation.Date
-	20, // 31: weaviate.v1.AggregateReply.Aggregations.Aggregation.reference:type_name -> weaviate.v1.AggregateReply.Aggregations.Aggregation.Reference
-	21, // 32: weaviate.v1.AggregateReply.Aggregations.Aggregation.Text.top_occurences:type_name -> weaviate.v1.AggregateReply.Aggregations.Aggregation.Text.TopOccurrences
-	22, // 33: weaviate.v1.AggregateReply.Aggregations.Aggregation.Text.TopOccurrences.items:type_name -> weaviate.v1.AggregateReply.Aggregations.Aggregation.Text.TopOccurrences.TopOccurrence
-	35, // 34: weaviate.v1.AggregateReply.Group.GroupedBy.texts:type_name -> weaviate.v1.TextArray
-	36, // 35: weaviate.v1.AggregateReply.Group.GroupedBy.ints:type_name -> weaviate.v1.IntArray
-	37, // 36: weaviate.v1.AggregateReply.Group.GroupedBy.booleans:type_name -> weaviate.v1.BooleanArray
-	38, // 37: weaviate.v1.AggregateReply.Group.GroupedBy.numbers:type_name -> weaviate.v1.NumberArray
-	39, // 38: weaviate.v1.AggregateReply.Group.GroupedBy.geo:type_name -> weaviate.v1.GeoCoordinatesFilter
-	39, // [39:39] is the sub-list for method output_type
-	39, // [39:39] is the sub-list for method input_type
-	39, // [39:39] is the sub-list for extension type_name
-	39, // [39:39] is the sub-list for extension extendee
-	0,  // [0:39] is the sub-list for field type_name
+	29, // 2: weaviate.v1.AggregateRequest.filters:type_name -> weaviate.v1.Filters
+	30, // 3: weaviate.v1.AggregateRequest.hybrid:type_name -> weaviate.v1.Hybrid
+	31, // 4: weaviate.v1.AggregateRequest.near_vector:type_name -> weaviate.v1.NearVector
+	32, // 5: weaviate.v1.AggregateRequest.near_object:type_name -> weaviate.v1.NearObject
+	33, // 6: weaviate.v1.AggregateRequest.near_text:type_name -> weaviate.v1.NearTextSearch
+	34, // 7: weaviate.v1.AggregateRequest.near_image:type_name -> weaviate.v1.NearImageSearch
+	35, // 8: weaviate.v1.AggregateRequest.near_audio:type_name -> weaviate.v1.NearAudioSearch
+	36, // 9: weaviate.v1.AggregateRequest.near_video:type_name -> weaviate.v1.NearVideoSearch
+	37, // 10: weaviate.v1.AggregateRequest.near_depth:type_name -> weaviate.v1.NearDepthSearch
+	38, // 11: weaviate.v1.AggregateRequest.near_thermal:type_name -> weaviate.v1.NearThermalSearch
+	39, // 12: weaviate.v1.AggregateRequest.near_imu:type_name -> weaviate.v1.NearIMUSearch
+	13, // 13: weaviate.v1.AggregateReply.single_result:type_name -> weaviate.v1.AggregateReply.Single
+	15, // 14: weaviate.v1.AggregateReply.grouped_results:type_name -> weaviate.v1.AggregateReply.Grouped
+	6,  // 15: weaviate.v1.AggregateRequest.Aggregation.int:type_name -> weaviate.v1.AggregateRequest.Aggregation.Integer
+	7,  // 16: weaviate.v1.AggregateRequest.Aggregation.number:type_name -> weaviate.v1.AggregateRequest.Aggregation.Number
+	8,  // 17: weaviate.v1.AggregateRequest.Aggregation.text:type_name -> weaviate.v1.AggregateRequest.Aggregation.Text
+	9,  // 18: weaviate.v1.AggregateRequest.Aggregation.boolean:type_name -> weaviate.v1.AggregateRequest.Aggregation.Boolean
+	10, // 19: weaviate.v1.AggregateRequest.Aggregation.date:type_name -> weaviate.v1.AggregateRequest.Aggregation.Date
+	11, // 20: weaviate.v1.AggregateRequest.Aggregation.reference:type_name -> weaviate.v1.AggregateRequest.Aggregation.Reference
+	4,  // 21: weaviate.v1.AggregateRequest.Aggregation.Integer.histogram:type_name -> weaviate.v1.AggregateRequest.Aggregation.Histogram
+	4,  // 22: weaviate.v1.AggregateRequest.Aggregation.Number.histogram:type_name -> weaviate.v1.AggregateRequest.Aggregation.Histogram
+	5,  // 23: weaviate.v1.AggregateRequest.Aggregation.Date.date_histogram:type_name -> weaviate.v1.AggregateRequest.Aggregation.DateHistogram
+	16, // 24: weaviate.v1.AggregateReply.Aggregations.aggregations:type_name -> weaviate.v1.AggregateReply.Aggregations.Aggregation
+	12, // 25: weaviate.v1.AggregateReply.Single.aggregations:type_name -> weaviate.v1.AggregateReply.Aggregations
+	12, // 26: weaviate.v1.AggregateReply.Group.aggregations:type_name -> weaviate.v1.AggregateReply.Aggregations
+	28, // 27: weaviate.v1.AggregateReply.Group.grouped_by:type_name -> weaviate.v1.AggregateReply.Group.GroupedBy
+	14, // 28: weaviate.v1.AggregateReply.Grouped.groups:type_name -> weaviate.v1.AggregateReply.Group
+	20, // 29: weaviate.v1.AggregateReply.Aggregations.Aggregation.int:type_name -> weaviate.v1.AggregateReply.Aggregations.Aggregation.Integer
+	21, // 30: weaviate.v1.AggregateReply.Aggregations.Aggregation.number:type_name -> weaviate.v1.AggregateReply.Aggregations.Aggregation.Number
+	22, // 31: weaviate.v1.AggregateReply.Aggregations.Aggregation.text:type_name -> weaviate.v1.AggregateReply.Aggregations.Aggregation.Text
+	23, // 32: weaviate.v1.AggregateReply.Aggregations.Aggregation.boolean:type_name -> weaviate.v1.AggregateReply.Aggregations.Aggregation.Boolean
+	24, // 33: weaviate.v1.AggregateReply.Aggregations.Aggregation.date:type_name -> weaviate.v1.AggregateReply.Aggregations.Aggregation.Date
+	25, // 34: weaviate.v1.AggregateReply.Aggregations.Aggregation.reference:type_name -> weaviate.v1.AggregateReply.Aggregations.Aggregation.Reference
+	17, // 35: weaviate.v1.AggregateReply.Aggregations.Aggregation.HistogramBucket.metrics:type_name -> weaviate.v1.AggregateReply.Aggregations.Aggregation.HistogramMetrics
+	17, // 36: weaviate.v1.AggregateReply.Aggregations.Aggregation.DateHistogramBucket.metrics:type_name -> weaviate.v1.AggregateReply.Aggregations.Aggregation.HistogramMetrics
+	18, // 37: weaviate.v1.AggregateReply.Aggregations.Aggregation.Integer.histogram:type_name -> weaviate.v1.AggregateReply.Aggregations.Aggregation.HistogramBucket
+	18, // 38: weaviate.v1.AggregateReply.Aggregations.Aggregation.Number.histogram:type_name -> weaviate.v1.AggregateReply.Aggregations.Aggregation.HistogramBucket
+	26, // 39: weaviate.v1.AggregateReply.Aggregations.Aggregation.Text.top_occurences:type_name -> weaviate.v1.AggregateReply.Aggregations.Aggregation.Text.TopOccurrences
+	19, // 40: weaviate.v1.AggregateReply.Aggregations.Aggregation.Date.histogram:type_name -> weaviate.v1.AggregateReply.Aggregations.Aggregation.DateHistogramBucket
+	27, // 41: weaviate.v1.AggregateReply.Aggregations.Aggregation.Text.TopOccurrences.items:type_name -> weaviate.v1.AggregateReply.Aggregations.Aggregation.Text.TopOccurrences.TopOccurrence
+	40, // 42: weaviate.v1.AggregateReply.Group.GroupedBy.texts:type_name -> weaviate.v1.TextArray
+	41, // 43: weaviate.v1.AggregateReply.Group.GroupedBy.ints:type_name -> weaviate.v1.IntArray
+	42, // 44: weaviate.v1.AggregateReply.Group.GroupedBy.booleans:type_name -> weaviate.v1.BooleanArray
+	43, // 45: weaviate.v1.AggregateReply.Group.GroupedBy.numbers:type_name -> weaviate.v1.NumberArray
+	44, // 46: weaviate.v1.AggregateReply.Group.GroupedBy.geo:type_name -> weaviate.v1.GeoCoordinatesFilter
+	47, // [47:47] is the sub-list for method output_type
+	47, // [47:47] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
 }
 
 func init() { file_v1_aggregate_proto_init() }
@@ -2582,10 +3014,14 @@ func file_v1_aggregate_proto_init() {
 		(*AggregateRequest_Aggregation_Date_)(nil),
 		(*AggregateRequest_Aggregation_Reference_)(nil),
 	}
+	file_v1_aggregate_proto_msgTypes[5].OneofWrappers = []any{}
 	file_v1_aggregate_proto_msgTypes[6].OneofWrappers = []any{}
-	file_v1_aggregate_proto_msgTypes[11].OneofWrappers = []any{}
-	file_v1_aggregate_proto_msgTypes[12].OneofWrappers = []any{}
-	file_v1_aggregate_proto_msgTypes[14].OneofWrappers = []any{
+	file_v1_aggregate_proto_msgTypes[7].OneofWrappers = []any{}
+	file_v1_aggregate_proto_msgTypes[8].OneofWrappers = []any{}
+	file_v1_aggregate_proto_msgTypes[10].OneofWrappers = []any{}
+	file_v1_aggregate_proto_msgTypes[13].OneofWrappers = []any{}
+	file_v1_aggregate_proto_msgTypes[14].OneofWrappers = []any{}
+	file_v1_aggregate_proto_msgTypes[16].OneofWrappers = []any{
 		(*AggregateReply_Aggregations_Aggregation_Int)(nil),
 		(*AggregateReply_Aggregations_Aggregation_Number_)(nil),
 		(*AggregateReply_Aggregations_Aggregation_Text_)(nil),
@@ -2593,13 +3029,13 @@ func file_v1_aggregate_proto_init() {
 		(*AggregateReply_Aggregations_Aggregation_Date_)(nil),
 		(*AggregateReply_Aggregations_Aggregation_Reference_)(nil),
 	}
-	file_v1_aggregate_proto_msgTypes[15].OneofWrappers = []any{}
-	file_v1_aggregate_proto_msgTypes[16].OneofWrappers = []any{}
-	file_v1_aggregate_proto_msgTypes[17].OneofWrappers = []any{}
-	file_v1_aggregate_proto_msgTypes[18].OneofWrappers = []any{}
-	file_v1_aggregate_proto_msgTypes[19].OneofWrappers = []any{}
 	file_v1_aggregate_proto_msgTypes[20].OneofWrappers = []any{}
-	file_v1_aggregate_proto_msgTypes[23].OneofWrappers = []any{
+	file_v1_aggregate_proto_msgTypes[21].OneofWrappers = []any{}
+	file_v1_aggregate_proto_msgTypes[22].OneofWrappers = []any{}
+	file_v1_aggregate_proto_msgTypes[23].OneofWrappers = []any{}
+	file_v1_aggregate_proto_msgTypes[24].OneofWrappers = []any{}
+	file_v1_aggregate_proto_msgTypes[25].OneofWrappers = []any{}
+	file_v1_aggregate_proto_msgTypes[28].OneofWrappers = []any{
 		(*AggregateReply_Group_GroupedBy_Text)(nil),
 		(*AggregateReply_Group_GroupedBy_Int)(nil),
 		(*AggregateReply_Group_GroupedBy_Boolean)(nil),
@@ -2616,7 +3052,7 @@ func file_v1_aggregate_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_aggregate_proto_rawDesc), len(file_v1_aggregate_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   24,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
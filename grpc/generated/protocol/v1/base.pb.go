@@ -90,6 +90,8 @@ const (
 	Filters_OPERATOR_CONTAINS_ALL       Filters_Operator = 13
 	Filters_OPERATOR_CONTAINS_NONE      Filters_Operator = 14
 	Filters_OPERATOR_NOT                Filters_Operator = 15
+	Filters_OPERATOR_REGEX              Filters_Operator = 16
+	Filters_OPERATOR_STARTS_WITH        Filters_Operator = 17
 )
 
 // Enum value maps for Filters_Operator.
@@ -111,6 +113,8 @@ var (
 		13: "OPERATOR_CONTAINS_ALL",
 		14: "OPERATOR_CONTAINS_NONE",
 		15: "OPERATOR_NOT",
+		16: "OPERATOR_REGEX",
+		17: "OPERATOR_STARTS_WITH",
 	}
 	Filters_Operator_value = map[string]int32{
 		"OPERATOR_UNSPECIFIED":        0,
@@ -129,6 +133,8 @@ var (
 		"OPERATOR_CONTAINS_ALL":       13,
 		"OPERATOR_CONTAINS_NONE":      14,
 		"OPERATOR_NOT":                15,
+		"OPERATOR_REGEX":              16,
+		"OPERATOR_STARTS_WITH":        17,
 	}
 )
 
@@ -165,6 +171,13 @@ const (
 	Vectors_VECTOR_TYPE_UNSPECIFIED Vectors_VectorType = 0
 	Vectors_VECTOR_TYPE_SINGLE_FP32 Vectors_VectorType = 1
 	Vectors_VECTOR_TYPE_MULTI_FP32  Vectors_VectorType = 2
+	// single vectors encoded in the dataType of the vector index config,
+	// little endian float16 or one byte per dimension
+	Vectors_VECTOR_TYPE_SINGLE_FP16  Vectors_VectorType = 3
+	Vectors_VECTOR_TYPE_SINGLE_INT8  Vectors_VectorType = 4
+	Vectors_VECTOR_TYPE_SINGLE_UINT8 Vectors_VectorType = 5
+	// sparse vectors as little endian pairs of uint32 dimension and float32 weight
+	Vectors_VECTOR_TYPE_SPARSE_FP32 Vectors_VectorType = 6
 )
 
 // Enum value maps for Vectors_VectorType.
@@ -173,11 +186,19 @@ var (
 		0: "VECTOR_TYPE_UNSPECIFIED",
 		1: "VECTOR_TYPE_SINGLE_FP32",
 		2: "VECTOR_TYPE_MULTI_FP32",
+		3: "VECTOR_TYPE_SINGLE_FP16",
+		4: "VECTOR_TYPE_SINGLE_INT8",
+		5: "VECTOR_TYPE_SINGLE_UINT8",
+		6: "VECTOR_TYPE_SPARSE_FP32",
 	}
 	Vectors_VectorType_value = map[string]int32{
-		"VECTOR_TYPE_UNSPECIFIED": 0,
-		"VECTOR_TYPE_SINGLE_FP32": 1,
-		"VECTOR_TYPE_MULTI_FP32":  2,
+		"VECTOR_TYPE_UNSPECIFIED":  0,
+		"VECTOR_TYPE_SINGLE_FP32":  1,
+		"VECTOR_TYPE_MULTI_FP32":   2,
+		"VECTOR_TYPE_SINGLE_FP16":  3,
+		"VECTOR_TYPE_SINGLE_INT8":  4,
+		"VECTOR_TYPE_SINGLE_UINT8": 5,
+		"VECTOR_TYPE_SPARSE_FP32":  6,
 	}
 )
 
@@ -1480,7 +1501,7 @@ const file_v1_base_proto_rawDesc = "" +
 	"\vNumberArray\x12\x16\n" +
 	"\x06values\x18\x01 \x03(\x01R\x06values\"&\n" +
 	"\fBooleanArray\x12\x16\n" +
-	"\x06values\x18\x01 \x03(\bR\x06values\"\xc7\b\n" +
+	"\x06values\x18\x01 \x03(\bR\x06values\"\xf5\b\n" +
 	"\aFilters\x129\n" +
 	"\boperator\x18\x01 \x01(\x0e2\x1d.weaviate.v1.Filters.OperatorR\boperator\x12\x12\n" +
 	"\x02on\x18\x02 \x03(\tB\x02\x18\x01R\x02on\x12.\n" +
@@ -1496,7 +1517,7 @@ const file_v1_base_proto_rawDesc = "" +
 	"\x13value_boolean_array\x18\v \x01(\v2\x19.weaviate.v1.BooleanArrayH\x00R\x11valueBooleanArray\x12H\n" +
 	"\x12value_number_array\x18\f \x01(\v2\x18.weaviate.v1.NumberArrayH\x00R\x10valueNumberArray\x12@\n" +
 	"\tvalue_geo\x18\r \x01(\v2!.weaviate.v1.GeoCoordinatesFilterH\x00R\bvalueGeo\x121\n" +
-	"\x06target\x18\x14 \x01(\v2\x19.weaviate.v1.FilterTargetR\x06target\"\x91\x03\n" +
+	"\x06target\x18\x14 \x01(\v2\x19.weaviate.v1.FilterTargetR\x06target\"\xbf\x03\n" +
 	"\bOperator\x12\x18\n" +
 	"\x14OPERATOR_UNSPECIFIED\x10\x00\x12\x12\n" +
 	"\x0eOPERATOR_EQUAL\x10\x01\x12\x16\n" +
@@ -1514,7 +1535,9 @@ const file_v1_base_proto_rawDesc = "" +
 	"\x15OPERATOR_CONTAINS_ANY\x10\f\x12\x19\n" +
 	"\x15OPERATOR_CONTAINS_ALL\x10\r\x12\x1a\n" +
 	"\x16OPERATOR_CONTAINS_NONE\x10\x0e\x12\x10\n" +
-	"\fOPERATOR_NOT\x10\x0fB\f\n" +
+	"\fOPERATOR_NOT\x10\x0f\x12\x12\n" +
+	"\x0eOPERATOR_REGEX\x10\x10\x12\x18\n" +
+	"\x14OPERATOR_STARTS_WITH\x10\x11B\f\n" +
 	"\n" +
 	"test_value\"`\n" +
 	"\x1bFilterReferenceSingleTarget\x12\x0e\n" +
@@ -1535,17 +1558,21 @@ const file_v1_base_proto_rawDesc = "" +
 	"\x14GeoCoordinatesFilter\x12\x1a\n" +
 	"\blatitude\x18\x01 \x01(\x02R\blatitude\x12\x1c\n" +
 	"\tlongitude\x18\x02 \x01(\x02R\tlongitude\x12\x1a\n" +
-	"\bdistance\x18\x03 \x01(\x02R\bdistance\"\xf3\x01\n" +
+	"\bdistance\x18\x03 \x01(\x02R\bdistance\"\xe9\x02\n" +
 	"\aVectors\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\x05index\x18\x02 \x01(\x04B\x02\x18\x01R\x05index\x12!\n" +
 	"\fvector_bytes\x18\x03 \x01(\fR\vvectorBytes\x123\n" +
-	"\x04type\x18\x04 \x01(\x0e2\x1f.weaviate.v1.Vectors.VectorTypeR\x04type\"b\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x1f.weaviate.v1.Vectors.VectorTypeR\x04type\"\xd7\x01\n" +
 	"\n" +
 	"VectorType\x12\x1b\n" +
 	"\x17VECTOR_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
 	"\x17VECTOR_TYPE_SINGLE_FP32\x10\x01\x12\x1a\n" +
-	"\x16VECTOR_TYPE_MULTI_FP32\x10\x02*\x89\x01\n" +
+	"\x16VECTOR_TYPE_MULTI_FP32\x10\x02\x12\x1b\n" +
+	"\x17VECTOR_TYPE_SINGLE_FP16\x10\x03\x12\x1b\n" +
+	"\x17VECTOR_TYPE_SINGLE_INT8\x10\x04\x12\x1c\n" +
+	"\x18VECTOR_TYPE_SINGLE_UINT8\x10\x05\x12\x1b\n" +
+	"\x17VECTOR_TYPE_SPARSE_FP32\x10\x06*\x89\x01\n" +
 	"\x10ConsistencyLevel\x12!\n" +
 	"\x1dCONSISTENCY_LEVEL_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15CONSISTENCY_LEVEL_ONE\x10\x01\x12\x1c\n" +
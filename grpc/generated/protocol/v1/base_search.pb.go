@@ -171,7 +171,7 @@ func (x Hybrid_FusionType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Hybrid_FusionType.Descriptor instead.
 func (Hybrid_FusionType) EnumDescriptor() ([]byte, []int) {
-	return file_v1_base_search_proto_rawDescGZIP(), []int{4, 0}
+	return file_v1_base_search_proto_rawDescGZIP(), []int{5, 0}
 }
 
 type WeightsForTarget struct {
@@ -400,6 +400,71 @@ func (x *SearchOperatorOptions) GetMinimumOrTokensMatch() int32 {
 	return 0
 }
 
+// Fuzziness matches query terms to the indexed terms within a maximum edit
+// distance. It cannot be combined with the AND operator or minimum_or_tokens_match.
+type Fuzziness struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// maximum Levenshtein distance between a query term and an indexed term, at most 2
+	MaxEdits uint32 `protobuf:"varint,1,opt,name=max_edits,json=maxEdits,proto3" json:"max_edits,omitempty"`
+	// number of leading characters which have to match exactly
+	PrefixLength uint32 `protobuf:"varint,2,opt,name=prefix_length,json=prefixLength,proto3" json:"prefix_length,omitempty"`
+	// maximum number of indexed terms a query term is expanded to, defaults to 50
+	MaxExpansions *uint32 `protobuf:"varint,3,opt,name=max_expansions,json=maxExpansions,proto3,oneof" json:"max_expansions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Fuzziness) Reset() {
+	*x = Fuzziness{}
+	mi := &file_v1_base_search_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Fuzziness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fuzziness) ProtoMessage() {}
+
+func (x *Fuzziness) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_base_search_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fuzziness.ProtoReflect.Descriptor instead.
+func (*Fuzziness) Descriptor() ([]byte, []int) {
+	return file_v1_base_search_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Fuzziness) GetMaxEdits() uint32 {
+	if x != nil {
+		return x.MaxEdits
+	}
+	return 0
+}
+
+func (x *Fuzziness) GetPrefixLength() uint32 {
+	if x != nil {
+		return x.PrefixLength
+	}
+	return 0
+}
+
+func (x *Fuzziness) GetMaxExpansions() uint32 {
+	if x != nil && x.MaxExpansions != nil {
+		return *x.MaxExpansions
+	}
+	return 0
+}
+
 type Hybrid struct {
 	state      protoimpl.MessageState `protogen:"open.v1"`
 	Query      string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
@@ -418,6 +483,9 @@ type Hybrid struct {
 	NearVector         *NearVector            `protobuf:"bytes,9,opt,name=near_vector,json=nearVector,proto3" json:"near_vector,omitempty"`          // same as above. Use the target vector in the hybrid message
 	Targets            *Targets               `protobuf:"bytes,10,opt,name=targets,proto3" json:"targets,omitempty"`
 	Bm25SearchOperator *SearchOperatorOptions `protobuf:"bytes,11,opt,name=bm25_search_operator,json=bm25SearchOperator,proto3,oneof" json:"bm25_search_operator,omitempty"`
+	Bm25Fuzziness      *Fuzziness             `protobuf:"bytes,12,opt,name=bm25_fuzziness,json=bm25Fuzziness,proto3,oneof" json:"bm25_fuzziness,omitempty"`
+	// sparse query vector, searched as the keyword side of the hybrid fusion
+	SparseVector *Vectors `protobuf:"bytes,13,opt,name=sparse_vector,json=sparseVector,proto3" json:"sparse_vector,omitempty"`
 	// only vector distance, but keep it extendable
 	//
 	// Types that are valid to be assigned to Threshold:
@@ -431,7 +499,7 @@ type Hybrid struct {
 
 func (x *Hybrid) Reset() {
 	*x = Hybrid{}
-	mi := &file_v1_base_search_proto_msgTypes[4]
+	mi := &file_v1_base_search_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -443,7 +511,7 @@ func (x *Hybrid) String() string {
 func (*Hybrid) ProtoMessage() {}
 
 func (x *Hybrid) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_base_search_proto_msgTypes[4]
+	mi := &file_v1_base_search_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -456,7 +524,7 @@ func (x *Hybrid) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Hybrid.ProtoReflect.Descriptor instead.
 func (*Hybrid) Descriptor() ([]byte, []int) {
-	return file_v1_base_search_proto_rawDescGZIP(), []int{4}
+	return file_v1_base_search_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Hybrid) GetQuery() string {
@@ -539,6 +607,20 @@ func (x *Hybrid) GetBm25SearchOperator() *SearchOperatorOptions {
 	return nil
 }
 
+func (x *Hybrid) GetBm25Fuzziness() *Fuzziness {
+	if x != nil {
+		return x.Bm25Fuzziness
+	}
+	return nil
+}
+
+func (x *Hybrid) GetSparseVector() *Vectors {
+	if x != nil {
+		return x.SparseVector
+	}
+	return nil
+}
+
 func (x *Hybrid) GetThreshold() isHybrid_Threshold {
 	if x != nil {
 		return x.Threshold
@@ -595,7 +677,7 @@ type NearVector struct {
 
 func (x *NearVector) Reset() {
 	*x = NearVector{}
-	mi := &file_v1_base_search_proto_msgTypes[5]
+	mi := &file_v1_base_search_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -607,7 +689,7 @@ func (x *NearVector) String() string {
 func (*NearVector) ProtoMessage() {}
 
 func (x *NearVector) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_base_search_proto_msgTypes[5]
+	mi := &file_v1_base_search_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -620,7 +702,7 @@ func (x *NearVector) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NearVector.ProtoReflect.Descriptor instead.
 func (*NearVector) Descriptor() ([]byte, []int) {
-	return file_v1_base_search_proto_rawDescGZIP(), []int{5}
+	return file_v1_base_search_proto_rawDescGZIP(), []int{6}
 }
 
 // Deprecated: Marked as deprecated in v1/base_search.proto.
@@ -704,7 +786,7 @@ type NearObject struct {
 
 func (x *NearObject) Reset() {
 	*x = NearObject{}
-	mi := &file_v1_base_search_proto_msgTypes[6]
+	mi := &file_v1_base_search_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -716,7 +798,7 @@ func (x *NearObject) String() string {
 func (*NearObject) ProtoMessage() {}
 
 func (x *NearObject) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_base_search_proto_msgTypes[6]
+	mi := &file_v1_base_search_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -729,7 +811,7 @@ func (x *NearObject) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NearObject.ProtoReflect.Descriptor instead.
 func (*NearObject) Descriptor() ([]byte, []int) {
-	return file_v1_base_search_proto_rawDescGZIP(), []int{6}
+	return file_v1_base_search_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *NearObject) GetId() string {
@@ -785,7 +867,7 @@ type NearTextSearch struct {
 
 func (x *NearTextSearch) Reset() {
 	*x = NearTextSearch{}
-	mi := &file_v1_base_search_proto_msgTypes[7]
+	mi := &file_v1_base_search_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -797,7 +879,7 @@ func (x *NearTextSearch) String() string {
 func (*NearTextSearch) ProtoMessage() {}
 
 func (x *NearTextSearch) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_base_search_proto_msgTypes[7]
+	mi := &file_v1_base_search_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -810,7 +892,7 @@ func (x *NearTextSearch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NearTextSearch.ProtoReflect.Descriptor instead.
 func (*NearTextSearch) Descriptor() ([]byte, []int) {
-	return file_v1_base_search_proto_rawDescGZIP(), []int{7}
+	return file_v1_base_search_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *NearTextSearch) GetQuery() []string {
@@ -877,7 +959,7 @@ type NearImageSearch struct {
 
 func (x *NearImageSearch) Reset() {
 	*x = NearImageSearch{}
-	mi := &file_v1_base_search_proto_msgTypes[8]
+	mi := &file_v1_base_search_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -889,7 +971,7 @@ func (x *NearImageSearch) String() string {
 func (*NearImageSearch) ProtoMessage() {}
 
 func (x *NearImageSearch) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_base_search_proto_msgTypes[8]
+	mi := &file_v1_base_search_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -902,7 +984,7 @@ func (x *NearImageSearch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NearImageSearch.ProtoReflect.Descriptor instead.
 func (*NearImageSearch) Descriptor() ([]byte, []int) {
-	return file_v1_base_search_proto_rawDescGZIP(), []int{8}
+	return file_v1_base_search_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *NearImageSearch) GetImage() string {
@@ -955,7 +1037,7 @@ type NearAudioSearch struct {
 
 func (x *NearAudioSearch) Reset() {
 	*x = NearAudioSearch{}
-	mi := &file_v1_base_search_proto_msgTypes[9]
+	mi := &file_v1_base_search_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -967,7 +1049,7 @@ func (x *NearAudioSearch) String() string {
 func (*NearAudioSearch) ProtoMessage() {}
 
 func (x *NearAudioSearch) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_base_search_proto_msgTypes[9]
+	mi := &file_v1_base_search_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -980,7 +1062,7 @@ func (x *NearAudioSearch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NearAudioSearch.ProtoReflect.Descriptor instead.
 func (*NearAudioSearch) Descriptor() ([]byte, []int) {
-	return file_v1_base_search_proto_rawDescGZIP(), []int{9}
+	return file_v1_base_search_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *NearAudioSearch) GetAudio() string {
@@ -1033,7 +1115,7 @@ type NearVideoSearch struct {
 
 func (x *NearVideoSearch) Reset() {
 	*x = NearVideoSearch{}
-	mi := &file_v1_base_search_proto_msgTypes[10]
+	mi := &file_v1_base_search_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1045,7 +1127,7 @@ func (x *NearVideoSearch) String() string {
 func (*NearVideoSearch) ProtoMessage() {}
 
 func (x *NearVideoSearch) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_base_search_proto_msgTypes[10]
+	mi := &file_v1_base_search_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1058,7 +1140,7 @@ func (x *NearVideoSearch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NearVideoSearch.ProtoReflect.Descriptor instead.
 func (*NearVideoSearch) Descriptor() ([]byte, []int) {
-	return file_v1_base_search_proto_rawDescGZIP(), []int{10}
+	return file_v1_base_search_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *NearVideoSearch) GetVideo() string {
@@ -1111,7 +1193,7 @@ type NearDepthSearch struct {
 
 func (x *NearDepthSearch) Reset() {
 	*x = NearDepthSearch{}
-	mi := &file_v1_base_search_proto_msgTypes[11]
+	mi := &file_v1_base_search_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1123,7 +1205,7 @@ func (x *NearDepthSearch) String() string {
 func (*NearDepthSearch) ProtoMessage() {}
 
 func (x *NearDepthSearch) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_base_search_proto_msgTypes[11]
+	mi := &file_v1_base_search_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1136,7 +1218,7 @@ func (x *NearDepthSearch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NearDepthSearch.ProtoReflect.Descriptor instead.
 func (*NearDepthSearch) Descriptor() ([]byte, []int) {
-	return file_v1_base_search_proto_rawDescGZIP(), []int{11}
+	return file_v1_base_search_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *NearDepthSearch) GetDepth() string {
@@ -1189,7 +1271,7 @@ type NearThermalSearch struct {
 
 func (x *NearThermalSearch) Reset() {
 	*x = NearThermalSearch{}
-	mi := &file_v1_base_search_proto_msgTypes[12]
+	mi := &file_v1_base_search_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1201,7 +1283,7 @@ func (x *NearThermalSearch) String() string {
 func (*NearThermalSearch) ProtoMessage() {}
 
 func (x *NearThermalSearch) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_base_search_proto_msgTypes[12]
+	mi := &file_v1_base_search_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1214,7 +1296,7 @@ func (x *NearThermalSearch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NearThermalSearch.ProtoReflect.Descriptor instead.
 func (*NearThermalSearch) Descriptor() ([]byte, []int) {
-	return file_v1_base_search_proto_rawDescGZIP(), []int{12}
+	return file_v1_base_search_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *NearThermalSearch) GetThermal() string {
@@ -1267,7 +1349,7 @@ type NearIMUSearch struct {
 
 func (x *NearIMUSearch) Reset() {
 	*x = NearIMUSearch{}
-	mi := &file_v1_base_search_proto_msgTypes[13]
+	mi := &file_v1_base_search_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1279,7 +1361,7 @@ func (x *NearIMUSearch) String() string {
 func (*NearIMUSearch) ProtoMessage() {}
 
 func (x *NearIMUSearch) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_base_search_proto_msgTypes[13]
+	mi := &file_v1_base_search_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1292,7 +1374,7 @@ func (x *NearIMUSearch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NearIMUSearch.ProtoReflect.Descriptor instead.
 func (*NearIMUSearch) Descriptor() ([]byte, []int) {
-	return file_v1_base_search_proto_rawDescGZIP(), []int{13}
+	return file_v1_base_search_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *NearIMUSearch) GetImu() string {
@@ -1336,13 +1418,14 @@ type BM25 struct {
 	Query          string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 	Properties     []string               `protobuf:"bytes,2,rep,name=properties,proto3" json:"properties,omitempty"`
 	SearchOperator *SearchOperatorOptions `protobuf:"bytes,3,opt,name=search_operator,json=searchOperator,proto3,oneof" json:"search_operator,omitempty"`
+	Fuzziness      *Fuzziness             `protobuf:"bytes,4,opt,name=fuzziness,proto3,oneof" json:"fuzziness,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *BM25) Reset() {
 	*x = BM25{}
-	mi := &file_v1_base_search_proto_msgTypes[14]
+	mi := &file_v1_base_search_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1354,7 +1437,7 @@ func (x *BM25) String() string {
 func (*BM25) ProtoMessage() {}
 
 func (x *BM25) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_base_search_proto_msgTypes[14]
+	mi := &file_v1_base_search_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1367,7 +1450,7 @@ func (x *BM25) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BM25.ProtoReflect.Descriptor instead.
 func (*BM25) Descriptor() ([]byte, []int) {
-	return file_v1_base_search_proto_rawDescGZIP(), []int{14}
+	return file_v1_base_search_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *BM25) GetQuery() string {
@@ -1391,6 +1474,13 @@ func (x *BM25) GetSearchOperator() *SearchOperatorOptions {
 	return nil
 }
 
+func (x *BM25) GetFuzziness() *Fuzziness {
+	if x != nil {
+		return x.Fuzziness
+	}
+	return nil
+}
+
 type NearTextSearch_Move struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Force         float32                `protobuf:"fixed32,1,opt,name=force,proto3" json:"force,omitempty"`
@@ -1402,7 +1492,7 @@ type NearTextSearch_Move struct {
 
 func (x *NearTextSearch_Move) Reset() {
 	*x = NearTextSearch_Move{}
-	mi := &file_v1_base_search_proto_msgTypes[16]
+	mi := &file_v1_base_search_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1414,7 +1504,7 @@ func (x *NearTextSearch_Move) String() string {
 func (*NearTextSearch_Move) ProtoMessage() {}
 
 func (x *NearTextSearch_Move) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_base_search_proto_msgTypes[16]
+	mi := &file_v1_base_search_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1427,7 +1517,7 @@ func (x *NearTextSearch_Move) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NearTextSearch_Move.ProtoReflect.Descriptor instead.
 func (*NearTextSearch_Move) Descriptor() ([]byte, []int) {
-	return file_v1_base_search_proto_rawDescGZIP(), []int{7, 0}
+	return file_v1_base_search_proto_rawDescGZIP(), []int{8, 0}
 }
 
 func (x *NearTextSearch_Move) GetForce() float32 {
@@ -1474,7 +1564,12 @@ const file_v1_base_search_proto_rawDesc = "" +
 	"\x14OPERATOR_UNSPECIFIED\x10\x00\x12\x0f\n" +
 	"\vOPERATOR_OR\x10\x01\x12\x10\n" +
 	"\fOPERATOR_AND\x10\x02B\x1a\n" +
-	"\x18_minimum_or_tokens_match\"\xe6\x05\n" +
+	"\x18_minimum_or_tokens_match\"\x8c\x01\n" +
+	"\tFuzziness\x12\x1b\n" +
+	"\tmax_edits\x18\x01 \x01(\rR\bmaxEdits\x12#\n" +
+	"\rprefix_length\x18\x02 \x01(\rR\fprefixLength\x12*\n" +
+	"\x0emax_expansions\x18\x03 \x01(\rH\x00R\rmaxExpansions\x88\x01\x01B\x11\n" +
+	"\x0f_max_expansions\"\xf8\x06\n" +
 	"\x06Hybrid\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1e\n" +
 	"\n" +
@@ -1491,7 +1586,9 @@ const file_v1_base_search_proto_rawDesc = "" +
 	"nearVector\x12.\n" +
 	"\atargets\x18\n" +
 	" \x01(\v2\x14.weaviate.v1.TargetsR\atargets\x12Y\n" +
-	"\x14bm25_search_operator\x18\v \x01(\v2\".weaviate.v1.SearchOperatorOptionsH\x01R\x12bm25SearchOperator\x88\x01\x01\x12)\n" +
+	"\x14bm25_search_operator\x18\v \x01(\v2\".weaviate.v1.SearchOperatorOptionsH\x01R\x12bm25SearchOperator\x88\x01\x01\x12B\n" +
+	"\x0ebm25_fuzziness\x18\f \x01(\v2\x16.weaviate.v1.FuzzinessH\x02R\rbm25Fuzziness\x88\x01\x01\x129\n" +
+	"\rsparse_vector\x18\r \x01(\v2\x14.weaviate.v1.VectorsR\fsparseVector\x12)\n" +
 	"\x0fvector_distance\x18\x14 \x01(\x02H\x00R\x0evectorDistance\x12.\n" +
 	"\avectors\x18\x15 \x03(\v2\x14.weaviate.v1.VectorsR\avectors\"a\n" +
 	"\n" +
@@ -1500,7 +1597,8 @@ const file_v1_base_search_proto_rawDesc = "" +
 	"\x12FUSION_TYPE_RANKED\x10\x01\x12\x1e\n" +
 	"\x1aFUSION_TYPE_RELATIVE_SCORE\x10\x02B\v\n" +
 	"\tthresholdB\x17\n" +
-	"\x15_bm25_search_operator\"\xa7\x04\n" +
+	"\x15_bm25_search_operatorB\x11\n" +
+	"\x0f_bm25_fuzziness\"\xa7\x04\n" +
 	"\n" +
 	"NearVector\x12\x1a\n" +
 	"\x06vector\x18\x01 \x03(\x02B\x02\x18\x01R\x06vector\x12!\n" +
@@ -1600,14 +1698,17 @@ const file_v1_base_search_proto_rawDesc = "" +
 	"\atargets\x18\x05 \x01(\v2\x14.weaviate.v1.TargetsR\atargetsB\f\n" +
 	"\n" +
 	"_certaintyB\v\n" +
-	"\t_distance\"\xa2\x01\n" +
+	"\t_distance\"\xeb\x01\n" +
 	"\x04BM25\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1e\n" +
 	"\n" +
 	"properties\x18\x02 \x03(\tR\n" +
 	"properties\x12P\n" +
-	"\x0fsearch_operator\x18\x03 \x01(\v2\".weaviate.v1.SearchOperatorOptionsH\x00R\x0esearchOperator\x88\x01\x01B\x12\n" +
-	"\x10_search_operator*\xee\x01\n" +
+	"\x0fsearch_operator\x18\x03 \x01(\v2\".weaviate.v1.SearchOperatorOptionsH\x00R\x0esearchOperator\x88\x01\x01\x129\n" +
+	"\tfuzziness\x18\x04 \x01(\v2\x16.weaviate.v1.FuzzinessH\x01R\tfuzziness\x88\x01\x01B\x12\n" +
+	"\x10_search_operatorB\f\n" +
+	"\n" +
+	"_fuzziness*\xee\x01\n" +
 	"\x11CombinationMethod\x12\"\n" +
 	"\x1eCOMBINATION_METHOD_UNSPECIFIED\x10\x00\x12\x1f\n" +
 	"\x1bCOMBINATION_METHOD_TYPE_SUM\x10\x01\x12\x1f\n" +
@@ -1630,7 +1731,7 @@ func file_v1_base_search_proto_rawDescGZIP() []byte {
 }
 
 var file_v1_base_search_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_v1_base_search_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_v1_base_search_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_v1_base_search_proto_goTypes = []any{
 	(CombinationMethod)(0),              // 0: weaviate.v1.CombinationMethod
 	(SearchOperatorOptions_Operator)(0), // 1: weaviate.v1.SearchOperatorOptions.Operator
@@ -1639,52 +1740,56 @@ var file_v1_base_search_proto_goTypes = []any{
 	(*Targets)(nil),                     // 4: weaviate.v1.Targets
 	(*VectorForTarget)(nil),             // 5: weaviate.v1.VectorForTarget
 	(*SearchOperatorOptions)(nil),       // 6: weaviate.v1.SearchOperatorOptions
-	(*Hybrid)(nil),                      // 7: weaviate.v1.Hybrid
-	(*NearVector)(nil),                  // 8: weaviate.v1.NearVector
-	(*NearObject)(nil),                  // 9: weaviate.v1.NearObject
-	(*NearTextSearch)(nil),              // 10: weaviate.v1.NearTextSearch
-	(*NearImageSearch)(nil),             // 11: weaviate.v1.NearImageSearch
-	(*NearAudioSearch)(nil),             // 12: weaviate.v1.NearAudioSearch
-	(*NearVideoSearch)(nil),             // 13: weaviate.v1.NearVideoSearch
-	(*NearDepthSearch)(nil),             // 14: weaviate.v1.NearDepthSearch
-	(*NearThermalSearch)(nil),           // 15: weaviate.v1.NearThermalSearch
-	(*NearIMUSearch)(nil),               // 16: weaviate.v1.NearIMUSearch
-	(*BM25)(nil),                        // 17: weaviate.v1.BM25
-	nil,                                 // 18: weaviate.v1.NearVector.VectorPerTargetEntry
-	(*NearTextSearch_Move)(nil),         // 19: weaviate.v1.NearTextSearch.Move
-	(*Vectors)(nil),                     // 20: weaviate.v1.Vectors
+	(*Fuzziness)(nil),                   // 7: weaviate.v1.Fuzziness
+	(*Hybrid)(nil),                      // 8: weaviate.v1.Hybrid
+	(*NearVector)(nil),                  // 9: weaviate.v1.NearVector
+	(*NearObject)(nil),                  // 10: weaviate.v1.NearObject
+	(*NearTextSearch)(nil),              // 11: weaviate.v1.NearTextSearch
+	(*NearImageSearch)(nil),             // 12: weaviate.v1.NearImageSearch
+	(*NearAudioSearch)(nil),             // 13: weaviate.v1.NearAudioSearch
+	(*NearVideoSearch)(nil),             // 14: weaviate.v1.NearVideoSearch
+	(*NearDepthSearch)(nil),             // 15: weaviate.v1.NearDepthSearch
+	(*NearThermalSearch)(nil),           // 16: weaviate.v1.NearThermalSearch
+	(*NearIMUSearch)(nil),               // 17: weaviate.v1.NearIMUSearch
+	(*BM25)(nil),                        // 18: weaviate.v1.BM25
+	nil,                                 // 19: weaviate.v1.NearVector.VectorPerTargetEntry
+	(*NearTextSearch_Move)(nil),         // 20: weaviate.v1.NearTextSearch.Move
+	(*Vectors)(nil),                     // 21: weaviate.v1.Vectors
 }
 var file_v1_base_search_proto_depIdxs = []int32{
 	0,  // 0: weaviate.v1.Targets.combination:type_name -> weaviate.v1.CombinationMethod
 	3,  // 1: weaviate.v1.Targets.weights_for_targets:type_name -> weaviate.v1.WeightsForTarget
-	20, // 2: weaviate.v1.VectorForTarget.vectors:type_name -> weaviate.v1.Vectors
+	21, // 2: weaviate.v1.VectorForTarget.vectors:type_name -> weaviate.v1.Vectors
 	1,  // 3: weaviate.v1.SearchOperatorOptions.operator:type_name -> weaviate.v1.SearchOperatorOptions.Operator
 	2,  // 4: weaviate.v1.Hybrid.fusion_type:type_name -> weaviate.v1.Hybrid.FusionType
-	10, // 5: weaviate.v1.Hybrid.near_text:type_name -> weaviate.v1.NearTextSearch
-	8,  // 6: weaviate.v1.Hybrid.near_vector:type_name -> weaviate.v1.NearVector
+	11, // 5: weaviate.v1.Hybrid.near_text:type_name -> weaviate.v1.NearTextSearch
+	9,  // 6: weaviate.v1.Hybrid.near_vector:type_name -> weaviate.v1.NearVector
 	4,  // 7: weaviate.v1.Hybrid.targets:type_name -> weaviate.v1.Targets
 	6,  // 8: weaviate.v1.Hybrid.bm25_search_operator:type_name -> weaviate.v1.SearchOperatorOptions
-	20, // 9: weaviate.v1.Hybrid.vectors:type_name -> weaviate.v1.Vectors
-	4,  // 10: weaviate.v1.NearVector.targets:type_name -> weaviate.v1.Targets
-	18, // 11: weaviate.v1.NearVector.vector_per_target:type_name -> weaviate.v1.NearVector.VectorPerTargetEntry
-	5,  // 12: weaviate.v1.NearVector.vector_for_targets:type_name -> weaviate.v1.VectorForTarget
-	20, // 13: weaviate.v1.NearVector.vectors:type_name -> weaviate.v1.Vectors
-	4,  // 14: weaviate.v1.NearObject.targets:type_name -> weaviate.v1.Targets
-	19, // 15: weaviate.v1.NearTextSearch.move_to:type_name -> weaviate.v1.NearTextSearch.Move
-	19, // 16: weaviate.v1.NearTextSearch.move_away:type_name -> weaviate.v1.NearTextSearch.Move
-	4,  // 17: weaviate.v1.NearTextSearch.targets:type_name -> weaviate.v1.Targets
-	4,  // 18: weaviate.v1.NearImageSearch.targets:type_name -> weaviate.v1.Targets
-	4,  // 19: weaviate.v1.NearAudioSearch.targets:type_name -> weaviate.v1.Targets
-	4,  // 20: weaviate.v1.NearVideoSearch.targets:type_name -> weaviate.v1.Targets
-	4,  // 21: weaviate.v1.NearDepthSearch.targets:type_name -> weaviate.v1.Targets
-	4,  // 22: weaviate.v1.NearThermalSearch.targets:type_name -> weaviate.v1.Targets
-	4,  // 23: weaviate.v1.NearIMUSearch.targets:type_name -> weaviate.v1.Targets
-	6,  // 24: weaviate.v1.BM25.search_operator:type_name -> weaviate.v1.SearchOperatorOptions
-	25, // [25:25] is the sub-list for method output_type
-	25, // [25:25] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	7,  // 9: weaviate.v1.Hybrid.bm25_fuzziness:type_name -> weaviate.v1.Fuzziness
+	21, // 10: weaviate.v1.Hybrid.sparse_vector:type_name -> weaviate.v1.Vectors
+	21, // 11: weaviate.v1.Hybrid.vectors:type_name -> weaviate.v1.Vectors
+	4,  // 12: weaviate.v1.NearVector.targets:type_name -> weaviate.v1.Targets
+	19, // 13: weaviate.v1.NearVector.vector_per_target:type_name -> weaviate.v1.NearVector.VectorPerTargetEntry
+	5,  // 14: weaviate.v1.NearVector.vector_for_targets:type_name -> weaviate.v1.VectorForTarget
+	21, // 15: weaviate.v1.NearVector.vectors:type_name -> weaviate.v1.Vectors
+	4,  // 16: weaviate.v1.NearObject.targets:type_name -> weaviate.v1.Targets
+	20, // 17: weaviate.v1.NearTextSearch.move_to:type_name -> weaviate.v1.NearTextSearch.Move
+	20, // 18: weaviate.v1.NearTextSearch.move_away:type_name -> weaviate.v1.NearTextSearch.Move
+	4,  // 19: weaviate.v1.NearTextSearch.targets:type_name -> weaviate.v1.Targets
+	4,  // 20: weaviate.v1.NearImageSearch.targets:type_name -> weaviate.v1.Targets
+	4,  // 21: weaviate.v1.NearAudioSearch.targets:type_name -> weaviate.v1.Targets
+	4,  // 22: weaviate.v1.NearVideoSearch.targets:type_name -> weaviate.v1.Targets
+	4,  // 23: weaviate.v1.NearDepthSearch.targets:type_name -> weaviate.v1.Targets
+	4,  // 24: weaviate.v1.NearThermalSearch.targets:type_name -> weaviate.v1.Targets
+	4,  // 25: weaviate.v1.NearIMUSearch.targets:type_name -> weaviate.v1.Targets
+	6,  // 26: weaviate.v1.BM25.search_operator:type_name -> weaviate.v1.SearchOperatorOptions
+	7,  // 27: weaviate.v1.BM25.fuzziness:type_name -> weaviate.v1.Fuzziness
+	28, // [28:28] is the sub-list for method output_type
+	28, // [28:28] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_v1_base_search_proto_init() }
@@ -1694,10 +1799,10 @@ func file_v1_base_search_proto_init() {
 	}
 	file_v1_base_proto_init()
 	file_v1_base_search_proto_msgTypes[3].OneofWrappers = []any{}
-	file_v1_base_search_proto_msgTypes[4].OneofWrappers = []any{
+	file_v1_base_search_proto_msgTypes[4].OneofWrappers = []any{}
+	file_v1_base_search_proto_msgTypes[5].OneofWrappers = []any{
 		(*Hybrid_VectorDistance)(nil),
 	}
-	file_v1_base_search_proto_msgTypes[5].OneofWrappers = []any{}
 	file_v1_base_search_proto_msgTypes[6].OneofWrappers = []any{}
 	file_v1_base_search_proto_msgTypes[7].OneofWrappers = []any{}
 	file_v1_base_search_proto_msgTypes[8].OneofWrappers = []any{}
@@ -1707,13 +1812,14 @@ func file_v1_base_search_proto_init() {
 	file_v1_base_search_proto_msgTypes[12].OneofWrappers = []any{}
 	file_v1_base_search_proto_msgTypes[13].OneofWrappers = []any{}
 	file_v1_base_search_proto_msgTypes[14].OneofWrappers = []any{}
+	file_v1_base_search_proto_msgTypes[15].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_base_search_proto_rawDesc), len(file_v1_base_search_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   17,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
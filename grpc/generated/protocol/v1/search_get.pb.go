@@ -25,10 +25,22 @@ type SearchRequest struct {
 	// parameters
 	Tenant           string            `protobuf:"bytes,10,opt,name=tenant,proto3" json:"tenant,omitempty"`
 	ConsistencyLevel *ConsistencyLevel `protobuf:"varint,11,opt,name=consistency_level,json=consistencyLevel,proto3,enum=weaviate.v1.ConsistencyLevel,oneof" json:"consistency_level,omitempty"`
+	// overrides the configured aggregation of multi vector distances, one of
+	// maxSim, maxSimNormalized, avgPool or topKMean
+	MultivectorAggregation *string `protobuf:"bytes,12,opt,name=multivector_aggregation,json=multivectorAggregation,proto3,oneof" json:"multivector_aggregation,omitempty"`
+	// id of the point-in-time snapshot the shards are read from, requests of
+	// the same user with the same id, e.g. paginated with after, read the same
+	// objects. The snapshots don't include the vector indexes, vector and
+	// hybrid searches can't read from a snapshot. A snapshot is released after
+	// 5 minutes without reads and expires after 30 minutes, a user can hold 4
+	// snapshots per shard.
+	Snapshot *string `protobuf:"bytes,13,opt,name=snapshot,proto3,oneof" json:"snapshot,omitempty"`
 	// what is returned
 	Properties *PropertiesRequest `protobuf:"bytes,20,opt,name=properties,proto3,oneof" json:"properties,omitempty"`
 	Metadata   *MetadataRequest   `protobuf:"bytes,21,opt,name=metadata,proto3,oneof" json:"metadata,omitempty"`
 	GroupBy    *GroupBy           `protobuf:"bytes,22,opt,name=group_by,json=groupBy,proto3,oneof" json:"group_by,omitempty"`
+	// value counts of all results of a vector, bm25 or hybrid search independent of limit and offset, or of all objects matching the filters otherwise
+	Facets []*Facet `protobuf:"bytes,23,rep,name=facets,proto3" json:"facets,omitempty"`
 	// affects order and length of results. 0/empty (default value) means disabled
 	Limit   uint32 `protobuf:"varint,30,opt,name=limit,proto3" json:"limit,omitempty"`
 	Offset  uint32 `protobuf:"varint,31,opt,name=offset,proto3" json:"offset,omitempty"`
@@ -111,6 +123,20 @@ func (x *SearchRequest) GetConsistencyLevel() ConsistencyLevel {
 	return ConsistencyLevel_CONSISTENCY_LEVEL_UNSPECIFIED
 }
 
+func (x *SearchRequest) GetMultivectorAggregation() string {
+	if x != nil && x.MultivectorAggregation != nil {
+		return *x.MultivectorAggregation
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetSnapshot() string {
+	if x != nil && x.Snapshot != nil {
+		return *x.Snapshot
+	}
+	return ""
+}
+
 func (x *SearchRequest) GetProperties() *PropertiesRequest {
 	if x != nil {
 		return x.Properties
@@ -132,6 +158,13 @@ func (x *SearchRequest) GetGroupBy() *GroupBy {
 	return nil
 }
 
+func (x *SearchRequest) GetFacets() []*Facet {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
 func (x *SearchRequest) GetLimit() uint32 {
 	if x != nil {
 		return x.Limit
@@ -351,6 +384,121 @@ func (x *GroupBy) GetObjectsPerGroup() int32 {
 	return 0
 }
 
+type Facet struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Property string                 `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
+	// the number of most frequent values to return, defaults to 10
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// count the values of an int or number property per range instead
+	Ranges        []*FacetRange `protobuf:"bytes,3,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Facet) Reset() {
+	*x = Facet{}
+	mi := &file_v1_search_get_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Facet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Facet) ProtoMessage() {}
+
+func (x *Facet) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_search_get_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Facet.ProtoReflect.Descriptor instead.
+func (*Facet) Descriptor() ([]byte, []int) {
+	return file_v1_search_get_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Facet) GetProperty() string {
+	if x != nil {
+		return x.Property
+	}
+	return ""
+}
+
+func (x *Facet) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Facet) GetRanges() []*FacetRange {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
+}
+
+// a range includes from and excludes to, an unset bound leaves it open
+type FacetRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *float64               `protobuf:"fixed64,1,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To            *float64               `protobuf:"fixed64,2,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetRange) Reset() {
+	*x = FacetRange{}
+	mi := &file_v1_search_get_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetRange) ProtoMessage() {}
+
+func (x *FacetRange) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_search_get_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetRange.ProtoReflect.Descriptor instead.
+func (*FacetRange) Descriptor() ([]byte, []int) {
+	return file_v1_search_get_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FacetRange) GetFrom() float64 {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return 0
+}
+
+func (x *FacetRange) GetTo() float64 {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return 0
+}
+
 type SortBy struct {
 	state     protoimpl.MessageState `protogen:"open.v1"`
 	Ascending bool                   `protobuf:"varint,1,opt,name=ascending,proto3" json:"ascending,omitempty"`
@@ -365,7 +513,7 @@ type SortBy struct {
 
 func (x *SortBy) Reset() {
 	*x = SortBy{}
-	mi := &file_v1_search_get_proto_msgTypes[2]
+	mi := &file_v1_search_get_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -377,7 +525,7 @@ func (x *SortBy) String() string {
 func (*SortBy) ProtoMessage() {}
 
 func (x *SortBy) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_search_get_proto_msgTypes[2]
+	mi := &file_v1_search_get_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -390,7 +538,7 @@ func (x *SortBy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SortBy.ProtoReflect.Descriptor instead.
 func (*SortBy) Descriptor() ([]byte, []int) {
-	return file_v1_search_get_proto_rawDescGZIP(), []int{2}
+	return file_v1_search_get_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SortBy) GetAscending() bool {
@@ -419,13 +567,14 @@ type MetadataRequest struct {
 	ExplainScore       bool                   `protobuf:"varint,8,opt,name=explain_score,json=explainScore,proto3" json:"explain_score,omitempty"`
 	IsConsistent       bool                   `protobuf:"varint,9,opt,name=is_consistent,json=isConsistent,proto3" json:"is_consistent,omitempty"`
 	Vectors            []string               `protobuf:"bytes,10,rep,name=vectors,proto3" json:"vectors,omitempty"`
+	Highlights         *HighlightsRequest     `protobuf:"bytes,11,opt,name=highlights,proto3" json:"highlights,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
 
 func (x *MetadataRequest) Reset() {
 	*x = MetadataRequest{}
-	mi := &file_v1_search_get_proto_msgTypes[3]
+	mi := &file_v1_search_get_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -437,7 +586,7 @@ func (x *MetadataRequest) String() string {
 func (*MetadataRequest) ProtoMessage() {}
 
 func (x *MetadataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_search_get_proto_msgTypes[3]
+	mi := &file_v1_search_get_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -450,7 +599,7 @@ func (x *MetadataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MetadataRequest.ProtoReflect.Descriptor instead.
 func (*MetadataRequest) Descriptor() ([]byte, []int) {
-	return file_v1_search_get_proto_rawDescGZIP(), []int{3}
+	return file_v1_search_get_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *MetadataRequest) GetUuid() bool {
@@ -523,6 +672,67 @@ func (x *MetadataRequest) GetVectors() []string {
 	return nil
 }
 
+func (x *MetadataRequest) GetHighlights() *HighlightsRequest {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+type HighlightsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the text properties to highlight, defaults to the searched properties
+	Properties []string `protobuf:"bytes,1,rep,name=properties,proto3" json:"properties,omitempty"`
+	// the number of characters of a fragment, defaults to 100
+	FragmentSize  int32 `protobuf:"varint,2,opt,name=fragment_size,json=fragmentSize,proto3" json:"fragment_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HighlightsRequest) Reset() {
+	*x = HighlightsRequest{}
+	mi := &file_v1_search_get_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HighlightsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighlightsRequest) ProtoMessage() {}
+
+func (x *HighlightsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_search_get_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighlightsRequest.ProtoReflect.Descriptor instead.
+func (*HighlightsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_search_get_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *HighlightsRequest) GetProperties() []string {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *HighlightsRequest) GetFragmentSize() int32 {
+	if x != nil {
+		return x.FragmentSize
+	}
+	return 0
+}
+
 type PropertiesRequest struct {
 	state                     protoimpl.MessageState     `protogen:"open.v1"`
 	NonRefProperties          []string                   `protobuf:"bytes,1,rep,name=non_ref_properties,json=nonRefProperties,proto3" json:"non_ref_properties,omitempty"`
@@ -535,7 +745,7 @@ type PropertiesRequest struct {
 
 func (x *PropertiesRequest) Reset() {
 	*x = PropertiesRequest{}
-	mi := &file_v1_search_get_proto_msgTypes[4]
+	mi := &file_v1_search_get_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -547,7 +757,7 @@ func (x *PropertiesRequest) String() string {
 func (*PropertiesRequest) ProtoMessage() {}
 
 func (x *PropertiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_search_get_proto_msgTypes[4]
+	mi := &file_v1_search_get_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -560,7 +770,7 @@ func (x *PropertiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PropertiesRequest.ProtoReflect.Descriptor instead.
 func (*PropertiesRequest) Descriptor() ([]byte, []int) {
-	return file_v1_search_get_proto_rawDescGZIP(), []int{4}
+	return file_v1_search_get_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PropertiesRequest) GetNonRefProperties() []string {
@@ -602,7 +812,7 @@ type ObjectPropertiesRequest struct {
 
 func (x *ObjectPropertiesRequest) Reset() {
 	*x = ObjectPropertiesRequest{}
-	mi := &file_v1_search_get_proto_msgTypes[5]
+	mi := &file_v1_search_get_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -614,7 +824,7 @@ func (x *ObjectPropertiesRequest) String() string {
 func (*ObjectPropertiesRequest) ProtoMessage() {}
 
 func (x *ObjectPropertiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_search_get_proto_msgTypes[5]
+	mi := &file_v1_search_get_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -627,7 +837,7 @@ func (x *ObjectPropertiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ObjectPropertiesRequest.ProtoReflect.Descriptor instead.
 func (*ObjectPropertiesRequest) Descriptor() ([]byte, []int) {
-	return file_v1_search_get_proto_rawDescGZIP(), []int{5}
+	return file_v1_search_get_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ObjectPropertiesRequest) GetPropName() string {
@@ -663,7 +873,7 @@ type RefPropertiesRequest struct {
 
 func (x *RefPropertiesRequest) Reset() {
 	*x = RefPropertiesRequest{}
-	mi := &file_v1_search_get_proto_msgTypes[6]
+	mi := &file_v1_search_get_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -675,7 +885,7 @@ func (x *RefPropertiesRequest) String() string {
 func (*RefPropertiesRequest) ProtoMessage() {}
 
 func (x *RefPropertiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_search_get_proto_msgTypes[6]
+	mi := &file_v1_search_get_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -688,7 +898,7 @@ func (x *RefPropertiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefPropertiesRequest.ProtoReflect.Descriptor instead.
 func (*RefPropertiesRequest) Descriptor() ([]byte, []int) {
-	return file_v1_search_get_proto_rawDescGZIP(), []int{6}
+	return file_v1_search_get_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *RefPropertiesRequest) GetReferenceProperty() string {
@@ -729,7 +939,7 @@ type Rerank struct {
 
 func (x *Rerank) Reset() {
 	*x = Rerank{}
-	mi := &file_v1_search_get_proto_msgTypes[7]
+	mi := &file_v1_search_get_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -741,7 +951,7 @@ func (x *Rerank) String() string {
 func (*Rerank) ProtoMessage() {}
 
 func (x *Rerank) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_search_get_proto_msgTypes[7]
+	mi := &file_v1_search_get_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -754,7 +964,7 @@ func (x *Rerank) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Rerank.ProtoReflect.Descriptor instead.
 func (*Rerank) Descriptor() ([]byte, []int) {
-	return file_v1_search_get_proto_rawDescGZIP(), []int{7}
+	return file_v1_search_get_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Rerank) GetProperty() string {
@@ -779,13 +989,14 @@ type SearchReply struct {
 	GenerativeGroupedResult  *string           `protobuf:"bytes,3,opt,name=generative_grouped_result,json=generativeGroupedResult,proto3,oneof" json:"generative_grouped_result,omitempty"`
 	GroupByResults           []*GroupByResult  `protobuf:"bytes,4,rep,name=group_by_results,json=groupByResults,proto3" json:"group_by_results,omitempty"`
 	GenerativeGroupedResults *GenerativeResult `protobuf:"bytes,5,opt,name=generative_grouped_results,json=generativeGroupedResults,proto3,oneof" json:"generative_grouped_results,omitempty"`
+	Facets                   []*FacetResult    `protobuf:"bytes,6,rep,name=facets,proto3" json:"facets,omitempty"`
 	unknownFields            protoimpl.UnknownFields
 	sizeCache                protoimpl.SizeCache
 }
 
 func (x *SearchReply) Reset() {
 	*x = SearchReply{}
-	mi := &file_v1_search_get_proto_msgTypes[8]
+	mi := &file_v1_search_get_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -797,7 +1008,7 @@ func (x *SearchReply) String() string {
 func (*SearchReply) ProtoMessage() {}
 
 func (x *SearchReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_search_get_proto_msgTypes[8]
+	mi := &file_v1_search_get_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -810,7 +1021,7 @@ func (x *SearchReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchReply.ProtoReflect.Descriptor instead.
 func (*SearchReply) Descriptor() ([]byte, []int) {
-	return file_v1_search_get_proto_rawDescGZIP(), []int{8}
+	return file_v1_search_get_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SearchReply) GetTook() float32 {
@@ -849,6 +1060,73 @@ func (x *SearchReply) GetGenerativeGroupedResults() *GenerativeResult {
 	return nil
 }
 
+func (x *SearchReply) GetFacets() []*FacetResult {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+type FacetResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Property      string                 `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
+	Values        []*FacetResult_Value   `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	Ranges        []*FacetResult_Range   `protobuf:"bytes,3,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetResult) Reset() {
+	*x = FacetResult{}
+	mi := &file_v1_search_get_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetResult) ProtoMessage() {}
+
+func (x *FacetResult) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_search_get_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetResult.ProtoReflect.Descriptor instead.
+func (*FacetResult) Descriptor() ([]byte, []int) {
+	return file_v1_search_get_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FacetResult) GetProperty() string {
+	if x != nil {
+		return x.Property
+	}
+	return ""
+}
+
+func (x *FacetResult) GetValues() []*FacetResult_Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *FacetResult) GetRanges() []*FacetResult_Range {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
+}
+
 type RerankReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Score         float64                `protobuf:"fixed64,1,opt,name=score,proto3" json:"score,omitempty"`
@@ -858,7 +1136,7 @@ type RerankReply struct {
 
 func (x *RerankReply) Reset() {
 	*x = RerankReply{}
-	mi := &file_v1_search_get_proto_msgTypes[9]
+	mi := &file_v1_search_get_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -870,7 +1148,7 @@ func (x *RerankReply) String() string {
 func (*RerankReply) ProtoMessage() {}
 
 func (x *RerankReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_search_get_proto_msgTypes[9]
+	mi := &file_v1_search_get_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -883,7 +1161,7 @@ func (x *RerankReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RerankReply.ProtoReflect.Descriptor instead.
 func (*RerankReply) Descriptor() ([]byte, []int) {
-	return file_v1_search_get_proto_rawDescGZIP(), []int{9}
+	return file_v1_search_get_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *RerankReply) GetScore() float64 {
@@ -910,7 +1188,7 @@ type GroupByResult struct {
 
 func (x *GroupByResult) Reset() {
 	*x = GroupByResult{}
-	mi := &file_v1_search_get_proto_msgTypes[10]
+	mi := &file_v1_search_get_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -922,7 +1200,7 @@ func (x *GroupByResult) String() string {
 func (*GroupByResult) ProtoMessage() {}
 
 func (x *GroupByResult) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_search_get_proto_msgTypes[10]
+	mi := &file_v1_search_get_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -935,7 +1213,7 @@ func (x *GroupByResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupByResult.ProtoReflect.Descriptor instead.
 func (*GroupByResult) Descriptor() ([]byte, []int) {
-	return file_v1_search_get_proto_rawDescGZIP(), []int{10}
+	return file_v1_search_get_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GroupByResult) GetName() string {
@@ -1006,7 +1284,7 @@ type SearchResult struct {
 
 func (x *SearchResult) Reset() {
 	*x = SearchResult{}
-	mi := &file_v1_search_get_proto_msgTypes[11]
+	mi := &file_v1_search_get_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1018,7 +1296,7 @@ func (x *SearchResult) String() string {
 func (*SearchResult) ProtoMessage() {}
 
 func (x *SearchResult) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_search_get_proto_msgTypes[11]
+	mi := &file_v1_search_get_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1031,7 +1309,7 @@ func (x *SearchResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
 func (*SearchResult) Descriptor() ([]byte, []int) {
-	return file_v1_search_get_proto_rawDescGZIP(), []int{11}
+	return file_v1_search_get_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *SearchResult) GetProperties() *PropertiesResult {
@@ -1078,20 +1356,21 @@ type MetadataResult struct {
 	// Deprecated: Marked as deprecated in v1/search_get.proto.
 	Generative string `protobuf:"bytes,16,opt,name=generative,proto3" json:"generative,omitempty"`
 	// Deprecated: Marked as deprecated in v1/search_get.proto.
-	GenerativePresent   bool       `protobuf:"varint,17,opt,name=generative_present,json=generativePresent,proto3" json:"generative_present,omitempty"`
-	IsConsistentPresent bool       `protobuf:"varint,18,opt,name=is_consistent_present,json=isConsistentPresent,proto3" json:"is_consistent_present,omitempty"`
-	VectorBytes         []byte     `protobuf:"bytes,19,opt,name=vector_bytes,json=vectorBytes,proto3" json:"vector_bytes,omitempty"`
-	IdAsBytes           []byte     `protobuf:"bytes,20,opt,name=id_as_bytes,json=idAsBytes,proto3" json:"id_as_bytes,omitempty"`
-	RerankScore         float64    `protobuf:"fixed64,21,opt,name=rerank_score,json=rerankScore,proto3" json:"rerank_score,omitempty"`
-	RerankScorePresent  bool       `protobuf:"varint,22,opt,name=rerank_score_present,json=rerankScorePresent,proto3" json:"rerank_score_present,omitempty"`
-	Vectors             []*Vectors `protobuf:"bytes,23,rep,name=vectors,proto3" json:"vectors,omitempty"`
+	GenerativePresent   bool         `protobuf:"varint,17,opt,name=generative_present,json=generativePresent,proto3" json:"generative_present,omitempty"`
+	IsConsistentPresent bool         `protobuf:"varint,18,opt,name=is_consistent_present,json=isConsistentPresent,proto3" json:"is_consistent_present,omitempty"`
+	VectorBytes         []byte       `protobuf:"bytes,19,opt,name=vector_bytes,json=vectorBytes,proto3" json:"vector_bytes,omitempty"`
+	IdAsBytes           []byte       `protobuf:"bytes,20,opt,name=id_as_bytes,json=idAsBytes,proto3" json:"id_as_bytes,omitempty"`
+	RerankScore         float64      `protobuf:"fixed64,21,opt,name=rerank_score,json=rerankScore,proto3" json:"rerank_score,omitempty"`
+	RerankScorePresent  bool         `protobuf:"varint,22,opt,name=rerank_score_present,json=rerankScorePresent,proto3" json:"rerank_score_present,omitempty"`
+	Vectors             []*Vectors   `protobuf:"bytes,23,rep,name=vectors,proto3" json:"vectors,omitempty"`
+	Highlights          []*Highlight `protobuf:"bytes,24,rep,name=highlights,proto3" json:"highlights,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
 func (x *MetadataResult) Reset() {
 	*x = MetadataResult{}
-	mi := &file_v1_search_get_proto_msgTypes[12]
+	mi := &file_v1_search_get_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1103,7 +1382,7 @@ func (x *MetadataResult) String() string {
 func (*MetadataResult) ProtoMessage() {}
 
 func (x *MetadataResult) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_search_get_proto_msgTypes[12]
+	mi := &file_v1_search_get_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1116,7 +1395,7 @@ func (x *MetadataResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MetadataResult.ProtoReflect.Descriptor instead.
 func (*MetadataResult) Descriptor() ([]byte, []int) {
-	return file_v1_search_get_proto_rawDescGZIP(), []int{12}
+	return file_v1_search_get_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *MetadataResult) GetId() string {
@@ -1283,6 +1562,65 @@ func (x *MetadataResult) GetVectors() []*Vectors {
 	return nil
 }
 
+func (x *MetadataResult) GetHighlights() []*Highlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+type Highlight struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Property      string                 `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
+	Fragments     []string               `protobuf:"bytes,2,rep,name=fragments,proto3" json:"fragments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Highlight) Reset() {
+	*x = Highlight{}
+	mi := &file_v1_search_get_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Highlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Highlight) ProtoMessage() {}
+
+func (x *Highlight) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_search_get_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Highlight.ProtoReflect.Descriptor instead.
+func (*Highlight) Descriptor() ([]byte, []int) {
+	return file_v1_search_get_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Highlight) GetProperty() string {
+	if x != nil {
+		return x.Property
+	}
+	return ""
+}
+
+func (x *Highlight) GetFragments() []string {
+	if x != nil {
+		return x.Fragments
+	}
+	return nil
+}
+
 type PropertiesResult struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	RefProps          []*RefPropertiesResult `protobuf:"bytes,2,rep,name=ref_props,json=refProps,proto3" json:"ref_props,omitempty"`
@@ -1296,7 +1634,7 @@ type PropertiesResult struct {
 
 func (x *PropertiesResult) Reset() {
 	*x = PropertiesResult{}
-	mi := &file_v1_search_get_proto_msgTypes[13]
+	mi := &file_v1_search_get_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1308,7 +1646,7 @@ func (x *PropertiesResult) String() string {
 func (*PropertiesResult) ProtoMessage() {}
 
 func (x *PropertiesResult) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_search_get_proto_msgTypes[13]
+	mi := &file_v1_search_get_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1321,7 +1659,7 @@ func (x *PropertiesResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PropertiesResult.ProtoReflect.Descriptor instead.
 func (*PropertiesResult) Descriptor() ([]byte, []int) {
-	return file_v1_search_get_proto_rawDescGZIP(), []int{13}
+	return file_v1_search_get_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *PropertiesResult) GetRefProps() []*RefPropertiesResult {
@@ -1369,7 +1707,7 @@ type RefPropertiesResult struct {
 
 func (x *RefPropertiesResult) Reset() {
 	*x = RefPropertiesResult{}
-	mi := &file_v1_search_get_proto_msgTypes[14]
+	mi := &file_v1_search_get_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1381,7 +1719,7 @@ func (x *RefPropertiesResult) String() string {
 func (*RefPropertiesResult) ProtoMessage() {}
 
 func (x *RefPropertiesResult) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_search_get_proto_msgTypes[14]
+	mi := &file_v1_search_get_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1394,7 +1732,7 @@ func (x *RefPropertiesResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefPropertiesResult.ProtoReflect.Descriptor instead.
 func (*RefPropertiesResult) Descriptor() ([]byte, []int) {
-	return file_v1_search_get_proto_rawDescGZIP(), []int{14}
+	return file_v1_search_get_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *RefPropertiesResult) GetProperties() []*PropertiesResult {
@@ -1411,59 +1749,176 @@ func (x *RefPropertiesResult) GetPropName() string {
 	return ""
 }
 
+type FacetResult_Value struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetResult_Value) Reset() {
+	*x = FacetResult_Value{}
+	mi := &file_v1_search_get_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetResult_Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetResult_Value) ProtoMessage() {}
+
+func (x *FacetResult_Value) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_search_get_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetResult_Value.ProtoReflect.Descriptor instead.
+func (*FacetResult_Value) Descriptor() ([]byte, []int) {
+	return file_v1_search_get_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *FacetResult_Value) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FacetResult_Value) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type FacetResult_Range struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *float64               `protobuf:"fixed64,1,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To            *float64               `protobuf:"fixed64,2,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetResult_Range) Reset() {
+	*x = FacetResult_Range{}
+	mi := &file_v1_search_get_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetResult_Range) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetResult_Range) ProtoMessage() {}
+
+func (x *FacetResult_Range) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_search_get_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetResult_Range.ProtoReflect.Descriptor instead.
+func (*FacetResult_Range) Descriptor() ([]byte, []int) {
+	return file_v1_search_get_proto_rawDescGZIP(), []int{12, 1}
+}
+
+func (x *FacetResult_Range) GetFrom() float64 {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return 0
+}
+
+func (x *FacetResult_Range) GetTo() float64 {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return 0
+}
+
+func (x *FacetResult_Range) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 var File_v1_search_get_proto protoreflect.FileDescriptor
 
 const file_v1_search_get_proto_rawDesc = "" +
 	"\n" +
-	"\x13v1/search_get.proto\x12\vweaviate.v1\x1a\rv1/base.proto\x1a\x14v1/base_search.proto\x1a\x13v1/generative.proto\x1a\x13v1/properties.proto\"\xc7\r\n" +
+	"\x13v1/search_get.proto\x12\vweaviate.v1\x1a\rv1/base.proto\x1a\x14v1/base_search.proto\x1a\x13v1/generative.proto\x1a\x13v1/properties.proto\"\xfb\x0e\n" +
 	"\rSearchRequest\x12\x1e\n" +
 	"\n" +
 	"collection\x18\x01 \x01(\tR\n" +
 	"collection\x12\x16\n" +
 	"\x06tenant\x18\n" +
 	" \x01(\tR\x06tenant\x12O\n" +
-	"\x11consistency_level\x18\v \x01(\x0e2\x1d.weaviate.v1.ConsistencyLevelH\x00R\x10consistencyLevel\x88\x01\x01\x12C\n" +
+	"\x11consistency_level\x18\v \x01(\x0e2\x1d.weaviate.v1.ConsistencyLevelH\x00R\x10consistencyLevel\x88\x01\x01\x12<\n" +
+	"\x17multivector_aggregation\x18\f \x01(\tH\x01R\x16multivectorAggregation\x88\x01\x01\x12\x1f\n" +
+	"\bsnapshot\x18\r \x01(\tH\x02R\bsnapshot\x88\x01\x01\x12C\n" +
 	"\n" +
-	"properties\x18\x14 \x01(\v2\x1e.weaviate.v1.PropertiesRequestH\x01R\n" +
+	"properties\x18\x14 \x01(\v2\x1e.weaviate.v1.PropertiesRequestH\x03R\n" +
 	"properties\x88\x01\x01\x12=\n" +
-	"\bmetadata\x18\x15 \x01(\v2\x1c.weaviate.v1.MetadataRequestH\x02R\bmetadata\x88\x01\x01\x124\n" +
-	"\bgroup_by\x18\x16 \x01(\v2\x14.weaviate.v1.GroupByH\x03R\agroupBy\x88\x01\x01\x12\x14\n" +
+	"\bmetadata\x18\x15 \x01(\v2\x1c.weaviate.v1.MetadataRequestH\x04R\bmetadata\x88\x01\x01\x124\n" +
+	"\bgroup_by\x18\x16 \x01(\v2\x14.weaviate.v1.GroupByH\x05R\agroupBy\x88\x01\x01\x12*\n" +
+	"\x06facets\x18\x17 \x03(\v2\x12.weaviate.v1.FacetR\x06facets\x12\x14\n" +
 	"\x05limit\x18\x1e \x01(\rR\x05limit\x12\x16\n" +
 	"\x06offset\x18\x1f \x01(\rR\x06offset\x12\x18\n" +
 	"\aautocut\x18  \x01(\rR\aautocut\x12\x14\n" +
 	"\x05after\x18! \x01(\tR\x05after\x12,\n" +
 	"\asort_by\x18\" \x03(\v2\x13.weaviate.v1.SortByR\x06sortBy\x123\n" +
-	"\afilters\x18( \x01(\v2\x14.weaviate.v1.FiltersH\x04R\afilters\x88\x01\x01\x12=\n" +
-	"\rhybrid_search\x18) \x01(\v2\x13.weaviate.v1.HybridH\x05R\fhybridSearch\x88\x01\x01\x127\n" +
-	"\vbm25_search\x18* \x01(\v2\x11.weaviate.v1.BM25H\x06R\n" +
+	"\afilters\x18( \x01(\v2\x14.weaviate.v1.FiltersH\x06R\afilters\x88\x01\x01\x12=\n" +
+	"\rhybrid_search\x18) \x01(\v2\x13.weaviate.v1.HybridH\aR\fhybridSearch\x88\x01\x01\x127\n" +
+	"\vbm25_search\x18* \x01(\v2\x11.weaviate.v1.BM25H\bR\n" +
 	"bm25Search\x88\x01\x01\x12=\n" +
-	"\vnear_vector\x18+ \x01(\v2\x17.weaviate.v1.NearVectorH\aR\n" +
+	"\vnear_vector\x18+ \x01(\v2\x17.weaviate.v1.NearVectorH\tR\n" +
 	"nearVector\x88\x01\x01\x12=\n" +
-	"\vnear_object\x18, \x01(\v2\x17.weaviate.v1.NearObjectH\bR\n" +
+	"\vnear_object\x18, \x01(\v2\x17.weaviate.v1.NearObjectH\n" +
+	"R\n" +
 	"nearObject\x88\x01\x01\x12=\n" +
-	"\tnear_text\x18- \x01(\v2\x1b.weaviate.v1.NearTextSearchH\tR\bnearText\x88\x01\x01\x12@\n" +
+	"\tnear_text\x18- \x01(\v2\x1b.weaviate.v1.NearTextSearchH\vR\bnearText\x88\x01\x01\x12@\n" +
 	"\n" +
-	"near_image\x18. \x01(\v2\x1c.weaviate.v1.NearImageSearchH\n" +
-	"R\tnearImage\x88\x01\x01\x12@\n" +
+	"near_image\x18. \x01(\v2\x1c.weaviate.v1.NearImageSearchH\fR\tnearImage\x88\x01\x01\x12@\n" +
 	"\n" +
-	"near_audio\x18/ \x01(\v2\x1c.weaviate.v1.NearAudioSearchH\vR\tnearAudio\x88\x01\x01\x12@\n" +
+	"near_audio\x18/ \x01(\v2\x1c.weaviate.v1.NearAudioSearchH\rR\tnearAudio\x88\x01\x01\x12@\n" +
 	"\n" +
-	"near_video\x180 \x01(\v2\x1c.weaviate.v1.NearVideoSearchH\fR\tnearVideo\x88\x01\x01\x12@\n" +
+	"near_video\x180 \x01(\v2\x1c.weaviate.v1.NearVideoSearchH\x0eR\tnearVideo\x88\x01\x01\x12@\n" +
 	"\n" +
-	"near_depth\x181 \x01(\v2\x1c.weaviate.v1.NearDepthSearchH\rR\tnearDepth\x88\x01\x01\x12F\n" +
-	"\fnear_thermal\x182 \x01(\v2\x1e.weaviate.v1.NearThermalSearchH\x0eR\vnearThermal\x88\x01\x01\x12:\n" +
-	"\bnear_imu\x183 \x01(\v2\x1a.weaviate.v1.NearIMUSearchH\x0fR\anearImu\x88\x01\x01\x12B\n" +
+	"near_depth\x181 \x01(\v2\x1c.weaviate.v1.NearDepthSearchH\x0fR\tnearDepth\x88\x01\x01\x12F\n" +
+	"\fnear_thermal\x182 \x01(\v2\x1e.weaviate.v1.NearThermalSearchH\x10R\vnearThermal\x88\x01\x01\x12:\n" +
+	"\bnear_imu\x183 \x01(\v2\x1a.weaviate.v1.NearIMUSearchH\x11R\anearImu\x88\x01\x01\x12B\n" +
 	"\n" +
-	"generative\x18< \x01(\v2\x1d.weaviate.v1.GenerativeSearchH\x10R\n" +
+	"generative\x18< \x01(\v2\x1d.weaviate.v1.GenerativeSearchH\x12R\n" +
 	"generative\x88\x01\x01\x120\n" +
-	"\x06rerank\x18= \x01(\v2\x13.weaviate.v1.RerankH\x11R\x06rerank\x88\x01\x01\x12$\n" +
+	"\x06rerank\x18= \x01(\v2\x13.weaviate.v1.RerankH\x13R\x06rerank\x88\x01\x01\x12$\n" +
 	"\fuses_123_api\x18d \x01(\bB\x02\x18\x01R\n" +
 	"uses123Api\x12$\n" +
 	"\fuses_125_api\x18e \x01(\bB\x02\x18\x01R\n" +
 	"uses125Api\x12 \n" +
 	"\fuses_127_api\x18f \x01(\bR\n" +
 	"uses127ApiB\x14\n" +
-	"\x12_consistency_levelB\r\n" +
+	"\x12_consistency_levelB\x1a\n" +
+	"\x18_multivector_aggregationB\v\n" +
+	"\t_snapshotB\r\n" +
 	"\v_propertiesB\v\n" +
 	"\t_metadataB\v\n" +
 	"\t_group_byB\n" +
@@ -1486,10 +1941,20 @@ const file_v1_search_get_proto_rawDesc = "" +
 	"\aGroupBy\x12\x12\n" +
 	"\x04path\x18\x01 \x03(\tR\x04path\x12(\n" +
 	"\x10number_of_groups\x18\x02 \x01(\x05R\x0enumberOfGroups\x12*\n" +
-	"\x11objects_per_group\x18\x03 \x01(\x05R\x0fobjectsPerGroup\":\n" +
+	"\x11objects_per_group\x18\x03 \x01(\x05R\x0fobjectsPerGroup\"j\n" +
+	"\x05Facet\x12\x1a\n" +
+	"\bproperty\x18\x01 \x01(\tR\bproperty\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12/\n" +
+	"\x06ranges\x18\x03 \x03(\v2\x17.weaviate.v1.FacetRangeR\x06ranges\"J\n" +
+	"\n" +
+	"FacetRange\x12\x17\n" +
+	"\x04from\x18\x01 \x01(\x01H\x00R\x04from\x88\x01\x01\x12\x13\n" +
+	"\x02to\x18\x02 \x01(\x01H\x01R\x02to\x88\x01\x01B\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_to\":\n" +
 	"\x06SortBy\x12\x1c\n" +
 	"\tascending\x18\x01 \x01(\bR\tascending\x12\x12\n" +
-	"\x04path\x18\x02 \x03(\tR\x04path\"\xd2\x02\n" +
+	"\x04path\x18\x02 \x03(\tR\x04path\"\x92\x03\n" +
 	"\x0fMetadataRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\bR\x04uuid\x12\x16\n" +
 	"\x06vector\x18\x02 \x01(\bR\x06vector\x12,\n" +
@@ -1501,7 +1966,15 @@ const file_v1_search_get_proto_rawDesc = "" +
 	"\rexplain_score\x18\b \x01(\bR\fexplainScore\x12#\n" +
 	"\ris_consistent\x18\t \x01(\bR\fisConsistent\x12\x18\n" +
 	"\avectors\x18\n" +
-	" \x03(\tR\avectors\"\x9f\x02\n" +
+	" \x03(\tR\avectors\x12>\n" +
+	"\n" +
+	"highlights\x18\v \x01(\v2\x1e.weaviate.v1.HighlightsRequestR\n" +
+	"highlights\"X\n" +
+	"\x11HighlightsRequest\x12\x1e\n" +
+	"\n" +
+	"properties\x18\x01 \x03(\tR\n" +
+	"properties\x12#\n" +
+	"\rfragment_size\x18\x02 \x01(\x05R\ffragmentSize\"\x9f\x02\n" +
 	"\x11PropertiesRequest\x12,\n" +
 	"\x12non_ref_properties\x18\x01 \x03(\tR\x10nonRefProperties\x12H\n" +
 	"\x0eref_properties\x18\x02 \x03(\v2!.weaviate.v1.RefPropertiesRequestR\rrefProperties\x12Q\n" +
@@ -1521,15 +1994,29 @@ const file_v1_search_get_proto_rawDesc = "" +
 	"\x06Rerank\x12\x1a\n" +
 	"\bproperty\x18\x01 \x01(\tR\bproperty\x12\x19\n" +
 	"\x05query\x18\x02 \x01(\tH\x00R\x05query\x88\x01\x01B\b\n" +
-	"\x06_query\"\x80\x03\n" +
+	"\x06_query\"\xb2\x03\n" +
 	"\vSearchReply\x12\x12\n" +
 	"\x04took\x18\x01 \x01(\x02R\x04took\x123\n" +
 	"\aresults\x18\x02 \x03(\v2\x19.weaviate.v1.SearchResultR\aresults\x12C\n" +
 	"\x19generative_grouped_result\x18\x03 \x01(\tB\x02\x18\x01H\x00R\x17generativeGroupedResult\x88\x01\x01\x12D\n" +
 	"\x10group_by_results\x18\x04 \x03(\v2\x1a.weaviate.v1.GroupByResultR\x0egroupByResults\x12`\n" +
-	"\x1agenerative_grouped_results\x18\x05 \x01(\v2\x1d.weaviate.v1.GenerativeResultH\x01R\x18generativeGroupedResults\x88\x01\x01B\x1c\n" +
+	"\x1agenerative_grouped_results\x18\x05 \x01(\v2\x1d.weaviate.v1.GenerativeResultH\x01R\x18generativeGroupedResults\x88\x01\x01\x120\n" +
+	"\x06facets\x18\x06 \x03(\v2\x18.weaviate.v1.FacetResultR\x06facetsB\x1c\n" +
 	"\x1a_generative_grouped_resultB\x1d\n" +
-	"\x1b_generative_grouped_results\"#\n" +
+	"\x1b_generative_grouped_results\"\xab\x02\n" +
+	"\vFacetResult\x12\x1a\n" +
+	"\bproperty\x18\x01 \x01(\tR\bproperty\x126\n" +
+	"\x06values\x18\x02 \x03(\v2\x1e.weaviate.v1.FacetResult.ValueR\x06values\x126\n" +
+	"\x06ranges\x18\x03 \x03(\v2\x1e.weaviate.v1.FacetResult.RangeR\x06ranges\x1a3\n" +
+	"\x05Value\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x1a[\n" +
+	"\x05Range\x12\x17\n" +
+	"\x04from\x18\x01 \x01(\x01H\x00R\x04from\x88\x01\x01\x12\x13\n" +
+	"\x02to\x18\x02 \x01(\x01H\x01R\x02to\x88\x01\x01\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05countB\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_to\"#\n" +
 	"\vRerankReply\x12\x14\n" +
 	"\x05score\x18\x01 \x01(\x01R\x05score\"\xc9\x03\n" +
 	"\rGroupByResult\x12\x12\n" +
@@ -1554,7 +2041,7 @@ const file_v1_search_get_proto_rawDesc = "" +
 	"\n" +
 	"generative\x18\x03 \x01(\v2\x1d.weaviate.v1.GenerativeResultH\x00R\n" +
 	"generative\x88\x01\x01B\r\n" +
-	"\v_generative\"\xd1\a\n" +
+	"\v_generative\"\x89\b\n" +
 	"\x0eMetadataResult\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
 	"\x06vector\x18\x02 \x03(\x02B\x02\x18\x01R\x06vector\x12,\n" +
@@ -1581,8 +2068,14 @@ const file_v1_search_get_proto_rawDesc = "" +
 	"\vid_as_bytes\x18\x14 \x01(\fR\tidAsBytes\x12!\n" +
 	"\frerank_score\x18\x15 \x01(\x01R\vrerankScore\x120\n" +
 	"\x14rerank_score_present\x18\x16 \x01(\bR\x12rerankScorePresent\x12.\n" +
-	"\avectors\x18\x17 \x03(\v2\x14.weaviate.v1.VectorsR\avectorsB\x10\n" +
-	"\x0e_is_consistent\"\xce\x02\n" +
+	"\avectors\x18\x17 \x03(\v2\x14.weaviate.v1.VectorsR\avectors\x126\n" +
+	"\n" +
+	"highlights\x18\x18 \x03(\v2\x16.weaviate.v1.HighlightR\n" +
+	"highlightsB\x10\n" +
+	"\x0e_is_consistent\"E\n" +
+	"\tHighlight\x12\x1a\n" +
+	"\bproperty\x18\x01 \x01(\tR\bproperty\x12\x1c\n" +
+	"\tfragments\x18\x02 \x03(\tR\tfragments\"\xce\x02\n" +
 	"\x10PropertiesResult\x12=\n" +
 	"\tref_props\x18\x02 \x03(\v2 .weaviate.v1.RefPropertiesResultR\brefProps\x12+\n" +
 	"\x11target_collection\x18\x03 \x01(\tR\x10targetCollection\x127\n" +
@@ -1610,87 +2103,101 @@ func file_v1_search_get_proto_rawDescGZIP() []byte {
 	return file_v1_search_get_proto_rawDescData
 }
 
-var file_v1_search_get_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_v1_search_get_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_v1_search_get_proto_goTypes = []any{
 	(*SearchRequest)(nil),           // 0: weaviate.v1.SearchRequest
 	(*GroupBy)(nil),                 // 1: weaviate.v1.GroupBy
-	(*SortBy)(nil),                  // 2: weaviate.v1.SortBy
-	(*MetadataRequest)(nil),         // 3: weaviate.v1.MetadataRequest
-	(*PropertiesRequest)(nil),       // 4: weaviate.v1.PropertiesRequest
-	(*ObjectPropertiesRequest)(nil), // 5: weaviate.v1.ObjectPropertiesRequest
-	(*RefPropertiesRequest)(nil),    // 6: weaviate.v1.RefPropertiesRequest
-	(*Rerank)(nil),                  // 7: weaviate.v1.Rerank
-	(*SearchReply)(nil),             // 8: weaviate.v1.SearchReply
-	(*RerankReply)(nil),             // 9: weaviate.v1.RerankReply
-	(*GroupByResult)(nil),           // 10: weaviate.v1.GroupByResult
-	(*SearchResult)(nil),            // 11: weaviate.v1.SearchResult
-	(*MetadataResult)(nil),          // 12: weaviate.v1.MetadataResult
-	(*PropertiesResult)(nil),        // 13: weaviate.v1.PropertiesResult
-	(*RefPropertiesResult)(nil),     // 14: weaviate.v1.RefPropertiesResult
-	(ConsistencyLevel)(0),           // 15: weaviate.v1.ConsistencyLevel
-	(*Filters)(nil),                 // 16: weaviate.v1.Filters
-	(*Hybrid)(nil),                  // 17: weaviate.v1.Hybrid
-	(*BM25)(nil),                    // 18: weaviate.v1.BM25
-	(*NearVector)(nil),              // 19: weaviate.v1.NearVector
-	(*NearObject)(nil),              // 20: weaviate.v1.NearObject
-	(*NearTextSearch)(nil),          // 21: weaviate.v1.NearTextSearch
-	(*NearImageSearch)(nil),         // 22: weaviate.v1.NearImageSearch
-	(*NearAudioSearch)(nil),         // 23: weaviate.v1.NearAudioSearch
-	(*NearVideoSearch)(nil),         // 24: weaviate.v1.NearVideoSearch
-	(*NearDepthSearch)(nil),         // 25: weaviate.v1.NearDepthSearch
-	(*NearThermalSearch)(nil),       // 26: weaviate.v1.NearThermalSearch
-	(*NearIMUSearch)(nil),           // 27: weaviate.v1.NearIMUSearch
-	(*GenerativeSearch)(nil),        // 28: weaviate.v1.GenerativeSearch
-	(*GenerativeResult)(nil),        // 29: weaviate.v1.GenerativeResult
-	(*GenerativeReply)(nil),         // 30: weaviate.v1.GenerativeReply
-	(*Vectors)(nil),                 // 31: weaviate.v1.Vectors
-	(*Properties)(nil),              // 32: weaviate.v1.Properties
+	(*Facet)(nil),                   // 2: weaviate.v1.Facet
+	(*FacetRange)(nil),              // 3: weaviate.v1.FacetRange
+	(*SortBy)(nil),                  // 4: weaviate.v1.SortBy
+	(*MetadataRequest)(nil),         // 5: weaviate.v1.MetadataRequest
+	(*HighlightsRequest)(nil),       // 6: weaviate.v1.HighlightsRequest
+	(*PropertiesRequest)(nil),       // 7: weaviate.v1.PropertiesRequest
+	(*ObjectPropertiesRequest)(nil), // 8: weaviate.v1.ObjectPropertiesRequest
+	(*RefPropertiesRequest)(nil),    // 9: weaviate.v1.RefPropertiesRequest
+	(*Rerank)(nil),                  // 10: weaviate.v1.Rerank
+	(*SearchReply)(nil),             // 11: weaviate.v1.SearchReply
+	(*FacetResult)(nil),             // 12: weaviate.v1.FacetResult
+	(*RerankReply)(nil),             // 13: weaviate.v1.RerankReply
+	(*GroupByResult)(nil),           // 14: weaviate.v1.GroupByResult
+	(*SearchResult)(nil),            // 15: weaviate.v1.SearchResult
+	(*MetadataResult)(nil),          // 16: weaviate.v1.MetadataResult
+	(*Highlight)(nil),               // 17: weaviate.v1.Highlight
+	(*PropertiesResult)(nil),        // 18: weaviate.v1.PropertiesResult
+	(*RefPropertiesResult)(nil),     // 19: weaviate.v1.RefPropertiesResult
+	(*FacetResult_Value)(nil),       // 20: weaviate.v1.FacetResult.Value
+	(*FacetResult_Range)(nil),       // 21: weaviate.v1.FacetResult.Range
+	(ConsistencyLevel)(0),           // 22: weaviate.v1.ConsistencyLevel
+	(*Filters)(nil),                 // 23: weaviate.v1.Filters
+	(*Hybrid)(nil),                  // 24: weaviate.v1.Hybrid
+	(*BM25)(nil),                    // 25: weaviate.v1.BM25
+	(*NearVector)(nil),              // 26: weaviate.v1.NearVector
+	(*NearObject)(nil),              // 27: weaviate.v1.NearObject
+	(*NearTextSearch)(nil),          // 28: weaviate.v1.NearTextSearch
+	(*NearImageSearch)(nil),         // 29: weaviate.v1.NearImageSearch
+	(*NearAudioSearch)(nil),         // 30: weaviate.v1.NearAudioSearch
+	(*NearVideoSearch)(nil),         // 31: weaviate.v1.NearVideoSearch
+	(*NearDepthSearch)(nil),         // 32: weaviate.v1.NearDepthSearch
+	(*NearThermalSearch)(nil),       // 33: weaviate.v1.NearThermalSearch
+	(*NearIMUSearch)(nil),           // 34: weaviate.v1.NearIMUSearch
+	(*GenerativeSearch)(nil),        // 35: weaviate.v1.GenerativeSearch
+	(*GenerativeResult)(nil),        // 36: weaviate.v1.GenerativeResult
+	(*GenerativeReply)(nil),         // 37: weaviate.v1.GenerativeReply
+	(*Vectors)(nil),                 // 38: weaviate.v1.Vectors
+	(*Properties)(nil),              // 39: weaviate.v1.Properties
 }
 var file_v1_search_get_proto_depIdxs = []int32{
-	15, // 0: weaviate.v1.SearchRequest.consistency_level:type_name -> weaviate.v1.ConsistencyLevel
-	4,  // 1: weaviate.v1.SearchRequest.properties:type_name -> weaviate.v1.PropertiesRequest
-	3,  // 2: weaviate.v1.SearchRequest.metadata:type_name -> weaviate.v1.MetadataRequest
+	22, // 0: weaviate.v1.SearchRequest.consistency_level:type_name -> weaviate.v1.ConsistencyLevel
+	7,  // 1: weaviate.v1.SearchRequest.properties:type_name -> weaviate.v1.PropertiesRequest
+	5,  // 2: weaviate.v1.SearchRequest.metadata:type_name -> weaviate.v1.MetadataRequest
 	1,  // 3: weaviate.v1.SearchRequest.group_by:type_name -> weaviate.v1.GroupBy
-	2,  // 4: weaviate.v1.SearchRequest.sort_by:type_name -> weaviate.v1.SortBy
-	16, // 5: weaviate.v1.SearchRequest.filters:type_name -> weaviate.v1.Filters
-	17, // 6: weaviate.v1.SearchRequest.hybrid_search:type_name -> weaviate.v1.Hybrid
-	18, // 7: weaviate.v1.SearchRequest.bm25_search:type_name -> weaviate.v1.BM25
-	19, // 8: weaviate.v1.SearchRequest.near_vector:type_name -> weaviate.v1.NearVector
-	20, // 9: weaviate.v1.SearchRequest.near_object:type_name -> weaviate.v1.NearObject
-	21, // 10: weaviate.v1.SearchRequest.near_text:type_name -> weaviate.v1.NearTextSearch
-	22, // 11: weaviate.v1.SearchRequest.near_image:type_name -> weaviate.v1.NearImageSearch
-	23, // 12: weaviate.v1.SearchRequest.near_audio:type_name -> weaviate.v1.NearAudioSearch
-	24, // 13: weaviate.v1.SearchRequest.near_video:type_name -> weaviate.v1.NearVideoSearch
-	25, // 14: weaviate.v1.SearchRequest.near_depth:type_name -> weaviate.v1.NearDepthSearch
-	26, // 15: weaviate.v1.SearchRequest.near_thermal:type_name -> weaviate.v1.NearThermalSearch
-	27, // 16: weaviate.v1.SearchRequest.near_imu:type_name -> weaviate.v1.NearIMUSearch
-	28, // 17: weaviate.v1.SearchRequest.generative:type_name -> weaviate.v1.GenerativeSearch
-	7,  // 18: weaviate.v1.SearchRequest.rerank:type_name -> weaviate.v1.Rerank
-	6,  // 19: weaviate.v1.PropertiesRequest.ref_properties:type_name -> weaviate.v1.RefPropertiesRequest
-	5,  // 20: weaviate.v1.PropertiesRequest.object_properties:type_name -> weaviate.v1.ObjectPropertiesRequest
-	5,  // 21: weaviate.v1.ObjectPropertiesRequest.object_properties:type_name -> weaviate.v1.ObjectPropertiesRequest
-	4,  // 22: weaviate.v1.RefPropertiesRequest.properties:type_name -> weaviate.v1.PropertiesRequest
-	3,  // 23: weaviate.v1.RefPropertiesRequest.metadata:type_name -> weaviate.v1.MetadataRequest
-	11, // 24: weaviate.v1.SearchReply.results:type_name -> weaviate.v1.SearchResult
-	10, // 25: weaviate.v1.SearchReply.group_by_results:type_name -> weaviate.v1.GroupByResult
-	29, // 26: weaviate.v1.SearchReply.generative_grouped_results:type_name -> weaviate.v1.GenerativeResult
-	11, // 27: weaviate.v1.GroupByResult.objects:type_name -> weaviate.v1.SearchResult
-	9,  // 28: weaviate.v1.GroupByResult.rerank:type_name -> weaviate.v1.RerankReply
-	30, // 29: weaviate.v1.GroupByResult.generative:type_name -> weaviate.v1.GenerativeReply
-	29, // 30: weaviate.v1.GroupByResult.generative_result:type_name -> weaviate.v1.GenerativeResult
-	13, // 31: weaviate.v1.SearchResult.properties:type_name -> weaviate.v1.PropertiesResult
-	12, // 32: weaviate.v1.SearchResult.metadata:type_name -> weaviate.v1.MetadataResult
-	29, // 33: weaviate.v1.SearchResult.generative:type_name -> weaviate.v1.GenerativeResult
-	31, // 34: weaviate.v1.MetadataResult.vectors:type_name -> weaviate.v1.Vectors
-	14, // 35: weaviate.v1.PropertiesResult.ref_props:type_name -> weaviate.v1.RefPropertiesResult
-	12, // 36: weaviate.v1.PropertiesResult.metadata:type_name -> weaviate.v1.MetadataResult
-	32, // 37: weaviate.v1.PropertiesResult.non_ref_props:type_name -> weaviate.v1.Properties
-	13, // 38: weaviate.v1.RefPropertiesResult.properties:type_name -> weaviate.v1.PropertiesResult
-	39, // [39:39] is the sub-list for method output_type
-	39, // [39:39] is the sub-list for method input_type
-	39, // [39:39] is the sub-list for extension type_name
-	39, // [39:39] is the sub-list for extension extendee
-	0,  // [0:39] is the sub-list for field type_name
+	2,  // 4: weaviate.v1.SearchRequest.facets:type_name -> weaviate.v1.Facet
+	4,  // 5: weaviate.v1.SearchRequest.sort_by:type_name -> weaviate.v1.SortBy
+	23, // 6: weaviate.v1.SearchRequest.filters:type_name -> weaviate.v1.Filters
+	24, // 7: weaviate.v1.SearchRequest.hybrid_search:type_name -> weaviate.v1.Hybrid
+	25, // 8: weaviate.v1.SearchRequest.bm25_search:type_name -> weaviate.v1.BM25
+	26, // 9: weaviate.v1.SearchRequest.near_vector:type_name -> weaviate.v1.NearVector
+	27, // 10: weaviate.v1.SearchRequest.near_object:type_name -> weaviate.v1.NearObject
+	28, // 11: weaviate.v1.SearchRequest.near_text:type_name -> weaviate.v1.NearTextSearch
+	29, // 12: weaviate.v1.SearchRequest.near_image:type_name -> weaviate.v1.NearImageSearch
+	30, // 13: weaviate.v1.SearchRequest.near_audio:type_name -> weaviate.v1.NearAudioSearch
+	31, // 14: weaviate.v1.SearchRequest.near_video:type_name -> weaviate.v1.NearVideoSearch
+	32, // 15: weaviate.v1.SearchRequest.near_depth:type_name -> weaviate.v1.NearDepthSearch
+	33, // 16: weaviate.v1.SearchRequest.near_thermal:type_name -> weaviate.v1.NearThermalSearch
+	34, // 17: weaviate.v1.SearchRequest.near_imu:type_name -> weaviate.v1.NearIMUSearch
+	35, // 18: weaviate.v1.SearchRequest.generative:type_name -> weaviate.v1.GenerativeSearch
+	10, // 19: weaviate.v1.SearchRequest.rerank:type_name -> weaviate.v1.Rerank
+	3,  // 20: weaviate.v1.Facet.ranges:type_name -> weaviate.v1.FacetRange
+	6,  // 21: weaviate.v1.MetadataRequest.highlights:type_name -> weaviate.v1.HighlightsRequest
+	9,  // 22: weaviate.v1.PropertiesRequest.ref_properties:type_name -> weaviate.v1.RefPropertiesRequest
+	8,  // 23: weaviate.v1.PropertiesRequest.object_properties:type_name -> weaviate.v1.ObjectPropertiesRequest
+	8,  // 24: weaviate.v1.ObjectPropertiesRequest.object_properties:type_name -> weaviate.v1.ObjectPropertiesRequest
+	7,  // 25: weaviate.v1.RefPropertiesRequest.properties:type_name -> weaviate.v1.PropertiesRequest
+	5,  // 26: weaviate.v1.RefPropertiesRequest.metadata:type_name -> weaviate.v1.MetadataRequest
+	15, // 27: weaviate.v1.SearchReply.results:type_name -> weaviate.v1.SearchResult
+	14, // 28: weaviate.v1.SearchReply.group_by_results:type_name -> weaviate.v1.GroupByResult
+	36, // 29: weaviate.v1.SearchReply.generative_grouped_results:type_name -> weaviate.v1.GenerativeResult
+	12, // 30: weaviate.v1.SearchReply.facets:type_name -> weaviate.v1.FacetResult
+	20, // 31: weaviate.v1.FacetResult.values:type_name -> weaviate.v1.FacetResult.Value
+	21, // 32: weaviate.v1.FacetResult.ranges:type_name -> weaviate.v1.FacetResult.Range
+	15, // 33: weaviate.v1.GroupByResult.objects:type_name -> weaviate.v1.SearchResult
+	13, // 34: weaviate.v1.GroupByResult.rerank:type_name -> weaviate.v1.RerankReply
+	37, // 35: weaviate.v1.GroupByResult.generative:type_name -> weaviate.v1.GenerativeReply
+	36, // 36: weaviate.v1.GroupByResult.generative_result:type_name -> weaviate.v1.GenerativeResult
+	18, // 37: weaviate.v1.SearchResult.properties:type_name -> weaviate.v1.PropertiesResult
+	16, // 38: weaviate.v1.SearchResult.metadata:type_name -> weaviate.v1.MetadataResult
+	36, // 39: weaviate.v1.SearchResult.generative:type_name -> weaviate.v1.GenerativeResult
+	38, // 40: weaviate.v1.MetadataResult.vectors:type_name -> weaviate.v1.Vectors
+	17, // 41: weaviate.v1.MetadataResult.highlights:type_name -> weaviate.v1.Highlight
+	19, // 42: weaviate.v1.PropertiesResult.ref_props:type_name -> weaviate.v1.RefPropertiesResult
+	16, // 43: weaviate.v1.PropertiesResult.metadata:type_name -> weaviate.v1.MetadataResult
+	39, // 44: weaviate.v1.PropertiesResult.non_ref_props:type_name -> weaviate.v1.Properties
+	18, // 45: weaviate.v1.RefPropertiesResult.properties:type_name -> weaviate.v1.PropertiesResult
+	46, // [46:46] is the sub-list for method output_type
+	46, // [46:46] is the sub-list for method input_type
+	46, // [46:46] is the sub-list for extension type_name
+	46, // [46:46] is the sub-list for extension extendee
+	0,  // [0:46] is the sub-list for field type_name
 }
 
 func init() { file_v1_search_get_proto_init() }
@@ -1703,18 +2210,20 @@ func file_v1_search_get_proto_init() {
 	file_v1_generative_proto_init()
 	file_v1_properties_proto_init()
 	file_v1_search_get_proto_msgTypes[0].OneofWrappers = []any{}
-	file_v1_search_get_proto_msgTypes[7].OneofWrappers = []any{}
-	file_v1_search_get_proto_msgTypes[8].OneofWrappers = []any{}
+	file_v1_search_get_proto_msgTypes[3].OneofWrappers = []any{}
 	file_v1_search_get_proto_msgTypes[10].OneofWrappers = []any{}
 	file_v1_search_get_proto_msgTypes[11].OneofWrappers = []any{}
-	file_v1_search_get_proto_msgTypes[12].OneofWrappers = []any{}
+	file_v1_search_get_proto_msgTypes[14].OneofWrappers = []any{}
+	file_v1_search_get_proto_msgTypes[15].OneofWrappers = []any{}
+	file_v1_search_get_proto_msgTypes[16].OneofWrappers = []any{}
+	file_v1_search_get_proto_msgTypes[21].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_search_get_proto_rawDesc), len(file_v1_search_get_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
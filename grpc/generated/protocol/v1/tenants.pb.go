@@ -316,6 +316,311 @@ func (x *Tenant) GetActivityStatus() TenantActivityStatus {
 	return TenantActivityStatus_TENANT_ACTIVITY_STATUS_UNSPECIFIED
 }
 
+type TenantsCreateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Collection string                 `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	// tenants without an activity status are created as HOT
+	Tenants       []*Tenant `protobuf:"bytes,2,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantsCreateRequest) Reset() {
+	*x = TenantsCreateRequest{}
+	mi := &file_v1_tenants_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantsCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantsCreateRequest) ProtoMessage() {}
+
+func (x *TenantsCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tenants_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantsCreateRequest.ProtoReflect.Descriptor instead.
+func (*TenantsCreateRequest) Descriptor() ([]byte, []int) {
+	return file_v1_tenants_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TenantsCreateRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *TenantsCreateRequest) GetTenants() []*Tenant {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+type TenantsCreateReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Took          float32                `protobuf:"fixed32,1,opt,name=took,proto3" json:"took,omitempty"`
+	Tenants       []*Tenant              `protobuf:"bytes,2,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantsCreateReply) Reset() {
+	*x = TenantsCreateReply{}
+	mi := &file_v1_tenants_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantsCreateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantsCreateReply) ProtoMessage() {}
+
+func (x *TenantsCreateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tenants_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantsCreateReply.ProtoReflect.Descriptor instead.
+func (*TenantsCreateReply) Descriptor() ([]byte, []int) {
+	return file_v1_tenants_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TenantsCreateReply) GetTook() float32 {
+	if x != nil {
+		return x.Took
+	}
+	return 0
+}
+
+func (x *TenantsCreateReply) GetTenants() []*Tenant {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+type TenantsUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    string                 `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Tenants       []*Tenant              `protobuf:"bytes,2,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantsUpdateRequest) Reset() {
+	*x = TenantsUpdateRequest{}
+	mi := &file_v1_tenants_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantsUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantsUpdateRequest) ProtoMessage() {}
+
+func (x *TenantsUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tenants_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantsUpdateRequest.ProtoReflect.Descriptor instead.
+func (*TenantsUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_v1_tenants_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TenantsUpdateRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *TenantsUpdateRequest) GetTenants() []*Tenant {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+type TenantsUpdateReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Took          float32                `protobuf:"fixed32,1,opt,name=took,proto3" json:"took,omitempty"`
+	Tenants       []*Tenant              `protobuf:"bytes,2,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantsUpdateReply) Reset() {
+	*x = TenantsUpdateReply{}
+	mi := &file_v1_tenants_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantsUpdateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantsUpdateReply) ProtoMessage() {}
+
+func (x *TenantsUpdateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tenants_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantsUpdateReply.ProtoReflect.Descriptor instead.
+func (*TenantsUpdateReply) Descriptor() ([]byte, []int) {
+	return file_v1_tenants_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TenantsUpdateReply) GetTook() float32 {
+	if x != nil {
+		return x.Took
+	}
+	return 0
+}
+
+func (x *TenantsUpdateReply) GetTenants() []*Tenant {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+type TenantsDeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    string                 `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Tenants       []string               `protobuf:"bytes,2,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantsDeleteRequest) Reset() {
+	*x = TenantsDeleteRequest{}
+	mi := &file_v1_tenants_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantsDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantsDeleteRequest) ProtoMessage() {}
+
+func (x *TenantsDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tenants_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantsDeleteRequest.ProtoReflect.Descriptor instead.
+func (*TenantsDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_v1_tenants_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TenantsDeleteRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *TenantsDeleteRequest) GetTenants() []string {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+type TenantsDeleteReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Took          float32                `protobuf:"fixed32,1,opt,name=took,proto3" json:"took,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantsDeleteReply) Reset() {
+	*x = TenantsDeleteReply{}
+	mi := &file_v1_tenants_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantsDeleteReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantsDeleteReply) ProtoMessage() {}
+
+func (x *TenantsDeleteReply) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tenants_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantsDeleteReply.ProtoReflect.Descriptor instead.
+func (*TenantsDeleteReply) Descriptor() ([]byte, []int) {
+	return file_v1_tenants_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TenantsDeleteReply) GetTook() float32 {
+	if x != nil {
+		return x.Took
+	}
+	return 0
+}
+
 var File_v1_tenants_proto protoreflect.FileDescriptor
 
 const file_v1_tenants_proto_rawDesc = "" +
@@ -334,7 +639,30 @@ const file_v1_tenants_proto_rawDesc = "" +
 	"\atenants\x18\x02 \x03(\v2\x13.weaviate.v1.TenantR\atenants\"h\n" +
 	"\x06Tenant\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12J\n" +
-	"\x0factivity_status\x18\x02 \x01(\x0e2!.weaviate.v1.TenantActivityStatusR\x0eactivityStatus*\xaf\x03\n" +
+	"\x0factivity_status\x18\x02 \x01(\x0e2!.weaviate.v1.TenantActivityStatusR\x0eactivityStatus\"e\n" +
+	"\x14TenantsCreateRequest\x12\x1e\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\tR\n" +
+	"collection\x12-\n" +
+	"\atenants\x18\x02 \x03(\v2\x13.weaviate.v1.TenantR\atenants\"W\n" +
+	"\x12TenantsCreateReply\x12\x12\n" +
+	"\x04took\x18\x01 \x01(\x02R\x04took\x12-\n" +
+	"\atenants\x18\x02 \x03(\v2\x13.weaviate.v1.TenantR\atenants\"e\n" +
+	"\x14TenantsUpdateRequest\x12\x1e\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\tR\n" +
+	"collection\x12-\n" +
+	"\atenants\x18\x02 \x03(\v2\x13.weaviate.v1.TenantR\atenants\"W\n" +
+	"\x12TenantsUpdateReply\x12\x12\n" +
+	"\x04took\x18\x01 \x01(\x02R\x04took\x12-\n" +
+	"\atenants\x18\x02 \x03(\v2\x13.weaviate.v1.TenantR\atenants\"P\n" +
+	"\x14TenantsDeleteRequest\x12\x1e\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\tR\n" +
+	"collection\x12\x18\n" +
+	"\atenants\x18\x02 \x03(\tR\atenants\"(\n" +
+	"\x12TenantsDeleteReply\x12\x12\n" +
+	"\x04took\x18\x01 \x01(\x02R\x04took*\xaf\x03\n" +
 	"\x14TenantActivityStatus\x12&\n" +
 	"\"TENANT_ACTIVITY_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
 	"\x1aTENANT_ACTIVITY_STATUS_HOT\x10\x01\x12\x1f\n" +
@@ -363,23 +691,33 @@ func file_v1_tenants_proto_rawDescGZIP() []byte {
 }
 
 var file_v1_tenants_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_v1_tenants_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_v1_tenants_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_v1_tenants_proto_goTypes = []any{
-	(TenantActivityStatus)(0), // 0: weaviate.v1.TenantActivityStatus
-	(*TenantsGetRequest)(nil), // 1: weaviate.v1.TenantsGetRequest
-	(*TenantNames)(nil),       // 2: weaviate.v1.TenantNames
-	(*TenantsGetReply)(nil),   // 3: weaviate.v1.TenantsGetReply
-	(*Tenant)(nil),            // 4: weaviate.v1.Tenant
+	(TenantActivityStatus)(0),    // 0: weaviate.v1.TenantActivityStatus
+	(*TenantsGetRequest)(nil),    // 1: weaviate.v1.TenantsGetRequest
+	(*TenantNames)(nil),          // 2: weaviate.v1.TenantNames
+	(*TenantsGetReply)(nil),      // 3: weaviate.v1.TenantsGetReply
+	(*Tenant)(nil),               // 4: weaviate.v1.Tenant
+	(*TenantsCreateRequest)(nil), // 5: weaviate.v1.TenantsCreateRequest
+	(*TenantsCreateReply)(nil),   // 6: weaviate.v1.TenantsCreateReply
+	(*TenantsUpdateRequest)(nil), // 7: weaviate.v1.TenantsUpdateRequest
+	(*TenantsUpdateReply)(nil),   // 8: weaviate.v1.TenantsUpdateReply
+	(*TenantsDeleteRequest)(nil), // 9: weaviate.v1.TenantsDeleteRequest
+	(*TenantsDeleteReply)(nil),   // 10: weaviate.v1.TenantsDeleteReply
 }
 var file_v1_tenants_proto_depIdxs = []int32{
 	2, // 0: weaviate.v1.TenantsGetRequest.names:type_name -> weaviate.v1.TenantNames
 	4, // 1: weaviate.v1.TenantsGetReply.tenants:type_name -> weaviate.v1.Tenant
 	0, // 2: weaviate.v1.Tenant.activity_status:type_name -> weaviate.v1.TenantActivityStatus
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 3: weaviate.v1.TenantsCreateRequest.tenants:type_name -> weaviate.v1.Tenant
+	4, // 4: weaviate.v1.TenantsCreateReply.tenants:type_name -> weaviate.v1.Tenant
+	4, // 5: weaviate.v1.TenantsUpdateRequest.tenants:type_name -> weaviate.v1.Tenant
+	4, // 6: weaviate.v1.TenantsUpdateReply.tenants:type_name -> weaviate.v1.Tenant
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_v1_tenants_proto_init() }
@@ -396,7 +734,7 @@ func file_v1_tenants_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_tenants_proto_rawDesc), len(file_v1_tenants_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   4,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -21,51 +21,164 @@ var File_v1_weaviate_proto protoreflect.FileDescriptor
 
 const file_v1_weaviate_proto_rawDesc = "" +
 	"\n" +
-	"\x11v1/weaviate.proto\x12\vweaviate.v1\x1a\x12v1/aggregate.proto\x1a\x0ev1/batch.proto\x1a\x15v1/batch_delete.proto\x1a\x13v1/search_get.proto\x1a\x10v1/tenants.proto2\xbc\x04\n" +
+	"\x11v1/weaviate.proto\x12\vweaviate.v1\x1a\x12v1/aggregate.proto\x1a\x0ev1/batch.proto\x1a\x15v1/batch_delete.proto\x1a\x10v1/changes.proto\x1a\x10v1/objects.proto\x1a\x0fv1/schema.proto\x1a\x15v1/search_batch.proto\x1a\x13v1/search_get.proto\x1a\x10v1/tenants.proto2\xc4\x13\n" +
 	"\bWeaviate\x12@\n" +
-	"\x06Search\x12\x1a.weaviate.v1.SearchRequest\x1a\x18.weaviate.v1.SearchReply\"\x00\x12R\n" +
+	"\x06Search\x12\x1a.weaviate.v1.SearchRequest\x1a\x18.weaviate.v1.SearchReply\"\x00\x12O\n" +
+	"\vSearchBatch\x12\x1f.weaviate.v1.SearchBatchRequest\x1a\x1d.weaviate.v1.SearchBatchReply\"\x00\x12R\n" +
 	"\fBatchObjects\x12 .weaviate.v1.BatchObjectsRequest\x1a\x1e.weaviate.v1.BatchObjectsReply\"\x00\x12[\n" +
 	"\x0fBatchReferences\x12#.weaviate.v1.BatchReferencesRequest\x1a!.weaviate.v1.BatchReferencesReply\"\x00\x12O\n" +
 	"\vBatchDelete\x12\x1f.weaviate.v1.BatchDeleteRequest\x1a\x1d.weaviate.v1.BatchDeleteReply\"\x00\x12L\n" +
 	"\n" +
 	"TenantsGet\x12\x1e.weaviate.v1.TenantsGetRequest\x1a\x1c.weaviate.v1.TenantsGetReply\"\x00\x12I\n" +
 	"\tAggregate\x12\x1d.weaviate.v1.AggregateRequest\x1a\x1b.weaviate.v1.AggregateReply\"\x00\x12S\n" +
-	"\vBatchStream\x12\x1f.weaviate.v1.BatchStreamRequest\x1a\x1d.weaviate.v1.BatchStreamReply\"\x00(\x010\x01Bj\n" +
+	"\vBatchStream\x12\x1f.weaviate.v1.BatchStreamRequest\x1a\x1d.weaviate.v1.BatchStreamReply\"\x00(\x010\x01\x12W\n" +
+	"\rChangesStream\x12!.weaviate.v1.ChangesStreamRequest\x1a\x1f.weaviate.v1.ChangesStreamReply\"\x000\x01\x12X\n" +
+	"\x0eCollectionsGet\x12\".weaviate.v1.CollectionsGetRequest\x1a .weaviate.v1.CollectionsGetReply\"\x00\x12[\n" +
+	"\x0fCollectionsList\x12#.weaviate.v1.CollectionsListRequest\x1a!.weaviate.v1.CollectionsListReply\"\x00\x12a\n" +
+	"\x11CollectionsCreate\x12%.weaviate.v1.CollectionsCreateRequest\x1a#.weaviate.v1.CollectionsCreateReply\"\x00\x12a\n" +
+	"\x11CollectionsUpdate\x12%.weaviate.v1.CollectionsUpdateRequest\x1a#.weaviate.v1.CollectionsUpdateReply\"\x00\x12a\n" +
+	"\x11CollectionsDelete\x12%.weaviate.v1.CollectionsDeleteRequest\x1a#.weaviate.v1.CollectionsDeleteReply\"\x00\x12U\n" +
+	"\rPropertiesAdd\x12!.weaviate.v1.PropertiesAddRequest\x1a\x1f.weaviate.v1.PropertiesAddReply\"\x00\x12^\n" +
+	"\x10PropertiesDelete\x12$.weaviate.v1.PropertiesDeleteRequest\x1a\".weaviate.v1.PropertiesDeleteReply\"\x00\x12U\n" +
+	"\rTenantsCreate\x12!.weaviate.v1.TenantsCreateRequest\x1a\x1f.weaviate.v1.TenantsCreateReply\"\x00\x12U\n" +
+	"\rTenantsUpdate\x12!.weaviate.v1.TenantsUpdateRequest\x1a\x1f.weaviate.v1.TenantsUpdateReply\"\x00\x12U\n" +
+	"\rTenantsDelete\x12!.weaviate.v1.TenantsDeleteRequest\x1a\x1f.weaviate.v1.TenantsDeleteReply\"\x00\x12L\n" +
+	"\n" +
+	"AliasesGet\x12\x1e.weaviate.v1.AliasesGetRequest\x1a\x1c.weaviate.v1.AliasesGetReply\"\x00\x12O\n" +
+	"\vAliasesList\x12\x1f.weaviate.v1.AliasesListRequest\x1a\x1d.weaviate.v1.AliasesListReply\"\x00\x12U\n" +
+	"\rAliasesCreate\x12!.weaviate.v1.AliasesCreateRequest\x1a\x1f.weaviate.v1.AliasesCreateReply\"\x00\x12U\n" +
+	"\rAliasesUpdate\x12!.weaviate.v1.AliasesUpdateRequest\x1a\x1f.weaviate.v1.AliasesUpdateReply\"\x00\x12U\n" +
+	"\rAliasesDelete\x12!.weaviate.v1.AliasesDeleteRequest\x1a\x1f.weaviate.v1.AliasesDeleteReply\"\x00\x12L\n" +
+	"\n" +
+	"ObjectsGet\x12\x1e.weaviate.v1.ObjectsGetRequest\x1a\x1c.weaviate.v1.ObjectsGetReply\"\x00\x12L\n" +
+	"\n" +
+	"ObjectsPut\x12\x1e.weaviate.v1.ObjectsPutRequest\x1a\x1c.weaviate.v1.ObjectsPutReply\"\x00\x12R\n" +
+	"\fObjectsPatch\x12 .weaviate.v1.ObjectsPatchRequest\x1a\x1e.weaviate.v1.ObjectsPatchReply\"\x00\x12U\n" +
+	"\rObjectsDelete\x12!.weaviate.v1.ObjectsDeleteRequest\x1a\x1f.weaviate.v1.ObjectsDeleteReply\"\x00\x12U\n" +
+	"\rObjectsExists\x12!.weaviate.v1.ObjectsExistsRequest\x1a\x1f.weaviate.v1.ObjectsExistsReply\"\x00Bj\n" +
 	"#io.weaviate.client.grpc.protocol.v1B\rWeaviateProtoZ4github.com/weaviate/weaviate/grpc/generated;protocolb\x06proto3"
 
 var file_v1_weaviate_proto_goTypes = []any{
-	(*SearchRequest)(nil),          // 0: weaviate.v1.SearchRequest
-	(*BatchObjectsRequest)(nil),    // 1: weaviate.v1.BatchObjectsRequest
-	(*BatchReferencesRequest)(nil), // 2: weaviate.v1.BatchReferencesRequest
-	(*BatchDeleteRequest)(nil),     // 3: weaviate.v1.BatchDeleteRequest
-	(*TenantsGetRequest)(nil),      // 4: weaviate.v1.TenantsGetRequest
-	(*AggregateRequest)(nil),       // 5: weaviate.v1.AggregateRequest
-	(*BatchStreamRequest)(nil),     // 6: weaviate.v1.BatchStreamRequest
-	(*SearchReply)(nil),            // 7: weaviate.v1.SearchReply
-	(*BatchObjectsReply)(nil),      // 8: weaviate.v1.BatchObjectsReply
-	(*BatchReferencesReply)(nil),   // 9: weaviate.v1.BatchReferencesReply
-	(*BatchDeleteReply)(nil),       // 10: weaviate.v1.BatchDeleteReply
-	(*TenantsGetReply)(nil),        // 11: weaviate.v1.TenantsGetReply
-	(*AggregateReply)(nil),         // 12: weaviate.v1.AggregateReply
-	(*BatchStreamReply)(nil),       // 13: weaviate.v1.BatchStreamReply
+	(*SearchRequest)(nil),            // 0: weaviate.v1.SearchRequest
+	(*SearchBatchRequest)(nil),       // 1: weaviate.v1.SearchBatchRequest
+	(*BatchObjectsRequest)(nil),      // 2: weaviate.v1.BatchObjectsRequest
+	(*BatchReferencesRequest)(nil),   // 3: weaviate.v1.BatchReferencesRequest
+	(*BatchDeleteRequest)(nil),       // 4: weaviate.v1.BatchDeleteRequest
+	(*TenantsGetRequest)(nil),        // 5: weaviate.v1.TenantsGetRequest
+	(*AggregateRequest)(nil),         // 6: weaviate.v1.AggregateRequest
+	(*BatchStreamRequest)(nil),       // 7: weaviate.v1.BatchStreamRequest
+	(*ChangesStreamRequest)(nil),     // 8: weaviate.v1.ChangesStreamRequest
+	(*CollectionsGetRequest)(nil),    // 9: weaviate.v1.CollectionsGetRequest
+	(*CollectionsListRequest)(nil),   // 10: weaviate.v1.CollectionsListRequest
+	(*CollectionsCreateRequest)(nil), // 11: weaviate.v1.CollectionsCreateRequest
+	(*CollectionsUpdateRequest)(nil), // 12: weaviate.v1.CollectionsUpdateRequest
+	(*CollectionsDeleteRequest)(nil), // 13: weaviate.v1.CollectionsDeleteRequest
+	(*PropertiesAddRequest)(nil),     // 14: weaviate.v1.PropertiesAddRequest
+	(*PropertiesDeleteRequest)(nil),  // 15: weaviate.v1.PropertiesDeleteRequest
+	(*TenantsCreateRequest)(nil),     // 16: weaviate.v1.TenantsCreateRequest
+	(*TenantsUpdateRequest)(nil),     // 17: weaviate.v1.TenantsUpdateRequest
+	(*TenantsDeleteRequest)(nil),     // 18: weaviate.v1.TenantsDeleteRequest
+	(*AliasesGetRequest)(nil),        // 19: weaviate.v1.AliasesGetRequest
+	(*AliasesListRequest)(nil),       // 20: weaviate.v1.AliasesListRequest
+	(*AliasesCreateRequest)(nil),     // 21: weaviate.v1.AliasesCreateRequest
+	(*AliasesUpdateRequest)(nil),     // 22: weaviate.v1.AliasesUpdateRequest
+	(*AliasesDeleteRequest)(nil),     // 23: weaviate.v1.AliasesDeleteRequest
+	(*ObjectsGetRequest)(nil),        // 24: weaviate.v1.ObjectsGetRequest
+	(*ObjectsPutRequest)(nil),        // 25: weaviate.v1.ObjectsPutRequest
+	(*ObjectsPatchRequest)(nil),      // 26: weaviate.v1.ObjectsPatchRequest
+	(*ObjectsDeleteRequest)(nil),     // 27: weaviate.v1.ObjectsDeleteRequest
+	(*ObjectsExistsRequest)(nil),     // 28: weaviate.v1.ObjectsExistsRequest
+	(*SearchReply)(nil),              // 29: weaviate.v1.SearchReply
+	(*SearchBatchReply)(nil),         // 30: weaviate.v1.SearchBatchReply
+	(*BatchObjectsReply)(nil),        // 31: weaviate.v1.BatchObjectsReply
+	(*BatchReferencesReply)(nil),     // 32: weaviate.v1.BatchReferencesReply
+	(*BatchDeleteReply)(nil),         // 33: weaviate.v1.BatchDeleteReply
+	(*TenantsGetReply)(nil),          // 34: weaviate.v1.TenantsGetReply
+	(*AggregateReply)(nil),           // 35: weaviate.v1.AggregateReply
+	(*BatchStreamReply)(nil),         // 36: weaviate.v1.BatchStreamReply
+	(*ChangesStreamReply)(nil),       // 37: weaviate.v1.ChangesStreamReply
+	(*CollectionsGetReply)(nil),      // 38: weaviate.v1.CollectionsGetReply
+	(*CollectionsListReply)(nil),     // 39: weaviate.v1.CollectionsListReply
+	(*CollectionsCreateReply)(nil),   // 40: weaviate.v1.CollectionsCreateReply
+	(*CollectionsUpdateReply)(nil),   // 41: weaviate.v1.CollectionsUpdateReply
+	(*CollectionsDeleteReply)(nil),   // 42: weaviate.v1.CollectionsDeleteReply
+	(*PropertiesAddReply)(nil),       // 43: weaviate.v1.PropertiesAddReply
+	(*PropertiesDeleteReply)(nil),    // 44: weaviate.v1.PropertiesDeleteReply
+	(*TenantsCreateReply)(nil),       // 45: weaviate.v1.TenantsCreateReply
+	(*TenantsUpdateReply)(nil),       // 46: weaviate.v1.TenantsUpdateReply
+	(*TenantsDeleteReply)(nil),       // 47: weaviate.v1.TenantsDeleteReply
+	(*AliasesGetReply)(nil),          // 48: weaviate.v1.AliasesGetReply
+	(*AliasesListReply)(nil),         // 49: weaviate.v1.AliasesListReply
+	(*AliasesCreateReply)(nil),       // 50: weaviate.v1.AliasesCreateReply
+	(*AliasesUpdateReply)(nil),       // 51: weaviate.v1.AliasesUpdateReply
+	(*AliasesDeleteReply)(nil),       // 52: weaviate.v1.AliasesDeleteReply
+	(*ObjectsGetReply)(nil),          // 53: weaviate.v1.ObjectsGetReply
+	(*ObjectsPutReply)(nil),          // 54: weaviate.v1.ObjectsPutReply
+	(*ObjectsPatchReply)(nil),        // 55: weaviate.v1.ObjectsPatchReply
+	(*ObjectsDeleteReply)(nil),       // 56: weaviate.v1.ObjectsDeleteReply
+	(*ObjectsExistsReply)(nil),       // 57: weaviate.v1.ObjectsExistsReply
 }
 var file_v1_weaviate_proto_depIdxs = []int32{
 	0,  // 0: weaviate.v1.Weaviate.Search:input_type -> weaviate.v1.SearchRequest
-	1,  // 1: weaviate.v1.Weaviate.BatchObjects:input_type -> weaviate.v1.BatchObjectsRequest
-	2,  // 2: weaviate.v1.Weaviate.BatchReferences:input_type -> weaviate.v1.BatchReferencesRequest
-	3,  // 3: weaviate.v1.Weaviate.BatchDelete:input_type -> weaviate.v1.BatchDeleteRequest
-	4,  // 4: weaviate.v1.Weaviate.TenantsGet:input_type -> weaviate.v1.TenantsGetRequest
-	5,  // 5: weaviate.v1.Weaviate.Aggregate:input_type -> weaviate.v1.AggregateRequest
-	6,  // 6: weaviate.v1.Weaviate.BatchStream:input_type -> weaviate.v1.BatchStreamRequest
-	7,  // 7: weaviate.v1.Weaviate.Search:output_type -> weaviate.v1.SearchReply
-	8,  // 8: weaviate.v1.Weaviate.BatchObjects:output_type -> weaviate.v1.BatchObjectsReply
-	9,  // 9: weaviate.v1.Weaviate.BatchReferences:output_type -> weaviate.v1.BatchReferencesReply
-	10, // 10: weaviate.v1.Weaviate.BatchDelete:output_type -> weaviate.v1.BatchDeleteReply
-	11, // 11: weaviate.v1.Weaviate.TenantsGet:output_type -> weaviate.v1.TenantsGetReply
-	12, // 12: weaviate.v1.Weaviate.Aggregate:output_type -> weaviate.v1.AggregateReply
-	13, // 13: weaviate.v1.Weaviate.BatchStream:output_type -> weaviate.v1.BatchStreamReply
-	7,  // [7:14] is the sub-list for method output_type
-	0,  // [0:7] is the sub-list for method input_type
+	1,  // 1: weaviate.v1.Weaviate.SearchBatch:input_type -> weaviate.v1.SearchBatchRequest
+	2,  // 2: weaviate.v1.Weaviate.BatchObjects:input_type -> weaviate.v1.BatchObjectsRequest
+	3,  // 3: weaviate.v1.Weaviate.BatchReferences:input_type -> weaviate.v1.BatchReferencesRequest
+	4,  // 4: weaviate.v1.Weaviate.BatchDelete:input_type -> weaviate.v1.BatchDeleteRequest
+	5,  // 5: weaviate.v1.Weaviate.TenantsGet:input_type -> weaviate.v1.TenantsGetRequest
+	6,  // 6: weaviate.v1.Weaviate.Aggregate:input_type -> weaviate.v1.AggregateRequest
+	7,  // 7: weaviate.v1.Weaviate.BatchStream:input_type -> weaviate.v1.BatchStreamRequest
+	8,  // 8: weaviate.v1.Weaviate.ChangesStream:input_type -> weaviate.v1.ChangesStreamRequest
+	9,  // 9: weaviate.v1.Weaviate.CollectionsGet:input_type -> weaviate.v1.CollectionsGetRequest
+	10, // 10: weaviate.v1.Weaviate.CollectionsList:input_type -> weaviate.v1.CollectionsListRequest
+	11, // 11: weaviate.v1.Weaviate.CollectionsCreate:input_type -> weaviate.v1.CollectionsCreateRequest
+	12, // 12: weaviate.v1.Weaviate.CollectionsUpdate:input_type -> weaviate.v1.CollectionsUpdateRequest
+	13, // 13: weaviate.v1.Weaviate.CollectionsDelete:input_type -> weaviate.v1.CollectionsDeleteRequest
+	14, // 14: weaviate.v1.Weaviate.PropertiesAdd:input_type -> weaviate.v1.PropertiesAddRequest
+	15, // 15: weaviate.v1.Weaviate.PropertiesDelete:input_type -> weaviate.v1.PropertiesDeleteRequest
+	16, // 16: weaviate.v1.Weaviate.TenantsCreate:input_type -> weaviate.v1.TenantsCreateRequest
+	17, // 17: weaviate.v1.Weaviate.TenantsUpdate:input_type -> weaviate.v1.TenantsUpdateRequest
+	18, // 18: weaviate.v1.Weaviate.TenantsDelete:input_type -> weaviate.v1.TenantsDeleteRequest
+	19, // 19: weaviate.v1.Weaviate.AliasesGet:input_type -> weaviate.v1.AliasesGetRequest
+	20, // 20: weaviate.v1.Weaviate.AliasesList:input_type -> weaviate.v1.AliasesListRequest
+	21, // 21: weaviate.v1.Weaviate.AliasesCreate:input_type -> weaviate.v1.AliasesCreateRequest
+	22, // 22: weaviate.v1.Weaviate.AliasesUpdate:input_type -> weaviate.v1.AliasesUpdateRequest
+	23, // 23: weaviate.v1.Weaviate.AliasesDelete:input_type -> weaviate.v1.AliasesDeleteRequest
+	24, // 24: weaviate.v1.Weaviate.ObjectsGet:input_type -> weaviate.v1.ObjectsGetRequest
+	25, // 25: weaviate.v1.Weaviate.ObjectsPut:input_type -> weaviate.v1.ObjectsPutRequest
+	26, // 26: weaviate.v1.Weaviate.ObjectsPatch:input_type -> weaviate.v1.ObjectsPatchRequest
+	27, // 27: weaviate.v1.Weaviate.ObjectsDelete:input_type -> weaviate.v1.ObjectsDeleteRequest
+	28, // 28: weaviate.v1.Weaviate.ObjectsExists:input_type -> weaviate.v1.ObjectsExistsRequest
+	29, // 29: weaviate.v1.Weaviate.Search:output_type -> weaviate.v1.SearchReply
+	30, // 30: weaviate.v1.Weaviate.SearchBatch:output_type -> weaviate.v1.SearchBatchReply
+	31, // 31: weaviate.v1.Weaviate.BatchObjects:output_type -> weaviate.v1.BatchObjectsReply
+	32, // 32: weaviate.v1.Weaviate.BatchReferences:output_type -> weaviate.v1.BatchReferencesReply
+	33, // 33: weaviate.v1.Weaviate.BatchDelete:output_type -> weaviate.v1.BatchDeleteReply
+	34, // 34: weaviate.v1.Weaviate.TenantsGet:output_type -> weaviate.v1.TenantsGetReply
+	35, // 35: weaviate.v1.Weaviate.Aggregate:output_type -> weaviate.v1.AggregateReply
+	36, // 36: weaviate.v1.Weaviate.BatchStream:output_type -> weaviate.v1.BatchStreamReply
+	37, // 37: weaviate.v1.Weaviate.ChangesStream:output_type -> weaviate.v1.ChangesStreamReply
+	38, // 38: weaviate.v1.Weaviate.CollectionsGet:output_type -> weaviate.v1.CollectionsGetReply
+	39, // 39: weaviate.v1.Weaviate.CollectionsList:output_type -> weaviate.v1.CollectionsListReply
+	40, // 40: weaviate.v1.Weaviate.CollectionsCreate:output_type -> weaviate.v1.CollectionsCreateReply
+	41, // 41: weaviate.v1.Weaviate.CollectionsUpdate:output_type -> weaviate.v1.CollectionsUpdateReply
+	42, // 42: weaviate.v1.Weaviate.CollectionsDelete:output_type -> weaviate.v1.CollectionsDeleteReply
+	43, // 43: weaviate.v1.Weaviate.PropertiesAdd:output_type -> weaviate.v1.PropertiesAddReply
+	44, // 44: weaviate.v1.Weaviate.PropertiesDelete:output_type -> weaviate.v1.PropertiesDeleteReply
+	45, // 45: weaviate.v1.Weaviate.TenantsCreate:output_type -> weaviate.v1.TenantsCreateReply
+	46, // 46: weaviate.v1.Weaviate.TenantsUpdate:output_type -> weaviate.v1.TenantsUpdateReply
+	47, // 47: weaviate.v1.Weaviate.TenantsDelete:output_type -> weaviate.v1.TenantsDeleteReply
+	48, // 48: weaviate.v1.Weaviate.AliasesGet:output_type -> weaviate.v1.AliasesGetReply
+	49, // 49: weaviate.v1.Weaviate.AliasesList:output_type -> weaviate.v1.AliasesListReply
+	50, // 50: weaviate.v1.Weaviate.AliasesCreate:output_type -> weaviate.v1.AliasesCreateReply
+	51, // 51: weaviate.v1.Weaviate.AliasesUpdate:output_type -> weaviate.v1.AliasesUpdateReply
+	52, // 52: weaviate.v1.Weaviate.AliasesDelete:output_type -> weaviate.v1.AliasesDeleteReply
+	53, // 53: weaviate.v1.Weaviate.ObjectsGet:output_type -> weaviate.v1.ObjectsGetReply
+	54, // 54: weaviate.v1.Weaviate.ObjectsPut:output_type -> weaviate.v1.ObjectsPutReply
+	55, // 55: weaviate.v1.Weaviate.ObjectsPatch:output_type -> weaviate.v1.ObjectsPatchReply
+	56, // 56: weaviate.v1.Weaviate.ObjectsDelete:output_type -> weaviate.v1.ObjectsDeleteReply
+	57, // 57: weaviate.v1.Weaviate.ObjectsExists:output_type -> weaviate.v1.ObjectsExistsReply
+	29, // [29:58] is the sub-list for method output_type
+	0,  // [0:29] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -79,6 +192,10 @@ func file_v1_weaviate_proto_init() {
 	file_v1_aggregate_proto_init()
 	file_v1_batch_proto_init()
 	file_v1_batch_delete_proto_init()
+	file_v1_changes_proto_init()
+	file_v1_objects_proto_init()
+	file_v1_schema_proto_init()
+	file_v1_search_batch_proto_init()
 	file_v1_search_get_proto_init()
 	file_v1_tenants_proto_init()
 	type x struct{}
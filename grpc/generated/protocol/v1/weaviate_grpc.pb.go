@@ -16,13 +16,35 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Weaviate_Search_FullMethodName          = "/weaviate.v1.Weaviate/Search"
-	Weaviate_BatchObjects_FullMethodName    = "/weaviate.v1.Weaviate/BatchObjects"
-	Weaviate_BatchReferences_FullMethodName = "/weaviate.v1.Weaviate/BatchReferences"
-	Weaviate_BatchDelete_FullMethodName     = "/weaviate.v1.Weaviate/BatchDelete"
-	Weaviate_TenantsGet_FullMethodName      = "/weaviate.v1.Weaviate/TenantsGet"
-	Weaviate_Aggregate_FullMethodName       = "/weaviate.v1.Weaviate/Aggregate"
-	Weaviate_BatchStream_FullMethodName     = "/weaviate.v1.Weaviate/BatchStream"
+	Weaviate_Search_FullMethodName            = "/weaviate.v1.Weaviate/Search"
+	Weaviate_SearchBatch_FullMethodName       = "/weaviate.v1.Weaviate/SearchBatch"
+	Weaviate_BatchObjects_FullMethodName      = "/weaviate.v1.Weaviate/BatchObjects"
+	Weaviate_BatchReferences_FullMethodName   = "/weaviate.v1.Weaviate/BatchReferences"
+	Weaviate_BatchDelete_FullMethodName       = "/weaviate.v1.Weaviate/BatchDelete"
+	Weaviate_TenantsGet_FullMethodName        = "/weaviate.v1.Weaviate/TenantsGet"
+	Weaviate_Aggregate_FullMethodName         = "/weaviate.v1.Weaviate/Aggregate"
+	Weaviate_BatchStream_FullMethodName       = "/weaviate.v1.Weaviate/BatchStream"
+	Weaviate_ChangesStream_FullMethodName     = "/weaviate.v1.Weaviate/ChangesStream"
+	Weaviate_CollectionsGet_FullMethodName    = "/weaviate.v1.Weaviate/CollectionsGet"
+	Weaviate_CollectionsList_FullMethodName   = "/weaviate.v1.Weaviate/CollectionsList"
+	Weaviate_CollectionsCreate_FullMethodName = "/weaviate.v1.Weaviate/CollectionsCreate"
+	Weaviate_CollectionsUpdate_FullMethodName = "/weaviate.v1.Weaviate/CollectionsUpdate"
+	Weaviate_CollectionsDelete_FullMethodName = "/weaviate.v1.Weaviate/CollectionsDelete"
+	Weaviate_PropertiesAdd_FullMethodName     = "/weaviate.v1.Weaviate/PropertiesAdd"
+	Weaviate_PropertiesDelete_FullMethodName  = "/weaviate.v1.Weaviate/PropertiesDelete"
+	Weaviate_TenantsCreate_FullMethodName     = "/weaviate.v1.Weaviate/TenantsCreate"
+	Weaviate_TenantsUpdate_FullMethodName     = "/weaviate.v1.Weaviate/TenantsUpdate"
+	Weaviate_TenantsDelete_FullMethodName     = "/weaviate.v1.Weaviate/TenantsDelete"
+	Weaviate_AliasesGet_FullMethodName        = "/weaviate.v1.Weaviate/AliasesGet"
+	Weaviate_AliasesList_FullMethodName       = "/weaviate.v1.Weaviate/AliasesList"
+	Weaviate_AliasesCreate_FullMethodName     = "/weaviate.v1.Weaviate/AliasesCreate"
+	Weaviate_AliasesUpdate_FullMethodName     = "/weaviate.v1.Weaviate/AliasesUpdate"
+	Weaviate_AliasesDelete_FullMethodName     = "/weaviate.v1.Weaviate/AliasesDelete"
+	Weaviate_ObjectsGet_FullMethodName        = "/weaviate.v1.Weaviate/ObjectsGet"
+	Weaviate_ObjectsPut_FullMethodName        = "/weaviate.v1.Weaviate/ObjectsPut"
+	Weaviate_ObjectsPatch_FullMethodName      = "/weaviate.v1.Weaviate/ObjectsPatch"
+	Weaviate_ObjectsDelete_FullMethodName     = "/weaviate.v1.Weaviate/ObjectsDelete"
+	Weaviate_ObjectsExists_FullMethodName     = "/weaviate.v1.Weaviate/ObjectsExists"
 )
 
 // WeaviateClient is the client API for Weaviate service.
@@ -30,12 +52,34 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type WeaviateClient interface {
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchReply, error)
+	SearchBatch(ctx context.Context, in *SearchBatchRequest, opts ...grpc.CallOption) (*SearchBatchReply, error)
 	BatchObjects(ctx context.Context, in *BatchObjectsRequest, opts ...grpc.CallOption) (*BatchObjectsReply, error)
 	BatchReferences(ctx context.Context, in *BatchReferencesRequest, opts ...grpc.CallOption) (*BatchReferencesReply, error)
 	BatchDelete(ctx context.Context, in *BatchDeleteRequest, opts ...grpc.CallOption) (*BatchDeleteReply, error)
 	TenantsGet(ctx context.Context, in *TenantsGetRequest, opts ...grpc.CallOption) (*TenantsGetReply, error)
 	Aggregate(ctx context.Context, in *AggregateRequest, opts ...grpc.CallOption) (*AggregateReply, error)
 	BatchStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[BatchStreamRequest, BatchStreamReply], error)
+	ChangesStream(ctx context.Context, in *ChangesStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChangesStreamReply], error)
+	CollectionsGet(ctx context.Context, in *CollectionsGetRequest, opts ...grpc.CallOption) (*CollectionsGetReply, error)
+	CollectionsList(ctx context.Context, in *CollectionsListRequest, opts ...grpc.CallOption) (*CollectionsListReply, error)
+	CollectionsCreate(ctx context.Context, in *CollectionsCreateRequest, opts ...grpc.CallOption) (*CollectionsCreateReply, error)
+	CollectionsUpdate(ctx context.Context, in *CollectionsUpdateRequest, opts ...grpc.CallOption) (*CollectionsUpdateReply, error)
+	CollectionsDelete(ctx context.Context, in *CollectionsDeleteRequest, opts ...grpc.CallOption) (*CollectionsDeleteReply, error)
+	PropertiesAdd(ctx context.Context, in *PropertiesAddRequest, opts ...grpc.CallOption) (*PropertiesAddReply, error)
+	PropertiesDelete(ctx context.Context, in *PropertiesDeleteRequest, opts ...grpc.CallOption) (*PropertiesDeleteReply, error)
+	TenantsCreate(ctx context.Context, in *TenantsCreateRequest, opts ...grpc.CallOption) (*TenantsCreateReply, error)
+	TenantsUpdate(ctx context.Context, in *TenantsUpdateRequest, opts ...grpc.CallOption) (*TenantsUpdateReply, error)
+	TenantsDelete(ctx context.Context, in *TenantsDeleteRequest, opts ...grpc.CallOption) (*TenantsDeleteReply, error)
+	AliasesGet(ctx context.Context, in *AliasesGetRequest, opts ...grpc.CallOption) (*AliasesGetReply, error)
+	AliasesList(ctx context.Context, in *AliasesListRequest, opts ...grpc.CallOption) (*AliasesListReply, error)
+	AliasesCreate(ctx context.Context, in *AliasesCreateRequest, opts ...grpc.CallOption) (*AliasesCreateReply, error)
+	AliasesUpdate(ctx context.Context, in *AliasesUpdateRequest, opts ...grpc.CallOption) (*AliasesUpdateReply, error)
+	AliasesDelete(ctx context.Context, in *AliasesDeleteRequest, opts ...grpc.CallOption) (*AliasesDeleteReply, error)
+	ObjectsGet(ctx context.Context, in *ObjectsGetRequest, opts ...grpc.CallOption) (*ObjectsGetReply, error)
+	ObjectsPut(ctx context.Context, in *ObjectsPutRequest, opts ...grpc.CallOption) (*ObjectsPutReply, error)
+	ObjectsPatch(ctx context.Context, in *ObjectsPatchRequest, opts ...grpc.CallOption) (*ObjectsPatchReply, error)
+	ObjectsDelete(ctx context.Context, in *ObjectsDeleteRequest, opts ...grpc.CallOption) (*ObjectsDeleteReply, error)
+	ObjectsExists(ctx context.Context, in *ObjectsExistsRequest, opts ...grpc.CallOption) (*ObjectsExistsReply, error)
 }
 
 type weaviateClient struct {
@@ -56,6 +100,16 @@ func (c *weaviateClient) Search(ctx context.Context, in *SearchRequest, opts ...
 	return out, nil
 }
 
+func (c *weaviateClient) SearchBatch(ctx context.Context, in *SearchBatchRequest, opts ...grpc.CallOption) (*SearchBatchReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchBatchReply)
+	err := c.cc.Invoke(ctx, Weaviate_SearchBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *weaviateClient) BatchObjects(ctx context.Context, in *BatchObjectsRequest, opts ...grpc.CallOption) (*BatchObjectsReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BatchObjectsReply)
@@ -119,17 +173,258 @@ func (c *weaviateClient) BatchStream(ctx context.Context, opts ...grpc.CallOptio
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Weaviate_BatchStreamClient = grpc.BidiStreamingClient[BatchStreamRequest, BatchStreamReply]
 
+func (c *weaviateClient) ChangesStream(ctx context.Context, in *ChangesStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChangesStreamReply], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Weaviate_ServiceDesc.Streams[1], Weaviate_ChangesStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ChangesStreamRequest, ChangesStreamReply]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Weaviate_ChangesStreamClient = grpc.ServerStreamingClient[ChangesStreamReply]
+
+func (c *weaviateClient) CollectionsGet(ctx context.Context, in *CollectionsGetRequest, opts ...grpc.CallOption) (*CollectionsGetReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionsGetReply)
+	err := c.cc.Invoke(ctx, Weaviate_CollectionsGet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) CollectionsList(ctx context.Context, in *CollectionsListRequest, opts ...grpc.CallOption) (*CollectionsListReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionsListReply)
+	err := c.cc.Invoke(ctx, Weaviate_CollectionsList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) CollectionsCreate(ctx context.Context, in *CollectionsCreateRequest, opts ...grpc.CallOption) (*CollectionsCreateReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionsCreateReply)
+	err := c.cc.Invoke(ctx, Weaviate_CollectionsCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) CollectionsUpdate(ctx context.Context, in *CollectionsUpdateRequest, opts ...grpc.CallOption) (*CollectionsUpdateReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionsUpdateReply)
+	err := c.cc.Invoke(ctx, Weaviate_CollectionsUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) CollectionsDelete(ctx context.Context, in *CollectionsDeleteRequest, opts ...grpc.CallOption) (*CollectionsDeleteReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionsDeleteReply)
+	err := c.cc.Invoke(ctx, Weaviate_CollectionsDelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) PropertiesAdd(ctx context.Context, in *PropertiesAddRequest, opts ...grpc.CallOption) (*PropertiesAddReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PropertiesAddReply)
+	err := c.cc.Invoke(ctx, Weaviate_PropertiesAdd_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) PropertiesDelete(ctx context.Context, in *PropertiesDeleteRequest, opts ...grpc.CallOption) (*PropertiesDeleteReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PropertiesDeleteReply)
+	err := c.cc.Invoke(ctx, Weaviate_PropertiesDelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) TenantsCreate(ctx context.Context, in *TenantsCreateRequest, opts ...grpc.CallOption) (*TenantsCreateReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantsCreateReply)
+	err := c.cc.Invoke(ctx, Weaviate_TenantsCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) TenantsUpdate(ctx context.Context, in *TenantsUpdateRequest, opts ...grpc.CallOption) (*TenantsUpdateReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantsUpdateReply)
+	err := c.cc.Invoke(ctx, Weaviate_TenantsUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) TenantsDelete(ctx context.Context, in *TenantsDeleteRequest, opts ...grpc.CallOption) (*TenantsDeleteReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantsDeleteReply)
+	err := c.cc.Invoke(ctx, Weaviate_TenantsDelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) AliasesGet(ctx context.Context, in *AliasesGetRequest, opts ...grpc.CallOption) (*AliasesGetReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AliasesGetReply)
+	err := c.cc.Invoke(ctx, Weaviate_AliasesGet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) AliasesList(ctx context.Context, in *AliasesListRequest, opts ...grpc.CallOption) (*AliasesListReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AliasesListReply)
+	err := c.cc.Invoke(ctx, Weaviate_AliasesList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) AliasesCreate(ctx context.Context, in *AliasesCreateRequest, opts ...grpc.CallOption) (*AliasesCreateReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AliasesCreateReply)
+	err := c.cc.Invoke(ctx, Weaviate_AliasesCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) AliasesUpdate(ctx context.Context, in *AliasesUpdateRequest, opts ...grpc.CallOption) (*AliasesUpdateReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AliasesUpdateReply)
+	err := c.cc.Invoke(ctx, Weaviate_AliasesUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) AliasesDelete(ctx context.Context, in *AliasesDeleteRequest, opts ...grpc.CallOption) (*AliasesDeleteReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AliasesDeleteReply)
+	err := c.cc.Invoke(ctx, Weaviate_AliasesDelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ObjectsGet(ctx context.Context, in *ObjectsGetRequest, opts ...grpc.CallOption) (*ObjectsGetReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ObjectsGetReply)
+	err := c.cc.Invoke(ctx, Weaviate_ObjectsGet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ObjectsPut(ctx context.Context, in *ObjectsPutRequest, opts ...grpc.CallOption) (*ObjectsPutReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ObjectsPutReply)
+	err := c.cc.Invoke(ctx, Weaviate_ObjectsPut_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ObjectsPatch(ctx context.Context, in *ObjectsPatchRequest, opts ...grpc.CallOption) (*ObjectsPatchReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ObjectsPatchReply)
+	err := c.cc.Invoke(ctx, Weaviate_ObjectsPatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ObjectsDelete(ctx context.Context, in *ObjectsDeleteRequest, opts ...grpc.CallOption) (*ObjectsDeleteReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ObjectsDeleteReply)
+	err := c.cc.Invoke(ctx, Weaviate_ObjectsDelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ObjectsExists(ctx context.Context, in *ObjectsExistsRequest, opts ...grpc.CallOption) (*ObjectsExistsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ObjectsExistsReply)
+	err := c.cc.Invoke(ctx, Weaviate_ObjectsExists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WeaviateServer is the server API for Weaviate service.
 // All implementations must embed UnimplementedWeaviateServer
 // for forward compatibility.
 type WeaviateServer interface {
 	Search(context.Context, *SearchRequest) (*SearchReply, error)
+	SearchBatch(context.Context, *SearchBatchRequest) (*SearchBatchReply, error)
 	BatchObjects(context.Context, *BatchObjectsRequest) (*BatchObjectsReply, error)
 	BatchReferences(context.Context, *BatchReferencesRequest) (*BatchReferencesReply, error)
 	BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteReply, error)
 	TenantsGet(context.Context, *TenantsGetRequest) (*TenantsGetReply, error)
 	Aggregate(context.Context, *AggregateRequest) (*AggregateReply, error)
 	BatchStream(grpc.BidiStreamingServer[BatchStreamRequest, BatchStreamReply]) error
+	ChangesStream(*ChangesStreamRequest, grpc.ServerStreamingServer[ChangesStreamReply]) error
+	CollectionsGet(context.Context, *CollectionsGetRequest) (*CollectionsGetReply, error)
+	CollectionsList(context.Context, *CollectionsListRequest) (*CollectionsListReply, error)
+	CollectionsCreate(context.Context, *CollectionsCreateRequest) (*CollectionsCreateReply, error)
+	CollectionsUpdate(context.Context, *CollectionsUpdateRequest) (*CollectionsUpdateReply, error)
+	CollectionsDelete(context.Context, *CollectionsDeleteRequest) (*CollectionsDeleteReply, error)
+	PropertiesAdd(context.Context, *PropertiesAddRequest) (*PropertiesAddReply, error)
+	PropertiesDelete(context.Context, *PropertiesDeleteRequest) (*PropertiesDeleteReply, error)
+	TenantsCreate(context.Context, *TenantsCreateRequest) (*TenantsCreateReply, error)
+	TenantsUpdate(context.Context, *TenantsUpdateRequest) (*TenantsUpdateReply, error)
+	TenantsDelete(context.Context, *TenantsDeleteRequest) (*TenantsDeleteReply, error)
+	AliasesGet(context.Context, *AliasesGetRequest) (*AliasesGetReply, error)
+	AliasesList(context.Context, *AliasesListRequest) (*AliasesListReply, error)
+	AliasesCreate(context.Context, *AliasesCreateRequest) (*AliasesCreateReply, error)
+	AliasesUpdate(context.Context, *AliasesUpdateRequest) (*AliasesUpdateReply, error)
+	AliasesDelete(context.Context, *AliasesDeleteRequest) (*AliasesDeleteReply, error)
+	ObjectsGet(context.Context, *ObjectsGetRequest) (*ObjectsGetReply, error)
+	ObjectsPut(context.Context, *ObjectsPutRequest) (*ObjectsPutReply, error)
+	ObjectsPatch(context.Context, *ObjectsPatchRequest) (*ObjectsPatchReply, error)
+	ObjectsDelete(context.Context, *ObjectsDeleteRequest) (*ObjectsDeleteReply, error)
+	ObjectsExists(context.Context, *ObjectsExistsRequest) (*ObjectsExistsReply, error)
 	mustEmbedUnimplementedWeaviateServer()
 }
 
@@ -143,6 +438,9 @@ type UnimplementedWeaviateServer struct{}
 func (UnimplementedWeaviateServer) Search(context.Context, *SearchRequest) (*SearchReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method Search not implemented")
 }
+func (UnimplementedWeaviateServer) SearchBatch(context.Context, *SearchBatchRequest) (*SearchBatchReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchBatch not implemented")
+}
 func (UnimplementedWeaviateServer) BatchObjects(context.Context, *BatchObjectsRequest) (*BatchObjectsReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method BatchObjects not implemented")
 }
@@ -161,6 +459,69 @@ func (UnimplementedWeaviateServer) Aggregate(context.Context, *AggregateRequest)
 func (UnimplementedWeaviateServer) BatchStream(grpc.BidiStreamingServer[BatchStreamRequest, BatchStreamReply]) error {
 	return status.Error(codes.Unimplemented, "method BatchStream not implemented")
 }
+func (UnimplementedWeaviateServer) ChangesStream(*ChangesStreamRequest, grpc.ServerStreamingServer[ChangesStreamReply]) error {
+	return status.Error(codes.Unimplemented, "method ChangesStream not implemented")
+}
+func (UnimplementedWeaviateServer) CollectionsGet(context.Context, *CollectionsGetRequest) (*CollectionsGetReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CollectionsGet not implemented")
+}
+func (UnimplementedWeaviateServer) CollectionsList(context.Context, *CollectionsListRequest) (*CollectionsListReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CollectionsList not implemented")
+}
+func (UnimplementedWeaviateServer) CollectionsCreate(context.Context, *CollectionsCreateRequest) (*CollectionsCreateReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CollectionsCreate not implemented")
+}
+func (UnimplementedWeaviateServer) CollectionsUpdate(context.Context, *CollectionsUpdateRequest) (*CollectionsUpdateReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CollectionsUpdate not implemented")
+}
+func (UnimplementedWeaviateServer) CollectionsDelete(context.Context, *CollectionsDeleteRequest) (*CollectionsDeleteReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CollectionsDelete not implemented")
+}
+func (UnimplementedWeaviateServer) PropertiesAdd(context.Context, *PropertiesAddRequest) (*PropertiesAddReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method PropertiesAdd not implemented")
+}
+func (UnimplementedWeaviateServer) PropertiesDelete(context.Context, *PropertiesDeleteRequest) (*PropertiesDeleteReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method PropertiesDelete not implemented")
+}
+func (UnimplementedWeaviateServer) TenantsCreate(context.Context, *TenantsCreateRequest) (*TenantsCreateReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method TenantsCreate not implemented")
+}
+func (UnimplementedWeaviateServer) TenantsUpdate(context.Context, *TenantsUpdateRequest) (*TenantsUpdateReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method TenantsUpdate not implemented")
+}
+func (UnimplementedWeaviateServer) TenantsDelete(context.Context, *TenantsDeleteRequest) (*TenantsDeleteReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method TenantsDelete not implemented")
+}
+func (UnimplementedWeaviateServer) AliasesGet(context.Context, *AliasesGetRequest) (*AliasesGetReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method AliasesGet not implemented")
+}
+func (UnimplementedWeaviateServer) AliasesList(context.Context, *AliasesListRequest) (*AliasesListReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method AliasesList not implemented")
+}
+func (UnimplementedWeaviateServer) AliasesCreate(context.Context, *AliasesCreateRequest) (*AliasesCreateReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method AliasesCreate not implemented")
+}
+func (UnimplementedWeaviateServer) AliasesUpdate(context.Context, *AliasesUpdateRequest) (*AliasesUpdateReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method AliasesUpdate not implemented")
+}
+func (UnimplementedWeaviateServer) AliasesDelete(context.Context, *AliasesDeleteRequest) (*AliasesDeleteReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method AliasesDelete not implemented")
+}
+func (UnimplementedWeaviateServer) ObjectsGet(context.Context, *ObjectsGetRequest) (*ObjectsGetReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ObjectsGet not implemented")
+}
+func (UnimplementedWeaviateServer) ObjectsPut(context.Context, *ObjectsPutRequest) (*ObjectsPutReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ObjectsPut not implemented")
+}
+func (UnimplementedWeaviateServer) ObjectsPatch(context.Context, *ObjectsPatchRequest) (*ObjectsPatchReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ObjectsPatch not implemented")
+}
+func (UnimplementedWeaviateServer) ObjectsDelete(context.Context, *ObjectsDeleteRequest) (*ObjectsDeleteReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ObjectsDelete not implemented")
+}
+func (UnimplementedWeaviateServer) ObjectsExists(context.Context, *ObjectsExistsRequest) (*ObjectsExistsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ObjectsExists not implemented")
+}
 func (UnimplementedWeaviateServer) mustEmbedUnimplementedWeaviateServer() {}
 func (UnimplementedWeaviateServer) testEmbeddedByValue()                  {}
 
@@ -200,6 +561,24 @@ func _Weaviate_Search_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Weaviate_SearchBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).SearchBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Weaviate_SearchBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).SearchBatch(ctx, req.(*SearchBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Weaviate_BatchObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BatchObjectsRequest)
 	if err := dec(in); err != nil {
@@ -297,6 +676,377 @@ func _Weaviate_BatchStream_Handler(srv interface{}, stream grpc.ServerStream) er
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Weaviate_BatchStreamServer = grpc.BidiStreamingServer[BatchStreamRequest, BatchStreamReply]
 
+func _Weaviate_ChangesStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ChangesStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WeaviateServer).ChangesStream(m, &grpc.GenericServerStream[ChangesStreamRequest, ChangesStreamReply]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Weaviate_ChangesStreamServer = grpc.ServerStreamingServer[ChangesStreamReply]
+
+func _Weaviate_CollectionsGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionsGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).CollectionsGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Weaviate_CollectionsGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).CollectionsGet(ctx, req.(*CollectionsGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_CollectionsList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionsListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).CollectionsList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Weaviate_CollectionsList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).CollectionsList(ctx, req.(*CollectionsListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_CollectionsCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionsCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).CollectionsCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Weaviate_CollectionsCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).CollectionsCreate(ctx, req.(*CollectionsCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_CollectionsUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionsUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).CollectionsUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Weaviate_CollectionsUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).CollectionsUpdate(ctx, req.(*CollectionsUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_CollectionsDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionsDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).CollectionsDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Weaviate_CollectionsDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).CollectionsDelete(ctx, req.(*CollectionsDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_PropertiesAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PropertiesAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).PropertiesAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Weaviate_PropertiesAdd_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).PropertiesAdd(ctx, req.(*PropertiesAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_PropertiesDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PropertiesDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).PropertiesDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Weaviate_PropertiesDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).PropertiesDelete(ctx, req.(*PropertiesDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_TenantsCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantsCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).TenantsCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Weaviate_TenantsCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).TenantsCreate(ctx, req.(*TenantsCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_TenantsUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantsUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).TenantsUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Weaviate_TenantsUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).TenantsUpdate(ctx, req.(*TenantsUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_TenantsDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantsDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).TenantsDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Weaviate_TenantsDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).TenantsDelete(ctx, req.(*TenantsDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_AliasesGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AliasesGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).AliasesGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Weaviate_AliasesGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).AliasesGet(ctx, req.(*AliasesGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_AliasesList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AliasesListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).AliasesList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Weaviate_AliasesList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).AliasesList(ctx, req.(*AliasesListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_AliasesCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AliasesCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).AliasesCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Weaviate_AliasesCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).AliasesCreate(ctx, req.(*AliasesCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_AliasesUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AliasesUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).AliasesUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Weaviate_AliasesUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).AliasesUpdate(ctx, req.(*AliasesUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_AliasesDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AliasesDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).AliasesDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Weaviate_AliasesDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).AliasesDelete(ctx, req.(*AliasesDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ObjectsGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectsGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ObjectsGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Weaviate_ObjectsGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ObjectsGet(ctx, req.(*ObjectsGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ObjectsPut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectsPutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ObjectsPut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Weaviate_ObjectsPut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ObjectsPut(ctx, req.(*ObjectsPutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ObjectsPatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectsPatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ObjectsPatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Weaviate_ObjectsPatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ObjectsPatch(ctx, req.(*ObjectsPatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ObjectsDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectsDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ObjectsDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Weaviate_ObjectsDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ObjectsDelete(ctx, req.(*ObjectsDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ObjectsExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectsExistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ObjectsExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Weaviate_ObjectsExists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ObjectsExists(ctx, req.(*ObjectsExistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Weaviate_ServiceDesc is the grpc.ServiceDesc for Weaviate service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -308,6 +1058,10 @@ var Weaviate_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Search",
 			Handler:    _Weaviate_Search_Handler,
 		},
+		{
+			MethodName: "SearchBatch",
+			Handler:    _Weaviate_SearchBatch_Handler,
+		},
 		{
 			MethodName: "BatchObjects",
 			Handler:    _Weaviate_BatchObjects_Handler,
@@ -328,6 +1082,86 @@ var Weaviate_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Aggregate",
 			Handler:    _Weaviate_Aggregate_Handler,
 		},
+		{
+			MethodName: "CollectionsGet",
+			Handler:    _Weaviate_CollectionsGet_Handler,
+		},
+		{
+			MethodName: "CollectionsList",
+			Handler:    _Weaviate_CollectionsList_Handler,
+		},
+		{
+			MethodName: "CollectionsCreate",
+			Handler:    _Weaviate_CollectionsCreate_Handler,
+		},
+		{
+			MethodName: "CollectionsUpdate",
+			Handler:    _Weaviate_CollectionsUpdate_Handler,
+		},
+		{
+			MethodName: "CollectionsDelete",
+			Handler:    _Weaviate_CollectionsDelete_Handler,
+		},
+		{
+			MethodName: "PropertiesAdd",
+			Handler:    _Weaviate_PropertiesAdd_Handler,
+		},
+		{
+			MethodName: "PropertiesDelete",
+			Handler:    _Weaviate_PropertiesDelete_Handler,
+		},
+		{
+			MethodName: "TenantsCreate",
+			Handler:    _Weaviate_TenantsCreate_Handler,
+		},
+		{
+			MethodName: "TenantsUpdate",
+			Handler:    _Weaviate_TenantsUpdate_Handler,
+		},
+		{
+			MethodName: "TenantsDelete",
+			Handler:    _Weaviate_TenantsDelete_Handler,
+		},
+		{
+			MethodName: "AliasesGet",
+			Handler:    _Weaviate_AliasesGet_Handler,
+		},
+		{
+			MethodName: "AliasesList",
+			Handler:    _Weaviate_AliasesList_Handler,
+		},
+		{
+			MethodName: "AliasesCreate",
+			Handler:    _Weaviate_AliasesCreate_Handler,
+		},
+		{
+			MethodName: "AliasesUpdate",
+			Handler:    _Weaviate_AliasesUpdate_Handler,
+		},
+		{
+			MethodName: "AliasesDelete",
+			Handler:    _Weaviate_AliasesDelete_Handler,
+		},
+		{
+			MethodName: "ObjectsGet",
+			Handler:    _Weaviate_ObjectsGet_Handler,
+		},
+		{
+			MethodName: "ObjectsPut",
+			Handler:    _Weaviate_ObjectsPut_Handler,
+		},
+		{
+			MethodName: "ObjectsPatch",
+			Handler:    _Weaviate_ObjectsPatch_Handler,
+		},
+		{
+			MethodName: "ObjectsDelete",
+			Handler:    _Weaviate_ObjectsDelete_Handler,
+		},
+		{
+			MethodName: "ObjectsExists",
+			Handler:    _Weaviate_ObjectsExists_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -336,6 +1170,11 @@ var Weaviate_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "ChangesStream",
+			Handler:       _Weaviate_ChangesStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "v1/weaviate.proto",
 }
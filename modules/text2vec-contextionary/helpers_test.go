@@ -22,6 +22,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/explore"
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/get"
 	test_helper "github.com/weaviate/weaviate/adapters/handlers/graphql/test/helper"
+	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
@@ -370,6 +371,13 @@ func (m *mockResolver) GetClass(ctx context.Context, principal *models.Principal
 	return args.Get(0).([]interface{}), args.Error(1)
 }
 
+func (m *mockResolver) GetClassWithFacets(ctx context.Context, principal *models.Principal,
+	params dto.GetParams,
+) ([]interface{}, []*additional.FacetResult, error) {
+	args := m.Called(params)
+	return args.Get(0).([]interface{}), args.Get(1).([]*additional.FacetResult), args.Error(2)
+}
+
 func (m *mockResolver) Explore(ctx context.Context,
 	principal *models.Principal, params traverser.ExploreParams,
 ) ([]search.Result, error) {
@@ -380,6 +388,7 @@ func (m *mockResolver) Explore(ctx context.Context,
 // Resolver is a local abstraction of the required UC resolvers
 type GetResolver interface {
 	GetClass(ctx context.Context, principal *models.Principal, info dto.GetParams) ([]interface{}, error)
+	GetClassWithFacets(ctx context.Context, principal *models.Principal, info dto.GetParams) ([]interface{}, []*additional.FacetResult, error)
 }
 
 type ExploreResolver interface {
@@ -72,6 +72,8 @@ func startMinIO(ctx context.Context, networkName string, buckets map[string]stri
 	envSettings["BACKUP_S3_ENDPOINT"] = fmt.Sprintf("%s:%s", MinIO, port.Port())
 	envSettings["OFFLOAD_S3_ENDPOINT"] = fmt.Sprintf("http://%s:%s", MinIO, port.Port())
 	envSettings["BACKUP_S3_USE_SSL"] = "false"
+	envSettings["SEGMENT_TIERING_S3_ENDPOINT"] = fmt.Sprintf("%s:%s", MinIO, port.Port())
+	envSettings["SEGMENT_TIERING_S3_USE_SSL"] = "false"
 	envSettings["AWS_ACCESS_KEY_ID"] = "aws_access_key"
 	envSettings["AWS_SECRET_KEY"] = "aws_secret_key"
 	endpoints := make(map[EndpointName]endpoint)
@@ -62,7 +62,19 @@ func (v *Vectors) UnmarshalJSON(data []byte) error {
 				}
 				continue
 			}
-			return fmt.Errorf("vectors: cannot unmarshal vector into either []float32 or [][]float32 for target vector %s", targetVector)
+			// Try unmarshaling as SparseVector
+			var sparseVector SparseVector
+			if err := json.Unmarshal(rawMessage, &sparseVector); err == nil {
+				if len(sparseVector.Indices) != len(sparseVector.Values) {
+					return fmt.Errorf("vectors: sparse vector for target vector %s has %d indices but %d values",
+						targetVector, len(sparseVector.Indices), len(sparseVector.Values))
+				}
+				if len(sparseVector.Indices) > 0 {
+					(*v)[targetVector] = &sparseVector
+				}
+				continue
+			}
+			return fmt.Errorf("vectors: cannot unmarshal vector into either []float32, [][]float32 or a sparse vector for target vector %s", targetVector)
 		}
 	}
 	return nil
@@ -523,6 +523,12 @@ func (f *fakeRemoteClient) GetShardStatus(ctx context.Context,
 	return "", nil
 }
 
+func (f *fakeRemoteClient) GetShardReindexed(ctx context.Context,
+	hostName, indexName, shardName, task string,
+) (bool, error) {
+	return false, nil
+}
+
 func (f *fakeRemoteClient) UpdateShardStatus(ctx context.Context, hostName, indexName, shardName,
 	targetStatus string, schemaVersion uint64,
 ) error {
@@ -0,0 +1,39 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package coldstorage stores files which are rarely read, such as old LSM
+// segments, in object storage. Files are addressed by keys of slash
+// separated paths and are only ever written as a whole, read as a whole and
+// deleted, which is supported by S3-compatible object stores and plain
+// directories alike.
+package coldstorage
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrNotFound = errors.New("object not found")
+
+// Backend is the object storage files are moved to. Implementations need to
+// be safe for concurrent use.
+type Backend interface {
+	// Upload copies the local file to the object with the given key,
+	// overwriting the object if it exists
+	Upload(ctx context.Context, key, path string) error
+	// Download copies the object to the local path. The file is written
+	// completely or not at all, it returns ErrNotFound if there is no object
+	// with the key
+	Download(ctx context.Context, key, path string) error
+	// Delete removes the object, deleting an object which does not exist is
+	// not an error
+	Delete(ctx context.Context, key string) error
+}
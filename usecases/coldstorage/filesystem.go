@@ -0,0 +1,126 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package coldstorage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/diskio"
+)
+
+// Filesystem stores the objects as files below a root directory, e.g. on a
+// network file system or as a local stand-in for an object store in tests
+type Filesystem struct {
+	root string
+}
+
+func NewFilesystem(root string) (*Filesystem, error) {
+	if err := os.MkdirAll(root, os.ModePerm); err != nil {
+		return nil, fmt.Errorf("create root directory %q: %w", root, err)
+	}
+	return &Filesystem{root: root}, nil
+}
+
+func (f *Filesystem) Upload(ctx context.Context, key, path string) error {
+	target, err := f.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(target), os.ModePerm); err != nil {
+		return fmt.Errorf("create directory of %q: %w", key, err)
+	}
+	if err := copyFile(ctx, path, target); err != nil {
+		return fmt.Errorf("upload %q: %w", key, err)
+	}
+	return nil
+}
+
+func (f *Filesystem) Download(ctx context.Context, key, path string) error {
+	source, err := f.path(key)
+	if err != nil {
+		return err
+	}
+	if err := copyFile(ctx, source, path); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("download %q: %w", key, ErrNotFound)
+		}
+		return fmt.Errorf("download %q: %w", key, err)
+	}
+	return nil
+}
+
+func (f *Filesystem) Delete(ctx context.Context, key string) error {
+	target, err := f.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(target); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("delete %q: %w", key, err)
+	}
+	return nil
+}
+
+// path returns the file of the object, keys must not point outside of the
+// root directory
+func (f *Filesystem) path(key string) (string, error) {
+	rel := filepath.FromSlash(key)
+	if key == "" || !filepath.IsLocal(rel) || strings.HasSuffix(key, "/") {
+		return "", fmt.Errorf("invalid key %q", key)
+	}
+	return filepath.Join(f.root, rel), nil
+}
+
+// copyFile writes the copy to a temporary file first, which is renamed once
+// it is complete, so that readers never see partial files
+func copyFile(ctx context.Context, source, target string) (err error) {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	in, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	tmp := target + ".tmp"
+	out, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			out.Close()
+			os.Remove(tmp)
+		}
+	}()
+
+	if _, err := io.Copy(out, in); err != nil {
+		return err
+	}
+	if err := out.Sync(); err != nil {
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, target); err != nil {
+		return err
+	}
+	return diskio.Fsync(filepath.Dir(target))
+}
@@ -0,0 +1,72 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package coldstorage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilesystem(t *testing.T) {
+	ctx := context.Background()
+	local := t.TempDir()
+	fs, err := NewFilesystem(filepath.Join(t.TempDir(), "cold"))
+	require.Nil(t, err)
+
+	source := filepath.Join(local, "segment-1.db")
+	require.Nil(t, os.WriteFile(source, []byte("segment contents"), 0o644))
+
+	t.Run("upload and download", func(t *testing.T) {
+		require.Nil(t, fs.Upload(ctx, "node1/Articles/shard1/segment-1.db", source))
+
+		target := filepath.Join(local, "downloaded.db")
+		require.Nil(t, fs.Download(ctx, "node1/Articles/shard1/segment-1.db", target))
+		contents, err := os.ReadFile(target)
+		require.Nil(t, err)
+		assert.Equal(t, "segment contents", string(contents))
+
+		_, err = os.Stat(target + ".tmp")
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("upload overwrites", func(t *testing.T) {
+		other := filepath.Join(local, "segment-2.db")
+		require.Nil(t, os.WriteFile(other, []byte("other contents"), 0o644))
+		require.Nil(t, fs.Upload(ctx, "node1/Articles/shard1/segment-1.db", other))
+
+		target := filepath.Join(local, "overwritten.db")
+		require.Nil(t, fs.Download(ctx, "node1/Articles/shard1/segment-1.db", target))
+		contents, err := os.ReadFile(target)
+		require.Nil(t, err)
+		assert.Equal(t, "other contents", string(contents))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.Nil(t, fs.Delete(ctx, "node1/Articles/shard1/segment-1.db"))
+		err := fs.Download(ctx, "node1/Articles/shard1/segment-1.db", filepath.Join(local, "deleted.db"))
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		// deleting again is not an error
+		require.Nil(t, fs.Delete(ctx, "node1/Articles/shard1/segment-1.db"))
+	})
+
+	t.Run("keys outside of the root are rejected", func(t *testing.T) {
+		for _, key := range []string{"", "../segment-1.db", "/etc/passwd", "node1/"} {
+			assert.NotNil(t, fs.Upload(ctx, key, source), key)
+		}
+	})
+}
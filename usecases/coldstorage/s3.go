@@ -0,0 +1,118 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package coldstorage
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+type S3Config struct {
+	Endpoint string
+	Bucket   string
+	// Path is prepended to the keys of all objects, optional
+	Path   string
+	UseSSL bool
+}
+
+// S3 stores the objects in a bucket of AWS S3 or an S3-compatible object
+// store such as MinIO. The credentials are read from the environment like
+// for the backups: AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY, or the IAM
+// role of the instance if they are not set.
+type S3 struct {
+	client *minio.Client
+	config S3Config
+}
+
+func NewS3(config S3Config) (*S3, error) {
+	region := os.Getenv("AWS_REGION")
+	if len(region) == 0 {
+		region = os.Getenv("AWS_DEFAULT_REGION")
+	}
+
+	var creds *credentials.Credentials
+	if (os.Getenv("AWS_ACCESS_KEY_ID") != "" || os.Getenv("AWS_ACCESS_KEY") != "") &&
+		(os.Getenv("AWS_SECRET_ACCESS_KEY") != "" || os.Getenv("AWS_SECRET_KEY") != "") {
+		creds = credentials.NewEnvAWS()
+	} else {
+		creds = credentials.NewIAM("")
+		if _, err := creds.GetWithContext(nil); err != nil {
+			// can be anonymous access
+			creds = credentials.NewEnvAWS()
+		}
+	}
+
+	client, err := minio.New(config.Endpoint, &minio.Options{
+		Creds:  creds,
+		Region: region,
+		Secure: config.UseSSL,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create s3 client: %w", err)
+	}
+	return &S3{client: client, config: config}, nil
+}
+
+// VerifyBucket returns an error if the bucket does not exist or is not
+// accessible with the credentials
+func (s *S3) VerifyBucket(ctx context.Context) error {
+	exists, err := s.client.BucketExists(ctx, s.config.Bucket)
+	if err != nil {
+		return fmt.Errorf("check bucket %q: %w", s.config.Bucket, err)
+	}
+	if !exists {
+		return fmt.Errorf("bucket %q does not exist", s.config.Bucket)
+	}
+	return nil
+}
+
+func (s *S3) Upload(ctx context.Context, key, filePath string) error {
+	_, err := s.client.FPutObject(ctx, s.config.Bucket, s.objectName(key), filePath,
+		minio.PutObjectOptions{ContentType: "application/octet-stream"})
+	if err != nil {
+		return fmt.Errorf("upload %q: %w", key, err)
+	}
+	return nil
+}
+
+// Download relies on FGetObject writing to a temporary file, which is only
+// renamed to the path once the object was read completely
+func (s *S3) Download(ctx context.Context, key, filePath string) error {
+	err := s.client.FGetObject(ctx, s.config.Bucket, s.objectName(key), filePath,
+		minio.GetObjectOptions{})
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return fmt.Errorf("download %q: %w", key, ErrNotFound)
+		}
+		return fmt.Errorf("download %q: %w", key, err)
+	}
+	return nil
+}
+
+func (s *S3) Delete(ctx context.Context, key string) error {
+	// deleting an object which does not exist succeeds
+	err := s.client.RemoveObject(ctx, s.config.Bucket, s.objectName(key),
+		minio.RemoveObjectOptions{})
+	if err != nil {
+		return fmt.Errorf("delete %q: %w", key, err)
+	}
+	return nil
+}
+
+func (s *S3) objectName(key string) string {
+	return path.Join(s.config.Path, key)
+}
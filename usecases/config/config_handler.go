@@ -17,6 +17,7 @@ import (
 	"math"
 	"os"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -104,64 +105,70 @@ type RuntimeOverrides struct {
 
 // Config outline of the config file
 type Config struct {
-	Name                                string                   `json:"name" yaml:"name"`
-	Debug                               bool                     `json:"debug" yaml:"debug"`
-	QueryDefaults                       QueryDefaults            `json:"query_defaults" yaml:"query_defaults"`
-	QueryMaximumResults                 int64                    `json:"query_maximum_results" yaml:"query_maximum_results"`
-	QueryHybridMaximumResults           int64                    `json:"query_hybrid_maximum_results" yaml:"query_hybrid_maximum_results"`
-	QueryNestedCrossReferenceLimit      int64                    `json:"query_nested_cross_reference_limit" yaml:"query_nested_cross_reference_limit"`
-	QueryCrossReferenceDepthLimit       int                      `json:"query_cross_reference_depth_limit" yaml:"query_cross_reference_depth_limit"`
-	Contextionary                       Contextionary            `json:"contextionary" yaml:"contextionary"`
-	Authentication                      Authentication           `json:"authentication" yaml:"authentication"`
-	Authorization                       Authorization            `json:"authorization" yaml:"authorization"`
-	Origin                              string                   `json:"origin" yaml:"origin"`
-	Persistence                         Persistence              `json:"persistence" yaml:"persistence"`
-	DefaultVectorizerModule             string                   `json:"default_vectorizer_module" yaml:"default_vectorizer_module"`
-	DefaultVectorDistanceMetric         string                   `json:"default_vector_distance_metric" yaml:"default_vector_distance_metric"`
-	EnableModules                       string                   `json:"enable_modules" yaml:"enable_modules"`
-	EnableApiBasedModules               bool                     `json:"api_based_modules_disabled" yaml:"api_based_modules_disabled"`
-	ModulesPath                         string                   `json:"modules_path" yaml:"modules_path"`
-	ModuleHttpClientTimeout             time.Duration            `json:"modules_client_timeout" yaml:"modules_client_timeout"`
-	AutoSchema                          AutoSchema               `json:"auto_schema" yaml:"auto_schema"`
-	Cluster                             cluster.Config           `json:"cluster" yaml:"cluster"`
-	Replication                         replication.GlobalConfig `json:"replication" yaml:"replication"`
-	Monitoring                          monitoring.Config        `json:"monitoring" yaml:"monitoring"`
-	GRPC                                GRPC                     `json:"grpc" yaml:"grpc"`
-	Profiling                           Profiling                `json:"profiling" yaml:"profiling"`
-	ResourceUsage                       ResourceUsage            `json:"resource_usage" yaml:"resource_usage"`
-	MaxImportGoroutinesFactor           float64                  `json:"max_import_goroutine_factor" yaml:"max_import_goroutine_factor"`
-	MaximumConcurrentGetRequests        int                      `json:"maximum_concurrent_get_requests" yaml:"maximum_concurrent_get_requests"`
-	MaximumConcurrentShardLoads         int                      `json:"maximum_concurrent_shard_loads" yaml:"maximum_concurrent_shard_loads"`
-	TrackVectorDimensions               bool                     `json:"track_vector_dimensions" yaml:"track_vector_dimensions"`
-	TrackVectorDimensionsInterval       time.Duration            `json:"track_vector_dimensions_interval" yaml:"track_vector_dimensions_interval"`
-	ReindexVectorDimensionsAtStartup    bool                     `json:"reindex_vector_dimensions_at_startup" yaml:"reindex_vector_dimensions_at_startup"`
-	DisableLazyLoadShards               bool                     `json:"disable_lazy_load_shards" yaml:"disable_lazy_load_shards"`
-	ForceFullReplicasSearch             bool                     `json:"force_full_replicas_search" yaml:"force_full_replicas_search"`
-	TransferInactivityTimeout           time.Duration            `json:"transfer_inactivity_timeout" yaml:"transfer_inactivity_timeout"`
-	RecountPropertiesAtStartup          bool                     `json:"recount_properties_at_startup" yaml:"recount_properties_at_startup"`
-	ReindexSetToRoaringsetAtStartup     bool                     `json:"reindex_set_to_roaringset_at_startup" yaml:"reindex_set_to_roaringset_at_startup"`
-	ReindexerGoroutinesFactor           float64                  `json:"reindexer_goroutines_factor" yaml:"reindexer_goroutines_factor"`
-	ReindexMapToBlockmaxAtStartup       bool                     `json:"reindex_map_to_blockmax_at_startup" yaml:"reindex_map_to_blockmax_at_startup"`
-	ReindexMapToBlockmaxConfig          MapToBlockamaxConfig     `json:"reindex_map_to_blockmax_config" yaml:"reindex_map_to_blockmax_config"`
-	IndexMissingTextFilterableAtStartup bool                     `json:"index_missing_text_filterable_at_startup" yaml:"index_missing_text_filterable_at_startup"`
-	DisableGraphQL                      bool                     `json:"disable_graphql" yaml:"disable_graphql"`
-	AvoidMmap                           bool                     `json:"avoid_mmap" yaml:"avoid_mmap"`
-	CORS                                CORS                     `json:"cors" yaml:"cors"`
-	DisableTelemetry                    bool                     `json:"disable_telemetry" yaml:"disable_telemetry"`
-	HNSWStartupWaitForVectorCache       bool                     `json:"hnsw_startup_wait_for_vector_cache" yaml:"hnsw_startup_wait_for_vector_cache"`
-	HNSWVisitedListPoolMaxSize          int                      `json:"hnsw_visited_list_pool_max_size" yaml:"hnsw_visited_list_pool_max_size"`
-	HNSWFlatSearchConcurrency           int                      `json:"hnsw_flat_search_concurrency" yaml:"hnsw_flat_search_concurrency"`
-	HNSWAcornFilterRatio                float64                  `json:"hnsw_acorn_filter_ratio" yaml:"hnsw_acorn_filter_ratio"`
-	HNSWGeoIndexEF                      int                      `json:"hnsw_geo_index_ef" yaml:"hnsw_geo_index_ef"`
-	AsyncIndexingEnabled                bool                     `json:"async_indexing_enabled" yaml:"async_indexing_enabled"`
-	Sentry                              *entsentry.ConfigOpts    `json:"sentry" yaml:"sentry"`
-	MetadataServer                      MetadataServer           `json:"metadata_server" yaml:"metadata_server"`
-	SchemaHandlerConfig                 SchemaHandlerConfig      `json:"schema" yaml:"schema"`
-	DistributedTasks                    DistributedTasksConfig   `json:"distributed_tasks" yaml:"distributed_tasks"`
-	ReplicationEngineMaxWorkers         int                      `json:"replication_engine_max_workers" yaml:"replication_engine_max_workers"`
-	ReplicationEngineFileCopyWorkers    int                      `json:"replication_engine_file_copy_workers" yaml:"replication_engine_file_copy_workers"`
-	HFreshEnabled                       bool                     `json:"hfresh_enabled" yaml:"hfresh_enabled"`
-	ReplicationEngineFileCopyChunkSize  int                      `json:"replication_engine_file_copy_chunk_size" yaml:"replication_engine_file_copy_chunk_size"`
+	Name                                string                    `json:"name" yaml:"name"`
+	Debug                               bool                      `json:"debug" yaml:"debug"`
+	QueryDefaults                       QueryDefaults             `json:"query_defaults" yaml:"query_defaults"`
+	QueryMaximumResults                 int64                     `json:"query_maximum_results" yaml:"query_maximum_results"`
+	QueryHybridMaximumResults           int64                     `json:"query_hybrid_maximum_results" yaml:"query_hybrid_maximum_results"`
+	QueryNestedCrossReferenceLimit      int64                     `json:"query_nested_cross_reference_limit" yaml:"query_nested_cross_reference_limit"`
+	QueryCrossReferenceDepthLimit       int                       `json:"query_cross_reference_depth_limit" yaml:"query_cross_reference_depth_limit"`
+	Contextionary                       Contextionary             `json:"contextionary" yaml:"contextionary"`
+	Authentication                      Authentication            `json:"authentication" yaml:"authentication"`
+	Authorization                       Authorization             `json:"authorization" yaml:"authorization"`
+	Origin                              string                    `json:"origin" yaml:"origin"`
+	Persistence                         Persistence               `json:"persistence" yaml:"persistence"`
+	DefaultVectorizerModule             string                    `json:"default_vectorizer_module" yaml:"default_vectorizer_module"`
+	DefaultVectorDistanceMetric         string                    `json:"default_vector_distance_metric" yaml:"default_vector_distance_metric"`
+	EnableModules                       string                    `json:"enable_modules" yaml:"enable_modules"`
+	EnableApiBasedModules               bool                      `json:"api_based_modules_disabled" yaml:"api_based_modules_disabled"`
+	ModulesPath                         string                    `json:"modules_path" yaml:"modules_path"`
+	ModuleHttpClientTimeout             time.Duration             `json:"modules_client_timeout" yaml:"modules_client_timeout"`
+	AutoSchema                          AutoSchema                `json:"auto_schema" yaml:"auto_schema"`
+	Cluster                             cluster.Config            `json:"cluster" yaml:"cluster"`
+	Replication                         replication.GlobalConfig  `json:"replication" yaml:"replication"`
+	Monitoring                          monitoring.Config         `json:"monitoring" yaml:"monitoring"`
+	GRPC                                GRPC                      `json:"grpc" yaml:"grpc"`
+	Profiling                           Profiling                 `json:"profiling" yaml:"profiling"`
+	ResourceUsage                       ResourceUsage             `json:"resource_usage" yaml:"resource_usage"`
+	MaxImportGoroutinesFactor           float64                   `json:"max_import_goroutine_factor" yaml:"max_import_goroutine_factor"`
+	MaximumConcurrentGetRequests        int                       `json:"maximum_concurrent_get_requests" yaml:"maximum_concurrent_get_requests"`
+	MaximumConcurrentShardLoads         int                       `json:"maximum_concurrent_shard_loads" yaml:"maximum_concurrent_shard_loads"`
+	TrackVectorDimensions               bool                      `json:"track_vector_dimensions" yaml:"track_vector_dimensions"`
+	TrackVectorDimensionsInterval       time.Duration             `json:"track_vector_dimensions_interval" yaml:"track_vector_dimensions_interval"`
+	ReindexVectorDimensionsAtStartup    bool                      `json:"reindex_vector_dimensions_at_startup" yaml:"reindex_vector_dimensions_at_startup"`
+	DisableLazyLoadShards               bool                      `json:"disable_lazy_load_shards" yaml:"disable_lazy_load_shards"`
+	ForceFullReplicasSearch             bool                      `json:"force_full_replicas_search" yaml:"force_full_replicas_search"`
+	TransferInactivityTimeout           time.Duration             `json:"transfer_inactivity_timeout" yaml:"transfer_inactivity_timeout"`
+	RecountPropertiesAtStartup          bool                      `json:"recount_properties_at_startup" yaml:"recount_properties_at_startup"`
+	ReindexSetToRoaringsetAtStartup     bool                      `json:"reindex_set_to_roaringset_at_startup" yaml:"reindex_set_to_roaringset_at_startup"`
+	ReindexerGoroutinesFactor           float64                   `json:"reindexer_goroutines_factor" yaml:"reindexer_goroutines_factor"`
+	ReindexMapToBlockmaxAtStartup       bool                      `json:"reindex_map_to_blockmax_at_startup" yaml:"reindex_map_to_blockmax_at_startup"`
+	ReindexMapToBlockmaxConfig          MapToBlockamaxConfig      `json:"reindex_map_to_blockmax_config" yaml:"reindex_map_to_blockmax_config"`
+	ReindexSearchablePositionsAtStartup bool                      `json:"reindex_searchable_positions_at_startup" yaml:"reindex_searchable_positions_at_startup"`
+	ReindexSearchablePositionsConfig    SearchablePositionsConfig `json:"reindex_searchable_positions_config" yaml:"reindex_searchable_positions_config"`
+	IndexMissingTextFilterableAtStartup bool                      `json:"index_missing_text_filterable_at_startup" yaml:"index_missing_text_filterable_at_startup"`
+	DisableGraphQL                      bool                      `json:"disable_graphql" yaml:"disable_graphql"`
+	AvoidMmap                           bool                      `json:"avoid_mmap" yaml:"avoid_mmap"`
+	CORS                                CORS                      `json:"cors" yaml:"cors"`
+	DisableTelemetry                    bool                      `json:"disable_telemetry" yaml:"disable_telemetry"`
+	HNSWStartupWaitForVectorCache       bool                      `json:"hnsw_startup_wait_for_vector_cache" yaml:"hnsw_startup_wait_for_vector_cache"`
+	HNSWVisitedListPoolMaxSize          int                       `json:"hnsw_visited_list_pool_max_size" yaml:"hnsw_visited_list_pool_max_size"`
+	HNSWFlatSearchConcurrency           int                       `json:"hnsw_flat_search_concurrency" yaml:"hnsw_flat_search_concurrency"`
+	HNSWAcornFilterRatio                float64                   `json:"hnsw_acorn_filter_ratio" yaml:"hnsw_acorn_filter_ratio"`
+	HNSWGeoIndexEF                      int                       `json:"hnsw_geo_index_ef" yaml:"hnsw_geo_index_ef"`
+	AsyncIndexingEnabled                bool                      `json:"async_indexing_enabled" yaml:"async_indexing_enabled"`
+	ChangeStreamRetention               int                       `json:"change_stream_retention" yaml:"change_stream_retention"`
+	Sentry                              *entsentry.ConfigOpts     `json:"sentry" yaml:"sentry"`
+	MetadataServer                      MetadataServer            `json:"metadata_server" yaml:"metadata_server"`
+	SchemaHandlerConfig                 SchemaHandlerConfig       `json:"schema" yaml:"schema"`
+	DistributedTasks                    DistributedTasksConfig    `json:"distributed_tasks" yaml:"distributed_tasks"`
+	RecallMonitoring                    RecallMonitoringConfig    `json:"recall_monitoring" yaml:"recall_monitoring"`
+	EncryptionAtRest                    EncryptionAtRestConfig    `json:"encryption_at_rest" yaml:"encryption_at_rest"`
+	SegmentTiering                      SegmentTieringConfig      `json:"segment_tiering" yaml:"segment_tiering"`
+	ReplicationEngineMaxWorkers         int                       `json:"replication_engine_max_workers" yaml:"replication_engine_max_workers"`
+	ReplicationEngineFileCopyWorkers    int                       `json:"replication_engine_file_copy_workers" yaml:"replication_engine_file_copy_workers"`
+	HFreshEnabled                       bool                      `json:"hfresh_enabled" yaml:"hfresh_enabled"`
+	ReplicationEngineFileCopyChunkSize  int                       `json:"replication_engine_file_copy_chunk_size" yaml:"replication_engine_file_copy_chunk_size"`
 	// Raft Specific configuration
 	// TODO-RAFT: Do we want to be able to specify these with config file as well ?
 	Raft Raft
@@ -252,6 +259,12 @@ type MapToBlockamaxConfig struct {
 	Selected                   []CollectionPropsTenants `json:"selected" yaml:"selected"`
 }
 
+type SearchablePositionsConfig struct {
+	ProcessingDurationSeconds int      `json:"processing_duration_seconds" yaml:"processing_duration_seconds"`
+	PauseDurationSeconds      int      `json:"pause_duration_seconds" yaml:"pause_duration_seconds"`
+	Collections               []string `json:"collections" yaml:"collections"`
+}
+
 type CollectionPropsTenants struct {
 	Collection string   `json:"collection" yaml:"collection"`
 	Props      []string `json:"props" yaml:"props"`
@@ -389,6 +402,122 @@ type DistributedTasksConfig struct {
 	SchedulerTickInterval time.Duration `json:"schedulerTickInterval" yaml:"schedulerTickInterval"`
 }
 
+// RecallMonitoringConfig configures the background job which periodically
+// measures the recall@k of the vector indexes against an exact search.
+//
+// Every shard keeps the SampleSize most recent query vectors of each target
+// vector in memory. Every Interval, they are replayed against the index and
+// an exhaustive search, which is topped up with stored vectors if there were
+// fewer queries. The result is published as the vector_index_recall and
+// vector_index_recall_samples metrics per collection and target vector.
+// Only hnsw indexes of single vectors are measured, flat indexes are exact
+// and the other index types cannot compute the ground truth.
+type RecallMonitoringConfig struct {
+	// Enabled is set by RECALL_MONITORING_ENABLED, off by default
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// Interval is set by RECALL_MONITORING_INTERVAL, one hour by default
+	Interval time.Duration `json:"interval" yaml:"interval"`
+	// SampleSize is set by RECALL_MONITORING_SAMPLE_SIZE, 20 by default. Every
+	// sample costs one exhaustive search per measurement.
+	SampleSize int `json:"sampleSize" yaml:"sampleSize"`
+	// K is set by RECALL_MONITORING_K, 10 by default
+	K int `json:"k" yaml:"k"`
+	// Collections is set by RECALL_MONITORING_COLLECTIONS as a comma
+	// separated list. All collections are measured if it is empty.
+	Collections []string `json:"collections" yaml:"collections"`
+}
+
+// EncryptionAtRestConfig configures the envelope encryption of the files of
+// the shards:
+//   - the segments and write-ahead-logs, and the files derived from the
+//     segments: bloom filters (.bloom), net addition counts (.cna), .metadata
+//     files and the zstd dictionaries of compressed buckets
+//   - the vector index commit logs and snapshots
+//   - the chunks of the async indexing queues, which hold vectors until they
+//     are indexed
+//   - the state of vector reindex operations
+//
+// The data encryption keys of the files are wrapped with the keys of the
+// KeyProvider, "local" reads them from LocalKeyfile.
+//
+// The contents are encrypted with AES-256 in counter mode, which only
+// provides confidentiality. There is no MAC: whoever can write to the files
+// can flip bits of the plaintext without the key, and the change is not
+// detected. The checksums of the file formats are CRC32 without a key, they
+// detect accidental corruption but not tampering.
+//
+// Some files of a shard are not encrypted and are written in plaintext even
+// if the encryption is enabled:
+//   - the doc id counter, the property length tracker and the shard version
+//   - the progress of inverted index migrations, which holds the last
+//     migrated key
+//
+// Files outside of the shards, e.g. the schema and the raft log, are not
+// encrypted either.
+//
+// Backups copy the files as they are on disk. Encrypted files keep their
+// header with the wrapped data encryption key and the id of the key it is
+// wrapped with, they are not re-wrapped for the backup. Restoring a backup
+// therefore requires a key provider which still has all keys the backed up
+// files are encrypted with, and the plaintext files above are plaintext in
+// the backup, too.
+type EncryptionAtRestConfig struct {
+	// Enabled is set by ENCRYPTION_AT_REST_ENABLED, off by default. Existing
+	// plaintext files are rewritten with the current key in the compaction
+	// cycle.
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// KeyProvider is set by ENCRYPTION_AT_REST_KEY_PROVIDER, only "local" is
+	// supported
+	KeyProvider string `json:"keyProvider" yaml:"keyProvider"`
+	// LocalKeyfile is set by ENCRYPTION_AT_REST_LOCAL_KEYFILE, the JSON file
+	// with the keys of the "local" key provider and the id of the current one.
+	// Keys which files are still encrypted with must be kept in the file.
+	LocalKeyfile string `json:"localKeyfile" yaml:"localKeyfile"`
+}
+
+// SegmentTieringConfig configures moving LSM segments which were not
+// rewritten for MinAge to object storage, either an S3-compatible bucket or a
+// directory of the filesystem. Offloaded segments are fetched again on their
+// first read and the local copy is removed if it was not read for CacheTTL.
+// A fetch which takes longer than FetchTimeout fails the read.
+// Collections restricts the tiering to the listed collections, all
+// collections are tiered if it is empty.
+type SegmentTieringConfig struct {
+	Enabled        bool          `json:"enabled" yaml:"enabled"`
+	Backend        string        `json:"backend" yaml:"backend"`
+	FilesystemPath string        `json:"filesystemPath" yaml:"filesystemPath"`
+	S3Endpoint     string        `json:"s3Endpoint" yaml:"s3Endpoint"`
+	S3Bucket       string        `json:"s3Bucket" yaml:"s3Bucket"`
+	S3Path         string        `json:"s3Path" yaml:"s3Path"`
+	S3UseSSL       bool          `json:"s3UseSSL" yaml:"s3UseSSL"`
+	MinAge         time.Duration `json:"minAge" yaml:"minAge"`
+	MinSegmentSize int64         `json:"minSegmentSize" yaml:"minSegmentSize"`
+	CacheTTL       time.Duration `json:"cacheTTL" yaml:"cacheTTL"`
+	FetchTimeout   time.Duration `json:"fetchTimeout" yaml:"fetchTimeout"`
+	Collections    []string      `json:"collections" yaml:"collections"`
+}
+
+// IsTiered returns whether the segments of the collection are moved to
+// object storage
+func (c SegmentTieringConfig) IsTiered(collection string) bool {
+	if !c.Enabled {
+		return false
+	}
+	return len(c.Collections) == 0 || slices.Contains(c.Collections, collection)
+}
+
+// QuerySamples returns the number of recent query vectors the shards of the
+// collection keep to measure the recall on, 0 if it is not monitored
+func (r RecallMonitoringConfig) QuerySamples(collection string) int {
+	if !r.Enabled {
+		return 0
+	}
+	if len(r.Collections) > 0 && !slices.Contains(r.Collections, collection) {
+		return 0
+	}
+	return r.SampleSize
+}
+
 type Persistence struct {
 	DataPath                                     string `json:"dataPath" yaml:"dataPath"`
 	MemtablesFlushDirtyAfter                     int    `json:"flushDirtyMemtablesAfter" yaml:"flushDirtyMemtablesAfter"`
@@ -447,6 +576,9 @@ const (
 	DefaultMapToBlockmaxProcessingDurationSeconds  = 3 * 60
 	DefaultMapToBlockmaxPauseDurationSeconds       = 60
 	DefaultMapToBlockmaxPerObjectDelayMilliseconds = 0
+
+	DefaultSearchablePositionsProcessingDurationSeconds = 3 * 60
+	DefaultSearchablePositionsPauseDurationSeconds      = 60
 )
 
 // MetadataServer is experimental.
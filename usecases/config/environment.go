@@ -57,6 +57,18 @@ const (
 	DefaultTransferInactivityTimeout = 5 * time.Minute
 
 	DefaultTrackVectorDimensionsInterval = 5 * time.Minute
+
+	DefaultRecallMonitoringInterval   = time.Hour
+	DefaultRecallMonitoringSampleSize = 20
+	DefaultRecallMonitoringK          = 10
+
+	DefaultEncryptionAtRestKeyProvider = "local"
+
+	DefaultSegmentTieringBackend      = "s3"
+	DefaultSegmentTieringS3Endpoint   = "s3.amazonaws.com"
+	DefaultSegmentTieringMinAge       = 24 * time.Hour
+	DefaultSegmentTieringCacheTTL     = time.Hour
+	DefaultSegmentTieringFetchTimeout = 5 * time.Minute
 )
 
 // FromEnv takes a *Config as it will respect initial config that has been
@@ -580,6 +592,19 @@ func FromEnv(config *Config) error {
 		config.ReindexMapToBlockmaxConfig.Selected = cptSelected
 	}
 
+	if enabledForHost("REINDEX_SEARCHABLE_POSITIONS_AT_STARTUP", clusterCfg.Hostname) {
+		config.ReindexSearchablePositionsAtStartup = true
+		parsePositiveInt("REINDEX_SEARCHABLE_POSITIONS_PROCESSING_DURATION_SECONDS",
+			func(val int) { config.ReindexSearchablePositionsConfig.ProcessingDurationSeconds = val },
+			DefaultSearchablePositionsProcessingDurationSeconds)
+		parsePositiveInt("REINDEX_SEARCHABLE_POSITIONS_PAUSE_DURATION_SECONDS",
+			func(val int) { config.ReindexSearchablePositionsConfig.PauseDurationSeconds = val },
+			DefaultSearchablePositionsPauseDurationSeconds)
+		if v := os.Getenv("REINDEX_SEARCHABLE_POSITIONS_COLLECTIONS"); v != "" {
+			config.ReindexSearchablePositionsConfig.Collections = strings.Split(v, ",")
+		}
+	}
+
 	if err := config.parseMemtableConfig(); err != nil {
 		return err
 	}
@@ -855,6 +880,56 @@ func FromEnv(config *Config) error {
 		config.AsyncIndexingEnabled = true
 	}
 
+	if err := parseNonNegativeInt(
+		"CHANGE_STREAM_RETENTION",
+		func(val int) { config.ChangeStreamRetention = val },
+		DefaultChangeStreamRetention,
+	); err != nil {
+		return err
+	}
+
+	if entcfg.Enabled(os.Getenv("RECALL_MONITORING_ENABLED")) {
+		config.RecallMonitoring.Enabled = true
+	}
+
+	if err := parsePositiveDuration(
+		"RECALL_MONITORING_INTERVAL",
+		func(val time.Duration) { config.RecallMonitoring.Interval = val },
+		DefaultRecallMonitoringInterval,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"RECALL_MONITORING_SAMPLE_SIZE",
+		func(val int) { config.RecallMonitoring.SampleSize = val },
+		DefaultRecallMonitoringSampleSize,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"RECALL_MONITORING_K",
+		func(val int) { config.RecallMonitoring.K = val },
+		DefaultRecallMonitoringK,
+	); err != nil {
+		return err
+	}
+
+	parseStringList(
+		"RECALL_MONITORING_COLLECTIONS",
+		func(val []string) { config.RecallMonitoring.Collections = val },
+		nil,
+	)
+
+	if err := parseEncryptionAtRest(&config.EncryptionAtRest); err != nil {
+		return err
+	}
+
+	if err := parseSegmentTiering(&config.SegmentTiering); err != nil {
+		return err
+	}
+
 	if err := parseInt(
 		"MAXIMUM_ALLOWED_COLLECTIONS_COUNT",
 		func(val int) {
@@ -1400,6 +1475,7 @@ const (
 	DefaultGRPCIdleConnTimeout                 = 5 * time.Minute
 	DefaultMinimumReplicationFactor            = 1
 	DefaultMaximumAllowedCollectionsCount      = -1 // unlimited
+	DefaultChangeStreamRetention               = 1_000_000
 )
 
 const VectorizerModuleNone = "none"
@@ -1419,6 +1495,108 @@ func parseStringList(varName string, cb func(val []string), defaultValue []strin
 	}
 }
 
+// parseEncryptionAtRest reads the key provider of the encryption at rest,
+// only the local keyfile provider is supported for now
+func parseEncryptionAtRest(encryption *EncryptionAtRestConfig) error {
+	encryption.Enabled = entcfg.Enabled(os.Getenv("ENCRYPTION_AT_REST_ENABLED"))
+	encryption.KeyProvider = DefaultEncryptionAtRestKeyProvider
+	if v := os.Getenv("ENCRYPTION_AT_REST_KEY_PROVIDER"); v != "" {
+		encryption.KeyProvider = v
+	}
+	encryption.LocalKeyfile = os.Getenv("ENCRYPTION_AT_REST_LOCAL_KEYFILE")
+
+	if !encryption.Enabled {
+		return nil
+	}
+	switch encryption.KeyProvider {
+	case "local":
+		if encryption.LocalKeyfile == "" {
+			return fmt.Errorf("ENCRYPTION_AT_REST_LOCAL_KEYFILE is required for the %q key provider",
+				encryption.KeyProvider)
+		}
+		return nil
+	default:
+		return fmt.Errorf("ENCRYPTION_AT_REST_KEY_PROVIDER: unsupported key provider %q, must be \"local\"",
+			encryption.KeyProvider)
+	}
+}
+
+// parseSegmentTiering reads the object storage segments are moved to and
+// when they are moved and fetched again
+func parseSegmentTiering(tiering *SegmentTieringConfig) error {
+	tiering.Enabled = entcfg.Enabled(os.Getenv("SEGMENT_TIERING_ENABLED"))
+	tiering.Backend = DefaultSegmentTieringBackend
+	if v := os.Getenv("SEGMENT_TIERING_BACKEND"); v != "" {
+		tiering.Backend = v
+	}
+	tiering.FilesystemPath = os.Getenv("SEGMENT_TIERING_FILESYSTEM_PATH")
+	tiering.S3Endpoint = DefaultSegmentTieringS3Endpoint
+	if v := os.Getenv("SEGMENT_TIERING_S3_ENDPOINT"); v != "" {
+		tiering.S3Endpoint = v
+	}
+	tiering.S3Bucket = os.Getenv("SEGMENT_TIERING_S3_BUCKET")
+	tiering.S3Path = os.Getenv("SEGMENT_TIERING_S3_PATH")
+	// SSL on by default
+	tiering.S3UseSSL = strings.ToLower(os.Getenv("SEGMENT_TIERING_S3_USE_SSL")) != "false"
+
+	if err := parsePositiveDuration(
+		"SEGMENT_TIERING_MIN_AGE",
+		func(val time.Duration) { tiering.MinAge = val },
+		DefaultSegmentTieringMinAge,
+	); err != nil {
+		return err
+	}
+
+	if err := parseNonNegativeInt(
+		"SEGMENT_TIERING_MIN_SEGMENT_SIZE_MB",
+		func(val int) { tiering.MinSegmentSize = int64(val) * 1024 * 1024 },
+		0,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveDuration(
+		"SEGMENT_TIERING_CACHE_TTL",
+		func(val time.Duration) { tiering.CacheTTL = val },
+		DefaultSegmentTieringCacheTTL,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveDuration(
+		"SEGMENT_TIERING_FETCH_TIMEOUT",
+		func(val time.Duration) { tiering.FetchTimeout = val },
+		DefaultSegmentTieringFetchTimeout,
+	); err != nil {
+		return err
+	}
+
+	parseStringList(
+		"SEGMENT_TIERING_COLLECTIONS",
+		func(val []string) { tiering.Collections = val },
+		nil,
+	)
+
+	if !tiering.Enabled {
+		return nil
+	}
+	switch tiering.Backend {
+	case "s3":
+		if tiering.S3Bucket == "" {
+			return fmt.Errorf("SEGMENT_TIERING_S3_BUCKET is required for the %q backend", tiering.Backend)
+		}
+		return nil
+	case "filesystem":
+		if tiering.FilesystemPath == "" {
+			return fmt.Errorf("SEGMENT_TIERING_FILESYSTEM_PATH is required for the %q backend", tiering.Backend)
+		}
+		return nil
+	default:
+		return fmt.Errorf("SEGMENT_TIERING_BACKEND: unsupported backend %q, must be \"s3\" or \"filesystem\"",
+			tiering.Backend)
+	}
+}
+
 func parseResourceUsageEnvVars() (ResourceUsage, error) {
 	ru := ResourceUsage{}
 
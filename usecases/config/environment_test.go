@@ -1439,3 +1439,279 @@ func TestEnvironmentAsyncIndexing(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvironmentChangeStream(t *testing.T) {
+	factors := []struct {
+		name              string
+		retention         []string
+		expectedRetention int
+		expectedErr       bool
+	}{
+		{"not given", []string{}, DefaultChangeStreamRetention, false},
+		{"retention", []string{"500"}, 500, false},
+		{"unlimited retention", []string{"0"}, 0, false},
+		{"negative retention", []string{"-1"}, 0, true},
+		{"not parsable retention", []string{"I'm not a number"}, 0, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.retention) == 1 {
+				t.Setenv("CHANGE_STREAM_RETENTION", tt.retention[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.expectedRetention, conf.ChangeStreamRetention)
+			}
+		})
+	}
+}
+
+func TestEnvironmentRecallMonitoring(t *testing.T) {
+	factors := []struct {
+		name        string
+		env         map[string]string
+		expected    RecallMonitoringConfig
+		expectedErr bool
+	}{
+		{
+			name: "not given",
+			expected: RecallMonitoringConfig{
+				Interval:   DefaultRecallMonitoringInterval,
+				SampleSize: DefaultRecallMonitoringSampleSize,
+				K:          DefaultRecallMonitoringK,
+			},
+		},
+		{
+			name: "enabled with settings",
+			env: map[string]string{
+				"RECALL_MONITORING_ENABLED":     "true",
+				"RECALL_MONITORING_INTERVAL":    "10m",
+				"RECALL_MONITORING_SAMPLE_SIZE": "50",
+				"RECALL_MONITORING_K":           "100",
+				"RECALL_MONITORING_COLLECTIONS": "Articles,Products",
+			},
+			expected: RecallMonitoringConfig{
+				Enabled:     true,
+				Interval:    10 * time.Minute,
+				SampleSize:  50,
+				K:           100,
+				Collections: []string{"Articles", "Products"},
+			},
+		},
+		{
+			name:        "invalid interval",
+			env:         map[string]string{"RECALL_MONITORING_INTERVAL": "0s"},
+			expectedErr: true,
+		},
+		{
+			name:        "invalid sample size",
+			env:         map[string]string{"RECALL_MONITORING_SAMPLE_SIZE": "-1"},
+			expectedErr: true,
+		},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.expected, conf.RecallMonitoring)
+			}
+		})
+	}
+}
+
+func TestEnvironmentEncryptionAtRest(t *testing.T) {
+	factors := []struct {
+		name        string
+		env         map[string]string
+		expected    EncryptionAtRestConfig
+		expectedErr bool
+	}{
+		{
+			name:     "not given",
+			expected: EncryptionAtRestConfig{KeyProvider: DefaultEncryptionAtRestKeyProvider},
+		},
+		{
+			name: "local keyfile",
+			env: map[string]string{
+				"ENCRYPTION_AT_REST_ENABLED":       "true",
+				"ENCRYPTION_AT_REST_LOCAL_KEYFILE": "/etc/weaviate/keys.json",
+			},
+			expected: EncryptionAtRestConfig{
+				Enabled:      true,
+				KeyProvider:  "local",
+				LocalKeyfile: "/etc/weaviate/keys.json",
+			},
+		},
+		{
+			name: "missing keyfile",
+			env: map[string]string{
+				"ENCRYPTION_AT_REST_ENABLED": "true",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "unsupported key provider",
+			env: map[string]string{
+				"ENCRYPTION_AT_REST_ENABLED":      "true",
+				"ENCRYPTION_AT_REST_KEY_PROVIDER": "vault",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "unsupported key provider is ignored if disabled",
+			env: map[string]string{
+				"ENCRYPTION_AT_REST_KEY_PROVIDER": "vault",
+			},
+			expected: EncryptionAtRestConfig{KeyProvider: "vault"},
+		},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.expected, conf.EncryptionAtRest)
+			}
+		})
+	}
+}
+
+func TestEnvironmentSegmentTiering(t *testing.T) {
+	defaults := SegmentTieringConfig{
+		Backend:      DefaultSegmentTieringBackend,
+		S3Endpoint:   DefaultSegmentTieringS3Endpoint,
+		S3UseSSL:     true,
+		MinAge:       DefaultSegmentTieringMinAge,
+		CacheTTL:     DefaultSegmentTieringCacheTTL,
+		FetchTimeout: DefaultSegmentTieringFetchTimeout,
+	}
+
+	factors := []struct {
+		name        string
+		env         map[string]string
+		expected    SegmentTieringConfig
+		expectedErr bool
+	}{
+		{
+			name:     "not given",
+			expected: defaults,
+		},
+		{
+			name: "s3",
+			env: map[string]string{
+				"SEGMENT_TIERING_ENABLED":             "true",
+				"SEGMENT_TIERING_S3_ENDPOINT":         "localhost:9000",
+				"SEGMENT_TIERING_S3_BUCKET":           "weaviate-segments",
+				"SEGMENT_TIERING_S3_PATH":             "cluster-1",
+				"SEGMENT_TIERING_S3_USE_SSL":          "false",
+				"SEGMENT_TIERING_MIN_AGE":             "72h",
+				"SEGMENT_TIERING_MIN_SEGMENT_SIZE_MB": "64",
+				"SEGMENT_TIERING_CACHE_TTL":           "10m",
+				"SEGMENT_TIERING_FETCH_TIMEOUT":       "30s",
+				"SEGMENT_TIERING_COLLECTIONS":         "Articles,Logs",
+			},
+			expected: SegmentTieringConfig{
+				Enabled:        true,
+				Backend:        "s3",
+				S3Endpoint:     "localhost:9000",
+				S3Bucket:       "weaviate-segments",
+				S3Path:         "cluster-1",
+				MinAge:         72 * time.Hour,
+				MinSegmentSize: 64 * 1024 * 1024,
+				CacheTTL:       10 * time.Minute,
+				FetchTimeout:   30 * time.Second,
+				Collections:    []string{"Articles", "Logs"},
+			},
+		},
+		{
+			name: "filesystem",
+			env: map[string]string{
+				"SEGMENT_TIERING_ENABLED":         "true",
+				"SEGMENT_TIERING_BACKEND":         "filesystem",
+				"SEGMENT_TIERING_FILESYSTEM_PATH": "/mnt/cold",
+			},
+			expected: func() SegmentTieringConfig {
+				c := defaults
+				c.Enabled = true
+				c.Backend = "filesystem"
+				c.FilesystemPath = "/mnt/cold"
+				return c
+			}(),
+		},
+		{
+			name: "missing s3 bucket",
+			env: map[string]string{
+				"SEGMENT_TIERING_ENABLED": "true",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "missing filesystem path",
+			env: map[string]string{
+				"SEGMENT_TIERING_ENABLED": "true",
+				"SEGMENT_TIERING_BACKEND": "filesystem",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "unsupported backend",
+			env: map[string]string{
+				"SEGMENT_TIERING_ENABLED": "true",
+				"SEGMENT_TIERING_BACKEND": "ftp",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "invalid min age",
+			env: map[string]string{
+				"SEGMENT_TIERING_MIN_AGE": "-1h",
+			},
+			expectedErr: true,
+		},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.expected, conf.SegmentTiering)
+			}
+		})
+	}
+}
+
+func TestSegmentTieringIsTiered(t *testing.T) {
+	assert.False(t, SegmentTieringConfig{}.IsTiered("Articles"))
+	assert.True(t, SegmentTieringConfig{Enabled: true}.IsTiered("Articles"))
+
+	c := SegmentTieringConfig{Enabled: true, Collections: []string{"Articles"}}
+	assert.True(t, c.IsTiered("Articles"))
+	assert.False(t, c.IsTiered("Logs"))
+}
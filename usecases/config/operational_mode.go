@@ -65,8 +65,16 @@ func IsHTTPWrite(method string) bool {
 
 func IsGRPCRead(method string) bool {
 	return method == protocol.Weaviate_Search_FullMethodName ||
+		method == protocol.Weaviate_SearchBatch_FullMethodName ||
 		method == protocol.Weaviate_Aggregate_FullMethodName ||
-		method == protocol.Weaviate_TenantsGet_FullMethodName
+		method == protocol.Weaviate_TenantsGet_FullMethodName ||
+		method == protocol.Weaviate_CollectionsGet_FullMethodName ||
+		method == protocol.Weaviate_CollectionsList_FullMethodName ||
+		method == protocol.Weaviate_AliasesGet_FullMethodName ||
+		method == protocol.Weaviate_AliasesList_FullMethodName ||
+		method == protocol.Weaviate_ObjectsGet_FullMethodName ||
+		method == protocol.Weaviate_ObjectsExists_FullMethodName ||
+		method == protocol.Weaviate_ChangesStream_FullMethodName
 }
 
 func IsGRPCWrite(method string) bool {
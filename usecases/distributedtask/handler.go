@@ -15,6 +15,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"sort"
 
 	"github.com/go-openapi/strfmt"
@@ -72,6 +73,7 @@ func (h *Handler) ListTasks(ctx context.Context, principal *models.Principal) (m
 				StartedAt:     strfmt.DateTime(task.StartedAt),
 				FinishedAt:    strfmt.DateTime(task.FinishedAt),
 				FinishedNodes: finishedNodes,
+				NodesProgress: maps.Clone(task.NodesProgress),
 				Payload:       payload,
 			})
 		}
@@ -0,0 +1,464 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package encryption implements envelope encryption of the files of a shard.
+//
+// Every file is encrypted with its own random data encryption key (DEK). The
+// DEK is wrapped by a key encryption key (KEK) of a KeyProvider and stored,
+// together with the id of the KEK, in a fixed size header in front of the
+// encrypted contents. The contents are encrypted with AES-256 in counter
+// mode, which allows to read and write at arbitrary offsets, so that the
+// files can be used like any other file: segments are patched in place,
+// write-ahead-logs are appended to and read at random positions.
+//
+// Counter mode only provides confidentiality, there is no MAC. Anyone who can
+// write to a file can flip bits of its plaintext without knowing the key. The
+// checksums of the file formats are not keyed, they only detect accidental
+// corruption, not tampering.
+//
+// Files without the header are plaintext files, e.g. written before the
+// encryption was enabled. They are read as they are and rewritten with the
+// current key by Rewrite, just like files encrypted with a previous key.
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/weaviate/weaviate/entities/diskio"
+)
+
+// KeyProvider manages the key encryption keys which wrap the data encryption
+// keys of the files. The local keyfile provider is built in, key management
+// services can be added by implementing this interface.
+type KeyProvider interface {
+	// CurrentKeyID is the id of the key new data encryption keys are wrapped
+	// with. Files wrapped with another key are rewritten during compactions.
+	CurrentKeyID() string
+	// WrapKey encrypts a data encryption key with the current key
+	WrapKey(ctx context.Context, dek []byte) (keyID string, wrapped []byte, err error)
+	// UnwrapKey decrypts a data encryption key with the key it was wrapped with
+	UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error)
+}
+
+const (
+	// HeaderSize is the size of the header in front of the contents of every
+	// encrypted file. It is fixed, so that the size of the contents can be
+	// derived from the size of the file.
+	HeaderSize = 512
+
+	dekSize = 32
+	ivSize  = aes.BlockSize
+)
+
+// headerMagic identifies encrypted files, the last byte is the version of
+// the header
+var headerMagic = [8]byte{'W', 'V', 'E', 'N', 'C', 0, 0, 1}
+
+var ErrUnknownKey = errors.New("unknown key")
+
+// Encryptor creates and opens files encrypted with the keys of its
+// KeyProvider. A nil *Encryptor is valid and creates plaintext files, so
+// that callers do not need to distinguish between the two cases.
+type Encryptor struct {
+	provider KeyProvider
+}
+
+func New(provider KeyProvider) *Encryptor {
+	return &Encryptor{provider: provider}
+}
+
+func (e *Encryptor) Enabled() bool {
+	return e != nil
+}
+
+// CurrentKeyID is the id of the key new files are encrypted with, empty if
+// encryption is disabled
+func (e *Encryptor) CurrentKeyID() string {
+	if e == nil {
+		return ""
+	}
+	return e.provider.CurrentKeyID()
+}
+
+func (e *Encryptor) Open(name string) (*File, error) {
+	return e.OpenFile(name, os.O_RDONLY, 0)
+}
+
+func (e *Encryptor) Create(name string) (*File, error) {
+	return e.OpenFile(name, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o666)
+}
+
+// OpenFile opens the named file like os.OpenFile. Existing files are
+// decrypted if they have an encryption header and read as they are
+// otherwise. Empty files that are opened for writing are initialized with a
+// new data encryption key if encryption is enabled.
+func (e *Encryptor) OpenFile(name string, flag int, perm os.FileMode) (*File, error) {
+	writable := flag&(os.O_WRONLY|os.O_RDWR) != 0
+	if e != nil && flag&os.O_WRONLY != 0 {
+		// the header needs to be read back when an existing file is opened
+		flag = flag&^os.O_WRONLY | os.O_RDWR
+	}
+
+	f, err := os.OpenFile(name, flag, perm)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := e.newFile(f, flag&os.O_APPEND != 0, writable)
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("open %q: %w", name, err)
+	}
+	return out, nil
+}
+
+func (e *Encryptor) newFile(f *os.File, appendOnly, writable bool) (*File, error) {
+	out := &File{file: f, appendOnly: appendOnly}
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	if info.Size() == 0 {
+		if e == nil || !writable {
+			return out, nil
+		}
+		if err := e.initHeader(out); err != nil {
+			return nil, fmt.Errorf("write encryption header: %w", err)
+		}
+		return out, nil
+	}
+
+	h, err := readHeader(f)
+	if err != nil {
+		return nil, err
+	}
+	if h == nil {
+		// plaintext file
+		return out, nil
+	}
+	if e == nil {
+		return nil, fmt.Errorf("file is encrypted with key %q, but encryption is disabled", h.keyID)
+	}
+
+	dek, err := e.provider.UnwrapKey(context.Background(), h.keyID, h.wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("unwrap data encryption key of key %q: %w", h.keyID, err)
+	}
+	if err := out.initCipher(h.keyID, dek, h.iv); err != nil {
+		return nil, err
+	}
+
+	if appendOnly {
+		if _, err := out.Seek(0, io.SeekEnd); err != nil {
+			return nil, err
+		}
+	} else if _, err := f.Seek(HeaderSize, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (e *Encryptor) initHeader(f *File) error {
+	dek := make([]byte, dekSize)
+	if _, err := rand.Read(dek); err != nil {
+		return err
+	}
+	var iv [ivSize]byte
+	if _, err := rand.Read(iv[:]); err != nil {
+		return err
+	}
+
+	keyID, wrapped, err := e.provider.WrapKey(context.Background(), dek)
+	if err != nil {
+		return fmt.Errorf("wrap data encryption key: %w", err)
+	}
+
+	buf, err := marshalHeader(header{keyID: keyID, wrapped: wrapped, iv: iv})
+	if err != nil {
+		return err
+	}
+	// the file is empty and was just opened, so this writes at the start even
+	// if it was opened with O_APPEND
+	if _, err := f.file.Write(buf); err != nil {
+		return err
+	}
+
+	return f.initCipher(keyID, dek, iv)
+}
+
+// FileKeyID returns the id of the key the named file is encrypted with,
+// encrypted is false for plaintext files
+func FileKeyID(name string) (keyID string, encrypted bool, err error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", false, err
+	}
+	defer f.Close()
+
+	h, err := readHeader(f)
+	if err != nil {
+		return "", false, fmt.Errorf("read header of %q: %w", name, err)
+	}
+	if h == nil {
+		return "", false, nil
+	}
+	return h.keyID, true, nil
+}
+
+// IsStale returns true if the named file needs to be rewritten, because it
+// is plaintext or encrypted with another key than the current one
+func (e *Encryptor) IsStale(name string) (bool, error) {
+	if e == nil {
+		return false, nil
+	}
+
+	keyID, encrypted, err := FileKeyID(name)
+	if err != nil {
+		return false, err
+	}
+	return !encrypted || keyID != e.provider.CurrentKeyID(), nil
+}
+
+// Rewrite re-encrypts the named file with the current key if it is stale.
+// The file is replaced atomically, readers holding the previous file open
+// keep reading the previous contents.
+func (e *Encryptor) Rewrite(name string) (rewritten bool, err error) {
+	stale, err := e.IsStale(name)
+	if err != nil || !stale {
+		return false, err
+	}
+
+	tmpName := name + ".rekey.tmp"
+	if err := e.Copy(name, tmpName); err != nil {
+		return false, err
+	}
+	if err := os.Rename(tmpName, name); err != nil {
+		os.Remove(tmpName)
+		return false, err
+	}
+	if err := diskio.Fsync(filepath.Dir(name)); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Copy writes the contents of src to dst encrypted with the current key, src
+// may be plaintext or encrypted with any known key. dst is synced, but not
+// its directory.
+func (e *Encryptor) Copy(src, dst string) error {
+	return e.copyN(src, dst, -1)
+}
+
+// copyN is like Copy, but only copies the first n bytes of the contents of
+// src if n is not negative
+func (e *Encryptor) copyN(src, dst string, n int64) (err error) {
+	in, err := e.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := e.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			out.Close()
+			os.Remove(dst)
+		}
+	}()
+
+	if n < 0 {
+		_, err = io.Copy(out, in)
+	} else if _, err = io.CopyN(out, in, n); errors.Is(err, io.EOF) {
+		err = fmt.Errorf("%d bytes exceed the contents", n)
+	}
+	if err != nil {
+		return fmt.Errorf("copy %q: %w", src, err)
+	}
+	if err := out.Sync(); err != nil {
+		return err
+	}
+	return out.Close()
+}
+
+// Status summarizes the encryption of a set of files
+type Status struct {
+	Enabled        bool
+	CurrentKeyID   string
+	KeyIDs         []string
+	EncryptedFiles int64
+	PlaintextFiles int64
+	// StaleFiles are encrypted with another key than the current one
+	StaleFiles int64
+}
+
+// Status reads the headers of the named files. Files which no longer exist
+// are skipped, as they may have been removed by a compaction in the
+// meantime.
+func (e *Encryptor) Status(names []string) (Status, error) {
+	out := Status{Enabled: e.Enabled(), CurrentKeyID: e.CurrentKeyID()}
+	keyIDs := map[string]struct{}{}
+
+	for _, name := range names {
+		keyID, encrypted, err := FileKeyID(name)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return out, err
+		}
+
+		if !encrypted {
+			out.PlaintextFiles++
+			continue
+		}
+
+		out.EncryptedFiles++
+		if keyID != out.CurrentKeyID {
+			out.StaleFiles++
+		}
+		if _, ok := keyIDs[keyID]; !ok {
+			keyIDs[keyID] = struct{}{}
+			out.KeyIDs = append(out.KeyIDs, keyID)
+		}
+	}
+
+	return out, nil
+}
+
+type header struct {
+	keyID   string
+	wrapped []byte
+	iv      [ivSize]byte
+}
+
+// header layout:
+//
+//	magic           8 bytes
+//	key id length   2 bytes
+//	wrapped length  2 bytes
+//	iv             16 bytes
+//	key id
+//	wrapped data encryption key
+//	zero padding up to HeaderSize
+const headerFixedSize = 8 + 2 + 2 + ivSize
+
+func marshalHeader(h header) ([]byte, error) {
+	if headerFixedSize+len(h.keyID)+len(h.wrapped) > HeaderSize {
+		return nil, fmt.Errorf("key id and wrapped key of %d bytes exceed the header size",
+			len(h.keyID)+len(h.wrapped))
+	}
+
+	buf := make([]byte, HeaderSize)
+	copy(buf, headerMagic[:])
+	binary.LittleEndian.PutUint16(buf[8:], uint16(len(h.keyID)))
+	binary.LittleEndian.PutUint16(buf[10:], uint16(len(h.wrapped)))
+	copy(buf[12:], h.iv[:])
+	copy(buf[headerFixedSize:], h.keyID)
+	copy(buf[headerFixedSize+len(h.keyID):], h.wrapped)
+	return buf, nil
+}
+
+// readHeader returns nil if the file has no encryption header
+func readHeader(r io.ReaderAt) (*header, error) {
+	var magic [len(headerMagic)]byte
+	if _, err := r.ReadAt(magic[:], 0); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if magic != headerMagic {
+		return nil, nil
+	}
+
+	buf := make([]byte, HeaderSize)
+	if _, err := r.ReadAt(buf, 0); err != nil {
+		return nil, fmt.Errorf("truncated encryption header: %w", err)
+	}
+
+	keyIDLen := int(binary.LittleEndian.Uint16(buf[8:]))
+	wrappedLen := int(binary.LittleEndian.Uint16(buf[10:]))
+	if headerFixedSize+keyIDLen+wrappedLen > HeaderSize {
+		return nil, fmt.Errorf("corrupt encryption header")
+	}
+
+	h := &header{
+		keyID:   string(buf[headerFixedSize : headerFixedSize+keyIDLen]),
+		wrapped: buf[headerFixedSize+keyIDLen : headerFixedSize+keyIDLen+wrappedLen],
+	}
+	copy(h.iv[:], buf[12:])
+	return h, nil
+}
+
+// HasHeader returns true if the contents of a file, e.g. read into memory or
+// memory mapped, start with an encryption header
+func HasHeader(contents []byte) bool {
+	return len(contents) >= HeaderSize && [len(headerMagic)]byte(contents[:len(headerMagic)]) == headerMagic
+}
+
+// Stat is like os.Stat, but reports the size of the plaintext contents
+func Stat(name string) (os.FileInfo, error) {
+	info, err := os.Stat(name)
+	if err != nil || info.IsDir() || info.Size() < HeaderSize {
+		return info, err
+	}
+
+	_, encrypted, err := FileKeyID(name)
+	if err != nil || !encrypted {
+		return info, err
+	}
+	return fileInfo{FileInfo: info, size: info.Size() - HeaderSize}, nil
+}
+
+// Truncate is like os.Truncate with the size of the plaintext contents, but
+// it can't extend encrypted files.
+//
+// Encrypted files are not truncated in place. Contents appended afterwards
+// would be encrypted with the same key stream as the contents which were cut
+// off, and anyone who has seen the previous file, e.g. in a backup, could xor
+// both. Instead, the remaining contents are copied with a new data encryption
+// key, wrapped with the current key, and the file is replaced atomically.
+func (e *Encryptor) Truncate(name string, size int64) error {
+	_, encrypted, err := FileKeyID(name)
+	if err != nil {
+		return err
+	}
+	if !encrypted {
+		return os.Truncate(name, size)
+	}
+
+	// hidden, so that a copy left behind by a crash isn't mistaken for a file
+	// of its directory, e.g. a commit log
+	tmpName := filepath.Join(filepath.Dir(name), "."+filepath.Base(name)+".truncate.tmp")
+	if err := e.copyN(name, tmpName, size); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpName, name); err != nil {
+		os.Remove(tmpName)
+		return err
+	}
+	return diskio.Fsync(filepath.Dir(name))
+}
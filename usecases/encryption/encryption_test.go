@@ -0,0 +1,327 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package encryption
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeKeyfile(t *testing.T, current string, keys map[string][]byte) string {
+	kf := localKeyfile{CurrentKeyID: current, Keys: map[string]string{}}
+	for id, key := range keys {
+		kf.Keys[id] = base64.StdEncoding.EncodeToString(key)
+	}
+	raw, err := json.Marshal(kf)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "keyfile.json")
+	require.NoError(t, os.WriteFile(path, raw, 0o600))
+	return path
+}
+
+func randomKey(t *testing.T) []byte {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return key
+}
+
+func newTestEncryptor(t *testing.T, current string, keys map[string][]byte) *Encryptor {
+	p, err := NewLocalKeyProvider(writeKeyfile(t, current, keys))
+	require.NoError(t, err)
+	return New(p)
+}
+
+func TestFile(t *testing.T) {
+	enc := newTestEncryptor(t, "k1", map[string][]byte{"k1": randomKey(t)})
+	contents := bytes.Repeat([]byte("some plaintext contents of a segment "), 1000)
+
+	t.Run("write, patch and read", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "segment.db")
+
+		f, err := enc.Create(path)
+		require.NoError(t, err)
+		assert.True(t, f.Encrypted())
+		assert.Equal(t, "k1", f.KeyID())
+
+		// write a dummy header first and patch it at the end, like compactions
+		_, err = f.Write(make([]byte, 16))
+		require.NoError(t, err)
+		for i := 16; i < len(contents); i += 1000 {
+			_, err = f.Write(contents[i:min(i+1000, len(contents))])
+			require.NoError(t, err)
+		}
+		_, err = f.Seek(0, io.SeekStart)
+		require.NoError(t, err)
+		_, err = f.Write(contents[:16])
+		require.NoError(t, err)
+
+		info, err := f.Stat()
+		require.NoError(t, err)
+		assert.Equal(t, int64(len(contents)), info.Size())
+		require.NoError(t, f.Close())
+
+		raw, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Len(t, raw, HeaderSize+len(contents))
+		assert.False(t, bytes.Contains(raw, []byte("plaintext")))
+
+		f, err = enc.Open(path)
+		require.NoError(t, err)
+		defer f.Close()
+
+		all, err := io.ReadAll(f)
+		require.NoError(t, err)
+		assert.Equal(t, contents, all)
+
+		for _, off := range []int64{0, 1, 15, 16, 17, 4095, 12345} {
+			buf := make([]byte, 100)
+			_, err := f.ReadAt(buf, off)
+			require.NoError(t, err)
+			assert.Equal(t, contents[off:off+100], buf, "offset %d", off)
+		}
+
+		pos, err := f.Seek(-10, io.SeekEnd)
+		require.NoError(t, err)
+		assert.Equal(t, int64(len(contents)-10), pos)
+		buf := make([]byte, 10)
+		_, err = io.ReadFull(f, buf)
+		require.NoError(t, err)
+		assert.Equal(t, contents[len(contents)-10:], buf)
+
+		_, err = f.Seek(-1, io.SeekStart)
+		require.Error(t, err)
+	})
+
+	t.Run("append", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "bucket.wal")
+		flags := os.O_APPEND | os.O_CREATE | os.O_RDWR
+
+		f, err := enc.OpenFile(path, flags, 0o666)
+		require.NoError(t, err)
+		_, err = f.Write(contents[:100])
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+
+		f, err = enc.OpenFile(path, flags, 0o666)
+		require.NoError(t, err)
+		defer f.Close()
+
+		// read the end, e.g. to restore the checksum seed of a wal
+		_, err = f.Seek(-4, io.SeekEnd)
+		require.NoError(t, err)
+		buf := make([]byte, 4)
+		_, err = io.ReadFull(f, buf)
+		require.NoError(t, err)
+		assert.Equal(t, contents[96:100], buf)
+
+		_, err = f.Seek(0, io.SeekStart)
+		require.NoError(t, err)
+		_, err = f.Write(contents[100:200])
+		require.NoError(t, err)
+
+		_, err = f.Seek(0, io.SeekStart)
+		require.NoError(t, err)
+		all, err := io.ReadAll(f)
+		require.NoError(t, err)
+		assert.Equal(t, contents[:200], all)
+	})
+
+	t.Run("truncate and stat by name", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "commitlog")
+
+		f, err := enc.Create(path)
+		require.NoError(t, err)
+		_, err = f.Write(contents[:100])
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+
+		before, err := os.ReadFile(path)
+		require.NoError(t, err)
+
+		require.NoError(t, enc.Truncate(path, 50))
+		info, err := Stat(path)
+		require.NoError(t, err)
+		assert.Equal(t, int64(50), info.Size())
+
+		f, err = enc.OpenFile(path, os.O_RDWR|os.O_APPEND, 0o666)
+		require.NoError(t, err)
+		defer f.Close()
+		_, err = f.Write(contents[100:150])
+		require.NoError(t, err)
+
+		// the contents are rewritten with a new key, so that the appended
+		// contents don't reuse the key stream of the ones cut off
+		after, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.NotEqual(t, before[:HeaderSize], after[:HeaderSize])
+		assert.NotEqual(t, before[HeaderSize:HeaderSize+50], after[HeaderSize:HeaderSize+50])
+
+		_, err = f.Seek(0, io.SeekStart)
+		require.NoError(t, err)
+		all, err := io.ReadAll(f)
+		require.NoError(t, err)
+		assert.Equal(t, append(append([]byte{}, contents[:50]...), contents[100:150]...), all)
+
+		require.ErrorContains(t, f.Truncate(10), "can't be truncated in place")
+		require.ErrorContains(t, enc.Truncate(path, 200), "exceed the contents")
+	})
+}
+
+func TestPlaintextFiles(t *testing.T) {
+	enc := newTestEncryptor(t, "k1", map[string][]byte{"k1": randomKey(t)})
+	contents := []byte("plaintext written before the encryption was enabled")
+	path := filepath.Join(t.TempDir(), "segment.db")
+
+	var disabled *Encryptor
+	f, err := disabled.Create(path)
+	require.NoError(t, err)
+	assert.False(t, f.Encrypted())
+	_, err = f.Write(contents)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, contents, raw)
+
+	f, err = enc.Open(path)
+	require.NoError(t, err)
+	assert.False(t, f.Encrypted())
+	all, err := io.ReadAll(f)
+	require.NoError(t, err)
+	assert.Equal(t, contents, all)
+	require.NoError(t, f.Close())
+
+	encryptedPath := filepath.Join(t.TempDir(), "encrypted.db")
+	f, err = enc.Create(encryptedPath)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	_, err = disabled.Open(encryptedPath)
+	require.ErrorContains(t, err, "encryption is disabled")
+}
+
+func TestRewrite(t *testing.T) {
+	k1, k2 := randomKey(t), randomKey(t)
+	before := newTestEncryptor(t, "k1", map[string][]byte{"k1": k1})
+	after := newTestEncryptor(t, "k2", map[string][]byte{"k1": k1, "k2": k2})
+	contents := bytes.Repeat([]byte("rotate me "), 1000)
+	dir := t.TempDir()
+
+	write := func(enc *Encryptor, name string) string {
+		path := filepath.Join(dir, name)
+		f, err := enc.Create(path)
+		require.NoError(t, err)
+		_, err = f.Write(contents)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+		return path
+	}
+
+	plaintext := write(nil, "plaintext.db")
+	stale := write(before, "stale.db")
+	current := write(after, "current.db")
+
+	status, err := after.Status([]string{plaintext, stale, current, filepath.Join(dir, "removed.db")})
+	require.NoError(t, err)
+	assert.Equal(t, Status{
+		Enabled:        true,
+		CurrentKeyID:   "k2",
+		KeyIDs:         []string{"k1", "k2"},
+		EncryptedFiles: 2,
+		PlaintextFiles: 1,
+		StaleFiles:     1,
+	}, status)
+
+	for _, path := range []string{plaintext, stale, current} {
+		rewritten, err := after.Rewrite(path)
+		require.NoError(t, err)
+		assert.Equal(t, path != current, rewritten, path)
+
+		keyID, encrypted, err := FileKeyID(path)
+		require.NoError(t, err)
+		assert.True(t, encrypted)
+		assert.Equal(t, "k2", keyID)
+
+		f, err := after.Open(path)
+		require.NoError(t, err)
+		all, err := io.ReadAll(f)
+		require.NoError(t, err)
+		assert.Equal(t, contents, all)
+		require.NoError(t, f.Close())
+	}
+
+	_, err = os.Stat(stale + ".rekey.tmp")
+	assert.True(t, os.IsNotExist(err))
+
+	// the previous key is needed as long as files are encrypted with it
+	_, err = newTestEncryptor(t, "k2", map[string][]byte{"k2": k2}).Open(write(before, "old.db"))
+	require.ErrorIs(t, err, ErrUnknownKey)
+}
+
+func TestLocalKeyProvider(t *testing.T) {
+	t.Run("unknown current key", func(t *testing.T) {
+		_, err := NewLocalKeyProvider(writeKeyfile(t, "k2", map[string][]byte{"k1": randomKey(t)}))
+		require.ErrorIs(t, err, ErrUnknownKey)
+	})
+
+	t.Run("invalid key length", func(t *testing.T) {
+		_, err := NewLocalKeyProvider(writeKeyfile(t, "k1", map[string][]byte{"k1": []byte("short")}))
+		require.ErrorContains(t, err, "must be 32 bytes long")
+	})
+
+	t.Run("key id is authenticated", func(t *testing.T) {
+		key := randomKey(t)
+		p, err := NewLocalKeyProvider(writeKeyfile(t, "k1", map[string][]byte{"k1": key, "k2": key}))
+		require.NoError(t, err)
+
+		dek := randomKey(t)
+		keyID, wrapped, err := p.WrapKey(t.Context(), dek)
+		require.NoError(t, err)
+		assert.Equal(t, "k1", keyID)
+
+		unwrapped, err := p.UnwrapKey(t.Context(), "k1", wrapped)
+		require.NoError(t, err)
+		assert.Equal(t, dek, unwrapped)
+
+		_, err = p.UnwrapKey(t.Context(), "k2", wrapped)
+		require.Error(t, err)
+	})
+}
+
+func TestHasHeader(t *testing.T) {
+	enc := newTestEncryptor(t, "k1", map[string][]byte{"k1": randomKey(t)})
+	contents := bytes.Repeat([]byte("memory mapped segment "), 100)
+	path := filepath.Join(t.TempDir(), "segment.db")
+
+	f, err := enc.Create(path)
+	require.NoError(t, err)
+	_, err = f.Write(contents)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.True(t, HasHeader(raw))
+	assert.False(t, HasHeader(contents))
+}
@@ -0,0 +1,218 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+)
+
+// File is a drop-in replacement for *os.File. All offsets and sizes refer to
+// the plaintext contents, the header of encrypted files is hidden. Files
+// without a header are passed through as they are.
+//
+// Like *os.File, ReadAt and WriteAt are safe for concurrent use, the other
+// methods share the position of the file.
+type File struct {
+	file       *os.File
+	appendOnly bool
+
+	// only set for encrypted files
+	keyID string
+	block cipher.Block
+	iv    [ivSize]byte
+
+	// position of the file in the plaintext contents, only tracked for
+	// encrypted files
+	pos int64
+	buf []byte
+}
+
+func (f *File) initCipher(keyID string, dek []byte, iv [ivSize]byte) error {
+	block, err := aes.NewCipher(dek)
+	if err != nil {
+		return fmt.Errorf("init cipher: %w", err)
+	}
+	f.keyID = keyID
+	f.block = block
+	f.iv = iv
+	return nil
+}
+
+// Encrypted returns false for plaintext files
+func (f *File) Encrypted() bool {
+	return f.block != nil
+}
+
+// KeyID is the id of the key the data encryption key of the file is wrapped
+// with, empty for plaintext files
+func (f *File) KeyID() string {
+	return f.keyID
+}
+
+func (f *File) Name() string {
+	return f.file.Name()
+}
+
+func (f *File) Read(p []byte) (int, error) {
+	if f.block == nil {
+		return f.file.Read(p)
+	}
+
+	n, err := f.file.Read(p)
+	f.xor(p[:n], p[:n], f.pos)
+	f.pos += int64(n)
+	return n, err
+}
+
+func (f *File) ReadAt(p []byte, off int64) (int, error) {
+	if f.block == nil {
+		return f.file.ReadAt(p, off)
+	}
+
+	n, err := f.file.ReadAt(p, off+HeaderSize)
+	f.xor(p[:n], p[:n], off)
+	return n, err
+}
+
+func (f *File) Write(p []byte) (int, error) {
+	if f.block == nil {
+		return f.file.Write(p)
+	}
+
+	if f.appendOnly {
+		// the operating system writes at the end regardless of the position
+		end, err := f.file.Seek(0, io.SeekEnd)
+		if err != nil {
+			return 0, err
+		}
+		f.pos = end - HeaderSize
+	}
+
+	if cap(f.buf) < len(p) {
+		f.buf = make([]byte, len(p))
+	}
+	buf := f.buf[:len(p)]
+	f.xor(buf, p, f.pos)
+
+	n, err := f.file.Write(buf)
+	f.pos += int64(n)
+	return n, err
+}
+
+func (f *File) WriteAt(p []byte, off int64) (int, error) {
+	if f.block == nil {
+		return f.file.WriteAt(p, off)
+	}
+
+	buf := make([]byte, len(p))
+	f.xor(buf, p, off)
+	return f.file.WriteAt(buf, off+HeaderSize)
+}
+
+func (f *File) Seek(offset int64, whence int) (int64, error) {
+	if f.block == nil {
+		return f.file.Seek(offset, whence)
+	}
+
+	switch whence {
+	case io.SeekStart:
+		offset += HeaderSize
+	case io.SeekCurrent:
+		offset += f.pos + HeaderSize
+		whence = io.SeekStart
+	}
+	pos, err := f.file.Seek(offset, whence)
+	if err != nil {
+		return 0, err
+	}
+	if pos < HeaderSize {
+		// restore a valid position before reporting the error
+		f.file.Seek(f.pos+HeaderSize, io.SeekStart)
+		return 0, fmt.Errorf("seek %q: negative position", f.file.Name())
+	}
+
+	f.pos = pos - HeaderSize
+	return f.pos, nil
+}
+
+func (f *File) Stat() (os.FileInfo, error) {
+	info, err := f.file.Stat()
+	if err != nil || f.block == nil {
+		return info, err
+	}
+	return fileInfo{FileInfo: info, size: info.Size() - HeaderSize}, nil
+}
+
+// Truncate is only supported for plaintext files. Contents written to an
+// encrypted file after truncating it would reuse the key stream of the
+// contents which were cut off, encrypted files are truncated by name with
+// [Encryptor.Truncate] instead, which rewrites them with a new key.
+func (f *File) Truncate(size int64) error {
+	if f.block == nil {
+		return f.file.Truncate(size)
+	}
+	return fmt.Errorf("truncate %q: encrypted files can't be truncated in place", f.file.Name())
+}
+
+func (f *File) Sync() error {
+	return f.file.Sync()
+}
+
+func (f *File) Close() error {
+	return f.file.Close()
+}
+
+// xor en- or decrypts src into dst with the key stream at the offset of the
+// plaintext contents
+func (f *File) xor(dst, src []byte, off int64) {
+	if len(src) == 0 {
+		return
+	}
+
+	var iv [ivSize]byte
+	copy(iv[:], f.iv[:])
+	addCounter(&iv, uint64(off)/aes.BlockSize)
+
+	stream := cipher.NewCTR(f.block, iv[:])
+	if skip := off % aes.BlockSize; skip > 0 {
+		var discard [aes.BlockSize]byte
+		stream.XORKeyStream(discard[:skip], discard[:skip])
+	}
+	stream.XORKeyStream(dst, src)
+}
+
+// addCounter adds n to the 128 bit big endian counter of the iv, the same
+// way counter mode increments it
+func addCounter(iv *[ivSize]byte, n uint64) {
+	lo := binary.BigEndian.Uint64(iv[8:])
+	hi := binary.BigEndian.Uint64(iv[:8])
+	sum := lo + n
+	if sum < lo {
+		hi++
+	}
+	binary.BigEndian.PutUint64(iv[8:], sum)
+	binary.BigEndian.PutUint64(iv[:8], hi)
+}
+
+type fileInfo struct {
+	os.FileInfo
+	size int64
+}
+
+func (i fileInfo) Size() int64 {
+	return i.size
+}
@@ -0,0 +1,122 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// LocalKeyProvider wraps the data encryption keys with AES-256-GCM using
+// key encryption keys read from a local keyfile:
+//
+//	{
+//	  "currentKeyId": "2025-02",
+//	  "keys": {
+//	    "2025-01": "<base64 encoded 32 byte key>",
+//	    "2025-02": "<base64 encoded 32 byte key>"
+//	  }
+//	}
+//
+// To rotate the key, a new key is added to the file and made the current
+// key. Previous keys have to stay in the file until no file is encrypted
+// with them anymore, which is reported by the encryption status of the
+// shards.
+type LocalKeyProvider struct {
+	currentKeyID string
+	keys         map[string]cipher.AEAD
+}
+
+type localKeyfile struct {
+	CurrentKeyID string            `json:"currentKeyId"`
+	Keys         map[string]string `json:"keys"`
+}
+
+func NewLocalKeyProvider(path string) (*LocalKeyProvider, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read keyfile: %w", err)
+	}
+
+	var kf localKeyfile
+	if err := json.Unmarshal(raw, &kf); err != nil {
+		return nil, fmt.Errorf("parse keyfile %q: %w", path, err)
+	}
+
+	p := &LocalKeyProvider{
+		currentKeyID: kf.CurrentKeyID,
+		keys:         make(map[string]cipher.AEAD, len(kf.Keys)),
+	}
+	for id, encoded := range kf.Keys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("keyfile %q: decode key %q: %w", path, id, err)
+		}
+		if len(key) != 32 {
+			return nil, fmt.Errorf("keyfile %q: key %q must be 32 bytes long, got %d", path, id, len(key))
+		}
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, fmt.Errorf("keyfile %q: key %q: %w", path, id, err)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("keyfile %q: key %q: %w", path, id, err)
+		}
+		p.keys[id] = aead
+	}
+
+	if _, ok := p.keys[p.currentKeyID]; !ok {
+		return nil, fmt.Errorf("keyfile %q: current key %q: %w", path, p.currentKeyID, ErrUnknownKey)
+	}
+
+	return p, nil
+}
+
+func (p *LocalKeyProvider) CurrentKeyID() string {
+	return p.currentKeyID
+}
+
+// WrapKey seals the key with a random nonce, which is stored in front of the
+// sealed key. The key id is authenticated as additional data.
+func (p *LocalKeyProvider) WrapKey(ctx context.Context, dek []byte) (string, []byte, error) {
+	aead := p.keys[p.currentKeyID]
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(dek)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", nil, err
+	}
+	return p.currentKeyID, aead.Seal(nonce, nonce, dek, []byte(p.currentKeyID)), nil
+}
+
+func (p *LocalKeyProvider) UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	aead, ok := p.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("key %q: %w", keyID, ErrUnknownKey)
+	}
+	if len(wrapped) < aead.NonceSize() {
+		return nil, fmt.Errorf("wrapped key too short")
+	}
+
+	nonce, sealed := wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():]
+	dek, err := aead.Open(nil, nonce, sealed, []byte(keyID))
+	if err != nil {
+		return nil, fmt.Errorf("open wrapped key with key %q: %w", keyID, err)
+	}
+	return dek, nil
+}
@@ -80,6 +80,16 @@ func (m *MockSchemaExecutor) AddProperty(class string, req cmd.AddPropertyReques
 	return args.Error(0)
 }
 
+func (m *MockSchemaExecutor) DeleteProperty(class string, req cmd.DeletePropertyRequest) error {
+	args := m.Called(class, req)
+	return args.Error(0)
+}
+
+func (m *MockSchemaExecutor) ReindexVectorIndex(class string, req cmd.ReindexVectorIndexRequest) error {
+	args := m.Called(class, req)
+	return args.Error(0)
+}
+
 func (m *MockSchemaExecutor) AddTenants(class string, req *cmd.AddTenantsRequest) error {
 	args := m.Called(class, req)
 	return args.Error(0)
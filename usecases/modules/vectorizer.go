@@ -20,6 +20,7 @@ import (
 	"github.com/weaviate/weaviate/entities/dto"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/modelsext"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
 
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/models"
@@ -519,7 +520,8 @@ func (p *Provider) getVectorIndexConfig(class *models.Class, targetVector string
 	_, okFlat := vectorIndexConfig.(flat.UserConfig)
 	_, okDynamic := vectorIndexConfig.(dynamic.UserConfig)
 	_, okHFresh := vectorIndexConfig.(hfresh.UserConfig)
-	if !(okHnsw || okFlat || okDynamic || okHFresh) {
+	_, okSparse := vectorIndexConfig.(sparse.UserConfig)
+	if !(okHnsw || okFlat || okDynamic || okHFresh || okSparse) {
 		return hnsw.UserConfig{}, fmt.Errorf(errorVectorIndexType, vectorIndexConfig)
 	}
 	return hnswConfig, nil
@@ -101,6 +101,8 @@ type PrometheusMetrics struct {
 	VectorIndexDurations               *prometheus.SummaryVec
 	VectorIndexSize                    *prometheus.GaugeVec
 	VectorIndexMaintenanceDurations    *prometheus.SummaryVec
+	VectorIndexRecall                  *prometheus.GaugeVec
+	VectorIndexRecallSamples           *prometheus.GaugeVec
 	// IVF
 	VectorIndexPostings                      *prometheus.GaugeVec
 	VectorIndexPostingSize                   *prometheus.HistogramVec
@@ -364,6 +366,8 @@ func (pm *PrometheusMetrics) DeleteClass(className string) error {
 	pm.BackupRestoreDataTransferred.DeletePartialMatch(labels)
 	pm.BackupStoreDataTransferred.DeletePartialMatch(labels)
 	pm.QueriesFilteredVectorDurations.DeletePartialMatch(labels)
+	pm.VectorIndexRecall.DeletePartialMatch(labels)
+	pm.VectorIndexRecallSamples.DeletePartialMatch(labels)
 
 	return nil
 }
@@ -639,6 +643,14 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Name: "vector_index_maintenance_durations_ms",
 			Help: "Duration of a sync or async vector index maintenance operation",
 		}, []string{"operation", "class_name", "shard_name"}),
+		VectorIndexRecall: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "vector_index_recall",
+			Help: "Recall@k of the vector index measured against an exact search over sampled queries. 1 means the index returned the exact nearest neighbors.",
+		}, []string{"class_name", "target_vector", "k"}),
+		VectorIndexRecallSamples: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "vector_index_recall_samples",
+			Help: "Number of sampled queries the last recall measurement of the vector index is based on",
+		}, []string{"class_name", "target_vector", "source"}),
 		VectorIndexDurations: promauto.NewSummaryVec(prometheus.SummaryOpts{
 			Name: "vector_index_durations_ms",
 			Help: "Duration of typical vector index operations (insert, delete)",
@@ -17,6 +17,8 @@ import (
 
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modelsext"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
 )
 
 func (v *Validator) vector(ctx context.Context, class *models.Class,
@@ -54,12 +56,24 @@ func (v *Validator) vector(ctx context.Context, class *models.Class,
 	}
 
 	var incomingTargetVectors []string
-	for name := range incomingObject.Vectors {
-		_, ok := class.VectorConfig[name]
+	for name, vec := range incomingObject.Vectors {
+		vectorConfig, ok := class.VectorConfig[name]
 		if !ok {
 			return fmt.Errorf("collection %v does not have configuration for vector %s", class.Class, name)
 		}
 
+		sparseVec, isSparse := vec.(*models.SparseVector)
+		if vectorConfig.VectorIndexType == vectorindex.VectorIndexTypeSparse {
+			if !isSparse {
+				return fmt.Errorf("vector %s uses a sparse index and has to be a sparse vector", name)
+			}
+			if err := sparse.Validate(sparseVec); err != nil {
+				return fmt.Errorf("vector %s: %w", name, err)
+			}
+		} else if isSparse {
+			return fmt.Errorf("vector %s is a sparse vector, but its index type is %q", name, vectorConfig.VectorIndexType)
+		}
+
 		incomingTargetVectors = append(incomingTargetVectors, name)
 	}
 
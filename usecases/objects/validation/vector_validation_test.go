@@ -99,6 +99,45 @@ func TestVectors(t *testing.T) {
 				Vector:  []float32{7, 8, 9},
 			},
 		},
+		"sparse vector for sparse index": {
+			class: &models.Class{
+				VectorConfig: map[string]models.VectorConfig{"dense": {}, "sparse": {VectorIndexType: "sparse"}},
+			},
+			obj: &models.Object{
+				Vectors: models.Vectors{
+					"dense":  []float32{1, 2, 3},
+					"sparse": &models.SparseVector{Indices: []uint32{1, 5}, Values: []float32{0.5, 1}},
+				},
+			},
+			expErr: false,
+		},
+		"dense vector for sparse index": {
+			class: &models.Class{
+				VectorConfig: map[string]models.VectorConfig{"sparse": {VectorIndexType: "sparse"}},
+			},
+			obj: &models.Object{
+				Vectors: models.Vectors{"sparse": []float32{1, 2, 3}},
+			},
+			expErr: true,
+		},
+		"sparse vector for dense index": {
+			class: &models.Class{
+				VectorConfig: map[string]models.VectorConfig{"dense": {VectorIndexType: "hnsw"}},
+			},
+			obj: &models.Object{
+				Vectors: models.Vectors{"dense": &models.SparseVector{Indices: []uint32{1}, Values: []float32{1}}},
+			},
+			expErr: true,
+		},
+		"invalid sparse vector": {
+			class: &models.Class{
+				VectorConfig: map[string]models.VectorConfig{"sparse": {VectorIndexType: "sparse"}},
+			},
+			obj: &models.Object{
+				Vectors: models.Vectors{"sparse": &models.SparseVector{Indices: []uint32{1, 1}, Values: []float32{1, 2}}},
+			},
+			expErr: true,
+		},
 		"default vector not touched in named vector class": {
 			class: &models.Class{
 				VectorConfig: map[string]models.VectorConfig{modelsext.DefaultNamedVectorName: {}},
@@ -86,6 +86,30 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:      authorization.UPDATE,
 			expectedResources: authorization.CollectionsMetadata("somename"),
 		},
+		{
+			methodName:        "ReindexVectorIndex",
+			additionalArgs:    []interface{}{"somename", "somevector", models.VectorConfig{}},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.CollectionsMetadata("somename"),
+		},
+		{
+			methodName:        "StartVectorIndexMaintenance",
+			additionalArgs:    []interface{}{"className", "shardName", "somevector", "tombstoneCleanup"},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.ShardsMetadata("className", "shardName"),
+		},
+		{
+			methodName:        "GetVectorIndexMaintenance",
+			additionalArgs:    []interface{}{"className", "shardName", "somevector"},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.ShardsMetadata("className", "shardName"),
+		},
+		{
+			methodName:        "CancelVectorIndexMaintenance",
+			additionalArgs:    []interface{}{"className", "shardName", "somevector", "someid"},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.ShardsMetadata("className", "shardName"),
+		},
 		{
 			methodName:        "UpdateShardStatus",
 			additionalArgs:    []interface{}{"className", "shardName", "targetStatus"},
@@ -158,7 +182,7 @@ func Test_Schema_Authorization(t *testing.T) {
 
 		for _, method := range allExportedMethods(&Handler{classGetter: nil}) {
 			switch method {
-			case "RegisterSchemaUpdateCallback",
+			case "RegisterSchemaUpdateCallback", "SetVectorReindexTasks", "SetVectorIndexMaintainer",
 				// introduced by sync.Mutex in go 1.18
 				"UpdateMeta", "GetSchemaSkipAuth", "IndexedInverted", "RLock", "RUnlock", "Lock", "Unlock",
 				"TryLock", "RLocker", "TryRLock", "TxManager", "RestoreClass",
@@ -28,6 +28,7 @@ import (
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/ttl"
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/classcache"
@@ -311,11 +312,17 @@ func (h *Handler) UpdateClass(ctx context.Context, principal *models.Principal,
 		return err
 	}
 
-	return UpdateClassInternal(h, ctx, className, updated)
+	return updateClass(h, ctx, className, updated, false)
 }
 
 // bypass the auth check for internal class update requests
 func UpdateClassInternal(h *Handler, ctx context.Context, className string, updated *models.Class,
+) error {
+	return updateClass(h, ctx, className, updated, true)
+}
+
+func updateClass(h *Handler, ctx context.Context, className string, updated *models.Class,
+	internal bool,
 ) error {
 	// make sure unset optionals on 'updated' don't lead to an error, as all
 	// optionals would have been set with defaults on the initial already
@@ -329,6 +336,10 @@ func UpdateClassInternal(h *Handler, ctx context.Context, className string, upda
 		updated.ObjectTTLConfig = ttlConfig
 	}
 
+	if err := validateObjectStorageConfig(updated); err != nil {
+		return err
+	}
+
 	if err := h.parser.ParseClass(updated); err != nil {
 		return err
 	}
@@ -386,6 +397,11 @@ func UpdateClassInternal(h *Handler, ctx context.Context, className string, upda
 		if err := validateImmutableFields(initial, updated); err != nil {
 			return err
 		}
+		if !internal {
+			if err := validateIndexPositionsUpdate(initial, updated); err != nil {
+				return err
+			}
+		}
 	}
 	// A nil sharding state means that the sharding state will not be updated.
 
@@ -771,6 +787,10 @@ func (h *Handler) validateClassInvariants(
 		return err
 	}
 
+	if err := validateObjectStorageConfig(class); err != nil {
+		return err
+	}
+
 	if ttlConfig, needsInvertedIndexTimestamp, err := ttl.ValidateObjectTTLConfig(class, false); err != nil {
 		return fmt.Errorf("ObjectTTLConfig: %w", err)
 	} else {
@@ -894,6 +914,9 @@ func (h *Handler) validateVectorSettings(class *models.Class) error {
 		if err := h.validateVectorIndexType(class.VectorIndexType); err != nil {
 			return err
 		}
+		if class.VectorIndexType == vectorindex.VectorIndexTypeSparse {
+			return errors.New("the sparse index is only configurable using named vectors")
+		}
 
 		if err := h.validateVectorizer(class.Vectorizer); err != nil {
 			return err
@@ -918,6 +941,10 @@ func (h *Handler) validateVectorSettings(class *models.Class) error {
 				if err := h.validateVectorizer(vectorizer); err != nil {
 					return fmt.Errorf("target vector %q: %w", name, err)
 				}
+				if cfg.VectorIndexType == vectorindex.VectorIndexTypeSparse && vectorizer != config.VectorizerModuleNone {
+					return fmt.Errorf("target vector %q: sparse index requires vectorizer %q, got %q",
+						name, config.VectorizerModuleNone, vectorizer)
+				}
 			}
 		}
 		if err := h.validateVectorIndexType(cfg.VectorIndexType); err != nil {
@@ -941,7 +968,7 @@ func (h *Handler) validateVectorizer(vectorizer string) error {
 
 func (h *Handler) validateVectorIndexType(vectorIndexType string) error {
 	switch vectorIndexType {
-	case vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT:
+	case vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT, vectorindex.VectorIndexTypeSparse:
 		return nil
 	case vectorindex.VectorIndexTypeDYNAMIC:
 		if !h.asyncIndexingEnabled {
@@ -988,6 +1015,19 @@ func validateUpdatingMT(current, update *models.Class) (enabled bool, err error)
 	return enabled, err
 }
 
+// validateObjectStorageConfig checks the compression of the objects. It can
+// be changed at any time, segments written with the previous compression stay
+// readable.
+func validateObjectStorageConfig(class *models.Class) error {
+	if class.ObjectStorageConfig == nil {
+		return nil
+	}
+	if _, err := lsmkv.SegmentCompressionFromString(class.ObjectStorageConfig.Compression); err != nil {
+		return fmt.Errorf("objectStorageConfig.compression: %w", err)
+	}
+	return nil
+}
+
 func validateImmutableFields(initial, updated *models.Class) error {
 	immutableFields := []immutableText{
 		{
@@ -1028,10 +1068,37 @@ func validateImmutableFields(initial, updated *models.Class) error {
 	if err := compareIndexSetting("indexPropertyLength", func(config *models.InvertedIndexConfig) bool { return config.IndexPropertyLength }); err != nil {
 		return err
 	}
+	initialChangeStream := initial.ChangeStreamConfig != nil && initial.ChangeStreamConfig.Enabled
+	updatedChangeStream := updated.ChangeStreamConfig != nil && updated.ChangeStreamConfig.Enabled
+	if initialChangeStream != updatedChangeStream {
+		return fmt.Errorf("%q setting is immutable. Value changed from \"%v\" to \"%v\"",
+			"changeStreamConfig.enabled", initialChangeStream, updatedChangeStream)
+	}
+
+	// positions can only be enabled by the reindexing task, see
+	// validateIndexPositionsUpdate
+	if indexPositions(initial) && !indexPositions(updated) {
+		return fmt.Errorf("%q setting can not be disabled", "indexPositions")
+	}
 
 	return nil
 }
 
+// validateIndexPositionsUpdate rejects changes of indexPositions by users.
+// The positions of existing objects are indexed by the reindexing task, which
+// enables the setting once all replicas are done.
+func validateIndexPositionsUpdate(initial, updated *models.Class) error {
+	initialVal, updatedVal := indexPositions(initial), indexPositions(updated)
+	if initialVal != updatedVal {
+		return fmt.Errorf("%q setting is immutable. Value changed from \"%v\" to \"%v\"", "indexPositions", initialVal, updatedVal)
+	}
+	return nil
+}
+
+func indexPositions(class *models.Class) bool {
+	return class.InvertedIndexConfig != nil && class.InvertedIndexConfig.IndexPositions
+}
+
 type immutableText struct {
 	accessor func(c *models.Class) string
 	name     string
@@ -1765,6 +1765,107 @@ func Test_UpdateClass(t *testing.T) {
 				},
 				expectedError: fmt.Errorf("\"indexPropertyLength\" setting is immutable. Value changed from \"false\" to \"true\""),
 			},
+			{
+				name: "attempting to enable the change stream",
+				initial: &models.Class{
+					Class:             "InitialName",
+					Vectorizer:        "none",
+					ReplicationConfig: &models.ReplicationConfig{Factor: 1},
+				},
+				update: &models.Class{
+					Class:              "InitialName",
+					Vectorizer:         "none",
+					ChangeStreamConfig: &models.ChangeStreamConfig{Enabled: true},
+					ReplicationConfig:  &models.ReplicationConfig{Factor: 1},
+				},
+				expectedError: fmt.Errorf("\"changeStreamConfig.enabled\" setting is immutable. Value changed from \"false\" to \"true\""),
+			},
+			{
+				name: "attempting to disable the change stream",
+				initial: &models.Class{
+					Class:              "InitialName",
+					Vectorizer:         "none",
+					ChangeStreamConfig: &models.ChangeStreamConfig{Enabled: true},
+					ReplicationConfig:  &models.ReplicationConfig{Factor: 1},
+				},
+				update: &models.Class{
+					Class:              "InitialName",
+					Vectorizer:         "none",
+					ChangeStreamConfig: &models.ChangeStreamConfig{Enabled: false},
+					ReplicationConfig:  &models.ReplicationConfig{Factor: 1},
+				},
+				expectedError: fmt.Errorf("\"changeStreamConfig.enabled\" setting is immutable. Value changed from \"true\" to \"false\""),
+			},
+			{
+				name: "changing the compression of the objects",
+				initial: &models.Class{
+					Class:               "InitialName",
+					Vectorizer:          "none",
+					ObjectStorageConfig: &models.ObjectStorageConfig{Compression: "snappy"},
+					ReplicationConfig:   &models.ReplicationConfig{Factor: 1},
+				},
+				update: &models.Class{
+					Class:               "InitialName",
+					Vectorizer:          "none",
+					ObjectStorageConfig: &models.ObjectStorageConfig{Compression: "zstd"},
+					ReplicationConfig:   &models.ReplicationConfig{Factor: 1},
+				},
+			},
+			{
+				name: "attempting to use an unknown compression of the objects",
+				initial: &models.Class{
+					Class:             "InitialName",
+					Vectorizer:        "none",
+					ReplicationConfig: &models.ReplicationConfig{Factor: 1},
+				},
+				update: &models.Class{
+					Class:               "InitialName",
+					Vectorizer:          "none",
+					ObjectStorageConfig: &models.ObjectStorageConfig{Compression: "lzma"},
+					ReplicationConfig:   &models.ReplicationConfig{Factor: 1},
+				},
+				expectedError: fmt.Errorf("objectStorageConfig.compression: unsupported compression \"lzma\""),
+			},
+			{
+				name: "attempting to update the inverted IndexPositions false->true",
+				initial: &models.Class{
+					Class:      "InitialName",
+					Vectorizer: "none",
+					InvertedIndexConfig: &models.InvertedIndexConfig{
+						IndexPositions: false,
+					},
+					ReplicationConfig: &models.ReplicationConfig{Factor: 1},
+				},
+				update: &models.Class{
+					Class:      "InitialName",
+					Vectorizer: "none",
+					InvertedIndexConfig: &models.InvertedIndexConfig{
+						IndexPositions: true,
+					},
+					ReplicationConfig: &models.ReplicationConfig{Factor: 1},
+				},
+				expectedError: fmt.Errorf("\"indexPositions\" setting is immutable. Value changed from \"false\" to \"true\""),
+			},
+			{
+				name: "attempting to update the inverted IndexPositions true->false",
+				initial: &models.Class{
+					Class:      "InitialName",
+					Vectorizer: "none",
+					InvertedIndexConfig: &models.InvertedIndexConfig{
+						IndexPositions: true,
+					},
+					ReplicationConfig: &models.ReplicationConfig{Factor: 1},
+				},
+				update: &models.Class{
+					Class:      "InitialName",
+					Vectorizer: "none",
+					InvertedIndexConfig: &models.InvertedIndexConfig{
+						IndexPositions: false,
+					},
+					ReplicationConfig: &models.ReplicationConfig{Factor: 1},
+				},
+				expectedError: fmt.Errorf("\"indexPositions\" setting can not be disabled"),
+			},
 
 			{
 				name: "attempting to update module config",
@@ -2228,6 +2329,70 @@ func Test_UpdateClass(t *testing.T) {
 	})
 }
 
+func Test_UpdateClassInternal_IndexPositions(t *testing.T) {
+	newClass := func(indexPositions bool) *models.Class {
+		return &models.Class{
+			Class:      "InitialName",
+			Vectorizer: "none",
+			InvertedIndexConfig: &models.InvertedIndexConfig{
+				IndexPositions: indexPositions,
+			},
+			ReplicationConfig: &models.ReplicationConfig{Factor: 1},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		initial       bool
+		update        bool
+		expectedError string
+	}{
+		{
+			name:    "enabled by the reindexing task",
+			initial: false,
+			update:  true,
+		},
+		{
+			name:          "can not be disabled",
+			initial:       true,
+			update:        false,
+			expectedError: "\"indexPositions\" setting can not be disabled",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+			ctx := context.Background()
+
+			store := NewFakeStore()
+			store.parser = handler.parser
+
+			initial := newClass(test.initial)
+			fakeSchemaManager.On("AddClass", initial, mock.Anything).Return(nil)
+			fakeSchemaManager.On("QueryCollectionsCount").Return(0, nil)
+			fakeSchemaManager.On("UpdateClass", mock.Anything, mock.Anything).Return(nil)
+			fakeSchemaManager.On("ReadOnlyClass", initial.Class, mock.Anything).Return(initial)
+			handler.schemaConfig.MaximumAllowedCollectionsCount = runtime.NewDynamicValue(-1)
+			_, _, err := handler.AddClass(ctx, nil, initial)
+			require.NoError(t, err)
+			store.AddClass(initial)
+
+			update := newClass(test.update)
+			err = UpdateClassInternal(handler, ctx, initial.Class, update)
+			if err == nil {
+				err = store.UpdateClass(update)
+			}
+
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
 func Test_UpdateClass_ObjectTTLConfig(t *testing.T) {
 	vFalse := false
 
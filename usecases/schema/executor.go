@@ -177,6 +177,10 @@ func (e *executor) UpdateClass(req api.UpdateClassRequest) error {
 		return fmt.Errorf("update replication config: %w", err)
 	}
 
+	if err := e.migrator.UpdateObjectStorageConfig(ctx, className, req.Class.ObjectStorageConfig); err != nil {
+		return fmt.Errorf("update object storage config: %w", err)
+	}
+
 	return nil
 }
 
@@ -218,6 +222,41 @@ func (e *executor) AddProperty(className string, req api.AddPropertyRequest) err
 	return nil
 }
 
+func (e *executor) DeleteProperty(className string, req api.DeletePropertyRequest) error {
+	ctx := context.Background()
+	if err := e.migrator.DropProperty(ctx, className, req.Name); err != nil {
+		return err
+	}
+
+	e.logger.WithFields(logrus.Fields{
+		"action":   "delete_property",
+		"class":    className,
+		"property": req.Name,
+	}).Debug("deleting property")
+	return nil
+}
+
+func (e *executor) ReindexVectorIndex(className string, req api.ReindexVectorIndexRequest) error {
+	cfg, ok := req.VectorConfig.VectorIndexConfig.(schemaConfig.VectorIndexConfig)
+	if !ok {
+		return fmt.Errorf("reindex vector %q: config is not schema.VectorIndexConfig: %T",
+			req.TargetVector, req.VectorConfig.VectorIndexConfig)
+	}
+
+	ctx := context.Background()
+	if err := e.migrator.ReindexVectorIndex(ctx, className, req.TargetVector, cfg); err != nil {
+		return err
+	}
+
+	e.logger.WithFields(logrus.Fields{
+		"action":          "reindex_vector_index",
+		"class":           className,
+		"targetVector":    req.TargetVector,
+		"vectorIndexType": cfg.IndexType(),
+	}).Debug("reindexing vector index")
+	return nil
+}
+
 func (e *executor) AddTenants(class string, req *api.AddTenantsRequest) error {
 	if len(req.Tenants) == 0 {
 		return nil
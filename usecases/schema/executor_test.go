@@ -122,6 +122,25 @@ func TestExecutor(t *testing.T) {
 		assert.Nil(t, x.AddProperty("A", req))
 	})
 
+	t.Run("DeleteProperty", func(t *testing.T) {
+		migrator := &fakeMigrator{}
+		migrator.On("DropProperty", Anything, "A", "p1").Return(nil)
+		x := newMockExecutor(migrator, store)
+		assert.Nil(t, x.DeleteProperty("A", api.DeletePropertyRequest{Name: "p1"}))
+	})
+
+	t.Run("ReindexVectorIndex", func(t *testing.T) {
+		migrator := &fakeMigrator{}
+		cfg := fakeVectorConfig{}
+		migrator.On("ReindexVectorIndex", Anything, "A", "v1", cfg).Return(nil)
+		x := newMockExecutor(migrator, store)
+		assert.Nil(t, x.ReindexVectorIndex("A", api.ReindexVectorIndexRequest{
+			TargetVector: "v1",
+			VectorConfig: models.VectorConfig{VectorIndexType: "flat", VectorIndexConfig: cfg},
+		}))
+		assert.NotNil(t, x.ReindexVectorIndex("A", api.ReindexVectorIndexRequest{TargetVector: "v1"}))
+	})
+
 	tenants := []*api.Tenant{{Name: "T1"}, {Name: "T2"}}
 
 	t.Run("DeleteTenants", func(t *testing.T) {
@@ -55,6 +55,16 @@ func (f *fakeSchemaManager) AddProperty(_ context.Context, class string, p ...*m
 	return 0, args.Error(0)
 }
 
+func (f *fakeSchemaManager) DeleteProperty(_ context.Context, class, property string) (uint64, error) {
+	args := f.Called(class, property)
+	return 0, args.Error(0)
+}
+
+func (f *fakeSchemaManager) ReindexVectorIndex(_ context.Context, class, targetVector string, cfg models.VectorConfig) (uint64, error) {
+	args := f.Called(class, targetVector, cfg)
+	return 0, args.Error(0)
+}
+
 func (f *fakeSchemaManager) UpdateShardStatus(c_ context.Context, class, shard, status string) (uint64, error) {
 	args := f.Called(class, shard, status)
 	return 0, args.Error(0)
@@ -49,6 +49,8 @@ type SchemaManager interface {
 	UpdateClass(ctx context.Context, cls *models.Class, ss *sharding.State) (uint64, error)
 	DeleteClass(ctx context.Context, name string) (uint64, error)
 	AddProperty(ctx context.Context, class string, p ...*models.Property) (uint64, error)
+	DeleteProperty(ctx context.Context, class, property string) (uint64, error)
+	ReindexVectorIndex(ctx context.Context, class, targetVector string, cfg models.VectorConfig) (uint64, error)
 	UpdateShardStatus(ctx context.Context, class, shard, status string) (uint64, error)
 	AddTenants(ctx context.Context, class string, req *command.AddTenantsRequest) (uint64, error)
 	UpdateTenants(ctx context.Context, class string, req *command.UpdateTenantsRequest) (uint64, error)
@@ -152,6 +154,11 @@ type Handler struct {
 	classGetter *ClassGetter
 
 	asyncIndexingEnabled bool
+
+	// vectorReindexTasks is only set if distributed tasks are enabled
+	vectorReindexTasks VectorReindexTasks
+
+	vectorIndexMaintainer VectorIndexMaintainer
 }
 
 // NewHandler creates a new handler
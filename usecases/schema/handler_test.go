@@ -21,6 +21,7 @@ import (
 
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/config/runtime"
 )
@@ -42,6 +43,7 @@ var schemaTests = []struct {
 	{name: "AddInvalidPropertyDuringCreation", fn: testAddInvalidPropertyDuringCreation},
 	{name: "AddInvalidPropertyWithEmptyDataTypeDuringCreation", fn: testAddInvalidPropertyWithEmptyDataTypeDuringCreation},
 	{name: "DropProperty", fn: testDropProperty},
+	{name: "ReindexVectorIndex", fn: testReindexVectorIndex},
 }
 
 func testAddObjectClass(t *testing.T, handler *Handler, fakeSchemaManager *fakeSchemaManager) {
@@ -360,31 +362,109 @@ func testAddInvalidPropertyWithEmptyDataTypeDuringCreation(t *testing.T, handler
 }
 
 func testDropProperty(t *testing.T, handler *Handler, fakeSchemaManager *fakeSchemaManager) {
-	// TODO: https://github.com/weaviate/weaviate/issues/973
-	// Remove skip
+	t.Parallel()
 
-	t.Skip()
+	class := &models.Class{
+		Class: "Car",
+		Properties: []*models.Property{
+			{Name: "color", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWhitespace},
+			{Name: "description", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWhitespace},
+			{Name: "soldAt", DataType: schema.DataTypeDate.PropString()},
+			{Name: "photo", DataType: schema.DataTypeBlob.PropString()},
+			{Name: "make", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationField},
+		},
+		ObjectTTLConfig: &models.ObjectTTLConfig{Enabled: true, DeleteOn: "soldAt", DefaultTTL: 3600},
+		VectorConfig: map[string]models.VectorConfig{
+			"description": {
+				Vectorizer: map[string]interface{}{
+					"text2vec-contextionary": map[string]interface{}{"properties": []interface{}{"description"}},
+				},
+			},
+			"photo": {
+				Vectorizer: map[string]interface{}{
+					"multi2vec-clip": map[string]interface{}{"imageFields": []interface{}{"photo"}},
+				},
+			},
+		},
+		ShardingConfig:    map[string]interface{}{"key": "make"},
+		ReplicationConfig: &models.ReplicationConfig{Factor: 1},
+	}
+	fakeSchemaManager.On("ReadOnlyClass", "Car").Return(class)
+	fakeSchemaManager.On("DeleteProperty", "Car", "color").Return(nil)
 
-	t.Parallel()
+	err := handler.DeleteClassProperty(context.Background(), nil, "Car", "color")
+	require.Nil(t, err)
 
-	fakeSchemaManager.On("ReadOnlySchema").Return(models.Schema{})
+	err = handler.DeleteClassProperty(context.Background(), nil, "Car", "unknown")
+	assert.ErrorIs(t, err, ErrNotFound)
 
-	properties := []*models.Property{
-		{Name: "color", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWhitespace},
-	}
+	err = handler.DeleteClassProperty(context.Background(), nil, "Car", "soldAt")
+	assert.ErrorContains(t, err, "objectTtlConfig.deleteOn")
+
+	err = handler.DeleteClassProperty(context.Background(), nil, "Car", "description")
+	assert.ErrorContains(t, err, "source property")
+
+	err = handler.DeleteClassProperty(context.Background(), nil, "Car", "photo")
+	assert.ErrorContains(t, err, "source property")
+
+	err = handler.DeleteClassProperty(context.Background(), nil, "Car", "make")
+	assert.ErrorContains(t, err, "shardingConfig.key")
+}
+
+func testReindexVectorIndex(t *testing.T, handler *Handler, fakeSchemaManager *fakeSchemaManager) {
+	t.Parallel()
+
+	vectorizer := map[string]interface{}{"none": map[string]interface{}{}}
 	class := &models.Class{
-		Class:             "Car",
-		Properties:        properties,
+		Class: "Car",
+		VectorConfig: map[string]models.VectorConfig{
+			"hnsw": {
+				Vectorizer:        vectorizer,
+				VectorIndexType:   "hnsw",
+				VectorIndexConfig: hnsw.NewDefaultUserConfig(),
+			},
+			"unchanged": {
+				Vectorizer:        vectorizer,
+				VectorIndexType:   "flat",
+				VectorIndexConfig: fakeVectorConfig{},
+			},
+			"sparse": {
+				Vectorizer:      vectorizer,
+				VectorIndexType: "sparse",
+			},
+		},
 		ReplicationConfig: &models.ReplicationConfig{Factor: 1},
 	}
-	fakeSchemaManager.On("QueryCollectionsCount").Return(0, nil)
-	fakeSchemaManager.On("AddClass", class, mock.Anything).Return(nil)
-	_, _, err := handler.AddClass(context.Background(), nil, class)
-	assert.Nil(t, err)
+	fakeSchemaManager.On("ReadOnlyClass", "Car").Return(class)
+	fakeSchemaManager.On("ReadOnlyClass", "Bike").Return(nil)
+	fakeSchemaManager.On("ReindexVectorIndex", "Car", "hnsw", models.VectorConfig{VectorIndexType: "flat"}).Return(nil)
+
+	err := handler.ReindexVectorIndex(context.Background(), nil, "Car", "hnsw", models.VectorConfig{VectorIndexType: "flat"})
+	require.Nil(t, err)
+
+	err = handler.ReindexVectorIndex(context.Background(), nil, "Bike", "hnsw", models.VectorConfig{VectorIndexType: "flat"})
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	err = handler.ReindexVectorIndex(context.Background(), nil, "Car", "unknown", models.VectorConfig{VectorIndexType: "flat"})
+	assert.ErrorIs(t, err, ErrNotFound)
 
-	// Now drop the property
-	handler.DeleteClassProperty(context.Background(), nil, "Car", "color")
-	// TODO: add the mock necessary to verify that the property is deleted
+	err = handler.ReindexVectorIndex(context.Background(), nil, "Car", "unchanged", models.VectorConfig{VectorIndexType: "flat"})
+	assert.ErrorContains(t, err, "already uses")
+
+	err = handler.ReindexVectorIndex(context.Background(), nil, "Car", "sparse", models.VectorConfig{VectorIndexType: "flat"})
+	assert.ErrorContains(t, err, "sparse vector indexes can not be reindexed")
+
+	err = handler.ReindexVectorIndex(context.Background(), nil, "Car", "hnsw", models.VectorConfig{VectorIndexType: "sparse"})
+	assert.ErrorContains(t, err, "sparse vector indexes can not be reindexed")
+
+	err = handler.ReindexVectorIndex(context.Background(), nil, "Car", "hnsw", models.VectorConfig{VectorIndexType: "unknown"})
+	assert.ErrorContains(t, err, "unrecognized or unsupported vectorIndexType")
+
+	err = handler.ReindexVectorIndex(context.Background(), nil, "Car", "hnsw", models.VectorConfig{
+		Vectorizer:      map[string]interface{}{"text2vec-contextionary": map[string]interface{}{}},
+		VectorIndexType: "flat",
+	})
+	assert.ErrorContains(t, err, "the vectorizer can not be changed")
 }
 
 // This grant parent test setups up the temporary directory needed for the tests.
@@ -131,6 +131,14 @@ func (f *fakeDB) AddProperty(prop string, cmd command.AddPropertyRequest) error
 	return nil
 }
 
+func (f *fakeDB) DeleteProperty(class string, cmd command.DeletePropertyRequest) error {
+	return nil
+}
+
+func (f *fakeDB) ReindexVectorIndex(class string, cmd command.ReindexVectorIndexRequest) error {
+	return nil
+}
+
 func (f *fakeDB) AddTenants(class string, cmd *command.AddTenantsRequest) error {
 	return nil
 }
@@ -314,6 +322,11 @@ func (f *fakeMigrator) ShutdownShard(ctx context.Context, class string, shard st
 	return args.Error(0)
 }
 
+func (f *fakeMigrator) DropProperty(ctx context.Context, className string, propName string) error {
+	args := f.Called(ctx, className, propName)
+	return args.Error(0)
+}
+
 func (f *fakeMigrator) UpdateProperty(ctx context.Context, className string, propName string, newName *string) error {
 	return nil
 }
@@ -359,6 +372,13 @@ func (*fakeMigrator) UpdateVectorIndexConfigs(ctx context.Context, className str
 	return nil
 }
 
+func (f *fakeMigrator) ReindexVectorIndex(ctx context.Context, className, targetVector string,
+	updated schemaConfig.VectorIndexConfig,
+) error {
+	args := f.Called(ctx, className, targetVector, updated)
+	return args.Error(0)
+}
+
 func (*fakeMigrator) ValidateInvertedIndexConfigUpdate(old, updated *models.InvertedIndexConfig) error {
 	return nil
 }
@@ -372,6 +392,10 @@ func (f *fakeMigrator) UpdateReplicationConfig(ctx context.Context, className st
 	return nil
 }
 
+func (f *fakeMigrator) UpdateObjectStorageConfig(ctx context.Context, className string, cfg *models.ObjectStorageConfig) error {
+	return nil
+}
+
 func (f *fakeMigrator) WaitForStartup(ctx context.Context) error {
 	args := f.Called(ctx)
 	return args.Error(0)
@@ -46,6 +46,7 @@ type Migrator interface {
 		props ...*models.Property) error
 	UpdateProperty(ctx context.Context, className string,
 		propName string, newName *string) error
+	DropProperty(ctx context.Context, className string, propName string) error
 	UpdateIndex(ctx context.Context, class *models.Class, shardingState *sharding.State) error
 
 	NewTenants(ctx context.Context, class *models.Class, creates []*CreateTenantPayload) error
@@ -59,11 +60,15 @@ type Migrator interface {
 	ValidateVectorIndexConfigsUpdate(old, updated map[string]schemaConfig.VectorIndexConfig) error
 	UpdateVectorIndexConfigs(ctx context.Context, className string,
 		updated map[string]schemaConfig.VectorIndexConfig) error
+	ReindexVectorIndex(ctx context.Context, className, targetVector string,
+		updated schemaConfig.VectorIndexConfig) error
 	ValidateInvertedIndexConfigUpdate(old, updated *models.InvertedIndexConfig) error
 	UpdateInvertedIndexConfig(ctx context.Context, className string,
 		updated *models.InvertedIndexConfig) error
 	UpdateReplicationConfig(ctx context.Context, className string,
 		updated *models.ReplicationConfig) error
+	UpdateObjectStorageConfig(ctx context.Context, className string,
+		updated *models.ObjectStorageConfig) error
 	WaitForStartup(context.Context) error
 	Shutdown(context.Context) error
 }
@@ -14,12 +14,15 @@ package schema
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
+	"github.com/weaviate/weaviate/adapters/repos/db/ttl"
 	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	shardingcfg "github.com/weaviate/weaviate/usecases/sharding/config"
 )
 
 // AddClassProperty it is upsert operation. it adds properties to a class and updates
@@ -92,18 +95,114 @@ func (h *Handler) AddClassProperty(ctx context.Context, principal *models.Princi
 	return class, version, err
 }
 
-// DeleteClassProperty from existing Schema
+// DeleteClassProperty removes a property from an existing class. The property's
+// buckets and the values stored in objects are removed asynchronously on every
+// shard.
 func (h *Handler) DeleteClassProperty(ctx context.Context, principal *models.Principal,
-	class string, property string,
+	className string, propName string,
 ) error {
-	err := h.Authorizer.Authorize(ctx, principal, authorization.UPDATE, authorization.CollectionsMetadata(class)...)
+	err := h.Authorizer.Authorize(ctx, principal, authorization.UPDATE, authorization.CollectionsMetadata(className)...)
 	if err != nil {
 		return err
 	}
 
-	return fmt.Errorf("deleting a property is currently not supported, see " +
-		"https://github.com/weaviate/weaviate/issues/973 for details")
-	// return h.deleteClassProperty(ctx, class, property, kind.Action)
+	className = schema.UppercaseClassName(className)
+	class := h.schemaReader.ReadOnlyClass(className)
+	if class == nil {
+		return fmt.Errorf("class %q: %w", className, ErrNotFound)
+	}
+
+	prop, err := schema.GetPropertyByName(class, schema.LowercaseFirstLetter(propName))
+	if err != nil {
+		return fmt.Errorf("property %q of class %q: %w", propName, className, ErrNotFound)
+	}
+
+	if err := validatePropertyDeletion(class, prop.Name); err != nil {
+		return err
+	}
+
+	_, err = h.schemaManager.DeleteProperty(ctx, class.Class, prop.Name)
+	return err
+}
+
+// validatePropertyDeletion makes sure that no other part of the class
+// configuration depends on the property.
+func validatePropertyDeletion(class *models.Class, propName string) error {
+	if ttl.IsTtlEnabled(class.ObjectTTLConfig) && class.ObjectTTLConfig.DeleteOn == propName {
+		return fmt.Errorf("property %q is used by objectTtlConfig.deleteOn", propName)
+	}
+
+	if shardingKey(class.ShardingConfig) == propName {
+		return fmt.Errorf("property %q is used by shardingConfig.key", propName)
+	}
+
+	if class.Vectorizer != "" {
+		if cfg, ok := class.ModuleConfig.(map[string]interface{}); ok {
+			if slices.Contains(vectorizerSourceProperties(cfg[class.Vectorizer]), propName) {
+				return fmt.Errorf("property %q is a source property of vectorizer %q", propName, class.Vectorizer)
+			}
+		}
+	}
+
+	for targetVector, vectorConfig := range class.VectorConfig {
+		vectorizers, ok := vectorConfig.Vectorizer.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for vectorizer, cfg := range vectorizers {
+			if slices.Contains(vectorizerSourceProperties(cfg), propName) {
+				return fmt.Errorf("property %q is a source property of vectorizer %q of target vector %q",
+					propName, vectorizer, targetVector)
+			}
+		}
+	}
+
+	return nil
+}
+
+// shardingKey returns the property objects are distributed by, the config
+// is either parsed already or still a map
+func shardingKey(shardingConfig interface{}) string {
+	switch cfg := shardingConfig.(type) {
+	case shardingcfg.Config:
+		return cfg.Key
+	case map[string]interface{}:
+		key, _ := cfg["key"].(string)
+		return key
+	default:
+		return ""
+	}
+}
+
+// vectorizerSourceProperties returns the properties explicitly configured as
+// input of a vectorizer: the properties of text vectorizers, the reference
+// properties of ref2vec and the fields of multi-modal vectorizers, e.g.
+// imageFields. Depending on whether the config has been parsed already, they
+// are either of type []string or []interface{}.
+func vectorizerSourceProperties(vectorizerConfig interface{}) []string {
+	cfg, ok := vectorizerConfig.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	var names []string
+	for key, value := range cfg {
+		if key != "properties" && key != "referenceProperties" && !strings.HasSuffix(key, "Fields") {
+			continue
+		}
+
+		switch props := value.(type) {
+		case []string:
+			names = append(names, props...)
+		case []interface{}:
+			for _, prop := range props {
+				if name, ok := prop.(string); ok {
+					names = append(names, name)
+				}
+			}
+		}
+	}
+	return names
 }
 
 func (h *Handler) setNewPropDefaults(class *models.Class, props ...*models.Property) error {
@@ -0,0 +1,140 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// legacyTargetVector addresses the vector index of a class without named
+// vectors
+const legacyTargetVector = "default"
+
+// VectorIndexMaintainer runs maintenance operations on the vector indexes of
+// the shards of this node
+type VectorIndexMaintainer interface {
+	StartVectorIndexMaintenance(ctx context.Context, className, shardName, targetVector,
+		operation string) (*models.VectorIndexMaintenanceOperation, error)
+	GetVectorIndexMaintenance(ctx context.Context, className, shardName,
+		targetVector string) (*models.VectorIndexMaintenanceStatus, error)
+	CancelVectorIndexMaintenance(ctx context.Context, className, shardName, targetVector,
+		id string) error
+}
+
+// SetVectorIndexMaintainer enables the maintenance operations of vector
+// indexes
+func (h *Handler) SetVectorIndexMaintainer(maintainer VectorIndexMaintainer) {
+	h.vectorIndexMaintainer = maintainer
+}
+
+// StartVectorIndexMaintenance starts a maintenance operation on the vector
+// index of a named vector of a shard on this node. Tombstone cleanups, commit
+// log condensing or snapshots and rebuilds run in the background and can be
+// followed and cancelled, pausing or resuming the maintenance cycles of the
+// index completes right away.
+func (h *Handler) StartVectorIndexMaintenance(ctx context.Context, principal *models.Principal,
+	className, shardName, targetVector, operation string,
+) (*models.VectorIndexMaintenanceOperation, error) {
+	err := h.Authorizer.Authorize(ctx, principal, authorization.UPDATE, authorization.ShardsMetadata(className, shardName)...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(vectorindex.MaintenanceOperations, operation) {
+		return nil, fmt.Errorf("unknown operation %q, expected one of %v", operation,
+			vectorindex.MaintenanceOperations)
+	}
+	className, targetVector, err = h.vectorIndexMaintenanceTarget(className, shardName, targetVector)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.vectorIndexMaintainer.StartVectorIndexMaintenance(ctx, className, shardName,
+		targetVector, operation)
+}
+
+// GetVectorIndexMaintenance returns whether the maintenance cycles of the
+// vector index of a named vector of a shard on this node are paused and its
+// recent maintenance operations
+func (h *Handler) GetVectorIndexMaintenance(ctx context.Context, principal *models.Principal,
+	className, shardName, targetVector string,
+) (*models.VectorIndexMaintenanceStatus, error) {
+	err := h.Authorizer.Authorize(ctx, principal, authorization.READ, authorization.ShardsMetadata(className, shardName)...)
+	if err != nil {
+		return nil, err
+	}
+
+	className, targetVector, err = h.vectorIndexMaintenanceTarget(className, shardName, targetVector)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.vectorIndexMaintainer.GetVectorIndexMaintenance(ctx, className, shardName, targetVector)
+}
+
+// CancelVectorIndexMaintenance cancels a running maintenance operation on the
+// vector index of a named vector of a shard on this node
+func (h *Handler) CancelVectorIndexMaintenance(ctx context.Context, principal *models.Principal,
+	className, shardName, targetVector, id string,
+) error {
+	err := h.Authorizer.Authorize(ctx, principal, authorization.UPDATE, authorization.ShardsMetadata(className, shardName)...)
+	if err != nil {
+		return err
+	}
+
+	className, targetVector, err = h.vectorIndexMaintenanceTarget(className, shardName, targetVector)
+	if err != nil {
+		return err
+	}
+
+	err = h.vectorIndexMaintainer.CancelVectorIndexMaintenance(ctx, className, shardName, targetVector, id)
+	if errors.Is(err, vectorindex.ErrMaintenanceNotFound) {
+		return fmt.Errorf("%w: %w", ErrNotFound, err)
+	}
+	return err
+}
+
+// vectorIndexMaintenanceTarget makes sure that the class and its target
+// vector exist and that the shard is hosted by this node, it returns the name
+// of the target vector as used by the shards
+func (h *Handler) vectorIndexMaintenanceTarget(className, shardName, targetVector string) (string, string, error) {
+	if h.vectorIndexMaintainer == nil {
+		return "", "", errors.New("vector index maintenance is not available")
+	}
+
+	className = schema.UppercaseClassName(className)
+	class := h.schemaReader.ReadOnlyClass(className)
+	if class == nil {
+		return "", "", fmt.Errorf("class %q: %w", className, ErrNotFound)
+	}
+	if _, ok := class.VectorConfig[targetVector]; !ok {
+		if targetVector != legacyTargetVector || class.VectorIndexConfig == nil {
+			return "", "", fmt.Errorf("target vector %q of class %q: %w", targetVector, className, ErrNotFound)
+		}
+		targetVector = ""
+	}
+
+	replicas, err := h.schemaReader.ShardReplicas(class.Class, shardName)
+	if err != nil || !slices.Contains(replicas, h.clusterState.LocalName()) {
+		return "", "", fmt.Errorf("shard %q of class %q on node %q: %w", shardName, className,
+			h.clusterState.LocalName(), ErrNotFound)
+	}
+	return class.Class, targetVector, nil
+}
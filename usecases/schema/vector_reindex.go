@@ -0,0 +1,185 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/cluster/distributedtask"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// VectorReindexTasks submits the distributed tasks which track the rebuild of
+// a vector index across the cluster
+type VectorReindexTasks interface {
+	distributedtask.TasksLister
+	AddDistributedTask(ctx context.Context, namespace, taskID string, taskPayload any) error
+	CancelDistributedTask(ctx context.Context, namespace, taskID string, taskVersion uint64) error
+}
+
+// SetVectorReindexTasks enables tracking the rebuild of vector indexes with
+// distributed tasks, which requires them to be enabled in the cluster
+func (h *Handler) SetVectorReindexTasks(tasks VectorReindexTasks) {
+	h.vectorReindexTasks = tasks
+}
+
+// ReindexVectorIndex moves a named vector of an existing class to a vector
+// index of another type, distance or quantizer. Every shard builds the new
+// index in the background from the vectors already stored, encoding them
+// with the quantizer of the new index, and swaps it in once complete. The
+// progress is reported per shard in the verbose nodes status and, if
+// distributed tasks are enabled, per node in the task of the rebuild.
+func (h *Handler) ReindexVectorIndex(ctx context.Context, principal *models.Principal,
+	className, targetVector string, updated models.VectorConfig,
+) error {
+	err := h.Authorizer.Authorize(ctx, principal, authorization.UPDATE, authorization.CollectionsMetadata(className)...)
+	if err != nil {
+		return err
+	}
+
+	className = schema.UppercaseClassName(className)
+	class := h.schemaReader.ReadOnlyClass(className)
+	if class == nil {
+		return fmt.Errorf("class %q: %w", className, ErrNotFound)
+	}
+	current, ok := class.VectorConfig[targetVector]
+	if !ok {
+		return fmt.Errorf("target vector %q of class %q: %w", targetVector, className, ErrNotFound)
+	}
+
+	if err := validateVectorReindex(current, updated); err != nil {
+		return fmt.Errorf("target vector %q: %w", targetVector, err)
+	}
+	if err := h.validateVectorIndexType(updated.VectorIndexType); err != nil {
+		return fmt.Errorf("target vector %q: %w", targetVector, err)
+	}
+
+	parsed := &models.Class{
+		Class:              class.Class,
+		MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+		VectorConfig: map[string]models.VectorConfig{targetVector: {
+			Vectorizer:        current.Vectorizer,
+			VectorIndexType:   updated.VectorIndexType,
+			VectorIndexConfig: updated.VectorIndexConfig,
+		}},
+	}
+	if err := h.parser.ParseClass(parsed); err != nil {
+		return fmt.Errorf("target vector %q: %w", targetVector, err)
+	}
+
+	currentCfg, ok := current.VectorIndexConfig.(schemaConfig.VectorIndexConfig)
+	if !ok {
+		return fmt.Errorf("target vector %q: config is not schema.VectorIndexConfig: %T",
+			targetVector, current.VectorIndexConfig)
+	}
+	updatedCfg := parsed.VectorConfig[targetVector].VectorIndexConfig.(schemaConfig.VectorIndexConfig)
+	if !needsVectorReindex(currentCfg, updatedCfg) {
+		return fmt.Errorf("target vector %q already uses a %q index with distance %q and a compatible "+
+			"compression, update the class to change other settings of the index",
+			targetVector, updatedCfg.IndexType(), updatedCfg.DistanceName())
+	}
+
+	_, err = h.schemaManager.ReindexVectorIndex(ctx, class.Class, targetVector, models.VectorConfig{
+		VectorIndexType:   updated.VectorIndexType,
+		VectorIndexConfig: updated.VectorIndexConfig,
+	})
+	if err != nil {
+		return err
+	}
+
+	if h.vectorReindexTasks != nil {
+		// the rebuild was already started, it only cannot be followed as task
+		if err := h.submitVectorReindexTask(ctx, class.Class, targetVector, updatedCfg); err != nil {
+			h.logger.WithField("action", "reindex_vector_index").
+				WithField("collection", class.Class).
+				WithField("targetVector", targetVector).
+				WithError(err).
+				Warn("failed to submit the task tracking the vector index rebuild")
+		}
+	}
+	return nil
+}
+
+// needsVectorReindex tells whether the vector index needs to be rebuilt to
+// change from the current to the updated config. Compression can be enabled
+// on an existing index, but not switched to another quantizer.
+func needsVectorReindex(current, updated schemaConfig.VectorIndexConfig) bool {
+	currentCompression, updatedCompression := vectorindex.Compression(current), vectorindex.Compression(updated)
+	return current.IndexType() != updated.IndexType() ||
+		current.DistanceName() != updated.DistanceName() ||
+		current.IsMultiVector() != updated.IsMultiVector() ||
+		(currentCompression != "" && updatedCompression != "" && currentCompression != updatedCompression)
+}
+
+// submitVectorReindexTask replaces the task tracking a previous rebuild of
+// the target vector, if still running, with one tracking the new rebuild
+func (h *Handler) submitVectorReindexTask(ctx context.Context, className, targetVector string,
+	cfg schemaConfig.VectorIndexConfig,
+) error {
+	taskID := vectorindex.ReindexTaskID(className, targetVector)
+	tasks, err := h.vectorReindexTasks.ListDistributedTasks(ctx)
+	if err != nil {
+		return fmt.Errorf("list tasks: %w", err)
+	}
+	for _, task := range tasks[vectorindex.ReindexTaskNamespace] {
+		if task.ID != taskID || task.Status != distributedtask.TaskStatusStarted {
+			continue
+		}
+		if err := h.vectorReindexTasks.CancelDistributedTask(ctx, vectorindex.ReindexTaskNamespace,
+			task.ID, task.Version); err != nil {
+			return fmt.Errorf("cancel task of previous rebuild: %w", err)
+		}
+	}
+
+	distance := cfg.DistanceName()
+	if distance == "" {
+		distance = vectorIndexCommon.DefaultDistanceMetric
+	}
+	return h.vectorReindexTasks.AddDistributedTask(ctx, vectorindex.ReindexTaskNamespace, taskID,
+		vectorindex.ReindexTaskPayload{
+			Collection:   className,
+			TargetVector: targetVector,
+			IndexType:    cfg.IndexType(),
+			Distance:     distance,
+			Compression:  vectorindex.Compression(cfg),
+		})
+}
+
+// validateVectorReindex makes sure that only the vector index of a named
+// vector is changed, as its vectors are kept as they are
+func validateVectorReindex(current, updated models.VectorConfig) error {
+	if current.VectorIndexType == vectorindex.VectorIndexTypeSparse ||
+		updated.VectorIndexType == vectorindex.VectorIndexTypeSparse {
+		return fmt.Errorf("sparse vector indexes can not be reindexed")
+	}
+
+	if updated.Vectorizer == nil {
+		return nil
+	}
+	currentVectorizers, _ := current.Vectorizer.(map[string]interface{})
+	updatedVectorizers, ok := updated.Vectorizer.(map[string]interface{})
+	if !ok || len(updatedVectorizers) != len(currentVectorizers) {
+		return fmt.Errorf("the vectorizer can not be changed by a reindex")
+	}
+	for name := range updatedVectorizers {
+		if _, ok := currentVectorizers[name]; !ok {
+			return fmt.Errorf("the vectorizer can not be changed by a reindex")
+		}
+	}
+	return nil
+}
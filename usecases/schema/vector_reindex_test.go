@@ -0,0 +1,124 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/cluster/distributedtask"
+	"github.com/weaviate/weaviate/entities/models"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestNeedsVectorReindex(t *testing.T) {
+	withPQ := hnsw.NewDefaultUserConfig()
+	withPQ.PQ.Enabled = true
+	withRQ8 := hnsw.NewDefaultUserConfig()
+	withRQ8.RQ.Enabled = true
+	withRQ8.RQ.Bits = 8
+	withRQ1 := withRQ8
+	withRQ1.RQ.Bits = 1
+	withDot := hnsw.NewDefaultUserConfig()
+	withDot.Distance = "dot"
+
+	tests := []struct {
+		name     string
+		current  hnsw.UserConfig
+		updated  schemaConfig.VectorIndexConfig
+		expected bool
+	}{
+		{name: "same config", current: withPQ, updated: withPQ, expected: false},
+		{name: "enable compression", current: hnsw.NewDefaultUserConfig(), updated: withPQ, expected: false},
+		{name: "switch from pq to rq", current: withPQ, updated: withRQ8, expected: true},
+		{name: "change rq bits", current: withRQ8, updated: withRQ1, expected: true},
+		{name: "change distance", current: hnsw.NewDefaultUserConfig(), updated: withDot, expected: true},
+		{name: "change index type", current: withPQ, updated: flat.NewDefaultUserConfig(), expected: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, needsVectorReindex(test.current, test.updated))
+		})
+	}
+}
+
+type fakeVectorReindexTasks struct {
+	tasks     map[string][]*distributedtask.Task
+	added     []vectorindex.ReindexTaskPayload
+	cancelled []uint64
+}
+
+func (f *fakeVectorReindexTasks) ListDistributedTasks(context.Context) (map[string][]*distributedtask.Task, error) {
+	return f.tasks, nil
+}
+
+func (f *fakeVectorReindexTasks) AddDistributedTask(_ context.Context, namespace, taskID string, taskPayload any) error {
+	payload := taskPayload.(vectorindex.ReindexTaskPayload)
+	if namespace != vectorindex.ReindexTaskNamespace ||
+		taskID != vectorindex.ReindexTaskID(payload.Collection, payload.TargetVector) {
+		return assert.AnError
+	}
+	f.added = append(f.added, payload)
+	return nil
+}
+
+func (f *fakeVectorReindexTasks) CancelDistributedTask(_ context.Context, _, _ string, taskVersion uint64) error {
+	f.cancelled = append(f.cancelled, taskVersion)
+	return nil
+}
+
+func TestReindexVectorIndexSubmitsTask(t *testing.T) {
+	handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+	tasks := &fakeVectorReindexTasks{tasks: map[string][]*distributedtask.Task{
+		vectorindex.ReindexTaskNamespace: {
+			{
+				TaskDescriptor: distributedtask.TaskDescriptor{ID: "Car/vec", Version: 3},
+				Status:         distributedtask.TaskStatusStarted,
+			},
+			{
+				TaskDescriptor: distributedtask.TaskDescriptor{ID: "Car/other", Version: 4},
+				Status:         distributedtask.TaskStatusStarted,
+			},
+		},
+	}}
+	handler.SetVectorReindexTasks(tasks)
+
+	class := &models.Class{
+		Class: "Car",
+		VectorConfig: map[string]models.VectorConfig{
+			"vec": {
+				Vectorizer:        map[string]interface{}{"none": map[string]interface{}{}},
+				VectorIndexType:   "hnsw",
+				VectorIndexConfig: hnsw.NewDefaultUserConfig(),
+			},
+		},
+	}
+	fakeSchemaManager.On("ReadOnlyClass", "Car").Return(class)
+	fakeSchemaManager.On("ReindexVectorIndex", "Car", "vec", models.VectorConfig{VectorIndexType: "flat"}).Return(nil)
+
+	err := handler.ReindexVectorIndex(context.Background(), nil, "Car", "vec", models.VectorConfig{VectorIndexType: "flat"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []uint64{3}, tasks.cancelled)
+	assert.Equal(t, []vectorindex.ReindexTaskPayload{{
+		Collection:   "Car",
+		TargetVector: "vec",
+		IndexType:    "fake",
+		Distance:     "cosine",
+	}}, tasks.added)
+}
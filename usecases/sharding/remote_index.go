@@ -99,6 +99,7 @@ type RemoteIndexClient interface {
 		uuids []strfmt.UUID, deletionTime time.Time, dryRun bool, schemaVersion uint64) objects.BatchSimpleObjects
 	GetShardQueueSize(ctx context.Context, hostName, indexName, shardName string) (int64, error)
 	GetShardStatus(ctx context.Context, hostName, indexName, shardName string) (string, error)
+	GetShardReindexed(ctx context.Context, hostName, indexName, shardName, task string) (bool, error)
 	UpdateShardStatus(ctx context.Context, hostName, indexName, shardName, targetStatus string, schemaVersion uint64) error
 
 	PutFile(ctx context.Context, hostName, indexName, shardName, fileName string,
@@ -415,6 +416,17 @@ func (ri *RemoteIndex) GetShardStatus(ctx context.Context, shardName string) (st
 	return ri.client.GetShardStatus(ctx, host, ri.class, shardName)
 }
 
+// GetShardReindexed tells whether the replica of the shard on the node
+// finished the reindexing task
+func (ri *RemoteIndex) GetShardReindexed(ctx context.Context, nodeName, shardName, task string) (bool, error) {
+	host, ok := ri.nodeResolver.NodeHostname(nodeName)
+	if !ok {
+		return false, fmt.Errorf("resolve node name %q to host", nodeName)
+	}
+
+	return ri.client.GetShardReindexed(ctx, host, ri.class, shardName, task)
+}
+
 func (ri *RemoteIndex) UpdateShardStatus(ctx context.Context, shardName, targetStatus string, schemaVersion uint64) error {
 	owner, err := ri.stateGetter.ShardOwner(ri.class, shardName)
 	if err != nil {
@@ -79,6 +79,7 @@ type RemoteIndexIncomingRepo interface {
 		uuids []strfmt.UUID, deletionTime time.Time, dryRun bool, schemaVersion uint64) objects.BatchSimpleObjects
 	IncomingGetShardQueueSize(ctx context.Context, shardName string) (int64, error)
 	IncomingGetShardStatus(ctx context.Context, shardName string) (string, error)
+	IncomingGetShardReindexed(ctx context.Context, shardName, task string) (bool, error)
 	IncomingUpdateShardStatus(ctx context.Context, shardName, targetStatus string, schemaVersion uint64) error
 	IncomingOverwriteObjects(ctx context.Context, shard string,
 		vobjects []*objects.VObject) ([]types.RepairResponse, error)
@@ -283,6 +284,17 @@ func (rii *RemoteIndexIncoming) GetShardStatus(ctx context.Context,
 	return index.IncomingGetShardStatus(ctx, shardName)
 }
 
+func (rii *RemoteIndexIncoming) GetShardReindexed(ctx context.Context,
+	indexName, shardName, task string,
+) (bool, error) {
+	index := rii.repo.GetIndexForIncomingSharding(schema.ClassName(indexName))
+	if index == nil {
+		return false, enterrors.NewErrUnprocessable(errors.Errorf("local index %q not found", indexName))
+	}
+
+	return index.IncomingGetShardReindexed(ctx, shardName, task)
+}
+
 func (rii *RemoteIndexIncoming) UpdateShardStatus(ctx context.Context,
 	indexName, shardName, targetStatus string, schemaVersion uint64,
 ) error {
@@ -20,6 +20,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/ttl"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema/configvalidation"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 
@@ -92,6 +93,7 @@ type objectsSearcher interface {
 	// GraphQL Get{} queries
 	Search(ctx context.Context, params dto.GetParams) ([]search.Result, error)
 	VectorSearch(ctx context.Context, params dto.GetParams, targetVectors []string, searchVectors []models.Vector) ([]search.Result, error)
+	VectorSearchBatch(ctx context.Context, params dto.GetParams, targetVector string, searchVectors [][]float32) ([][]search.Result, error)
 
 	// GraphQL Explore{} queries
 	CrossClassVectorSearch(ctx context.Context, vector models.Vector, targetVector string, offset, limit int,
@@ -131,6 +133,37 @@ func (e *Explorer) SetSchemaGetter(sg uc.SchemaGetter) {
 // GetClass from search and connector repo
 func (e *Explorer) GetClass(ctx context.Context,
 	params dto.GetParams,
+) ([]interface{}, error) {
+	highlightOnlyProps, err := e.prepareHighlights(&params)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.MultivectorAggregation != "" {
+		// the vector indexes read the override from the context, so that it
+		// reaches every shard searched for the query
+		ctx = hnswent.ContextWithMultivectorAggregation(ctx, params.MultivectorAggregation)
+	}
+
+	if params.Snapshot != "" {
+		// the shards read the snapshot id from the context, so that it also
+		// reaches the shards searched for hybrid and grouped queries
+		ctx = dto.ContextWithReadSnapshot(ctx, params.Snapshot)
+	}
+
+	res, err := e.getClass(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.addHighlights(res, params, highlightOnlyProps); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (e *Explorer) getClass(ctx context.Context,
+	params dto.GetParams,
 ) ([]interface{}, error) {
 	searchStartTime := time.Now()
 	if params.Pagination == nil {
@@ -0,0 +1,110 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// GetClassBatch searches the nearest objects of each of the search vectors.
+// The filters, pagination and selected properties of the params are shared by
+// all queries, the params must not contain a search of their own. It returns
+// one result list per search vector, in the order of the search vectors.
+func (e *Explorer) GetClassBatch(ctx context.Context, params dto.GetParams,
+	targetVector string, searchVectors [][]float32,
+) ([][]interface{}, error) {
+	searchStartTime := time.Now()
+	if err := validateBatchParams(params); err != nil {
+		return nil, err
+	}
+	if len(searchVectors) == 0 {
+		return nil, errors.New("batch search requires at least one vector")
+	}
+	if params.Pagination == nil {
+		params.Pagination = &filters.Pagination{
+			Offset: 0,
+			Limit:  int(e.config.QueryDefaults.LimitGraphQL),
+		}
+	}
+
+	var targetVectors []string
+	if targetVector != "" {
+		targetVectors = []string{targetVector}
+	}
+	targetVectors, err := e.targetParamHelper.GetTargetVectorOrDefault(e.schemaGetter.GetSchemaSkipAuth(),
+		params.ClassName, targetVectors)
+	if err != nil {
+		return nil, errors.Errorf("explorer: get class batch: validate target vector: %v", err)
+	}
+	if len(targetVectors) != 1 {
+		return nil, errors.New("explorer: get class batch: exactly one target vector is required")
+	}
+
+	if len(params.AdditionalProperties.ModuleParams) > 0 {
+		// modules extending the results expect the vector to be present, see
+		// getClassVectorSearch
+		params.AdditionalProperties.Vector = true
+	}
+
+	ress, err := e.searcher.VectorSearchBatch(ctx, params, targetVectors[0], searchVectors)
+	if err != nil {
+		return nil, errors.Errorf("explorer: get class batch: vector search: %v", err)
+	}
+
+	out := make([][]interface{}, len(ress))
+	for i, res := range ress {
+		searchVector := models.Vector(searchVectors[i])
+		if e.modulesProvider != nil {
+			res, err = e.modulesProvider.GetExploreAdditionalExtend(ctx, res,
+				params.AdditionalProperties.ModuleParams, searchVector, params.ModuleParams)
+			if err != nil {
+				return nil, errors.Errorf("explorer: get class batch: extend: %v", err)
+			}
+		}
+		if len(res) > 0 && e.metrics != nil {
+			e.metrics.AddUsageDimensions(params.ClassName, "get_graphql", "nearVector", res[0].Dims)
+		}
+
+		out[i], err = e.searchResultsToGetResponse(ctx, res, searchVector, params, searchStartTime)
+		if err != nil {
+			return nil, fmt.Errorf("query %d: %w", i, err)
+		}
+	}
+	return out, nil
+}
+
+// validateBatchParams rejects the parts of a query which cannot be shared by
+// the queries of a batch
+func validateBatchParams(params dto.GetParams) error {
+	switch {
+	case params.NearVector != nil, params.NearObject != nil, len(params.ModuleParams) > 0,
+		params.KeywordRanking != nil, params.HybridSearch != nil:
+		return errors.New("batch search does not support an additional search")
+	case len(params.Sort) > 0:
+		return errors.New("batch search does not support sort")
+	case params.GroupBy != nil, params.Group != nil:
+		return errors.New("batch search does not support grouping")
+	case params.Cursor != nil:
+		return errors.New("batch search does not support the cursor api")
+	case params.TargetVectorCombination != nil:
+		return errors.New("batch search does not support multiple target vectors")
+	}
+	return nil
+}
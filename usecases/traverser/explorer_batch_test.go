@@ -0,0 +1,115 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func Test_Explorer_GetClassBatch(t *testing.T) {
+	newExplorer := func(searcher *fakeVectorSearcher, metrics *fakeMetrics) *Explorer {
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, log, getFakeModulesProvider(), metrics, defaultConfig)
+		explorer.SetSchemaGetter(&fakeSchemaGetter{
+			schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+				{
+					Class: "BestClass",
+					VectorConfig: map[string]models.VectorConfig{
+						"first":  {VectorIndexType: "hnsw"},
+						"second": {VectorIndexType: "hnsw"},
+					},
+				},
+			}}},
+		})
+		return explorer
+	}
+
+	t.Run("returns one result list per vector", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName:  "BestClass",
+			Pagination: &filters.Pagination{Limit: 10},
+		}
+		vectors := [][]float32{{0.1, 0.2}, {0.3, 0.4}}
+
+		searcher := &fakeVectorSearcher{}
+		metrics := &fakeMetrics{}
+		searcher.On("VectorSearchBatch", params, "second", vectors).
+			Return([][]search.Result{
+				{
+					{ID: "id1", Schema: map[string]interface{}{"name": "Foo"}, Dims: 2},
+					{ID: "id2", Schema: map[string]interface{}{"name": "Bar"}, Dims: 2},
+				},
+				{},
+			}, nil)
+		metrics.On("AddUsageDimensions", "BestClass", "get_graphql", "nearVector", 2)
+
+		res, err := newExplorer(searcher, metrics).GetClassBatch(context.Background(), params, "second", vectors)
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"name": "Foo"},
+			map[string]interface{}{"name": "Bar"},
+		}, res[0])
+		assert.Empty(t, res[1])
+		searcher.AssertExpectations(t)
+		metrics.AssertExpectations(t)
+	})
+
+	t.Run("requires a target vector if there are several", func(t *testing.T) {
+		params := dto.GetParams{ClassName: "BestClass"}
+		_, err := newExplorer(&fakeVectorSearcher{}, &fakeMetrics{}).
+			GetClassBatch(context.Background(), params, "", [][]float32{{0.1, 0.2}})
+		require.NotNil(t, err)
+	})
+
+	t.Run("rejects params which cannot be shared", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			params dto.GetParams
+		}{
+			{
+				name: "near vector",
+				params: dto.GetParams{NearVector: &searchparams.NearVector{
+					Vectors: []models.Vector{[]float32{0.1, 0.2}},
+				}},
+			},
+			{
+				name:   "sort",
+				params: dto.GetParams{Sort: []filters.Sort{{Path: []string{"name"}, Order: "asc"}}},
+			},
+			{
+				name:   "group by",
+				params: dto.GetParams{GroupBy: &searchparams.GroupBy{Property: "name"}},
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				tt.params.ClassName = "BestClass"
+				_, err := newExplorer(&fakeVectorSearcher{}, &fakeMetrics{}).
+					GetClassBatch(context.Background(), tt.params, "first", [][]float32{{0.1, 0.2}})
+				require.NotNil(t, err)
+			})
+		}
+	})
+}
@@ -0,0 +1,541 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/tokenizer"
+)
+
+const (
+	// maxHighlightsFragments limits the number of fragments returned per
+	// property, so that long texts with many matches do not blow up responses
+	maxHighlightsFragments = 5
+
+	highlightsPreTag  = "<em>"
+	highlightsPostTag = "</em>"
+)
+
+// highlighter extracts the fragments of property values containing the terms
+// of a bm25 or hybrid query. It uses the terms the bm25 searcher looked up,
+// including the terms fuzzy query terms were expanded to, and marks the
+// phrases of the query as a whole. Without terms of the searcher, e.g. if
+// only the vector search of a hybrid query found results, the query is
+// tokenized per tokenization and stopwords are removed for word
+// tokenization, the same way the bm25 searcher does when scoring.
+//
+// Highlights are computed from the values of the results after the search, no
+// extra index data is stored. Only bm25 and hybrid queries can be highlighted
+// and grouped results are not.
+type highlighter struct {
+	class        *models.Class
+	query        string
+	fragmentSize int
+	stopwords    stopwords.StopwordDetector
+	searched     *additional.HighlightTerms
+	termsByToken map[string]map[string]struct{}
+	phrasesByTok map[string][]highlightPhrase
+}
+
+type highlightPhrase struct {
+	terms []string
+	slop  int
+}
+
+// prepareHighlights validates the requested highlights and makes sure that
+// the highlighted properties are fetched. It returns the names of the
+// properties which were only added for highlighting and need to be removed
+// from the results again.
+func (e *Explorer) prepareHighlights(params *dto.GetParams) ([]string, error) {
+	requested := params.AdditionalProperties.Highlights
+	if requested == nil {
+		return nil, nil
+	}
+
+	query, searched := highlightsQuery(*params)
+	if query == "" {
+		return nil, fmt.Errorf("highlights require a bm25 or hybrid query")
+	}
+	if requested.FragmentSize < 0 {
+		return nil, fmt.Errorf("highlights: fragmentSize must not be negative, got %d", requested.FragmentSize)
+	}
+
+	class := e.schemaGetter.ReadOnlyClass(params.ClassName)
+	if class == nil {
+		return nil, fmt.Errorf("highlights: class %q not found", params.ClassName)
+	}
+
+	props := requested.Properties
+	if len(props) == 0 {
+		for _, prop := range searched {
+			props = append(props, strings.Split(prop, "^")[0])
+		}
+	}
+	if len(props) == 0 {
+		for _, prop := range class.Properties {
+			if isTextProperty(prop) && inverted.HasSearchableIndex(prop) {
+				props = append(props, prop.Name)
+			}
+		}
+	}
+	for _, propName := range props {
+		prop, err := schema.GetPropertyByName(class, propName)
+		if err != nil {
+			return nil, fmt.Errorf("highlights: %w", err)
+		}
+		if !isTextProperty(prop) {
+			return nil, fmt.Errorf("highlights: property %q is not of type text or text[]", propName)
+		}
+	}
+
+	fragmentSize := requested.FragmentSize
+	if fragmentSize == 0 {
+		fragmentSize = additional.DefaultHighlightsFragmentSize
+	}
+	params.AdditionalProperties.Highlights = &additional.Highlights{
+		Properties:   props,
+		FragmentSize: fragmentSize,
+	}
+
+	var added []string
+	if params.AdditionalProperties.NoProps {
+		params.AdditionalProperties.NoProps = false
+		params.Properties = nil
+	}
+	for _, propName := range props {
+		if params.Properties.FindProperty(propName) == nil {
+			params.Properties = append(params.Properties, search.SelectProperty{Name: propName, IsPrimitive: true})
+			added = append(added, propName)
+		}
+	}
+	return added, nil
+}
+
+// addHighlights adds the highlights to the additional properties of the
+// results and removes the properties which were only fetched for
+// highlighting. Grouped results are not highlighted.
+func (e *Explorer) addHighlights(results []interface{}, params dto.GetParams, added []string) error {
+	if params.AdditionalProperties.Highlights == nil {
+		return nil
+	}
+	searched := takeHighlightTerms(results)
+	if params.GroupBy != nil {
+		return nil
+	}
+
+	class := e.schemaGetter.ReadOnlyClass(params.ClassName)
+	if class == nil {
+		return fmt.Errorf("highlights: class %q not found", params.ClassName)
+	}
+	h, err := newHighlighter(class, params, searched)
+	if err != nil {
+		return err
+	}
+
+	for _, result := range results {
+		props, ok := result.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		highlights := make([]*additional.Highlight, 0, len(params.AdditionalProperties.Highlights.Properties))
+		for _, propName := range params.AdditionalProperties.Highlights.Properties {
+			prop, err := schema.GetPropertyByName(class, propName)
+			if err != nil {
+				return fmt.Errorf("highlights: %w", err)
+			}
+			fragments := h.fragments(prop, props[propName])
+			if len(fragments) > 0 {
+				highlights = append(highlights, &additional.Highlight{Property: propName, Fragments: fragments})
+			}
+		}
+
+		additionalProps, ok := props["_additional"].(map[string]interface{})
+		if !ok {
+			additionalProps = map[string]interface{}{}
+			props["_additional"] = additionalProps
+		}
+		additionalProps["highlights"] = highlights
+
+		for _, propName := range added {
+			delete(props, propName)
+		}
+	}
+	return nil
+}
+
+// takeHighlightTerms removes the terms added by the bm25 searcher from the
+// results and merges them. Results of a hybrid query found by the vector
+// search only carry no terms, so the terms of all results are used for each.
+func takeHighlightTerms(results []interface{}) *additional.HighlightTerms {
+	var merged *additional.HighlightTerms
+	seenTerms := map[string]map[string]struct{}{}
+	seenPhrases := map[additional.HighlightPhrase]struct{}{}
+	for _, result := range results {
+		props, ok := result.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		additionalProps, ok := props["_additional"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		terms, ok := additionalProps["highlightTerms"].(*additional.HighlightTerms)
+		delete(additionalProps, "highlightTerms")
+		if !ok || terms == nil {
+			continue
+		}
+
+		if merged == nil {
+			merged = &additional.HighlightTerms{Terms: map[string][]string{}}
+		}
+		for tokenization, tokenizationTerms := range terms.Terms {
+			if seenTerms[tokenization] == nil {
+				seenTerms[tokenization] = map[string]struct{}{}
+			}
+			for _, term := range tokenizationTerms {
+				if _, ok := seenTerms[tokenization][term]; !ok {
+					seenTerms[tokenization][term] = struct{}{}
+					merged.Terms[tokenization] = append(merged.Terms[tokenization], term)
+				}
+			}
+		}
+		for _, phrase := range terms.Phrases {
+			if _, ok := seenPhrases[phrase]; !ok {
+				seenPhrases[phrase] = struct{}{}
+				merged.Phrases = append(merged.Phrases, phrase)
+			}
+		}
+	}
+	return merged
+}
+
+func highlightsQuery(params dto.GetParams) (string, []string) {
+	if params.KeywordRanking != nil {
+		return params.KeywordRanking.Query, params.KeywordRanking.Properties
+	}
+	if params.HybridSearch != nil {
+		return params.HybridSearch.Query, params.HybridSearch.Properties
+	}
+	return "", nil
+}
+
+func isTextProperty(prop *models.Property) bool {
+	dt, ok := schema.AsPrimitive(prop.DataType)
+	return ok && (dt == schema.DataTypeText || dt == schema.DataTypeTextArray)
+}
+
+func newHighlighter(class *models.Class, params dto.GetParams, searched *additional.HighlightTerms) (*highlighter, error) {
+	query, _ := highlightsQuery(params)
+	config := models.StopwordConfig{Preset: stopwords.EnglishPreset}
+	if class.InvertedIndexConfig != nil && class.InvertedIndexConfig.Stopwords != nil {
+		config = *class.InvertedIndexConfig.Stopwords
+	}
+	detector, err := stopwords.NewDetectorFromConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("highlights: %w", err)
+	}
+
+	return &highlighter{
+		class:        class,
+		query:        query,
+		fragmentSize: params.AdditionalProperties.Highlights.FragmentSize,
+		stopwords:    detector,
+		searched:     searched,
+		termsByToken: map[string]map[string]struct{}{},
+		phrasesByTok: map[string][]highlightPhrase{},
+	}, nil
+}
+
+func (h *highlighter) queryTerms(tokenization string) map[string]struct{} {
+	if terms, ok := h.termsByToken[tokenization]; ok {
+		return terms
+	}
+
+	terms := map[string]struct{}{}
+	if searched, ok := h.searchedTerms(tokenization); ok {
+		for _, term := range searched {
+			terms[term] = struct{}{}
+		}
+	} else {
+		for _, term := range tokenizer.TokenizeForClass(tokenization, h.query, h.class.Class) {
+			if tokenization == models.PropertyTokenizationWord && h.stopwords.IsStopword(term) {
+				continue
+			}
+			terms[term] = struct{}{}
+		}
+	}
+	h.termsByToken[tokenization] = terms
+	return terms
+}
+
+func (h *highlighter) searchedTerms(tokenization string) ([]string, bool) {
+	if h.searched == nil {
+		return nil, false
+	}
+	terms, ok := h.searched.Terms[tokenization]
+	return terms, ok
+}
+
+func (h *highlighter) queryPhrases(tokenization string) []highlightPhrase {
+	if phrases, ok := h.phrasesByTok[tokenization]; ok {
+		return phrases
+	}
+
+	var phrases []highlightPhrase
+	if h.searched != nil {
+		for _, phrase := range h.searched.Phrases {
+			terms := tokenizer.TokenizeForClass(tokenization, phrase.Text, h.class.Class)
+			if len(terms) > 0 {
+				phrases = append(phrases, highlightPhrase{terms: terms, slop: phrase.Slop})
+			}
+		}
+	}
+	h.phrasesByTok[tokenization] = phrases
+	return phrases
+}
+
+func (h *highlighter) fragments(prop *models.Property, value interface{}) []string {
+	var texts []string
+	switch v := value.(type) {
+	case string:
+		texts = []string{v}
+	case []string:
+		texts = v
+	case []interface{}:
+		for _, elem := range v {
+			if text, ok := elem.(string); ok {
+				texts = append(texts, text)
+			}
+		}
+	default:
+		return nil
+	}
+
+	terms := h.queryTerms(prop.Tokenization)
+	phrases := h.queryPhrases(prop.Tokenization)
+	if len(terms) == 0 && len(phrases) == 0 {
+		return nil
+	}
+
+	var fragments []string
+	for _, text := range texts {
+		matches := h.matches(prop.Tokenization, text, terms, phrases)
+		fragments = append(fragments, buildFragments(text, matches, h.fragmentSize,
+			maxHighlightsFragments-len(fragments))...)
+		if len(fragments) >= maxHighlightsFragments {
+			break
+		}
+	}
+	return fragments
+}
+
+type termMatch struct {
+	start, end int
+}
+
+// matches returns the byte ranges of the terms of text which are query terms
+// and of the occurrences of the phrases, from their first to their last term.
+func (h *highlighter) matches(tokenization, text string, terms map[string]struct{},
+	phrases []highlightPhrase,
+) []termMatch {
+	tokens, offsets := h.termOffsets(tokenization, text)
+
+	var matches []termMatch
+	for i, term := range tokens {
+		if offsets[i].start < 0 {
+			continue
+		}
+		if _, ok := terms[term]; ok {
+			matches = append(matches, offsets[i])
+		}
+	}
+
+	for _, phrase := range phrases {
+		// the positions of the terms are their indexes, the same way the
+		// positions of the inverted index are counted
+		positions := make([][]uint32, len(phrase.terms))
+		for i, term := range tokens {
+			for j, phraseTerm := range phrase.terms {
+				if term == phraseTerm {
+					positions[j] = append(positions[j], uint32(i))
+				}
+			}
+		}
+		for _, span := range inverted.PhraseSpans(positions, phrase.slop) {
+			first, last := offsets[span[0]], offsets[span[1]]
+			if first.start >= 0 && last.start >= 0 {
+				matches = append(matches, termMatch{start: first.start, end: last.end})
+			}
+		}
+	}
+	return mergeMatches(matches)
+}
+
+// termOffsets returns the terms of text and their byte ranges. The tokenizer
+// does not keep the offsets of the terms, so they are looked up in the text
+// in order of occurrence, a term which is not found starts at -1. The text is
+// lowercased for the lookup only if that keeps its byte length, otherwise
+// matching is case sensitive.
+func (h *highlighter) termOffsets(tokenization, text string) ([]string, []termMatch) {
+	haystack := text
+	switch tokenization {
+	case models.PropertyTokenizationWord, models.PropertyTokenizationLowercase, models.PropertyTokenizationTrigram:
+		if lower := strings.ToLower(text); len(lower) == len(text) {
+			haystack = lower
+		}
+	}
+	// these tokenizations produce overlapping terms
+	overlapping := tokenization == models.PropertyTokenizationTrigram ||
+		tokenization == models.PropertyTokenizationGse || tokenization == models.PropertyTokenizationGseCh
+
+	tokens := tokenizer.TokenizeForClass(tokenization, text, h.class.Class)
+	offsets := make([]termMatch, len(tokens))
+	cursor := 0
+	for i, term := range tokens {
+		pos := strings.Index(haystack[cursor:], term)
+		if pos < 0 {
+			offsets[i] = termMatch{start: -1, end: -1}
+			continue
+		}
+		start := cursor + pos
+		end := start + len(term)
+		offsets[i] = termMatch{start: start, end: end}
+		if overlapping {
+			_, size := utf8.DecodeRuneInString(haystack[start:])
+			cursor = start + size
+		} else {
+			cursor = end
+		}
+	}
+	return tokens, offsets
+}
+
+func mergeMatches(matches []termMatch) []termMatch {
+	if len(matches) < 2 {
+		return matches
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].start < matches[j].start })
+
+	merged := matches[:1]
+	for _, match := range matches[1:] {
+		last := &merged[len(merged)-1]
+		if match.start <= last.end {
+			if match.end > last.end {
+				last.end = match.end
+			}
+			continue
+		}
+		merged = append(merged, match)
+	}
+	return merged
+}
+
+// buildFragments returns up to limit fragments of about fragmentSize
+// characters around the matches, with the matches enclosed in tags. A
+// fragment contains all matches which fit into it. Fragments are centered on
+// their first match and trimmed to word boundaries.
+//
+// The text of the fragments is HTML-escaped, so that clients can render them
+// as markup without property values injecting their own.
+func buildFragments(text string, matches []termMatch, fragmentSize, limit int) []string {
+	var fragments []string
+	for i := 0; i < len(matches) && len(fragments) < limit; {
+		first := matches[i]
+
+		// center the fragment on its first match
+		before := (fragmentSize - utf8.RuneCountInString(text[first.start:first.end])) / 2
+		start := moveRunes(text, first.start, -before)
+		end := moveRunes(text, start, fragmentSize)
+		if end < first.end {
+			end = first.end
+		}
+
+		j := i + 1
+		for j < len(matches) && matches[j].end <= end {
+			j++
+		}
+		start = trimToWordStart(text, start, first.start)
+		end = trimToWordEnd(text, end, matches[j-1].end)
+
+		var fragment strings.Builder
+		cursor := start
+		for _, match := range matches[i:j] {
+			fragment.WriteString(html.EscapeString(text[cursor:match.start]))
+			fragment.WriteString(highlightsPreTag)
+			fragment.WriteString(html.EscapeString(text[match.start:match.end]))
+			fragment.WriteString(highlightsPostTag)
+			cursor = match.end
+		}
+		fragment.WriteString(html.EscapeString(text[cursor:end]))
+		fragments = append(fragments, strings.TrimSpace(fragment.String()))
+
+		i = j
+	}
+	return fragments
+}
+
+// moveRunes moves the byte offset pos by n runes, staying within text
+func moveRunes(text string, pos, n int) int {
+	for ; n > 0 && pos < len(text); n-- {
+		_, size := utf8.DecodeRuneInString(text[pos:])
+		pos += size
+	}
+	for ; n < 0 && pos > 0; n++ {
+		_, size := utf8.DecodeLastRuneInString(text[:pos])
+		pos -= size
+	}
+	return pos
+}
+
+// trimToWordStart moves start forward to the beginning of a word, so that
+// fragments do not begin in the middle of a word. It never moves past limit.
+func trimToWordStart(text string, start, limit int) int {
+	if start == 0 {
+		return start
+	}
+	if prev, _ := utf8.DecodeLastRuneInString(text[:start]); unicode.IsSpace(prev) {
+		return start
+	}
+	if next := strings.IndexFunc(text[start:limit], unicode.IsSpace); next >= 0 {
+		return start + next
+	}
+	return start
+}
+
+// trimToWordEnd moves end back to the end of a word, so that fragments do not
+// end in the middle of a word. It never moves before limit.
+func trimToWordEnd(text string, end, limit int) int {
+	if end == len(text) {
+		return end
+	}
+	if next, _ := utf8.DecodeRuneInString(text[end:]); unicode.IsSpace(next) {
+		return end
+	}
+	if prev := strings.LastIndexFunc(text[limit:end], unicode.IsSpace); prev >= 0 {
+		return limit + prev
+	}
+	return end
+}
@@ -0,0 +1,239 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func highlightsTestClass() *models.Class {
+	vTrue := true
+	return &models.Class{
+		Class: "Article",
+		Properties: []*models.Property{
+			{
+				Name:            "title",
+				DataType:        schema.DataTypeText.PropString(),
+				Tokenization:    models.PropertyTokenizationWord,
+				IndexSearchable: &vTrue,
+			},
+			{
+				Name:            "tags",
+				DataType:        schema.DataTypeTextArray.PropString(),
+				Tokenization:    models.PropertyTokenizationWord,
+				IndexSearchable: &vTrue,
+			},
+			{
+				Name:     "views",
+				DataType: schema.DataTypeInt.PropString(),
+			},
+		},
+	}
+}
+
+func TestExplorerHighlights(t *testing.T) {
+	newExplorer := func(searcher *fakeVectorSearcher) *Explorer {
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, log, getFakeModulesProvider(), &fakeMetrics{}, defaultConfig)
+		explorer.SetSchemaGetter(&fakeSchemaGetter{
+			schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{highlightsTestClass()}}},
+		})
+		return explorer
+	}
+
+	t.Run("bm25 with highlights of a property which is not selected", func(t *testing.T) {
+		searcher := &fakeVectorSearcher{}
+		searcher.On("Search", mock.Anything).Return([]search.Result{
+			{
+				ID: "id1",
+				Schema: map[string]interface{}{
+					"views": 3,
+					"title": "The quick brown fox jumps over the lazy dog",
+					"tags":  []string{"Fox", "dog"},
+				},
+			},
+		}, nil).Run(func(args mock.Arguments) {
+			params := args.Get(0).(dto.GetParams)
+			assert.NotNil(t, params.Properties.FindProperty("title"))
+			assert.NotNil(t, params.Properties.FindProperty("tags"))
+		})
+
+		res, err := newExplorer(searcher).GetClass(context.Background(), dto.GetParams{
+			ClassName:      "Article",
+			Pagination:     &filters.Pagination{Limit: 10},
+			KeywordRanking: &searchparams.KeywordRanking{Type: "bm25", Query: "the fox dog"},
+			Properties:     search.SelectProperties{{Name: "views", IsPrimitive: true}},
+			AdditionalProperties: additional.Properties{
+				Highlights: &additional.Highlights{Properties: []string{"title", "tags"}},
+			},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, map[string]interface{}{
+			"views": 3,
+			"_additional": map[string]interface{}{
+				"highlights": []*additional.Highlight{
+					{Property: "title", Fragments: []string{"The quick brown <em>fox</em> jumps over the lazy <em>dog</em>"}},
+					{Property: "tags", Fragments: []string{"<em>Fox</em>", "<em>dog</em>"}},
+				},
+			},
+		}, res[0])
+	})
+
+	t.Run("hybrid highlights the searched properties by default", func(t *testing.T) {
+		searcher := &fakeVectorSearcher{}
+		searcher.On("Search", mock.Anything).Return([]search.Result{}, nil).Maybe()
+
+		params := dto.GetParams{
+			ClassName:    "Article",
+			HybridSearch: &searchparams.HybridSearch{Query: "fox", Properties: []string{"title^2"}},
+			AdditionalProperties: additional.Properties{
+				Highlights: &additional.Highlights{},
+			},
+		}
+		_, err := newExplorer(searcher).prepareHighlights(&params)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"title"}, params.AdditionalProperties.Highlights.Properties)
+		assert.Equal(t, additional.DefaultHighlightsFragmentSize, params.AdditionalProperties.Highlights.FragmentSize)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		explorer := newExplorer(&fakeVectorSearcher{})
+		for name, params := range map[string]dto.GetParams{
+			"no keyword query": {
+				ClassName:            "Article",
+				AdditionalProperties: additional.Properties{Highlights: &additional.Highlights{}},
+			},
+			"unknown property": {
+				ClassName:            "Article",
+				KeywordRanking:       &searchparams.KeywordRanking{Type: "bm25", Query: "fox"},
+				AdditionalProperties: additional.Properties{Highlights: &additional.Highlights{Properties: []string{"body"}}},
+			},
+			"non text property": {
+				ClassName:            "Article",
+				KeywordRanking:       &searchparams.KeywordRanking{Type: "bm25", Query: "fox"},
+				AdditionalProperties: additional.Properties{Highlights: &additional.Highlights{Properties: []string{"views"}}},
+			},
+			"negative fragment size": {
+				ClassName:            "Article",
+				KeywordRanking:       &searchparams.KeywordRanking{Type: "bm25", Query: "fox"},
+				AdditionalProperties: additional.Properties{Highlights: &additional.Highlights{FragmentSize: -1}},
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := explorer.prepareHighlights(&params)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func TestBuildHighlightFragments(t *testing.T) {
+	h := &highlighter{class: highlightsTestClass(), termsByToken: map[string]map[string]struct{}{}}
+	terms := map[string]struct{}{"fox": {}, "dog": {}}
+
+	t.Run("matches are found case insensitively", func(t *testing.T) {
+		text := "Fox, fox-trot and DOG."
+		matches := h.matches(models.PropertyTokenizationWord, text, terms, nil)
+		assert.Equal(t, []termMatch{{0, 3}, {5, 8}, {18, 21}}, matches)
+		assert.Equal(t, []string{"<em>Fox</em>, <em>fox</em>-trot and <em>DOG</em>."},
+			buildFragments(text, matches, 100, maxHighlightsFragments))
+	})
+
+	t.Run("long texts are split into fragments at word boundaries", func(t *testing.T) {
+		text := "a fox is seen here and then a long story follows until finally a dog appears at the end"
+		matches := h.matches(models.PropertyTokenizationWord, text, terms, nil)
+		assert.Equal(t, []string{"a <em>fox</em> is seen here", "a <em>dog</em> appears"},
+			buildFragments(text, matches, 20, maxHighlightsFragments))
+		assert.Equal(t, []string{"a <em>fox</em> is seen here"}, buildFragments(text, matches, 20, 1))
+	})
+
+	t.Run("overlapping matches are merged", func(t *testing.T) {
+		assert.Equal(t, []termMatch{{0, 5}, {7, 8}}, mergeMatches([]termMatch{{0, 3}, {7, 8}, {2, 5}}))
+	})
+
+	t.Run("text is escaped", func(t *testing.T) {
+		text := `<script>alert("fox")</script> & dog`
+		matches := h.matches(models.PropertyTokenizationWord, text, terms, nil)
+		assert.Equal(t, []string{`&lt;script&gt;alert(&#34;<em>fox</em>&#34;)&lt;/script&gt; &amp; <em>dog</em>`},
+			buildFragments(text, matches, 100, maxHighlightsFragments))
+	})
+
+	t.Run("multibyte characters", func(t *testing.T) {
+		text := "ünïcödé fox"
+		matches := h.matches(models.PropertyTokenizationWord, text, terms, nil)
+		assert.Equal(t, []string{"ünïcödé <em>fox</em>"}, buildFragments(text, matches, 100, maxHighlightsFragments))
+	})
+
+	t.Run("phrases are highlighted as a whole", func(t *testing.T) {
+		text := "new shoes in new york, the big new apple of the york"
+		phrases := []highlightPhrase{{terms: []string{"new", "york"}, slop: 2}}
+		matches := h.matches(models.PropertyTokenizationWord, text, nil, phrases)
+		assert.Equal(t, []termMatch{{13, 21}}, matches)
+		assert.Equal(t, []string{"new shoes in <em>new york</em>, the big new apple of the york"},
+			buildFragments(text, matches, 100, maxHighlightsFragments))
+	})
+}
+
+func TestHighlightSearchedTerms(t *testing.T) {
+	results := []interface{}{
+		map[string]interface{}{"_additional": map[string]interface{}{
+			"highlightTerms": &additional.HighlightTerms{
+				Terms:   map[string][]string{models.PropertyTokenizationWord: {"quick", "quack"}},
+				Phrases: []additional.HighlightPhrase{{Text: "brown fox"}},
+			},
+		}},
+		map[string]interface{}{"_additional": map[string]interface{}{"distance": 0.1}},
+		map[string]interface{}{"_additional": map[string]interface{}{
+			"highlightTerms": &additional.HighlightTerms{
+				Terms:   map[string][]string{models.PropertyTokenizationWord: {"quick", "quirk"}},
+				Phrases: []additional.HighlightPhrase{{Text: "brown fox"}},
+			},
+		}},
+	}
+
+	searched := takeHighlightTerms(results)
+	assert.Equal(t, &additional.HighlightTerms{
+		Terms:   map[string][]string{models.PropertyTokenizationWord: {"quick", "quack", "quirk"}},
+		Phrases: []additional.HighlightPhrase{{Text: "brown fox"}},
+	}, searched)
+	for _, result := range results {
+		assert.NotContains(t, result.(map[string]interface{})["_additional"], "highlightTerms")
+	}
+
+	h := &highlighter{
+		class:        highlightsTestClass(),
+		query:        "quikc \"brown fox\"",
+		fragmentSize: 100,
+		searched:     searched,
+		termsByToken: map[string]map[string]struct{}{},
+		phrasesByTok: map[string][]highlightPhrase{},
+	}
+	prop := &models.Property{Name: "body", Tokenization: models.PropertyTokenizationWord}
+	assert.Equal(t, []string{"a <em>quirk</em> of the <em>brown fox</em>, not the quikc brown dog"},
+		h.fragments(prop, "a quirk of the brown fox, not the quikc brown dog"))
+
+	assert.Nil(t, takeHighlightTerms([]interface{}{map[string]interface{}{}}))
+}
@@ -32,12 +32,22 @@ import (
 	"github.com/weaviate/weaviate/usecases/traverser/hybrid"
 )
 
-// Do a bm25 search.  The results will be used in the hybrid algorithm
+// Do a bm25 search, or a sparse vector search if a sparse vector was given.
+// The results will be used in the hybrid algorithm
 func sparseSearch(ctx context.Context, e *Explorer, params dto.GetParams) ([]*search.Result, string, error) {
-	params.KeywordRanking = &searchparams.KeywordRanking{
-		Query:      params.HybridSearch.Query,
-		Type:       "bm25",
-		Properties: params.HybridSearch.Properties,
+	name := "keyword,bm25"
+	if params.HybridSearch.SparseVector != nil {
+		params.KeywordRanking = &searchparams.KeywordRanking{
+			Type:         "sparse",
+			SparseVector: params.HybridSearch.SparseVector,
+		}
+		name = "keyword,sparse"
+	} else {
+		params.KeywordRanking = &searchparams.KeywordRanking{
+			Query:      params.HybridSearch.Query,
+			Type:       "bm25",
+			Properties: params.HybridSearch.Properties,
+		}
 	}
 
 	params.Group = nil
@@ -55,6 +65,8 @@ func sparseSearch(ctx context.Context, e *Explorer, params dto.GetParams) ([]*se
 		params.KeywordRanking.MinimumOrTokensMatch = params.HybridSearch.MinimumOrTokensMatch
 	}
 
+	params.KeywordRanking.Fuzziness = params.HybridSearch.Fuzziness
+
 	totalLimit, err := e.CalculateTotalLimit(params.Pagination)
 	if err != nil {
 		return nil, "", err
@@ -78,7 +90,7 @@ func sparseSearch(ctx context.Context, e *Explorer, params dto.GetParams) ([]*se
 		out[i] = &sr
 	}
 
-	return out, "keyword,bm25", nil
+	return out, name, nil
 }
 
 // Do a nearvector search.  The results will be used in the hybrid algorithm
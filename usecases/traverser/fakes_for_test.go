@@ -99,6 +99,13 @@ func (f *fakeVectorSearcher) VectorSearch(ctx context.Context,
 	return args.Get(0).([]search.Result), args.Error(1)
 }
 
+func (f *fakeVectorSearcher) VectorSearchBatch(ctx context.Context,
+	params dto.GetParams, targetVector string, searchVectors [][]float32,
+) ([][]search.Result, error) {
+	args := f.Called(params, targetVector, searchVectors)
+	return args.Get(0).([][]search.Result), args.Error(1)
+}
+
 func (f *fakeVectorSearcher) Search(ctx context.Context,
 	params dto.GetParams,
 ) ([]search.Result, error) {
@@ -174,6 +181,12 @@ func (f *fakeExplorer) GetClass(ctx context.Context, p dto.GetParams) ([]interfa
 	return nil, nil
 }
 
+func (f *fakeExplorer) GetClassBatch(ctx context.Context, p dto.GetParams, targetVector string,
+	searchVectors [][]float32,
+) ([][]interface{}, error) {
+	return nil, nil
+}
+
 func (f *fakeExplorer) CrossClassVectorSearch(ctx context.Context, p ExploreParams) ([]search.Result, error) {
 	return nil, nil
 }
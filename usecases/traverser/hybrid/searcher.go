@@ -82,7 +82,7 @@ func Search(ctx context.Context, params *Params, logger logrus.FieldLogger, spar
 	alpha := params.Alpha
 	var belowCutoffSet map[strfmt.UUID]struct{}
 	if alpha < 1 {
-		if params.Query != "" {
+		if params.Query != "" || params.SparseVector != nil {
 			res, err := processSparseSearch(sparseSearch())
 			if err != nil {
 				return nil, err
@@ -52,6 +52,7 @@ type VectorSearcher interface {
 
 type explorer interface {
 	GetClass(ctx context.Context, params dto.GetParams) ([]interface{}, error)
+	GetClassBatch(ctx context.Context, params dto.GetParams, targetVector string, searchVectors [][]float32) ([][]interface{}, error)
 	CrossClassVectorSearch(ctx context.Context, params ExploreParams) ([]search.Result, error)
 }
 
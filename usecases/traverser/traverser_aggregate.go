@@ -40,6 +40,14 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 		return nil, errors.Wrap(err, "invalid 'where' filter")
 	}
 
+	vectorSearch := params.NearVector != nil || params.NearObject != nil ||
+		params.Hybrid != nil || len(params.ModuleParams) > 0
+	snapshot, err := readSnapshotKey(principal, params.Snapshot, vectorSearch)
+	if err != nil {
+		return nil, err
+	}
+	params.Snapshot = snapshot
+
 	if params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0 {
 		className := params.ClassName.String()
 		err := t.nearParamsVector.validateNearParams(params.NearVector,
@@ -19,6 +19,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/searchparams"
@@ -366,3 +367,38 @@ var aggregateTestSchema = schema.Schema{
 		},
 	},
 }
+
+func Test_Traverser_Aggregate_ReadSnapshot(t *testing.T) {
+	principal := &models.Principal{Username: "alice"}
+	logger, _ := test.NewNullLogger()
+	vectorRepo := &fakeVectorRepo{}
+	traverser := NewTraverser(&config.WeaviateConfig{}, logger, mocks.NewMockAuthorizer(),
+		vectorRepo, &fakeExplorer{}, &fakeSchemaGetter{aggregateTestSchema}, nil, nil, -1)
+
+	t.Run("snapshot is scoped to the principal", func(t *testing.T) {
+		params := aggregation.Params{ClassName: "MyClass", IncludeMetaCount: true, Snapshot: "export"}
+		expectedParams := params
+		expectedParams.Snapshot = dto.ReadSnapshotKey(principal, "export")
+		vectorRepo.On("Aggregate", expectedParams).Return(&aggregation.Result{}, nil).Once()
+
+		_, err := traverser.Aggregate(context.Background(), principal, &params)
+		require.Nil(t, err)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("vector searches are rejected", func(t *testing.T) {
+		params := aggregation.Params{
+			ClassName:  "MyClass",
+			NearVector: &searchparams.NearVector{Vectors: []models.Vector{[]float32{1, 2, 3}}},
+			Snapshot:   "export",
+		}
+		_, err := traverser.Aggregate(context.Background(), principal, &params)
+		assert.ErrorIs(t, err, errReadSnapshotVectorSearch)
+	})
+
+	t.Run("batch searches are rejected", func(t *testing.T) {
+		params := dto.GetParams{ClassName: "MyClass", Snapshot: "export"}
+		_, err := traverser.GetClassBatch(context.Background(), principal, params, "", [][]float32{{1, 2, 3}})
+		assert.ErrorIs(t, err, errReadSnapshotVectorSearch)
+	})
+}
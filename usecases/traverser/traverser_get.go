@@ -19,6 +19,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/filters"
 
+	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/dto"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
@@ -26,9 +27,97 @@ import (
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
+// GetClass returns the results of the query. Facets are not computed, see
+// GetClassWithFacets.
 func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 	params dto.GetParams,
 ) ([]interface{}, error) {
+	params.AdditionalProperties.Facets = nil
+	res, _, err := t.GetClassWithFacets(ctx, principal, params)
+	return res, err
+}
+
+// GetClassWithFacets returns the results of the query and the facets
+// requested in its additional properties, if any.
+func (t *Traverser) GetClassWithFacets(ctx context.Context, principal *models.Principal,
+	params dto.GetParams,
+) ([]interface{}, []*additional.FacetResult, error) {
+	done, err := t.startGetQuery(ctx, principal, &params)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer done()
+
+	certainty := ExtractCertaintyFromParams(params)
+	if certainty != 0 || params.AdditionalProperties.Certainty {
+		// if certainty is provided as input, we must ensure
+		// that the vector index is configured to use cosine
+		// distance
+		if err := t.validateGetDistanceParams(params); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	facetsAgg, err := t.facetsAggregation(params)
+	if err != nil {
+		return nil, nil, err
+	}
+	if facetsAgg == nil {
+		res, err := t.explorer.GetClass(ctx, params)
+		return res, nil, err
+	}
+
+	res, err := t.explorer.GetClass(ctx, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if facetsOverResults(params) {
+		// the facets of a search are counted over all of its results, not
+		// only over the page returned
+		all, err := t.explorer.GetClass(ctx, t.resultSetParams(params))
+		if err != nil {
+			return nil, nil, fmt.Errorf("facets: %w", err)
+		}
+		facetsAgg.Filters = resultIDsFilter(params.ClassName, all)
+		if facetsAgg.Filters == nil {
+			return res, emptyFacets(params), nil
+		}
+	}
+
+	facets, err := t.facets(ctx, params, facetsAgg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return res, facets, nil
+}
+
+// GetClassBatch runs a vector search for each of the search vectors, sharing
+// the filters, pagination and properties of the params. The batch counts as a
+// single query towards the rate limit.
+func (t *Traverser) GetClassBatch(ctx context.Context, principal *models.Principal,
+	params dto.GetParams, targetVector string, searchVectors [][]float32,
+) ([][]interface{}, error) {
+	if params.Snapshot != "" {
+		return nil, errReadSnapshotVectorSearch
+	}
+
+	done, err := t.startGetQuery(ctx, principal, &params)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	return t.explorer.GetClassBatch(ctx, params, targetVector, searchVectors)
+}
+
+// startGetQuery counts a Get query towards the rate limit and the metrics,
+// validates the references and filters of the params and scopes the read
+// snapshot to the principal. The returned func must be called once the query
+// finished, it is nil if the query can not be run.
+func (t *Traverser) startGetQuery(ctx context.Context, principal *models.Principal,
+	params *dto.GetParams,
+) (func(), error) {
 	before := time.Now()
 
 	ok := t.ratelimiter.TryInc()
@@ -39,32 +128,52 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 		return nil, enterrors.NewErrRateLimit()
 	}
 
-	defer t.ratelimiter.Dec()
-
 	t.metrics.QueriesGetInc(params.ClassName)
-	defer t.metrics.QueriesGetDec(params.ClassName)
-	defer t.metrics.QueriesObserveDuration(params.ClassName, before.UnixMilli())
+	done := func() {
+		t.metrics.QueriesObserveDuration(params.ClassName, before.UnixMilli())
+		t.metrics.QueriesGetDec(params.ClassName)
+		t.ratelimiter.Dec()
+	}
 
 	if err := t.probeForRefDepthLimit(params.Properties); err != nil {
+		done()
 		return nil, err
 	}
 
 	// validate here, because filters can contain references that need to be authorized
 	if err := t.validateFilters(ctx, principal, params.Filters); err != nil {
+		done()
 		return nil, errors.Wrap(err, "invalid 'where' filter")
 	}
 
-	certainty := ExtractCertaintyFromParams(params)
-	if certainty != 0 || params.AdditionalProperties.Certainty {
-		// if certainty is provided as input, we must ensure
-		// that the vector index is configured to use cosine
-		// distance
-		if err := t.validateGetDistanceParams(params); err != nil {
-			return nil, err
-		}
+	vectorSearch := params.NearVector != nil || params.NearObject != nil ||
+		params.HybridSearch != nil || len(params.ModuleParams) > 0
+	snapshot, err := readSnapshotKey(principal, params.Snapshot, vectorSearch)
+	if err != nil {
+		done()
+		return nil, err
 	}
+	params.Snapshot = snapshot
+	return done, nil
+}
 
-	return t.explorer.GetClass(ctx, params)
+// errReadSnapshotVectorSearch is returned for vector and hybrid searches which
+// request a read snapshot. Snapshots of the vector indexes are not
+// implemented, so their results would not be stable across the requests of a
+// snapshot.
+var errReadSnapshotVectorSearch = errors.New("snapshot: read snapshots of the vector indexes are " +
+	"not implemented, only filtered, bm25 and cursor queries can read from a snapshot")
+
+// readSnapshotKey returns the key of the read snapshot requested by the
+// principal, see [dto.ReadSnapshotKey], if any
+func readSnapshotKey(principal *models.Principal, snapshot string, vectorSearch bool) (string, error) {
+	if snapshot == "" {
+		return "", nil
+	}
+	if vectorSearch {
+		return "", errReadSnapshotVectorSearch
+	}
+	return dto.ReadSnapshotKey(principal, snapshot), nil
 }
 
 // probeForRefDepthLimit checks to ensure reference nesting depth doesn't exceed the limit
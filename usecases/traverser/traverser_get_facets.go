@@ -0,0 +1,272 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/modules"
+)
+
+// facetsAggregation builds the aggregation counting the values of the
+// requested facets. Without a search the facets are counted over all objects
+// matching the where filter, independent of the limit. The facets of a search
+// are counted over all of its results, see resultSetParams.
+func (t *Traverser) facetsAggregation(params dto.GetParams) (*aggregation.Params, error) {
+	facets := params.AdditionalProperties.Facets
+	if len(facets) == 0 {
+		return nil, nil
+	}
+	if params.GroupBy != nil {
+		return nil, fmt.Errorf("facets can not be combined with groupBy")
+	}
+
+	className := params.ClassName
+	if cls := t.schemaGetter.ResolveAlias(className); cls != "" {
+		className = cls
+	}
+	class := t.schemaGetter.ReadOnlyClass(className)
+	if class == nil {
+		return nil, fmt.Errorf("facets: class %q not found", className)
+	}
+
+	agg := &aggregation.Params{
+		ClassName: schema.ClassName(className),
+		Filters:   params.Filters,
+		Tenant:    params.Tenant,
+		Snapshot:  params.Snapshot,
+	}
+	seen := map[string]struct{}{}
+	for _, facet := range facets {
+		if _, ok := seen[facet.Property]; ok {
+			return nil, fmt.Errorf("facets: property %q is requested more than once", facet.Property)
+		}
+		seen[facet.Property] = struct{}{}
+
+		if facet.Limit < 0 {
+			return nil, fmt.Errorf("facets: limit of property %q must not be negative, got %d", facet.Property, facet.Limit)
+		}
+		prop, err := schema.GetPropertyByName(class, facet.Property)
+		if err != nil {
+			return nil, fmt.Errorf("facets: %w", err)
+		}
+		aggregator, err := facetAggregator(prop, facet)
+		if err != nil {
+			return nil, fmt.Errorf("facets: property %q: %w", facet.Property, err)
+		}
+		agg.Properties = append(agg.Properties, aggregation.ParamProperty{
+			Name:        schema.PropertyName(prop.Name),
+			Aggregators: aggregator,
+		})
+	}
+	return agg, nil
+}
+
+func facetAggregator(prop *models.Property, facet additional.Facet) ([]aggregation.Aggregator, error) {
+	dt, ok := schema.AsPrimitive(prop.DataType)
+	if !ok {
+		return nil, fmt.Errorf("facets are not supported for references")
+	}
+
+	switch dt {
+	case schema.DataTypeInt, schema.DataTypeIntArray, schema.DataTypeNumber, schema.DataTypeNumberArray:
+		if len(facet.Ranges) > 0 {
+			ranges := make([]aggregation.Range, len(facet.Ranges))
+			for i, r := range facet.Ranges {
+				if r.From != nil && r.To != nil && *r.From >= *r.To {
+					return nil, fmt.Errorf("range from %v must be lower than to %v", *r.From, *r.To)
+				}
+				ranges[i] = aggregation.Range{From: r.From, To: r.To}
+			}
+			return []aggregation.Aggregator{aggregation.NewRangesAggregator(ranges)}, nil
+		}
+	case schema.DataTypeText, schema.DataTypeTextArray, schema.DataTypeDate, schema.DataTypeDateArray:
+	case schema.DataTypeBoolean, schema.DataTypeBooleanArray:
+		if len(facet.Ranges) == 0 {
+			return []aggregation.Aggregator{aggregation.TotalTrueAggregator, aggregation.TotalFalseAggregator}, nil
+		}
+	default:
+		return nil, fmt.Errorf("facets are not supported for data type %q", dt)
+	}
+
+	if len(facet.Ranges) > 0 {
+		return nil, fmt.Errorf("ranges are only supported for int and number properties")
+	}
+	limit := facetShardLimit
+	return []aggregation.Aggregator{aggregation.NewTopOccurrencesAggregator(&limit)}, nil
+}
+
+// facetShardLimit is the number of values every shard returns for a facet.
+// A value that is not among the most frequent of one shard can still be
+// among the most frequent of all shards, so the shards return all of their
+// values and the merged counts are cut to the limit of the facet in
+// facetResult.
+const facetShardLimit = math.MaxInt32
+
+func facetLimit(facet additional.Facet) int {
+	if facet.Limit == 0 {
+		return additional.DefaultFacetLimit
+	}
+	return facet.Limit
+}
+
+func (t *Traverser) facets(ctx context.Context, params dto.GetParams, agg *aggregation.Params) ([]*additional.FacetResult, error) {
+	var mp *modules.Provider
+	if t.nearParamsVector.modulesProvider != nil {
+		mp = t.nearParamsVector.modulesProvider.(*modules.Provider)
+	}
+
+	res, err := t.vectorSearcher.Aggregate(ctx, *agg, mp)
+	if err != nil {
+		return nil, fmt.Errorf("facets: %w", err)
+	}
+
+	var group aggregation.Group
+	if res != nil && len(res.Groups) > 0 {
+		group = res.Groups[0]
+	}
+
+	out := make([]*additional.FacetResult, len(params.AdditionalProperties.Facets))
+	for i, facet := range params.AdditionalProperties.Facets {
+		out[i] = facetResult(facet, group.Properties[facet.Property])
+	}
+	return out, nil
+}
+
+func facetResult(facet additional.Facet, prop aggregation.Property) *additional.FacetResult {
+	res := &additional.FacetResult{Property: facet.Property}
+
+	if len(facet.Ranges) > 0 {
+		res.Ranges = make([]additional.FacetRangeCount, len(facet.Ranges))
+		for i, r := range facet.Ranges {
+			res.Ranges[i] = additional.FacetRangeCount{From: r.From, To: r.To}
+			if i < len(prop.RangeCounts) {
+				res.Ranges[i].Count = prop.RangeCounts[i].Count
+			}
+		}
+		return res
+	}
+
+	values := []additional.FacetValue{}
+	switch prop.Type {
+	case aggregation.PropertyTypeText:
+		for _, item := range prop.TextAggregation.Items {
+			values = append(values, additional.FacetValue{Value: item.Value, Count: item.Occurs})
+		}
+	case aggregation.PropertyTypeBoolean:
+		if prop.BooleanAggregation.TotalTrue > 0 {
+			values = append(values, additional.FacetValue{Value: "true", Count: prop.BooleanAggregation.TotalTrue})
+		}
+		if prop.BooleanAggregation.TotalFalse > 0 {
+			values = append(values, additional.FacetValue{Value: "false", Count: prop.BooleanAggregation.TotalFalse})
+		}
+		sort.SliceStable(values, func(a, b int) bool { return values[a].Count > values[b].Count })
+	default:
+		for _, occ := range prop.TopOccurrences {
+			values = append(values, additional.FacetValue{Value: facetValueString(occ.Value), Count: occ.Occurs})
+		}
+	}
+
+	if limit := facetLimit(facet); len(values) > limit {
+		values = values[:limit]
+	}
+	res.Values = values
+	return res
+}
+
+func facetValueString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// facetsOverResults reports whether the query is a vector, bm25 or hybrid
+// search. Such a search ranks objects rather than matching them, so its
+// facets are counted over the objects it can return.
+func facetsOverResults(params dto.GetParams) bool {
+	return params.NearVector != nil || params.NearObject != nil ||
+		params.KeywordRanking != nil || params.HybridSearch != nil ||
+		len(params.ModuleParams) > 0
+}
+
+// resultSetParams returns the params of a search for the ids of all of its
+// results, that is of every page up to the maximum number of results of a
+// query. Properties, generative and rerank modules are left out, autocut and
+// the filters still apply.
+func (t *Traverser) resultSetParams(params dto.GetParams) dto.GetParams {
+	pagination := &filters.Pagination{Limit: int(t.config.Config.QueryMaximumResults)}
+	if params.Pagination != nil {
+		pagination.Autocut = params.Pagination.Autocut
+	}
+	params.Pagination = pagination
+	params.Cursor = nil
+	params.Properties = nil
+	params.AdditionalProperties = additional.Properties{ID: true}
+	return params
+}
+
+// resultIDsFilter returns a filter matching the objects of the results, or
+// nil if there are none.
+func resultIDsFilter(className string, res []interface{}) *filters.LocalFilter {
+	ids := make([]string, 0, len(res))
+	for _, result := range res {
+		props, ok := result.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		additionalProps, ok := props["_additional"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if id, ok := additionalProps["id"].(strfmt.UUID); ok {
+			ids = append(ids, id.String())
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.ContainsAny,
+		On: &filters.Path{
+			Class:    schema.ClassName(className),
+			Property: filters.InternalPropID,
+		},
+		Value: &filters.Value{Value: ids, Type: schema.DataTypeText},
+	}}
+}
+
+// emptyFacets returns the facets of a query without results
+func emptyFacets(params dto.GetParams) []*additional.FacetResult {
+	out := make([]*additional.FacetResult, len(params.AdditionalProperties.Facets))
+	for i, facet := range params.AdditionalProperties.Facets {
+		out[i] = facetResult(facet, aggregation.Property{})
+	}
+	return out
+}
@@ -0,0 +1,205 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_Traverser_Facets(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	vectorRepo := &fakeVectorRepo{}
+	traverser := NewTraverser(&config.WeaviateConfig{}, logger, mocks.NewMockAuthorizer(),
+		vectorRepo, &fakeExplorer{}, &fakeSchemaGetter{aggregateTestSchema}, nil, nil, -1)
+
+	ptFloat := func(f float64) *float64 { return &f }
+	ptInt := func(i int) *int { return &i }
+
+	t.Run("invalid facets", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			facet       additional.Facet
+			expectedErr string
+		}{
+			{
+				name:        "unknown property",
+				facet:       additional.Facet{Property: "unknown"},
+				expectedErr: "facets: no such prop with name 'unknown' found in class 'MyClass' in the schema. Check your schema files for which properties in this class are available",
+			},
+			{
+				name:        "reference",
+				facet:       additional.Facet{Property: "a ref"},
+				expectedErr: "facets: property \"a ref\": facets are not supported for references",
+			},
+			{
+				name:        "negative limit",
+				facet:       additional.Facet{Property: "label", Limit: -1},
+				expectedErr: "facets: limit of property \"label\" must not be negative, got -1",
+			},
+			{
+				name:        "ranges on text",
+				facet:       additional.Facet{Property: "label", Ranges: []additional.FacetRange{{To: ptFloat(1)}}},
+				expectedErr: "facets: property \"label\": ranges are only supported for int and number properties",
+			},
+			{
+				name:        "empty range",
+				facet:       additional.Facet{Property: "int", Ranges: []additional.FacetRange{{From: ptFloat(2), To: ptFloat(1)}}},
+				expectedErr: "facets: property \"int\": range from 2 must be lower than to 1",
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				params := dto.GetParams{ClassName: "MyClass"}
+				params.AdditionalProperties.Facets = []additional.Facet{tt.facet}
+				_, err := traverser.facetsAggregation(params)
+				assert.EqualError(t, err, tt.expectedErr)
+			})
+		}
+	})
+
+	t.Run("counts values and ranges", func(t *testing.T) {
+		params := dto.GetParams{ClassName: "MyClass", Tenant: "tenant"}
+		params.AdditionalProperties.Facets = []additional.Facet{
+			{Property: "label", Limit: 1},
+			{Property: "number"},
+			{Property: "int", Ranges: []additional.FacetRange{{To: ptFloat(10)}, {From: ptFloat(10)}}},
+		}
+
+		agg, err := traverser.facetsAggregation(params)
+		require.Nil(t, err)
+		expectedAgg := aggregation.Params{
+			ClassName: "MyClass",
+			Tenant:    "tenant",
+			Properties: []aggregation.ParamProperty{
+				{Name: "label", Aggregators: []aggregation.Aggregator{aggregation.NewTopOccurrencesAggregator(ptInt(facetShardLimit))}},
+				{Name: "number", Aggregators: []aggregation.Aggregator{aggregation.NewTopOccurrencesAggregator(ptInt(facetShardLimit))}},
+				{Name: "int", Aggregators: []aggregation.Aggregator{aggregation.NewRangesAggregator([]aggregation.Range{{To: ptFloat(10)}, {From: ptFloat(10)}})}},
+			},
+		}
+		assert.Equal(t, &expectedAgg, agg)
+
+		vectorRepo.On("Aggregate", expectedAgg).Return(&aggregation.Result{Groups: []aggregation.Group{{
+			Properties: map[string]aggregation.Property{
+				"label": {
+					Type: aggregation.PropertyTypeText,
+					TextAggregation: aggregation.Text{Items: []aggregation.TextOccurrence{
+						{Value: "foo", Occurs: 3},
+						{Value: "bar", Occurs: 1},
+					}},
+				},
+				"number": {
+					Type:           aggregation.PropertyTypeNumerical,
+					TopOccurrences: []aggregation.Occurrence{{Value: 1.5, Occurs: 2}},
+				},
+				"int": {
+					Type: aggregation.PropertyTypeNumerical,
+					RangeCounts: []aggregation.RangeCount{
+						{Range: aggregation.Range{To: ptFloat(10)}, Count: 4},
+						{Range: aggregation.Range{From: ptFloat(10)}, Count: 0},
+					},
+				},
+			},
+		}}}, nil)
+
+		facets, err := traverser.facets(context.Background(), params, agg)
+		require.Nil(t, err)
+		assert.Equal(t, []*additional.FacetResult{
+			{Property: "label", Values: []additional.FacetValue{{Value: "foo", Count: 3}}},
+			{Property: "number", Values: []additional.FacetValue{{Value: "1.5", Count: 2}}},
+			{Property: "int", Ranges: []additional.FacetRangeCount{
+				{To: ptFloat(10), Count: 4},
+				{From: ptFloat(10), Count: 0},
+			}},
+		}, facets)
+	})
+
+	t.Run("rejects groupBy", func(t *testing.T) {
+		params := dto.GetParams{ClassName: "MyClass", GroupBy: &searchparams.GroupBy{Property: "label"}}
+		params.AdditionalProperties.Facets = []additional.Facet{{Property: "label"}}
+		_, err := traverser.facetsAggregation(params)
+		assert.EqualError(t, err, "facets can not be combined with groupBy")
+	})
+
+	t.Run("counts the results of a search", func(t *testing.T) {
+		assert.False(t, facetsOverResults(dto.GetParams{ClassName: "MyClass"}))
+		assert.True(t, facetsOverResults(dto.GetParams{NearVector: &searchparams.NearVector{}}))
+		assert.True(t, facetsOverResults(dto.GetParams{KeywordRanking: &searchparams.KeywordRanking{Query: "foo"}}))
+		assert.True(t, facetsOverResults(dto.GetParams{HybridSearch: &searchparams.HybridSearch{Query: "foo"}}))
+		assert.True(t, facetsOverResults(dto.GetParams{ModuleParams: map[string]interface{}{"nearText": nil}}))
+
+		id1 := strfmt.UUID("8b7f0d2e-3c1a-4d6b-9e0f-1a2b3c4d5e6f")
+		id2 := strfmt.UUID("2c4e6a8b-0d1f-4a3c-8e5b-7d9f1b3d5f7a")
+		results := []interface{}{
+			map[string]interface{}{"_additional": map[string]interface{}{"id": id1}},
+			map[string]interface{}{"_additional": map[string]interface{}{"id": id2, "score": "1"}},
+		}
+
+		filter := resultIDsFilter("MyClass", results)
+		assert.Equal(t, &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.ContainsAny,
+			On:       &filters.Path{Class: "MyClass", Property: filters.InternalPropID},
+			Value:    &filters.Value{Value: []string{id1.String(), id2.String()}, Type: schema.DataTypeText},
+		}}, filter)
+
+		assert.Nil(t, resultIDsFilter("MyClass", nil))
+	})
+
+	t.Run("counts all results of a search independent of the page", func(t *testing.T) {
+		traverser := NewTraverser(&config.WeaviateConfig{Config: config.Config{QueryMaximumResults: 1000}},
+			logger, mocks.NewMockAuthorizer(), vectorRepo, &fakeExplorer{}, &fakeSchemaGetter{aggregateTestSchema}, nil, nil, -1)
+
+		params := dto.GetParams{
+			ClassName:      "MyClass",
+			KeywordRanking: &searchparams.KeywordRanking{Query: "foo"},
+			Pagination:     &filters.Pagination{Offset: 20, Limit: 10, Autocut: 2},
+			Cursor:         &filters.Cursor{Limit: 10},
+			Properties:     search.SelectProperties{{Name: "label"}},
+		}
+		params.AdditionalProperties.Score = true
+		params.AdditionalProperties.Facets = []additional.Facet{{Property: "label"}}
+
+		resultSet := traverser.resultSetParams(params)
+		assert.Equal(t, &filters.Pagination{Limit: 1000, Autocut: 2}, resultSet.Pagination)
+		assert.Nil(t, resultSet.Cursor)
+		assert.Nil(t, resultSet.Properties)
+		assert.Equal(t, additional.Properties{ID: true}, resultSet.AdditionalProperties)
+		assert.Equal(t, params.KeywordRanking, resultSet.KeywordRanking)
+		assert.Equal(t, &filters.Pagination{Offset: 20, Limit: 10, Autocut: 2}, params.Pagination)
+	})
+
+	t.Run("searches without results have empty facets", func(t *testing.T) {
+		params := dto.GetParams{ClassName: "MyClass"}
+		params.AdditionalProperties.Facets = []additional.Facet{
+			{Property: "label"},
+			{Property: "int", Ranges: []additional.FacetRange{{To: ptFloat(10)}}},
+		}
+		assert.Equal(t, []*additional.FacetResult{
+			{Property: "label", Values: []additional.FacetValue{}},
+			{Property: "int", Ranges: []additional.FacetRangeCount{{To: ptFloat(10)}}},
+		}, emptyFacets(params))
+	})
+}